@@ -23,9 +23,9 @@ import (
 	"encoding/binary"
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/pb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/pb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto"
 )
 
 // flushThreshold determines when a buffer will be flushed. When performing a
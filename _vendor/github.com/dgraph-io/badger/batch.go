@@ -19,7 +19,7 @@ package badger
 import (
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
 )
 
 // WriteBatch holds the necessary info to perform batched writes.
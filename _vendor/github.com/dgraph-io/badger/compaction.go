@@ -25,8 +25,8 @@ import (
 
 	"golang.org/x/net/trace"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/table"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/table"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
 )
 
 type keyRange struct {
@@ -31,13 +31,13 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/options"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/pb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/skl"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/table"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
-	humanize "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dustin/go-humanize"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/options"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/pb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/skl"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/table"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
+	humanize "github.com/ipfs/fs-repo-migrations/_vendor/github.com/dustin/go-humanize"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
 	"golang.org/x/net/trace"
 )
 
@@ -22,7 +22,7 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
 )
 
 // directoryLockGuard holds a lock on a directory and a pid file inside.  The pid file isn't part
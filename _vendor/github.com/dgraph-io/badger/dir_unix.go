@@ -24,9 +24,9 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/unix"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/unix"
 )
 
 // directoryLockGuard holds a lock on a directory and a pid file inside.  The pid file isn't part
@@ -24,7 +24,7 @@ import (
 	"path/filepath"
 	"syscall"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
 )
 
 // FILE_ATTRIBUTE_TEMPORARY - A file that is being used for temporary storage.
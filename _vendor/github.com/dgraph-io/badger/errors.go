@@ -19,7 +19,7 @@ package badger
 import (
 	"math"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
 )
 
 const (
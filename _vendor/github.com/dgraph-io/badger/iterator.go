@@ -25,10 +25,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/options"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/table"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/options"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/table"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
 )
 
 type prefetchStatus uint8
@@ -17,7 +17,7 @@
 package badger
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/options"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/options"
 )
 
 // Note: If you add a new option X make sure you also add a WithX method on Options.
@@ -5,7 +5,7 @@ package pb
 
 import (
 	fmt "fmt"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
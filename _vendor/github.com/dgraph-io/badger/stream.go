@@ -24,10 +24,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/pb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/y"
-	humanize "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dustin/go-humanize"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/pb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/y"
+	humanize "github.com/ipfs/fs-repo-migrations/_vendor/github.com/dustin/go-humanize"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto"
 )
 
 const pageSize = 4 << 20 // 4MB
@@ -18,7 +18,7 @@
 
 package y
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/unix"
+import "github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/unix"
 
 func init() {
 	datasyncFileFlag = unix.O_DSYNC
@@ -21,7 +21,7 @@ package y
 import (
 	"os"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/unix"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/unix"
 )
 
 // Mmap uses the mmap system call to memory-map a file. If writable is true,
@@ -26,7 +26,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/pkg/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/pkg/errors"
 )
 
 // ErrEOF indicates an end of file when trying to read from a memory mapped file
@@ -17,7 +17,7 @@
 package z
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/cespare/xxhash"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/cespare/xxhash"
 )
 
 // TODO: Figure out a way to re-use memhash for the second uint64 hash, we
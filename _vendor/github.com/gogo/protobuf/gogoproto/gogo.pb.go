@@ -5,8 +5,8 @@ package gogoproto
 
 import (
 	fmt "fmt"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
-	descriptor "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	descriptor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	math "math"
 )
 
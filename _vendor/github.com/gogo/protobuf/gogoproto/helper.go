@@ -28,8 +28,8 @@
 
 package gogoproto
 
-import google_protobuf "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-import proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+import google_protobuf "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+import proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 
 func IsEmbed(field *google_protobuf.FieldDescriptorProto) bool {
 	return proto.GetBoolExtension(field.Options, E_Embed, false)
@@ -99,7 +99,7 @@ The resulting file, test.pb.go, is:
 
 	package example
 
-	import proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	import proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 	import math "math"
 
 	type FOO int32
@@ -226,7 +226,7 @@ To create and play with a Test object:
 	import (
 		"log"
 
-		"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+		"github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 		pb "./example.pb"
 	)
 
@@ -42,7 +42,7 @@ import (
 	"fmt"
 	"io/ioutil"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 )
 
 // extractFile extracts a FileDescriptorProto from a gzip'd buffer.
@@ -5,7 +5,7 @@ package descriptor
 
 import (
 	fmt "fmt"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 	math "math"
 )
 
@@ -5,8 +5,8 @@ package descriptor
 
 import (
 	fmt "fmt"
-	github_com_gogo_protobuf_proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 	math "math"
 	reflect "reflect"
 	sort "sort"
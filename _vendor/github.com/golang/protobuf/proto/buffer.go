@@ -8,9 +8,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/prototext"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/protowire"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoimpl"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/prototext"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/protowire"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoimpl"
 )
 
 const (
@@ -10,7 +10,7 @@ import (
 	"fmt"
 	"strconv"
 
-	protoV2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
+	protoV2 "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
 )
 
 var (
@@ -5,7 +5,7 @@
 package proto
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // DiscardUnknown recursively discards all unknown fields from this message
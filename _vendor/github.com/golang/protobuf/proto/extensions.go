@@ -9,12 +9,12 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/protowire"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoregistry"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoiface"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoimpl"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/protowire"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoregistry"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoiface"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoimpl"
 )
 
 type (
@@ -11,8 +11,8 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoimpl"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoimpl"
 )
 
 // StructProperties represents protocol buffer type information for a
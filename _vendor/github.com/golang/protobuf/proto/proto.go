@@ -9,14 +9,14 @@
 // See https://developers.google.com/protocol-buffers/docs/gotutorial for
 // more information.
 //
-// Deprecated: Use the "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto" package instead.
+// Deprecated: Use the "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto" package instead.
 package proto
 
 import (
-	protoV2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoiface"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoimpl"
+	protoV2 "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoiface"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoimpl"
 )
 
 const (
@@ -42,7 +42,7 @@ type GeneratedMessage interface{}
 // than an empty interface as it lacks any method to programatically interact
 // with the contents of the message.
 //
-// A v2 message is declared in "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto".Message and
+// A v2 message is declared in "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto".Message and
 // exposes protobuf reflection as a first-class feature of the interface.
 //
 // To convert a v1 message to a v2 message, use the MessageV2 function.
@@ -13,11 +13,11 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/prototext"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/protowire"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoregistry"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/prototext"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/protowire"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoregistry"
 )
 
 const wrapTextMarshalV2 = false
@@ -5,8 +5,8 @@
 package proto
 
 import (
-	protoV2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/runtime/protoiface"
+	protoV2 "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/runtime/protoiface"
 )
 
 // Size returns the size in bytes of the wire-format encoding of m.
@@ -7,9 +7,9 @@ import (
 	"errors"
 	"fmt"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
-	u "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs-util"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	u "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipfs-util"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
 )
 
 // ErrWrongHash is returned when the Cid of a block is not the expected
@@ -28,9 +28,9 @@ import (
 	"io"
 	"strings"
 
-	mbase "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multibase"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
-	varint "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-varint"
+	mbase "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multibase"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+	varint "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-varint"
 )
 
 // UnsupportedVersionString just holds an error message
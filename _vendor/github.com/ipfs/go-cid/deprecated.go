@@ -1,7 +1,7 @@
 package cid
 
 import (
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
 )
 
 // NewPrefixV0 returns a CIDv0 prefix with the specified multihash type.
@@ -1,7 +1,7 @@
 package cid
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-varint"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-varint"
 )
 
 // Version of varint function that work with a string rather than
@@ -3,7 +3,7 @@ package datastore
 import (
 	"log"
 
-	dsq "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	dsq "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 )
 
 // Here are some basic datastore implementations.
@@ -5,7 +5,7 @@ import (
 	"io"
 	"time"
 
-	query "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	query "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 )
 
 /*
@@ -5,9 +5,9 @@ import (
 	"path"
 	"strings"
 
-	dsq "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	dsq "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/google/uuid"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/google/uuid"
 )
 
 /*
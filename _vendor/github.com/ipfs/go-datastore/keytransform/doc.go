@@ -7,8 +7,8 @@
 // its inverse. For example:
 //
 //   import (
-//     ktds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/keytransform"
-//     ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
+//     ktds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/keytransform"
+//     ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
 //   )
 //
 //   func reverseKey(k ds.Key) ds.Key {
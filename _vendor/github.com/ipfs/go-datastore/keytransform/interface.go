@@ -1,6 +1,6 @@
 package keytransform
 
-import ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
+import ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
 
 // KeyMapping is a function that maps one key to annother
 type KeyMapping func(ds.Key) ds.Key
@@ -1,8 +1,8 @@
 package keytransform
 
 import (
-	ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	dsq "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 )
 
 // Wrap wraps a given datastore with a KeyTransform function.
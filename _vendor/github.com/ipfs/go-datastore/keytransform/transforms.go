@@ -1,6 +1,6 @@
 package keytransform
 
-import ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
+import ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
 
 // Pair is a convince struct for constructing a key transform.
 type Pair struct {
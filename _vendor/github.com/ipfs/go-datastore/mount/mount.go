@@ -10,10 +10,10 @@ import (
 	"strings"
 	"sync"
 
-	ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
 )
 
 var (
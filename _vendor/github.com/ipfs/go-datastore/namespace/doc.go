@@ -6,8 +6,8 @@
 //  import (
 //    "fmt"
 //
-//    ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-//    nsds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/namespace"
+//    ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+//    nsds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/namespace"
 //  )
 //
 //  func main() {
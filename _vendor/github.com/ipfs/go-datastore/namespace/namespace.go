@@ -1,8 +1,8 @@
 package namespace
 
 import (
-	ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	ktds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/keytransform"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	ktds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/keytransform"
 )
 
 // PrefixTransform constructs a KeyTransform with a pair of functions that
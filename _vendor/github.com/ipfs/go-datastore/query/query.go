@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"time"
 
-	goprocess "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/goprocess"
+	goprocess "github.com/ipfs/fs-repo-migrations/_vendor/github.com/jbenet/goprocess"
 )
 
 /*
@@ -3,7 +3,7 @@ package query
 import (
 	"path"
 
-	goprocess "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/goprocess"
+	goprocess "github.com/ipfs/fs-repo-migrations/_vendor/github.com/jbenet/goprocess"
 )
 
 // NaiveFilter applies a filter to the results.
@@ -8,12 +8,12 @@ import (
 	"sync"
 	"time"
 
-	badger "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger"
-	options "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/dgraph-io/badger/options"
-	ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	dsq "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
-	logger "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/v2"
-	goprocess "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/goprocess"
+	badger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger"
+	options "github.com/ipfs/fs-repo-migrations/_vendor/github.com/dgraph-io/badger/options"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	logger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/v2"
+	goprocess "github.com/ipfs/fs-repo-migrations/_vendor/github.com/jbenet/goprocess"
 )
 
 // badgerLog is a local wrapper for go-log to make the interface
@@ -11,8 +11,8 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
 )
 
 func UpgradeV0toV1(path string, prefixLen int) error {
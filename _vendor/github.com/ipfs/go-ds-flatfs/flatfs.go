@@ -17,11 +17,11 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/goprocess"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/jbenet/goprocess"
 
-	logging "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log"
+	logging "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log"
 )
 
 var log = logging.Logger("flatfs")
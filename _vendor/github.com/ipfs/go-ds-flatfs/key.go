@@ -1,7 +1,7 @@
 package flatfs
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
 )
 
 // keyIsValid returns true if the key is valid for flatfs.
@@ -5,14 +5,14 @@ import (
 	"path/filepath"
 	"sync"
 
-	ds "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	dsq "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore/query"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/iterator"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/opt"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/util"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type Datastore struct {
@@ -3,9 +3,9 @@
 package dshelp
 
 import (
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-base32"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-base32"
 )
 
 // NewKeyFromBinary creates a new key from a byte slice.
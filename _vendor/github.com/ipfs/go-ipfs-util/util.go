@@ -12,8 +12,8 @@ import (
 	"strings"
 	"time"
 
-	b58 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/mr-tron/base58/base58"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
+	b58 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
 )
 
 // DefaultIpfsHash is the current default hash function used by IPFS.
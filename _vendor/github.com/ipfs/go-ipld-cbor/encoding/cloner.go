@@ -3,8 +3,8 @@ package encoding
 import (
 	"sync"
 
-	refmt "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
+	refmt "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
 )
 
 // PooledCloner is a thread-safe pooled object cloner.
@@ -5,8 +5,8 @@ import (
 	"io"
 	"sync"
 
-	cbor "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/cbor"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
+	cbor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/cbor"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
 )
 
 type proxyReader struct {
@@ -8,10 +8,10 @@ import (
 	"strconv"
 	"strings"
 
-	blocks "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-block-format"
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
-	node "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-format"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
+	blocks "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-block-format"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	node "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipld-format"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
 )
 
 // CBORTagLink is the integer used to represent tags in CBOR.
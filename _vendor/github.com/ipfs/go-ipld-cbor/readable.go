@@ -4,9 +4,9 @@ import (
 	"bufio"
 	"bytes"
 
-	cbor "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/cbor"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/pretty"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/shared"
+	cbor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/cbor"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/pretty"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/shared"
 )
 
 //HumanReadable returns a string representation of a CBOR blob
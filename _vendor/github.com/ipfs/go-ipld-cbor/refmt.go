@@ -3,11 +3,11 @@ package cbornode
 import (
 	"math/big"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
 
-	encoding "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-cbor/encoding"
+	encoding "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipld-cbor/encoding"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
 )
 
 // This atlas describes the CBOR Tag (42) for IPLD links, such that refmt can marshal and unmarshal them
@@ -5,12 +5,12 @@ import (
 	"context"
 	"fmt"
 
-	block "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-block-format"
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
-	recbor "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/cbor"
-	atlas "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
-	cbg "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/whyrusleeping/cbor-gen"
+	block "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-block-format"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+	recbor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/cbor"
+	atlas "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
+	cbg "github.com/ipfs/fs-repo-migrations/_vendor/github.com/whyrusleeping/cbor-gen"
 )
 
 // IpldStore wraps a Blockstore and provides an interface for storing and retrieving CBOR encoded data.
@@ -5,7 +5,7 @@ import (
 	"errors"
 	"runtime"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
 )
 
 // parallelBatchCommits is the number of batch commits that can be in-flight before blocking.
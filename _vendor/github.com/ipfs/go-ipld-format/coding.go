@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"sync"
 
-	blocks "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-block-format"
+	blocks "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-block-format"
 )
 
 // DecodeBlockFunc functions decode blocks into nodes.
@@ -3,7 +3,7 @@ package format
 import (
 	"context"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
 )
 
 // GetLinks returns the CIDs of the children of the given node. Prefer this
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
 )
 
 var ErrNotFound = fmt.Errorf("merkledag: not found")
@@ -3,7 +3,7 @@ package format
 import (
 	"context"
 
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
 )
 
 // NavigableIPLDNode implements the `NavigableNode` interface wrapping
@@ -1,7 +1,7 @@
 package log
 
 import (
-	log2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/v2"
+	log2 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/v2"
 )
 
 // LogLevel represents a log severity level. Use the package variables as an
@@ -11,12 +11,12 @@ import (
 	"runtime"
 	"time"
 
-	log2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/v2"
-	writer "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/writer"
+	log2 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/v2"
+	writer "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/writer"
 
-	opentrace "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
-	otExt "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/ext"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap"
+	opentrace "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
+	otExt "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/ext"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap"
 )
 
 var log = Logger("eventlog")
@@ -1,13 +1,13 @@
 package log
 
 import (
-	tracer "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/tracer"
-	lwriter "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/writer"
+	tracer "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/tracer"
+	lwriter "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/writer"
 	"os"
 
-	opentrace "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+	opentrace "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 
-	log2 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/v2"
+	log2 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/v2"
 )
 
 func init() {
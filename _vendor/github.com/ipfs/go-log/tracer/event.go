@@ -1,6 +1,6 @@
 package loggabletracer
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+import "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 
 // A SpanEvent is emitted when a mutating command is called on a Span.
 type SpanEvent interface{}
@@ -1,6 +1,6 @@
 package loggabletracer
 
-import opentracing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+import opentracing "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 
 type accessorPropagator struct {
 	tracer *LoggableTracer
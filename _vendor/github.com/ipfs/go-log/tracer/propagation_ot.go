@@ -6,9 +6,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/tracer/wire"
-	opentracing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/tracer/wire"
+	opentracing "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 )
 
 type textMapPropagator struct {
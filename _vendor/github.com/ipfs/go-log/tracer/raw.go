@@ -3,7 +3,7 @@ package loggabletracer
 import (
 	"time"
 
-	opentracing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+	opentracing "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 )
 
 // RawSpan encapsulates all state associated with a (finished) Span.
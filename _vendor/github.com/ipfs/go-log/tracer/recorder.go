@@ -8,8 +8,8 @@ import (
 	"os"
 	"time"
 
-	writer "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/writer"
-	opentrace "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+	writer "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/writer"
+	opentrace "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 )
 
 // A SpanRecorder handles all of the `RawSpan` data generated via an
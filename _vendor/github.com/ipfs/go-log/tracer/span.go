@@ -4,9 +4,9 @@ import (
 	"sync"
 	"time"
 
-	opentracing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/ext"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/log"
+	opentracing "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/ext"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/log"
 )
 
 // Span provides access to the essential details of the span, for use
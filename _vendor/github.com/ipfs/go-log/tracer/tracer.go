@@ -3,8 +3,8 @@ package loggabletracer
 import (
 	"time"
 
-	writer "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log/writer"
-	opentracing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+	writer "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-log/writer"
+	opentracing "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 )
 
 // Tracer extends the opentracing.Tracer interface with methods to
@@ -6,7 +6,7 @@ package wire
 import (
 	encoding_binary "encoding/binary"
 	fmt "fmt"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
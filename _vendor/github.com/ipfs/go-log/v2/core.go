@@ -3,9 +3,9 @@ package log
 import (
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 var _ zapcore.Core = (*lockedMultiCore)(nil)
@@ -1,6 +1,6 @@
 package log
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+import "github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 
 // LogLevel represents a log severity level. Use the package variables as an
 // enum.
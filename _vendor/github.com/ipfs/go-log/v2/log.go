@@ -5,7 +5,7 @@ package log
 import (
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap"
 )
 
 // StandardLogger provides API compatibility with standard printf loggers
@@ -3,8 +3,8 @@ package log
 import (
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 // A PipeReader is a reader that reads from the logger. It is synchronous
@@ -7,8 +7,8 @@ import (
 	"regexp"
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 func init() {
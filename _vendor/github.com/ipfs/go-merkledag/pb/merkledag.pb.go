@@ -6,8 +6,8 @@ package merkledag_pb
 import (
 	bytes "bytes"
 	fmt "fmt"
-	_ "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/gogoproto"
-	proto "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/gogo/protobuf/proto"
+	_ "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/gogoproto"
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
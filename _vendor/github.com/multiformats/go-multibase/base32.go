@@ -1,7 +1,7 @@
 package multibase
 
 import (
-	b32 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-base32"
+	b32 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-base32"
 )
 
 var base32StdLowerPad = b32.NewEncodingCI("abcdefghijklmnopqrstuvwxyz234567")
@@ -5,9 +5,9 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	b58 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/mr-tron/base58/base58"
-	b32 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-base32"
-	b36 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-base36"
+	b58 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+	b32 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-base32"
+	b36 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-base36"
 )
 
 // Encoding identifies the type of base-encoding that a multibase is carrying.
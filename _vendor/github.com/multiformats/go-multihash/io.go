@@ -5,7 +5,7 @@ import (
 	"io"
 	"math"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-varint"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-varint"
 )
 
 // Reader is an io.Reader wrapper that exposes a function
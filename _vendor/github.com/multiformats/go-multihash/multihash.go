@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"math"
 
-	b58 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/mr-tron/base58/base58"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-varint"
+	b58 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-varint"
 )
 
 // errors
@@ -7,11 +7,11 @@ import (
 	"errors"
 	"fmt"
 
-	blake2b "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/minio/blake2b-simd"
-	sha256 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/minio/sha256-simd"
-	murmur3 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/spaolacci/murmur3"
-	blake2s "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/crypto/blake2s"
-	sha3 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/crypto/sha3"
+	blake2b "github.com/ipfs/fs-repo-migrations/_vendor/github.com/minio/blake2b-simd"
+	sha256 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/minio/sha256-simd"
+	murmur3 "github.com/ipfs/fs-repo-migrations/_vendor/github.com/spaolacci/murmur3"
+	blake2s "github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/crypto/blake2s"
+	sha3 "github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/crypto/sha3"
 )
 
 // ErrSumNotSupported is returned when the Sum function code is not implemented
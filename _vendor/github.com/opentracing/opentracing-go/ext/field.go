@@ -1,8 +1,8 @@
 package ext
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/log"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/log"
 )
 
 // LogError sets the error=true tag on the Span and logs err as an "error" event.
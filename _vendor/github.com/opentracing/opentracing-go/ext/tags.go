@@ -1,6 +1,6 @@
 package ext
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go"
+import "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go"
 
 // These constants define common tag names recommended for better portability across
 // tracing systems and languages/platforms.
@@ -1,6 +1,6 @@
 package opentracing
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/log"
+import "github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/log"
 
 // A NoopTracer is a trivial, minimum overhead implementation of Tracer
 // for which all operations are no-ops.
@@ -3,7 +3,7 @@ package opentracing
 import (
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/opentracing/opentracing-go/log"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/opentracing/opentracing-go/log"
 )
 
 // SpanContext represents Span state that must propagate to descendant Spans and across process
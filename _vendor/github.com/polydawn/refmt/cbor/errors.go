@@ -3,7 +3,7 @@ package cbor
 import (
 	"fmt"
 
-	. "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/tok"
+	. "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/tok"
 )
 
 // Error raised by Encoder when invalid tokens or invalid ordering, e.g. a MapClose with no matching open.
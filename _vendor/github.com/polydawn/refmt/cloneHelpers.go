@@ -1,9 +1,9 @@
 package refmt
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/shared"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/shared"
 )
 
 func Clone(src, dst interface{}) error {
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/shared"
-	. "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/tok"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/shared"
+	. "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/tok"
 )
 
 type Decoder struct {
@@ -13,7 +13,7 @@ import (
 	"unicode/utf16"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/tok"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/tok"
 )
 
 func (d *Decoder) decodeString() (string, error) {
@@ -5,7 +5,7 @@ import (
 	"io"
 	"strconv"
 
-	. "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/tok"
+	. "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/tok"
 )
 
 func NewEncoder(wr io.Writer, cfg EncodeOptions) *Encoder {
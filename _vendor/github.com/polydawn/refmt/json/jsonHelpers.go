@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/shared"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/shared"
 )
 
 // All of the methods in this file are exported,
@@ -3,9 +3,9 @@ package refmt
 import (
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/cbor"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/json"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/obj/atlas"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/cbor"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/json"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
 )
 
 type EncodeOptions interface {
@@ -3,7 +3,7 @@ package obj
 import (
 	"reflect"
 
-	. "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/polydawn/refmt/tok"
+	. "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/tok"
 )
 
 type unmarshalMachineArrayWildcard struct {
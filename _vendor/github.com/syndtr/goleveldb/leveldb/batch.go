@@ -11,9 +11,9 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/memdb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
 )
 
 // ErrBatchCorrupted records reason of batch corruption. This error will be
@@ -7,7 +7,7 @@
 package leveldb
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/comparer"
 )
 
 type iComparer struct {
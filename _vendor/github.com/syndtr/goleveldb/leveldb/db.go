@@ -17,14 +17,14 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/iterator"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/journal"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/memdb"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/opt"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/table"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/journal"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/table"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // DB is a LevelDB database.
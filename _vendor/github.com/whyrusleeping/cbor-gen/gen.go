@@ -39,8 +39,8 @@ package {{ .Package }}
 import (
 	"fmt"
 	"io"
-	cbg "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/whyrusleeping/cbor-gen"
-	xerrors "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/xerrors"
+	cbg "github.com/ipfs/fs-repo-migrations/_vendor/github.com/whyrusleeping/cbor-gen"
+	xerrors "github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/xerrors"
 )
 
 
@@ -70,7 +70,7 @@ func typeName(pkg string, t reflect.Type) string {
 		return "map[" + typeName(pkg, t.Key()) + "]" + typeName(pkg, t.Elem())
 	default:
 		name := t.String()
-		if t.PkgPath() == "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/whyrusleeping/cbor-gen" {
+		if t.PkgPath() == "github.com/ipfs/fs-repo-migrations/_vendor/github.com/whyrusleeping/cbor-gen" {
 			name = "cbg." + strings.TrimPrefix(name, "typegen.")
 		} else {
 			name = strings.TrimPrefix(name, pkg+".")
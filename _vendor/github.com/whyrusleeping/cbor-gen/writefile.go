@@ -6,7 +6,7 @@ import (
 	"os"
 	"os/exec"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/xerrors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/xerrors"
 )
 
 func WriteTupleEncodersToFile(fname, pkg string, types ...interface{}) error {
@@ -92,7 +92,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/atomic"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/atomic"
 )
 
 var (
@@ -23,7 +23,7 @@ package zap
 import (
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 // Array constructs a field with the given key and ArrayMarshaler. It provides
@@ -25,7 +25,7 @@ import (
 	"sort"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 // SamplingConfig sets a sampling strategy for the logger. Sampling caps the
@@ -23,7 +23,7 @@ package zap
 import (
 	"flag"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 )
 
 // LevelFlag uses the standard library's flag.Var to declare a global flag
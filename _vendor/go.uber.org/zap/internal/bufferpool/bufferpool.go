@@ -22,7 +22,7 @@
 // packages can recreate the same functionality with buffers.NewPool.
 package bufferpool
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/buffer"
+import "github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/buffer"
 
 var (
 	_pool = buffer.NewPool()
@@ -24,7 +24,7 @@ import (
 	"runtime"
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/internal/bufferpool"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/internal/bufferpool"
 )
 
 var (
@@ -23,9 +23,9 @@ package zap
 import (
 	"fmt"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/zapcore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/zapcore"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
 )
 
 const (
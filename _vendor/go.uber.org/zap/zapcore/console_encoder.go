@@ -24,8 +24,8 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/buffer"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/internal/bufferpool"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/buffer"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/internal/bufferpool"
 )
 
 var _sliceEncoderPool = sync.Pool{
@@ -24,7 +24,7 @@ import (
 	"encoding/json"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/buffer"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/buffer"
 )
 
 // DefaultLineEnding defines the default line ending when writing logs.
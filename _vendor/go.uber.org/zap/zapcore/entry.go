@@ -27,10 +27,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/internal/bufferpool"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/internal/exit"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/internal/bufferpool"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/internal/exit"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
 )
 
 var (
@@ -20,7 +20,7 @@
 
 package zapcore
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/multierr"
+import "github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/multierr"
 
 type hooked struct {
 	Core
@@ -28,8 +28,8 @@ import (
 	"time"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/buffer"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/zap/internal/bufferpool"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/buffer"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/zap/internal/bufferpool"
 )
 
 // For JSON-escaping; see jsonEncoder.safeAddString below.
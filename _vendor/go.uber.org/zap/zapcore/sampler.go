@@ -23,7 +23,7 @@ package zapcore
 import (
 	"time"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/atomic"
+	"github.com/ipfs/fs-repo-migrations/_vendor/go.uber.org/atomic"
 )
 
 const (
@@ -6,7 +6,7 @@
 
 package blake2s
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/cpu"
+import "github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/cpu"
 
 var (
 	useSSE4  = false
@@ -13,7 +13,7 @@ package sha3
 import (
 	"hash"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/cpu"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/cpu"
 )
 
 // codes represent 7-bit KIMD/KLMD function codes as defined in
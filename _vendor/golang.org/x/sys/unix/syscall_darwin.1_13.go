@@ -9,7 +9,7 @@ package unix
 import (
 	"unsafe"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/internal/unsafeheader"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/internal/unsafeheader"
 )
 
 //sys	closedir(dir uintptr) (err error)
@@ -13,7 +13,7 @@ import (
 	"syscall"
 	"unsafe"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/sys/internal/unsafeheader"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/sys/internal/unsafeheader"
 )
 
 var (
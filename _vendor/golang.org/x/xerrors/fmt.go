@@ -10,7 +10,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/golang.org/x/xerrors/internal"
+	"github.com/ipfs/fs-repo-migrations/_vendor/golang.org/x/xerrors/internal"
 )
 
 const percentBangString = "%!"
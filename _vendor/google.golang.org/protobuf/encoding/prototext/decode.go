@@ -9,17 +9,17 @@ import (
 	"strings"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/encoding/messageset"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/encoding/text"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/flags"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/genid"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/pragma"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/set"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/strs"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto"
-	pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoregistry"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/encoding/messageset"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/encoding/text"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/flags"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/genid"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/pragma"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/set"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/strs"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto"
+	pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoregistry"
 )
 
 // Unmarshal reads the given []byte into the given proto.Message.
@@ -6,7 +6,7 @@
 // See https://developers.google.com/protocol-buffers/docs/encoding.
 //
 // For marshaling and unmarshaling entire protobuf messages,
-// use the "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/proto" package instead.
+// use the "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/proto" package instead.
 package protowire
 
 import (
@@ -14,7 +14,7 @@ import (
 	"math"
 	"math/bits"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/errors"
 )
 
 // Number represents the field number.
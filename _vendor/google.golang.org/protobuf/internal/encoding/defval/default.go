@@ -14,9 +14,9 @@ import (
 	"math"
 	"strconv"
 
-	ptext "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/encoding/text"
-	errors "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/errors"
-	pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	ptext "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/encoding/text"
+	errors "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/errors"
+	pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Format is the serialization format used to represent the default value.
@@ -11,10 +11,10 @@ import (
 	"strconv"
 	"strings"
 
-	defval "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/encoding/defval"
-	fdesc "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/filedesc"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/strs"
-	pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	defval "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/encoding/defval"
+	fdesc "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/filedesc"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/strs"
+	pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var byteType = reflect.TypeOf(byte(0))
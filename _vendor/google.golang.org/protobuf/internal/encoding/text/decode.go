@@ -12,7 +12,7 @@ import (
 	"strconv"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/errors"
 )
 
 // Decoder is a token-based textproto decoder.
@@ -12,7 +12,7 @@ import (
 	"unicode/utf16"
 	"unicode/utf8"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/strs"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/strs"
 )
 
 // parseStringValue parses string field token.
@@ -11,7 +11,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/flags"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/flags"
 )
 
 // Kind represents a token kind expressible in the textproto format.
@@ -9,7 +9,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/detrand"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/detrand"
 )
 
 // Error is a sentinel matching all errors produced by this package.
@@ -10,14 +10,14 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/descfmt"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/descopts"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/encoding/defval"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/genid"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/pragma"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/strs"
-	pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoregistry"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/descfmt"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/descopts"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/encoding/defval"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/genid"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/pragma"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/strs"
+	pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoregistry"
 )
 
 // The types in this file may have a suffix:
@@ -7,7 +7,7 @@
 package genid
 
 import (
-	protoreflect "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	protoreflect "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const File_google_protobuf_descriptor_proto = "google/protobuf/descriptor.proto"
@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"reflect"
 
-	pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func newListConverter(t reflect.Type, fd pref.FieldDescriptor) Converter {
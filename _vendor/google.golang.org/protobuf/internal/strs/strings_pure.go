@@ -6,7 +6,7 @@
 
 package strs
 
-import pref "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+import pref "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 
 func UnsafeString(b []byte) string {
 	return string(b)
@@ -75,10 +75,10 @@
 // • Package "google.golang.org/protobuf/encoding/protojson" converts messages to
 // and from JSON.
 //
-// • Package "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/encoding/prototext" converts messages to
+// • Package "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/encoding/prototext" converts messages to
 // and from the text format.
 //
-// • Package "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect" provides a
+// • Package "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect" provides a
 // reflection interface for protocol buffer data types.
 //
 // • Package "google.golang.org/protobuf/testing/protocmp" provides features
@@ -5,8 +5,8 @@
 package proto
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/errors"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/reflect/protoreflect"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/errors"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Message is the top-level interface that all messages must implement.
@@ -15,12 +15,12 @@ import (
 // protobuf module that accept a Message, except where otherwise specified.
 //
 // This is the v2 interface definition for protobuf messages.
-// The v1 interface definition is "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto".Message.
+// The v1 interface definition is "github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto".Message.
 //
 // To convert a v1 message to a v2 message,
-// use "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto".MessageV2.
+// use "github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto".MessageV2.
 // To convert a v2 message to a v1 message,
-// use "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/golang/protobuf/proto".MessageV1.
+// use "github.com/ipfs/fs-repo-migrations/_vendor/github.com/golang/protobuf/proto".MessageV1.
 type Message = protoreflect.ProtoMessage
 
 // Error matches all errors produced by packages in the protobuf module.
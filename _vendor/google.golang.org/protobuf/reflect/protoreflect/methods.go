@@ -5,7 +5,7 @@
 package protoreflect
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/pragma"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/pragma"
 )
 
 // The following types are used by the fast-path Message.ProtoMethods method.
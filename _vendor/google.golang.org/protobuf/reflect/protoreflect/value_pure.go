@@ -6,7 +6,7 @@
 
 package protoreflect
 
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/pragma"
+import "github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/pragma"
 
 type valueType int
 
@@ -12,9 +12,9 @@
 package protoimpl
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/filedesc"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/filetype"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/impl"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/filedesc"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/filetype"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/impl"
 )
 
 // UnsafeEnabled specifies whether package unsafe can be used.
@@ -5,7 +5,7 @@
 package protoimpl
 
 import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/google.golang.org/protobuf/internal/version"
+	"github.com/ipfs/fs-repo-migrations/_vendor/google.golang.org/protobuf/internal/version"
 )
 
 const (
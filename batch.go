@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// runBatch migrates several repos at once, "batch [flags] <repo>...". The
+// repos share the worker, bandwidth and memory limits, and their log lines
+// are prefixed with their path, or carry it in JSON mode.
+func runBatch(args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ExitOnError)
+	target := fs.Int("to", CurrentVersion, "version to migrate the repos to")
+	parallel := fs.Int("parallel", 2, "number of repos migrated at once")
+	workers := fs.Int("workers", 0, "total workers across the repos migrated at once (default: one per repo)")
+	maxKeys := fs.Int("max-keys-per-sec", 0, "process at most this many keys per second across all repos (0: no limit)")
+	maxMB := fs.Int("max-mb-per-sec", 0, "read and write at most this many MB of blocks per second across all repos (0: no limit)")
+	maxMemory := fs.Int("max-memory", 0, "size each repo's batches and queues for its share of, and hold work back above, this many MB of memory across all repos (0: no limit)")
+	revertOk := fs.Bool("revert-ok", false, "allow running migrations backward")
+	yes := fs.Bool("y", false, "answer yes to all prompts")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for running ipfs daemons to exit instead of failing their repos")
+	logJSON := fs.Bool("log-json", false, "write log lines, including each repo's outcome, as JSON objects")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: fs-repo-migrations batch [flags] <repo>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	repos := fs.Args()
+	if len(repos) == 0 {
+		fs.Usage()
+		return fmt.Errorf("batch: no repos given")
+	}
+	if *target > CurrentVersion {
+		return fmt.Errorf("no known migration to version %d", *target)
+	}
+	if *parallel < 1 {
+		return fmt.Errorf("batch: -parallel must be at least 1")
+	}
+	if *workers == 0 {
+		*workers = *parallel
+	}
+	if *workers < *parallel {
+		// a repo needs a worker, so fewer repos run at once
+		*parallel = *workers
+	}
+	if *parallel < 1 {
+		return fmt.Errorf("batch: -workers must be at least 1")
+	}
+	perRepo := *workers / *parallel
+
+	var err error
+	throttle, err = gomigrate.NewThrottle(*maxKeys, int64(*maxMB)<<20)
+	if err != nil {
+		return err
+	}
+	budget, err := gomigrate.MemoryBudget(*maxMemory)
+	if err != nil {
+		return fmt.Errorf("batch: %w", err)
+	}
+	memory = gomigrate.NewMemoryLimit(budget)
+	// each repo is sized for its share, but the limit is held together
+	perRepoBudget := budget / uint64(*parallel)
+	if perRepoBudget != 0 && perRepoBudget < gomigrate.MinMemory {
+		perRepoBudget = gomigrate.MinMemory
+	}
+	log.SetJSON(*logJSON)
+
+	prompt := fmt.Sprintf("Do you want to migrate %d repos to version %d, %d at a time? [y/n]", len(repos), *target, *parallel)
+	if !(*yes || YesNoPrompt(prompt)) {
+		return fmt.Errorf("batch: aborted")
+	}
+
+	run := func(path string, step gomigrate.Step) error {
+		opts := migrationOptions(path, step)
+		opts.Workers = perRepo
+		opts.Log = log.Default.ForRepo(path)
+		opts.FitMemory(perRepoBudget)
+		opts.Log.Info("running migration %s", step)
+		if err := gomigrate.Execute(step.Migration, opts); err != nil {
+			return fmt.Errorf("migration %s failed: %w", step, err)
+		}
+		return nil
+	}
+
+	shutdown.Notify()
+	errs := make([]error, len(repos))
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < *parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				errs[i] = migrateBatchRepo(repos[i], *target, *revertOk, *waitDaemon, run)
+			}
+		}()
+	}
+	for i := range repos {
+		select {
+		case <-shutdown.Stopping():
+			// interrupted: the repos not started are left alone
+			errs[i] = gomigrate.ErrInterrupted
+			continue
+		default:
+		}
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("batch: %d of %d repos failed", failed, len(repos))
+	}
+	return nil
+}
+
+// migrateBatchRepo migrates the repo at path to target with run, logging
+// the outcome as a record with the repo's status and version.
+func migrateBatchRepo(path string, target int, revertOk, waitDaemon bool, run func(string, gomigrate.Step) error) error {
+	l := log.Default.ForRepo(path)
+	from, err := GetVersion(path)
+	if err == nil {
+		err = gomigrate.CheckVersionSkew(from, CurrentVersion)
+	}
+	if err == nil && from > target && !revertOk {
+		err = errors.New("attempt to run backward migration, pass -revert-ok to allow")
+	}
+	if err == nil && from == target {
+		l.Info("already at version %d", target, log.Fields{"status": "current", "version": target})
+		return nil
+	}
+	if err == nil {
+		err = gomigrate.CheckDaemon(path, waitDaemon)
+	}
+	var rec *gomigrate.Recovery
+	if err == nil {
+		rec, err = gomigrate.Recover(path, migrations)
+	}
+	if err == nil && rec != nil {
+		l.Info("%s", rec)
+		if rec.Action == gomigrate.Revert {
+			// restoring the snapshot is left to a run on the repo alone
+			err = errors.New("an interrupted migration needs its snapshot restored; run fs-repo-migrations on this repo alone")
+		}
+	}
+	if err == nil {
+		l.Info("migrating from version %d to %d", from, target)
+		err = migrateWith(path, from, target, run)
+	}
+	if err != nil {
+		l.Error("%s", err, log.Fields{"status": "failed", "version": from})
+		return err
+	}
+	l.Info("migrated to version %d", target, log.Fields{"status": "migrated", "version": target})
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/bench"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	blocks := fs.Int("blocks", bench.DefaultBlocks, "number of blocks of each size written, read and deleted")
+	workers := fs.Int("workers", gomigrate.DefaultWorkers, "number of blocks handled at once, as migrations' -workers")
+	asJSON := fs.Bool("json", false, "print the result as JSON")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to write to the repo")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	res, err := bench.Run(ipfsdir, bench.Options{Blocks: *blocks, Workers: *workers})
+	if err != nil {
+		return err
+	}
+	if err := res.Save(ipfsdir); err != nil {
+		log.Warn("could not save the result for estimate: %s", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	}
+	fmt.Printf("datastore: %s at %s, %d workers\n", res.Backend, res.Mountpoint, res.Workers)
+	for _, op := range res.Ops {
+		fmt.Printf("  %s\n", op)
+	}
+	fmt.Printf("cost: %s a key, %s a MB\n", res.Cost.PerKey.Round(time.Microsecond), res.Cost.PerMB.Round(time.Microsecond))
+	return nil
+}
@@ -0,0 +1,375 @@
+// Package bench measures how fast the datastore of a repo writes, reads
+// and deletes blocks, which is most of what block-moving migrations do. It
+// tells whether the hardware, e.g. network storage, is why a migration is
+// slow, and its fitted Cost turns a migration estimate into a duration.
+//
+// Blocks of each size are written under keys of their own in the blocks
+// mount, read back and deleted, so the repo is left as it was. Blocks read
+// right after they are written may come from the page cache, so a sample of
+// the repo's own blocks is read first as well.
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// ResultFile holds the result of the last run, relative to the repo.
+const ResultFile = "bench.json"
+
+// DefaultSizes are the block sizes measured by default: a small dag node
+// and a full chunk of the default chunker.
+var DefaultSizes = []int{4 << 10, 256 << 10}
+
+// DefaultBlocks is the default number of blocks of each size.
+const DefaultBlocks = 200
+
+// Operations measured.
+const (
+	OpWrite  = "write"
+	OpRead   = "read"
+	OpDelete = "delete"
+	// OpReadRepo reads blocks already in the repo, not recently touched.
+	OpReadRepo = "read-repo"
+)
+
+// Options controls a run.
+type Options struct {
+	// Blocks is the number of blocks of each size, and of the repo's own
+	// blocks read. It defaults to DefaultBlocks.
+	Blocks int
+	// Sizes are the block sizes measured, in bytes. They default to
+	// DefaultSizes.
+	Sizes []int
+	// Workers is the number of operations run at once, as migrations run
+	// -workers keys at once. It defaults to 1.
+	Workers int
+}
+
+// Op is the time taken by one operation on a number of blocks.
+type Op struct {
+	Op      string        `json:"op"`
+	Size    int           `json:"size"` // mean size, for OpReadRepo
+	Blocks  int           `json:"blocks"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// PerSec returns the blocks done per second.
+func (o Op) PerSec() float64 {
+	return float64(o.Blocks) / o.Elapsed.Seconds()
+}
+
+// MBPerSec returns the MB of blocks done per second.
+func (o Op) MBPerSec() float64 {
+	return o.PerSec() * float64(o.Size) / (1 << 20)
+}
+
+func (o Op) String() string {
+	return fmt.Sprintf("%-9s %7d B: %d blocks in %s, %.0f blocks/s, %.1f MB/s",
+		o.Op, o.Size, o.Blocks, o.Elapsed.Round(time.Millisecond), o.PerSec(), o.MBPerSec())
+}
+
+// Cost is the time moving a key takes, split into a fixed part and a part
+// growing with its size. It is fitted to the time to write, read and delete
+// a block of each size measured.
+type Cost struct {
+	PerKey time.Duration `json:"per_key"`
+	PerMB  time.Duration `json:"per_mb"`
+}
+
+// Duration returns how long moving the keys of est would take.
+func (c Cost) Duration(est migrate.Estimate) time.Duration {
+	return time.Duration(est.Keys)*c.PerKey + time.Duration(float64(c.PerMB)*float64(est.Bytes)/(1<<20))
+}
+
+// Result is the outcome of a run.
+type Result struct {
+	Time       time.Time `json:"time"`
+	Mountpoint string    `json:"mountpoint"`
+	Backend    string    `json:"backend"`
+	Workers    int       `json:"workers"`
+	Ops        []Op      `json:"ops"`
+	Cost       Cost      `json:"cost"`
+}
+
+// Run measures the datastore of the repo at path. The repo must not be in
+// use.
+func Run(path string, opts Options) (Result, error) {
+	if opts.Blocks <= 0 {
+		opts.Blocks = DefaultBlocks
+	}
+	if len(opts.Sizes) == 0 {
+		opts.Sizes = DefaultSizes
+	}
+	if opts.Workers < 1 {
+		opts.Workers = 1
+	}
+	res := Result{Time: time.Now().UTC(), Workers: opts.Workers}
+
+	mounts, err := mfsr.RepoPath(path).Mounts()
+	if err != nil {
+		return res, err
+	}
+	m, ok := blocksMount(mounts)
+	if !ok {
+		return res, fmt.Errorf("no datastore mount holds %s", dsblocks.Prefix)
+	}
+	res.Mountpoint, res.Backend = m.Mountpoint, m.Type
+
+	d, err := convert.Open(path)
+	if err != nil {
+		return res, err
+	}
+	defer d.Close()
+
+	// first, before the blocks written fill the page cache
+	op, err := readRepo(d, opts)
+	if err != nil {
+		return res, err
+	}
+	if op.Blocks > 0 {
+		res.Ops = append(res.Ops, op)
+	}
+
+	run := rand.New(rand.NewSource(time.Now().UnixNano())).Uint32()
+	for _, size := range opts.Sizes {
+		keys := make([]ds.Key, opts.Blocks)
+		for i := range keys {
+			// flatfs only takes upper case letters and digits
+			keys[i] = ds.NewKey(fmt.Sprintf("%s/BENCH%08X%dX%06d", dsblocks.Prefix, run, size, i))
+		}
+		ops, err := measure(d, keys, size, opts.Workers)
+		res.Ops = append(res.Ops, ops...)
+		if err != nil {
+			return res, err
+		}
+	}
+	res.Cost = fit(res.Ops)
+	return res, nil
+}
+
+// blocksMount returns the mount blocks are stored in.
+func blocksMount(mounts []mfsr.Mount) (mfsr.Mount, bool) {
+	var root mfsr.Mount
+	found := false
+	for _, m := range mounts {
+		switch m.Mountpoint {
+		case dsblocks.Prefix:
+			return m, true
+		case "/":
+			root, found = m, true
+		}
+	}
+	return root, found
+}
+
+// measure writes, reads and deletes a block of size under each key. Blocks
+// written are deleted even if a step fails.
+func measure(d ds.Batching, keys []ds.Key, size, workers int) ([]Op, error) {
+	for _, k := range keys {
+		// never overwrite a block of the repo
+		if has, err := d.Has(k); err != nil || has {
+			if err == nil {
+				err = fmt.Errorf("%s already exists", k)
+			}
+			return nil, err
+		}
+	}
+	value := make([]byte, size)
+	// random, so that compression does not flatter the datastore
+	rand.Read(value)
+
+	written := false
+	defer func() {
+		if written {
+			for _, k := range keys {
+				d.Delete(k)
+			}
+			d.Sync(ds.NewKey(dsblocks.Prefix))
+		}
+	}()
+
+	var ops []Op
+	written = true
+	op, err := timeOp(OpWrite, size, keys, workers, func(k ds.Key) error {
+		return d.Put(k, value)
+	}, func() error {
+		return d.Sync(ds.NewKey(dsblocks.Prefix))
+	})
+	if err != nil {
+		return ops, err
+	}
+	ops = append(ops, op)
+
+	op, err = timeOp(OpRead, size, keys, workers, func(k ds.Key) error {
+		v, err := d.Get(k)
+		if err == nil && len(v) != size {
+			err = fmt.Errorf("%s: read %d bytes, wrote %d", k, len(v), size)
+		}
+		return err
+	}, nil)
+	if err != nil {
+		return ops, err
+	}
+	ops = append(ops, op)
+
+	op, err = timeOp(OpDelete, size, keys, workers, d.Delete, func() error {
+		return d.Sync(ds.NewKey(dsblocks.Prefix))
+	})
+	if err != nil {
+		return ops, err
+	}
+	written = false
+	return append(ops, op), nil
+}
+
+// readRepo reads up to opts.Blocks of the repo's blocks.
+func readRepo(d ds.Datastore, opts Options) (Op, error) {
+	results, err := d.Query(query.Query{Prefix: dsblocks.Prefix, KeysOnly: true, Limit: opts.Blocks})
+	if err != nil {
+		return Op{}, err
+	}
+	var keys []ds.Key
+	for r := range results.Next() {
+		if r.Error != nil {
+			results.Close()
+			return Op{}, r.Error
+		}
+		keys = append(keys, ds.RawKey(r.Key))
+	}
+	results.Close()
+	if len(keys) == 0 {
+		return Op{Op: OpReadRepo}, nil
+	}
+
+	var mu sync.Mutex
+	var bytes int
+	op, err := timeOp(OpReadRepo, 0, keys, opts.Workers, func(k ds.Key) error {
+		v, err := d.Get(k)
+		mu.Lock()
+		bytes += len(v)
+		mu.Unlock()
+		return err
+	}, nil)
+	op.Size = bytes / len(keys)
+	return op, err
+}
+
+// timeOp runs fn on every key with workers at once, then done if not nil,
+// and times it all.
+func timeOp(name string, size int, keys []ds.Key, workers int, fn func(ds.Key) error, done func() error) (Op, error) {
+	op := Op{Op: name, Size: size, Blocks: len(keys)}
+	start := time.Now()
+
+	next := make(chan ds.Key)
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range next {
+				if err := fn(k); err != nil {
+					errs <- err
+					// drain, so that the feeder does not block
+					for range next {
+					}
+					return
+				}
+			}
+		}()
+	}
+	for _, k := range keys {
+		next <- k
+	}
+	close(next)
+	wg.Wait()
+	select {
+	case err := <-errs:
+		return op, err
+	default:
+	}
+	if done != nil {
+		if err := done(); err != nil {
+			return op, err
+		}
+	}
+	op.Elapsed = time.Since(start)
+	return op, nil
+}
+
+// fit fits Cost to the time per block to write, read and delete a block of
+// each size, by least squares.
+func fit(ops []Op) Cost {
+	perBlock := make(map[int]float64)
+	for _, o := range ops {
+		if o.Op == OpReadRepo || o.Blocks == 0 {
+			continue
+		}
+		perBlock[o.Size] += float64(o.Elapsed) / float64(o.Blocks)
+	}
+	if len(perBlock) == 0 {
+		return Cost{}
+	}
+
+	var meanSize, meanTime float64
+	for s, t := range perBlock {
+		meanSize += float64(s)
+		meanTime += t
+	}
+	n := float64(len(perBlock))
+	meanSize, meanTime = meanSize/n, meanTime/n
+	var cov, vari float64
+	for s, t := range perBlock {
+		cov += (float64(s) - meanSize) * (t - meanTime)
+		vari += (float64(s) - meanSize) * (float64(s) - meanSize)
+	}
+	perByte := 0.0
+	if vari > 0 && cov > 0 {
+		perByte = cov / vari
+	}
+	perKey := meanTime - perByte*meanSize
+	if perKey < 0 {
+		// all of it grows with size
+		perKey, perByte = 0, meanTime/meanSize
+	}
+	return Cost{PerKey: time.Duration(perKey), PerMB: time.Duration(perByte * (1 << 20))}
+}
+
+// Save writes r to ResultFile in the repo at path, for the estimate command.
+func (r Result) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	file := filepath.Join(path, ResultFile)
+	if err := ioutil.WriteFile(file+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(file+".tmp", file)
+}
+
+// Load reads the result saved in the repo at path. The error satisfies
+// os.IsNotExist if bench has not been run.
+func Load(path string) (Result, error) {
+	var r Result
+	data, err := ioutil.ReadFile(filepath.Join(path, ResultFile))
+	if err != nil {
+		return r, err
+	}
+	return r, json.Unmarshal(data, &r)
+}
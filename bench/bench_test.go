@@ -0,0 +1,138 @@
+package bench
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+// countKeys returns the number of keys in the datastore of the repo at path.
+func countKeys(t *testing.T, path string) int {
+	t.Helper()
+	d, err := convert.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	results, err := d.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(entries)
+}
+
+func TestRun(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb, migrationtest.Badger} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(b), migrationtest.WithBlocks(10, 2000))
+			before := countKeys(t, r.Path)
+
+			res, err := Run(r.Path, Options{Blocks: 20, Sizes: []int{100, 10000}, Workers: 3})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Backend == "" || res.Workers != 3 {
+				t.Errorf("result %+v", res)
+			}
+
+			want := []Op{
+				{Op: OpReadRepo, Size: 2000, Blocks: 10},
+				{Op: OpWrite, Size: 100, Blocks: 20},
+				{Op: OpRead, Size: 100, Blocks: 20},
+				{Op: OpDelete, Size: 100, Blocks: 20},
+				{Op: OpWrite, Size: 10000, Blocks: 20},
+				{Op: OpRead, Size: 10000, Blocks: 20},
+				{Op: OpDelete, Size: 10000, Blocks: 20},
+			}
+			if len(res.Ops) != len(want) {
+				t.Fatalf("got ops %v, want %v", res.Ops, want)
+			}
+			for i, op := range res.Ops {
+				if op.Op != want[i].Op || op.Size != want[i].Size || op.Blocks != want[i].Blocks || op.Elapsed <= 0 {
+					t.Errorf("op %d: got %v, want %v", i, op, want[i])
+				}
+			}
+
+			if after := countKeys(t, r.Path); after != before {
+				t.Errorf("%d keys after the run, %d before", after, before)
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	// 1ms a block and 100ms a MB, spread over write, read and delete
+	perBlock := func(size int) time.Duration {
+		return time.Millisecond + time.Duration(float64(100*time.Millisecond)*float64(size)/(1<<20))
+	}
+	var ops []Op
+	for _, size := range []int{4 << 10, 256 << 10} {
+		d := perBlock(size) * 100
+		ops = append(ops,
+			Op{Op: OpWrite, Size: size, Blocks: 100, Elapsed: d / 2},
+			Op{Op: OpRead, Size: size, Blocks: 100, Elapsed: d / 4},
+			Op{Op: OpDelete, Size: size, Blocks: 100, Elapsed: d / 4},
+		)
+	}
+	ops = append(ops, Op{Op: OpReadRepo, Size: 1000, Blocks: 100, Elapsed: time.Hour})
+
+	c := fit(ops)
+	near := func(got, want time.Duration) bool {
+		return got > want-want/100 && got < want+want/100
+	}
+	if !near(c.PerKey, time.Millisecond) || !near(c.PerMB, 100*time.Millisecond) {
+		t.Errorf("got %+v, want 1ms a key and 100ms a MB", c)
+	}
+	if got := c.Duration(migrate.Estimate{Keys: 1000, Bytes: 10 << 20}); !near(got, 2*time.Second) {
+		t.Errorf("1000 keys and 10 MB take %s, want 2s", got)
+	}
+
+	// a size costing less than a smaller one leaves only the per-key part
+	c = fit([]Op{
+		{Op: OpWrite, Size: 100, Blocks: 10, Elapsed: 20 * time.Millisecond},
+		{Op: OpWrite, Size: 1000, Blocks: 10, Elapsed: 10 * time.Millisecond},
+	})
+	if c.PerMB != 0 || !near(c.PerKey, 1500*time.Microsecond) {
+		t.Errorf("got %+v, want 1.5ms a key and nothing a MB", c)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bench")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err := Load(dir); err == nil {
+		t.Fatal("loaded a result never saved")
+	}
+	res := Result{
+		Time:    time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Backend: "flatfs",
+		Workers: 2,
+		Ops:     []Op{{Op: OpWrite, Size: 4096, Blocks: 10, Elapsed: time.Second}},
+		Cost:    Cost{PerKey: time.Millisecond, PerMB: time.Second},
+	}
+	if err := res.Save(dir); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Time.Equal(res.Time) || got.Backend != res.Backend || got.Cost != res.Cost || len(got.Ops) != 1 || got.Ops[0] != res.Ops[0] {
+		t.Errorf("got %+v, want %+v", got, res)
+	}
+}
@@ -0,0 +1,175 @@
+// Package blockcheck finds blocks whose contents no longer match their
+// key: it reads every block of a repo, hashes it again with the hash
+// function its key names, and compares. Bad blocks can be moved out of the
+// datastore into a quarantine directory, so that a migration does not
+// carry them over and the node fetches them again from the network.
+package blockcheck
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// QuarantineDir is the directory in the repo bad blocks are moved to, each
+// in a file named after its key.
+const QuarantineDir = "quarantine"
+
+// minVersion is the first repo version with base32 block keys.
+const minVersion = 4
+
+// Options controls a scan.
+type Options struct {
+	// Quarantine moves the bad blocks found to QuarantineDir.
+	Quarantine bool
+	// Progress, if not nil, is called every so often with the number of
+	// blocks and bytes read so far.
+	Progress func(blocks, bytes int64)
+}
+
+// progressEvery is the number of blocks between Progress calls.
+const progressEvery = 1000
+
+// Bad is a block whose contents do not hash to its key.
+type Bad struct {
+	Key string
+	// Want is the multihash in the key, Got the one of the contents.
+	Want, Got []byte
+	// Quarantined is the file the block was moved to, if it was.
+	Quarantined string
+}
+
+func (b Bad) String() string {
+	return fmt.Sprintf("%s: contents hash to %s, want %s", b.Key, base58.Encode(b.Got), base58.Encode(b.Want))
+}
+
+// Report is the outcome of a scan.
+type Report struct {
+	Blocks int64
+	Bytes  int64
+	Bad    []Bad
+	// Unchecked counts the blocks whose key is not a CID or multihash, or
+	// names a hash function that is not supported.
+	Unchecked int64
+}
+
+// Err returns an error listing the bad blocks, if there are any.
+func (r Report) Err() error {
+	if len(r.Bad) == 0 {
+		return nil
+	}
+	const shown = 10
+	var list []string
+	for i, b := range r.Bad {
+		if i == shown {
+			list = append(list, fmt.Sprintf("and %d more", len(r.Bad)-shown))
+			break
+		}
+		list = append(list, b.Key)
+	}
+	return fmt.Errorf("%d blocks do not match their key: %s", len(r.Bad), strings.Join(list, ", "))
+}
+
+// Check hashes data with the function and length of the multihash want,
+// and returns the multihash it gets. It reports false if the hash
+// function is not supported.
+func Check(data, want []byte) ([]byte, bool) {
+	dec, err := mh.Decode(want)
+	if err != nil {
+		return nil, false
+	}
+	got, err := mh.Sum(data, dec.Code, dec.Length)
+	if err != nil {
+		return nil, false
+	}
+	return got, true
+}
+
+// Scan reads every block of the repo at path and reports those whose
+// contents do not match their key. The repo must not be in use.
+func Scan(path string, opts Options) (Report, error) {
+	var r Report
+	v, err := mfsr.RepoPath(path).VersionNum()
+	if err != nil {
+		return r, err
+	}
+	if v < minVersion {
+		return r, fmt.Errorf("repo version %d is older than %d, whose block keys are not supported", v, minVersion)
+	}
+
+	d, err := convert.Open(path)
+	if err != nil {
+		return r, err
+	}
+	defer d.Close()
+
+	results, err := d.Query(query.Query{Prefix: dsblocks.Prefix})
+	if err != nil {
+		return r, err
+	}
+	defer results.Close()
+	for res := range results.Next() {
+		if res.Error != nil {
+			return r, res.Error
+		}
+		r.Blocks++
+		r.Bytes += int64(len(res.Value))
+		if opts.Progress != nil && r.Blocks%progressEvery == 0 {
+			opts.Progress(r.Blocks, r.Bytes)
+		}
+
+		key := ds.RawKey(res.Key)
+		want, ok := dsblocks.KeyHash(key)
+		if !ok {
+			r.Unchecked++
+			continue
+		}
+		got, ok := Check(res.Value, want)
+		if !ok {
+			r.Unchecked++
+			continue
+		}
+		if bytes.Equal(got, want) {
+			continue
+		}
+		b := Bad{Key: res.Key, Want: want, Got: got}
+		if opts.Quarantine {
+			if b.Quarantined, err = quarantine(path, d, key, res.Value); err != nil {
+				return r, fmt.Errorf("quarantining %s: %w", res.Key, err)
+			}
+		}
+		r.Bad = append(r.Bad, b)
+	}
+	if opts.Progress != nil {
+		opts.Progress(r.Blocks, r.Bytes)
+	}
+	if opts.Quarantine && len(r.Bad) > 0 {
+		return r, d.Sync(ds.NewKey(dsblocks.Prefix))
+	}
+	return r, nil
+}
+
+// quarantine writes a block to QuarantineDir, then deletes it from d.
+func quarantine(path string, d ds.Datastore, key ds.Key, data []byte) (string, error) {
+	dir := filepath.Join(path, QuarantineDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	file := filepath.Join(dir, key.BaseNamespace())
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		return "", err
+	}
+	return file, d.Delete(key)
+}
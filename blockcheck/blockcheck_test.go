@@ -0,0 +1,86 @@
+package blockcheck
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestCheck(t *testing.T) {
+	data := []byte("block")
+	for _, code := range []uint64{mh.SHA2_256, mh.SHA2_512, mh.SHA3_256, mh.IDENTITY} {
+		want, err := mh.Sum(data, code, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := Check(data, want)
+		if !ok || string(got) != string(want) {
+			t.Errorf("code %x: got %x, %v", code, got, ok)
+		}
+		if got, _ := Check([]byte("other"), want); string(got) == string(want) {
+			t.Errorf("code %x: other data matches", code)
+		}
+	}
+	if _, ok := Check(data, []byte{0x01, 0x02}); ok {
+		t.Error("checked against an invalid multihash")
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Badger} {
+		t.Run(string(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(b), migrationtest.WithBlocks(10, 256))
+
+			rep, err := Scan(r.Path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rep.Blocks != 10 || rep.Bytes != 2560 || rep.Err() != nil || rep.Unchecked != 0 {
+				t.Fatalf("clean repo: %+v", rep)
+			}
+
+			// corrupt one block
+			d, err := r.OpenBlocks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var bad string
+			for k := range r.Blocks {
+				bad = k
+				break
+			}
+			if err := d.Put(ds.NewKey(bad), []byte("bit rot")); err != nil {
+				t.Fatal(err)
+			}
+			d.Close()
+
+			rep, err = Scan(r.Path, Options{Quarantine: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rep.Bad) != 1 || rep.Err() == nil {
+				t.Fatalf("got %d bad blocks, want 1", len(rep.Bad))
+			}
+			data, err := ioutil.ReadFile(rep.Bad[0].Quarantined)
+			if err != nil || string(data) != "bit rot" {
+				t.Fatalf("quarantined %q, %v", data, err)
+			}
+			if filepath.Dir(rep.Bad[0].Quarantined) != filepath.Join(r.Path, QuarantineDir) {
+				t.Errorf("quarantined to %s", rep.Bad[0].Quarantined)
+			}
+
+			rep, err = Scan(r.Path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rep.Blocks != 9 || rep.Err() != nil {
+				t.Errorf("after quarantine: %+v", rep)
+			}
+		})
+	}
+}
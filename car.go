@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ipfs/fs-repo-migrations/car"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// runCar exports the blockstore to a CAR file, or imports CAR files into
+// the repo: "car [flags] export <file>" and "car [flags] import <file>...".
+func runCar(args []string) error {
+	fs := flag.NewFlagSet("car", flag.ExitOnError)
+	batchSize := fs.Int("batch-size", gomigrate.DefaultBatchSize, "number of blocks per datastore batch on import")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to run")
+	fs.Usage = func() {
+		fmt.Println("Usage: fs-repo-migrations car [flags] export <file> | import <file>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	verb, files := fs.Arg(0), fs.Args()
+	if len(files) > 0 {
+		files = files[1:]
+	}
+	if verb != "export" && verb != "import" {
+		fs.Usage()
+		return fmt.Errorf("car: unknown command %q", verb)
+	}
+	if len(files) == 0 || verb == "export" && len(files) != 1 {
+		fs.Usage()
+		return fmt.Errorf("car %s: missing or extra file names", verb)
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		progress := log.NewProgress(log.DefaultProgressInterval)
+		opts := car.Options{
+			BatchSize: *batchSize,
+			Progress: func(blocks, bytes int64) {
+				progress.Update("%sed %d blocks, %d bytes", verb, blocks, bytes)
+			},
+		}
+		var st car.Stats
+		if verb == "export" {
+			st, err = car.Export(ipfsdir, file, opts)
+		} else {
+			st, err = car.Import(ipfsdir, file, opts)
+		}
+		progress.Done()
+		for _, c := range st.Bad {
+			fmt.Printf("left out %s: its contents do not match its CID\n", c)
+		}
+		if st.Skipped > 0 {
+			fmt.Printf("left out %d keys that are not CIDs\n", st.Skipped)
+		}
+		if err != nil {
+			return fmt.Errorf("car %s %s: %w", verb, file, err)
+		}
+		fmt.Printf("%sed %d blocks (%d bytes): %s\n", verb, st.Blocks, st.Bytes, file)
+	}
+	return nil
+}
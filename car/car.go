@@ -0,0 +1,236 @@
+// Package car exports a repo's blockstore to a CAR file and imports CAR
+// files into a repo, so that a repo too damaged to migrate in place can be
+// recreated at the latest version and have its blocks put back.
+//
+// Exports are CARv2 files without an index, whose CARv1 payload holds every
+// block. As CARs must have a root and a blockstore has none, the root is
+// the empty identity CID. Imports read CARv1 and CARv2 files.
+package car
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipld-cbor"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+	varint "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-varint"
+)
+
+// pragma starts every CARv2 file: a CARv1 header saying version 2.
+var pragma = []byte{0x0a, 0xa1, 0x67, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x02}
+
+// v2HeaderSize is the size of the CARv2 header following the pragma: the
+// characteristics bitfield, then the data offset, data size and index
+// offset as little-endian uint64s.
+const v2HeaderSize = 40
+
+// maxSection bounds the size of a section, so that a corrupt length does
+// not make the reader allocate the whole disk.
+const maxSection = 32 << 20
+
+// ErrNotCAR is returned when a file does not start with a CAR header.
+var ErrNotCAR = errors.New("not a CAR file")
+
+type header struct {
+	Roots   []cid.Cid
+	Version uint64
+}
+
+func init() {
+	cbor.RegisterCborType(header{})
+}
+
+// EmptyRoot is the root of exported CARs: the CID of the empty block,
+// inlined with the identity hash.
+func EmptyRoot() cid.Cid {
+	hash, _ := mh.Sum(nil, mh.IDENTITY, -1)
+	return cid.NewCidV1(cid.Raw, hash)
+}
+
+// Writer writes a CARv2 file. Blocks are streamed into the payload; the
+// header, which holds the size of the payload, is written on Close.
+type Writer struct {
+	f    *os.File
+	w    *bufio.Writer
+	size uint64
+	buf  [binary.MaxVarintLen64]byte
+}
+
+// Create creates the CAR file name with the given roots.
+func Create(name string, roots []cid.Cid) (*Writer, error) {
+	hdr, err := cbor.DumpObject(header{Roots: roots, Version: 1})
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{f: f}
+	if _, err := f.Seek(int64(len(pragma)+v2HeaderSize), io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	w.w = bufio.NewWriterSize(f, 1<<20)
+	if err := w.section(hdr); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// section writes a length-prefixed section made of parts.
+func (w *Writer) section(parts ...[]byte) error {
+	var n int
+	for _, p := range parts {
+		n += len(p)
+	}
+	l := binary.PutUvarint(w.buf[:], uint64(n))
+	if _, err := w.w.Write(w.buf[:l]); err != nil {
+		return err
+	}
+	for _, p := range parts {
+		if _, err := w.w.Write(p); err != nil {
+			return err
+		}
+	}
+	w.size += uint64(l + n)
+	return nil
+}
+
+// Put writes a block.
+func (w *Writer) Put(c cid.Cid, data []byte) error {
+	return w.section(c.Bytes(), data)
+}
+
+// Close writes the header, syncs the file and closes it.
+func (w *Writer) Close() error {
+	err := w.w.Flush()
+	if err == nil {
+		hdr := make([]byte, len(pragma)+v2HeaderSize)
+		copy(hdr, pragma)
+		binary.LittleEndian.PutUint64(hdr[len(pragma)+16:], uint64(len(hdr)))
+		binary.LittleEndian.PutUint64(hdr[len(pragma)+24:], w.size)
+		// no index: the index offset stays 0
+		_, err = w.f.WriteAt(hdr, 0)
+	}
+	if err == nil {
+		err = w.f.Sync()
+	}
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Reader reads the blocks of a CARv1 or CARv2 file.
+type Reader struct {
+	// Version is the CAR version of the file, 1 or 2.
+	Version int
+	// Roots are the roots listed in the header.
+	Roots []cid.Cid
+
+	f *os.File
+	r *bufio.Reader
+}
+
+// Open opens the CAR file name and reads its header.
+func Open(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := newReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return r, nil
+}
+
+func newReader(f *os.File) (*Reader, error) {
+	r := &Reader{f: f, r: bufio.NewReaderSize(f, 1<<20)}
+	hdr, err := r.header()
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Version == 2 {
+		var v2 [v2HeaderSize]byte
+		if _, err := io.ReadFull(r.r, v2[:]); err != nil {
+			return nil, fmt.Errorf("%w: short CARv2 header", ErrNotCAR)
+		}
+		offset := binary.LittleEndian.Uint64(v2[16:])
+		size := binary.LittleEndian.Uint64(v2[24:])
+		if _, err := f.Seek(int64(offset), io.SeekStart); err != nil {
+			return nil, err
+		}
+		r.r = bufio.NewReaderSize(io.LimitReader(f, int64(size)), 1<<20)
+		if hdr, err = r.header(); err != nil {
+			return nil, err
+		}
+		r.Version = 2
+	} else {
+		r.Version = 1
+	}
+	if hdr.Version != 1 {
+		return nil, fmt.Errorf("unsupported CAR version %d", hdr.Version)
+	}
+	r.Roots = hdr.Roots
+	return r, nil
+}
+
+func (r *Reader) header() (header, error) {
+	var hdr header
+	data, err := r.section()
+	if err != nil {
+		return hdr, fmt.Errorf("%w: %v", ErrNotCAR, err)
+	}
+	// the pragma lists no roots, so decode it as a plain version map
+	if bytes.Equal(data, pragma[1:]) {
+		hdr.Version = 2
+		return hdr, nil
+	}
+	if err := cbor.DecodeInto(data, &hdr); err != nil {
+		return hdr, fmt.Errorf("%w: %v", ErrNotCAR, err)
+	}
+	return hdr, nil
+}
+
+func (r *Reader) section() ([]byte, error) {
+	n, err := varint.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSection {
+		return nil, fmt.Errorf("section of %d bytes is too large", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return data, nil
+}
+
+// Next returns the next block. It returns io.EOF after the last one.
+func (r *Reader) Next() (cid.Cid, []byte, error) {
+	data, err := r.section()
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	n, c, err := cid.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("bad CID in CAR section: %w", err)
+	}
+	return c, data[n:], nil
+}
+
+// Close closes the file.
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
@@ -0,0 +1,137 @@
+package car
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestRoundTrip(t *testing.T) {
+	src := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(30, 500))
+	dst := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Leveldb), migrationtest.WithBlocks(0, 0))
+
+	var bad string
+	for k := range src.Blocks {
+		bad = k
+		break
+	}
+	d, err := src.OpenBlocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Put(ds.NewKey(bad), []byte("rotten")); err != nil {
+		t.Fatal(err)
+	}
+	d.Close()
+
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "blocks.car")
+	st, err := Export(src.Path, name, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Blocks != 29 || len(st.Bad) != 1 {
+		t.Fatalf("exported %d blocks, bad %v", st.Blocks, st.Bad)
+	}
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Version != 2 || len(r.Roots) != 1 || !r.Roots[0].Equals(EmptyRoot()) {
+		t.Errorf("version %d, roots %v", r.Version, r.Roots)
+	}
+	r.Close()
+
+	st, err = Import(dst.Path, name, Options{BatchSize: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Blocks != 29 || len(st.Bad) != 0 {
+		t.Fatalf("imported %d blocks, bad %v", st.Blocks, st.Bad)
+	}
+	d, err = dst.OpenBlocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	for k, want := range src.Blocks {
+		got, err := d.Get(ds.NewKey(k))
+		if k == bad {
+			if err != ds.ErrNotFound {
+				t.Errorf("bad block imported: %v", err)
+			}
+			continue
+		}
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s: %v", k, err)
+		}
+	}
+}
+
+func TestReadCARv1(t *testing.T) {
+	dir, err := ioutil.TempDir("", "car")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "v2.car")
+	w, err := Create(name, []cid.Cid{EmptyRoot()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Put(EmptyRoot(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the payload of a CARv2 is a CARv1
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1 := data[len(pragma)+v2HeaderSize:]
+	if !bytes.Contains(v1[:64], []byte("roots")) || !bytes.Contains(v1[:64], []byte("version")) {
+		t.Errorf("unexpected header %x", v1[:64])
+	}
+	name = filepath.Join(dir, "v1.car")
+	if err := ioutil.WriteFile(name, v1, 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.Version != 1 {
+		t.Errorf("version %d", r.Version)
+	}
+	c, block, err := r.Next()
+	if err != nil || !c.Equals(EmptyRoot()) || len(block) != 0 {
+		t.Fatalf("got %s %x %v", c, block, err)
+	}
+	if _, _, err := r.Next(); err != io.EOF {
+		t.Errorf("got %v after the last block", err)
+	}
+
+	if err := ioutil.WriteFile(name, []byte("not a car"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(name); err == nil {
+		t.Error("opened a file that is not a CAR")
+	}
+}
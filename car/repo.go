@@ -0,0 +1,186 @@
+package car
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	dshelp "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipfs-ds-help"
+
+	"github.com/ipfs/fs-repo-migrations/blockcheck"
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// minVersion is the first repo version with base32 block keys, which are
+// CIDs or, for CIDv0, multihashes.
+const minVersion = 4
+
+// Options controls an export or import.
+type Options struct {
+	// BatchSize is the number of blocks per datastore batch on import.
+	BatchSize int
+	// Progress, if not nil, is called every so often with the number of
+	// blocks and bytes done so far.
+	Progress func(blocks, bytes int64)
+}
+
+// progressEvery is the number of blocks between Progress calls.
+const progressEvery = 1000
+
+// Stats is the outcome of an export or import.
+type Stats struct {
+	Blocks int64
+	Bytes  int64
+	// Bad lists the blocks left out because their contents do not hash to
+	// their CID.
+	Bad []string
+	// Skipped counts the keys left out of an export because they are not
+	// CIDs.
+	Skipped int64
+}
+
+func checkVersion(path string) error {
+	v, err := mfsr.RepoPath(path).VersionNum()
+	if err != nil {
+		return err
+	}
+	if v < minVersion {
+		return fmt.Errorf("repo version %d is older than %d, whose block keys are not supported", v, minVersion)
+	}
+	return nil
+}
+
+// valid reports whether data hashes to c. Blocks whose hash function is
+// not supported are taken as they are.
+func valid(c cid.Cid, data []byte) bool {
+	got, ok := blockcheck.Check(data, c.Hash())
+	return !ok || bytes.Equal(got, c.Hash())
+}
+
+// Export writes every block of the repo at path to the CAR file name.
+// Blocks that do not match their CID are left out and listed. The repo must
+// not be in use.
+func Export(path, name string, opts Options) (Stats, error) {
+	var st Stats
+	if err := checkVersion(path); err != nil {
+		return st, err
+	}
+	d, err := convert.Open(path)
+	if err != nil {
+		return st, err
+	}
+	defer d.Close()
+
+	w, err := Create(name, []cid.Cid{EmptyRoot()})
+	if err != nil {
+		return st, err
+	}
+	results, err := d.Query(query.Query{Prefix: dsblocks.Prefix})
+	if err != nil {
+		w.Close()
+		return st, err
+	}
+	defer results.Close()
+	for res := range results.Next() {
+		if res.Error != nil {
+			w.Close()
+			return st, res.Error
+		}
+		c, err := dshelp.DsKeyToCid(ds.NewKey(ds.RawKey(res.Key).BaseNamespace()))
+		if err != nil {
+			st.Skipped++
+			continue
+		}
+		if !valid(c, res.Value) {
+			st.Bad = append(st.Bad, c.String())
+			continue
+		}
+		if err := w.Put(c, res.Value); err != nil {
+			w.Close()
+			return st, err
+		}
+		st.add(opts, len(res.Value))
+	}
+	if opts.Progress != nil {
+		opts.Progress(st.Blocks, st.Bytes)
+	}
+	return st, w.Close()
+}
+
+func (st *Stats) add(opts Options, size int) {
+	st.Blocks++
+	st.Bytes += int64(size)
+	if opts.Progress != nil && st.Blocks%progressEvery == 0 {
+		opts.Progress(st.Blocks, st.Bytes)
+	}
+}
+
+// Import puts the blocks of the CAR file name into the datastore of the
+// repo at path, whatever its backend. Blocks that do not match their CID
+// are left out and listed. The repo must not be in use.
+func Import(path, name string, opts Options) (Stats, error) {
+	var st Stats
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = migrate.DefaultBatchSize
+	}
+	if err := checkVersion(path); err != nil {
+		return st, err
+	}
+	r, err := Open(name)
+	if err != nil {
+		return st, err
+	}
+	defer r.Close()
+	d, err := convert.Open(path)
+	if err != nil {
+		return st, err
+	}
+	defer d.Close()
+
+	b, err := d.Batch()
+	if err != nil {
+		return st, err
+	}
+	pending := 0
+	prefix := ds.NewKey(dsblocks.Prefix)
+	for {
+		c, data, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return st, fmt.Errorf("%s: %w", name, err)
+		}
+		if !valid(c, data) {
+			st.Bad = append(st.Bad, c.String())
+			continue
+		}
+		if err := b.Put(prefix.Child(dshelp.CidToDsKey(c)), data); err != nil {
+			return st, err
+		}
+		st.add(opts, len(data))
+		if pending++; pending < opts.BatchSize {
+			continue
+		}
+		if err := b.Commit(); err != nil {
+			return st, err
+		}
+		if b, err = d.Batch(); err != nil {
+			return st, err
+		}
+		pending = 0
+	}
+	if err := b.Commit(); err != nil {
+		return st, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(st.Blocks, st.Bytes)
+	}
+	return st, d.Sync(prefix)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+func runClean(args []string) error {
+	fs := flag.NewFlagSet("clean", flag.ExitOnError)
+	keep := fs.Int("keep", 1, "number of most recent migrations whose backups are kept")
+	verified := fs.Bool("verified", false, "only remove backups of migrations marked verified by the verify command")
+	dryRun := fs.Bool("dry-run", false, "list the backups that would be removed")
+	dir := fs.String("dir", "", "backup directory (default: migration-backups in the repo)")
+	fs.Parse(args)
+
+	if *dir == "" {
+		ipfsdir, err := GetIpfsDir()
+		if err != nil {
+			return err
+		}
+		*dir = filepath.Join(ipfsdir, gomigrate.BackupsDir)
+	}
+
+	removed, err := gomigrate.CleanBackups(*dir, gomigrate.Retention{
+		Keep:         *keep,
+		VerifiedOnly: *verified,
+		DryRun:       *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	verb := "removed"
+	if *dryRun {
+		verb = "would remove"
+	}
+	for _, s := range removed {
+		fmt.Printf("%s backups of %s (%d files, created %s)\n", verb, s.Migration, len(s.Files), s.Created.Format("2006-01-02"))
+	}
+	if len(removed) == 0 {
+		fmt.Println("nothing to clean")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/repocopy"
+)
+
+// runClone copies a repo so that migrations can be rehearsed on the copy.
+// The copy has no lock files, so it can be used while the original is
+// locked.
+func runClone(args []string) error {
+	fs := flag.NewFlagSet("clone", flag.ExitOnError)
+	noLink := fs.Bool("no-link", false, "copy every file instead of hard-linking blocks and leveldb tables")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to clone")
+	fs.Usage = func() {
+		fmt.Println("Usage: fs-repo-migrations clone [flags] <src> <dst>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return fmt.Errorf("clone: need a source and a destination")
+	}
+	src, dst := fs.Arg(0), fs.Arg(1)
+	if _, err := os.Stat(mfsr.RepoPath(src).ConfigFile()); err != nil {
+		return fmt.Errorf("clone: %s is not an ipfs repo: %w", src, err)
+	}
+	// a repo in use may change while it is copied
+	if err := gomigrate.CheckDaemon(src, *waitDaemon); err != nil {
+		return err
+	}
+
+	st, err := repocopy.Copy(src, dst, repocopy.Options{Link: !*noLink})
+	if err != nil {
+		return fmt.Errorf("clone: %w", err)
+	}
+	fmt.Printf("cloned %s to %s: copied %d files (%d bytes), hard-linked %d files\n", src, dst, st.Files, st.Bytes, st.Linked)
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+// command is a subcommand of fs-repo-migrations, invoked as
+// "fs-repo-migrations <name> [flags]". Running the tool without a
+// subcommand migrates the repo.
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]command{
+	"batch": {
+		usage: "migrate several repos at once within shared resource limits",
+		run:   runBatch,
+	},
+	"bench": {
+		usage: "measure how fast the repo's datastore writes, reads and deletes blocks",
+		run:   runBench,
+	},
+	"car": {
+		usage: "export the blockstore to a CAR file, or import CAR files into the repo",
+		run:   runCar,
+	},
+	"clean": {
+		usage: "remove old migration backups",
+		run:   runClean,
+	},
+	"clone": {
+		usage: "copy a repo to rehearse migrations on, hard-linking blocks",
+		run:   runClone,
+	},
+	"compact": {
+		usage: "reclaim space in the repo's badger datastores",
+		run:   runCompact,
+	},
+	"convert": {
+		usage: "move the repo to another datastore layout or its mounts to other disks",
+		run:   runConvert,
+	},
+	"diff": {
+		usage: "compare two repos' versions, configs, keys and sampled blocks",
+		run:   runDiff,
+	},
+	"discover": {
+		usage: "find repos under the given directories",
+		run:   runDiscover,
+	},
+	"downgrade": {
+		usage: "take the repo back to an older version",
+		run:   runDowngrade,
+	},
+	"dry-run": {
+		usage: "report what the next migration would do without doing it",
+		run:   runDryRun,
+	},
+	"estimate": {
+		usage: "estimate the work needed to reach the target version",
+		run:   runEstimate,
+	},
+	"fetch": {
+		usage: "download a release from the distribution index into a local cache",
+		run:   runFetch,
+	},
+	"history": {
+		usage: "show the migrations recorded in the repo",
+		run:   runHistory,
+	},
+	"keystore": {
+		usage: "encrypt or decrypt the keystore's private keys with a passphrase",
+		run:   runKeystore,
+	},
+	"orphans": {
+		usage: "find, and optionally remove, blocks not reachable from pins or MFS",
+		run:   runOrphans,
+	},
+	"purge": {
+		usage: "remove expired provider records and other cached namespaces",
+		run:   runPurge,
+	},
+	"repair": {
+		usage: "rewrite a version file that cannot be parsed",
+		run:   runRepair,
+	},
+	"reshard": {
+		usage: "move flatfs blocks to another shard function in place",
+		run:   runReshard,
+	},
+	"rotate-identity": {
+		usage: "replace the node's RSA identity with a new ed25519 key and peer ID",
+		run:   runRotateIdentity,
+	},
+	"snapshot": {
+		usage: "create, list, restore or remove hard-linked repo snapshots",
+		run:   runSnapshot,
+	},
+	"stats": {
+		usage: "count the repo's blocks by size and key format, and its pins",
+		run:   runStats,
+	},
+	"status": {
+		usage: "show the repo version and whether it needs migrating",
+		run:   runStatus,
+	},
+	"verify": {
+		usage: "check that every block matches its key, quarantining bad ones",
+		run:   runVerify,
+	},
+	"gen-fixture": {
+		usage: "create a deterministic repo fixture at a given version",
+		run:   runGenFixture,
+	},
+	"new-migration": {
+		usage: "scaffold the migration to the next repo version",
+		run:   runNewMigration,
+	},
+}
+
+// runCommand runs the subcommand named by args[0], if there is one. It
+// reports whether a subcommand was found.
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return false
+	}
+	if err := cmd.run(args[1:]); err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+	return true
+}
+
+func printCommands() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n", name, commands[name].usage)
+	}
+}
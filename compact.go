@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ipfs/fs-repo-migrations/compact"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+func runCompact(args []string) error {
+	fs := flag.NewFlagSet("compact", flag.ExitOnError)
+	workers := fs.Int("workers", gomigrate.DefaultWorkers, "number of concurrent compactors")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to compact")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+	return compactRepo(ipfsdir, *workers)
+}
+
+// compactRepo compacts the badger datastores of the repo and prints how
+// much space was reclaimed.
+func compactRepo(ipfsdir string, workers int) error {
+	results, err := compact.Repo(ipfsdir, workers)
+	for _, r := range results {
+		fmt.Printf("compacted %s\n", r)
+	}
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		fmt.Println("no badger datastores to compact")
+	}
+	return nil
+}
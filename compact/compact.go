@@ -0,0 +1,87 @@
+// Package compact reclaims the disk space left behind in a repo's badger
+// datastores by migrations that rewrite many keys. Badger appends new
+// versions of keys to its value log and only drops the old ones when the
+// LSM tree is compacted and the value log garbage collected.
+package compact
+
+import (
+	"fmt"
+	"path/filepath"
+
+	badger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-badger"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Result describes a compacted datastore.
+type Result struct {
+	Mountpoint string
+	Dir        string // relative to the repo
+	Before     int64  // bytes on disk
+	After      int64
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%s (%s): %d -> %d bytes", r.Mountpoint, r.Dir, r.Before, r.After)
+}
+
+// Repo compacts every badger datastore mounted in the repo at path. The
+// repo must not be in use. Workers is the number of concurrent compactors
+// used to flatten the LSM tree.
+func Repo(path string, workers int) ([]Result, error) {
+	mounts, err := mfsr.RepoPath(path).Mounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	for _, m := range mounts {
+		if m.Type != "badgerds" || m.Path() == "" {
+			continue
+		}
+		res, err := Badger(filepath.Join(path, m.Path()), workers)
+		if err != nil {
+			return results, fmt.Errorf("compacting %s: %w", m.Mountpoint, err)
+		}
+		res.Mountpoint, res.Dir = m.Mountpoint, m.Path()
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// Badger compacts the badger datastore in dir: it flattens the LSM tree,
+// dropping stale key versions, then garbage collects the value log until
+// nothing more can be rewritten.
+func Badger(dir string, workers int) (Result, error) {
+	res := Result{Dir: dir}
+	before, err := migrate.DirUsage(dir)
+	if err != nil {
+		return res, err
+	}
+	res.Before = before.Bytes
+
+	opts := badger.DefaultOptions
+	opts.GcInterval = 0 // collect once, below
+	d, err := badger.NewDatastore(dir, &opts)
+	if err != nil {
+		return res, err
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	err = d.DB.Flatten(workers)
+	if err == nil {
+		err = d.CollectGarbage()
+	}
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return res, err
+	}
+
+	after, err := migrate.DirUsage(dir)
+	res.After = after.Bytes
+	return res, err
+}
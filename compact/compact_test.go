@@ -0,0 +1,27 @@
+package compact
+
+import (
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestRepo(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Badger),
+		migrationtest.WithBlocks(50, 1024))
+
+	results, err := Repo(r.Path, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Dir != "badgerds" {
+		t.Fatalf("expected the badgerds datastore to be compacted, got %v", results)
+	}
+	r.AssertBlocks()
+
+	// other backends have nothing to compact
+	r = migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Flatfs))
+	if results, err := Repo(r.Path, 1); err != nil || len(results) != 0 {
+		t.Fatalf("flatfs repo: got %v, %v", results, err)
+	}
+}
@@ -0,0 +1,140 @@
+package configrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+)
+
+// Policy lists config keys a site wants to survive every migration
+// unchanged. It is read from JSON:
+//
+//	{
+//	  "pin": ["Addresses.Gateway", "Swarm.ConnMgr"],
+//	  "force": {"Swarm.ConnMgr.HighWater": 900},
+//	  "forbid": ["Experimental.ShardingEnabled"]
+//	}
+type Policy struct {
+	// Pin lists keys that keep the value they had before each migration,
+	// or stay absent if they were.
+	Pin []string `json:"pin,omitempty"`
+	// Force sets keys to the given values after each migration.
+	Force map[string]interface{} `json:"force,omitempty"`
+	// Forbid lists keys removed after each migration.
+	Forbid []string `json:"forbid,omitempty"`
+}
+
+// LoadPolicy reads a policy from a JSON file and checks it.
+func LoadPolicy(path string) (Policy, error) {
+	var p Policy
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if err := p.Validate(); err != nil {
+		return p, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Empty reports whether p has no keys.
+func (p Policy) Empty() bool {
+	return len(p.Pin) == 0 && len(p.Force) == 0 && len(p.Forbid) == 0
+}
+
+// Validate checks that every key is named once, and that forced keys
+// have a value.
+func (p Policy) Validate() error {
+	seen := make(map[string]string)
+	add := func(path, list string) error {
+		if path == "" {
+			return fmt.Errorf("%s: empty key", list)
+		}
+		if prev, ok := seen[path]; ok {
+			return fmt.Errorf("%s is both in %s and %s", path, prev, list)
+		}
+		seen[path] = list
+		return nil
+	}
+	for _, path := range p.Pin {
+		if err := add(path, "pin"); err != nil {
+			return err
+		}
+	}
+	for path, v := range p.Force {
+		if err := add(path, "force"); err != nil {
+			return err
+		}
+		if v == nil {
+			return fmt.Errorf("force %s: missing value", path)
+		}
+	}
+	for _, path := range p.Forbid {
+		if err := add(path, "forbid"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply enforces p on cfg, the config after a migration, given before,
+// the config before it. It returns the number of keys changed.
+func (p Policy) Apply(before, cfg map[string]interface{}) (int, error) {
+	changed := 0
+	for _, path := range p.Pin {
+		ok, err := pin(before, cfg, path)
+		if err != nil {
+			return changed, fmt.Errorf("pin %s: %w", path, err)
+		}
+		if ok {
+			changed++
+		}
+	}
+
+	var rs Rules
+	forced := make([]string, 0, len(p.Force))
+	for path := range p.Force {
+		forced = append(forced, path)
+	}
+	sort.Strings(forced)
+	for _, path := range forced {
+		rs = append(rs, Rule{Op: OpSet, Path: path, Value: p.Force[path]})
+	}
+	for _, path := range p.Forbid {
+		rs = append(rs, Rule{Op: OpRemove, Path: path})
+	}
+	n, err := rs.Apply(cfg)
+	return changed + n, err
+}
+
+// pin restores the value at path in cfg to the one in before, removing it
+// if before had none. It reports whether cfg changed.
+func pin(before, cfg map[string]interface{}, path string) (bool, error) {
+	var want interface{}
+	had := false
+	if parent, key, _ := walk(before, path, false); parent != nil {
+		want, had = parent[key]
+	}
+
+	parent, key, err := walk(cfg, path, had)
+	if err != nil || parent == nil {
+		return false, err
+	}
+	cur, exists := parent[key]
+	switch {
+	case !had && !exists:
+		return false, nil
+	case !had:
+		delete(parent, key)
+	case exists && reflect.DeepEqual(cur, want):
+		return false, nil
+	default:
+		parent[key] = want
+	}
+	return true, nil
+}
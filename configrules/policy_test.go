@@ -0,0 +1,64 @@
+package configrules
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPolicyApply(t *testing.T) {
+	before := parse(t, `{
+		"Addresses": {"Gateway": "/ip4/0.0.0.0/tcp/8080"},
+		"Swarm": {"ConnMgr": {"LowWater": 100, "HighWater": 200}}
+	}`)
+	// as a migration might leave it
+	cfg := parse(t, `{
+		"Addresses": {"Gateway": "/ip4/127.0.0.1/tcp/8080", "API": "/ip4/127.0.0.1/tcp/5001"},
+		"Swarm": {"ConnMgr": {"LowWater": 600, "HighWater": 900}, "New": true},
+		"Experimental": {"ShardingEnabled": true}
+	}`)
+
+	var p Policy
+	if err := json.Unmarshal([]byte(`{
+		"pin": ["Addresses.Gateway", "Swarm.ConnMgr", "Addresses.API"],
+		"force": {"Swarm.New": false},
+		"forbid": ["Experimental.ShardingEnabled"]
+	}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := p.Apply(before, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("expected 5 keys changed, got %d", n)
+	}
+	want := parse(t, `{
+		"Addresses": {"Gateway": "/ip4/0.0.0.0/tcp/8080"},
+		"Swarm": {"ConnMgr": {"LowWater": 100, "HighWater": 200}, "New": false},
+		"Experimental": {}
+	}`)
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %v, expected %v", cfg, want)
+	}
+
+	if n, err := p.Apply(before, cfg); err != nil || n != 0 {
+		t.Errorf("second run changed %d keys, err %v", n, err)
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	for _, p := range []Policy{
+		{Pin: []string{"A"}, Forbid: []string{"A"}},
+		{Force: map[string]interface{}{"A": nil}},
+		{Pin: []string{""}},
+	} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", p)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package configrules edits repo configs with declarative rules, so that
+// config changes can be written as data instead of hand-rolled map
+// manipulation, and operators can supply site-specific rules to apply
+// during a migration, e.g. to keep custom API addresses.
+//
+// Rules are read from JSON:
+//
+//	[
+//	  {"op": "set", "path": "Addresses.API", "value": "/ip4/0.0.0.0/tcp/5001"},
+//	  {"op": "append", "path": "Bootstrap", "value": "/dnsaddr/example.com",
+//	   "if": [{"path": "Bootstrap", "missing": false}]}
+//	]
+package configrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+)
+
+// Operations a Rule can perform.
+const (
+	// OpSet sets the value at the path, creating parent objects.
+	OpSet = "set"
+	// OpDefault sets the value at the path only if there is none.
+	OpDefault = "default"
+	// OpRemove removes the path.
+	OpRemove = "remove"
+	// OpAppend appends the value to the array at the path, unless it is
+	// already there, creating the array if needed.
+	OpAppend = "append"
+	// OpRemoveValue removes every occurrence of the value from the array
+	// at the path.
+	OpRemoveValue = "remove-value"
+)
+
+// Rule is one edit of the config, made if all of its conditions hold.
+type Rule struct {
+	Op string `json:"op"`
+	// Path is a dotted key, e.g. "Addresses.API".
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+	// If lists conditions that must all hold for the rule to apply.
+	If []Condition `json:"if,omitempty"`
+	// To restricts the rule to migrations to this repo version. 0 applies
+	// it after every migration.
+	To int `json:"to,omitempty"`
+}
+
+// Condition tests the value at a dotted path.
+type Condition struct {
+	Path string `json:"path"`
+	// Missing requires the path to be absent. Otherwise it must be
+	// present, and match Equals and Contains if they are set.
+	Missing bool `json:"missing,omitempty"`
+	// Equals requires the value to equal this one.
+	Equals interface{} `json:"equals,omitempty"`
+	// Contains requires the value to be an array holding this one.
+	Contains interface{} `json:"contains,omitempty"`
+}
+
+// Rules are applied in order, each seeing the edits of those before it.
+type Rules []Rule
+
+// Load reads rules from a JSON file and checks them.
+func Load(path string) (Rules, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rs Rules
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	for i, r := range rs {
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: rule %d: %w", path, i+1, err)
+		}
+	}
+	return rs, nil
+}
+
+// Validate checks that r has a known operation, a path, and a value if
+// its operation needs one.
+func (r Rule) Validate() error {
+	if r.Path == "" {
+		return fmt.Errorf("%s: missing path", r.Op)
+	}
+	switch r.Op {
+	case OpRemove:
+	case OpSet, OpDefault, OpAppend, OpRemoveValue:
+		if r.Value == nil {
+			return fmt.Errorf("%s %s: missing value", r.Op, r.Path)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", r.Op)
+	}
+	for _, c := range r.If {
+		if c.Path == "" {
+			return fmt.Errorf("%s %s: condition without path", r.Op, r.Path)
+		}
+	}
+	return nil
+}
+
+// For returns the rules that apply after migrating to version.
+func (rs Rules) For(version int) Rules {
+	var out Rules
+	for _, r := range rs {
+		if r.To == 0 || r.To == version {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Apply edits cfg in place and returns the number of rules that changed
+// it.
+func (rs Rules) Apply(cfg map[string]interface{}) (int, error) {
+	changed := 0
+	for _, r := range rs {
+		ok, err := r.Apply(cfg)
+		if err != nil {
+			return changed, err
+		}
+		if ok {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+// Apply edits cfg in place if r's conditions hold, and reports whether
+// cfg changed.
+func (r Rule) Apply(cfg map[string]interface{}) (bool, error) {
+	if err := r.Validate(); err != nil {
+		return false, err
+	}
+	for _, c := range r.If {
+		if !c.Holds(cfg) {
+			return false, nil
+		}
+	}
+
+	parent, key, err := walk(cfg, r.Path, r.Op != OpRemove && r.Op != OpRemoveValue)
+	if err != nil || parent == nil {
+		return false, err
+	}
+	cur, exists := parent[key]
+
+	switch r.Op {
+	case OpSet:
+		if exists && reflect.DeepEqual(cur, r.Value) {
+			return false, nil
+		}
+		parent[key] = r.Value
+	case OpDefault:
+		if exists {
+			return false, nil
+		}
+		parent[key] = r.Value
+	case OpRemove:
+		if !exists {
+			return false, nil
+		}
+		delete(parent, key)
+	case OpAppend:
+		arr, err := array(cur, exists, r)
+		if err != nil {
+			return false, err
+		}
+		if indexOf(arr, r.Value) >= 0 {
+			return false, nil
+		}
+		parent[key] = append(arr, r.Value)
+	case OpRemoveValue:
+		arr, err := array(cur, exists, r)
+		if err != nil {
+			return false, err
+		}
+		out := arr[:0:0]
+		for _, v := range arr {
+			if !reflect.DeepEqual(v, r.Value) {
+				out = append(out, v)
+			}
+		}
+		if len(out) == len(arr) {
+			return false, nil
+		}
+		parent[key] = out
+	}
+	return true, nil
+}
+
+// Holds reports whether c holds in cfg.
+func (c Condition) Holds(cfg map[string]interface{}) bool {
+	parent, key, _ := walk(cfg, c.Path, false)
+	var v interface{}
+	exists := false
+	if parent != nil {
+		v, exists = parent[key]
+	}
+	if c.Missing {
+		return !exists
+	}
+	if !exists {
+		return false
+	}
+	if c.Equals != nil && !reflect.DeepEqual(v, c.Equals) {
+		return false
+	}
+	if c.Contains != nil {
+		arr, ok := v.([]interface{})
+		if !ok || indexOf(arr, c.Contains) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// walk returns the object holding the last element of the dotted path,
+// and that element. It creates missing parent objects if create is set,
+// and otherwise returns a nil parent when one is missing.
+func walk(cfg map[string]interface{}, path string, create bool) (map[string]interface{}, string, error) {
+	parts := strings.Split(path, ".")
+	cur := cfg
+	for i, part := range parts[:len(parts)-1] {
+		next, ok := cur[part]
+		if !ok {
+			if !create {
+				return nil, "", nil
+			}
+			next = map[string]interface{}{}
+			cur[part] = next
+		}
+		m, ok := next.(map[string]interface{})
+		if !ok {
+			if !create {
+				return nil, "", nil
+			}
+			return nil, "", fmt.Errorf("%s is not an object", strings.Join(parts[:i+1], "."))
+		}
+		cur = m
+	}
+	return cur, parts[len(parts)-1], nil
+}
+
+func array(v interface{}, exists bool, r Rule) ([]interface{}, error) {
+	if !exists || v == nil {
+		return nil, nil
+	}
+	arr, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s %s: not an array", r.Op, r.Path)
+	}
+	return arr, nil
+}
+
+func indexOf(arr []interface{}, v interface{}) int {
+	for i, e := range arr {
+		if reflect.DeepEqual(e, v) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,84 @@
+package configrules
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func parse(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestApply(t *testing.T) {
+	cfg := parse(t, `{
+		"Addresses": {"API": "/ip4/127.0.0.1/tcp/5001"},
+		"Bootstrap": ["/ip4/1.2.3.4/tcp/4001"],
+		"Old": true
+	}`)
+
+	var rules Rules
+	if err := json.Unmarshal([]byte(`[
+		{"op": "set", "path": "Addresses.API", "value": "/ip4/0.0.0.0/tcp/5001"},
+		{"op": "default", "path": "Addresses.Gateway", "value": "/ip4/127.0.0.1/tcp/8080"},
+		{"op": "default", "path": "Addresses.API", "value": "ignored"},
+		{"op": "remove", "path": "Old"},
+		{"op": "append", "path": "Bootstrap", "value": "/dnsaddr/example.com",
+		 "if": [{"path": "Bootstrap", "contains": "/ip4/1.2.3.4/tcp/4001"}]},
+		{"op": "append", "path": "Bootstrap", "value": "/ip4/1.2.3.4/tcp/4001"},
+		{"op": "set", "path": "Swarm.Custom", "value": 1, "if": [{"path": "Swarm", "missing": true}]},
+		{"op": "remove-value", "path": "Missing.List", "value": "x"}
+	]`), &rules); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := rules.Apply(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("expected 5 rules to change the config, got %d", n)
+	}
+
+	want := parse(t, `{
+		"Addresses": {"API": "/ip4/0.0.0.0/tcp/5001", "Gateway": "/ip4/127.0.0.1/tcp/8080"},
+		"Bootstrap": ["/ip4/1.2.3.4/tcp/4001", "/dnsaddr/example.com"],
+		"Swarm": {"Custom": 1}
+	}`)
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got %v, expected %v", cfg, want)
+	}
+
+	// rules are idempotent
+	if n, err := rules.Apply(cfg); err != nil || n != 0 {
+		t.Errorf("second run changed %d settings, err %v", n, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, r := range []Rule{
+		{Op: "frob", Path: "A"},
+		{Op: OpSet},
+		{Op: OpSet, Path: "A"},
+		{Op: OpRemove, Path: "A", If: []Condition{{}}},
+	} {
+		if err := r.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", r)
+		}
+	}
+}
+
+func TestFor(t *testing.T) {
+	rs := Rules{{Op: OpRemove, Path: "A"}, {Op: OpRemove, Path: "B", To: 10}}
+	if got := len(rs.For(10)); got != 2 {
+		t.Errorf("expected 2 rules for version 10, got %d", got)
+	}
+	if got := len(rs.For(11)); got != 1 {
+		t.Errorf("expected 1 rule for version 11, got %d", got)
+	}
+}
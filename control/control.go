@@ -0,0 +1,279 @@
+// Package control serves the gRPC service of control.proto, through which
+// orchestration systems drive a run instead of managing the process's
+// terminal: start it, pause, resume or abort it, ask for its status and
+// stream its log records.
+//
+// The service speaks gRPC over HTTP/2 without TLS (h2c), as clients do
+// with insecure credentials, so serve it on localhost or a trusted network.
+// Every call must carry the dashboard's token as "authorization: Bearer
+// <token>" metadata. Browsers cannot reach it, as it only answers HTTP/2
+// requests.
+package control
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"github.com/ipfs/fs-repo-migrations/dashboard"
+)
+
+// Service is the full name of the service, prefixing the request paths.
+const Service = "fsrepomigrations.control.Control"
+
+// maxMessage bounds the size of a request message. The requests are empty.
+const maxMessage = 1 << 20
+
+// gRPC status codes.
+const (
+	codeOK                 = 0
+	codeInvalidArgument    = 3
+	codeFailedPrecondition = 9
+	codeUnimplemented      = 12
+	codeInternal           = 13
+	codeUnauthenticated    = 16
+)
+
+// Error is a gRPC status other than OK.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rpc error: code = %d desc = %s", e.Code, e.Message)
+}
+
+// Server serves the control service.
+type Server struct {
+	// Dashboard collects the status and the log records, and is worked by
+	// Pause, Resume and Abort. Its Token authenticates the calls.
+	Dashboard *dashboard.Dashboard
+
+	once    sync.Once
+	started chan struct{}
+}
+
+// New returns a server controlling the run d collects for.
+func New(d *dashboard.Dashboard) *Server {
+	return &Server{Dashboard: d, started: make(chan struct{})}
+}
+
+// Started is closed once a client called Start.
+func (s *Server) Started() <-chan struct{} {
+	return s.started
+}
+
+// Handler returns the handler to serve, accepting HTTP/2 without TLS.
+func (s *Server) Handler() http.Handler {
+	return h2c.NewHandler(s, &http2.Server{})
+}
+
+// replyWriter notes whether the reply has begun.
+type replyWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (w *replyWriter) WriteHeader(code int) {
+	w.wrote = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *replyWriter) Write(p []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *replyWriter) Flush() {
+	w.wrote = true
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+// ServeHTTP answers a gRPC request.
+func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w := &replyWriter{ResponseWriter: rw}
+	if r.ProtoMajor != 2 || r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "gRPC over HTTP/2 only", http.StatusUnsupportedMediaType)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc")
+	if !s.authorized(r) {
+		finish(w, &Error{codeUnauthenticated, "missing or wrong token"})
+		return
+	}
+
+	method := strings.TrimPrefix(r.URL.Path, "/"+Service+"/")
+	if method == r.URL.Path {
+		finish(w, &Error{codeUnimplemented, "unknown service in " + r.URL.Path})
+		return
+	}
+	var req Empty
+	if err := readMessage(r.Body, &req); err != nil {
+		finish(w, err)
+		return
+	}
+
+	var reply proto.Message = &Empty{}
+	var err error
+	switch method {
+	case "Start":
+		s.once.Do(func() { close(s.started) })
+	case "Pause", "Resume":
+		if s.Dashboard.Pause == nil {
+			err = &Error{codeFailedPrecondition, "pausing is not available"}
+		} else if method == "Pause" {
+			s.Dashboard.Pause.Pause()
+		} else {
+			s.Dashboard.Pause.Resume()
+		}
+	case "Abort":
+		if s.Dashboard.Abort == nil {
+			err = &Error{codeFailedPrecondition, "aborting is not available"}
+		} else {
+			// the shutdown may outlast the call
+			go s.Dashboard.Abort()
+		}
+	case "Status":
+		reply = s.status()
+	case "Events":
+		s.events(w, r)
+		return
+	default:
+		err = &Error{codeUnimplemented, "unknown method " + method}
+	}
+	if err == nil {
+		err = writeMessage(w, reply)
+	}
+	finish(w, err)
+}
+
+// authorized reports whether r carries the dashboard's token.
+func (s *Server) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return s.Dashboard.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.Dashboard.Token)) == 1
+}
+
+func (s *Server) status() *StatusReply {
+	st := s.Dashboard.Status()
+	reply := &StatusReply{
+		Migration: st.Migration,
+		Latest:    st.Latest,
+		Paused:    st.Paused,
+		UptimeNs:  int64(st.Uptime),
+		Errors:    int64(st.Errors),
+	}
+	select {
+	case <-s.started:
+		reply.Started = true
+	default:
+	}
+	for _, c := range st.Counters {
+		reply.Counters = append(reply.Counters, &Counter{Name: c.Name, Total: c.Total})
+	}
+	return reply
+}
+
+// events streams log records until the client goes away.
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	ch, cancel := s.Dashboard.Subscribe()
+	defer cancel()
+	w.WriteHeader(http.StatusOK)
+	w.(http.Flusher).Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case rec := <-ch:
+			if err := writeMessage(w, newEvent(rec)); err != nil {
+				finish(w, err)
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	}
+}
+
+// readMessage reads the single, length-prefixed message of a request.
+func readMessage(r io.Reader, m proto.Message) error {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return &Error{codeInvalidArgument, "reading message: " + err.Error()}
+	}
+	if prefix[0] != 0 {
+		return &Error{codeUnimplemented, "compressed messages are not supported"}
+	}
+	n := binary.BigEndian.Uint32(prefix[1:])
+	if n > maxMessage {
+		return &Error{codeInvalidArgument, "message too large"}
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, int64(n)))
+	if err == nil && uint32(len(data)) != n {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return &Error{codeInvalidArgument, "reading message: " + err.Error()}
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return &Error{codeInvalidArgument, err.Error()}
+	}
+	return nil
+}
+
+// writeMessage writes m with its length prefix.
+func writeMessage(w io.Writer, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return &Error{codeInternal, err.Error()}
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(0)
+	binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+	buf.Write(data)
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// finish sends the status of the call in the trailers, or in the headers
+// if the call fails before any reply was written, as gRPC's trailers-only
+// responses do.
+func finish(w http.ResponseWriter, err error) {
+	code, msg := codeOK, ""
+	if e, ok := err.(*Error); ok {
+		code, msg = e.Code, e.Message
+	} else if err != nil {
+		code, msg = codeInternal, err.Error()
+	}
+	prefix := http.TrailerPrefix
+	if rw, ok := w.(*replyWriter); ok && !rw.wrote {
+		prefix = ""
+	}
+	w.Header().Set(prefix+"Grpc-Status", strconv.Itoa(code))
+	if msg != "" {
+		w.Header().Set(prefix+"Grpc-Message", encodeMessage(msg))
+	}
+}
+
+// encodeMessage percent-encodes a status message as gRPC requires.
+func encodeMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,181 @@
+package control
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	"golang.org/x/net/http2"
+	"github.com/ipfs/fs-repo-migrations/dashboard"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// client speaks HTTP/2 without TLS, as gRPC clients do with
+// insecure credentials.
+var client = &http.Client{Transport: &http2.Transport{
+	AllowHTTP: true,
+	DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+		return net.Dial(network, addr)
+	},
+}}
+
+func newServer(t *testing.T) (*Server, *httptest.Server) {
+	t.Helper()
+	d := dashboard.New(migrate.NewPause(), nil)
+	s := New(d)
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+// call posts an empty request to method with the token of s and returns
+// the response, with the body left to read.
+func call(t *testing.T, s *Server, srv *httptest.Server, method string) *http.Response {
+	t.Helper()
+	return callWith(t, srv, method, s.Dashboard.Token)
+}
+
+func callWith(t *testing.T, srv *httptest.Server, method, token string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/"+Service+"/"+method, bytes.NewReader(make([]byte, 5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// unary calls method and decodes its reply into m, returning the status
+// code of the call as gRPC clients find it.
+func unary(t *testing.T, s *Server, srv *httptest.Server, method string, m proto.Message) string {
+	t.Helper()
+	return status(t, call(t, s, srv, method), method, m)
+}
+
+func status(t *testing.T, resp *http.Response, method string, m proto.Message) string {
+	t.Helper()
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body) > 0 {
+		if err := readMessage(bytes.NewReader(body), m); err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+	}
+	if code := resp.Trailer.Get("Grpc-Status"); code != "" {
+		return code
+	}
+	// trailers-only
+	return resp.Header.Get("Grpc-Status")
+}
+
+func TestControl(t *testing.T) {
+	s, srv := newServer(t)
+	aborted := make(chan struct{})
+	s.Dashboard.Abort = func() { close(aborted) }
+	s.Dashboard.Count("mg1.blocks_moved", 7)
+
+	var st StatusReply
+	if code := unary(t, s, srv, "Status", &st); code != "0" || st.Started {
+		t.Fatalf("status before Start: %s %+v", code, st)
+	}
+	if code := unary(t, s, srv, "Start", &Empty{}); code != "0" {
+		t.Fatalf("Start: %s", code)
+	}
+	select {
+	case <-s.Started():
+	default:
+		t.Fatal("not started")
+	}
+	if code := unary(t, s, srv, "Start", &Empty{}); code != "0" {
+		t.Errorf("second Start: %s", code)
+	}
+
+	if code := unary(t, s, srv, "Pause", &Empty{}); code != "0" || !s.Dashboard.Pause.Paused() {
+		t.Errorf("Pause: %s", code)
+	}
+	st = StatusReply{}
+	unary(t, s, srv, "Status", &st)
+	if !st.Started || !st.Paused || len(st.Counters) != 1 || st.Counters[0].Total != 7 {
+		t.Errorf("status %+v", st)
+	}
+	if code := unary(t, s, srv, "Resume", &Empty{}); code != "0" || s.Dashboard.Pause.Paused() {
+		t.Errorf("Resume: %s", code)
+	}
+
+	if code := unary(t, s, srv, "Abort", &Empty{}); code != "0" {
+		t.Errorf("Abort: %s", code)
+	}
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Abort not called")
+	}
+
+	if code := unary(t, s, srv, "Frobnicate", &Empty{}); code != "12" {
+		t.Errorf("unknown method: status %s", code)
+	}
+	s.Dashboard.Pause = nil
+	if code := unary(t, s, srv, "Pause", &Empty{}); code != "9" {
+		t.Errorf("Pause without a Pause: status %s", code)
+	}
+
+	for _, token := range []string{"", "x" + s.Dashboard.Token} {
+		if code := status(t, callWith(t, srv, "Abort", token), "Abort", &Empty{}); code != "16" {
+			t.Errorf("token %q: status %s", token, code)
+		}
+	}
+
+	// HTTP/1, as browsers would send
+	resp, err := http.Post(srv.URL+"/"+Service+"/Abort", "application/grpc", bytes.NewReader(make([]byte, 5)))
+	if err != nil || resp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("HTTP/1 request: %v %v", resp, err)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	s, srv := newServer(t)
+	resp := call(t, s, srv, "Events")
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatal(resp.Status)
+	}
+
+	s.Dashboard.Log(log.Record{Level: log.LevelWarn, Time: time.Now(), Message: "first", Fields: log.Fields{"key": 3}})
+	s.Dashboard.Log(log.Record{Level: log.LevelInfo, Time: time.Now(), Message: "second"})
+	for _, want := range []string{"first", "second"} {
+		var prefix [5]byte
+		if _, err := io.ReadFull(resp.Body, prefix[:]); err != nil {
+			t.Fatal(err)
+		}
+		data := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+		if _, err := io.ReadFull(resp.Body, data); err != nil {
+			t.Fatal(err)
+		}
+		var ev Event
+		if err := proto.Unmarshal(data, &ev); err != nil || ev.Message != want {
+			t.Fatalf("got %+v, %v; want %q", ev, err, want)
+		}
+		if want == "first" && (ev.Level != "warn" || ev.Fields["key"] != "3") {
+			t.Errorf("event %+v", ev)
+		}
+	}
+}
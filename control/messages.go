@@ -0,0 +1,69 @@
+package control
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/ipfs/fs-repo-migrations/_vendor/github.com/gogo/protobuf/proto"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// The messages of control.proto. proto marshals them by their struct tags.
+
+type Empty struct{}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+
+type Counter struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3"`
+	Total int64  `protobuf:"varint,2,opt,name=total,proto3"`
+}
+
+func (m *Counter) Reset()         { *m = Counter{} }
+func (m *Counter) String() string { return proto.CompactTextString(m) }
+func (*Counter) ProtoMessage()    {}
+
+type StatusReply struct {
+	Started   bool       `protobuf:"varint,1,opt,name=started,proto3"`
+	Migration string     `protobuf:"bytes,2,opt,name=migration,proto3"`
+	Latest    string     `protobuf:"bytes,3,opt,name=latest,proto3"`
+	Paused    bool       `protobuf:"varint,4,opt,name=paused,proto3"`
+	UptimeNs  int64      `protobuf:"varint,5,opt,name=uptime_ns,json=uptimeNs,proto3"`
+	Errors    int64      `protobuf:"varint,6,opt,name=errors,proto3"`
+	Counters  []*Counter `protobuf:"bytes,7,rep,name=counters,proto3"`
+}
+
+func (m *StatusReply) Reset()         { *m = StatusReply{} }
+func (m *StatusReply) String() string { return proto.CompactTextString(m) }
+func (*StatusReply) ProtoMessage()    {}
+
+type Event struct {
+	Time      string            `protobuf:"bytes,1,opt,name=time,proto3"`
+	Level     string            `protobuf:"bytes,2,opt,name=level,proto3"`
+	Migration string            `protobuf:"bytes,3,opt,name=migration,proto3"`
+	Message   string            `protobuf:"bytes,4,opt,name=message,proto3"`
+	Fields    map[string]string `protobuf:"bytes,5,rep,name=fields,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+
+// newEvent converts a log record.
+func newEvent(rec log.Record) *Event {
+	ev := &Event{
+		Time:      rec.Time.Format(time.RFC3339Nano),
+		Level:     rec.Level.String(),
+		Migration: rec.Migration,
+		Message:   rec.Message,
+	}
+	if len(rec.Fields) > 0 {
+		ev.Fields = make(map[string]string, len(rec.Fields))
+		for k, v := range rec.Fields {
+			ev.Fields[k] = fmt.Sprint(v)
+		}
+	}
+	return ev
+}
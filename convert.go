@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runConvert(args []string) error {
+	fs := flag.NewFlagSet("convert", flag.ExitOnError)
+	to := fs.String("to", "", "datastore layout to convert to: "+strings.Join(convert.Layouts(), ", "))
+	specFile := fs.String("spec", "", "file holding the Datastore.Spec to convert to, e.g. to split or merge mounts")
+	move := fs.String("move", "", "move a mount to another directory, as mountpoint=dir, e.g. /blocks=/mnt/disk2/blocks")
+	planOnly := fs.Bool("plan", false, "only print what would be copied and kept")
+	removeOld := fs.Bool("remove-old", false, "remove the old datastores once the repo is switched over")
+	verifyEvery := fs.Int("verify-every", convert.DefaultVerifyEvery, "compare every n-th copied value with the original, 1 for all")
+	batchSize := fs.Int("batch-size", gomigrate.DefaultBatchSize, "number of keys per datastore batch")
+	windowStr := fs.String("window", "", "only copy inside this daily window, e.g. \"22:00-06:00\", pausing between batches outside it")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to convert")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	var window *gomigrate.Window
+	if *windowStr != "" {
+		if window, err = gomigrate.ParseWindow(*windowStr); err != nil {
+			return err
+		}
+	}
+	spec, err := convertTarget(ipfsdir, *to, *specFile, *move)
+	if err != nil {
+		return err
+	}
+
+	plan, err := convert.NewPlan(ipfsdir, spec)
+	if err != nil {
+		return err
+	}
+	fmt.Print(plan)
+	if *planOnly {
+		return nil
+	}
+
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+	if vnum != CurrentVersion {
+		return fmt.Errorf("convert: repo is at version %d, migrate it to %d first", vnum, CurrentVersion)
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	// an estimate is enough for the ETA, and walking every key first is not
+	total, err := convert.EstimateKeys(ipfsdir)
+	if err != nil {
+		log.Warn("no progress estimate: %s", err)
+	}
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	res, err := convert.Spec(ipfsdir, spec, convert.Options{
+		BatchSize:   *batchSize,
+		RemoveOld:   *removeOld,
+		VerifyEvery: *verifyEvery,
+		Window:      window,
+		Progress: func(copied int64) {
+			progress.Update("copied %d keys%s", copied, progress.Remaining(copied, total))
+		},
+	})
+	progress.Done()
+	if err != nil {
+		return fmt.Errorf("converting (run again to resume): %w", err)
+	}
+	fmt.Printf("converted %d keys\n", res.Keys)
+	for _, dir := range res.Old {
+		fmt.Printf("kept the old datastore in %s, remove it once the repo works\n", dir)
+	}
+	return nil
+}
+
+// convertTarget returns the Datastore.Spec given by exactly one of the
+// -to, -spec and -move flags.
+func convertTarget(ipfsdir, to, specFile, move string) (map[string]interface{}, error) {
+	set := 0
+	for _, v := range []string{to, specFile, move} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("convert: exactly one of -to, -spec and -move is required")
+	}
+
+	switch {
+	case to != "":
+		return convert.LayoutSpec(to)
+	case specFile != "":
+		data, err := ioutil.ReadFile(specFile)
+		if err != nil {
+			return nil, err
+		}
+		var spec map[string]interface{}
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", specFile, err)
+		}
+		return spec, nil
+	}
+
+	parts := strings.SplitN(move, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("convert: -move must be mountpoint=dir, got %q", move)
+	}
+	cfg, err := mfsr.RepoPath(ipfsdir).Config()
+	if err != nil {
+		return nil, err
+	}
+	v, _ := mfsr.ConfigValue(cfg, "Datastore.Spec")
+	current, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no Datastore.Spec in %s", mfsr.RepoPath(ipfsdir).ConfigFile())
+	}
+	return convert.MoveMount(current, parts[0], parts[1])
+}
@@ -0,0 +1,465 @@
+// Package convert moves a repo's datastore to a different layout, e.g.
+// from the default flatfs and leveldb mounts to a single badger datastore
+// and back. All keys are copied into the new datastores next to the old
+// ones and checked before the repo is switched over, so the repo stays
+// usable with its old layout until the copy is known to be complete.
+package convert
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// StateFile records the progress of a conversion, relative to the repo.
+const StateFile = "convert.state"
+
+const (
+	// suffix of the directories being copied into
+	newSuffix = ".convert"
+	// suffix the old datastore directories are moved to on switching over
+	OldSuffix = ".pre-convert"
+)
+
+// the steps of a conversion, each recorded in the state once done
+const (
+	phaseCopy = iota
+	phaseMoveOld
+	phaseMoveNew
+	phaseSpec
+	phaseDone
+)
+
+// Options controls a conversion.
+type Options struct {
+	// BatchSize is the number of keys per datastore batch.
+	BatchSize int
+	// Progress, if not nil, is called after each batch with the number of
+	// keys copied so far.
+	Progress func(copied int64)
+	// RemoveOld removes the old datastores once the repo is switched over.
+	// Otherwise they are kept next to the new ones, with OldSuffix.
+	RemoveOld bool
+	// VerifyEvery is how often the values of copied keys are compared with
+	// the originals: every VerifyEvery-th key, 1 for every key. It defaults
+	// to DefaultVerifyEvery.
+	VerifyEvery int
+	// Window, if not nil, restricts copying to a daily execution window:
+	// outside it the conversion pauses between batches.
+	Window *migrate.Window
+}
+
+// DefaultVerifyEvery is the default Options.VerifyEvery.
+const DefaultVerifyEvery = 100
+
+// Result describes a finished conversion.
+type Result struct {
+	Keys int64    // keys copied
+	Old  []string // directories of the old datastores, if kept
+}
+
+// state is persisted in StateFile so that an interrupted conversion is
+// resumed with the specs it started with: once the config is rewritten it
+// no longer tells what the old layout was.
+type state struct {
+	path string
+
+	Phase int                    `json:"phase"`
+	From  map[string]interface{} `json:"from"`
+	To    map[string]interface{} `json:"to"`
+	Keys  int64                  `json:"keys"`
+}
+
+func (s *state) save() error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// Repo converts the datastore of the repo at path to the named layout, see
+// LayoutSpec. The repo must not be in use.
+func Repo(path, layout string, opts Options) (Result, error) {
+	spec, err := LayoutSpec(layout)
+	if err != nil {
+		return Result{}, err
+	}
+	return Spec(path, spec, opts)
+}
+
+// Spec converts the datastore of the repo at path to the layout described
+// by spec, a Datastore.Spec value. If a previous conversion was
+// interrupted, it is resumed; it must have been to the same spec.
+func Spec(path string, spec map[string]interface{}, opts Options) (Result, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = migrate.DefaultBatchSize
+	}
+	if opts.VerifyEvery <= 0 {
+		opts.VerifyEvery = DefaultVerifyEvery
+	}
+	// normalize the spec the way it reads back from the state file and
+	// the config, so specs can be compared
+	spec, err := roundtrip(spec)
+	if err != nil {
+		return Result{}, err
+	}
+
+	st, resumed, err := loadState(path, spec)
+	if err != nil {
+		return Result{}, err
+	}
+	p, err := plan(st.From, st.To)
+	if err != nil {
+		return Result{}, err
+	}
+	if !resumed {
+		if err := checkTargets(path, p); err != nil {
+			return Result{}, err
+		}
+		// recorded before copying, so that the copy is resumed as well
+		if err := st.save(); err != nil {
+			return Result{}, err
+		}
+	}
+	from, to := p.From, p.To
+
+	res := Result{Keys: st.Keys}
+	for ; st.Phase < phaseDone; st.Phase++ {
+		switch st.Phase {
+		case phaseCopy:
+			n, err := copyRepo(path, from, to, resumed, opts)
+			if err != nil {
+				return res, err
+			}
+			st.Keys, res.Keys = n, n
+		case phaseMoveOld:
+			for _, m := range from {
+				if err := moveDir(mountDir(path, m), mountDir(path, m)+OldSuffix); err != nil {
+					return res, err
+				}
+			}
+		case phaseMoveNew:
+			for _, m := range to {
+				if err := moveDir(mountDir(path, m)+newSuffix, mountDir(path, m)); err != nil {
+					return res, err
+				}
+			}
+		case phaseSpec:
+			if err := writeSpecs(path, st.To); err != nil {
+				return res, err
+			}
+		}
+		next := *st
+		next.Phase++
+		if err := next.save(); err != nil {
+			return res, err
+		}
+	}
+
+	for _, m := range from {
+		old := mountDir(path, m) + OldSuffix
+		if opts.RemoveOld {
+			if err := os.RemoveAll(old); err != nil {
+				return res, err
+			}
+			continue
+		}
+		res.Old = append(res.Old, old)
+	}
+	return res, os.Remove(st.path)
+}
+
+// loadState returns the state of an interrupted conversion to spec, or a
+// fresh state converting from the current Datastore.Spec. It reports
+// whether the state is that of an interrupted conversion.
+func loadState(path string, spec map[string]interface{}) (*state, bool, error) {
+	st := &state{path: filepath.Join(path, StateFile)}
+	data, err := ioutil.ReadFile(st.path)
+	if err == nil {
+		if err := json.Unmarshal(data, st); err != nil {
+			return nil, false, fmt.Errorf("reading %s: %w", st.path, err)
+		}
+		if !reflect.DeepEqual(st.To, spec) {
+			return nil, false, fmt.Errorf("an interrupted conversion to another layout must be finished first, see %s", st.path)
+		}
+		return st, true, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, false, err
+	}
+
+	cfg, err := mfsr.RepoPath(path).Config()
+	if err != nil {
+		return nil, false, err
+	}
+	v, _ := mfsr.ConfigValue(cfg, "Datastore.Spec")
+	current, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, false, fmt.Errorf("no Datastore.Spec in %s", mfsr.RepoPath(path).ConfigFile())
+	}
+	st.From, st.To = current, spec
+	return st, false, nil
+}
+
+func roundtrip(spec map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]interface{}
+	return out, json.Unmarshal(data, &out)
+}
+
+// copyRepo copies every key of the from mounts into new datastores for
+// the to mounts, and checks that they hold as many keys as the old ones and
+// a sample of the same values. Mounts that are flatfs on both sides have
+// their files copied directly, see copyFlatfs. When resuming, keys already
+// copied are skipped; otherwise anything left in the new datastores is
+// discarded first.
+func copyRepo(path string, from, to []mfsr.Mount, resume bool, opts Options) (int64, error) {
+	if !resume {
+		for _, m := range to {
+			if err := os.RemoveAll(mountDir(path, m) + newSuffix); err != nil {
+				return 0, err
+			}
+		}
+	}
+	srcDir := func(m mfsr.Mount) string { return mountDir(path, m) }
+	dstDir := func(m mfsr.Mount) string { return mountDir(path, m) + newSuffix }
+
+	var copied int64
+	pairs := flatfsPairs(from, to)
+	direct := make(map[int]bool)
+	var restFrom, restTo []mfsr.Mount
+	for i, f := range from {
+		j, ok := pairs[i]
+		if !ok {
+			restFrom = append(restFrom, f)
+			continue
+		}
+		direct[j] = true
+		n, err := copyFlatfs(srcDir(f), dstDir(to[j]), to[j], copied, opts)
+		copied += n
+		if err != nil {
+			return copied, err
+		}
+	}
+	for j, t := range to {
+		if !direct[j] {
+			restTo = append(restTo, t)
+		}
+	}
+	if len(restFrom) > 0 {
+		n, err := copyMounts(restFrom, restTo, srcDir, dstDir, copied, opts)
+		copied += n
+		if err != nil {
+			return copied, err
+		}
+	}
+
+	// opened whole only now, so that flatfs counts the disk usage of the
+	// files copied behind its back
+	src, err := openMounts(from, srcDir)
+	if err != nil {
+		return copied, err
+	}
+	defer src.Close()
+	dst, err := openMounts(to, dstDir)
+	if err != nil {
+		return copied, err
+	}
+	defer dst.Close()
+
+	have, err := countKeys(src)
+	if err != nil {
+		return copied, err
+	}
+	got, err := countKeys(dst)
+	if err != nil {
+		return copied, err
+	}
+	if have != copied || got != copied {
+		return copied, fmt.Errorf("key counts differ: %d in the old datastore, %d copied, %d in the new one", have, copied, got)
+	}
+	return copied, verifySample(src, dst, opts.VerifyEvery)
+}
+
+// copyMounts copies every key of the from mounts into the to mounts
+// through the datastore API. Progress counts from base.
+func copyMounts(from, to []mfsr.Mount, srcDir, dstDir func(mfsr.Mount) string, base int64, opts Options) (int64, error) {
+	src, err := openMounts(from, srcDir)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := openMounts(to, dstDir)
+	if err != nil {
+		return 0, err
+	}
+	defer dst.Close()
+
+	if progress := opts.Progress; progress != nil {
+		opts.Progress = func(copied int64) { progress(base + copied) }
+	}
+	copied, err := copyKeys(src, dst, opts)
+	if err != nil {
+		return copied, err
+	}
+	return copied, dst.Sync(ds.NewKey("/"))
+}
+
+func copyKeys(src, dst ds.Batching, opts Options) (int64, error) {
+	res, err := src.Query(query.Query{})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var copied int64
+	b, err := dst.Batch()
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for r := range res.Next() {
+		if r.Error != nil {
+			return copied, r.Error
+		}
+		key := ds.RawKey(r.Key)
+		// copied by an interrupted run; the size catches files that were
+		// not fully written before a crash
+		if size, err := dst.GetSize(key); err == nil && size == len(r.Value) {
+			copied++
+			continue
+		} else if err != nil && err != ds.ErrNotFound {
+			return copied, err
+		}
+		if err := b.Put(key, r.Value); err != nil {
+			return copied, err
+		}
+		copied++
+		if pending++; pending < opts.BatchSize {
+			continue
+		}
+		if err := b.Commit(); err != nil {
+			return copied, err
+		}
+		if opts.Progress != nil {
+			opts.Progress(copied)
+		}
+		opts.Window.Wait(nil)
+		if b, err = dst.Batch(); err != nil {
+			return copied, err
+		}
+		pending = 0
+	}
+	if err := b.Commit(); err != nil {
+		return copied, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(copied)
+	}
+	return copied, nil
+}
+
+// verifySample compares the hash of every n-th value in src with that of
+// the same key in dst.
+func verifySample(src, dst ds.Datastore, n int) error {
+	res, err := src.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	i := 0
+	for r := range res.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		if i++; (i-1)%n != 0 {
+			continue
+		}
+		key := ds.RawKey(r.Key)
+		want, err := src.Get(key)
+		if err != nil {
+			return err
+		}
+		got, err := dst.Get(key)
+		if err != nil {
+			return fmt.Errorf("verifying %s: %w", key, err)
+		}
+		if sha256.Sum256(got) != sha256.Sum256(want) {
+			return fmt.Errorf("verifying %s: value differs from the original", key)
+		}
+	}
+	return nil
+}
+
+func countKeys(d ds.Datastore) (int64, error) {
+	res, err := d.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int64
+	for r := range res.Next() {
+		if r.Error != nil {
+			return n, r.Error
+		}
+		n++
+	}
+	return n, nil
+}
+
+// moveDir renames src to dst, unless an earlier run already did.
+func moveDir(src, dst string) error {
+	err := os.Rename(src, dst)
+	if err == nil {
+		return nil
+	}
+	if _, err2 := os.Stat(src); os.IsNotExist(err2) {
+		if _, err2 := os.Stat(dst); err2 == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// writeSpecs points the config and datastore_spec at the new layout.
+func writeSpecs(path string, spec map[string]interface{}) error {
+	rp := mfsr.RepoPath(path)
+	disk, err := DiskSpec(spec)
+	if err != nil {
+		return err
+	}
+	if err := rp.WriteDatastoreSpec(disk); err != nil {
+		return err
+	}
+
+	cfg, err := rp.Config()
+	if err != nil {
+		return err
+	}
+	dscfg, ok := cfg["Datastore"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("no Datastore section in %s", rp.ConfigFile())
+	}
+	dscfg["Spec"] = spec
+	return rp.WriteConfig(cfg)
+}
@@ -0,0 +1,268 @@
+package convert
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestRepoBadger(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(50, 256))
+
+	res, err := Repo(r.Path, "badger", Options{BatchSize: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Keys < 50 {
+		t.Fatalf("expected at least the 50 blocks copied, got %d keys", res.Keys)
+	}
+	if len(res.Old) != 2 {
+		t.Fatalf("expected both old datastores kept, got %v", res.Old)
+	}
+	r.AssertExists("blocks" + OldSuffix)
+	r.AssertExists("datastore" + OldSuffix)
+	r.AssertNotExists(StateFile)
+
+	disk, err := ioutil.ReadFile(filepath.Join(r.Path, "datastore_spec"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(disk) != `{"path":"badgerds","type":"badgerds"}` {
+		t.Fatalf("unexpected datastore_spec %s", disk)
+	}
+	r.Backend = migrationtest.Badger
+	r.AssertBlocks()
+
+	if _, err := Repo(r.Path, "badger", Options{}); err == nil {
+		t.Fatal("converted a repo that already uses the layout")
+	}
+}
+
+func TestRepoRefusesExistingTarget(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(20, 256))
+
+	if err := os.MkdirAll(filepath.Join(r.Path, "badgerds"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Repo(r.Path, "badger", Options{}); err == nil {
+		t.Fatal("expected the conversion to be refused")
+	}
+	r.AssertNotExists(StateFile)
+	r.AssertNotExists("blocks" + OldSuffix)
+	r.AssertBlocks()
+}
+
+func TestRepoFlatfs(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Badger),
+		migrationtest.WithBlocks(30, 256))
+
+	if _, err := Repo(r.Path, "flatfs", Options{VerifyEvery: 1, RemoveOld: true}); err != nil {
+		t.Fatal(err)
+	}
+	r.AssertNotExists("badgerds")
+	r.AssertExists("blocks/SHARDING")
+
+	disk, err := ioutil.ReadFile(filepath.Join(r.Path, "datastore_spec"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"mounts":[{"mountpoint":"/blocks","path":"blocks","shardFunc":"/repo/flatfs/shard/v1/next-to-last/2","type":"flatfs"},{"mountpoint":"/","path":"datastore","type":"levelds"}],"type":"mount"}`
+	if string(disk) != want {
+		t.Fatalf("unexpected datastore_spec %s", disk)
+	}
+	r.Backend = migrationtest.Flatfs
+	r.AssertBlocks()
+}
+
+func TestCopyResume(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Badger),
+		migrationtest.WithBlocks(20, 256))
+	spec, err := LayoutSpec("flatfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, _ = roundtrip(spec)
+
+	// as if interrupted while copying: some keys copied, one of them only
+	// partially written
+	st, _, err := loadState(r.Path, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := st.save(); err != nil {
+		t.Fatal(err)
+	}
+	to, _ := mfsr.SpecMounts(spec)
+	dst, err := openMounts(to, func(m mfsr.Mount) string { return mountDir(r.Path, m) + newSuffix })
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for k, v := range r.Blocks {
+		if n == 0 {
+			v = v[:10]
+		}
+		if err := dst.Put(ds.NewKey("/blocks"+k), v); err != nil {
+			t.Fatal(err)
+		}
+		if n++; n == 5 {
+			break
+		}
+	}
+	dst.Close()
+
+	if _, err := Spec(r.Path, spec, Options{VerifyEvery: 1}); err != nil {
+		t.Fatal(err)
+	}
+	r.Backend = migrationtest.Flatfs
+	r.AssertBlocks()
+}
+
+func TestMoveMount(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(20, 256))
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	disk2 := filepath.Join(dir, "blocks")
+
+	cfg := r.Config()
+	spec, err := MoveMount(cfg["Datastore"].(map[string]interface{})["Spec"].(map[string]interface{}), "/blocks", disk2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPlan(r.Path, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.From) != 1 || len(p.Kept) != 1 || p.Kept[0].Mountpoint != "/" {
+		t.Fatalf("expected only /blocks to be copied, got\n%s", p)
+	}
+
+	if _, err := Spec(r.Path, spec, Options{RemoveOld: true}); err != nil {
+		t.Fatal(err)
+	}
+	r.AssertNotExists("blocks")
+	r.AssertNotExists("datastore" + OldSuffix)
+	if _, err := os.Stat(filepath.Join(disk2, "SHARDING")); err != nil {
+		t.Fatal(err)
+	}
+
+	mounts, err := mfsr.RepoPath(r.Path).Mounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := mfsr.MountFor(mounts, "/blocks"); m.Path() != disk2 {
+		t.Fatalf("/blocks is in %q", m.Path())
+	}
+	d, err := openMounts(mounts, func(m mfsr.Mount) string { return mountDir(r.Path, m) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	for k, want := range r.Blocks {
+		got, err := d.Get(ds.NewKey("/blocks" + k))
+		if err != nil || string(got) != string(want) {
+			t.Fatalf("%s: %v", k, err)
+		}
+	}
+}
+
+func TestFlatfsDirect(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(40, 256))
+
+	cfg := r.Config()
+	spec, _ := roundtrip(cfg["Datastore"].(map[string]interface{})["Spec"].(map[string]interface{}))
+	mounts, err := mfsr.SpecMounts(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks, _ := mfsr.MountFor(mounts, "/blocks")
+	blocks.Spec["shardFunc"] = flatfs.NextToLast(3).String()
+
+	var progress int64
+	res, err := Spec(r.Path, spec, Options{BatchSize: 7, VerifyEvery: 1, Progress: func(n int64) { progress = n }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Keys < 40 || progress != res.Keys {
+		t.Fatalf("copied %d keys, last progress %d", res.Keys, progress)
+	}
+
+	// resharded, and linked to the old files rather than copied
+	oldShard, newShard := flatfs.NextToLast(2).Func(), flatfs.NextToLast(3).Func()
+	for k := range r.Blocks {
+		name := k[1:] + ".data"
+		nfi, err := os.Stat(filepath.Join(r.Path, "blocks", newShard(k[1:]), name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ofi, err := os.Stat(filepath.Join(r.Path, "blocks"+OldSuffix, oldShard(k[1:]), name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !os.SameFile(nfi, ofi) {
+			t.Errorf("%s: copied rather than linked", k)
+		}
+	}
+
+	// streamed when links are not possible
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	from := filepath.Join(dir, "from.data")
+	if err := ioutil.WriteFile(from, []byte("block"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	to := filepath.Join(dir, "shard", "to.data")
+	link, err := copyBlockFile(from, to, false)
+	if err != nil || link {
+		t.Fatalf("copyBlockFile: %v, link %v", err, link)
+	}
+	if data, _ := ioutil.ReadFile(to); string(data) != "block" {
+		t.Errorf("streamed %q", data)
+	}
+}
+
+func TestEstimateKeys(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(50, 256))
+	d, err := Open(r.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := countKeys(d)
+	d.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// exact on a repo this small: every shard and leveldb entry is read
+	got, err := EstimateKeys(r.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("estimated %d keys, want %d", got, want)
+	}
+
+	// from the badger table stats, which count badger's own keys too
+	if _, err := Repo(r.Path, "badger", Options{RemoveOld: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = EstimateKeys(r.Path); err != nil {
+		t.Fatal(err)
+	}
+	if got < want || got > want+2 {
+		t.Errorf("estimated %d keys in badger, want about %d", got, want)
+	}
+}
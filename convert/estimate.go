@@ -0,0 +1,90 @@
+package convert
+
+import (
+	"fmt"
+
+	badger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-badger"
+	leveldb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-leveldb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/util"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// estimateSample is the number of leveldb entries read to estimate their
+// average size.
+const estimateSample = 1000
+
+// EstimateKeys approximates the number of keys in the datastore of the repo
+// at path, for progress, without walking every key: flatfs mounts are
+// estimated from a sample of their shard directories, leveldb mounts from
+// the size of their tables, and badger mounts from the key counts its
+// tables keep, which also count deleted and overwritten keys not yet
+// compacted away. The repo must not be in use.
+func EstimateKeys(path string) (int64, error) {
+	mounts, err := mfsr.RepoPath(path).Mounts()
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, m := range mounts {
+		n, err := estimateMount(m, mountDir(path, m))
+		if err != nil {
+			return total, fmt.Errorf("estimating %s: %w", m.Mountpoint, err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func estimateMount(m mfsr.Mount, dir string) (int64, error) {
+	if m.Type == "flatfs" {
+		est, err := migrate.ShardUsage(dir, migrate.ShardSample)
+		return est.Keys, err
+	}
+	d, err := openMount(m, dir)
+	if err != nil {
+		return 0, err
+	}
+	defer d.Close()
+
+	switch d := d.(type) {
+	case *leveldb.Datastore:
+		return leveldbKeys(d)
+	case *badger.Datastore:
+		var n int64
+		for _, t := range d.DB.Tables(true) {
+			n += int64(t.KeyCount)
+		}
+		return n, nil
+	}
+	return countKeys(d)
+}
+
+// leveldbKeys divides the size of the tables by the average size of the
+// first entries. Compression and entries still only in the journal make
+// it an underestimate, never below the entries read.
+func leveldbKeys(d *leveldb.Datastore) (int64, error) {
+	// every datastore key starts with a slash
+	rnge := util.BytesPrefix([]byte("/"))
+	sizes, err := d.DB.SizeOf([]util.Range{*rnge})
+	if err != nil {
+		return 0, err
+	}
+
+	i := d.DB.NewIterator(rnge, nil)
+	defer i.Release()
+	var n, size int64
+	for n < estimateSample && i.Next() {
+		n++
+		size += int64(len(i.Key()) + len(i.Value()))
+	}
+	if err := i.Error(); err != nil {
+		return 0, err
+	}
+
+	if n < estimateSample || size == 0 || sizes.Sum() <= size {
+		return n, nil
+	}
+	return sizes.Sum() * n / size, nil
+}
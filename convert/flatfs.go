@@ -0,0 +1,155 @@
+package convert
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+const flatfsExtension = ".data"
+
+// flatfsPairs returns the indexes in from and to of the mounts that are
+// flatfs on both sides, whose block files can be copied directly. Keys
+// only stay under the same mount when both sides have the same
+// mountpoints.
+func flatfsPairs(from, to []mfsr.Mount) map[int]int {
+	pairs := make(map[int]int)
+	if !sameMountpoints(from, to) {
+		return pairs
+	}
+	for i, f := range from {
+		if f.Type != "flatfs" {
+			continue
+		}
+		for j, t := range to {
+			if t.Mountpoint == f.Mountpoint && t.Type == "flatfs" {
+				pairs[i] = j
+			}
+		}
+	}
+	return pairs
+}
+
+// copyFlatfs copies the block files of the flatfs datastore in src into a
+// new one in dst, sharded as m says, without reading them through the
+// datastore API: files are hard-linked, which is safe as flatfs never
+// writes to a file in place, or streamed one to the other if dst is on
+// another filesystem. Files already copied by an interrupted run are
+// skipped. Progress is called every batchSize files with the count so far,
+// starting at base.
+func copyFlatfs(src, dst string, m mfsr.Mount, base int64, opts Options) (int64, error) {
+	sf, _ := m.Spec["shardFunc"].(string)
+	id, err := flatfs.ParseShardFunc(sf)
+	if err != nil {
+		return 0, err
+	}
+	if err := flatfs.Create(dst, id); err != nil && err != flatfs.ErrDatastoreExists {
+		return 0, err
+	}
+	shard := id.Func()
+
+	shards, err := ioutil.ReadDir(src)
+	if err != nil {
+		return 0, err
+	}
+	var copied int64
+	link := true
+	for _, s := range shards {
+		if !s.IsDir() {
+			continue
+		}
+		dir := filepath.Join(src, s.Name())
+		names, err := readDirNames(dir)
+		if err != nil {
+			return copied, err
+		}
+		for _, name := range names {
+			if !strings.HasSuffix(name, flatfsExtension) {
+				// e.g. left over from an unfinished write
+				continue
+			}
+			from := filepath.Join(dir, name)
+			to := filepath.Join(dst, shard(strings.TrimSuffix(name, flatfsExtension)), name)
+			if link, err = copyBlockFile(from, to, link); err != nil {
+				return copied, err
+			}
+			copied++
+			if copied%int64(opts.BatchSize) != 0 {
+				continue
+			}
+			if opts.Progress != nil {
+				opts.Progress(base + copied)
+			}
+			opts.Window.Wait(nil)
+		}
+	}
+	if opts.Progress != nil {
+		opts.Progress(base + copied)
+	}
+	return copied, nil
+}
+
+// copyBlockFile copies the block file from to to, by hard link if link is
+// set. It reports whether later files should still be linked: once a link
+// fails, e.g. across filesystems, the rest are streamed.
+func copyBlockFile(from, to string, link bool) (bool, error) {
+	fi, err := os.Stat(from)
+	if err != nil {
+		return link, err
+	}
+	// copied by an interrupted run; streamed files are renamed into
+	// place once complete, so the size only catches odd cases
+	if ti, err := os.Stat(to); err == nil && ti.Size() == fi.Size() {
+		return link, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return link, err
+	}
+	if link {
+		os.Remove(to)
+		if os.Link(from, to) == nil {
+			return true, nil
+		}
+	}
+	return false, streamFile(from, to)
+}
+
+// streamFile copies from to to through a temporary file in the same
+// directory, named like the ones flatfs uses so that it ignores it.
+func streamFile(from, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmp, err := ioutil.TempFile(filepath.Dir(to), "put-")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tmp, in)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), to)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(-1)
+}
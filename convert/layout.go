@@ -0,0 +1,188 @@
+package convert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/mount"
+	badger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-badger"
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+	leveldb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-leveldb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/syndtr/goleveldb/leveldb/opt"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// layouts are the Datastore.Spec values of the layouts a repo can be
+// converted to by name, as set by the ipfs init profiles.
+var layouts = map[string]func() map[string]interface{}{
+	"badger": func() map[string]interface{} {
+		return map[string]interface{}{
+			"type":   "measure",
+			"prefix": "badger.datastore",
+			"child": map[string]interface{}{
+				"type":       "badgerds",
+				"path":       "badgerds",
+				"syncWrites": false,
+				"truncate":   true,
+			},
+		}
+	},
+	"flatfs": func() map[string]interface{} {
+		return map[string]interface{}{
+			"type": "mount",
+			"mounts": []interface{}{
+				map[string]interface{}{
+					"mountpoint": "/blocks",
+					"type":       "measure",
+					"prefix":     "flatfs.datastore",
+					"child": map[string]interface{}{
+						"type":      "flatfs",
+						"path":      "blocks",
+						"sync":      true,
+						"shardFunc": flatfs.IPFS_DEF_SHARD_STR,
+					},
+				},
+				map[string]interface{}{
+					"mountpoint": "/",
+					"type":       "measure",
+					"prefix":     "leveldb.datastore",
+					"child": map[string]interface{}{
+						"type":        "levelds",
+						"path":        "datastore",
+						"compression": "none",
+					},
+				},
+			},
+		}
+	},
+}
+
+// Layouts returns the names of the layouts known to LayoutSpec.
+func Layouts() []string {
+	var names []string
+	for name := range layouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LayoutSpec returns the Datastore.Spec of the named layout.
+func LayoutSpec(name string) (map[string]interface{}, error) {
+	spec, ok := layouts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown datastore layout %q, known: %v", name, Layouts())
+	}
+	return spec(), nil
+}
+
+// DiskSpec returns the datastore_spec file contents for a Datastore.Spec:
+// the parts of the spec that determine what is on disk, which the daemon
+// checks against the config on start.
+func DiskSpec(spec interface{}) (map[string]interface{}, error) {
+	mounts, err := mfsr.SpecMounts(spec)
+	if err != nil {
+		return nil, err
+	}
+	if s, _ := spec.(map[string]interface{}); s["type"] != "mount" {
+		return mountDiskSpec(mounts[0])
+	}
+
+	// the daemon lists mounts by mountpoint, longest prefix first
+	sort.Slice(mounts, func(i, j int) bool { return mounts[i].Mountpoint > mounts[j].Mountpoint })
+	list := make([]interface{}, 0, len(mounts))
+	for _, m := range mounts {
+		d, err := mountDiskSpec(m)
+		if err != nil {
+			return nil, err
+		}
+		d["mountpoint"] = m.Mountpoint
+		list = append(list, d)
+	}
+	return map[string]interface{}{"type": "mount", "mounts": list}, nil
+}
+
+func mountDiskSpec(m mfsr.Mount) (map[string]interface{}, error) {
+	if m.Path() == "" {
+		return nil, fmt.Errorf("%s: datastore has no path", m.Mountpoint)
+	}
+	d := map[string]interface{}{"type": m.Type, "path": m.Path()}
+	switch m.Type {
+	case "flatfs":
+		d["shardFunc"] = m.Spec["shardFunc"]
+	case "levelds", "badgerds":
+	default:
+		return nil, fmt.Errorf("%s: unsupported datastore type %q", m.Mountpoint, m.Type)
+	}
+	return d, nil
+}
+
+// mountDir returns the directory of mount m in the repo at root.
+func mountDir(root string, m mfsr.Mount) string {
+	if filepath.IsAbs(m.Path()) {
+		return m.Path()
+	}
+	return filepath.Join(root, m.Path())
+}
+
+// Open opens the datastore of the repo at path as its Datastore.Spec
+// describes it, with every mount at its mountpoint. Writes are not synced;
+// the caller must Sync before closing. The repo must not be in use.
+func Open(path string) (*mount.Datastore, error) {
+	mounts, err := mfsr.RepoPath(path).Mounts()
+	if err != nil {
+		return nil, err
+	}
+	return openMounts(mounts, func(m mfsr.Mount) string { return mountDir(path, m) })
+}
+
+// openMounts opens the datastores of mounts as one datastore, each in the
+// directory given by dir. Writes are not synced; the caller must Sync
+// before closing.
+func openMounts(mounts []mfsr.Mount, dir func(mfsr.Mount) string) (*mount.Datastore, error) {
+	var opened []mount.Mount
+	closeAll := func() {
+		for _, m := range opened {
+			m.Datastore.Close()
+		}
+	}
+	for _, m := range mounts {
+		d, err := openMount(m, dir(m))
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("opening %s: %w", m.Mountpoint, err)
+		}
+		opened = append(opened, mount.Mount{Prefix: ds.NewKey(m.Mountpoint), Datastore: d})
+	}
+	return mount.New(opened), nil
+}
+
+func openMount(m mfsr.Mount, dir string) (ds.Batching, error) {
+	switch m.Type {
+	case "flatfs":
+		sf, _ := m.Spec["shardFunc"].(string)
+		id, err := flatfs.ParseShardFunc(sf)
+		if err != nil {
+			return nil, err
+		}
+		return flatfs.CreateOrOpen(dir, id, false)
+	case "levelds":
+		var o leveldb.Options
+		if c, _ := m.Spec["compression"].(string); c == "none" {
+			o.Compression = opt.NoCompression
+		}
+		return leveldb.NewDatastore(dir, &o)
+	case "badgerds":
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		o := badger.DefaultOptions
+		o.SyncWrites = false
+		return badger.NewDatastore(dir, &o)
+	}
+	return nil, fmt.Errorf("unsupported datastore type %q", m.Type)
+}
@@ -0,0 +1,146 @@
+package convert
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Plan describes a conversion: the keys of the From mounts are copied into
+// new datastores for the To mounts, and the Kept mounts are left in place.
+type Plan struct {
+	From, To []mfsr.Mount
+	Kept     []mfsr.Mount
+}
+
+func (p Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "copy %s\n", describeMounts(p.From))
+	fmt.Fprintf(&b, "  to %s\n", describeMounts(p.To))
+	if len(p.Kept) > 0 {
+		fmt.Fprintf(&b, "keep %s\n", describeMounts(p.Kept))
+	}
+	return b.String()
+}
+
+func describeMounts(mounts []mfsr.Mount) string {
+	var parts []string
+	for _, m := range mounts {
+		parts = append(parts, fmt.Sprintf("%s (%s in %s)", m.Mountpoint, m.Type, m.Path()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// NewPlan returns the plan for converting the repo at path to spec.
+func NewPlan(path string, spec map[string]interface{}) (Plan, error) {
+	cfg, err := mfsr.RepoPath(path).Config()
+	if err != nil {
+		return Plan{}, err
+	}
+	v, _ := mfsr.ConfigValue(cfg, "Datastore.Spec")
+	current, ok := v.(map[string]interface{})
+	if !ok {
+		return Plan{}, fmt.Errorf("no Datastore.Spec in %s", mfsr.RepoPath(path).ConfigFile())
+	}
+	return plan(current, spec)
+}
+
+// plan works out which mounts must be copied to go from one spec to the
+// other. Mounts are only kept in place when both specs have the same
+// mountpoints; otherwise keys may move between datastores and everything
+// is copied.
+func plan(from, to map[string]interface{}) (Plan, error) {
+	var p Plan
+	src, err := mfsr.SpecMounts(from)
+	if err != nil {
+		return p, err
+	}
+	dst, err := mfsr.SpecMounts(to)
+	if err != nil {
+		return p, err
+	}
+	for _, ms := range [][]mfsr.Mount{src, dst} {
+		for _, m := range ms {
+			if _, err := mountDiskSpec(m); err != nil {
+				return p, err
+			}
+		}
+	}
+
+	if !sameMountpoints(src, dst) {
+		p.From, p.To = src, dst
+		return p, nil
+	}
+	for _, s := range src {
+		d, _ := mfsr.MountFor(dst, s.Mountpoint)
+		sd, _ := mountDiskSpec(s)
+		dd, _ := mountDiskSpec(d)
+		if reflect.DeepEqual(sd, dd) {
+			p.Kept = append(p.Kept, s)
+			continue
+		}
+		p.From = append(p.From, s)
+		p.To = append(p.To, d)
+	}
+	if len(p.From) == 0 {
+		return p, fmt.Errorf("the repo already uses this datastore layout")
+	}
+	return p, nil
+}
+
+func sameMountpoints(a, b []mfsr.Mount) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, m := range a {
+		if _, ok := mfsr.MountFor(b, m.Mountpoint); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTargets makes sure that the directories of the new datastores are
+// free, or will be once the old datastores are moved away, so that the
+// switch over cannot fail half way for that reason.
+func checkTargets(path string, p Plan) error {
+	moved := make(map[string]bool)
+	for _, m := range p.From {
+		moved[mountDir(path, m)] = true
+	}
+	for _, m := range p.To {
+		dir := mountDir(path, m)
+		if moved[dir] {
+			continue
+		}
+		if _, err := os.Stat(dir); err == nil {
+			return fmt.Errorf("%s already exists", dir)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// MoveMount returns a copy of spec with the datastore mounted at
+// mountpoint stored in dir instead, e.g. to move "/blocks" to another disk.
+func MoveMount(spec map[string]interface{}, mountpoint, dir string) (map[string]interface{}, error) {
+	spec, err := roundtrip(spec)
+	if err != nil {
+		return nil, err
+	}
+	mounts, err := mfsr.SpecMounts(spec)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := mfsr.MountFor(mounts, mountpoint)
+	if !ok {
+		return nil, fmt.Errorf("no datastore mounted at %s", mountpoint)
+	}
+	// the mount's spec is part of the copy, so this edits spec
+	m.Spec["path"] = dir
+	return spec, nil
+}
@@ -0,0 +1,109 @@
+// Package daemon detects an ipfs daemon using a repo, so migrations never
+// run against a live repo.
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// APIFile is the file in the repo where a running daemon records the
+// multiaddr of its HTTP API.
+const APIFile = "api"
+
+// ErrRunning is returned, wrapped, when a daemon is using the repo.
+var ErrRunning = errors.New("ipfs daemon is running")
+
+// APIAddr returns the "host:port" of the API recorded in the repo's api
+// file, or "" if there is no api file.
+func APIAddr(repoPath string) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(repoPath, APIFile))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return parseMultiaddr(strings.TrimSpace(string(data)))
+}
+
+// parseMultiaddr converts a TCP multiaddr such as "/ip4/127.0.0.1/tcp/5001"
+// into "127.0.0.1:5001".
+func parseMultiaddr(ma string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(ma, "/"), "/")
+	if len(parts) < 4 || parts[2] != "tcp" {
+		return "", fmt.Errorf("unsupported api address %q", ma)
+	}
+	host := parts[1]
+	switch parts[0] {
+	case "ip4", "ip6", "dns", "dns4", "dns6":
+	default:
+		return "", fmt.Errorf("unsupported api address %q", ma)
+	}
+	if host == "0.0.0.0" {
+		host = "127.0.0.1"
+	} else if host == "::" {
+		host = "::1"
+	}
+	return net.JoinHostPort(host, parts[3]), nil
+}
+
+var client = &http.Client{Timeout: 2 * time.Second}
+
+// Running reports whether a daemon answers on the API address recorded in
+// the repo. A stale api file left by a crashed daemon, with nothing
+// listening, does not count as running.
+func Running(repoPath string) (bool, error) {
+	addr, err := APIAddr(repoPath)
+	if err != nil || addr == "" {
+		return false, err
+	}
+
+	resp, err := client.Post("http://"+addr+"/api/v0/version", "", nil)
+	if err != nil {
+		// nothing listening, or not speaking HTTP
+		return false, nil
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// Check returns an error wrapping ErrRunning if a daemon is using the repo.
+func Check(repoPath string) error {
+	running, err := Running(repoPath)
+	if err != nil {
+		return err
+	}
+	if running {
+		addr, _ := APIAddr(repoPath)
+		return fmt.Errorf("%w with API at %s; stop it before migrating", ErrRunning, addr)
+	}
+	return nil
+}
+
+// WaitStopped polls every interval until no daemon is using the repo. It
+// gives up with the last error once timeout has passed, or waits forever if
+// timeout is zero.
+func WaitStopped(repoPath string, interval, timeout time.Duration) error {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	for {
+		err := Check(repoPath)
+		if err == nil || !errors.Is(err, ErrRunning) {
+			return err
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(interval)
+	}
+}
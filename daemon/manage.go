@@ -0,0 +1,162 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Controller stops and starts the daemon using a repo, so that an upgrade
+// can stop the node, migrate its repo and start it again in one command.
+type Controller interface {
+	// Running reports whether the daemon is running.
+	Running() (bool, error)
+	// Stop asks the daemon to shut down, without waiting for it.
+	Stop() error
+	// Start starts the daemon, without waiting for it to be ready.
+	Start() error
+	String() string
+}
+
+// API controls a daemon through its HTTP API, and starts it by running a
+// command, by default "ipfs daemon".
+type API struct {
+	RepoPath string
+	// Command starts the daemon. Empty means "ipfs daemon".
+	Command []string
+	// Log receives the output of the started daemon. May be nil.
+	Log io.Writer
+}
+
+func (a API) Running() (bool, error) {
+	return Running(a.RepoPath)
+}
+
+// Stop asks the daemon to shut down with the shutdown command.
+func (a API) Stop() error {
+	addr, err := APIAddr(a.RepoPath)
+	if err != nil {
+		return err
+	}
+	if addr == "" {
+		return fmt.Errorf("no %s file in %s", APIFile, a.RepoPath)
+	}
+	resp, err := client.Post("http://"+addr+"/api/v0/shutdown", "", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("shutdown: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a API) command() []string {
+	if len(a.Command) == 0 {
+		return []string{"ipfs", "daemon"}
+	}
+	return a.Command
+}
+
+// Start runs the command in the background with IPFS_PATH set to the repo.
+// The daemon keeps running after this process exits.
+func (a API) Start() error {
+	args := a.command()
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), "IPFS_PATH="+a.RepoPath)
+	cmd.Stdout = a.Log
+	cmd.Stderr = a.Log
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// not waited for; let go of it
+	return cmd.Process.Release()
+}
+
+func (a API) String() string {
+	return fmt.Sprintf("daemon of %s (%s)", a.RepoPath, strings.Join(a.command(), " "))
+}
+
+// Systemd controls a daemon run as a systemd unit.
+type Systemd struct {
+	Unit string
+	// User controls the unit in the user's service manager.
+	User bool
+}
+
+func (s Systemd) systemctl(args ...string) *exec.Cmd {
+	if s.User {
+		args = append([]string{"--user"}, args...)
+	}
+	return exec.Command("systemctl", append(args, s.Unit)...)
+}
+
+func (s Systemd) run(args ...string) error {
+	out, err := s.systemctl(args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("systemctl %s %s: %w: %s", strings.Join(args, " "), s.Unit, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (s Systemd) Running() (bool, error) {
+	err := s.systemctl("is-active", "--quiet").Run()
+	var exit *exec.ExitError
+	if errors.As(err, &exit) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s Systemd) Stop() error {
+	return s.run("stop")
+}
+
+func (s Systemd) Start() error {
+	return s.run("start")
+}
+
+func (s Systemd) String() string {
+	return "systemd unit " + s.Unit
+}
+
+// StopAndWait stops the daemon with c, if it is running, and waits up to
+// timeout until it no longer answers on the repo's API. It reports whether
+// the daemon was running, so it is only started again if it was.
+func StopAndWait(c Controller, repoPath string, timeout time.Duration) (bool, error) {
+	running, err := c.Running()
+	if err != nil || !running {
+		return false, err
+	}
+	if err := c.Stop(); err != nil {
+		return true, fmt.Errorf("stopping %s: %w", c, err)
+	}
+	if err := WaitStopped(repoPath, time.Second, timeout); err != nil {
+		return true, fmt.Errorf("waiting for %s to stop: %w", c, err)
+	}
+	return true, nil
+}
+
+// StartAndWait starts the daemon with c and waits up to timeout until it
+// answers on the repo's API.
+func StartAndWait(c Controller, repoPath string, timeout time.Duration) error {
+	if err := c.Start(); err != nil {
+		return fmt.Errorf("starting %s: %w", c, err)
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		running, err := Running(repoPath)
+		if err != nil || running {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s did not answer on its API within %s", c, timeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
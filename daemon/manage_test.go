@@ -0,0 +1,53 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestStopAndWait(t *testing.T) {
+	dir, err := ioutil.TempDir("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	ma := "/ip4/127.0.0.1/tcp/" + strconv.Itoa(port)
+	if err := ioutil.WriteFile(filepath.Join(dir, APIFile), []byte(ma), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := &http.Server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v0/version", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/api/v0/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		go srv.Close()
+	})
+	srv.Handler = mux
+	go srv.Serve(ln)
+
+	c := API{RepoPath: dir}
+	running, err := StopAndWait(c, dir, 10*time.Second)
+	if err != nil || !running {
+		t.Fatalf("StopAndWait = %v, %v", running, err)
+	}
+	if running, _ := Running(dir); running {
+		t.Error("daemon still running")
+	}
+
+	// nothing to stop
+	if running, err := StopAndWait(c, dir, time.Second); err != nil || running {
+		t.Errorf("StopAndWait on a stopped daemon = %v, %v", running, err)
+	}
+}
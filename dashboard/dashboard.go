@@ -0,0 +1,295 @@
+// Package dashboard serves a small web page showing the progress of a
+// running migration: the latest progress line, the rate of every telemetry
+// counter over the last minutes, recent log lines, and controls to pause
+// and resume the work. The page is part of the binary and loads nothing
+// else; it polls the status as JSON.
+//
+// Scripts can use the same endpoints, GET /status and POST /pause, /resume
+// and /abort; orchestration systems have the gRPC service of package
+// control. POSTs must carry the dashboard's token in the X-Dashboard-Token
+// header and must not come from another origin, so that web pages open in
+// the operator's browser cannot work the controls.
+package dashboard
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// HistorySeconds is how far back counter rates are kept.
+const HistorySeconds = 300
+
+// LogLines is the number of recent log lines kept.
+const LogLines = 200
+
+// TokenHeader is the header carrying the token on POSTs.
+const TokenHeader = "X-Dashboard-Token"
+
+// Dashboard collects telemetry and log records for the page. It is a
+// migrate.Telemetry, forwarding to Next, and a stump.Sink, to be set as the
+// tap of the logger.
+type Dashboard struct {
+	// Next receives the telemetry as well. May be nil.
+	Next migrate.Telemetry
+	// Pause is worked by the page's controls. May be nil, disabling them.
+	Pause *migrate.Pause
+	// Abort stops the migration, waiting for it to wind down. May be nil,
+	// disabling /abort.
+	Abort func()
+	// Token must be sent with every POST. New sets a random one; the page
+	// takes it from the token parameter of its URL, see URL.
+	Token string
+
+	mu        sync.Mutex
+	started   time.Time
+	migration string
+	latest    string
+	counters  map[string]*series
+	logs      []log.Record
+	errors    int
+	events    map[chan log.Record]struct{}
+}
+
+// New returns a dashboard controlling pause, with a random token.
+func New(pause *migrate.Pause, next migrate.Telemetry) *Dashboard {
+	var token [16]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		panic(err)
+	}
+	return &Dashboard{
+		Next:     next,
+		Pause:    pause,
+		Token:    hex.EncodeToString(token[:]),
+		started:  time.Now(),
+		counters: map[string]*series{},
+		events:   map[chan log.Record]struct{}{},
+	}
+}
+
+// URL returns the address of the page served at addr, with the token.
+func (d *Dashboard) URL(addr string) string {
+	return "http://" + addr + "/?token=" + url.QueryEscape(d.Token)
+}
+
+// allowPost reports whether r is a POST with the token from the same
+// origin, answering the request if not.
+func (d *Dashboard) allowPost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return false
+	}
+	if o := r.Header.Get("Origin"); o != "" {
+		if u, err := url.Parse(o); err != nil || u.Host != r.Host {
+			http.Error(w, "cross-origin request", http.StatusForbidden)
+			return false
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(TokenHeader)), []byte(d.Token)) != 1 || d.Token == "" {
+		http.Error(w, "missing or wrong token", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// series counts events per second over the last HistorySeconds.
+type series struct {
+	total  int64
+	last   int64 // unix second of the newest slot
+	counts [HistorySeconds]int64
+}
+
+// advance moves the newest slot to sec, clearing the slots skipped.
+func (s *series) advance(sec int64) {
+	if sec-s.last >= HistorySeconds {
+		s.counts = [HistorySeconds]int64{}
+		s.last = sec
+		return
+	}
+	for s.last < sec {
+		s.last++
+		s.counts[s.last%HistorySeconds] = 0
+	}
+}
+
+func (s *series) add(sec, n int64) {
+	s.advance(sec)
+	if s.last-sec < HistorySeconds {
+		s.counts[sec%HistorySeconds] += n
+	}
+	s.total += n
+}
+
+// rates returns the counts per second up to sec, oldest first, leaving out
+// the second in progress.
+func (s *series) rates(sec int64) []int64 {
+	s.advance(sec)
+	r := make([]int64, 0, HistorySeconds-1)
+	for t := sec - HistorySeconds + 1; t < sec; t++ {
+		r = append(r, s.counts[t%HistorySeconds])
+	}
+	return r
+}
+
+func (d *Dashboard) Count(name string, delta int64) {
+	d.mu.Lock()
+	s, ok := d.counters[name]
+	if !ok {
+		s = &series{last: time.Now().Unix()}
+		d.counters[name] = s
+	}
+	s.add(time.Now().Unix(), delta)
+	d.mu.Unlock()
+	if d.Next != nil {
+		d.Next.Count(name, delta)
+	}
+}
+
+func (d *Dashboard) Timing(name string, dur time.Duration) {
+	if d.Next != nil {
+		d.Next.Timing(name, dur)
+	}
+}
+
+func (d *Dashboard) Error(name string, err error) {
+	d.mu.Lock()
+	d.errors++
+	d.mu.Unlock()
+	if d.Next != nil {
+		d.Next.Error(name, err)
+	}
+}
+
+// Log keeps rec for the page.
+func (d *Dashboard) Log(rec log.Record) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if rec.Migration != "" {
+		d.migration = rec.Migration
+	}
+	if rec.Level == log.LevelInfo {
+		d.latest = rec.Message
+	}
+	if len(d.logs) == LogLines {
+		copy(d.logs, d.logs[1:])
+		d.logs = d.logs[:LogLines-1]
+	}
+	d.logs = append(d.logs, rec)
+	for ch := range d.events {
+		select {
+		case ch <- rec:
+		default: // a slow reader misses records rather than slowing the migration
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the log records from now on, and a
+// func to stop receiving them. Records are dropped rather than slowing the
+// migration when the channel is full.
+func (d *Dashboard) Subscribe() (<-chan log.Record, func()) {
+	ch := make(chan log.Record, LogLines)
+	d.mu.Lock()
+	d.events[ch] = struct{}{}
+	d.mu.Unlock()
+	return ch, func() {
+		d.mu.Lock()
+		delete(d.events, ch)
+		d.mu.Unlock()
+	}
+}
+
+// Counter is the state of one telemetry counter.
+type Counter struct {
+	Name  string `json:"name"`
+	Total int64  `json:"total"`
+	// Rates are the counts of each of the last seconds, oldest first.
+	Rates []int64 `json:"rates"`
+}
+
+// Status is what the page shows.
+type Status struct {
+	Migration string        `json:"migration"`
+	Latest    string        `json:"latest"`
+	Paused    bool          `json:"paused"`
+	Pausable  bool          `json:"pausable"`
+	Abortable bool          `json:"abortable"`
+	Uptime    time.Duration `json:"uptime_ns"`
+	Errors    int           `json:"errors"`
+	Counters  []Counter     `json:"counters"`
+	Logs      []log.Record  `json:"logs"`
+}
+
+// Status returns the current status.
+func (d *Dashboard) Status() Status {
+	// asked first: pausing logs, which locks d
+	paused := d.Pause.Paused()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	st := Status{
+		Migration: d.migration,
+		Latest:    d.latest,
+		Paused:    paused,
+		Pausable:  d.Pause != nil,
+		Abortable: d.Abort != nil,
+		Uptime:    time.Since(d.started),
+		Errors:    d.errors,
+		Logs:      append([]log.Record(nil), d.logs...),
+	}
+	now := time.Now().Unix()
+	for name, s := range d.counters {
+		st.Counters = append(st.Counters, Counter{Name: name, Total: s.total, Rates: s.rates(now)})
+	}
+	sort.Slice(st.Counters, func(i, j int) bool { return st.Counters[i].Name < st.Counters[j].Name })
+	return st
+}
+
+// ServeHTTP serves the page at / and the status at /status. It pauses, resumes and aborts work on POST to /pause,
+// /resume and /abort, given the token.
+func (d *Dashboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(page))
+	case "/status":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(d.Status())
+	case "/pause", "/resume":
+		if !d.allowPost(w, r) {
+			return
+		}
+		if d.Pause == nil {
+			http.Error(w, "pausing is not available", http.StatusNotImplemented)
+			return
+		}
+		if r.URL.Path == "/pause" {
+			d.Pause.Pause()
+		} else {
+			d.Pause.Resume()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "/abort":
+		if !d.allowPost(w, r) {
+			return
+		}
+		if d.Abort == nil {
+			http.Error(w, "aborting is not available", http.StatusNotImplemented)
+			return
+		}
+		log.Warn("abort requested from the dashboard")
+		// the shutdown may outlast the request
+		go d.Abort()
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		http.NotFound(w, r)
+	}
+}
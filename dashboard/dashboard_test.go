@@ -0,0 +1,161 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func TestSeries(t *testing.T) {
+	var s series
+	s.last = 1000
+	s.add(1000, 5)
+	s.add(1001, 2)
+	s.add(1001, 1)
+	r := s.rates(1002)
+	if n := len(r); n != HistorySeconds-1 || r[n-2] != 5 || r[n-1] != 3 || s.total != 8 {
+		t.Fatalf("rates end in %v, total %d", r[len(r)-3:], s.total)
+	}
+	// long idle: everything has scrolled out
+	if r := s.rates(1002 + 2*HistorySeconds); r[len(r)-1] != 0 || r[len(r)-2] != 0 {
+		t.Errorf("stale rates %v", r[len(r)-3:])
+	}
+}
+
+// post posts to the dashboard at url with its token.
+func post(d *Dashboard, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(TokenHeader, d.Token)
+	return http.DefaultClient.Do(req)
+}
+
+func TestDashboard(t *testing.T) {
+	pause := migrate.NewPause()
+	d := New(pause, nil)
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	d.Count("mg1.blocks_moved", 10)
+	d.Log(log.Record{Level: log.LevelInfo, Time: time.Now(), Migration: "1-to-2", Message: "moving objects: 10"})
+
+	get := func() Status {
+		resp, err := http.Get(srv.URL + "/status")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var st Status
+		if err := json.NewDecoder(resp.Body).Decode(&st); err != nil {
+			t.Fatal(err)
+		}
+		return st
+	}
+	st := get()
+	if st.Migration != "1-to-2" || st.Latest != "moving objects: 10" || len(st.Logs) != 1 {
+		t.Errorf("status %+v", st)
+	}
+	if len(st.Counters) != 1 || st.Counters[0].Total != 10 {
+		t.Errorf("counters %+v", st.Counters)
+	}
+
+	if resp, err := post(d, srv.URL+"/pause"); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("pause: %v %v", resp, err)
+	}
+	if !pause.Paused() || !get().Paused {
+		t.Error("not paused")
+	}
+	if resp, _ := http.Get(srv.URL + "/resume"); resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /resume: %s", resp.Status)
+	}
+	if _, err := post(d, srv.URL+"/resume"); err != nil || pause.Paused() {
+		t.Errorf("not resumed: %v", err)
+	}
+
+	resp, err := http.Get(srv.URL + "/")
+	if err != nil || resp.Header.Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Errorf("page: %v %v", resp, err)
+	}
+}
+
+func TestEventsAndAbort(t *testing.T) {
+	aborted := make(chan struct{})
+	d := New(nil, nil)
+	d.Abort = func() { close(aborted) }
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	ch, cancel := d.Subscribe()
+	d.Log(log.Record{Level: log.LevelWarn, Message: "first"})
+	d.Log(log.Record{Level: log.LevelInfo, Message: "second"})
+	cancel()
+	d.Log(log.Record{Level: log.LevelInfo, Message: "after cancel"})
+	for _, want := range []string{"first", "second"} {
+		if rec := <-ch; rec.Message != want {
+			t.Fatalf("got %q, want %q", rec.Message, want)
+		}
+	}
+	if len(ch) != 0 {
+		t.Error("record received after cancel")
+	}
+
+	if resp, err := post(d, srv.URL+"/pause"); err != nil || resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("pause without a Pause: %v %v", resp, err)
+	}
+	if resp, err := post(d, srv.URL+"/abort"); err != nil || resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("abort: %v %v", resp, err)
+	}
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Abort not called")
+	}
+}
+
+func TestControlsNeedToken(t *testing.T) {
+	pause := migrate.NewPause()
+	d := New(pause, nil)
+	d.Abort = func() { t.Error("aborted") }
+	srv := httptest.NewServer(d)
+	defer srv.Close()
+
+	for _, c := range []struct {
+		name, token, origin string
+	}{
+		{"no token", "", ""},
+		{"wrong token", "x" + d.Token, ""},
+		{"other origin", d.Token, "http://example.com"},
+	} {
+		for _, path := range []string{"/pause", "/abort"} {
+			req, _ := http.NewRequest(http.MethodPost, srv.URL+path, nil)
+			req.Header.Set(TokenHeader, c.token)
+			if c.origin != "" {
+				req.Header.Set("Origin", c.origin)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil || resp.StatusCode != http.StatusForbidden {
+				t.Errorf("%s %s: %v %v", c.name, path, resp, err)
+			}
+		}
+	}
+	if pause.Paused() {
+		t.Error("paused without the token")
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/pause", nil)
+	req.Header.Set(TokenHeader, d.Token)
+	req.Header.Set("Origin", srv.URL)
+	if resp, err := http.DefaultClient.Do(req); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Errorf("same origin: %v %v", resp, err)
+	}
+	if u := d.URL("127.0.0.1:5050"); u != "http://127.0.0.1:5050/?token="+d.Token || len(d.Token) != 32 {
+		t.Errorf("URL %s", u)
+	}
+}
@@ -0,0 +1,95 @@
+package dashboard
+
+// page is the dashboard, self-contained so the binary needs no assets.
+const page = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>fs-repo-migrations</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.3em; }
+#latest { font-family: monospace; padding: .5em; background: #f3f3f3; }
+.counter { display: inline-block; margin: 0 1.5em 1em 0; }
+.counter svg { background: #f8f8f8; border: 1px solid #ddd; }
+#logs { font-family: monospace; font-size: .85em; white-space: pre-wrap; max-height: 30em; overflow-y: auto; border: 1px solid #ddd; padding: .5em; }
+.warn { color: #a60; } .error { color: #c00; }
+button { font-size: 1em; padding: .3em 1em; }
+</style>
+</head>
+<body>
+<h1>Migration <span id="migration">-</span> <span id="state"></span></h1>
+<p><button id="toggle" hidden></button> <button id="abort" hidden>Abort</button> <span id="uptime"></span> <span id="errors"></span></p>
+<div id="latest"></div>
+<h2>Throughput, per second</h2>
+<div id="counters"></div>
+<h2>Log</h2>
+<div id="logs"></div>
+<script>
+var paused = false;
+function el(id) { return document.getElementById(id); }
+
+function spark(rates) {
+	var w = 300, h = 60, max = Math.max.apply(null, rates.concat([1]));
+	var pts = rates.map(function (r, i) {
+		return (i * w / (rates.length - 1)).toFixed(1) + "," + (h - r * (h - 2) / max).toFixed(1);
+	}).join(" ");
+	return '<svg width="' + w + '" height="' + h + '"><polyline fill="none" stroke="#36c" points="' + pts + '"/></svg>';
+}
+
+function text(s) {
+	var d = document.createElement("div");
+	d.textContent = s;
+	return d.innerHTML;
+}
+
+function render(st) {
+	paused = st.paused;
+	el("migration").textContent = st.migration || "-";
+	el("state").textContent = st.paused ? "(paused)" : "";
+	el("toggle").hidden = !st.pausable;
+	el("toggle").textContent = st.paused ? "Resume" : "Pause";
+	el("abort").hidden = !st.abortable;
+	el("uptime").textContent = "running for " + Math.round(st.uptime_ns / 1e9) + "s";
+	el("errors").textContent = st.errors ? st.errors + " errors" : "";
+	el("latest").textContent = st.latest;
+	el("counters").innerHTML = (st.counters || []).map(function (c) {
+		var recent = c.rates.slice(-10), sum = recent.reduce(function (a, b) { return a + b; }, 0);
+		return '<div class="counter"><b>' + text(c.name) + '</b> ' + c.total + ' total, ' +
+			(sum / recent.length).toFixed(1) + '/s<br>' + spark(c.rates) + '</div>';
+	}).join("");
+	var logs = el("logs"), atEnd = logs.scrollTop + logs.clientHeight >= logs.scrollHeight - 5;
+	logs.innerHTML = (st.logs || []).map(function (r) {
+		return '<div class="' + r.level + '">' + r.time.substr(11, 8) + " " + text(r.message) + "</div>";
+	}).join("");
+	if (atEnd) { logs.scrollTop = logs.scrollHeight; }
+}
+
+var token = new URLSearchParams(location.search).get("token") || "";
+
+function post(path) {
+	return fetch(path, { method: "POST", headers: { "X-Dashboard-Token": token } }).then(function (r) {
+		if (r.status === 403) { alert("The controls need the dashboard URL printed at startup, with its token."); }
+	});
+}
+
+function poll() {
+	fetch("status").then(function (r) { return r.json(); }).then(render).catch(function () {
+		el("state").textContent = "(not responding)";
+	});
+}
+
+el("toggle").onclick = function () {
+	post(paused ? "resume" : "pause").then(poll);
+};
+el("abort").onclick = function () {
+	if (confirm("Stop the migration at the next checkpoint?")) {
+		post("abort").then(poll);
+	}
+};
+poll();
+setInterval(poll, 1000);
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/repodiff"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// errReposDiffer makes the diff command exit with an error status when
+// the repos are not the same, so that scripts can check a rehearsal.
+var errReposDiffer = errors.New("the repos differ")
+
+// runDiff compares two repos, e.g. a rehearsal migration with the expected
+// result, or a restored snapshot with the repo it was taken of.
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	sample := fs.Int("sample", repodiff.DefaultSample, "compare the contents of this many blocks held by both repos, -1 for all")
+	asJSON := fs.Bool("json", false, "print the differences as JSON")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for running ipfs daemons to exit instead of refusing to read the repos")
+	fs.Usage = func() {
+		fmt.Println("Usage: fs-repo-migrations diff [flags] <repoA> <repoB>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return fmt.Errorf("diff: need two repos")
+	}
+	a, b := fs.Arg(0), fs.Arg(1)
+	for _, p := range []string{a, b} {
+		if _, err := os.Stat(mfsr.RepoPath(p).ConfigFile()); err != nil {
+			return fmt.Errorf("diff: %s is not an ipfs repo: %w", p, err)
+		}
+		if err := gomigrate.CheckDaemon(p, *waitDaemon); err != nil {
+			return err
+		}
+	}
+
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	r, err := repodiff.Diff(a, b, repodiff.Options{
+		Sample: *sample,
+		Progress: func(keys int64) {
+			progress.Update("read %d keys", keys)
+		},
+	})
+	progress.Done()
+	if err != nil {
+		return fmt.Errorf("diff: %w", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	} else {
+		printDiff(r)
+	}
+	if !r.Same() {
+		return errReposDiffer
+	}
+	return nil
+}
+
+func printDiff(r repodiff.Report) {
+	if r.VersionA != r.VersionB {
+		fmt.Printf("version: %d in A, %d in B\n", r.VersionA, r.VersionB)
+	}
+	for _, c := range r.Config {
+		fmt.Printf("config:  %s\n", c)
+	}
+	fmt.Printf("keys:    %d in both, %d only in A, %d only in B\n", r.Common, r.OnlyA, r.OnlyB)
+	for _, k := range r.OnlyAKeys {
+		fmt.Printf("  only in A: %s\n", k)
+	}
+	if n := r.OnlyA - int64(len(r.OnlyAKeys)); n > 0 {
+		fmt.Printf("  and %d more only in A\n", n)
+	}
+	for _, k := range r.OnlyBKeys {
+		fmt.Printf("  only in B: %s\n", k)
+	}
+	if n := r.OnlyB - int64(len(r.OnlyBKeys)); n > 0 {
+		fmt.Printf("  and %d more only in B\n", n)
+	}
+	fmt.Printf("blocks:  %d sampled, %d differ\n", r.Sampled, len(r.Differ))
+	for _, k := range r.Differ {
+		fmt.Printf("  differs: %s\n", k)
+	}
+	if r.Same() {
+		fmt.Println("the repos are the same")
+	}
+}
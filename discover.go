@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// discovered is one line of discover's JSON output.
+type discovered struct {
+	mfsr.ScannedRepo
+	Error string `json:"error,omitempty"`
+}
+
+func runDiscover(args []string) error {
+	fs := flag.NewFlagSet("discover", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print each repo as a line of JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: fs-repo-migrations discover [flags] [dir...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	roots := fs.Args()
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for _, root := range roots {
+		repos, err := mfsr.Scan(root)
+		if err != nil {
+			return err
+		}
+		for _, r := range repos {
+			switch {
+			case *asJSON:
+				d := discovered{ScannedRepo: r}
+				if r.Err != nil {
+					d.Error = r.Err.Error()
+				}
+				if err := enc.Encode(d); err != nil {
+					return err
+				}
+			case r.Err != nil:
+				fmt.Printf("%s\t?\t%s\n", r.Path, r.Err)
+			default:
+				fmt.Printf("%s\t%d\n", r.Path, r.Version)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+// Package dist downloads releases from the IPFS distribution index, as
+// published at dist.ipfs.io: each distribution, e.g. "fs-repo-migrations",
+// has a versions file listing its releases and, for each release, a
+// dist.json naming the archive of every platform with its CID and sha512.
+//
+// Downloads are tried on each mirror in turn, either a plain HTTPS copy of
+// the index or an IPFS gateway serving it under /ipns/, and are kept in a
+// cache directory named after their sha512, so an archive is only ever
+// downloaded once whichever mirror served it. An interrupted download is
+// resumed with a range request.
+//
+// A Client with a Verifier only accepts a dist.json whose detached
+// signature verifies with a maintainer key, see sign.go, so an archive,
+// checked against the sha512 in it, is known to come from the maintainers
+// before anything in it is run.
+package dist
+
+import (
+	"bufio"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultMirrors are used when no mirrors are configured: the index over
+// HTTPS, then through the ipfs.io gateway.
+var DefaultMirrors = []string{
+	"https://dist.ipfs.io",
+	"https://ipfs.io/ipns/dist.ipfs.io",
+}
+
+// MirrorsEnv overrides DefaultMirrors with a comma-separated list.
+const MirrorsEnv = "IPFS_DIST_MIRRORS"
+
+// partSuffix marks a download in progress in the cache.
+const partSuffix = ".part"
+
+// ErrNotFound is returned, wrapped, for platforms a release does not have
+// and for files the mirrors do not have.
+var ErrNotFound = errors.New("not in the distribution index")
+
+// Artifact is the archive of a release for one platform.
+type Artifact struct {
+	// Link is the path of the archive relative to the release.
+	Link   string `json:"link"`
+	CID    string `json:"cid"`
+	SHA512 string `json:"sha512"`
+}
+
+// Platform lists the archives of a release for one OS, by architecture.
+type Platform struct {
+	Name  string              `json:"name"`
+	Archs map[string]Artifact `json:"archs"`
+}
+
+// Index is the dist.json of a release.
+type Index struct {
+	ID        string              `json:"id"`
+	Version   string              `json:"version"`
+	Platforms map[string]Platform `json:"platforms"`
+}
+
+// Artifact returns the archive for goos and goarch.
+func (ix Index) Artifact(goos, goarch string) (Artifact, error) {
+	a, ok := ix.Platforms[goos].Archs[goarch]
+	if !ok {
+		return a, fmt.Errorf("%s %s for %s-%s: %w", ix.ID, ix.Version, goos, goarch, ErrNotFound)
+	}
+	return a, nil
+}
+
+// Mirror is a copy of the distribution index.
+type Mirror struct {
+	// Base is the URL the index is found under.
+	Base string
+	// Gateway is the root of the IPFS gateway serving the index, if it is
+	// one, where archives can also be fetched by CID.
+	Gateway string
+}
+
+// ParseMirror parses the URL of a mirror. A URL whose path starts with
+// /ipns/ is taken for an IPFS gateway.
+func ParseMirror(s string) (Mirror, error) {
+	u, err := url.Parse(strings.TrimSuffix(s, "/"))
+	if err != nil {
+		return Mirror{}, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return Mirror{}, fmt.Errorf("mirror %q: not an http or https URL", s)
+	}
+	m := Mirror{Base: u.String()}
+	if strings.HasPrefix(u.Path, "/ipns/") {
+		m.Gateway = u.Scheme + "://" + u.Host
+	}
+	return m, nil
+}
+
+// Client downloads from the distribution index.
+type Client struct {
+	Mirrors []Mirror
+	// CacheDir holds the downloaded archives and partial downloads.
+	CacheDir string
+	// HTTP is the client requests are made with. May be nil, meaning
+	// http.DefaultClient.
+	HTTP *http.Client
+	// Verifier, if not nil, checks the signature of every dist.json, so
+	// that only archives published by the maintainers are accepted.
+	Verifier *Verifier
+}
+
+// NewClient returns a client for the given mirror URLs, or for
+// $IPFS_DIST_MIRRORS or DefaultMirrors if there are none, caching in
+// cacheDir, or in the user's cache directory if it is empty.
+func NewClient(mirrors []string, cacheDir string) (*Client, error) {
+	if len(mirrors) == 0 {
+		mirrors = DefaultMirrors
+		if env := os.Getenv(MirrorsEnv); env != "" {
+			mirrors = strings.Split(env, ",")
+		}
+	}
+	c := &Client{CacheDir: cacheDir}
+	for _, s := range mirrors {
+		m, err := ParseMirror(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		c.Mirrors = append(c.Mirrors, m)
+	}
+	if c.CacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		c.CacheDir = filepath.Join(dir, "fs-repo-migrations")
+	}
+	return c, nil
+}
+
+func (c *Client) http() *http.Client {
+	if c.HTTP == nil {
+		return http.DefaultClient
+	}
+	return c.HTTP
+}
+
+// Versions returns the releases of distribution dist, oldest first.
+func (c *Client) Versions(dist string) ([]string, error) {
+	var versions []string
+	err := c.eachMirror(func(m Mirror) error {
+		body, err := c.get(m.Base + "/" + dist + "/versions")
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+		versions = nil
+		s := bufio.NewScanner(body)
+		for s.Scan() {
+			if v := strings.TrimSpace(s.Text()); v != "" {
+				versions = append(versions, v)
+			}
+		}
+		return s.Err()
+	})
+	return versions, err
+}
+
+// Index returns the dist.json of release version of distribution dist,
+// checking its signature if c has a Verifier.
+func (c *Client) Index(dist, version string) (Index, error) {
+	var ix Index
+	err := c.eachMirror(func(m Mirror) error {
+		u := m.Base + "/" + dist + "/" + version + "/dist.json"
+		data, err := c.read(u)
+		if err != nil {
+			return err
+		}
+		if c.Verifier != nil {
+			sig, err := c.read(u + SigSuffix)
+			if err != nil {
+				return err
+			}
+			if err := c.Verifier.Verify(data, sig); err != nil {
+				return fmt.Errorf("%s: %w", u, err)
+			}
+		}
+		ix = Index{}
+		return json.Unmarshal(data, &ix)
+	})
+	return ix, err
+}
+
+// Fetch returns the path of the archive of release version of distribution
+// dist for goos and goarch in the cache, downloading it first if it is not
+// there. The archive is checked against its sha512 in the index.
+func (c *Client) Fetch(dist, version, goos, goarch string) (string, error) {
+	ix, err := c.Index(dist, version)
+	if err != nil {
+		return "", err
+	}
+	a, err := ix.Artifact(goos, goarch)
+	if err != nil {
+		return "", err
+	}
+	sum, err := hex.DecodeString(a.SHA512)
+	if err != nil || len(sum) != sha512.Size {
+		return "", fmt.Errorf("%s %s: invalid sha512 %q in the index", dist, version, a.SHA512)
+	}
+
+	path := filepath.Join(c.CacheDir, "sha512-"+a.SHA512+filepath.Ext(a.Link))
+	if ok, err := checkFile(path, a.SHA512); ok || err != nil {
+		return path, err
+	}
+	if err := os.MkdirAll(c.CacheDir, 0755); err != nil {
+		return "", err
+	}
+
+	err = c.eachMirror(func(m Mirror) error {
+		urls := []string{m.Base + "/" + dist + "/" + version + "/" + strings.TrimPrefix(a.Link, "/")}
+		if m.Gateway != "" && a.CID != "" {
+			urls = append(urls, m.Gateway+"/ipfs/"+a.CID)
+		}
+		var errs []string
+		for _, u := range urls {
+			err := c.download(u, path, a.SHA512)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return errors.New(strings.Join(errs, "; "))
+	})
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// eachMirror calls fn with each mirror until it succeeds.
+func (c *Client) eachMirror(fn func(Mirror) error) error {
+	if len(c.Mirrors) == 0 {
+		return errors.New("no distribution mirrors configured")
+	}
+	var errs []string
+	var err error
+	for _, m := range c.Mirrors {
+		if err = fn(m); err == nil {
+			return nil
+		}
+		err = fmt.Errorf("%s: %w", m.Base, err)
+		errs = append(errs, err.Error())
+	}
+	// the last error is wrapped, so callers can tell e.g. ErrNotFound
+	prev := ""
+	if n := len(errs); n > 1 {
+		prev = strings.Join(errs[:n-1], ", ") + ", "
+	}
+	return fmt.Errorf("all mirrors failed: %s%w", prev, err)
+}
+
+func (c *Client) get(u string) (io.ReadCloser, error) {
+	resp, err := c.http().Get(u)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, statusError(u, resp)
+	}
+	return resp.Body, nil
+}
+
+// read returns the body of u.
+func (c *Client) read(u string) ([]byte, error) {
+	body, err := c.get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func statusError(u string, resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", u, ErrNotFound)
+	}
+	return fmt.Errorf("%s: %s", u, resp.Status)
+}
+
+// download fetches u to path, resuming the partial download left by an
+// earlier attempt, if any, and keeps it only if its sha512 is want.
+func (c *Client) download(u, path, want string) error {
+	part := path + partSuffix
+	f, err := os.OpenFile(part, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha512.New()
+	have, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	if have > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", have))
+	}
+	resp, err := c.http().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && have > 0:
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && have > 0:
+		// the partial download is complete, or too long to be ours
+	case resp.StatusCode == http.StatusOK:
+		// the range was ignored: start over
+		if err := restart(f, &h); err != nil {
+			return err
+		}
+	default:
+		return statusError(u, resp)
+	}
+
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		if _, err := io.Copy(io.MultiWriter(f, h), resp.Body); err != nil {
+			// keep what we have for the next attempt
+			f.Sync()
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		os.Remove(part)
+		return fmt.Errorf("%s: sha512 is %s, want %s", u, got, want)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(part, path)
+}
+
+func restart(f *os.File, h *hash.Hash) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	*h = sha512.New()
+	return nil
+}
+
+// checkFile reports whether the file at path exists with sha512 want. A
+// file that does not match is removed.
+func checkFile(path, want string) (bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	sum := sha512.Sum512(data)
+	if hex.EncodeToString(sum[:]) == want {
+		return true, nil
+	}
+	return false, os.Remove(path)
+}
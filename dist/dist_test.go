@@ -0,0 +1,208 @@
+package dist
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var archive = bytes.Repeat([]byte("fs-repo-migrations archive "), 1000)
+
+const archiveCID = "QmArchive"
+
+// server serves a distribution index with one release of "tool", under
+// prefix, and the archive by CID if gateway is set.
+type server struct {
+	*httptest.Server
+	mu     sync.Mutex
+	ranges []string
+	broken bool
+}
+
+func newServer(t *testing.T, prefix string, gateway bool) *server {
+	sum := sha512.Sum512(archive)
+	ix, _ := json.Marshal(Index{
+		ID:      "tool",
+		Version: "v1.0.0",
+		Platforms: map[string]Platform{"linux": {Name: "Linux", Archs: map[string]Artifact{
+			"amd64": {Link: "/tool_v1.0.0_linux-amd64.tar.gz", CID: archiveCID, SHA512: hex.EncodeToString(sum[:])},
+		}}},
+	})
+
+	s := &server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(prefix+"/tool/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("v0.9.0\nv1.0.0\n"))
+	})
+	mux.HandleFunc(prefix+"/tool/v1.0.0/dist.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(ix)
+	})
+	serveArchive := func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.ranges = append(s.ranges, r.Header.Get("Range"))
+		broken := s.broken
+		s.mu.Unlock()
+		if broken {
+			http.Error(w, "broken", http.StatusBadGateway)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(archive))
+	}
+	mux.HandleFunc(prefix+"/tool/v1.0.0/tool_v1.0.0_linux-amd64.tar.gz", serveArchive)
+	if gateway {
+		mux.HandleFunc("/ipfs/"+archiveCID, serveArchive)
+	}
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newClient(t *testing.T, mirrors ...string) *Client {
+	dir, err := ioutil.TempDir("", "dist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	c, err := NewClient(mirrors, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestParseMirror(t *testing.T) {
+	m, err := ParseMirror("https://ipfs.io/ipns/dist.ipfs.io/")
+	if err != nil || m.Base != "https://ipfs.io/ipns/dist.ipfs.io" || m.Gateway != "https://ipfs.io" {
+		t.Errorf("gateway mirror: %+v, %v", m, err)
+	}
+	m, err = ParseMirror("https://dist.ipfs.io")
+	if err != nil || m.Gateway != "" {
+		t.Errorf("https mirror: %+v, %v", m, err)
+	}
+	if _, err := ParseMirror("ftp://dist.ipfs.io"); err == nil {
+		t.Error("accepted an ftp mirror")
+	}
+}
+
+func TestFetch(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	defer down.Close()
+	s := newServer(t, "", false)
+	c := newClient(t, down.URL, s.URL)
+
+	versions, err := c.Versions("tool")
+	if err != nil || strings.Join(versions, " ") != "v0.9.0 v1.0.0" {
+		t.Fatalf("Versions = %v, %v", versions, err)
+	}
+
+	path, err := c.Fetch("tool", "v1.0.0", "linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil || !bytes.Equal(data, archive) {
+		t.Fatalf("fetched %d bytes, %v", len(data), err)
+	}
+	if filepath.Dir(path) != c.CacheDir || !strings.HasSuffix(path, ".gz") {
+		t.Errorf("cached at %s", path)
+	}
+
+	// cached: the archive is not downloaded again
+	if _, err := c.Fetch("tool", "v1.0.0", "linux", "amd64"); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.ranges) != 1 {
+		t.Errorf("archive downloaded %d times", len(s.ranges))
+	}
+
+	if _, err := c.Fetch("tool", "v1.0.0", "plan9", "amd64"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("got %v for a missing platform", err)
+	}
+}
+
+func TestFetchResumes(t *testing.T) {
+	s := newServer(t, "", false)
+	c := newClient(t, s.URL)
+
+	ix, err := c.Index("tool", "v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := ix.Artifact("linux", "amd64")
+	part := filepath.Join(c.CacheDir, "sha512-"+a.SHA512+".gz"+partSuffix)
+	if err := ioutil.WriteFile(part, archive[:1000], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := c.Fetch("tool", "v1.0.0", "linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadFile(path); !bytes.Equal(data, archive) {
+		t.Fatal("resumed download is corrupt")
+	}
+	if len(s.ranges) != 1 || s.ranges[0] != "bytes=1000-" {
+		t.Errorf("requested ranges %q", s.ranges)
+	}
+	if _, err := os.Stat(part); !os.IsNotExist(err) {
+		t.Error("partial download left behind")
+	}
+}
+
+func TestFetchCorruptPartial(t *testing.T) {
+	s := newServer(t, "", false)
+	c := newClient(t, s.URL, s.URL)
+
+	ix, err := c.Index("tool", "v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := ix.Artifact("linux", "amd64")
+	part := filepath.Join(c.CacheDir, "sha512-"+a.SHA512+".gz"+partSuffix)
+	if err := ioutil.WriteFile(part, []byte("garbage"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the first attempt resumes and fails the hash, the second starts over
+	path, err := c.Fetch("tool", "v1.0.0", "linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadFile(path); !bytes.Equal(data, archive) {
+		t.Fatal("download is corrupt")
+	}
+}
+
+func TestFetchGateway(t *testing.T) {
+	s := newServer(t, "/ipns/dist.ipfs.io", true)
+	s.mu.Lock()
+	s.broken = true
+	s.mu.Unlock()
+
+	// the link fails, the CID is fetched from the gateway, which is
+	// broken too; then the gateway recovers
+	c := newClient(t, s.URL+"/ipns/dist.ipfs.io")
+	if _, err := c.Fetch("tool", "v1.0.0", "linux", "amd64"); err == nil {
+		t.Fatal("fetched from a broken mirror")
+	}
+	if len(s.ranges) != 2 {
+		t.Errorf("tried %d URLs, want the link and the CID", len(s.ranges))
+	}
+	s.mu.Lock()
+	s.broken = false
+	s.mu.Unlock()
+	if _, err := c.Fetch("tool", "v1.0.0", "linux", "amd64"); err != nil {
+		t.Fatal(err)
+	}
+}
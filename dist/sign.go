@@ -0,0 +1,227 @@
+package dist
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Release manifests and the keyring are signed with ed25519. Each signed
+// file has a detached signature beside it, with SigSuffix appended to its
+// name, holding a Signature as JSON.
+const SigSuffix = ".sig"
+
+// KeyringFile is the keyring at the root of the index: maintainer keys
+// added or revoked since the keys embedded in the tool, signed by a key
+// already trusted, so keys can be rotated without a new release.
+const KeyringFile = "keyring.json"
+
+// trustedFile caches, in the cache directory, the keys trusted after the
+// last keyring update, so that a later keyring signed by a rotated-in key
+// is still accepted.
+const trustedFile = "trusted-keys.json"
+
+// EmbeddedKeys are the maintainer keys the tool trusts, as a
+// comma-separated list of "id:base64 public key". Release builds set it
+// with -ldflags "-X github.com/ipfs/fs-repo-migrations/dist.EmbeddedKeys=...".
+var EmbeddedKeys = ""
+
+// ErrNoKeys is returned when there is no key to verify signatures with.
+var ErrNoKeys = errors.New("no maintainer keys to verify signatures with")
+
+// ErrBadSignature is returned, wrapped, for files whose signature does not
+// verify.
+var ErrBadSignature = errors.New("bad signature")
+
+// Key is a maintainer's public key.
+type Key struct {
+	ID        string `json:"id"`
+	PublicKey []byte `json:"public_key"`
+	// Revoked keys are no longer trusted, whoever signed with them.
+	Revoked bool `json:"revoked,omitempty"`
+}
+
+// Keyring is the content of KeyringFile.
+type Keyring struct {
+	Keys []Key `json:"keys"`
+}
+
+// Signature is a detached signature.
+type Signature struct {
+	KeyID     string `json:"key_id"`
+	Signature []byte `json:"signature"`
+}
+
+// Sign signs data with the maintainer key id, returning the content of
+// its signature file.
+func Sign(id string, priv ed25519.PrivateKey, data []byte) ([]byte, error) {
+	return json.Marshal(Signature{KeyID: id, Signature: ed25519.Sign(priv, data)})
+}
+
+// ParseKeys parses keys in the form of EmbeddedKeys.
+func ParseKeys(s string) ([]Key, error) {
+	var keys []Key
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f == "" {
+			continue
+		}
+		parts := strings.SplitN(f, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid key %q: expected id:base64 public key", f)
+		}
+		pub, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil || len(pub) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid key %q: not a base64 ed25519 public key", parts[0])
+		}
+		keys = append(keys, Key{ID: parts[0], PublicKey: pub})
+	}
+	return keys, nil
+}
+
+// Verifier checks signatures against the keys it trusts.
+type Verifier struct {
+	keys    map[string]ed25519.PublicKey
+	revoked map[string]bool
+}
+
+// NewVerifier returns a verifier trusting keys. Revoked keys are
+// remembered as such.
+func NewVerifier(keys []Key) *Verifier {
+	v := &Verifier{keys: map[string]ed25519.PublicKey{}, revoked: map[string]bool{}}
+	v.add(keys)
+	return v
+}
+
+func (v *Verifier) add(keys []Key) {
+	for _, k := range keys {
+		if k.Revoked {
+			v.revoked[k.ID] = true
+			delete(v.keys, k.ID)
+		} else if !v.revoked[k.ID] && len(k.PublicKey) == ed25519.PublicKeySize {
+			v.keys[k.ID] = ed25519.PublicKey(k.PublicKey)
+		}
+	}
+}
+
+// Keys returns the keys v trusts, followed by those it knows are revoked.
+func (v *Verifier) Keys() []Key {
+	var keys []Key
+	for id, pub := range v.keys {
+		keys = append(keys, Key{ID: id, PublicKey: pub})
+	}
+	for id := range v.revoked {
+		keys = append(keys, Key{ID: id, Revoked: true})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Revoked != keys[j].Revoked {
+			return !keys[i].Revoked
+		}
+		return keys[i].ID < keys[j].ID
+	})
+	return keys
+}
+
+// Verify checks that sig, the content of a signature file, is a signature
+// of data by a trusted key.
+func (v *Verifier) Verify(data, sig []byte) error {
+	if len(v.keys) == 0 {
+		return ErrNoKeys
+	}
+	var s Signature
+	if err := json.Unmarshal(sig, &s); err != nil {
+		return fmt.Errorf("%w: %s", ErrBadSignature, err)
+	}
+	if v.revoked[s.KeyID] {
+		return fmt.Errorf("%w: key %s is revoked", ErrBadSignature, s.KeyID)
+	}
+	pub, ok := v.keys[s.KeyID]
+	if !ok {
+		return fmt.Errorf("%w: unknown key %s", ErrBadSignature, s.KeyID)
+	}
+	if !ed25519.Verify(pub, data, s.Signature) {
+		return fmt.Errorf("%w: does not verify with key %s", ErrBadSignature, s.KeyID)
+	}
+	return nil
+}
+
+// Rotate verifies keyring, the content of KeyringFile, with its signature
+// sig, and then trusts the keys it adds and stops trusting those it
+// revokes.
+func (v *Verifier) Rotate(keyring, sig []byte) error {
+	if err := v.Verify(keyring, sig); err != nil {
+		return fmt.Errorf("%s: %w", KeyringFile, err)
+	}
+	var kr Keyring
+	if err := json.Unmarshal(keyring, &kr); err != nil {
+		return fmt.Errorf("%s: %w", KeyringFile, err)
+	}
+	v.add(kr.Keys)
+	return nil
+}
+
+// LoadVerifier returns a verifier trusting EmbeddedKeys, extra, and the
+// keys trusted after the last keyring update cached in cacheDir.
+func LoadVerifier(cacheDir string, extra []Key) (*Verifier, error) {
+	keys, err := ParseKeys(EmbeddedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("embedded keys: %w", err)
+	}
+	v := NewVerifier(append(keys, extra...))
+
+	data, err := ioutil.ReadFile(filepath.Join(cacheDir, trustedFile))
+	if os.IsNotExist(err) {
+		return v, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var kr Keyring
+	if err := json.Unmarshal(data, &kr); err != nil {
+		return nil, fmt.Errorf("%s: %w", trustedFile, err)
+	}
+	v.add(kr.Keys)
+	return v, nil
+}
+
+// UpdateKeyring fetches the keyring of the index and rotates c.Verifier
+// with it, caching the keys trusted afterwards. An index without a keyring
+// changes nothing.
+func (c *Client) UpdateKeyring() error {
+	if c.Verifier == nil {
+		return nil
+	}
+	var data, sig []byte
+	err := c.eachMirror(func(m Mirror) error {
+		var err error
+		if data, err = c.read(m.Base + "/" + KeyringFile); err != nil {
+			return err
+		}
+		sig, err = c.read(m.Base + "/" + KeyringFile + SigSuffix)
+		return err
+	})
+	if data == nil && errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.Verifier.Rotate(data, sig); err != nil {
+		return err
+	}
+
+	out, err := json.Marshal(Keyring{Keys: c.Verifier.Keys()})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.CacheDir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(c.CacheDir, trustedFile), out, 0644)
+}
@@ -0,0 +1,148 @@
+package dist
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testKey struct {
+	id   string
+	pub  ed25519.PublicKey
+	priv ed25519.PrivateKey
+}
+
+func newKey(t *testing.T, id string) testKey {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKey{id, pub, priv}
+}
+
+func (k testKey) key() Key { return Key{ID: k.id, PublicKey: k.pub} }
+
+func (k testKey) sign(t *testing.T, data []byte) []byte {
+	sig, err := Sign(k.id, k.priv, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func TestParseKeys(t *testing.T) {
+	k := newKey(t, "alice")
+	keys, err := ParseKeys(" alice:" + base64.StdEncoding.EncodeToString(k.pub) + ", ")
+	if err != nil || len(keys) != 1 || keys[0].ID != "alice" || !k.pub.Equal(ed25519.PublicKey(keys[0].PublicKey)) {
+		t.Fatalf("ParseKeys = %v, %v", keys, err)
+	}
+	for _, s := range []string{"alice", "alice:notbase64!", "alice:" + base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := ParseKeys(s); err == nil {
+			t.Errorf("parsed %q", s)
+		}
+	}
+}
+
+func TestVerifyAndRotate(t *testing.T) {
+	root, next, stranger := newKey(t, "root"), newKey(t, "next"), newKey(t, "stranger")
+	data := []byte(`{"id":"tool"}`)
+
+	if err := NewVerifier(nil).Verify(data, root.sign(t, data)); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("verified without keys: %v", err)
+	}
+	v := NewVerifier([]Key{root.key()})
+	if err := v.Verify(data, root.sign(t, data)); err != nil {
+		t.Fatal(err)
+	}
+	for name, sig := range map[string][]byte{
+		"tampered":    root.sign(t, []byte(`{"id":"evil"}`)),
+		"unknown key": stranger.sign(t, data),
+		"garbage":     []byte("garbage"),
+	} {
+		if err := v.Verify(data, sig); !errors.Is(err, ErrBadSignature) {
+			t.Errorf("%s: got %v", name, err)
+		}
+	}
+
+	// a keyring not signed by a trusted key is refused
+	kr, _ := json.Marshal(Keyring{Keys: []Key{stranger.key()}})
+	if err := v.Rotate(kr, stranger.sign(t, kr)); !errors.Is(err, ErrBadSignature) {
+		t.Fatalf("rotated in a self-signed keyring: %v", err)
+	}
+
+	// root hands over to next
+	kr, _ = json.Marshal(Keyring{Keys: []Key{next.key(), {ID: "root", Revoked: true}}})
+	if err := v.Rotate(kr, root.sign(t, kr)); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Verify(data, next.sign(t, data)); err != nil {
+		t.Errorf("rotated-in key: %v", err)
+	}
+	if err := v.Verify(data, root.sign(t, data)); !errors.Is(err, ErrBadSignature) {
+		t.Errorf("revoked key: %v", err)
+	}
+
+	// revocation sticks, even if the key is listed again
+	v = NewVerifier(append(v.Keys(), root.key()))
+	if err := v.Verify(data, root.sign(t, data)); !errors.Is(err, ErrBadSignature) {
+		t.Errorf("revoked key trusted again: %v", err)
+	}
+}
+
+func TestClientVerifies(t *testing.T) {
+	root, next := newKey(t, "root"), newKey(t, "next")
+	ix := []byte(`{"id":"tool","version":"v1.0.0"}`)
+	kr, _ := json.Marshal(Keyring{Keys: []Key{next.key()}})
+	files := map[string][]byte{
+		"/tool/v1.0.0/dist.json":     ix,
+		"/tool/v1.0.0/dist.json.sig": next.sign(t, ix),
+		"/keyring.json":              kr,
+		"/keyring.json.sig":          root.sign(t, kr),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	defer srv.Close()
+
+	c := newClient(t, srv.URL)
+	var err error
+	if c.Verifier, err = LoadVerifier(c.CacheDir, []Key{root.key()}); err != nil {
+		t.Fatal(err)
+	}
+	// signed by a key root has not handed over to yet
+	if _, err := c.Index("tool", "v1.0.0"); !errors.Is(err, ErrBadSignature) {
+		t.Fatalf("got %v before the keyring update", err)
+	}
+	if err := c.UpdateKeyring(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Index("tool", "v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the rotation is remembered without the keyring
+	delete(files, "/keyring.json")
+	if c.Verifier, err = LoadVerifier(c.CacheDir, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.UpdateKeyring(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Index("tool", "v1.0.0"); err != nil {
+		t.Errorf("after reload: %v", err)
+	}
+
+	files["/tool/v1.0.0/dist.json"] = []byte(`{"id":"tool","version":"v6.6.6"}`)
+	if _, err := c.Index("tool", "v1.0.0"); !errors.Is(err, ErrBadSignature) {
+		t.Errorf("accepted a tampered dist.json: %v", err)
+	}
+}
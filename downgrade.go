@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+// runDowngrade takes the repo back to an older version, e.g. to roll back a
+// daemon upgrade. Each step uses the migration's Revert or, where that is
+// not possible, its Downgrade. Running with -revert-ok does the same steps;
+// this command also reports the steps that will be downgraded before
+// prompting.
+func runDowngrade(args []string) error {
+	fs := flag.NewFlagSet("downgrade", flag.ExitOnError)
+	target := fs.Int("to", -1, "version to downgrade to (required)")
+	yes := fs.Bool("y", false, "answer yes to all prompts")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to migrate")
+	fs.Parse(args)
+
+	if *target < 0 {
+		fs.Usage()
+		return fmt.Errorf("missing target version; flag '-to <version>' is required")
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckVersionSkew(vnum, CurrentVersion); err != nil {
+		return err
+	}
+	if *target >= vnum {
+		return fmt.Errorf("repo is at version %d; downgrade target must be lower", vnum)
+	}
+
+	// plan first so an impossible downgrade is reported before prompting
+	steps, err := gomigrate.Plan(migrations, ipfsdir, vnum, *target)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Found fs-repo version %d at %s\n", vnum, ipfsdir)
+	for _, step := range steps {
+		if !step.Migration.Reversible() {
+			fmt.Printf("Migration %s cannot be reverted and will be downgraded instead\n", step)
+		}
+	}
+
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("Do you want to downgrade this to version %d? [y/n]", *target)
+	if !(*yes || YesNoPrompt(prompt)) {
+		os.Exit(1)
+	}
+
+	shutdown.Notify()
+	err = doMigrate(ipfsdir, vnum, *target)
+	printArtifacts(ipfsdir)
+	return err
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+func runDryRun(args []string) error {
+	fs := flag.NewFlagSet("dry-run", flag.ExitOnError)
+	target := fs.Int("to", CurrentVersion, "specify version to report on")
+	fs.Parse(args)
+
+	if *target > CurrentVersion {
+		return fmt.Errorf("no known migration to version %d", *target)
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+
+	if vnum == *target {
+		fmt.Println("already at target version number")
+		return nil
+	}
+
+	steps, err := gomigrate.Plan(migrations, ipfsdir, vnum, *target)
+	if err != nil {
+		return err
+	}
+
+	// Only the first step sees the repo as it is now; later steps would
+	// report on a repo their predecessors have not migrated yet.
+	for i, step := range steps {
+		if i > 0 {
+			fmt.Printf("===> %s: depends on the steps before it, not reported\n", step)
+			continue
+		}
+		dr, ok := step.Migration.(gomigrate.DryRunner)
+		if !ok {
+			fmt.Printf("===> %s: no dry run available\n", step)
+			continue
+		}
+
+		opts := gomigrate.NewOptions(ipfsdir)
+		opts.Revert = step.Revert
+		r, err := dr.DryRun(opts)
+		if err != nil {
+			return fmt.Errorf("dry run of %s failed: %w", step, err)
+		}
+		fmt.Printf("===> %s:\n", step)
+		r.WriteTo(os.Stdout)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Package dsblocks looks up blocks in a repo datastore under the keys they
+// are stored under since repo version 4.
+package dsblocks
+
+import (
+	"encoding/base32"
+	"errors"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+)
+
+// Prefix is the datastore namespace of blocks.
+const Prefix = "/blocks"
+
+// blockKeyEncoding encodes block keys from repo version 4 on.
+var blockKeyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Blocks looks up blocks under Prefix in a whole repo datastore, as opened
+// by convert.Open, by either key format written since repo version 4: the
+// CID for CIDv1 blocks, or the multihash. It implements pincheck.Blocks.
+type Blocks struct {
+	Datastore ds.Datastore
+}
+
+// Keys returns the keys the block with the given hash, a multihash or
+// CID, may be stored under.
+func Keys(hash []byte) []ds.Key {
+	keys := []ds.Key{blockKey(hash)}
+	if c, err := cid.Cast(hash); err == nil && c.Version() == 1 {
+		keys = append(keys, blockKey(c.Hash()))
+	}
+	return keys
+}
+
+// KeyHash returns the multihash of the block stored under key, or false if
+// the key is neither a CID nor a multihash.
+func KeyHash(key ds.Key) ([]byte, bool) {
+	b, err := blockKeyEncoding.DecodeString(key.BaseNamespace())
+	if err != nil {
+		return nil, false
+	}
+	if c, err := cid.Cast(b); err == nil && c.Version() == 1 {
+		return c.Hash(), true
+	}
+	if _, err := mh.Cast(b); err == nil {
+		return b, true
+	}
+	return nil, false
+}
+
+func blockKey(b []byte) ds.Key {
+	return ds.NewKey(Prefix).ChildString(blockKeyEncoding.EncodeToString(b))
+}
+
+func (b Blocks) Has(hash []byte) (bool, error) {
+	for _, k := range Keys(hash) {
+		if ok, err := b.Datastore.Has(k); ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func (b Blocks) Get(hash []byte) ([]byte, error) {
+	for _, k := range Keys(hash) {
+		data, err := b.Datastore.Get(k)
+		if err == nil {
+			return data, nil
+		} else if !errors.Is(err, ds.ErrNotFound) {
+			return nil, err
+		}
+	}
+	return nil, pincheck.ErrNotFound
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/bench"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runEstimate(args []string) error {
+	fs := flag.NewFlagSet("estimate", flag.ExitOnError)
+	target := fs.Int("to", CurrentVersion, "specify version to estimate up to")
+	fs.Parse(args)
+
+	if *target > len(migrations) {
+		return fmt.Errorf("no known migration to version %d", *target)
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+
+	if vnum == *target {
+		fmt.Println("already at target version number")
+		return nil
+	}
+
+	steps, err := gomigrate.Plan(migrations, ipfsdir, vnum, *target)
+	if err != nil {
+		return err
+	}
+
+	var total gomigrate.Estimate
+	for _, step := range steps {
+		opts := gomigrate.NewOptions(ipfsdir)
+		opts.Revert = step.Revert
+
+		est, ok := step.Migration.(gomigrate.Estimator)
+		if !ok {
+			fmt.Printf("%s: no estimate available\n", step)
+			continue
+		}
+
+		e, err := est.EstimateWork(opts)
+		if err != nil {
+			return fmt.Errorf("estimate for %s failed: %w", step, err)
+		}
+		fmt.Printf("%s: %d keys, %d bytes\n", step, e.Keys, e.Bytes)
+		total = total.Add(e)
+	}
+
+	fmt.Printf("total: %d keys, %d bytes\n", total.Keys, total.Bytes)
+	if res, err := bench.Load(ipfsdir); err == nil {
+		fmt.Printf("about %s at the throughput bench measured on %s\n",
+			res.Cost.Duration(total).Round(time.Second), res.Time.Format("2006-01-02"))
+	} else if !os.IsNotExist(err) {
+		log.Warn("could not read the bench result: %s", err)
+	}
+
+	sp, err := gomigrate.PlanSpace(steps, ipfsdir)
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+	printSpace(sp)
+	return checkSpace(ipfsdir, sp)
+}
+
+// printSpace prints the modelled disk usage of each step, stage by stage,
+// as bytes above the usage before the first step.
+func printSpace(sp gomigrate.SpacePlan) {
+	for _, ss := range sp.Steps {
+		if ss.Model == nil {
+			fmt.Printf("%s: no space model available\n", ss.Step)
+			continue
+		}
+		fmt.Printf("%s: peak %+d bytes, final %+d bytes\n", ss.Step, ss.Start+ss.Model.Peak(), ss.Start+ss.Model.Final())
+		used := ss.Start
+		for _, st := range ss.Model {
+			fmt.Printf("  %s: peak %+d, then %+d bytes\n", st.Name, used+st.Peak, used+st.Final)
+			used += st.Final
+		}
+	}
+	fmt.Printf("disk space: peak %+d bytes, final %+d bytes\n", sp.Peak, sp.Final)
+}
+
+// checkSpace returns an error if the peak of sp does not fit on the disk
+// holding the repo. Steps without a space model are warned about.
+func checkSpace(ipfsdir string, sp gomigrate.SpacePlan) error {
+	for _, step := range sp.Unknown {
+		log.Warn("the disk space used by migration %s is not modelled", step)
+	}
+	free, err := gomigrate.FreeSpace(ipfsdir)
+	if errors.Is(err, gomigrate.ErrFreeSpaceUnknown) {
+		log.Warn("%s", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return sp.CheckSpace(free)
+}
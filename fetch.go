@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"strings"
+
+	"github.com/ipfs/fs-repo-migrations/dist"
+)
+
+func runFetch(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	distName := fs.String("dist", "fs-repo-migrations", "distribution to download, e.g. ipfs-10-to-11")
+	version := fs.String("version", "", "release to download, the latest if empty")
+	goos := fs.String("os", runtime.GOOS, "operating system of the archive")
+	goarch := fs.String("arch", runtime.GOARCH, "architecture of the archive")
+	mirrors := fs.String("mirrors", "", "comma-separated distribution index URLs to try in turn, HTTPS or IPFS gateways under /ipns/ (default $"+dist.MirrorsEnv+" or "+strings.Join(dist.DefaultMirrors, ",")+")")
+	cacheDir := fs.String("cache", "", "directory downloads are cached in (default in the user cache directory)")
+	list := fs.Bool("list", false, "only list the releases of the distribution")
+	keysFile := fs.String("keys", "", "file of maintainer keys to trust besides the embedded ones, as comma-separated id:base64 public key")
+	noVerify := fs.Bool("no-verify", false, "do not check the signature of the release manifest")
+	fs.Parse(args)
+
+	var urls []string
+	if *mirrors != "" {
+		urls = strings.Split(*mirrors, ",")
+	}
+	c, err := dist.NewClient(urls, *cacheDir)
+	if err != nil {
+		return err
+	}
+	if !*noVerify {
+		var extra []dist.Key
+		if *keysFile != "" {
+			data, err := ioutil.ReadFile(*keysFile)
+			if err != nil {
+				return err
+			}
+			if extra, err = dist.ParseKeys(string(data)); err != nil {
+				return err
+			}
+		}
+		if c.Verifier, err = dist.LoadVerifier(c.CacheDir, extra); err != nil {
+			return err
+		}
+		if err := c.UpdateKeyring(); err != nil {
+			return err
+		}
+	}
+
+	if *list || *version == "" {
+		versions, err := c.Versions(*distName)
+		if err != nil {
+			return err
+		}
+		if *list {
+			for _, v := range versions {
+				fmt.Println(v)
+			}
+			return nil
+		}
+		if len(versions) == 0 {
+			return fmt.Errorf("%s has no releases", *distName)
+		}
+		*version = versions[len(versions)-1]
+	}
+
+	path, err := c.Fetch(*distName, *version, *goos, *goarch)
+	if errors.Is(err, dist.ErrNoKeys) {
+		return fmt.Errorf("%w: this build embeds none, pass -keys or -no-verify", err)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func runGenFixture(args []string) error {
+	fs := flag.NewFlagSet("gen-fixture", flag.ExitOnError)
+	version := fs.Int("version", CurrentVersion, "repo version of the fixture")
+	blocks := fs.Int("blocks", 100, "number of random blocks")
+	blockSize := fs.Int("block-size", 256, "size of each block in bytes")
+	backend := fs.String("backend", string(migrationtest.Flatfs), "blocks backend: flatfs, levelds or badgerds")
+	keys := fs.String("keys", "self", "comma-separated keystore entry names")
+	seed := fs.Int64("seed", 1, "random seed; the same seed always produces the same fixture")
+	out := fs.String("out", "", "directory to create the fixture in (required)")
+	fs.Parse(args)
+
+	if *out == "" {
+		fs.Usage()
+		return fmt.Errorf("missing or empty path; flag '-out <dir>' is required")
+	}
+	if *version < 0 || *version > CurrentVersion {
+		return fmt.Errorf("no known repo version %d", *version)
+	}
+	if _, err := os.Stat(*out); err == nil {
+		return fmt.Errorf("%s already exists", *out)
+	}
+
+	opts := []migrationtest.Option{
+		migrationtest.WithBackend(migrationtest.Backend(*backend)),
+		migrationtest.WithBlocks(*blocks, *blockSize),
+		migrationtest.WithSeed(*seed),
+	}
+	if *keys != "" {
+		opts = append(opts, migrationtest.WithKeys(strings.Split(*keys, ",")...))
+	}
+
+	r, err := migrationtest.Create(*out, *version, opts...)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("created version %d fixture at %s: %d blocks in %s, %d keys\n",
+		r.Version, r.Path, len(r.Blocks), r.Backend, len(r.Keys))
+	return nil
+}
@@ -0,0 +1,107 @@
+package migrate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// addressArraysVersion is the first repo version whose daemons accept
+// Addresses.API and Addresses.Gateway as arrays; older ones need a single
+// string.
+const addressArraysVersion = 8
+
+// singleAddresses are the Addresses keys that were strings before
+// addressArraysVersion. The other address keys are always arrays.
+var singleAddresses = map[string]bool{"API": true, "Gateway": true}
+
+var addressKeys = []string{"API", "Gateway", "Swarm", "Announce", "NoAnnounce"}
+
+// normalizeAddresses rewrites the Addresses section of cfg into the forms
+// the daemon of repo version v expects: hand-edited strings holding
+// several addresses become arrays, stray whitespace and empty entries go,
+// and API and Gateway are arrays or strings depending on v. It returns the
+// keys changed, and the addresses that had to be dropped because a
+// string can hold only one.
+func normalizeAddresses(cfg map[string]interface{}, v int) (changed, dropped []string) {
+	addrs, ok := cfg["Addresses"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	for _, key := range addressKeys {
+		cur, exists := addrs[key]
+		if !exists {
+			continue
+		}
+		list, ok := addressList(cur)
+		if !ok {
+			continue // not ours to guess at
+		}
+
+		var want interface{}
+		if singleAddresses[key] && v < addressArraysVersion {
+			s := ""
+			if len(list) > 0 {
+				s = list[0].(string)
+				for _, a := range list[1:] {
+					dropped = append(dropped, fmt.Sprintf("Addresses.%s %s", key, a))
+				}
+			}
+			want = s
+		} else {
+			want = list
+		}
+		if !reflect.DeepEqual(cur, want) {
+			addrs[key] = want
+			changed = append(changed, "Addresses."+key)
+		}
+	}
+	return changed, dropped
+}
+
+// addressList returns the addresses in a string, which may hold several
+// separated by commas or whitespace, or in an array of strings. It
+// reports false for any other value.
+func addressList(v interface{}) ([]interface{}, bool) {
+	list := []interface{}{}
+	switch v := v.(type) {
+	case nil:
+	case string:
+		for _, a := range strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\n'
+		}) {
+			list = append(list, a)
+		}
+	case []interface{}:
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			if s = strings.TrimSpace(s); s != "" {
+				list = append(list, s)
+			}
+		}
+	default:
+		return nil, false
+	}
+	return list, true
+}
+
+// normalizeConfigAddresses normalizes cfg's addresses for repo version v,
+// unless the migrate.keep-addresses feature flag is set, and reports
+// whether cfg changed.
+func normalizeConfigAddresses(opts Options, cfg map[string]interface{}, v int) bool {
+	if opts.FeatureBool("migrate.keep-addresses", false) {
+		return false
+	}
+	changed, dropped := normalizeAddresses(cfg, v)
+	if len(changed) == 0 {
+		return false
+	}
+	for _, d := range dropped {
+		opts.Logger().Warn("dropped %s: repo version %d allows a single address", d, v)
+	}
+	opts.Logger().Info("normalized %s", strings.Join(changed, ", "))
+	return true
+}
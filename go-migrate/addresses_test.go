@@ -0,0 +1,64 @@
+package migrate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeAddresses(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		version  int
+		in, want string
+		changed  int
+		dropped  int
+	}{
+		{
+			name:    "strings become arrays",
+			version: 10,
+			in:      `{"API": "/ip4/127.0.0.1/tcp/5001", "Gateway": " /ip4/127.0.0.1/tcp/8080, /ip6/::1/tcp/8080 ", "Swarm": "/ip4/0.0.0.0/tcp/4001"}`,
+			want:    `{"API": ["/ip4/127.0.0.1/tcp/5001"], "Gateway": ["/ip4/127.0.0.1/tcp/8080", "/ip6/::1/tcp/8080"], "Swarm": ["/ip4/0.0.0.0/tcp/4001"]}`,
+			changed: 3,
+		},
+		{
+			name:    "arrays are cleaned",
+			version: 10,
+			in:      `{"API": ["/ip4/127.0.0.1/tcp/5001 ", ""], "Announce": null, "NoAnnounce": []}`,
+			want:    `{"API": ["/ip4/127.0.0.1/tcp/5001"], "Announce": [], "NoAnnounce": []}`,
+			changed: 2,
+		},
+		{
+			name:    "arrays become strings for old versions",
+			version: 7,
+			in:      `{"API": ["/ip4/127.0.0.1/tcp/5001", "/ip4/127.0.0.1/tcp/5002"], "Gateway": [], "Swarm": "/ip4/0.0.0.0/tcp/4001"}`,
+			want:    `{"API": "/ip4/127.0.0.1/tcp/5001", "Gateway": "", "Swarm": ["/ip4/0.0.0.0/tcp/4001"]}`,
+			changed: 3,
+			dropped: 1,
+		},
+		{
+			name:    "unknown forms are left alone",
+			version: 10,
+			in:      `{"API": {"tcp": 5001}, "Gateway": [1, 2], "Other": "x"}`,
+			want:    `{"API": {"tcp": 5001}, "Gateway": [1, 2], "Other": "x"}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var in, want map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.in), &in); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tc.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			cfg := map[string]interface{}{"Addresses": in}
+			changed, dropped := normalizeAddresses(cfg, tc.version)
+			if !reflect.DeepEqual(cfg["Addresses"], want) {
+				t.Errorf("got %v, want %v", cfg["Addresses"], want)
+			}
+			if len(changed) != tc.changed || len(dropped) != tc.dropped {
+				t.Errorf("changed %v, dropped %v", changed, dropped)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Artifact is a file produced by a migration run, such as a backup, journal
+// or report, together with its checksum.
+type Artifact struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Artifacts collects the files produced during a run so their checksums can
+// be reported at the end. The zero value is ready to use, and a nil
+// *Artifacts silently ignores additions.
+type Artifacts struct {
+	mu    sync.Mutex
+	paths map[string]struct{}
+}
+
+// Add records path as an artifact of the current run.
+func (a *Artifacts) Add(path string) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.paths == nil {
+		a.paths = make(map[string]struct{})
+	}
+	a.paths[filepath.Clean(path)] = struct{}{}
+}
+
+// Manifest checksums every recorded artifact that still exists and returns
+// them sorted by path. Paths under base are made relative to it, so the
+// manifest stays valid when the artifacts are copied elsewhere together.
+func (a *Artifacts) Manifest(base string) ([]Artifact, error) {
+	if a == nil {
+		return nil, nil
+	}
+	a.mu.Lock()
+	paths := make([]string, 0, len(a.paths))
+	for p := range a.paths {
+		paths = append(paths, p)
+	}
+	a.mu.Unlock()
+
+	arts := make([]Artifact, 0, len(paths))
+	for _, p := range paths {
+		art, err := checksumFile(p)
+		if os.IsNotExist(err) {
+			// removed again before the end of the run, e.g. a temp file
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rel, err := filepath.Rel(base, p); err == nil && !strings.HasPrefix(rel, "..") {
+			art.Path = filepath.ToSlash(rel)
+		}
+		arts = append(arts, art)
+	}
+
+	sort.Slice(arts, func(i, j int) bool { return arts[i].Path < arts[j].Path })
+	return arts, nil
+}
+
+func checksumFile(path string) (Artifact, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Artifact{}, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return Artifact{}, err
+	}
+	return Artifact{
+		Path:   path,
+		Size:   n,
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// WriteManifest writes arts in the format of sha256sum(1), so a copy can be
+// checked with "sha256sum -c".
+func WriteManifest(w io.Writer, arts []Artifact) error {
+	for _, art := range arts {
+		if _, err := fmt.Fprintf(w, "%s  %s\n", art.SHA256, art.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Result summarizes a migration run for machine consumption.
+type Result struct {
+	Migration string     `json:"migration"`
+	Revert    bool       `json:"revert"`
+	Error     string     `json:"error,omitempty"`
+	Artifacts []Artifact `json:"artifacts"`
+}
+
+// WriteResult writes r as indented JSON to path.
+func WriteResult(path string, r Result) error {
+	if r.Artifacts == nil {
+		r.Artifacts = []Artifact{}
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,244 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// BackupsDir is the directory in the repo that backups are kept in unless
+// Options.BackupDir says otherwise. Each migration gets a subdirectory named
+// after its versions, e.g. "8-to-9", and the directory's manifest lists them.
+const BackupsDir = "migration-backups"
+
+const backupManifest = "manifest.json"
+
+// BackupSet is the backup files one migration wrote, as listed in the
+// backup manifest.
+type BackupSet struct {
+	Migration string    `json:"migration"`
+	Created   time.Time `json:"created"`
+	Files     []string  `json:"files"`
+	// Verified is set once the migrated repo has been checked, after which
+	// the backup is no longer needed.
+	Verified bool `json:"verified,omitempty"`
+}
+
+// BackupFile returns the path a backup file called name of migration m
+// should be written to, creating its directory and recording it in the
+// manifest and in o.Artifacts.
+func (o Options) BackupFile(m Migration, name string) (string, error) {
+	dir := filepath.Join(o.BackupDir, Versions(m))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	sets, err := ReadBackups(o.BackupDir)
+	if err != nil {
+		return "", err
+	}
+	i := findBackupSet(sets, Versions(m))
+	if i < 0 {
+		sets = append(sets, BackupSet{Migration: Versions(m), Created: time.Now().UTC()})
+		i = len(sets) - 1
+	}
+	if !contains(sets[i].Files, name) {
+		sets[i].Files = append(sets[i].Files, name)
+	}
+	sets[i].Verified = false
+	if err := writeBackups(o.BackupDir, sets); err != nil {
+		return "", err
+	}
+
+	p := filepath.Join(dir, name)
+	o.Artifacts.Add(p)
+	return p, nil
+}
+
+// CreateBackup creates the backup file called name of migration m, see
+// BackupFile. If o.BackupKey is set, what is written is encrypted with it
+// and the file is named with keycrypt.StreamSuffix. Closing the returned
+// writer completes and closes the file.
+func (o Options) CreateBackup(m Migration, name string) (io.WriteCloser, error) {
+	if o.BackupKey != nil {
+		name += keycrypt.StreamSuffix
+	}
+	p, err := o.BackupFile(m, name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(p)
+	if err != nil {
+		return nil, err
+	}
+	if o.BackupKey == nil {
+		return f, nil
+	}
+	w, err := keycrypt.NewWriter(f, o.BackupKey, 0)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return encryptedFile{w, f}, nil
+}
+
+type encryptedFile struct {
+	io.WriteCloser
+	f *os.File
+}
+
+func (e encryptedFile) Close() error {
+	err := e.WriteCloser.Close()
+	if err == nil {
+		err = e.f.Sync()
+	}
+	if cerr := e.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// OpenBackup opens the backup file called name of migration m, written
+// with CreateBackup, and decrypts it with o.BackupKey if it is encrypted.
+// A missing backup is reported with an error wrapping ErrBackupMissing.
+func (o Options) OpenBackup(m Migration, name string) (io.ReadCloser, error) {
+	dir := filepath.Join(o.BackupDir, Versions(m))
+	f, err := os.Open(filepath.Join(dir, name+keycrypt.StreamSuffix))
+	if os.IsNotExist(err) {
+		f, err = os.Open(filepath.Join(dir, name))
+		if err == nil {
+			return f, nil
+		}
+	}
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s of migration %s", ErrBackupMissing, name, Versions(m))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if o.BackupKey == nil {
+		f.Close()
+		return nil, fmt.Errorf("backup %s of migration %s is encrypted and no backup key was given", name, Versions(m))
+	}
+	r, err := keycrypt.NewReader(f, o.BackupKey)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, f}, nil
+}
+
+// ReadBackups returns the backup sets listed in the manifest in dir, oldest
+// first.
+func ReadBackups(dir string) ([]BackupSet, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, backupManifest))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var sets []BackupSet
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return nil, fmt.Errorf("reading backup manifest: %w", err)
+	}
+	sort.SliceStable(sets, func(i, j int) bool {
+		return sets[i].Created.Before(sets[j].Created)
+	})
+	return sets, nil
+}
+
+func writeBackups(dir string, sets []BackupSet) error {
+	data, err := json.MarshalIndent(sets, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, backupManifest+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, backupManifest))
+}
+
+func findBackupSet(sets []BackupSet, migration string) int {
+	for i, s := range sets {
+		if s.Migration == migration {
+			return i
+		}
+	}
+	return -1
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkVerified marks the backups of migration in dir as no longer needed.
+func MarkVerified(dir, migration string) error {
+	sets, err := ReadBackups(dir)
+	if err != nil {
+		return err
+	}
+	i := findBackupSet(sets, migration)
+	if i < 0 {
+		return fmt.Errorf("no backups of migration %s", migration)
+	}
+	sets[i].Verified = true
+	return writeBackups(dir, sets)
+}
+
+// Retention says which backup sets CleanBackups removes.
+type Retention struct {
+	// Keep is the number of most recent sets to keep. Zero keeps none.
+	Keep int
+	// VerifiedOnly restricts removal to verified sets.
+	VerifiedOnly bool
+	// DryRun reports what would be removed without removing it.
+	DryRun bool
+}
+
+// CleanBackups removes the backup sets in dir that r does not retain and
+// returns them.
+func CleanBackups(dir string, r Retention) ([]BackupSet, error) {
+	sets, err := ReadBackups(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept, removed []BackupSet
+	for i, s := range sets {
+		recent := len(sets)-i <= r.Keep
+		if recent || (r.VerifiedOnly && !s.Verified) {
+			kept = append(kept, s)
+			continue
+		}
+		removed = append(removed, s)
+	}
+	if r.DryRun || len(removed) == 0 {
+		return removed, nil
+	}
+
+	for _, s := range removed {
+		log.Debug("removing backups of %s", s.Migration)
+		if err := os.RemoveAll(filepath.Join(dir, s.Migration)); err != nil {
+			return nil, err
+		}
+	}
+	return removed, writeBackups(dir, kept)
+}
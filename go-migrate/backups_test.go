@@ -0,0 +1,106 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCleanBackups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts := NewOptions(dir)
+	for _, m := range []Migration{fakeMigration{from: 7, to: 8}, fakeMigration{from: 8, to: 9}} {
+		p, err := opts.BackupFile(m, "config")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	backups := filepath.Join(dir, BackupsDir)
+
+	removed, err := CleanBackups(backups, Retention{Keep: 1, VerifiedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 0 {
+		t.Fatalf("removed unverified backups: %v", removed)
+	}
+
+	if err := MarkVerified(backups, "7-to-8"); err != nil {
+		t.Fatal(err)
+	}
+	removed, err = CleanBackups(backups, Retention{Keep: 1, VerifiedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 || removed[0].Migration != "7-to-8" {
+		t.Fatalf("expected to remove 7-to-8, removed %v", removed)
+	}
+	if _, err := os.Stat(filepath.Join(backups, "7-to-8")); !os.IsNotExist(err) {
+		t.Error("7-to-8 backups still on disk")
+	}
+
+	sets, err := ReadBackups(backups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 1 || sets[0].Migration != "8-to-9" {
+		t.Errorf("expected only 8-to-9 left, got %v", sets)
+	}
+}
+
+func TestEncryptedBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := fakeMigration{from: 8, to: 9}
+	data := bytes.Repeat([]byte("backup "), 20000)
+	for _, key := range [][]byte{nil, []byte("secret")} {
+		opts := NewOptions(dir)
+		opts.BackupKey = key
+		w, err := opts.CreateBackup(m, "blocks")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := opts.OpenBackup(m, "blocks")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("key %q: backup read back differs", key)
+		}
+	}
+
+	// the encrypted backup is preferred and needs the key
+	if _, err := NewOptions(dir).OpenBackup(m, "blocks"); err == nil {
+		t.Error("opened an encrypted backup without the key")
+	}
+	if _, err := NewOptions(dir).OpenBackup(m, "config"); !errors.Is(err, ErrBackupMissing) {
+		t.Errorf("expected ErrBackupMissing, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package migrate
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// BootstrapPeers reads the bootstrap list from BootstrapFile: one
+// multiaddr per line, ignoring blank lines and lines starting with "#". It
+// returns nil if BootstrapFile is unset.
+func (o Options) BootstrapPeers() ([]string, error) {
+	if o.BootstrapFile == "" {
+		return nil, nil
+	}
+	f, err := os.Open(o.BootstrapFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	peers := []string{}
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		addr := strings.TrimSpace(s.Text())
+		if addr == "" || strings.HasPrefix(addr, "#") {
+			continue
+		}
+		if !strings.HasPrefix(addr, "/") {
+			return nil, fmt.Errorf("%s:%d: %q is not a multiaddr", o.BootstrapFile, line, addr)
+		}
+		peers = append(peers, addr)
+	}
+	return peers, s.Err()
+}
+
+// BootstrapConv returns conv, the conversion a migration applies to the
+// Bootstrap list, or, if BootstrapFile is set, a conversion replacing the
+// list with the file's, for private networks whose peers the migration
+// does not know about.
+func (o Options) BootstrapConv(conv func([]string) []string) (func([]string) []string, error) {
+	peers, err := o.BootstrapPeers()
+	if err != nil || peers == nil {
+		return conv, err
+	}
+	return func([]string) []string {
+		return peers
+	}, nil
+}
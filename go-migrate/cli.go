@@ -1,9 +1,16 @@
 package migrate
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	"github.com/ipfs/fs-repo-migrations/daemon"
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Flags struct {
@@ -13,6 +20,38 @@ type Flags struct {
 	Verbose  bool
 	Help     bool
 	NoRevert bool
+
+	// Tuning knobs, see Options.
+	Workers    int
+	BatchSize  int
+	ChanBuffer int
+	BackupDir  string
+
+	BackupKeyFile string // file holding the key backups are encrypted with
+
+	ResultJSON    string        // file to write the JSON result of the run to
+	GracePeriod   time.Duration // time in-flight batches get to finish on shutdown
+	Window        string        // daily execution window, e.g. "22:00-06:00"
+	MaxKeysPerSec int           // throttle to this many keys per second, 0 for no limit
+	MaxMBPerSec   int           // throttle to this many MB per second, 0 for no limit
+	MaxMemory     int           // MB of memory to size and hold work back for, 0 for no limit
+	Features      Features      // per-migration feature flags, see Features
+	ConfigRules   string        // JSON file of config rules applied after migrating
+	Policy        string        // JSON file of config keys to pin, force or forbid
+	BootstrapFile string        // file replacing the bootstrap list in bootstrap-updating migrations
+	Telemetry     string        // file to append JSON telemetry events to
+	LogFile       string        // file to append every log line to, rotated by size
+	LogTime       bool          // prefix log lines with timestamps and elapsed time
+	LogJSON       bool          // write log lines as JSON objects
+	LogLevel      log.Level     // lowest level to log
+	Trace         bool          // log each key decision to LogFile
+	TraceFile     string        // log each key decision to this file instead
+	CPUProfile    string        // file to write a CPU profile of the run to
+	MemProfile    string        // file to write a heap profile to at the end of the run
+	Pprof         string        // address to serve net/http/pprof at
+
+	WaitForDaemonStop bool // poll until a running daemon exits instead of refusing
+	DryRun            bool // report what the migration would do and exit
 }
 
 func (f *Flags) Setup() {
@@ -22,20 +61,55 @@ func (f *Flags) Setup() {
 	flag.BoolVar(&f.Help, "help", false, "display help message")
 	flag.StringVar(&f.Path, "path", "", "file path to migrate for fs based migrations (required)")
 	flag.BoolVar(&f.NoRevert, "no-revert", false, "do not attempt to automatically revert on failure")
+	flag.IntVar(&f.Workers, "workers", DefaultWorkers, "number of keys moved at once (1-to-2 and 3-to-4 only)")
+	flag.IntVar(&f.BatchSize, "batch-size", DefaultBatchSize, "number of keys per datastore batch or checkpoint (1-to-2 and 3-to-4 only)")
+	flag.IntVar(&f.ChanBuffer, "buffer", DefaultChanBuffer, "depth of the queue of keys waiting for a worker (1-to-2 and 3-to-4 only)")
+	flag.StringVar(&f.BackupDir, "backup-dir", "", "directory for backup files (default: migration-backups in the repo)")
+	flag.StringVar(&f.BackupKeyFile, "backup-key-file", "", "encrypt backup files with the key or passphrase in this file")
+	flag.StringVar(&f.ResultJSON, "result-json", "", "write the result of the run, with artifact checksums, to this file")
+	flag.DurationVar(&f.GracePeriod, "grace", DefaultGracePeriod, "time in-flight batches get to finish when interrupted")
+	flag.StringVar(&f.Window, "window", "", "only do heavy work inside this daily window, e.g. \"22:00-06:00\"")
+	flag.IntVar(&f.MaxKeysPerSec, "max-keys-per-sec", 0, "process at most this many keys per second (0: no limit)")
+	flag.IntVar(&f.MaxMBPerSec, "max-mb-per-sec", 0, "read and write at most this many MB of blocks per second (0: no limit)")
+	flag.IntVar(&f.MaxMemory, "max-memory", 0, "size batches and queues for, and hold work back above, this many MB of memory (0: no limit)")
+	f.Features = Features{}
+	flag.Var(f.Features, "flag", "set a migration feature flag, e.g. mg8.skip-verify=true (repeatable)")
+	flag.StringVar(&f.ConfigRules, "config-rules", "", "JSON file of site-specific config rules to apply after migrating")
+	flag.StringVar(&f.Policy, "policy", "", "JSON file of config keys to pin, force or forbid across migrations")
+	flag.StringVar(&f.BootstrapFile, "bootstrap-file", "", "replace the bootstrap list with the addresses in this file (one per line) instead of updating it")
+	flag.StringVar(&f.LogFile, "log-file", "", "also write verbose logs to this file, rotated at 10MB")
+	flag.StringVar(&f.Telemetry, "telemetry", "", "append telemetry events as JSON lines to this file")
+	flag.BoolVar(&f.LogJSON, "log-json", false, "write log lines as JSON objects")
+	f.LogLevel = log.LevelInfo
+	flag.Var(&f.LogLevel, "log-level", "lowest level to log: debug, info, warn or error")
+	flag.BoolVar(&f.Trace, "trace", false, "log what happens to each key to the -log-file")
+	flag.StringVar(&f.TraceFile, "trace-file", "", "log what happens to each key to this file, rotated at 10MB")
+	flag.BoolVar(&f.LogTime, "log-time", false, "prefix log lines with the time and the time since the migration started")
+	flag.StringVar(&f.CPUProfile, "cpuprofile", "", "write a CPU profile of the run to this file")
+	flag.StringVar(&f.MemProfile, "memprofile", "", "write a heap profile to this file at the end of the run")
+	flag.StringVar(&f.Pprof, "pprof", "", "serve net/http/pprof at this address, e.g. 127.0.0.1:6060")
+	flag.BoolVar(&f.DryRun, "dry-run", false, "report what the migration would do without changing the repo")
+	flag.BoolVar(&f.WaitForDaemonStop, "wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to migrate")
 }
 
 var SupportNoRevert = map[string]bool{
 	"4-to-5": true,
 }
 
-func (f *Flags) Parse() {
+func (f *Flags) Parse() error {
 	flag.Parse()
+	log.SetJSON(f.LogJSON)
+	log.SetThreshold(f.LogLevel)
+	log.SetTimestamps(f.LogTime, f.LogTime)
+	return f.Features.MergeEnv()
 }
 
 func Run(m Migration) error {
 	f := Flags{}
 	f.Setup()
-	f.Parse()
+	if err := f.Parse(); err != nil {
+		return err
+	}
 
 	if f.Help {
 		flag.Usage()
@@ -48,29 +122,174 @@ func Run(m Migration) error {
 	}
 
 	if !m.Reversible() {
-		if f.Revert {
-			return fmt.Errorf("migration %s is irreversible", m.Versions())
+		if f.Revert && !CanDowngrade(m) {
+			return fmt.Errorf("migration %s: %w", Versions(m), ErrNotReversible)
+		}
+		if !f.Revert && !f.Force {
+			return fmt.Errorf("migration %s: %w (use -f to proceed)", Versions(m), ErrNotReversible)
+		}
+	}
+
+	if f.NoRevert && !SupportNoRevert[Versions(m)] {
+		return fmt.Errorf("migration %s does not support the '-no-revert' option", Versions(m))
+	}
+
+	if f.DryRun {
+		dr, ok := m.(DryRunner)
+		if !ok {
+			return fmt.Errorf("migration %s does not support the '-dry-run' option", Versions(m))
+		}
+		opts := Options{Flags: f, Verbose: f.Verbose}
+		opts.setDefaults()
+		r, err := dr.DryRun(opts)
+		if err != nil {
+			return err
+		}
+		_, err = r.WriteTo(os.Stdout)
+		return err
+	}
+
+	if err := CheckDaemon(f.Path, f.WaitForDaemonStop); err != nil {
+		return err
+	}
+
+	var window *Window
+	if f.Window != "" {
+		var err error
+		if window, err = ParseWindow(f.Window); err != nil {
+			return err
+		}
+	}
+
+	throttle, err := NewThrottle(f.MaxKeysPerSec, int64(f.MaxMBPerSec)<<20)
+	if err != nil {
+		return err
+	}
+
+	budget, err := MemoryBudget(f.MaxMemory)
+	if err != nil {
+		return err
+	}
+
+	var rules configrules.Rules
+	if f.ConfigRules != "" {
+		if rules, err = configrules.Load(f.ConfigRules); err != nil {
+			return err
+		}
+	}
+	var policy configrules.Policy
+	if f.Policy != "" {
+		if policy, err = configrules.LoadPolicy(f.Policy); err != nil {
+			return err
 		}
-		if !f.Force {
-			return fmt.Errorf("migration %s is irreversible (use -f to proceed)", m.Versions())
+	}
+
+	var backupKey []byte
+	if f.BackupKeyFile != "" {
+		if backupKey, err = keycrypt.ReadKeyFile(f.BackupKeyFile); err != nil {
+			return err
+		}
+	}
+
+	opts := Options{
+		Flags:     f,
+		Verbose:   f.Verbose,
+		Artifacts: &Artifacts{},
+		Shutdown:  NewShutdown(f.GracePeriod),
+		Window:    window,
+		Throttle:  throttle,
+		Memory:    NewMemoryLimit(budget),
+
+		ConfigRules: rules,
+		Policy:      policy,
+		BackupKey:   backupKey,
+	}
+	opts.setDefaults()
+	opts.FitMemory(budget)
+
+	if f.LogFile != "" {
+		lf, err := log.LogToFile(f.LogFile)
+		if err != nil {
+			return err
 		}
+		defer lf.Close()
 	}
 
-	if f.NoRevert && !SupportNoRevert[m.Versions()] {
-		return fmt.Errorf("migration %s does not support the '-no-revert' option", m.Versions())
+	if f.TraceFile != "" {
+		tf, err := log.TraceToFile(f.TraceFile)
+		if err != nil {
+			return err
+		}
+		defer tf.Close()
+	} else if f.Trace {
+		if f.LogFile == "" {
+			return errors.New("-trace needs -log-file or -trace-file")
+		}
+		log.SetTrace(true, nil)
 	}
 
-	if f.Revert {
-		return m.Revert(Options{
-			Flags:   f,
-			Verbose: f.Verbose,
-		})
-	} else {
-		return m.Apply(Options{
-			Flags:   f,
-			Verbose: f.Verbose,
-		})
+	if f.Telemetry != "" {
+		tf, err := os.OpenFile(f.Telemetry, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		defer tf.Close()
+		opts.Telemetry = NewJSONTelemetry(tf)
+	}
+
+	stopProfiling, err := StartProfiling(f.CPUProfile, f.MemProfile, f.Pprof)
+	if err != nil {
+		return err
+	}
+	// written on interruption too, as shutdown exits the process
+	opts.Shutdown.OnRelease(stopProfiling)
+	defer stopProfiling()
+
+	opts.Shutdown.Notify()
+	return finish(m, opts, Execute(m, opts))
+}
+
+// finish prints the checksum manifest of the run's artifacts and writes the
+// result file if one was requested. It returns the migration error, if any.
+func finish(m Migration, opts Options, err error) error {
+	arts, merr := opts.Artifacts.Manifest(opts.Path)
+	if merr != nil {
+		fmt.Fprintf(os.Stderr, "failed to checksum artifacts: %s\n", merr)
+	}
+	if len(arts) > 0 {
+		fmt.Println("artifacts (sha256):")
+		WriteManifest(os.Stdout, arts)
+	}
+
+	if opts.ResultJSON != "" {
+		r := Result{
+			Migration: Versions(m),
+			Revert:    opts.Revert,
+			Artifacts: arts,
+		}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		if werr := WriteResult(opts.ResultJSON, r); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return err
+}
+
+// CheckDaemon refuses to continue while an ipfs daemon answers on the repo's
+// API address, or with wait set, blocks until it has exited. The repo lock
+// alone is not enough: it is taken only by some migrations, and a stale api
+// file is ignored.
+func CheckDaemon(path string, wait bool) error {
+	if !wait {
+		return daemon.Check(path)
+	}
+	if running, err := daemon.Running(path); err != nil || !running {
+		return err
 	}
+	log.Info("ipfs daemon is running, waiting for it to stop...")
+	return daemon.WaitStopped(path, time.Second, 0)
 }
 
 func Main(m Migration) {
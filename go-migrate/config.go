@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// WithConfigRules sets site-specific config rules applied by each
+// migration, see Options.ConfigRules.
+func WithConfigRules(rs configrules.Rules) Option {
+	return func(o *Options) {
+		o.ConfigRules = rs
+	}
+}
+
+// applyConfigRules applies the rules in opts.ConfigRules meant for
+// m.ToVersion() to cfg, once m has converted it, and reports whether cfg
+// changed.
+func applyConfigRules(m Migration, opts Options, cfg map[string]interface{}) (bool, error) {
+	rules := opts.ConfigRules.For(m.ToVersion())
+	if len(rules) == 0 {
+		return false, nil
+	}
+	n, err := rules.Apply(cfg)
+	if err != nil {
+		return false, fmt.Errorf("config rules: %w", err)
+	}
+	if n > 0 {
+		opts.Logger().Info("config rules changed %d setting(s)", n)
+	}
+	return n > 0, nil
+}
+
+// WithPolicy sets the site policy enforced after each migration, see
+// Options.Policy.
+func WithPolicy(p configrules.Policy) Option {
+	return func(o *Options) {
+		o.Policy = p
+	}
+}
+
+// policySnapshot reads the config before a migration, for pinned keys to
+// be restored from. It returns nil without a policy or a config.
+func policySnapshot(opts Options) (map[string]interface{}, error) {
+	if opts.Policy.Empty() {
+		return nil, nil
+	}
+	rp := mfsr.RepoPath(opts.Path)
+	if _, err := os.Stat(rp.ConfigFile()); os.IsNotExist(err) {
+		return nil, nil
+	}
+	return rp.Config()
+}
+
+// applyPolicy enforces opts.Policy on cfg after a migration, given the
+// config from before it, and reports whether cfg changed.
+func applyPolicy(opts Options, cfg, before map[string]interface{}) (bool, error) {
+	if before == nil {
+		return false, nil
+	}
+	n, err := opts.Policy.Apply(before, cfg)
+	if err != nil {
+		return false, fmt.Errorf("site policy: %w", err)
+	}
+	if n > 0 {
+		opts.Logger().Info("site policy restored %d setting(s)", n)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,106 @@
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// bumpMigration only writes the new version.
+type bumpMigration struct{ fakeMigration }
+
+func (m bumpMigration) Apply(opts Options) error {
+	return mfsr.RepoPath(opts.Path).CasVersion("8", "9")
+}
+
+func TestConfigRulesBeforeVersion(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		rule    configrules.Rule
+		version string
+		api     interface{}
+	}{
+		{"applied", configrules.Rule{Op: configrules.OpSet, Path: "Addresses.API", Value: "/ip4/0.0.0.0/tcp/5001"}, "9", "/ip4/0.0.0.0/tcp/5001"},
+		{"failing", configrules.Rule{Op: configrules.OpSet, Path: "Addresses.API.Port", Value: 5001.0}, "8", "/ip4/127.0.0.1/tcp/5001"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "config")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			rp := mfsr.RepoPath(dir)
+			if err := rp.WriteVersion("8"); err != nil {
+				t.Fatal(err)
+			}
+			cfg := `{"Addresses": {"API": "/ip4/127.0.0.1/tcp/5001"}}`
+			if err := ioutil.WriteFile(filepath.Join(dir, "config"), []byte(cfg), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			opts := NewOptions(dir, WithConfigRules(configrules.Rules{c.rule}))
+			err = Execute(bumpMigration{fakeMigration{8, 9, 1, true}}, opts)
+			if (err != nil) != (c.version == "8") {
+				t.Errorf("Execute: %v", err)
+			}
+			if v, _ := rp.Version(); v != c.version {
+				t.Errorf("repo at version %s, want %s", v, c.version)
+			}
+			cfgMap, err := rp.Config()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if api, _ := mfsr.ConfigValue(cfgMap, "Addresses.API"); api != c.api {
+				t.Errorf("Addresses.API = %v, want %v", api, c.api)
+			}
+		})
+	}
+}
+
+// moveMigration moves the repo from .go-ipfs to .ipfs before writing the
+// new version, as the 1-to-2 migration does.
+type moveMigration struct{ fakeMigration }
+
+func (m moveMigration) Apply(opts Options) error {
+	moved := strings.Replace(opts.Path, ".go-ipfs", ".ipfs", 1)
+	if err := os.Rename(opts.Path, moved); err != nil {
+		return err
+	}
+	return mfsr.RepoPath(moved).CasVersion("1", "2")
+}
+
+func TestConfigRulesAfterMove(t *testing.T) {
+	home, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	dir := filepath.Join(home, ".go-ipfs")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := mfsr.RepoPath(dir).WriteVersion("1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "config"), []byte(`{"Addresses": {}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rule := configrules.Rule{Op: configrules.OpSet, Path: "Addresses.API", Value: "/ip4/0.0.0.0/tcp/5001"}
+	opts := NewOptions(dir, WithConfigRules(configrules.Rules{rule}))
+	if err := Execute(moveMigration{fakeMigration{1, 2, 1, true}}, opts); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := mfsr.RepoPath(filepath.Join(home, ".ipfs")).Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api, _ := mfsr.ConfigValue(cfg, "Addresses.API"); api != rule.Value {
+		t.Errorf("Addresses.API = %v, want %v", api, rule.Value)
+	}
+}
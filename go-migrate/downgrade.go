@@ -0,0 +1,41 @@
+package migrate
+
+import (
+	"errors"
+)
+
+// Downgrader is implemented by migrations that can take a repo back to
+// FromVersion without relying on what Apply left behind, e.g. by
+// regenerating data instead of replaying a backup.
+type Downgrader interface {
+	Downgrade(Options) error
+}
+
+// CanDowngrade reports whether m can be run backward, by Revert or by
+// Downgrade.
+func CanDowngrade(m Migration) bool {
+	if m.Reversible() {
+		return true
+	}
+	_, ok := m.(Downgrader)
+	return ok
+}
+
+// revert runs m backward. Revert is used if m is reversible, Downgrade if it
+// is not or if Revert fails because its backup is gone.
+func revert(m Migration, opts Options) error {
+	d, canDowngrade := m.(Downgrader)
+	if !m.Reversible() {
+		if !canDowngrade {
+			return ErrNotReversible
+		}
+		return d.Downgrade(opts)
+	}
+
+	err := m.Revert(opts)
+	if canDowngrade && errors.Is(err, ErrBackupMissing) {
+		opts.Logger().Warn("cannot revert: %s; downgrading instead", err)
+		return d.Downgrade(opts)
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// DryRunReport describes what a migration would do to a repo.
+type DryRunReport struct {
+	// Counts holds the number of keys (or files) in each category the
+	// migration sorts them into, e.g. "convert" or "skip".
+	Counts map[string]int64
+	// BackupBytes is the estimated size of the backups the migration would
+	// write.
+	BackupBytes int64
+	// Changes describe the individual changes the migration would make,
+	// such as renames, where there are few enough to list.
+	Changes []string
+	// Notes are problems found that would make the migration fail or
+	// behave unexpectedly.
+	Notes []string
+}
+
+// Count adds n to the category.
+func (r *DryRunReport) Count(category string, n int64) {
+	if r.Counts == nil {
+		r.Counts = map[string]int64{}
+	}
+	r.Counts[category] += n
+}
+
+// Notef records a problem.
+func (r *DryRunReport) Notef(format string, args ...interface{}) {
+	r.Notes = append(r.Notes, fmt.Sprintf(format, args...))
+}
+
+// Changef records a change.
+func (r *DryRunReport) Changef(format string, args ...interface{}) {
+	r.Changes = append(r.Changes, fmt.Sprintf(format, args...))
+}
+
+// WriteTo writes the report as text, categories sorted by name.
+func (r DryRunReport) WriteTo(w io.Writer) (int64, error) {
+	cats := make([]string, 0, len(r.Counts))
+	for c := range r.Counts {
+		cats = append(cats, c)
+	}
+	sort.Strings(cats)
+
+	var total int64
+	write := func(format string, args ...interface{}) error {
+		n, err := fmt.Fprintf(w, format, args...)
+		total += int64(n)
+		return err
+	}
+	for _, c := range r.Changes {
+		if err := write("  %s\n", c); err != nil {
+			return total, err
+		}
+	}
+	for _, c := range cats {
+		if err := write("%s: %d\n", c, r.Counts[c]); err != nil {
+			return total, err
+		}
+	}
+	if err := write("backup size: %d bytes\n", r.BackupBytes); err != nil {
+		return total, err
+	}
+	for _, n := range r.Notes {
+		if err := write("note: %s\n", n); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// DryRunner is implemented by migrations that can report what they would
+// do without doing it, classifying the keys they would touch. The
+// direction is taken from opts.Revert. DryRun must not modify the repo.
+type DryRunner interface {
+	DryRun(opts Options) (DryRunReport, error)
+}
@@ -0,0 +1,44 @@
+package migrate
+
+import (
+	"errors"
+
+	"github.com/ipfs/fs-repo-migrations/daemon"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Errors returned by migrations and the runner. They are always wrapped with
+// context, so test for them with errors.Is.
+var (
+	// ErrWrongRepoVersion means the repo is not at the expected version.
+	ErrWrongRepoVersion = mfsr.ErrWrongRepoVersion
+
+	// ErrRepoLocked means another process, usually a daemon, holds the
+	// repo lock.
+	ErrRepoLocked = mfsr.ErrRepoLocked
+
+	// ErrStaleLock means the repo lock was left behind by a process that
+	// no longer exists. It wraps ErrRepoLocked.
+	ErrStaleLock = mfsr.ErrStaleLock
+
+	// ErrDaemonRunning means a daemon answers on the repo's API address.
+	ErrDaemonRunning = daemon.ErrRunning
+
+	// ErrBackupMissing means a revert needs a backup file that is not there.
+	ErrBackupMissing = errors.New("backup missing")
+
+	// ErrNotReversible means the migration cannot be reverted.
+	ErrNotReversible = errors.New("irreversible migration")
+
+	// ErrRequirementNotMet means the repo lacks something the migration
+	// declared it needs, see Requirer.
+	ErrRequirementNotMet = errors.New("repo does not meet requirements")
+
+	// ErrNotEnoughSpace means the modelled peak disk usage of a plan does
+	// not fit in the free space, see PlanSpace.
+	ErrNotEnoughSpace = errors.New("not enough disk space")
+
+	// ErrFreeSpaceUnknown means the free disk space cannot be asked for on
+	// this platform.
+	ErrFreeSpaceUnknown = errors.New("free disk space is not known on this platform")
+)
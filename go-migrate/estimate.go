@@ -0,0 +1,95 @@
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Estimate is the amount of work a migration expects to do.
+type Estimate struct {
+	// Keys is the number of keys (or files) the migration touches.
+	Keys int64
+	// Bytes is the number of bytes the migration reads or rewrites.
+	Bytes int64
+}
+
+// Add returns the sum of two estimates.
+func (e Estimate) Add(o Estimate) Estimate {
+	return Estimate{
+		Keys:  e.Keys + o.Keys,
+		Bytes: e.Bytes + o.Bytes,
+	}
+}
+
+// Estimator is implemented by migrations that can cheaply estimate the work
+// they will do, e.g. with a KeysOnly query or by stat-ing a directory. The
+// direction is taken from opts.Revert. EstimateWork must not modify the repo.
+type Estimator interface {
+	EstimateWork(opts Options) (Estimate, error)
+}
+
+// DirUsage walks dir and returns the number of regular files in it and
+// their total size. A missing directory is reported as empty.
+func DirUsage(dir string) (Estimate, error) {
+	var est Estimate
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			est.Keys++
+			est.Bytes += info.Size()
+		}
+		return nil
+	})
+	return est, err
+}
+
+// ShardSample is the number of shard directories ShardUsage reads.
+const ShardSample = 64
+
+// ShardUsage approximates DirUsage for a directory of shard directories,
+// as flatfs lays out its files, reading only sample of them, spread evenly,
+// and scaling their count and size to all shards. Flatfs spreads keys
+// evenly over its shards, so this is close on any repo large enough for
+// counting to take time; with sample shards or fewer it is exact. Files
+// outside the shards, like flatfs's SHARDING and _README, are left out. A
+// missing directory is reported as empty.
+func ShardUsage(dir string, sample int) (Estimate, error) {
+	var est Estimate
+	fis, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return est, nil
+	}
+	if err != nil {
+		return est, err
+	}
+
+	var shards []string
+	for _, fi := range fis {
+		if fi.IsDir() {
+			shards = append(shards, fi.Name())
+		}
+	}
+	if sample < 1 || sample > len(shards) {
+		sample = len(shards)
+	}
+
+	for i := 0; i < sample; i++ {
+		// every len/sample-th shard, so that the sample spans the key space
+		e, err := DirUsage(filepath.Join(dir, shards[i*len(shards)/sample]))
+		if err != nil {
+			return est, err
+		}
+		est = est.Add(e)
+	}
+	if sample > 0 {
+		est.Keys = est.Keys * int64(len(shards)) / int64(sample)
+		est.Bytes = est.Bytes * int64(len(shards)) / int64(sample)
+	}
+	return est, nil
+}
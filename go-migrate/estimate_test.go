@@ -0,0 +1,56 @@
+package migrate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestShardUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shards")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 20 shards of 5 files of 10 bytes, and a file outside them, left out
+	for s := 0; s < 20; s++ {
+		shard := filepath.Join(dir, fmt.Sprintf("%02d", s))
+		if err := os.Mkdir(shard, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for f := 0; f < 5; f++ {
+			if err := ioutil.WriteFile(filepath.Join(shard, fmt.Sprint(f)), make([]byte, 10), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "SHARDING"), []byte("v1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	want := Estimate{Keys: 100, Bytes: 1000}
+
+	for _, sample := range []int{3, 20, 100, 0} {
+		got, err := ShardUsage(dir, sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("sample %d: got %+v, want %+v", sample, got, want)
+		}
+	}
+
+	// an uneven sample is scaled: 6 files in the one shard read
+	if err := ioutil.WriteFile(filepath.Join(dir, "00", "extra"), make([]byte, 10), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := ShardUsage(dir, 1); got.Keys != 120 {
+		t.Errorf("sampling one shard: %d keys, want 120", got.Keys)
+	}
+
+	if got, err := ShardUsage(filepath.Join(dir, "missing"), 3); err != nil || got != (Estimate{}) {
+		t.Errorf("missing directory: %+v, %v", got, err)
+	}
+}
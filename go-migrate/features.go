@@ -0,0 +1,106 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FeaturesEnv is the environment variable holding comma-separated feature
+// flags, e.g. "mg8.skip-verify=true,mg3.skip-pin-check=true". Flags given on the
+// command line take precedence.
+const FeaturesEnv = "IPFS_MIGRATION_FLAGS"
+
+// Features holds per-migration tunables keyed by "<package>.<name>", such as
+// "mg8.skip-verify". They let a migration expose a setting without adding a
+// global command line flag. Features implements flag.Value so it can be
+// filled from repeated "-flag key=value" arguments.
+type Features map[string]string
+
+func (f Features) String() string {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + f[k]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Set parses a "key=value" pair. A bare "key" means "key=true".
+func (f Features) Set(s string) error {
+	kv := strings.SplitN(s, "=", 2)
+	key := strings.TrimSpace(kv[0])
+	if !strings.Contains(key, ".") {
+		return fmt.Errorf("invalid feature flag %q: expected <migration>.<name>[=value]", s)
+	}
+	val := "true"
+	if len(kv) == 2 {
+		val = strings.TrimSpace(kv[1])
+	}
+	f[key] = val
+	return nil
+}
+
+// MergeEnv adds the flags from FeaturesEnv that are not already set in f.
+func (f Features) MergeEnv() error {
+	env := os.Getenv(FeaturesEnv)
+	if env == "" {
+		return nil
+	}
+	fromEnv := Features{}
+	for _, s := range strings.Split(env, ",") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		if err := fromEnv.Set(s); err != nil {
+			return fmt.Errorf("%s: %w", FeaturesEnv, err)
+		}
+	}
+	for k, v := range fromEnv {
+		if _, ok := f[k]; !ok {
+			f[k] = v
+		}
+	}
+	return nil
+}
+
+// Feature returns the value of the feature flag key.
+func (o Options) Feature(key string) (string, bool) {
+	v, ok := o.Features[key]
+	return v, ok
+}
+
+// FeatureBool returns the feature flag key as a bool, or def if it is not
+// set or does not parse.
+func (o Options) FeatureBool(key string, def bool) bool {
+	v, ok := o.Features[key]
+	if !ok {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// FeatureInt returns the feature flag key as an int, or def if it is not
+// set or does not parse.
+func (o Options) FeatureInt(key string, def int) int {
+	v, ok := o.Features[key]
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
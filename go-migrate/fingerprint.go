@@ -0,0 +1,154 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// FingerprintFile holds the Fingerprint taken after the last successful
+// migration of the repo.
+const FingerprintFile = "migration.fingerprint"
+
+// Fingerprint summarizes what migrations assume about a repo's layout.
+// Comparing the fingerprint left by the last run with the repo as found
+// reveals changes made by other tools in between.
+type Fingerprint struct {
+	Time    time.Time `json:"time"`
+	Version string    `json:"version"`
+	// Mounts lists the datastore backends as "mountpoint=type".
+	Mounts []string `json:"mounts"`
+	// ShardFunc is the flatfs shard function of the blocks mount, if any.
+	ShardFunc    string `json:"shard_func,omitempty"`
+	ConfigSHA256 string `json:"config_sha256"`
+}
+
+// TakeFingerprint fingerprints the repo at path.
+func TakeFingerprint(path string) (Fingerprint, error) {
+	rp := mfsr.RepoPath(path)
+	fp := Fingerprint{Time: time.Now().UTC()}
+
+	v, err := rp.Version()
+	if err != nil {
+		return fp, err
+	}
+	fp.Version = v
+
+	data, err := ioutil.ReadFile(rp.ConfigFile())
+	if err != nil {
+		return fp, err
+	}
+	sum := sha256.Sum256(data)
+	fp.ConfigSHA256 = hex.EncodeToString(sum[:])
+
+	// repos from before Datastore.Spec have no mounts to record
+	mounts, _ := rp.Mounts()
+	for _, m := range mounts {
+		fp.Mounts = append(fp.Mounts, m.Mountpoint+"="+m.Type)
+		if m.Type != "flatfs" {
+			continue
+		}
+		dir := filepath.Join(path, m.Path())
+		if sf, err := ioutil.ReadFile(filepath.Join(dir, "SHARDING")); err == nil {
+			fp.ShardFunc = strings.TrimSpace(string(sf))
+		} else if sf, ok := m.Spec["shardFunc"].(string); ok {
+			fp.ShardFunc = sf
+		}
+	}
+	sort.Strings(fp.Mounts)
+	return fp, nil
+}
+
+// Diff describes how g differs from fp, ignoring the time and version.
+// Structural changes come first. The blocks stored are not compared, as
+// the daemon adds and removes them in normal use.
+func (fp Fingerprint) Diff(g Fingerprint) []string {
+	var d []string
+	if a, b := strings.Join(fp.Mounts, ","), strings.Join(g.Mounts, ","); a != b {
+		d = append(d, fmt.Sprintf("datastore mounts changed from %q to %q", a, b))
+	}
+	if fp.ShardFunc != g.ShardFunc {
+		d = append(d, fmt.Sprintf("flatfs shard function changed from %q to %q", fp.ShardFunc, g.ShardFunc))
+	}
+	if fp.ConfigSHA256 != g.ConfigSHA256 {
+		d = append(d, "config changed")
+	}
+	return d
+}
+
+// ReadFingerprint reads the fingerprint left in the repo at path. ok is
+// false if there is none.
+func ReadFingerprint(path string) (fp Fingerprint, ok bool, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(path, FingerprintFile))
+	if os.IsNotExist(err) {
+		return fp, false, nil
+	}
+	if err != nil {
+		return fp, false, err
+	}
+	if err := json.Unmarshal(data, &fp); err != nil {
+		return fp, false, fmt.Errorf("reading %s: %w", FingerprintFile, err)
+	}
+	return fp, true, nil
+}
+
+// WriteFingerprint fingerprints the repo at path and stores the result in
+// FingerprintFile.
+func WriteFingerprint(path string) error {
+	fp, err := TakeFingerprint(path)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(fp, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(path, FingerprintFile), data, 0644)
+}
+
+// CheckFingerprint compares the repo at path with the fingerprint left by
+// the last run and returns the differences, if any.
+func CheckFingerprint(path string) ([]string, error) {
+	last, ok, err := ReadFingerprint(path)
+	if err != nil || !ok {
+		return nil, err
+	}
+	cur, err := TakeFingerprint(path)
+	if err != nil {
+		return nil, err
+	}
+	return last.Diff(cur), nil
+}
+
+// warnFingerprint logs changes made to the repo since the last run.
+func warnFingerprint(opts Options) {
+	log := opts.Logger()
+	diff, err := CheckFingerprint(opts.Path)
+	if err != nil {
+		log.Debug("cannot check repo fingerprint: %s", err)
+		return
+	}
+	for _, d := range diff {
+		log.Warn("repo modified since the last migration: %s", d)
+	}
+}
+
+// updateFingerprint records the repo's fingerprint after a successful run.
+func updateFingerprint(opts Options) {
+	// the 1-to-2 migration moves the repo, leaving nothing at opts.Path
+	if _, err := os.Stat(opts.Path); err != nil {
+		return
+	}
+	if err := WriteFingerprint(opts.Path); err != nil {
+		opts.Logger().Warn("failed to write repo fingerprint: %s", err)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package migrate
+
+// FreeSpace returns ErrFreeSpaceUnknown, as the free space cannot be asked
+// for on this platform.
+func FreeSpace(path string) (int64, error) {
+	return 0, ErrFreeSpaceUnknown
+}
@@ -0,0 +1,94 @@
+package migrate
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// ToolRelease is the fs-repo-migrations release running. Release builds set
+// it with -ldflags "-X github.com/ipfs/fs-repo-migrations/go-migrate.ToolRelease=v1.2.3";
+// otherwise it is the main module version from the build info, or "devel".
+var ToolRelease = ""
+
+func init() {
+	if ToolRelease == "" {
+		ToolRelease = "devel"
+		if bi, ok := debug.ReadBuildInfo(); ok && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			ToolRelease = bi.Main.Version
+		}
+	}
+	mfsr.Tool = "fs-repo-migrations " + ToolRelease
+}
+
+// HistoryEntry records one migration run against a repo. The history is
+// read back from the repo's journal, see mfsr.JournalFile.
+type HistoryEntry struct {
+	Time       time.Time `json:"time"`
+	Migration  string    `json:"migration"` // the step, e.g. "9-to-8"
+	From       int       `json:"from"`
+	To         int       `json:"to"`
+	Revert     bool      `json:"revert,omitempty"`
+	Tool       string    `json:"tool"`
+	DurationMs int64     `json:"duration_ms"`
+	Result     string    `json:"result"` // "ok" or "error"
+	Error      string    `json:"error,omitempty"`
+}
+
+// ReadHistory returns the migration runs recorded in the journal of the
+// repo at path, oldest first. A run ends with PhaseDone or with a failed
+// phase; runs still going, or interrupted, are left out.
+func ReadHistory(path string) ([]HistoryEntry, error) {
+	es, err := mfsr.RepoPath(path).Journal()
+	if err != nil {
+		return nil, err
+	}
+
+	type run struct {
+		pid       int
+		migration string
+	}
+	started := make(map[run]time.Time)
+	var entries []HistoryEntry
+	for _, e := range es {
+		if e.Migration == "" {
+			continue
+		}
+		r := run{e.PID, e.Migration}
+		if e.Phase == PhaseStart && e.Error == "" {
+			started[r] = e.Time
+			continue
+		}
+		if e.Phase != PhaseDone && e.Error == "" {
+			continue
+		}
+		h := HistoryEntry{Time: e.Time, Migration: e.Migration, Tool: e.Tool, Result: "ok", Error: e.Error}
+		if e.Error != "" {
+			h.Result = "error"
+		}
+		if _, err := fmt.Sscanf(e.Migration, "%d-to-%d", &h.From, &h.To); err != nil {
+			log.Debug("%s: skipping unknown migration %q", mfsr.JournalFile, e.Migration)
+			continue
+		}
+		h.Revert = h.From > h.To
+		if t, ok := started[r]; ok {
+			h.Time = t
+			h.DurationMs = e.Time.Sub(t).Milliseconds()
+			delete(started, r)
+		}
+		entries = append(entries, h)
+	}
+	return entries, nil
+}
+
+func (e HistoryEntry) String() string {
+	s := fmt.Sprintf("%s  %d to %d  %s  %s  %s", e.Time.Local().Format(time.RFC3339), e.From, e.To,
+		e.Result, time.Duration(e.DurationMs)*time.Millisecond, e.Tool)
+	if e.Error != "" {
+		s += "  " + e.Error
+	}
+	return s
+}
@@ -0,0 +1,173 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Phases of a migration recorded in the journal, see mfsr.JournalFile.
+// Each is written before the work it names begins. An entry with an error
+// records that the phase failed.
+const (
+	// PhaseStart records the intent to run a migration; nothing has
+	// changed yet.
+	PhaseStart = "start"
+	// PhaseMigrate is the migration's own Apply or Revert.
+	PhaseMigrate = "migrate"
+	// PhaseFinish records a migration that succeeded. Address
+	// normalization, config rules and policy have run before it wrote the
+	// new version, or run in this phase if it did not write it through
+	// mfsr.
+	PhaseFinish = "finish"
+	// PhaseDone records a completed migration.
+	PhaseDone = "done"
+	// PhaseSnapshot records a snapshot taken before a run, to revert to if
+	// a migration of the run is interrupted.
+	PhaseSnapshot = "snapshot"
+)
+
+// Resumer is implemented by migrations that pick up their own interrupted
+// work when run again, e.g. from a checkpoint. Recover resumes them rather
+// than reverting.
+type Resumer interface {
+	Resumable() bool
+}
+
+// Recovery actions.
+const (
+	// Resume runs the interrupted migration again, or carries on after it
+	// if it had completed.
+	Resume = "resume"
+	// Revert restores the snapshot taken before the interrupted run.
+	Revert = "revert"
+	// Manual means the repo is in a state the journal cannot explain.
+	Manual = "manual"
+)
+
+// Recovery tells how to recover from an interrupted or failed migration.
+type Recovery struct {
+	// Entry is the last journal entry of the migration.
+	Entry  mfsr.JournalEntry `json:"entry"`
+	Action string            `json:"action"`
+	// Snapshot is the snapshot to revert to.
+	Snapshot string `json:"snapshot,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+func (r Recovery) String() string {
+	state := "was interrupted"
+	if r.Entry.Error != "" {
+		state = "failed"
+	}
+	return fmt.Sprintf("migration %s %s in phase %s; %s: %s", r.Entry.Migration, state, r.Entry.Phase, r.Action, r.Reason)
+}
+
+// JournalPhase appends the entry for step entering phase, or failing in it
+// if err is not nil, to the journal of the repo at path.
+func JournalPhase(path string, step Step, phase string, err error) error {
+	e := mfsr.JournalEntry{Migration: step.ID(), Phase: phase}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	return mfsr.RepoPath(path).AppendJournal(e)
+}
+
+// JournalSnapshot records that the snapshot at snapshotPath was taken of
+// the repo at path before migrating it.
+func JournalSnapshot(path, snapshotPath string) error {
+	return mfsr.RepoPath(path).AppendJournal(mfsr.JournalEntry{Phase: PhaseSnapshot, Snapshot: snapshotPath})
+}
+
+// journal records a phase of the step run with opts. The 1-to-2 migration
+// moves the repo, leaving nothing at opts.Path to journal to; the caller
+// records its end, see JournalPhase.
+func journal(step Step, opts Options, phase string, err error) error {
+	if _, serr := os.Stat(opts.Path); serr != nil {
+		return nil
+	}
+	jerr := JournalPhase(opts.Path, step, phase, err)
+	opts.Artifacts.Add(mfsr.RepoPath(opts.Path).JournalFile())
+	if jerr != nil && err != nil {
+		// the migration error matters more
+		opts.Logger().Warn("failed to journal migration failure: %s", jerr)
+		return nil
+	}
+	return jerr
+}
+
+// Recover reads the journal of the repo at path and tells how to recover
+// from the last migration run on it, or returns nil if that one completed.
+//
+// A migration that had not started, or had completed before the runner was
+// interrupted, is resumed. One interrupted half way is resumed if it is a
+// Resumer; otherwise, if a snapshot was taken before the run, the repo is
+// reverted to it, and if none was, the migration is run again over its
+// partial work, as it always has been.
+func Recover(path string, migrations []Migration) (*Recovery, error) {
+	rp := mfsr.RepoPath(path)
+	entries, err := rp.Journal()
+	if err != nil {
+		return nil, err
+	}
+	last := -1
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Migration != "" {
+			last = i
+			break
+		}
+	}
+	if last < 0 || entries[last].Phase == PhaseDone {
+		return nil, nil
+	}
+	r := &Recovery{Entry: entries[last], Action: Resume}
+
+	var from, to int
+	if _, err := fmt.Sscanf(r.Entry.Migration, "%d-to-%d", &from, &to); err != nil {
+		return nil, fmt.Errorf("journal names unknown migration %q", r.Entry.Migration)
+	}
+	v, err := rp.VersionNum()
+	var notFound mfsr.VersionFileNotFound
+	if errors.As(err, &notFound) {
+		v = 0
+	} else if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case r.Entry.Phase == PhaseStart:
+		r.Reason = "the repo was not changed yet"
+	case v == to:
+		r.Reason = fmt.Sprintf("the repo already is at version %d", to)
+	case v != from:
+		r.Action = Manual
+		r.Reason = fmt.Sprintf("the repo is at version %d, expected %d or %d", v, from, to)
+	case resumable(migrations, from, to):
+		r.Reason = "the migration resumes its own work"
+	default:
+		for i := last - 1; i >= 0; i-- {
+			if e := entries[i]; e.Phase == PhaseSnapshot && e.PID == r.Entry.PID {
+				r.Action = Revert
+				r.Snapshot = e.Snapshot
+				r.Reason = "restoring the snapshot taken before the run"
+				return r, nil
+			}
+		}
+		r.Reason = "no snapshot was taken, running the migration again over its partial work"
+	}
+	return r, nil
+}
+
+// resumable reports whether the migration taking the repo from version
+// from to version to, either way, is a Resumer that can resume.
+func resumable(migrations []Migration, from, to int) bool {
+	for _, m := range migrations {
+		if (m.FromVersion() == from && m.ToVersion() == to) || (m.FromVersion() == to && m.ToVersion() == from) {
+			r, ok := m.(Resumer)
+			return ok && r.Resumable()
+		}
+	}
+	return false
+}
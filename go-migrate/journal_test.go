@@ -0,0 +1,168 @@
+package migrate
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+type resumableMigration struct{ fakeMigration }
+
+func (resumableMigration) Resumable() bool { return true }
+
+func TestRecover(t *testing.T) {
+	step := Step{Migration: fakeMigration{8, 9, 1, true}}
+	for _, c := range []struct {
+		name      string
+		version   string
+		ms        []Migration
+		snapshot  bool
+		phases    []string
+		err       error
+		action    string
+		reverting bool
+	}{
+		{"done", "9", nil, false, []string{PhaseStart, PhaseMigrate, PhaseFinish, PhaseDone}, nil, "", false},
+		{"not started", "8", nil, true, []string{PhaseStart}, nil, Resume, false},
+		{"migrated", "9", nil, true, []string{PhaseStart, PhaseMigrate, PhaseFinish}, nil, Resume, false},
+		{"half way", "8", nil, false, []string{PhaseStart, PhaseMigrate}, nil, Resume, false},
+		{"half way with snapshot", "8", nil, true, []string{PhaseStart, PhaseMigrate}, nil, Revert, true},
+		{"failed with snapshot", "8", nil, true, []string{PhaseStart, PhaseMigrate}, errors.New("boom"), Revert, true},
+		{"resumable", "8", []Migration{resumableMigration{fakeMigration{8, 9, 1, true}}}, true, []string{PhaseStart, PhaseMigrate}, nil, Resume, false},
+		{"unexpected version", "7", nil, true, []string{PhaseStart, PhaseMigrate}, nil, Manual, false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "journal")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			if err := mfsr.RepoPath(dir).WriteVersion(c.version); err != nil {
+				t.Fatal(err)
+			}
+			if c.snapshot {
+				if err := JournalSnapshot(dir, "/snapshots/before"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i, p := range c.phases {
+				var err error
+				if i == len(c.phases)-1 {
+					err = c.err
+				}
+				if err := JournalPhase(dir, step, p, err); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			r, err := Recover(dir, c.ms)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.action == "" {
+				if r != nil {
+					t.Fatalf("got %s, want nothing to recover", r)
+				}
+				return
+			}
+			if r == nil || r.Action != c.action {
+				t.Fatalf("got %v, want %s", r, c.action)
+			}
+			if r.Entry.Phase != c.phases[len(c.phases)-1] || r.Entry.Migration != "8-to-9" {
+				t.Errorf("wrong entry %+v", r.Entry)
+			}
+			if c.reverting != (r.Snapshot == "/snapshots/before") {
+				t.Errorf("snapshot %q", r.Snapshot)
+			}
+		})
+	}
+}
+
+func TestRecoverIgnoresOtherRunsSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rp := mfsr.RepoPath(dir)
+	if err := rp.WriteVersion("8"); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte(`{"phase":"snapshot","pid":1,"snapshot":"/snapshots/old"}` + "\n" + "{truncated by a cra")
+	if err := ioutil.WriteFile(rp.JournalFile(), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := JournalPhase(dir, Step{Migration: fakeMigration{8, 9, 1, true}}, PhaseMigrate, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Recover(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil || r.Action != Resume {
+		t.Fatalf("got %v, want resume", r)
+	}
+}
+
+func TestReadHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rp := mfsr.RepoPath(dir)
+	if err := rp.WriteVersion("8"); err != nil {
+		t.Fatal(err)
+	}
+	up := Step{Migration: fakeMigration{8, 9, 1, true}}
+	down := Step{Migration: fakeMigration{8, 9, 1, true}, Revert: true}
+	for _, j := range []struct {
+		step  Step
+		phase string
+		err   error
+	}{
+		{up, PhaseStart, nil},
+		{up, PhaseMigrate, nil},
+		{up, PhaseDone, nil},
+		{down, PhaseStart, nil},
+		{down, PhaseMigrate, errors.New("boom")},
+		{up, PhaseStart, nil},
+	} {
+		if err := JournalPhase(dir, j.step, j.phase, j.err); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rp.WriteVersion("9"); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := ReadHistory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 2 {
+		t.Fatalf("got %d runs, want 2: %v", len(h), h)
+	}
+	if h[0].Migration != "8-to-9" || h[0].Result != "ok" || h[0].Revert {
+		t.Errorf("first run: %v", h[0])
+	}
+	if h[1].Migration != "9-to-8" || h[1].Result != "error" || h[1].Error != "boom" || !h[1].Revert {
+		t.Errorf("second run: %v", h[1])
+	}
+
+	ts, err := rp.VersionHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ts) != 2 || ts[0].Old != "" || ts[1].Old != "8" || ts[1].New != "9" {
+		t.Errorf("version history: %+v", ts)
+	}
+	if r, err := Recover(dir, nil); err != nil || r == nil || r.Entry.Phase != PhaseStart {
+		t.Errorf("version entries should not hide the run in progress: %v, %v", r, err)
+	}
+}
@@ -0,0 +1,158 @@
+package migrate
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// memoryCheckInterval bounds how often MemoryLimit reads the heap size,
+// which stops the world for a moment.
+const memoryCheckInterval = 100 * time.Millisecond
+
+// MemoryLimit holds heavy work back while the heap is above a limit, so
+// that migrations of several repos run at once stay within one memory
+// budget together. Batches in flight finish and free what they hold; new
+// ones wait until the heap is back under the limit. One batch is always
+// let through when none is in flight, as waiting could then never help.
+//
+// All methods are safe to call on a nil *MemoryLimit, which does not limit
+// anything.
+type MemoryLimit struct {
+	limit uint64
+	heap  func() uint64
+
+	mu       sync.Mutex
+	inFlight int
+	checked  time.Time
+	over     bool
+	held     bool // work is being held back, for logging
+}
+
+// NewMemoryLimit returns a MemoryLimit keeping the heap under bytes. A
+// limit of 0 is no limit, for which NewMemoryLimit returns nil.
+func NewMemoryLimit(bytes uint64) *MemoryLimit {
+	if bytes == 0 {
+		return nil
+	}
+	return &MemoryLimit{limit: bytes, heap: heapInUse}
+}
+
+func heapInUse() uint64 {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.HeapInuse
+}
+
+// Wait blocks while the heap is above the limit and other batches are in
+// flight, then counts a batch in flight until Done. It returns false if
+// stop is closed while waiting.
+func (m *MemoryLimit) Wait(stop <-chan struct{}) bool {
+	if m == nil {
+		return true
+	}
+	for {
+		if m.admit() {
+			return true
+		}
+		// garbage left by finished batches is not counted against them
+		runtime.GC()
+		select {
+		case <-stop:
+			return false
+		case <-time.After(memoryCheckInterval):
+		}
+	}
+}
+
+func (m *MemoryLimit) admit() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if time.Since(m.checked) >= memoryCheckInterval {
+		m.over = m.heap() > m.limit
+		m.checked = time.Now()
+	}
+	if m.over && m.inFlight > 0 {
+		if !m.held {
+			m.held = true
+			log.Info("memory in use is above the %d MB limit, holding work back", m.limit>>20)
+		}
+		return false
+	}
+	if m.held && !m.over {
+		m.held = false
+		log.Info("memory in use is back under the limit")
+	}
+	m.inFlight++
+	return true
+}
+
+// Done marks a batch admitted by Wait as finished.
+func (m *MemoryLimit) Done() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	m.inFlight--
+	m.mu.Unlock()
+}
+
+// Memory budgets are planned for blocks of up to budgetBlockSize, the
+// largest block ipfs transfers, with memoryReserve kept aside for the
+// runtime, datastore caches and everything else that is not a block.
+const (
+	budgetBlockSize = 2 << 20
+	memoryReserve   = 32 << 20
+)
+
+// MinMemory is the smallest memory budget FitMemory can plan for: the
+// reserve and a few blocks.
+const MinMemory = memoryReserve + 4*budgetBlockSize
+
+// MemoryBudget returns the budget in bytes of a -max-memory flag of mb
+// megabytes, 0 for no limit.
+func MemoryBudget(mb int) (uint64, error) {
+	if mb < 0 {
+		return 0, fmt.Errorf("-max-memory cannot be negative")
+	}
+	budget := uint64(mb) << 20
+	if budget != 0 && budget < MinMemory {
+		return 0, fmt.Errorf("-max-memory must be at least %d MB", MinMemory>>20)
+	}
+	return budget, nil
+}
+
+// FitMemory lowers Workers, ChanBuffer and BatchSize so that the blocks
+// they can hold at once fit in budget bytes: each worker holds a batch of
+// values and the block it is copying, and each queue slot may hold a
+// value. Knobs already under what the budget allows are left alone, and
+// none goes below 1. It does not set Memory, which callers sharing a
+// budget between runs set to one MemoryLimit.
+func (o *Options) FitMemory(budget uint64) {
+	if budget == 0 {
+		return
+	}
+	o.setDefaults()
+	slots := 4
+	if budget > MinMemory {
+		slots = int((budget - memoryReserve) / budgetBlockSize)
+	}
+	o.Workers = clamp(o.Workers, slots/4)
+	o.ChanBuffer = clamp(o.ChanBuffer, slots/4)
+	o.BatchSize = clamp(o.BatchSize, (slots-o.ChanBuffer)/o.Workers-2)
+	o.Logger().Debug("sized for %d MB of memory: %d workers, batches of %d keys, queues of %d", budget>>20, o.Workers, o.BatchSize, o.ChanBuffer)
+}
+
+// clamp returns n lowered to max, and at least 1.
+func clamp(n, max int) int {
+	if n > max {
+		n = max
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
@@ -0,0 +1,93 @@
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimit(t *testing.T) {
+	if NewMemoryLimit(0) != nil {
+		t.Fatal("no limit should give a nil MemoryLimit")
+	}
+	var heap uint64 = 200
+	m := NewMemoryLimit(100)
+	m.heap = func() uint64 { return heap }
+
+	stop := make(chan struct{})
+	// over the limit with nothing in flight: one batch goes through
+	if !m.Wait(stop) {
+		t.Fatal("first batch held back")
+	}
+	done := make(chan bool)
+	go func() { done <- m.Wait(stop) }()
+	select {
+	case <-done:
+		t.Fatal("second batch let through while over the limit")
+	case <-time.After(3 * memoryCheckInterval):
+	}
+
+	// the first batch finishing lets the second through
+	m.Done()
+	if !<-done {
+		t.Fatal("second batch not let through")
+	}
+
+	go func() { done <- m.Wait(stop) }()
+	close(stop)
+	if <-done {
+		t.Error("Wait returned true after stop")
+	}
+
+	heap = 50
+	time.Sleep(memoryCheckInterval)
+	if !m.Wait(nil) || !m.Wait(nil) {
+		t.Error("batches held back under the limit")
+	}
+
+	var nilLimit *MemoryLimit
+	if !nilLimit.Wait(nil) {
+		t.Error("nil MemoryLimit held work back")
+	}
+	nilLimit.Done()
+}
+
+func TestFitMemory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	o := NewOptions(dir, WithWorkers(8))
+	o.FitMemory(0)
+	if o.Workers != 8 || o.BatchSize != DefaultBatchSize || o.ChanBuffer != DefaultChanBuffer {
+		t.Fatalf("no budget changed the knobs: %+v", o.Flags)
+	}
+
+	// 256 MB leaves 112 blocks of 2 MB
+	o.FitMemory(256 << 20)
+	if o.Workers != 8 || o.ChanBuffer != 28 || o.BatchSize != 8 {
+		t.Errorf("got %d workers, queues of %d, batches of %d; want 8, 28, 8", o.Workers, o.ChanBuffer, o.BatchSize)
+	}
+	held := o.Workers*(o.BatchSize+2) + o.ChanBuffer
+	if uint64(held)*budgetBlockSize+memoryReserve > 256<<20 {
+		t.Errorf("%d blocks held do not fit", held)
+	}
+
+	o = NewOptions(dir, WithWorkers(8))
+	o.FitMemory(MinMemory)
+	if o.Workers != 1 || o.ChanBuffer != 1 || o.BatchSize != 1 {
+		t.Errorf("smallest budget: %d workers, queues of %d, batches of %d; want 1 each", o.Workers, o.ChanBuffer, o.BatchSize)
+	}
+
+	if _, err := MemoryBudget(-1); err == nil {
+		t.Error("negative budget accepted")
+	}
+	if _, err := MemoryBudget(10); err == nil {
+		t.Error("budget under MinMemory accepted")
+	}
+	if b, err := MemoryBudget(512); err != nil || b != 512<<20 {
+		t.Errorf("MemoryBudget(512) = %d, %v", b, err)
+	}
+}
@@ -2,19 +2,91 @@ package migrate
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
-// Options are migration options. For now all flags are options.
+// Options are migration options. For now all flags are options, including
+// the tuning knobs (Workers, BatchSize, ChanBuffer, BackupDir). Workers,
+// BatchSize and ChanBuffer are honored by the migrations that move keys
+// through the pipeline package, 1-to-2 and 3-to-4, and BatchSize also by
+// the purge, car, convert and orphans packages; the other migrations
+// ignore them. Use NewOptions to construct Options with defaults applied.
 type Options struct {
 	Flags
 	Verbose bool
+
+	// Artifacts collects files produced by the migration (backups, the
+	// journal and history) so they can be checksummed at the end of the
+	// run. May be nil.
+	Artifacts *Artifacts
+
+	// Shutdown is signalled when the process is asked to terminate.
+	// Migrations check it between batches and register hooks to flush
+	// and release what they hold. May be nil.
+	Shutdown *Shutdown
+
+	// Window restricts heavy work to a daily execution window. May be nil,
+	// meaning work may run at any time.
+	Window *Window
+
+	// Throttle limits the rate of heavy work. May be nil, meaning no
+	// limit.
+	Throttle *Throttle
+
+	// Memory holds heavy work back while the heap is above a limit. May be
+	// nil, meaning no limit. It may be shared by migrations of several
+	// repos, as may Throttle.
+	Memory *MemoryLimit
+
+	// Pause lets the operator hold heavy work back. May be nil, meaning
+	// work is never paused.
+	Pause *Pause
+
+	// ConfigRules are site-specific config edits applied by each
+	// migration, just before it writes the new version, e.g. to keep custom
+	// API addresses. Rules are not applied when reverting.
+	ConfigRules configrules.Rules
+
+	// Policy lists config keys to pin, force or forbid, enforced after
+	// each migration is applied, after ConfigRules.
+	Policy configrules.Policy
+
+	// BackupKey encrypts the backup files written with CreateBackup, see
+	// keycrypt.NewWriter. May be nil, meaning backups are plain.
+	BackupKey []byte
+
+	// Telemetry receives statistics. May be nil, see Count, Timing and
+	// ReportError.
+	Telemetry Telemetry
+
+	// Log is the logger migrations write to. May be nil, meaning the
+	// stump package default; see Logger.
+	Log *log.Logger
+}
+
+// Logger returns o.Log, or the stump package default if it is unset.
+func (o Options) Logger() *log.Logger {
+	if o.Log == nil {
+		return log.Default
+	}
+	return o.Log
 }
 
 // Migration represents
 type Migration interface {
 
-	// Versions is the "v-to-v" version string.
-	Versions() string
+	// FromVersion is the repo version the migration applies to.
+	FromVersion() int
+
+	// ToVersion is the repo version the migration produces.
+	ToVersion() int
 
 	// Reversible returns whether this migration can be reverted.
 	// Endeavor to make them all reversible. This is here only to warn users
@@ -29,10 +101,174 @@ type Migration interface {
 	Revert(Options) error
 }
 
-func SplitVersion(s string) (from int, to int) {
-	_, err := fmt.Scanf(s, "%d-to-%d", &from, &to)
+// Execute checks m's requirements, then applies m, or runs it backward if
+// opts.Revert is set (see Downgrader), reporting the outcome to
+// opts.Telemetry and recording each phase in the repo's journal. Before m
+// writes the new version, or after it for migrations not writing it through
+// mfsr, the config's addresses are normalized for the new version and, once
+// applied, opts.ConfigRules are applied to the config and opts.Policy
+// enforced on it. The repo is compared against its Fingerprint before, and
+// fingerprinted after. While m runs the repo carries an in-progress marker,
+// see mfsr.BeginMigration.
+func Execute(m Migration, opts Options) error {
+	op := "apply"
+	if opts.Revert {
+		op = "revert"
+	}
+	name := "runner." + Versions(m) + "." + op
+
+	opts.Log = opts.Logger().ForMigration(Versions(m), opts.Verbose)
+
+	step := Step{Migration: m, Revert: opts.Revert}
+	phase := PhaseStart
+	err := CheckRequirements(m, opts.Path)
+	if err == nil {
+		err = beginMigration(m, opts)
+	}
+	if err == nil {
+		err = journal(step, opts, phase, nil)
+	}
+	var before map[string]interface{}
+	if err == nil && !opts.Revert {
+		before, err = policySnapshot(opts)
+	}
+	if err == nil && !(opts.Window.Wait(opts.Shutdown.Stopping()) && opts.Pause.Wait(opts.Shutdown.Stopping())) {
+		// do not start a migration outside the execution window, or
+		// while paused
+		err = ErrInterrupted
+	}
+	if err == nil {
+		phase = PhaseMigrate
+		err = journal(step, opts, phase, nil)
+	}
+	if err == nil {
+		warnFingerprint(opts)
+		target := m.ToVersion()
+		if opts.Revert {
+			target = m.FromVersion()
+		}
+		// finish the config before the migration writes the new version,
+		// so that a failing rule leaves the repo at the old one
+		finished := false
+		deregister := mfsr.RepoPath(opts.Path).OnVersionChange(func(old, new string) error {
+			if new != strconv.Itoa(target) || finished {
+				return nil
+			}
+			finished = true
+			return finishConfig(m, opts, target, before)
+		})
+		start := time.Now()
+		if opts.Revert {
+			err = revert(m, opts)
+		} else {
+			err = m.Apply(opts)
+		}
+		deregister()
+		opts.Timing(name, time.Since(start))
+		if err == nil {
+			phase = PhaseFinish
+			err = journal(step, opts, phase, nil)
+		}
+		if err == nil && !finished {
+			// the migration wrote its version without mfsr
+			err = finishConfig(m, opts, target, before)
+		}
+	}
 	if err != nil {
-		panic(err.Error())
+		journal(step, opts, phase, err)
+		opts.ReportError(name, err)
+		return err
 	}
-	return
+	opts.Count("runner.migrations", 1)
+	updateFingerprint(opts)
+	if err := endMigration(opts); err != nil {
+		return err
+	}
+	return journal(step, opts, PhaseDone, nil)
+}
+
+// finishConfig normalizes the config's addresses for version target and,
+// when m is applied, applies opts.ConfigRules and enforces opts.Policy,
+// given the config from before m. The config is written once, and not at
+// all if a rule or the policy fails.
+func finishConfig(m Migration, opts Options, target int, before map[string]interface{}) error {
+	// the 1-to-2 migration moves the repo, leaving nothing at opts.Path
+	rp := mfsr.RepoPath(MovedRepoPath(opts.Path))
+	if _, err := os.Stat(rp.ConfigFile()); os.IsNotExist(err) {
+		if len(opts.ConfigRules.For(target)) > 0 || !opts.Policy.Empty() {
+			return fmt.Errorf("cannot apply the config rules and site policy: %w", err)
+		}
+		opts.Logger().Warn("no config at %s to normalize", rp.ConfigFile())
+		return nil
+	}
+	cfg, err := rp.Config()
+	if err != nil {
+		return err
+	}
+
+	changed := normalizeConfigAddresses(opts, cfg, target)
+	if !opts.Revert {
+		ok, err := applyConfigRules(m, opts, cfg)
+		if err != nil {
+			return err
+		}
+		changed = changed || ok
+		if ok, err = applyPolicy(opts, cfg, before); err != nil {
+			return err
+		}
+		changed = changed || ok
+	}
+	if !changed {
+		return nil
+	}
+	return rp.WriteConfig(cfg)
+}
+
+// beginMigration marks the repo as being migrated by m, refusing while
+// another process may still be migrating it. The marker stays behind if m
+// fails, as the repo may then be in an intermediate state.
+func beginMigration(m Migration, opts Options) error {
+	prev, err := mfsr.RepoPath(opts.Path).BeginMigration(Step{Migration: m, Revert: opts.Revert}.ID())
+	if err != nil {
+		return err
+	}
+	if prev != nil && prev.PID != os.Getpid() {
+		opts.Logger().Warn("resuming after interrupted migration %s", prev)
+	}
+	return nil
+}
+
+// endMigration annotates the version with this tool and clears the
+// in-progress marker, unless the migration moved the repo away from opts.Path,
+// taking the marker along; see EndMigration.
+func endMigration(opts Options) error {
+	if _, err := os.Stat(opts.Path); err != nil {
+		return nil
+	}
+	rp := mfsr.RepoPath(opts.Path)
+	if err := rp.Annotate(mfsr.AnnotationMigratedBy, mfsr.Tool); err != nil {
+		opts.Logger().Warn("failed to annotate repo version: %s", err)
+	}
+	return rp.EndMigration()
+}
+
+// MovedRepoPath returns where the repo at path lives after a migration.
+// The 1-to-2 migration moves ~/.go-ipfs to ~/.ipfs, and its revert moves
+// it back.
+func MovedRepoPath(path string) string {
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	for _, mv := range [][2]string{{".go-ipfs", ".ipfs"}, {".ipfs", ".go-ipfs"}} {
+		moved := strings.Replace(path, mv[0], mv[1], 1)
+		if _, err := os.Stat(moved); moved != path && err == nil {
+			return moved
+		}
+	}
+	return path
+}
+
+// Versions returns the display name of m, e.g. "8-to-9".
+func Versions(m Migration) string {
+	return fmt.Sprintf("%d-to-%d", m.FromVersion(), m.ToVersion())
 }
@@ -0,0 +1,135 @@
+package migrate
+
+import "path/filepath"
+
+// Defaults for the tuning knobs carried in Options. Migrations should read
+// the values from Options rather than hard-coding their own constants.
+const (
+	DefaultWorkers    = 1
+	DefaultBatchSize  = 1000
+	DefaultChanBuffer = 1000
+)
+
+// Option configures Options. See NewOptions.
+type Option func(*Options)
+
+// NewOptions returns Options for the repo at path with the given options
+// applied and every unset tuning knob filled with its default.
+func NewOptions(path string, opts ...Option) Options {
+	o := Options{}
+	o.Path = path
+	for _, opt := range opts {
+		opt(&o)
+	}
+	o.setDefaults()
+	return o
+}
+
+// WithFeature sets the feature flag key to value, see Features.
+func WithFeature(key, value string) Option {
+	return func(o *Options) {
+		if o.Features == nil {
+			o.Features = Features{}
+		}
+		o.Features[key] = value
+	}
+}
+
+// WithVerbose enables verbose logging.
+func WithVerbose(v bool) Option {
+	return func(o *Options) {
+		o.Verbose = v
+		o.Flags.Verbose = v
+	}
+}
+
+// WithWorkers sets the number of concurrent workers a migration may use.
+func WithWorkers(n int) Option {
+	return func(o *Options) {
+		o.Workers = n
+	}
+}
+
+// WithBatchSize sets the number of keys committed per datastore batch.
+func WithBatchSize(n int) Option {
+	return func(o *Options) {
+		o.BatchSize = n
+	}
+}
+
+// WithChanBuffer sets the depth of channels between pipeline stages.
+func WithChanBuffer(n int) Option {
+	return func(o *Options) {
+		o.ChanBuffer = n
+	}
+}
+
+// WithBootstrapFile sets the file replacing the bootstrap list, see
+// BootstrapConv.
+func WithBootstrapFile(path string) Option {
+	return func(o *Options) {
+		o.BootstrapFile = path
+	}
+}
+
+// WithBackupDir sets the directory backup files are written to, see
+// BackupFile.
+func WithBackupDir(dir string) Option {
+	return func(o *Options) {
+		o.BackupDir = dir
+	}
+}
+
+// BeginBatch is called by migrations before each unit of heavy work. It
+// waits while the execution window is closed, work is paused, or the
+// throttle or memory limit holds the work back, and returns false once
+// shutdown has begun, in which case the migration should stop with
+// ErrInterrupted. Every true result must be paired with a call to EndBatch.
+func (o Options) BeginBatch() bool {
+	if !o.Window.Wait(o.Shutdown.Stopping()) {
+		return false
+	}
+	if !o.Pause.Wait(o.Shutdown.Stopping()) {
+		return false
+	}
+	if !o.Throttle.Wait(o.Shutdown.Stopping()) {
+		return false
+	}
+	if !o.Memory.Wait(o.Shutdown.Stopping()) {
+		return false
+	}
+	if !o.Shutdown.Begin() {
+		o.Memory.Done()
+		return false
+	}
+	return true
+}
+
+// Transferred reports n bytes read or written by the current batch, for
+// byte rate limits, see Throttle.
+func (o Options) Transferred(n int64) {
+	o.Throttle.Charge(n)
+}
+
+// EndBatch marks the unit of work started with BeginBatch as finished.
+func (o Options) EndBatch() {
+	o.Memory.Done()
+	o.Shutdown.Done()
+}
+
+// setDefaults fills zero-valued tuning knobs. BackupDir defaults to
+// BackupsDir in the repo.
+func (o *Options) setDefaults() {
+	if o.Workers <= 0 {
+		o.Workers = DefaultWorkers
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultBatchSize
+	}
+	if o.ChanBuffer <= 0 {
+		o.ChanBuffer = DefaultChanBuffer
+	}
+	if o.BackupDir == "" {
+		o.BackupDir = filepath.Join(o.Path, BackupsDir)
+	}
+}
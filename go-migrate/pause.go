@@ -0,0 +1,87 @@
+package migrate
+
+import (
+	"sync"
+
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// Pause lets an operator hold heavy work back at will, e.g. from the
+// dashboard. Like a closed Window, it stops migrations at the next
+// BeginBatch until work is resumed.
+//
+// All methods are safe to call on a nil *Pause, which is never paused.
+type Pause struct {
+	mu     sync.Mutex
+	paused bool
+	resume chan struct{} // closed on Resume
+}
+
+// NewPause returns a Pause that is not paused.
+func NewPause() *Pause {
+	return &Pause{}
+}
+
+// Pause holds work back from the next BeginBatch on.
+func (p *Pause) Pause() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	changed := !p.paused
+	if changed {
+		p.paused = true
+		p.resume = make(chan struct{})
+	}
+	p.mu.Unlock()
+	if changed {
+		log.Info("paused by the operator, work stops at the next checkpoint")
+	}
+}
+
+// Resume lets work carry on.
+func (p *Pause) Resume() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	changed := p.paused
+	if changed {
+		p.paused = false
+		close(p.resume)
+	}
+	p.mu.Unlock()
+	if changed {
+		log.Info("resumed by the operator")
+	}
+}
+
+// Paused reports whether work is held back.
+func (p *Pause) Paused() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// Wait blocks while work is paused. It returns false if stop is closed
+// while waiting.
+func (p *Pause) Wait(stop <-chan struct{}) bool {
+	if p == nil {
+		return true
+	}
+	p.mu.Lock()
+	paused, resume := p.paused, p.resume
+	p.mu.Unlock()
+	if !paused {
+		return true
+	}
+	select {
+	case <-stop:
+		return false
+	case <-resume:
+		return true
+	}
+}
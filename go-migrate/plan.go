@@ -0,0 +1,172 @@
+package migrate
+
+import (
+	"fmt"
+)
+
+// Coster is implemented by migrations that know their relative cost. A
+// migration that does not implement it costs 1.
+type Coster interface {
+	Cost() int
+}
+
+// Applier is implemented by migrations that only apply to some repos, such
+// as a fast path for flatfs-only repos or a backend-specific variant.
+type Applier interface {
+	// Applicable reports whether the migration can run on the repo at path,
+	// which is at the migration's starting version.
+	Applicable(path string) (bool, error)
+}
+
+// Step is one migration of a plan, applied or reverted.
+type Step struct {
+	Migration Migration
+	Revert    bool
+}
+
+func (s Step) String() string {
+	if s.Revert {
+		return fmt.Sprintf("%d to %d", s.Migration.ToVersion(), s.Migration.FromVersion())
+	}
+	return fmt.Sprintf("%d to %d", s.Migration.FromVersion(), s.Migration.ToVersion())
+}
+
+// ID names the step in the in-progress marker and the journal, e.g.
+// "8-to-9", or "9-to-8" when reverting.
+func (s Step) ID() string {
+	if s.Revert {
+		return fmt.Sprintf("%d-to-%d", s.Migration.ToVersion(), s.Migration.FromVersion())
+	}
+	return Versions(s.Migration)
+}
+
+func cost(m Migration) int {
+	if c, ok := m.(Coster); ok {
+		return c.Cost()
+	}
+	return 1
+}
+
+// Plan returns the cheapest sequence of steps taking the repo at path from
+// version from to version to. Migrations may skip versions; those starting
+// at from that are not applicable to the repo are left out, so the plan falls
+// back to the linear chain. Migrations starting at later versions can only
+// be checked once the repo is there, so callers running the plan should
+// replan after each step, see NextStep. When paths cost the same, the one
+// using migrations listed earlier in ms wins. Downgrades only use migrations
+// that CanDowngrade.
+func Plan(ms []Migration, path string, from, to int) ([]Step, error) {
+	if from == to {
+		return nil, nil
+	}
+	revert := to < from
+	lo, hi := from, to
+	if revert {
+		lo, hi = to, from
+	}
+
+	var edges []Migration
+	for _, m := range ms {
+		if m.FromVersion() < lo || m.ToVersion() > hi || m.FromVersion() >= m.ToVersion() {
+			continue
+		}
+		if revert && !CanDowngrade(m) {
+			continue
+		}
+		start := m.FromVersion()
+		if revert {
+			start = m.ToVersion()
+		}
+		if a, ok := m.(Applier); ok && start == from {
+			ok, err := a.Applicable(path)
+			if err != nil {
+				return nil, fmt.Errorf("checking migration %s: %w", Versions(m), err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		edges = append(edges, m)
+	}
+
+	// Versions only ever move in one direction, so the graph is acyclic and
+	// relaxing edges in version order finds the cheapest path.
+	type node struct {
+		cost int
+		via  Migration
+		ok   bool
+	}
+	best := make(map[int]node)
+	best[from] = node{ok: true}
+	versions := make([]int, 0, hi-lo+1)
+	for v := from; ; {
+		versions = append(versions, v)
+		if v == to {
+			break
+		}
+		if revert {
+			v--
+		} else {
+			v++
+		}
+	}
+
+	for _, v := range versions {
+		n := best[v]
+		if !n.ok {
+			continue
+		}
+		for _, m := range edges {
+			src, dst := m.FromVersion(), m.ToVersion()
+			if revert {
+				src, dst = dst, src
+			}
+			if src != v {
+				continue
+			}
+			c := n.cost + cost(m)
+			if d := best[dst]; !d.ok || c < d.cost {
+				best[dst] = node{cost: c, via: m, ok: true}
+			}
+		}
+	}
+
+	if !best[to].ok {
+		return nil, fmt.Errorf("no migration path from %d to %d", from, to)
+	}
+
+	var steps []Step
+	for v := to; v != from; {
+		m := best[v].via
+		steps = append([]Step{{Migration: m, Revert: revert}}, steps...)
+		if revert {
+			v = m.ToVersion()
+		} else {
+			v = m.FromVersion()
+		}
+	}
+	return steps, nil
+}
+
+// NextStep returns the first step of the plan taking the repo at path from
+// version from to version to. Running one step at a time and replanning
+// from the version it leaves the repo at checks every migration for
+// applicability against the repo as it is when the migration would start.
+func NextStep(ms []Migration, path string, from, to int) (Step, error) {
+	steps, err := Plan(ms, path, from, to)
+	if err != nil {
+		return Step{}, err
+	}
+	if len(steps) == 0 {
+		return Step{}, fmt.Errorf("no migration needed from %d to %d", from, to)
+	}
+	return steps[0], nil
+}
+
+// End returns the version the step leaves the repo at.
+func (s Step) End() int {
+	if s.Revert {
+		return s.Migration.FromVersion()
+	}
+	return s.Migration.ToVersion()
+}
@@ -0,0 +1,113 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+)
+
+type fakeMigration struct {
+	from, to   int
+	cost       int
+	applicable bool
+}
+
+func (m fakeMigration) FromVersion() int                { return m.from }
+func (m fakeMigration) ToVersion() int                  { return m.to }
+func (m fakeMigration) Reversible() bool                { return true }
+func (m fakeMigration) Apply(Options) error             { return nil }
+func (m fakeMigration) Revert(Options) error            { return nil }
+func (m fakeMigration) Cost() int                       { return m.cost }
+func (m fakeMigration) Applicable(string) (bool, error) { return m.applicable, nil }
+
+func planString(t *testing.T, ms []Migration, from, to int) string {
+	t.Helper()
+	steps, err := Plan(ms, "", from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s []string
+	for _, st := range steps {
+		s = append(s, st.String())
+	}
+	return strings.Join(s, ", ")
+}
+
+func TestPlan(t *testing.T) {
+	linear := []Migration{
+		fakeMigration{7, 8, 1, true},
+		fakeMigration{8, 9, 1, true},
+		fakeMigration{9, 10, 1, true},
+	}
+	fast := fakeMigration{8, 10, 1, true}
+
+	for _, c := range []struct {
+		name     string
+		ms       []Migration
+		from, to int
+		expected string
+	}{
+		{"linear", linear, 7, 10, "7 to 8, 8 to 9, 9 to 10"},
+		{"revert", linear, 10, 8, "10 to 9, 9 to 8"},
+		{"fast path", append(linear, fast), 7, 10, "7 to 8, 8 to 10"},
+		{"fast path revert", append(linear, fast), 10, 7, "10 to 8, 8 to 7"},
+		{"not applicable", append(linear, fakeMigration{8, 10, 1, false}), 8, 10, "8 to 9, 9 to 10"},
+		{"checked at its start", append(linear, fakeMigration{8, 10, 1, false}), 7, 10, "7 to 8, 8 to 10"},
+		{"too expensive", append(linear, fakeMigration{8, 10, 3, true}), 7, 10, "7 to 8, 8 to 9, 9 to 10"},
+		{"past target", append(linear, fast), 7, 9, "7 to 8, 8 to 9"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if s := planString(t, c.ms, c.from, c.to); s != c.expected {
+				t.Errorf("got plan %q, expected %q", s, c.expected)
+			}
+		})
+	}
+
+	if _, err := Plan(linear, "", 7, 11); err == nil {
+		t.Error("expected an error planning past the last migration")
+	}
+}
+
+func TestNextStep(t *testing.T) {
+	ms := []Migration{
+		fakeMigration{7, 8, 1, true},
+		fakeMigration{8, 9, 1, true},
+		fakeMigration{9, 10, 1, true},
+		fakeMigration{8, 10, 1, false},
+	}
+	var steps []string
+	for v := 7; v != 10; {
+		step, err := NextStep(ms, "", v, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		steps = append(steps, step.String())
+		v = step.End()
+	}
+	// the fast path is planned from 7 but not taken once 8 is reached
+	if s := strings.Join(steps, ", "); s != "7 to 8, 8 to 9, 9 to 10" {
+		t.Errorf("ran %q", s)
+	}
+}
+
+type oneWayMigration struct{ fakeMigration }
+
+func (m oneWayMigration) Reversible() bool { return false }
+
+type downgradableMigration struct{ oneWayMigration }
+
+func (m downgradableMigration) Downgrade(Options) error { return nil }
+
+func TestPlanDowngrade(t *testing.T) {
+	ms := []Migration{
+		fakeMigration{8, 9, 1, true},
+		oneWayMigration{fakeMigration{9, 10, 1, true}},
+	}
+	if _, err := Plan(ms, "", 10, 8); err == nil {
+		t.Error("expected an error planning back through a one-way migration")
+	}
+
+	ms[1] = downgradableMigration{oneWayMigration{fakeMigration{9, 10, 1, true}}}
+	if s := planString(t, ms, 10, 8); s != "10 to 9, 9 to 8" {
+		t.Errorf("got plan %q", s)
+	}
+}
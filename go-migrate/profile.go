@@ -0,0 +1,105 @@
+package migrate
+
+import (
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	rpprof "runtime/pprof"
+	"sync"
+	"time"
+
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// blockProfileRate samples about one goroutine blocking event per this
+// many nanoseconds spent blocked, enough to tell waiting on the disk from
+// waiting on locks without slowing the run.
+const blockProfileRate = int(time.Millisecond)
+
+// mutexProfileFraction samples one in this many contended lock events.
+const mutexProfileFraction = 100
+
+// StartProfiling writes a CPU profile to cpuFile and, when stopped, a heap
+// profile to memFile, and serves net/http/pprof at addr, each only if set.
+// The pprof listener also enables the block and mutex profiles, which show
+// whether workers wait on the datastore, the disk or each other. The
+// returned stop function writes the profiles out and closes the listener;
+// it may be called more than once, e.g. both deferred and as an OnRelease
+// hook, so that an interrupted run still leaves its profiles.
+func StartProfiling(cpuFile, memFile, addr string) (stop func() error, err error) {
+	var cpu *os.File
+	if cpuFile != "" {
+		if cpu, err = os.Create(cpuFile); err != nil {
+			return nil, err
+		}
+		if err = rpprof.StartCPUProfile(cpu); err != nil {
+			cpu.Close()
+			return nil, err
+		}
+	}
+
+	var ln net.Listener
+	if addr != "" {
+		if ln, err = net.Listen("tcp", addr); err != nil {
+			if cpu != nil {
+				rpprof.StopCPUProfile()
+				cpu.Close()
+			}
+			return nil, err
+		}
+		runtime.SetBlockProfileRate(blockProfileRate)
+		runtime.SetMutexProfileFraction(mutexProfileFraction)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		go http.Serve(ln, mux)
+		log.Info("pprof at http://%s/debug/pprof/", ln.Addr())
+	}
+
+	var (
+		once    sync.Once
+		stopErr error
+	)
+	stop = func() error {
+		once.Do(func() {
+			if cpu != nil {
+				rpprof.StopCPUProfile()
+				if stopErr = cpu.Close(); stopErr == nil {
+					log.Info("CPU profile written to %s", cpuFile)
+				}
+			}
+			if memFile != "" {
+				err := writeHeapProfile(memFile)
+				if err == nil {
+					log.Info("heap profile written to %s", memFile)
+				} else if stopErr == nil {
+					stopErr = err
+				}
+			}
+			if ln != nil {
+				ln.Close()
+			}
+		})
+		return stopErr
+	}
+	return stop, nil
+}
+
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	// up to date statistics, as of the last collection otherwise
+	runtime.GC()
+	if err := rpprof.Lookup("heap").WriteTo(f, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
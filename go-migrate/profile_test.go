@@ -0,0 +1,42 @@
+package migrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStartProfiling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cpu, mem := filepath.Join(dir, "cpu.pprof"), filepath.Join(dir, "mem.pprof")
+	stop, err := StartProfiling(cpu, mem, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+	// deferred and run as a shutdown hook both
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{cpu, mem} {
+		if fi, err := os.Stat(p); err != nil || fi.Size() == 0 {
+			t.Errorf("%s not written: %v", p, err)
+		}
+	}
+
+	stop, err = StartProfiling("", "", "")
+	if err != nil || stop() != nil {
+		t.Errorf("nothing to profile: %v", err)
+	}
+	if _, err := StartProfiling(filepath.Join(dir, "missing", "cpu.pprof"), "", ""); err == nil {
+		t.Error("no error for an unwritable profile")
+	}
+}
@@ -0,0 +1,144 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Requirement is a precondition a migration needs the repo to satisfy,
+// checked by the runner before the migration starts.
+type Requirement interface {
+	// Check returns nil if the repo at path satisfies the requirement.
+	Check(path string) error
+	// String describes the requirement, e.g. "keystore directory".
+	String() string
+}
+
+// Requirer is implemented by migrations that declare requirements.
+type Requirer interface {
+	Requirements() []Requirement
+}
+
+// CheckRequirements checks every requirement m declares against the repo
+// at path and reports all that are not met in a single error wrapping
+// ErrRequirementNotMet.
+func CheckRequirements(m Migration, path string) error {
+	r, ok := m.(Requirer)
+	if !ok {
+		return nil
+	}
+
+	var failed []string
+	for _, req := range r.Requirements() {
+		if err := req.Check(path); err != nil {
+			failed = append(failed, fmt.Sprintf("  - %s: %s", req, err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w for migration %s:\n%s", ErrRequirementNotMet, Versions(m), strings.Join(failed, "\n"))
+}
+
+type dirRequirement struct {
+	rel  string
+	desc string
+}
+
+// RequireDir requires the directory rel, relative to the repo, to exist.
+func RequireDir(rel, desc string) Requirement {
+	return dirRequirement{rel: rel, desc: desc}
+}
+
+func (r dirRequirement) Check(path string) error {
+	fi, err := os.Stat(filepath.Join(path, r.rel))
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", r.rel)
+	}
+	return nil
+}
+
+func (r dirRequirement) String() string {
+	return r.desc
+}
+
+type configKeyRequirement string
+
+// RequireConfigKey requires the dotted key, e.g. "Datastore.Spec", to be
+// present in the repo config.
+func RequireConfigKey(key string) Requirement {
+	return configKeyRequirement(key)
+}
+
+func (r configKeyRequirement) Check(path string) error {
+	cfg, err := mfsr.RepoPath(path).Config()
+	if err != nil {
+		return err
+	}
+	if _, ok := mfsr.ConfigValue(cfg, string(r)); !ok {
+		return fmt.Errorf("%s not found in config", string(r))
+	}
+	return nil
+}
+
+func (r configKeyRequirement) String() string {
+	return string(r) + " present in config"
+}
+
+type mountRequirement struct {
+	mountpoint string
+	types      []string
+}
+
+// RequireMount requires the datastore serving the keys under mountpoint to
+// be one of the given backend types, e.g. RequireMount("/blocks", "flatfs",
+// "badgerds"). Other types are accepted if the repo has a plugins
+// directory, which may provide them.
+func RequireMount(mountpoint string, types ...string) Requirement {
+	return mountRequirement{mountpoint: mountpoint, types: types}
+}
+
+func (r mountRequirement) Check(path string) error {
+	mounts, err := mfsr.RepoPath(path).Mounts()
+	if err != nil {
+		return err
+	}
+	m, ok := servingMount(mounts, r.mountpoint)
+	if !ok {
+		return fmt.Errorf("no datastore mounted at %s", r.mountpoint)
+	}
+	for _, t := range r.types {
+		if m.Type == t {
+			return nil
+		}
+	}
+	if fi, err := os.Stat(filepath.Join(path, "plugins")); err == nil && fi.IsDir() {
+		return nil
+	}
+	return fmt.Errorf("unsupported datastore type %q at %s", m.Type, m.Mountpoint)
+}
+
+// servingMount returns the mount with the longest mountpoint that key is
+// under, which is the one the mount datastore hands key to.
+func servingMount(mounts []mfsr.Mount, key string) (mfsr.Mount, bool) {
+	var best mfsr.Mount
+	found := false
+	for _, m := range mounts {
+		under := m.Mountpoint == "/" || key == m.Mountpoint || strings.HasPrefix(key, m.Mountpoint+"/")
+		if under && (!found || len(m.Mountpoint) > len(best.Mountpoint)) {
+			best, found = m, true
+		}
+	}
+	return best, found
+}
+
+func (r mountRequirement) String() string {
+	return strings.Join(r.types, " or ") + " mounted at " + r.mountpoint
+}
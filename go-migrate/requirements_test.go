@@ -0,0 +1,51 @@
+package migrate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRequireMount(t *testing.T) {
+	mount := func(mountpoint, typ string) map[string]interface{} {
+		return map[string]interface{}{
+			"mountpoint": mountpoint,
+			"type":       "measure",
+			"child":      map[string]interface{}{"type": typ, "path": typ},
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		spec map[string]interface{}
+		ok   bool
+	}{
+		{"flatfs", map[string]interface{}{"type": "mount", "mounts": []interface{}{mount("/blocks", "flatfs"), mount("/", "levelds")}}, true},
+		{"single badger", map[string]interface{}{"type": "measure", "child": map[string]interface{}{"type": "badgerds", "path": "badgerds"}}, true},
+		{"s3", map[string]interface{}{"type": "mount", "mounts": []interface{}{mount("/blocks", "s3ds"), mount("/", "levelds")}}, false},
+	} {
+		dir, err := ioutil.TempDir("", "migrate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		data, _ := json.Marshal(map[string]interface{}{"Datastore": map[string]interface{}{"Spec": tc.spec}})
+		if err := ioutil.WriteFile(filepath.Join(dir, "config"), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+		err = RequireMount("/blocks", "flatfs", "badgerds").Check(dir)
+		if (err == nil) != tc.ok {
+			t.Errorf("%s: got %v", tc.name, err)
+		}
+		if tc.ok {
+			continue
+		}
+		if err := os.Mkdir(filepath.Join(dir, "plugins"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := RequireMount("/blocks", "flatfs", "badgerds").Check(dir); err != nil {
+			t.Errorf("%s with plugins: %s", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package migrate
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// DefaultGracePeriod is how long in-flight batches may take to finish after
+// a termination signal.
+const DefaultGracePeriod = 30 * time.Second
+
+// ErrInterrupted is returned by migrations that stopped early because a
+// shutdown was requested.
+var ErrInterrupted = errors.New("migration interrupted by shutdown")
+
+// Shutdown coordinates a staged shutdown when the process is asked to
+// terminate. The stages run in order, each one logged:
+//
+//  1. stop accepting new batches (Begin returns false)
+//  2. wait up to the grace period for in-flight batches (Begin/Done)
+//  3. run OnFlush hooks, e.g. flush and fsync checkpoints
+//  4. run OnRelease hooks, e.g. release repo locks
+//
+// A Shutdown outlives the migrations registering hooks on it, so each
+// registration returns a func removing the hook again, which the migration
+// defers. All methods are safe to call on a nil *Shutdown, which never
+// stops.
+type Shutdown struct {
+	grace time.Duration
+
+	mu       sync.Mutex
+	stopping bool
+	stopCh   chan struct{}
+	inflight sync.WaitGroup
+	flush    []*hook
+	release  []*hook
+
+	once sync.Once
+	done chan struct{}
+}
+
+// NewShutdown returns a Shutdown with the given grace period.
+func NewShutdown(grace time.Duration) *Shutdown {
+	if grace <= 0 {
+		grace = DefaultGracePeriod
+	}
+	return &Shutdown{
+		grace:  grace,
+		stopCh: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Notify starts the shutdown when the process receives SIGINT or SIGTERM,
+// then exits with status 1 once all stages have run.
+func (s *Shutdown) Notify() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigs
+		log.Warn("received %s, shutting down", sig)
+		s.Stop()
+		os.Exit(1)
+	}()
+}
+
+// Stopping is closed once shutdown has begun.
+func (s *Shutdown) Stopping() <-chan struct{} {
+	if s == nil {
+		return nil
+	}
+	return s.stopCh
+}
+
+// Begin registers an in-flight batch. It returns false, registering nothing,
+// if shutdown has begun and the batch must not be started.
+func (s *Shutdown) Begin() bool {
+	if s == nil {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopping {
+		return false
+	}
+	s.inflight.Add(1)
+	return true
+}
+
+// Done marks an in-flight batch registered with Begin as finished.
+func (s *Shutdown) Done() {
+	if s == nil {
+		return
+	}
+	s.inflight.Done()
+}
+
+// OnFlush registers a hook run after in-flight batches have drained, and
+// returns a func deregistering it.
+func (s *Shutdown) OnFlush(fn func() error) (deregister func()) {
+	if s == nil {
+		return func() {}
+	}
+	return addHook(&s.mu, &s.flush, fn)
+}
+
+// OnRelease registers a hook run last, to release locks, and returns a func
+// deregistering it.
+func (s *Shutdown) OnRelease(fn func() error) (deregister func()) {
+	if s == nil {
+		return func() {}
+	}
+	return addHook(&s.mu, &s.release, fn)
+}
+
+// hook wraps a hook func so that it can be told apart from others when it
+// is deregistered.
+type hook struct {
+	fn func() error
+}
+
+// addHook appends fn to hooks, which mu guards, and returns a func removing
+// it again. Removal copies the list, so a copy taken to run the hooks is
+// left as it was.
+func addHook(mu *sync.Mutex, hooks *[]*hook, fn func() error) func() {
+	h := &hook{fn}
+	mu.Lock()
+	*hooks = append(*hooks, h)
+	mu.Unlock()
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for i, x := range *hooks {
+			if x == h {
+				*hooks = append((*hooks)[:i:i], (*hooks)[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Stop runs the shutdown stages. Only the first call does any work; later
+// calls wait for it to complete.
+func (s *Shutdown) Stop() {
+	if s == nil {
+		return
+	}
+	s.once.Do(func() {
+		defer close(s.done)
+
+		log.Info("shutdown: no longer accepting new batches")
+		s.mu.Lock()
+		s.stopping = true
+		close(s.stopCh)
+		flush, release := s.flush, s.release
+		s.mu.Unlock()
+
+		log.Info("shutdown: waiting up to %s for in-flight batches", s.grace)
+		drained := make(chan struct{})
+		go func() {
+			s.inflight.Wait()
+			close(drained)
+		}()
+		select {
+		case <-drained:
+			log.Info("shutdown: in-flight batches finished")
+		case <-time.After(s.grace):
+			log.Error("shutdown: grace period expired with batches still in flight")
+		}
+
+		runHooks("flushing backups and checkpoints", flush)
+		runHooks("releasing locks", release)
+		log.Info("shutdown: complete")
+	})
+	<-s.done
+}
+
+func runHooks(stage string, hooks []*hook) {
+	if len(hooks) == 0 {
+		return
+	}
+	log.Info("shutdown: %s", stage)
+	for _, h := range hooks {
+		if err := h.fn(); err != nil {
+			log.Error("shutdown: %s: %s", stage, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package migrate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShutdownDeregister(t *testing.T) {
+	s := NewShutdown(time.Second)
+	var ran []string
+	hook := func(name string) func() error {
+		return func() error {
+			ran = append(ran, name)
+			return nil
+		}
+	}
+
+	// a migration that finished before the shutdown
+	s.OnFlush(hook("old flush"))()
+	s.OnRelease(hook("old release"))()
+
+	dereg := s.OnRelease(hook("release"))
+	s.OnFlush(hook("flush"))
+	s.Stop()
+	dereg()
+
+	if len(ran) != 2 || ran[0] != "flush" || ran[1] != "release" {
+		t.Errorf("ran hooks %q, expected flush then release", ran)
+	}
+
+	var nilShutdown *Shutdown
+	nilShutdown.OnRelease(hook("nil"))()
+	nilShutdown.Stop()
+}
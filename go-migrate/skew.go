@@ -0,0 +1,65 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRepoTooNew means the repo was written by a newer tool than this one.
+var ErrRepoTooNew = errors.New("repo version is newer than this tool supports")
+
+// ipfsReleases maps each repo version to the first go-ipfs release
+// writing it, as listed in the README.
+var ipfsReleases = map[int]string{
+	1:  "v0.0.0",
+	2:  "v0.3.0",
+	3:  "v0.4.0",
+	4:  "v0.4.3",
+	5:  "v0.4.6",
+	6:  "v0.4.11",
+	7:  "v0.4.16",
+	8:  "v0.5.0",
+	9:  "v0.5.0",
+	10: "v0.6.0",
+	11: "v0.8.0",
+	12: "v0.12.0",
+}
+
+// IpfsRelease returns the first go-ipfs release writing repo version v.
+func IpfsRelease(v int) (string, bool) {
+	r, ok := ipfsReleases[v]
+	return r, ok
+}
+
+// VersionSkewError is returned when a repo is newer than the tool. It
+// matches ErrRepoTooNew with errors.Is.
+type VersionSkewError struct {
+	RepoVersion int `json:"repo_version"`
+	ToolVersion int `json:"tool_version"`
+	// Ipfs is the first go-ipfs release writing RepoVersion, if known.
+	Ipfs string `json:"ipfs,omitempty"`
+}
+
+func (e *VersionSkewError) Error() string {
+	msg := fmt.Sprintf("repo version %d is newer than the highest version this tool supports (%d)", e.RepoVersion, e.ToolVersion)
+	msg += "; a newer release of fs-repo-migrations is needed"
+	if e.Ipfs != "" {
+		msg += fmt.Sprintf(" (repo written by go-ipfs %s or later)", e.Ipfs)
+	}
+	return msg
+}
+
+func (e *VersionSkewError) Is(target error) bool {
+	return target == ErrRepoTooNew
+}
+
+// CheckVersionSkew returns a *VersionSkewError if repoVersion is higher than
+// toolVersion, the highest version the running tool can migrate.
+func CheckVersionSkew(repoVersion, toolVersion int) error {
+	if repoVersion <= toolVersion {
+		return nil
+	}
+	e := &VersionSkewError{RepoVersion: repoVersion, ToolVersion: toolVersion}
+	e.Ipfs, _ = IpfsRelease(repoVersion)
+	return e
+}
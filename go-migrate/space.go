@@ -0,0 +1,129 @@
+package migrate
+
+import (
+	"fmt"
+)
+
+// SpaceStage is one stage of a migration's disk usage, e.g. copying blocks
+// to a new datastore or deleting the old one. Sizes are relative to the
+// usage when the stage starts.
+type SpaceStage struct {
+	Name string `json:"name"`
+	// Peak is the most bytes the stage uses at any point while it runs.
+	Peak int64 `json:"peak"`
+	// Final is the bytes used once the stage is done, negative if the stage
+	// frees space.
+	Final int64 `json:"final"`
+}
+
+// SpaceModel is the disk usage of a migration over time, as its stages in
+// the order they run.
+type SpaceModel []SpaceStage
+
+// Peak returns the most bytes used above the usage before the migration at
+// any point while it runs. It is never negative.
+func (m SpaceModel) Peak() int64 {
+	var used, peak int64
+	for _, s := range m {
+		if used+s.Peak > peak {
+			peak = used + s.Peak
+		}
+		used += s.Final
+		if used > peak {
+			peak = used
+		}
+	}
+	return peak
+}
+
+// Final returns the bytes used once the migration is done, compared to
+// before it ran.
+func (m SpaceModel) Final() int64 {
+	var used int64
+	for _, s := range m {
+		used += s.Final
+	}
+	return used
+}
+
+// CopyThenDelete models data of size bytes being written out in full before
+// the original is deleted, so the copy and the original coexist at the peak.
+func CopyThenDelete(what string, bytes int64) SpaceModel {
+	return SpaceModel{
+		{Name: "copy " + what, Peak: bytes, Final: bytes},
+		{Name: "delete old " + what, Final: -bytes},
+	}
+}
+
+// InPlace models data that is changed without growing, e.g. renamed files.
+func InPlace(what string) SpaceModel {
+	return SpaceModel{{Name: "change " + what + " in place"}}
+}
+
+// SpaceModeler is implemented by migrations that can model the disk space
+// they use over time, so that a run can be refused before a nearly full
+// disk fills up halfway. The direction is taken from opts.Revert.
+// ModelSpace must not modify the repo.
+type SpaceModeler interface {
+	ModelSpace(opts Options) (SpaceModel, error)
+}
+
+// StepSpace is the modelled disk usage of one step of a plan.
+type StepSpace struct {
+	Step Step
+	// Model is nil if the migration does not model its space use.
+	Model SpaceModel
+	// Start is the bytes used above the usage before the plan when the step
+	// starts.
+	Start int64
+}
+
+// SpacePlan is the modelled disk usage of a whole plan.
+type SpacePlan struct {
+	Steps []StepSpace
+	// Peak is the most bytes used above the usage before the plan at any
+	// point, counting the modelled steps only.
+	Peak int64
+	// Final is the bytes used once the plan is done, compared to before.
+	Final int64
+	// Unknown lists the steps that do not model their space use.
+	Unknown []Step
+}
+
+// PlanSpace models the disk usage of running steps on the repo at path.
+// A step starts from the usage the steps before it left behind, so a step
+// that frees space makes room for the next one's peak.
+func PlanSpace(steps []Step, path string) (SpacePlan, error) {
+	var p SpacePlan
+	for _, step := range steps {
+		ss := StepSpace{Step: step, Start: p.Final}
+		sm, ok := step.Migration.(SpaceModeler)
+		if !ok {
+			p.Unknown = append(p.Unknown, step)
+			p.Steps = append(p.Steps, ss)
+			continue
+		}
+		opts := NewOptions(path)
+		opts.Revert = step.Revert
+		model, err := sm.ModelSpace(opts)
+		if err != nil {
+			return p, fmt.Errorf("space model for %s failed: %w", step, err)
+		}
+		ss.Model = model
+		if peak := p.Final + model.Peak(); peak > p.Peak {
+			p.Peak = peak
+		}
+		p.Final += model.Final()
+		p.Steps = append(p.Steps, ss)
+	}
+	return p, nil
+}
+
+// CheckSpace returns an error wrapping ErrNotEnoughSpace if the plan's
+// peak does not fit in free bytes.
+func (p SpacePlan) CheckSpace(free int64) error {
+	if p.Peak <= free {
+		return nil
+	}
+	return fmt.Errorf("%w: up to %d bytes are needed at the peak, %d more than the %d bytes free", ErrNotEnoughSpace, p.Peak, p.Peak-free, free)
+}
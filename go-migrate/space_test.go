@@ -0,0 +1,61 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+)
+
+type spaceMigration struct {
+	fakeMigration
+	model SpaceModel
+}
+
+func (m spaceMigration) ModelSpace(Options) (SpaceModel, error) { return m.model, nil }
+
+func TestSpaceModel(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		model       SpaceModel
+		peak, final int64
+	}{
+		{"empty", nil, 0, 0},
+		{"in place", InPlace("files"), 0, 0},
+		{"copy then delete", CopyThenDelete("blocks", 100), 100, 0},
+		{"shrinks", SpaceModel{{Peak: 10, Final: -50}}, 10, -50},
+		{"grows after shrinking", SpaceModel{{Final: -50}, {Peak: 80, Final: 20}}, 30, -30},
+		{"final above peak", SpaceModel{{Peak: 5, Final: 5}, {Final: 5}}, 10, 10},
+	} {
+		if p, f := c.model.Peak(), c.model.Final(); p != c.peak || f != c.final {
+			t.Errorf("%s: peak %d, final %d; want %d, %d", c.name, p, f, c.peak, c.final)
+		}
+	}
+}
+
+func TestPlanSpace(t *testing.T) {
+	steps := []Step{
+		// frees 40 bytes, peaking at 10
+		{Migration: spaceMigration{fakeMigration{7, 8, 1, true}, SpaceModel{{Peak: 10, Final: -40}}}},
+		{Migration: fakeMigration{8, 9, 1, true}},
+		// copies 100 bytes, starting 40 below where the plan started
+		{Migration: spaceMigration{fakeMigration{9, 10, 1, true}, CopyThenDelete("blocks", 100)}},
+	}
+	sp, err := PlanSpace(steps, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sp.Peak != 60 || sp.Final != -40 {
+		t.Errorf("peak %d, final %d; want 60, -40", sp.Peak, sp.Final)
+	}
+	if len(sp.Unknown) != 1 || sp.Unknown[0].String() != "8 to 9" {
+		t.Errorf("unknown steps %v", sp.Unknown)
+	}
+	if sp.Steps[2].Start != -40 {
+		t.Errorf("last step starts at %d", sp.Steps[2].Start)
+	}
+	if err := sp.CheckSpace(60); err != nil {
+		t.Error(err)
+	}
+	if err := sp.CheckSpace(59); !errors.Is(err, ErrNotEnoughSpace) {
+		t.Errorf("got %v", err)
+	}
+}
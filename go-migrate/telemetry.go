@@ -0,0 +1,95 @@
+package migrate
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Telemetry receives statistics from the runner and migrations. It is
+// opt-in: unless an exporter is configured, statistics are discarded.
+// Implementations must be safe for concurrent use.
+//
+// Names are dotted, starting with the migration package for statistics a
+// migration reports itself, e.g. "mg1.blocks_moved".
+type Telemetry interface {
+	// Count adds delta to the counter name.
+	Count(name string, delta int64)
+	// Timing records that the operation name took d.
+	Timing(name string, d time.Duration)
+	// Error records that the operation name failed with err.
+	Error(name string, err error)
+}
+
+// NopTelemetry discards everything.
+var NopTelemetry Telemetry = nopTelemetry{}
+
+type nopTelemetry struct{}
+
+func (nopTelemetry) Count(string, int64)          {}
+func (nopTelemetry) Timing(string, time.Duration) {}
+func (nopTelemetry) Error(string, error)          {}
+
+// telemetry returns o.Telemetry, or NopTelemetry if it is unset.
+func (o Options) telemetry() Telemetry {
+	if o.Telemetry == nil {
+		return NopTelemetry
+	}
+	return o.Telemetry
+}
+
+// Count adds delta to the counter name, see Telemetry.
+func (o Options) Count(name string, delta int64) {
+	o.telemetry().Count(name, delta)
+}
+
+// Timing records the duration of the operation name, see Telemetry.
+func (o Options) Timing(name string, d time.Duration) {
+	o.telemetry().Timing(name, d)
+}
+
+// ReportError records that the operation name failed, see Telemetry.
+func (o Options) ReportError(name string, err error) {
+	o.telemetry().Error(name, err)
+}
+
+// TelemetryEvent is one record written by JSONTelemetry.
+type TelemetryEvent struct {
+	Time  time.Time `json:"time"`
+	Type  string    `json:"type"` // "count", "timing" or "error"
+	Name  string    `json:"name"`
+	Value int64     `json:"value,omitempty"` // delta, or duration in ms
+	Error string    `json:"error,omitempty"`
+}
+
+// JSONTelemetry exports every statistic as a line of JSON, for collection
+// by fleet-wide log or metrics pipelines.
+type JSONTelemetry struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONTelemetry returns a Telemetry writing JSON lines to w.
+func NewJSONTelemetry(w io.Writer) *JSONTelemetry {
+	return &JSONTelemetry{enc: json.NewEncoder(w)}
+}
+
+func (t *JSONTelemetry) emit(ev TelemetryEvent) {
+	ev.Time = time.Now().UTC()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.enc.Encode(ev)
+}
+
+func (t *JSONTelemetry) Count(name string, delta int64) {
+	t.emit(TelemetryEvent{Type: "count", Name: name, Value: delta})
+}
+
+func (t *JSONTelemetry) Timing(name string, d time.Duration) {
+	t.emit(TelemetryEvent{Type: "timing", Name: name, Value: d.Milliseconds()})
+}
+
+func (t *JSONTelemetry) Error(name string, err error) {
+	t.emit(TelemetryEvent{Type: "error", Name: name, Error: err.Error()})
+}
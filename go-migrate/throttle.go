@@ -0,0 +1,80 @@
+package migrate
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Throttle limits the rate of heavy work, so a migration can run on a
+// machine serving other workloads without saturating its disk. Work is
+// paced rather than done in bursts: each key and byte pushes back the time
+// the next batch may start. A nil Throttle does not limit anything.
+type Throttle struct {
+	keyCost  time.Duration
+	byteCost float64 // nanoseconds per byte
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewThrottle returns a Throttle allowing at most keysPerSec keys and
+// bytesPerSec bytes per second. A limit of 0 is no limit; if both are 0,
+// NewThrottle returns nil.
+func NewThrottle(keysPerSec int, bytesPerSec int64) (*Throttle, error) {
+	if keysPerSec < 0 || bytesPerSec < 0 {
+		return nil, fmt.Errorf("invalid throttle %d keys/s, %d bytes/s: limits cannot be negative", keysPerSec, bytesPerSec)
+	}
+	if keysPerSec == 0 && bytesPerSec == 0 {
+		return nil, nil
+	}
+	t := &Throttle{}
+	if keysPerSec > 0 {
+		t.keyCost = time.Second / time.Duration(keysPerSec)
+	}
+	if bytesPerSec > 0 {
+		t.byteCost = float64(time.Second) / float64(bytesPerSec)
+	}
+	return t, nil
+}
+
+// Wait blocks until the next key may be processed, then charges for it. It
+// returns false if stop is closed while waiting.
+func (t *Throttle) Wait(stop <-chan struct{}) bool {
+	if t == nil {
+		return true
+	}
+	d := t.reserve(time.Now(), 1, 0)
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Charge accounts for n bytes read or written, delaying the next Wait.
+func (t *Throttle) Charge(n int64) {
+	if t == nil {
+		return
+	}
+	t.reserve(time.Now(), 0, n)
+}
+
+// reserve charges for keys and bytes at now and returns how long to wait
+// before starting the work.
+func (t *Throttle) reserve(now time.Time, keys, bytes int64) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(time.Duration(keys)*t.keyCost + time.Duration(float64(bytes)*t.byteCost))
+	return wait
+}
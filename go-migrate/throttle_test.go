@@ -0,0 +1,46 @@
+package migrate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThrottleReserve(t *testing.T) {
+	th, err := NewThrottle(10, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	if d := th.reserve(now, 1, 0); d != 0 {
+		t.Fatalf("first key waited %s", d)
+	}
+	if d := th.reserve(now, 1, 0); d != 100*time.Millisecond {
+		t.Fatalf("second key waited %s, expected 100ms", d)
+	}
+	// 500 bytes at 1000 bytes/s push the next key back by 500ms
+	th.reserve(now, 0, 500)
+	if d := th.reserve(now, 1, 0); d != 700*time.Millisecond {
+		t.Fatalf("third key waited %s, expected 700ms", d)
+	}
+	// idle time is not saved up for bursts
+	later := now.Add(time.Minute)
+	if d := th.reserve(later, 1, 0); d != 0 {
+		t.Fatalf("key after idle time waited %s", d)
+	}
+}
+
+func TestNewThrottle(t *testing.T) {
+	if th, err := NewThrottle(0, 0); th != nil || err != nil {
+		t.Fatalf("no limits: got %v, %v", th, err)
+	}
+	if _, err := NewThrottle(-1, 0); err == nil {
+		t.Fatal("expected error for a negative limit")
+	}
+	// a nil Throttle never blocks
+	var th *Throttle
+	if !th.Wait(nil) {
+		t.Fatal("nil Throttle refused to proceed")
+	}
+	th.Charge(100)
+}
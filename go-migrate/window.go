@@ -0,0 +1,143 @@
+package migrate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// Window is a daily time-of-day range, in local time, during which heavy
+// migration work may run. A window may wrap midnight, e.g. "22:00-06:00".
+//
+// All methods are safe to call on a nil *Window, which is always open.
+type Window struct {
+	start, end time.Duration // offsets from midnight
+
+	mu     sync.Mutex
+	pause  []*hook
+	paused bool
+}
+
+// ParseWindow parses a window of the form "HH:MM-HH:MM".
+func ParseWindow(s string) (*Window, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid window %q: expected HH:MM-HH:MM", s)
+	}
+	start, err := parseClock(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid window %q: %w", s, err)
+	}
+	end, err := parseClock(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid window %q: %w", s, err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("invalid window %q: empty range", s)
+	}
+	return &Window{start: start, end: end}, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w *Window) String() string {
+	if w == nil {
+		return "always"
+	}
+	clock := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+	}
+	return clock(w.start) + "-" + clock(w.end)
+}
+
+// Contains reports whether t falls inside the window.
+func (w *Window) Contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+	off := sinceMidnight(t)
+	if w.start < w.end {
+		return off >= w.start && off < w.end
+	}
+	return off >= w.start || off < w.end
+}
+
+// NextOpen returns the next time at or after t that the window opens.
+func (w *Window) NextOpen(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+	midnight := t.Add(-sinceMidnight(t))
+	open := midnight.Add(w.start)
+	if !open.After(t) {
+		open = open.AddDate(0, 0, 1)
+	}
+	return open
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	y, m, d := t.Date()
+	return t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+}
+
+// OnPause registers a hook run each time work pauses because the window
+// closed, e.g. to write a checkpoint and release open files. It returns a
+// func deregistering the hook, see Shutdown.
+func (w *Window) OnPause(fn func() error) (deregister func()) {
+	if w == nil {
+		return func() {}
+	}
+	return addHook(&w.mu, &w.pause, fn)
+}
+
+// Wait blocks while the window is closed, running the pause hooks when the
+// window first closes. It returns false if stop is closed while waiting.
+func (w *Window) Wait(stop <-chan struct{}) bool {
+	if w == nil {
+		return true
+	}
+	for {
+		now := time.Now()
+		if w.Contains(now) {
+			w.mu.Lock()
+			if w.paused {
+				log.Info("execution window %s open, resuming", w)
+				w.paused = false
+			}
+			w.mu.Unlock()
+			return true
+		}
+
+		w.mu.Lock()
+		if !w.paused {
+			w.paused = true
+			log.Info("outside execution window %s, pausing until %s", w, w.NextOpen(now).Format(time.RFC3339))
+			for _, h := range w.pause {
+				if err := h.fn(); err != nil {
+					log.Warn("pause hook failed: %s", err)
+				}
+			}
+		}
+		w.mu.Unlock()
+
+		// Re-check at least every minute in case the clock jumps.
+		sleep := time.Until(w.NextOpen(now))
+		if sleep > time.Minute {
+			sleep = time.Minute
+		}
+		select {
+		case <-stop:
+			return false
+		case <-time.After(sleep):
+		}
+	}
+}
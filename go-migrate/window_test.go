@@ -0,0 +1,130 @@
+package migrate
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+func TestWindowContains(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2020, 1, 1, h, m, 0, 0, time.Local)
+	}
+
+	w, err := ParseWindow("22:00-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		t  time.Time
+		in bool
+	}{
+		{at(21, 59), false},
+		{at(22, 0), true},
+		{at(23, 30), true},
+		{at(5, 59), true},
+		{at(6, 0), false},
+		{at(12, 0), false},
+	} {
+		if w.Contains(c.t) != c.in {
+			t.Errorf("Contains(%s) = %t, expected %t", c.t.Format("15:04"), !c.in, c.in)
+		}
+	}
+
+	if next := w.NextOpen(at(12, 0)); !next.Equal(at(22, 0)) {
+		t.Errorf("NextOpen(12:00) = %s, expected 22:00", next)
+	}
+
+	w, err = ParseWindow("02:00-05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := w.NextOpen(at(6, 0)); !next.Equal(at(2, 0).AddDate(0, 0, 1)) {
+		t.Errorf("NextOpen(06:00) = %s, expected 02:00 the next day", next)
+	}
+}
+
+func TestParseWindowInvalid(t *testing.T) {
+	for _, s := range []string{"", "22:00", "25:00-01:00", "10:00-10:00", "a-b"} {
+		if _, err := ParseWindow(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+type recordingMigration struct {
+	fakeMigration
+	ran *bool
+}
+
+func (m recordingMigration) Revert(Options) error {
+	*m.ran = true
+	return nil
+}
+
+func TestExecuteStartsInsideWindow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "window")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	closed, err := ParseWindow(now.Add(2*time.Hour).Format("15:04") + "-" + now.Add(3*time.Hour).Format("15:04"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shutdown := NewShutdown(time.Second)
+	shutdown.Stop()
+
+	var ran bool
+	opts := NewOptions(dir)
+	opts.Revert = true
+	opts.Window = closed
+	opts.Shutdown = shutdown
+	if err := Execute(recordingMigration{fakeMigration{8, 9, 1, true}, &ran}, opts); !errors.Is(err, ErrInterrupted) {
+		t.Fatalf("got %v, want ErrInterrupted", err)
+	}
+	if ran {
+		t.Error("migration started outside the window")
+	}
+
+	entries, err := mfsr.RepoPath(dir).Journal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(entries); n == 0 || entries[n-1].Phase != PhaseStart || entries[n-1].Error == "" {
+		t.Errorf("journal %+v", entries)
+	}
+}
+
+func TestPause(t *testing.T) {
+	p := NewPause()
+	if !p.Wait(nil) {
+		t.Fatal("waited while not paused")
+	}
+	p.Pause()
+	done := make(chan bool)
+	go func() { done <- p.Wait(nil) }()
+	select {
+	case <-done:
+		t.Fatal("did not wait while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+	p.Resume()
+	if !<-done {
+		t.Error("Wait returned false on resume")
+	}
+
+	p.Pause()
+	stop := make(chan struct{})
+	close(stop)
+	if p.Wait(stop) {
+		t.Error("Wait returned true on stop")
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+func runHistory(args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the history as JSON lines")
+	versions := fs.Bool("versions", false, "show every change of the version file instead of migration runs")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+
+	var lines []string
+	var records []interface{}
+	if *versions {
+		ts, err := mfsr.RepoPath(ipfsdir).VersionHistory()
+		if err != nil {
+			return err
+		}
+		for _, t := range ts {
+			records = append(records, t)
+			lines = append(lines, fmt.Sprintf("%s  %q to %q  %s", t.Time.Local().Format(time.RFC3339), t.Old, t.New, t.Tool))
+		}
+	} else {
+		entries, err := gomigrate.ReadHistory(ipfsdir)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			records = append(records, e)
+			lines = append(lines, e.String())
+		}
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(lines) == 0 {
+		fmt.Printf("no history recorded in %s\n", ipfsdir)
+		return nil
+	}
+	for _, l := range lines {
+		fmt.Println(l)
+	}
+	return nil
+}
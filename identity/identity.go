@@ -0,0 +1,205 @@
+// Package identity replaces a repo's peer identity with a new ed25519 key,
+// for nodes still on the RSA keys older go-ipfs versions generated. The
+// node gets a new peer ID: the old key is kept in the keystore, so that
+// IPNS names published with it can still be updated, and in a backup file
+// next to the config.
+package identity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// DefaultOldKeyName is the keystore name the old key is saved under.
+const DefaultOldKeyName = "old-identity"
+
+// BackupPrefix starts the name of the backup file of an old identity,
+// followed by its peer ID and ".json".
+const BackupPrefix = "identity-backup-"
+
+const keyTypeEd25519 = 1
+
+// keyTypes names the libp2p key types, by the Type field of a marshaled
+// key.
+var keyTypes = map[uint64]string{0: "RSA", keyTypeEd25519: "Ed25519", 2: "Secp256k1", 3: "ECDSA"}
+
+// ErrAlreadyEd25519 is returned when the identity already is an ed25519
+// key.
+var ErrAlreadyEd25519 = errors.New("identity already is an ed25519 key")
+
+// Options controls a rotation.
+type Options struct {
+	// OldKeyName is the keystore name for the old key. It defaults to
+	// DefaultOldKeyName.
+	OldKeyName string
+	// Rand is the source of the new key. It defaults to crypto/rand.
+	Rand io.Reader
+}
+
+// Result describes a rotated identity.
+type Result struct {
+	OldPeerID, NewPeerID string
+	OldKeyType           string
+	// Backup is the file holding the old Identity section.
+	Backup string
+}
+
+// Rotate replaces the identity of the repo at path with a new ed25519
+// key. The old key is saved in the keystore first, so running Rotate
+// again after an interruption is safe. The repo must not be in use.
+func Rotate(path string, opts Options) (Result, error) {
+	var res Result
+	if opts.OldKeyName == "" {
+		opts.OldKeyName = DefaultOldKeyName
+	}
+	if opts.Rand == nil {
+		opts.Rand = rand.Reader
+	}
+	if err := validateKeyName(opts.OldKeyName); err != nil {
+		return res, err
+	}
+
+	rp := mfsr.RepoPath(path)
+	cfg, err := rp.Config()
+	if err != nil {
+		return res, err
+	}
+	id, ok := cfg["Identity"].(map[string]interface{})
+	if !ok {
+		return res, fmt.Errorf("no Identity section in %s", rp.ConfigFile())
+	}
+	res.OldPeerID, _ = id["PeerID"].(string)
+	encoded, _ := id["PrivKey"].(string)
+	old, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return res, fmt.Errorf("Identity.PrivKey: %w", err)
+	}
+	typ, err := keyType(old)
+	if err != nil {
+		return res, fmt.Errorf("Identity.PrivKey: %w", err)
+	}
+	if typ == keyTypeEd25519 {
+		return res, ErrAlreadyEd25519
+	}
+	res.OldKeyType = keyTypes[typ]
+	if scheme, err := keycrypt.RepoScheme(path); err != nil {
+		return res, err
+	} else if scheme != "" {
+		return res, errors.New("the keystore is encrypted; decrypt it first")
+	}
+
+	res.Backup = filepath.Join(path, BackupPrefix+res.OldPeerID+".json")
+	backup, err := json.MarshalIndent(id, "", "  ")
+	if err != nil {
+		return res, err
+	}
+	if err := mfsr.WriteFileAtomic(res.Backup, append(backup, '\n'), 0400); err != nil {
+		return res, err
+	}
+	if err := saveKey(path, opts.OldKeyName, old); err != nil {
+		return res, err
+	}
+
+	pub, priv, err := ed25519.GenerateKey(opts.Rand)
+	if err != nil {
+		return res, err
+	}
+	res.NewPeerID = PeerID(pub)
+	id["PeerID"] = res.NewPeerID
+	id["PrivKey"] = base64.StdEncoding.EncodeToString(marshalKey(keyTypeEd25519, priv))
+	return res, rp.WriteConfig(cfg)
+}
+
+// saveKey writes key to the keystore under name, unless an earlier run
+// already did. Any other key of that name is left alone.
+func saveKey(path, name string, key []byte) error {
+	vnum, err := mfsr.RepoPath(path).VersionNum()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(path, "keystore")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	file := filepath.Join(dir, keystoreName(vnum, name))
+	existing, err := ioutil.ReadFile(file)
+	switch {
+	case err == nil && string(existing) == string(key):
+		return nil
+	case err == nil:
+		return fmt.Errorf("the keystore already holds a key named %q", name)
+	case !os.IsNotExist(err):
+		return err
+	}
+	return mfsr.WriteFileAtomic(file, key, 0400)
+}
+
+// keystoreName returns the file name of key name at repo version vnum.
+// Names are base32 encoded from version 9 on.
+func keystoreName(vnum int, name string) string {
+	if vnum < 9 {
+		return name
+	}
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	return "key_" + strings.ToLower(enc.EncodeToString([]byte(name)))
+}
+
+// validateKeyName applies the rules ipfs key gen applies to names.
+func validateKeyName(name string) error {
+	switch {
+	case name == "self":
+		return errors.New(`cannot save the old key as "self"`)
+	case strings.Contains(name, "/"):
+		return fmt.Errorf("key name %q contains a slash", name)
+	case strings.HasPrefix(name, "."):
+		return fmt.Errorf("key name %q starts with a dot", name)
+	}
+	return nil
+}
+
+// PeerID returns the peer ID of an ed25519 public key: the base58 encoded
+// identity multihash of the marshaled key.
+func PeerID(pub ed25519.PublicKey) string {
+	key := marshalKey(keyTypeEd25519, pub)
+	return base58.Encode(append([]byte{0x00, byte(len(key))}, key...))
+}
+
+// marshalKey encodes a key the way libp2p does: a protobuf message with
+// the key type in field 1 and the key bytes in field 2.
+func marshalKey(typ uint64, data []byte) []byte {
+	out := []byte{0x08, byte(typ), 0x12}
+	var n [binary.MaxVarintLen64]byte
+	out = append(out, n[:binary.PutUvarint(n[:], uint64(len(data)))]...)
+	return append(out, data...)
+}
+
+// keyType returns the type of a key marshaled by libp2p.
+func keyType(key []byte) (uint64, error) {
+	if len(key) < 2 || key[0] != 0x08 {
+		return 0, errors.New("not a libp2p private key")
+	}
+	typ, n := binary.Uvarint(key[1:])
+	if n <= 0 {
+		return 0, errors.New("not a libp2p private key")
+	}
+	if _, ok := keyTypes[typ]; !ok {
+		return 0, fmt.Errorf("unknown key type %d", typ)
+	}
+	return typ, nil
+}
@@ -0,0 +1,94 @@
+package identity
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/mr-tron/base58/base58"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestPeerID(t *testing.T) {
+	pub := make(ed25519.PublicKey, ed25519.PublicKeySize)
+	id := PeerID(pub)
+	if !strings.HasPrefix(id, "12D3KooW") {
+		t.Errorf("unexpected ed25519 peer ID %s", id)
+	}
+	raw, err := base58.Decode(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte{0x00, 36, 0x08, 0x01, 0x12, 32}, pub...)
+	if !bytes.Equal(raw, want) {
+		t.Errorf("got %x, want %x", raw, want)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	rsaKey := marshalKey(0, []byte("not really an rsa key"))
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithKeys("self"),
+		migrationtest.WithConfig(func(cfg map[string]interface{}) {
+			id := cfg["Identity"].(map[string]interface{})
+			id["PrivKey"] = base64.StdEncoding.EncodeToString(rsaKey)
+		}))
+	oldID := r.Config()["Identity"].(map[string]interface{})["PeerID"]
+
+	res, err := Rotate(r.Path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.OldPeerID != oldID || res.OldKeyType != "RSA" {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	id := r.Config()["Identity"].(map[string]interface{})
+	if id["PeerID"] != res.NewPeerID {
+		t.Errorf("config has peer ID %v, want %s", id["PeerID"], res.NewPeerID)
+	}
+	priv, err := base64.StdEncoding.DecodeString(id["PrivKey"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ, err := keyType(priv); err != nil || typ != keyTypeEd25519 {
+		t.Fatalf("new key has type %d (%v)", typ, err)
+	}
+	key := ed25519.PrivateKey(priv[4:])
+	if PeerID(key.Public().(ed25519.PublicKey)) != res.NewPeerID {
+		t.Error("peer ID does not match the new key")
+	}
+
+	saved, err := ioutil.ReadFile(filepath.Join(r.Path, "keystore", r.KeystoreName(DefaultOldKeyName)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(saved, rsaKey) {
+		t.Error("old key not saved in the keystore")
+	}
+	r.AssertExists(filepath.Base(res.Backup))
+
+	if _, err := Rotate(r.Path, Options{}); err != ErrAlreadyEd25519 {
+		t.Errorf("expected ErrAlreadyEd25519, got %v", err)
+	}
+}
+
+func TestRotateKeepsOtherKeys(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithKeys(DefaultOldKeyName),
+		migrationtest.WithConfig(func(cfg map[string]interface{}) {
+			id := cfg["Identity"].(map[string]interface{})
+			id["PrivKey"] = base64.StdEncoding.EncodeToString(marshalKey(0, []byte("rsa")))
+		}))
+	before := r.Config()["Identity"]
+
+	if _, err := Rotate(r.Path, Options{}); err == nil {
+		t.Fatal("expected an existing key of the same name to be refused")
+	}
+	if id := r.Config()["Identity"].(map[string]interface{}); id["PrivKey"] != before.(map[string]interface{})["PrivKey"] {
+		t.Error("identity changed although the old key could not be saved")
+	}
+}
@@ -13,10 +13,14 @@ import (
 type Migration struct {
 }
 
-// Version is the int version number. This could be a string
-// in future versions
-func (m Migration) Versions() string {
-	return "0-to-1"
+// FromVersion is the repo version this migration applies to.
+func (m Migration) FromVersion() int {
+	return 0
+}
+
+// ToVersion is the repo version this migration produces.
+func (m Migration) ToVersion() int {
+	return 1
 }
 
 // Reversible returns whether this migration can be reverted.
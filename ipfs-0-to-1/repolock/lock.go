@@ -6,9 +6,14 @@ import (
 	"path"
 
 	"github.com/ipfs/fs-repo-migrations/ipfs-0-to-1/lock"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
 )
 
-var errRepoLock = `failed to acquire repo lock at %s/%s
+// ErrRepoLocked is returned, wrapped, when the repo lock is held by another
+// process. It is the same value as in later migrations.
+var ErrRepoLocked = mfsr.ErrRepoLocked
+
+var errRepoLock = `%w at %s/%s
 Is a daemon running? please stop it before running migration`
 
 // LockFile is the filename of the daemon lock, relative to config dir
@@ -18,7 +23,7 @@ const LockFile = "daemon.lock"
 func Lock(confdir string) (io.Closer, error) {
 	c, err := lock.Lock(path.Join(confdir, LockFile))
 	if err != nil {
-		return nil, fmt.Errorf(errRepoLock, confdir, LockFile)
+		return nil, fmt.Errorf(errRepoLock, ErrRepoLocked, confdir, LockFile)
 	}
 	return c, nil
 }
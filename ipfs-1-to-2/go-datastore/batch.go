@@ -0,0 +1,22 @@
+package datastore
+
+// Batch buffers puts and deletes until Commit applies them together.
+// Batches are not safe for concurrent use.
+type Batch interface {
+	Put(key Key, val interface{}) error
+
+	Delete(key Key) error
+
+	Commit() error
+
+	// Reset drops what the batch buffered.
+	Reset()
+}
+
+// Batching is a Datastore that can buffer writes in a Batch, which is much
+// cheaper than writing keys one at a time.
+type Batching interface {
+	Datastore
+
+	Batch() (Batch, error)
+}
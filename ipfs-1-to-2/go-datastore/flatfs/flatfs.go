@@ -245,6 +245,117 @@ func (fs *Datastore) enumerateKeys(fi os.FileInfo, res []query.Entry) ([]query.E
 	return res, nil
 }
 
+type flatfsBatch struct {
+	puts    map[datastore.Key]interface{}
+	deletes map[datastore.Key]struct{}
+
+	ds *Datastore
+}
+
+// Batch returns a Batch that writes its files together, syncing each
+// prefix directory once instead of once per file.
+func (fs *Datastore) Batch() (datastore.Batch, error) {
+	return &flatfsBatch{
+		puts:    make(map[datastore.Key]interface{}),
+		deletes: make(map[datastore.Key]struct{}),
+		ds:      fs,
+	}, nil
+}
+
+func (bt *flatfsBatch) Put(key datastore.Key, val interface{}) error {
+	if _, ok := val.([]byte); !ok {
+		return datastore.ErrInvalidType
+	}
+	delete(bt.deletes, key)
+	bt.puts[key] = val
+	return nil
+}
+
+func (bt *flatfsBatch) Delete(key datastore.Key) error {
+	delete(bt.puts, key)
+	bt.deletes[key] = struct{}{}
+	return nil
+}
+
+// Commit writes the puts, then the deletes. If the puts fail, the batch
+// keeps them, and the deletes, for a later Commit to retry; files already
+// written are written again.
+func (bt *flatfsBatch) Commit() error {
+	if err := bt.ds.putMany(bt.puts); err != nil {
+		return err
+	}
+	bt.puts = make(map[datastore.Key]interface{})
+	for k := range bt.deletes {
+		if err := bt.ds.Delete(k); err != nil && err != datastore.ErrNotFound {
+			return err
+		}
+		delete(bt.deletes, k)
+	}
+	return nil
+}
+
+func (bt *flatfsBatch) Reset() {
+	bt.puts = make(map[datastore.Key]interface{})
+	bt.deletes = make(map[datastore.Key]struct{})
+}
+
+// putMany writes and syncs the files one after another, then renames them
+// into place and syncs their directories once.
+func (fs *Datastore) putMany(data map[datastore.Key]interface{}) error {
+	dirs := make(map[string]bool)
+	renames := make(map[string]string)
+	defer func() {
+		for tmp := range renames {
+			os.Remove(tmp)
+		}
+	}()
+
+	for key, value := range data {
+		dir, path := fs.encode(key)
+		if !dirs[dir] {
+			if err := fs.makePrefixDir(dir); err != nil {
+				return err
+			}
+			dirs[dir] = true
+		}
+		tmp, err := ioutil.TempFile(dir, "put-")
+		if err != nil {
+			return err
+		}
+		renames[tmp.Name()] = path
+		_, err = tmp.Write(value.([]byte))
+		if err == nil {
+			err = tmp.Sync()
+		}
+		if cerr := tmp.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for tmp, path := range renames {
+		if err := os.Rename(tmp, path); err != nil {
+			return err
+		}
+		delete(renames, tmp)
+	}
+
+	for dir := range dirs {
+		f, err := os.Open(dir)
+		if err != nil {
+			return err
+		}
+		err = f.Sync()
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var _ datastore.ThreadSafeDatastore = (*Datastore)(nil)
 
 func (*Datastore) IsThreadSafe() {}
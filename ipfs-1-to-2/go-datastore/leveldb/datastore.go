@@ -148,6 +148,110 @@ func (d *datastore) runQuery(worker goprocess.Process, qrb *dsq.ResultBuilder) {
 	}
 }
 
+// Iterate calls fn with each key under prefix, in order, and its value
+// unless keysOnly, until fn returns false. It reads a snapshot taken when
+// it is called, so puts and deletes made meanwhile, e.g. by fn, are not
+// seen, and walks it with a single iterator: unlike Query there is no
+// goroutine handing over each result, and no seek to read each value.
+func (d *datastore) Iterate(prefix string, keysOnly bool, fn func(key string, value []byte) bool) error {
+	snap, err := d.DB.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	var rnge *util.Range
+	if prefix != "" {
+		rnge = util.BytesPrefix([]byte(prefix))
+	}
+	i := snap.NewIterator(rnge, nil)
+	defer i.Release()
+
+	for i.Next() {
+		var val []byte
+		if !keysOnly {
+			// the iterator reuses its buffer
+			val = make([]byte, len(i.Value()))
+			copy(val, i.Value())
+		}
+		if !fn(ds.NewKey(string(i.Key())).String(), val) {
+			break
+		}
+	}
+	return i.Error()
+}
+
+// Estimate approximates the number of keys under prefix and the bytes they
+// take without reading them all: the bytes are what the leveldb tables
+// hold for the range, and the keys that divided by the average size of the
+// first sample entries. Compression and entries still only in the journal
+// make it an underestimate; it is never below the entries sampled, and is
+// exact when there are no more than sample.
+func (d *datastore) Estimate(prefix string, sample int) (keys, bytes int64, err error) {
+	return Estimate(d.DB, prefix, sample)
+}
+
+// Estimate is Datastore.Estimate on db, e.g. one opened over a read-only
+// storage.
+func Estimate(db *leveldb.DB, prefix string, sample int) (keys, bytes int64, err error) {
+	rnge := util.BytesPrefix([]byte(prefix))
+	sizes, err := db.SizeOf([]util.Range{*rnge})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	i := db.NewIterator(rnge, nil)
+	defer i.Release()
+	var n, size int64
+	for n < int64(sample) && i.Next() {
+		n++
+		size += int64(len(i.Key()) + len(i.Value()))
+	}
+	if err := i.Error(); err != nil {
+		return 0, 0, err
+	}
+
+	bytes = int64(sizes.Sum())
+	if n < int64(sample) || size == 0 || bytes <= size {
+		return n, size, nil
+	}
+	return bytes * n / size, bytes, nil
+}
+
+type leveldbBatch struct {
+	b  *leveldb.Batch
+	db *leveldb.DB
+}
+
+// Batch returns a Batch committed as a single leveldb write batch.
+func (d *datastore) Batch() (ds.Batch, error) {
+	return &leveldbBatch{b: new(leveldb.Batch), db: d.DB}, nil
+}
+
+func (b *leveldbBatch) Put(key ds.Key, value interface{}) error {
+	val, ok := value.([]byte)
+	if !ok {
+		return ds.ErrInvalidType
+	}
+	b.b.Put(key.Bytes(), val)
+	return nil
+}
+
+func (b *leveldbBatch) Delete(key ds.Key) error {
+	b.b.Delete(key.Bytes())
+	return nil
+}
+
+func (b *leveldbBatch) Commit() error {
+	err := b.db.Write(b.b, nil)
+	b.b.Reset()
+	return err
+}
+
+func (b *leveldbBatch) Reset() {
+	b.b.Reset()
+}
+
 // LevelDB needs to be closed.
 func (d *datastore) Close() (err error) {
 	return d.DB.Close()
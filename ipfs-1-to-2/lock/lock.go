@@ -139,20 +139,24 @@ var (
 // unlocker is used by the darwin and linux implementations with fcntl
 // advisory locks.
 type unlocker struct {
-	f   *os.File
-	abs string
+	f    *os.File
+	abs  string
+	once sync.Once
+	err  error
 }
 
 func (u *unlocker) Close() error {
+	u.once.Do(u.close)
+	return u.err
+}
+
+func (u *unlocker) close() {
 	lockmu.Lock()
+	defer lockmu.Unlock()
 	// Remove is not necessary but it's nice for us to clean up.
 	// If we do do this, though, it needs to be before the
 	// u.f.Close below.
 	os.Remove(u.abs)
-	if err := u.f.Close(); err != nil {
-		return err
-	}
+	u.err = u.f.Close()
 	delete(locked, u.abs)
-	lockmu.Unlock()
-	return nil
 }
@@ -76,5 +76,5 @@ func lockFcntl(name string) (io.Closer, error) {
 		f.Close()
 		return nil, errno
 	}
-	return &unlocker{f, abs}, nil
+	return &unlocker{f: f, abs: abs}, nil
 }
@@ -51,5 +51,5 @@ func lockPlan9(name string) (io.Closer, error) {
 		return nil, fmt.Errorf("Lock Create of %s (abs: %s) failed: %v", name, abs, err)
 	}
 
-	return &unlocker{f, abs}, nil
+	return &unlocker{f: f, abs: abs}, nil
 }
@@ -1,11 +1,14 @@
 package mg1
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path"
 	"strings"
+	"sync"
 
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	dstore "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore"
@@ -14,20 +17,49 @@ import (
 	dsq "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore/query"
 	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	pipeline "github.com/ipfs/fs-repo-migrations/pipeline"
 )
 
 const peerKeyName = "peer.key"
 
+// checkpointFile records how far an interrupted block transfer got,
+// relative to the repo.
+const checkpointFile = "1-to-2.checkpoint"
+
+// defaultShardPrefix is the number of key bytes naming the flatfs shard
+// directories. It is the only prefix go-ipfs 0.3 and the 2-to-3 and 3-to-4
+// migrations open the blockstore with, so the mg1.shard-prefix feature flag
+// may only confirm it.
+const defaultShardPrefix = 4
+
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "1-to-2"
+func (m Migration) FromVersion() int {
+	return 1
+}
+
+func (m Migration) ToVersion() int {
+	return 2
 }
 
 func (m Migration) Reversible() bool {
 	return true
 }
 
+// Resumable reports that an interrupted block transfer carries on from its
+// checkpoint when run again.
+func (m Migration) Resumable() bool {
+	return true
+}
+
+// Requirements declares that the repo must have a leveldb datastore to move
+// blocks out of.
+func (m Migration) Requirements() []migrate.Requirement {
+	return []migrate.Requirement{
+		migrate.RequireDir("datastore", "leveldb datastore directory"),
+	}
+}
+
 func (m Migration) Apply(opts migrate.Options) error {
 
 	// lock the daemon.lock file. and if we succeed, remove it at the end.
@@ -43,6 +75,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 			repolk.Close()
 		}
 	}()
+	defer opts.Shutdown.OnRelease(repolk.Close)()
 
 	repo := mfsr.RepoPath(opts.Path)
 
@@ -60,7 +93,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 	}
 
 	// 2) Transfer blocks out of leveldb into flatDB
-	err = transferBlocksToFlatDB(opts.Path, opts.Verbose)
+	err = transferBlocksToFlatDB(opts)
 	if err != nil {
 		return err
 	}
@@ -79,7 +112,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	// 4) Update version number
 	repo = mfsr.RepoPath(newpath)
-	err = repo.WriteVersion("2")
+	err = repo.CasVersion("1", "2")
 	if err != nil {
 		return err
 	}
@@ -103,6 +136,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return err
 	}
 	defer repolk.Close()
+	defer opts.Shutdown.OnRelease(repolk.Close)()
 
 	repo := mfsr.RepoPath(opts.Path)
 	if err := repo.CheckVersion("2"); err != nil {
@@ -119,7 +153,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 	}
 
 	// 2) move blocks back from flatfs to leveldb
-	err = transferBlocksFromFlatDB(npath, opts.Verbose)
+	err = transferBlocksFromFlatDB(npath, opts)
 	if err != nil {
 		return err
 	}
@@ -129,7 +163,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 
 	// 3) change version number back down
 	repo = mfsr.RepoPath(npath)
-	err = repo.WriteVersion("1")
+	err = repo.CasVersion("2", "1")
 	if err != nil {
 		return err
 	}
@@ -140,9 +174,56 @@ func (m Migration) Revert(opts migrate.Options) error {
 	return nil
 }
 
+// EstimateWork estimates the blocks to transfer without walking them all:
+// applying scales the size of the leveldb tables under the block prefix by
+// the average size of a sample of blocks, reverting scales a sample of the
+// flatfs shard directories.
+func (m Migration) EstimateWork(opts migrate.Options) (migrate.Estimate, error) {
+	if opts.Revert {
+		return migrate.ShardUsage(path.Join(opts.Path, "blocks"), migrate.ShardSample)
+	}
+
+	// opened read-only, as estimates are also made for dry runs
+	db, err := openReadOnly(path.Join(opts.Path, "datastore"))
+	if err != nil {
+		return migrate.Estimate{}, err
+	}
+	defer db.Close()
+
+	keys, bytes, err := leveldb.Estimate(db, "/b/", estimateSample)
+	return migrate.Estimate{Keys: keys, Bytes: bytes}, err
+}
+
+// ModelSpace models moving the blocks between leveldb and flatfs. Blocks
+// are deleted from the source as they are copied, but leveldb only gives the
+// space back when it compacts its tables, so applying needs room for a full
+// flatfs copy of the leveldb datastore before it shrinks. Reverting frees
+// each flatfs file as it goes, but leveldb may hold a block in its log and
+// its tables at once until compaction, so a copy's worth is allowed for.
+func (m Migration) ModelSpace(opts migrate.Options) (migrate.SpaceModel, error) {
+	if opts.Revert {
+		blocks, err := migrate.DirUsage(path.Join(opts.Path, "blocks"))
+		if err != nil {
+			return nil, err
+		}
+		return migrate.SpaceModel{
+			{Name: "move blocks into leveldb", Peak: blocks.Bytes, Final: 0},
+		}, nil
+	}
+	ldb, err := migrate.DirUsage(path.Join(opts.Path, "datastore"))
+	if err != nil {
+		return nil, err
+	}
+	return migrate.CopyThenDelete("blocks to flatfs", ldb.Bytes), nil
+}
+
 // sanityChecks performs a set of tests to make sure the Migration will go
 // smoothly
 func sanityChecks(opts migrate.Options) error {
+	if prefix := opts.FeatureInt("mg1.shard-prefix", defaultShardPrefix); prefix != defaultShardPrefix {
+		return fmt.Errorf("mg1.shard-prefix=%d: go-ipfs and the later migrations only open flatfs blockstores with a prefix of %d", prefix, defaultShardPrefix)
+	}
+
 	npath := strings.Replace(opts.Path, ".go-ipfs", ".ipfs", 1)
 
 	// make sure we can move the repo from .go-ipfs to .ipfs
@@ -161,12 +242,16 @@ func sanityChecks(opts migrate.Options) error {
 	return nil
 }
 
-func transferBlocksToFlatDB(repopath string, verbose bool) error {
+func transferBlocksToFlatDB(opts migrate.Options) error {
+	repopath := opts.Path
 	ldbpath := path.Join(repopath, "datastore")
 	ldb, err := leveldb.NewDatastore(ldbpath, nil)
 	if err != nil {
 		return err
 	}
+	defer ldb.Close()
+	// on shutdown, closed last, once the flush has committed the last batch
+	defer opts.Shutdown.OnRelease(ldb.Close)()
 
 	blockspath := path.Join(repopath, "blocks")
 	err = os.Mkdir(blockspath, 0777)
@@ -174,19 +259,23 @@ func transferBlocksToFlatDB(repopath string, verbose bool) error {
 		return err
 	}
 
-	fds, err := flatfs.New(blockspath, 4)
+	fds, err := flatfs.New(blockspath, defaultShardPrefix)
 	if err != nil {
 		return err
 	}
 
-	return transferBlocks(ldb, fds, "/b/", "", verbose)
+	return transferBlocks(querySource(ldb, "/b/"), ldb, fds, "/b/", "", repopath, opts)
 }
 
-func transferBlocksFromFlatDB(repopath string, verbose bool) error {
+func transferBlocksFromFlatDB(repopath string, opts migrate.Options) error {
 
 	ldbpath := path.Join(repopath, "datastore")
 	blockspath := path.Join(repopath, "blocks")
-	fds, err := flatfs.New(blockspath, 4)
+	prefix, err := shardPrefix(blockspath)
+	if err != nil {
+		return err
+	}
+	fds, err := flatfs.New(blockspath, prefix)
 	if err != nil {
 		return err
 	}
@@ -195,8 +284,11 @@ func transferBlocksFromFlatDB(repopath string, verbose bool) error {
 	if err != nil {
 		return err
 	}
+	defer ldb.Close()
+	// on shutdown, closed last, once the flush has committed the last batch
+	defer opts.Shutdown.OnRelease(ldb.Close)()
 
-	err = transferBlocks(fds, ldb, "", "/b/", verbose)
+	err = transferBlocks(flatfsSource(blockspath, opts.Workers), fds, ldb, "", "/b/", repopath, opts)
 	if err != nil {
 		return err
 	}
@@ -210,46 +302,244 @@ func transferBlocksFromFlatDB(repopath string, verbose bool) error {
 	return nil
 }
 
-func transferBlocks(from, to dstore.Datastore, fpref, tpref string, verbose bool) error {
-	q := dsq.Query{Prefix: fpref, KeysOnly: true}
-	res, err := from.Query(q)
+// shardPrefix returns the prefix length the flatfs at dir was created with,
+// from the length of its shard directory names, which are hex encoded.
+func shardPrefix(dir string) (int, error) {
+	fis, err := ioutil.ReadDir(dir)
 	if err != nil {
-		return err
+		return 0, err
 	}
-
-	showProgress := func(i int) {}
-	if verbose {
-		showProgress = func(i int) {
-			fmt.Printf("\rmoving objects: %d", i)
+	for _, fi := range fis {
+		if fi.IsDir() {
+			return len(fi.Name()) / 2, nil
 		}
-		defer fmt.Println("")
 	}
+	return defaultShardPrefix, nil
+}
 
-	i := 0
-	for result := range res.Next() {
-		i++
-		showProgress(i)
-
-		nkey := fmt.Sprintf("%s%s", tpref, result.Key[len(fpref):])
+// transferBlocks moves every key src yields, under fpref in from, to tpref
+// in to, through a pipeline with opts.Workers workers. Puts and deletes are
+// buffered in datastore batches of opts.BatchSize keys, the puts committed
+// before the deletes, so a shutdown never leaves a key deleted but not
+// copied. A key copied but not deleted by a crash is copied again when the
+// transfer is re-run. The count moved so far is checkpointed in the repo at
+// repopath, so a re-run reports progress from where it left off.
+func transferBlocks(src pipeline.Source, from, to dstore.Datastore, fpref, tpref, repopath string, opts migrate.Options) error {
+	tag := "apply"
+	if opts.Revert {
+		tag = "revert"
+	}
+	cp, err := pipeline.LoadCheckpoint(path.Join(repopath, checkpointFile), tag)
+	if err != nil {
+		return err
+	}
 
-		fkey := dstore.NewKey(result.Key)
-		val, err := from.Get(fkey)
-		if err != nil {
-			return err
+	total, err := blockCount(from, fpref, path.Join(repopath, "blocks"), opts)
+	if err != nil {
+		return err
+	}
+	total += cp.Done
+
+	stages := pipeline.Stages{
+		Read: func(key string) ([]byte, error) {
+			val, err := from.Get(dstore.NewKey(key))
+			if err != nil {
+				return nil, err
+			}
+			b, ok := val.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("value is a %T, not a block", val)
+			}
+			return b, nil
+		},
+		Write: func(it pipeline.Item) error {
+			nkey := tpref + it.Key[len(fpref):]
+			return to.Put(dstore.NewKey(nkey), it.Value)
+		},
+		Delete: func(key string) error {
+			return from.Delete(dstore.NewKey(key))
+		},
+	}
+	b, err := newBatcher(from, to)
+	if err != nil {
+		return err
+	}
+	if b != nil {
+		stages.Write = func(it pipeline.Item) error {
+			return b.put(dstore.NewKey(tpref+it.Key[len(fpref):]), it.Value)
+		}
+		stages.Delete = func(key string) error {
+			return b.delete(dstore.NewKey(key))
 		}
+		stages.Flush = b.commit
+	}
+	_, err = pipeline.Run(opts, src, stages, pipeline.Options{
+		Name:       "block transfer",
+		Counter:    "mg1.blocks_moved",
+		Total:      total,
+		Checkpoint: cp,
+	})
+	return err
+}
 
-		err = to.Put(dstore.NewKey(nkey), val)
+// querySource streams the keys under prefix in ds. Values are only read
+// with the keys from leveldb, each once as it is moved.
+func querySource(ds dstore.Datastore, prefix string) pipeline.Source {
+	return func(yield func(pipeline.Item) bool) error {
+		if it, ok := ds.(iterator); ok {
+			// values come with the keys, in one pass over leveldb
+			return it.Iterate(prefix, false, func(key string, val []byte) bool {
+				return yield(pipeline.Item{Key: key, Value: val})
+			})
+		}
+		res, err := ds.Query(dsq.Query{Prefix: prefix, KeysOnly: true})
 		if err != nil {
 			return err
 		}
+		defer res.Close()
+		for result := range res.Next() {
+			if result.Error != nil {
+				return result.Error
+			}
+			if !yield(pipeline.Item{Key: result.Key}) {
+				return nil
+			}
+		}
+		return nil
+	}
+}
 
-		err = from.Delete(fkey)
+// flatfsSource streams the keys of the flatfs at dir, named by the hex of
+// the key, scanning its shard directories with workers at once.
+func flatfsSource(dir string, workers int) pipeline.Source {
+	return pipeline.ShardSource(dir, workers, func(name string) (string, bool) {
+		if !strings.HasSuffix(name, ".data") {
+			return "", false
+		}
+		k, err := hex.DecodeString(strings.TrimSuffix(name, ".data"))
 		if err != nil {
-			return err
+			return "", false
 		}
+		return dstore.NewKey(string(k)).String(), true
+	})
+}
+
+// batcher buffers the puts to one datastore and the deletes from another.
+// Writing a block to flatfs syncs its file and directory, and to leveldb
+// its log, so writing each block on its own is what makes moving millions
+// of small blocks slow.
+type batcher struct {
+	mu   sync.Mutex
+	puts dstore.Batch
+	dels dstore.Batch
+}
+
+// newBatcher returns a batcher deleting from from and putting to to, or nil
+// if either cannot batch.
+func newBatcher(from, to dstore.Datastore) (*batcher, error) {
+	bfrom, ok := from.(dstore.Batching)
+	if !ok {
+		return nil, nil
+	}
+	bto, ok := to.(dstore.Batching)
+	if !ok {
+		return nil, nil
+	}
+	dels, err := bfrom.Batch()
+	if err != nil {
+		return nil, err
 	}
+	puts, err := bto.Batch()
+	if err != nil {
+		return nil, err
+	}
+	return &batcher{puts: puts, dels: dels}, nil
+}
 
-	return nil
+func (b *batcher) put(k dstore.Key, val []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.puts.Put(k, val)
+}
+
+func (b *batcher) delete(k dstore.Key) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dels.Delete(k)
+}
+
+// commit commits the puts, then the deletes, so that no block is deleted
+// before its copy is written. If the puts fail, the deletes are dropped,
+// leaving the blocks to be moved again by a re-run, while the puts stay
+// in their batch.
+func (b *batcher) commit() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.puts.Commit(); err != nil {
+		b.dels.Reset()
+		return err
+	}
+	return b.dels.Commit()
+}
+
+// iterator is implemented by the leveldb datastore, which streams keys and
+// values from a snapshot, so that the deletes of the moved blocks do not
+// disturb the walk over the ones left.
+type iterator interface {
+	Iterate(prefix string, keysOnly bool, fn func(key string, value []byte) bool) error
+}
+
+// estimator is implemented by the leveldb datastore, which approximates
+// the keys under a prefix from the size of its tables.
+type estimator interface {
+	Estimate(prefix string, sample int) (keys, bytes int64, err error)
+}
+
+// estimateSample is the number of blocks read to estimate their average
+// size in leveldb.
+const estimateSample = 1000
+
+// blockCount returns the number of blocks under prefix in from, for
+// progress. It is estimated, from leveldb's table sizes or from a sample of
+// the flatfs shards in blocksdir, unless the mg1.count-keys feature flag
+// asks for an exact count, which costs an extra pass over the keys.
+func blockCount(from dstore.Datastore, prefix, blocksdir string, opts migrate.Options) (int64, error) {
+	if opts.FeatureBool("mg1.count-keys", false) {
+		return countKeys(from, prefix)
+	}
+	if e, ok := from.(estimator); ok {
+		keys, _, err := e.Estimate(prefix, estimateSample)
+		return keys, err
+	}
+	est, err := migrate.ShardUsage(blocksdir, migrate.ShardSample)
+	return est.Keys, err
+}
+
+// countKeys counts the keys under prefix, so progress can be reported as a
+// percentage. It costs an extra pass over the keys.
+func countKeys(ds dstore.Datastore, prefix string) (int64, error) {
+	if it, ok := ds.(iterator); ok {
+		var n int64
+		err := it.Iterate(prefix, true, func(string, []byte) bool {
+			n++
+			return true
+		})
+		return n, err
+	}
+	res, err := ds.Query(dsq.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int64
+	for result := range res.Next() {
+		if result.Error != nil {
+			return n, result.Error
+		}
+		n++
+	}
+	return n, nil
 }
 
 func moveIpfsDir(curpath string) (string, error) {
@@ -0,0 +1,141 @@
+package mg1
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	dstore "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore"
+	flatfs "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore/flatfs"
+	leveldb "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore/leveldb"
+)
+
+// TestTransferFailedPut checks that a block whose put fails is not deleted
+// from leveldb, by the failing commit or by any later one.
+func TestTransferFailedPut(t *testing.T) {
+	repo, err := ioutil.TempDir("", "migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+	ldb, err := leveldb.NewDatastore(path.Join(repo, "datastore"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("block%d", i)
+		names = append(names, name)
+		if err := ldb.Put(dstore.NewKey("/b/"+name), []byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ldb.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a file where the shard directory of one block belongs fails its put
+	blocks := path.Join(repo, "blocks")
+	if err := os.Mkdir(blocks, 0755); err != nil {
+		t.Fatal(err)
+	}
+	stray := path.Join(blocks, hex.EncodeToString([]byte(names[3]))[:2*defaultShardPrefix])
+	if err := ioutil.WriteFile(stray, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := migrate.NewOptions(repo, migrate.WithBatchSize(2))
+	if err := transferBlocksToFlatDB(opts); err == nil {
+		t.Fatal("expected the put to fail")
+	}
+	moved := checkBlocks(t, repo, names)
+	if len(moved) == len(names) {
+		t.Fatal("every block moved despite the failed put")
+	}
+
+	if err := os.Remove(stray); err != nil {
+		t.Fatal(err)
+	}
+	if err := transferBlocksToFlatDB(opts); err != nil {
+		t.Fatal(err)
+	}
+	if moved := checkBlocks(t, repo, names); len(moved) != len(names) {
+		t.Errorf("moved %v after the re-run, want every block", moved)
+	}
+}
+
+// TestBatcherFailedPut checks that the deletes of a commit whose puts
+// failed are dropped rather than committed by the next.
+func TestBatcherFailedPut(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ldb, err := leveldb.NewDatastore(path.Join(dir, "datastore"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	fds, err := flatfs.New(path.Join(dir, "blocks"), defaultShardPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// no blocks directory, so every put fails
+	b, err := newBatcher(ldb, fds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := dstore.NewKey("/block")
+	if err := ldb.Put(dstore.NewKey("/b/block"), []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	b.put(key, []byte("data"))
+	b.delete(dstore.NewKey("/b/block"))
+	if err := b.commit(); err == nil {
+		t.Fatal("expected the put to fail")
+	}
+	if err := os.Mkdir(path.Join(dir, "blocks"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.commit(); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := ldb.Has(dstore.NewKey("/b/block")); !ok {
+		t.Error("block deleted by the commit after its put failed")
+	}
+	if ok, _ := fds.Has(key); !ok {
+		t.Error("put not retried by the next commit")
+	}
+}
+
+// checkBlocks fails the test for the blocks of names in neither leveldb
+// nor flatfs, and returns the ones moved to flatfs.
+func checkBlocks(t *testing.T, repo string, names []string) []string {
+	t.Helper()
+	ldb, err := leveldb.NewDatastore(path.Join(repo, "datastore"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	fds, err := flatfs.New(path.Join(repo, "blocks"), defaultShardPrefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var moved []string
+	for _, name := range names {
+		inFlatfs, _ := fds.Has(dstore.NewKey(name))
+		inLeveldb, _ := ldb.Has(dstore.NewKey("/b/" + name))
+		if !inFlatfs && !inLeveldb {
+			t.Errorf("%s lost", name)
+		}
+		if inFlatfs && !inLeveldb {
+			moved = append(moved, name)
+		}
+	}
+	return moved
+}
@@ -0,0 +1,221 @@
+package mg1
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	goleveldb "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/goleveldb/leveldb"
+	"github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/goleveldb/leveldb/opt"
+	"github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/goleveldb/leveldb/storage"
+	"github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/goleveldb/leveldb/util"
+)
+
+var errReadOnly = errors.New("leveldb opened read-only")
+
+// openReadOnly opens the leveldb database in dir without writing to it.
+// leveldb writes a new journal and manifest when it opens a database; these
+// are kept in memory and dropped on Close, and no LOCK or LOG file is
+// created. The database must exist.
+func openReadOnly(dir string) (*goleveldb.DB, error) {
+	stor := &readOnlyStorage{
+		dir:     dir,
+		mem:     storage.NewMemStorage(),
+		created: make(map[fileID]bool),
+		removed: make(map[fileID]bool),
+	}
+	return goleveldb.Open(stor, &opt.Options{ErrorIfMissing: true})
+}
+
+type fileID struct {
+	num uint64
+	t   storage.FileType
+}
+
+// readOnlyStorage is a leveldb storage reading the files in dir and keeping
+// the files leveldb creates in mem. Removing a file only hides it.
+type readOnlyStorage struct {
+	dir string
+	mem storage.Storage
+
+	mu       sync.Mutex
+	created  map[fileID]bool
+	removed  map[fileID]bool
+	manifest storage.File
+}
+
+type nopReleaser struct{}
+
+func (nopReleaser) Release() {}
+
+func (s *readOnlyStorage) Lock() (util.Releaser, error) { return nopReleaser{}, nil }
+
+func (s *readOnlyStorage) Log(string) {}
+
+func (s *readOnlyStorage) Close() error { return s.mem.Close() }
+
+func (s *readOnlyStorage) GetFile(num uint64, t storage.FileType) storage.File {
+	return &readOnlyFile{s: s, id: fileID{num, t}}
+}
+
+func (s *readOnlyStorage) GetFiles(t storage.FileType) ([]storage.File, error) {
+	names, err := readDirNames(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seen := make(map[fileID]bool)
+	var ff []storage.File
+	add := func(id fileID) {
+		if id.t&t != 0 && !seen[id] && !s.removed[id] {
+			seen[id] = true
+			ff = append(ff, &readOnlyFile{s: s, id: id})
+		}
+	}
+	for _, name := range names {
+		if id, ok := parseFileName(name); ok {
+			add(id)
+		}
+	}
+	for id := range s.created {
+		add(id)
+	}
+	return ff, nil
+}
+
+func (s *readOnlyStorage) GetManifest() (storage.File, error) {
+	s.mu.Lock()
+	m := s.manifest
+	s.mu.Unlock()
+	if m != nil {
+		return m, nil
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, "CURRENT"))
+	if err != nil {
+		return nil, err
+	}
+	id, ok := parseFileName(strings.TrimSuffix(string(data), "\n"))
+	if !ok || id.t != storage.TypeManifest {
+		return nil, fmt.Errorf("leveldb/storage: corrupted CURRENT file in %s", s.dir)
+	}
+	return s.GetFile(id.num, id.t), nil
+}
+
+func (s *readOnlyStorage) SetManifest(f storage.File) error {
+	if f.Type() != storage.TypeManifest {
+		return storage.ErrInvalidFile
+	}
+	s.mu.Lock()
+	s.manifest = f
+	s.mu.Unlock()
+	return nil
+}
+
+type readOnlyFile struct {
+	s  *readOnlyStorage
+	id fileID
+}
+
+func (f *readOnlyFile) mem() storage.File {
+	return f.s.mem.GetFile(f.id.num, f.id.t)
+}
+
+func (f *readOnlyFile) Open() (storage.Reader, error) {
+	f.s.mu.Lock()
+	created, removed := f.s.created[f.id], f.s.removed[f.id]
+	f.s.mu.Unlock()
+	if created {
+		return f.mem().Open()
+	}
+	if removed {
+		return nil, os.ErrNotExist
+	}
+	r, err := os.Open(filepath.Join(f.s.dir, fileName(f.id)))
+	if os.IsNotExist(err) && f.id.t == storage.TypeTable {
+		// tables written by older leveldb versions
+		r, err = os.Open(filepath.Join(f.s.dir, fmt.Sprintf("%06d.sst", f.id.num)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (f *readOnlyFile) Create() (storage.Writer, error) {
+	f.s.mu.Lock()
+	f.s.created[f.id] = true
+	delete(f.s.removed, f.id)
+	f.s.mu.Unlock()
+	return f.mem().Create()
+}
+
+func (f *readOnlyFile) Replace(storage.File) error {
+	return errReadOnly
+}
+
+func (f *readOnlyFile) Type() storage.FileType { return f.id.t }
+
+func (f *readOnlyFile) Num() uint64 { return f.id.num }
+
+func (f *readOnlyFile) Remove() error {
+	f.s.mu.Lock()
+	created := f.s.created[f.id]
+	delete(f.s.created, f.id)
+	f.s.removed[f.id] = true
+	f.s.mu.Unlock()
+	if created {
+		return f.mem().Remove()
+	}
+	return nil
+}
+
+// fileName and parseFileName name files as the leveldb file storage does.
+func fileName(id fileID) string {
+	switch id.t {
+	case storage.TypeManifest:
+		return fmt.Sprintf("MANIFEST-%06d", id.num)
+	case storage.TypeJournal:
+		return fmt.Sprintf("%06d.log", id.num)
+	case storage.TypeTable:
+		return fmt.Sprintf("%06d.ldb", id.num)
+	default:
+		return fmt.Sprintf("%06d.tmp", id.num)
+	}
+}
+
+func parseFileName(name string) (fileID, bool) {
+	var id fileID
+	var tail string
+	if _, err := fmt.Sscanf(name, "%d.%s", &id.num, &tail); err == nil {
+		switch tail {
+		case "log":
+			id.t = storage.TypeJournal
+		case "ldb", "sst":
+			id.t = storage.TypeTable
+		case "tmp":
+			id.t = storage.TypeTemp
+		default:
+			return id, false
+		}
+		return id, true
+	}
+	if n, _ := fmt.Sscanf(name, "MANIFEST-%d%s", &id.num, &tail); n == 1 {
+		id.t = storage.TypeManifest
+		return id, true
+	}
+	return id, false
+}
+
+func readDirNames(dir string) ([]string, error) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+	return d.Readdirnames(0)
+}
@@ -0,0 +1,64 @@
+package mg1
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	dstore "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore"
+	leveldb "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/go-datastore/leveldb"
+)
+
+func TestEstimateWorkLeavesRepoUntouched(t *testing.T) {
+	repo, err := ioutil.TempDir("", "migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repo)
+	dir := path.Join(repo, "datastore")
+	ldb, err := leveldb.NewDatastore(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		if err := ldb.Put(dstore.NewKey(fmt.Sprintf("/b/%04d", i)), make([]byte, 100)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ldb.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	before := listDir(t, dir)
+	est, err := Migration{}.EstimateWork(migrate.NewOptions(repo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if est.Keys != 50 {
+		t.Errorf("estimated %d keys, want 50", est.Keys)
+	}
+	if after := listDir(t, dir); after != before {
+		t.Errorf("estimate changed the datastore:\nbefore: %s\nafter:  %s", before, after)
+	}
+
+	if _, err := (Migration{}).EstimateWork(migrate.NewOptions(path.Join(repo, "missing"))); err == nil {
+		t.Error("expected an error for a repo without a datastore")
+	}
+}
+
+// listDir describes the files in dir by name, size and modification time.
+func listDir(t *testing.T, dir string) string {
+	t.Helper()
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s string
+	for _, fi := range fis {
+		s += fmt.Sprintf("%s:%d:%d ", fi.Name(), fi.Size(), fi.ModTime().UnixNano())
+	}
+	return s
+}
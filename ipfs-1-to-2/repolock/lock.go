@@ -1,17 +1,14 @@
 package lock
 
 import (
-	"fmt"
 	"io"
 	"os"
 	"path"
 
 	"github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/lock"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
 )
 
-var errRepoLock = `failed to acquire repo lock at %s/%s
-Is a daemon running? please stop it before running migration`
-
 // LockFile is the filename of the daemon lock, relative to config dir
 // lock changed names.
 const (
@@ -22,9 +19,9 @@ const (
 func Lock1(confdir string) (io.Closer, error) {
 	c, err := lock.Lock(path.Join(confdir, LockFile1))
 	if err != nil {
-		return nil, fmt.Errorf(errRepoLock, confdir, LockFile1)
+		return nil, mfsr.RepoPath(confdir).LockError(LockFile1)
 	}
-	return c, nil
+	return recordOwner(c, confdir, LockFile1)
 }
 
 func Remove1(confdir string) error {
@@ -34,7 +31,7 @@ func Remove1(confdir string) error {
 func Lock2(confdir string) (io.Closer, error) {
 	c, err := lock.Lock(path.Join(confdir, LockFile2))
 	if err != nil {
-		return nil, fmt.Errorf(errRepoLock, confdir, LockFile2)
+		return nil, mfsr.RepoPath(confdir).LockError(LockFile2)
 	}
-	return c, nil
+	return recordOwner(c, confdir, LockFile2)
 }
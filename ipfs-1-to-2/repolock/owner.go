@@ -0,0 +1,42 @@
+package lock
+
+import (
+	"io"
+	"sync"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// ownedLock is a held lock whose holder recorded itself in the owner file
+// next to it. Close removes the owner file before releasing the lock.
+type ownedLock struct {
+	io.Closer
+	rp   mfsr.RepoPath
+	name string
+
+	once sync.Once
+	err  error
+}
+
+// Close is safe to call more than once; only the first call removes the
+// owner file, which the next holder may have written by the second.
+func (l *ownedLock) Close() error {
+	l.once.Do(func() {
+		l.err = l.rp.RemoveOwner(l.name)
+		if err := l.Closer.Close(); err != nil {
+			l.err = err
+		}
+	})
+	return l.err
+}
+
+// recordOwner writes the current process into the owner file of the lock
+// file name, held by c, and returns the lock.
+func recordOwner(c io.Closer, confdir, name string) (io.Closer, error) {
+	rp := mfsr.RepoPath(confdir)
+	if err := rp.WriteOwner(name); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return &ownedLock{Closer: c, rp: rp, name: name}, nil
+}
@@ -0,0 +1,121 @@
+package lock
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"testing"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// exitedPid returns the pid of a process that has exited.
+func exitedPid(t *testing.T) int {
+	t.Helper()
+	cmd := exec.Command("true")
+	if err := cmd.Run(); err != nil {
+		t.Skip("cannot run true:", err)
+	}
+	return cmd.Process.Pid
+}
+
+func TestLockRecordsOwner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repolock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := Lock2(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, ok, err := mfsr.RepoPath(dir).ReadOwner(LockFile2)
+	if err != nil || !ok {
+		t.Fatalf("no owner recorded: %v", err)
+	}
+	if o.OwnerPID != os.Getpid() || o.Stale() {
+		t.Errorf("wrong owner %s", o)
+	}
+	if fi, err := os.Stat(path.Join(dir, LockFile2)); err != nil || fi.Size() != 0 {
+		t.Errorf("lock file is not empty: %v", err)
+	}
+	if _, _, err := mfsr.RepoPath(dir).BreakStale(LockFile2); !errors.Is(err, mfsr.ErrRepoLocked) {
+		t.Errorf("broke a live lock: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := mfsr.RepoPath(dir).ReadOwner(LockFile2); ok {
+		t.Error("lock file left after Close")
+	}
+}
+
+func TestLockAfterCrash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repolock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a crashed migration leaves an empty lock file and its owner file
+	host, _ := os.Hostname()
+	data, _ := json.Marshal(mfsr.Owner{OwnerPID: exitedPid(t), Hostname: host})
+	if err := ioutil.WriteFile(path.Join(dir, LockFile2+mfsr.OwnerSuffix), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(dir, LockFile2), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Lock2(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	o, ok, err := mfsr.RepoPath(dir).ReadOwner(LockFile2)
+	if err != nil || !ok || o.OwnerPID != os.Getpid() {
+		t.Errorf("owner not rewritten: %s, %v", o, err)
+	}
+}
+
+func TestBreakStale(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repolock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	host, _ := os.Hostname()
+	stale := mfsr.Owner{OwnerPID: exitedPid(t), Hostname: host}
+	if !stale.Stale() {
+		t.Skip("cannot tell whether processes exist here")
+	}
+	data, _ := json.Marshal(stale)
+	if err := ioutil.WriteFile(path.Join(dir, LockFile2), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the vendored lock remembers the paths it failed on, so a failing
+	// Lock2 would keep this process from taking the lock later
+	if err := mfsr.RepoPath(dir).LockError(LockFile2); !errors.Is(err, mfsr.ErrStaleLock) || !errors.Is(err, mfsr.ErrRepoLocked) {
+		t.Fatalf("got %v, want a stale lock error", err)
+	}
+	o, broken, err := mfsr.RepoPath(dir).BreakStale(LockFile2)
+	if err != nil || !broken || o.OwnerPID != stale.OwnerPID {
+		t.Fatalf("BreakStale = %s, %v, %v", o, broken, err)
+	}
+	c, err := Lock2(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+
+	// owners on other hosts cannot be checked
+	other := mfsr.Owner{OwnerPID: stale.OwnerPID, Hostname: host + ".elsewhere"}
+	if other.Stale() {
+		t.Error("owner on another host taken for stale")
+	}
+}
@@ -1,17 +0,0 @@
-package fuse
-
-import (
-	"syscall"
-)
-
-const (
-	ENODATA = Errno(syscall.ENODATA)
-)
-
-const (
-	errNoXattr = ENODATA
-)
-
-func init() {
-	errnoNames[errNoXattr] = "ENODATA"
-}
@@ -1,6 +0,0 @@
-package fuse
-
-// Maximum file write size we are prepared to receive from the kernel.
-//
-// This number is just a guess.
-const maxWrite = 128 * 1024
@@ -1,2 +0,0 @@
-// Package generic contains the generic marker types.
-package generic
@@ -1,2 +0,0 @@
-//go:generate goupnpdcpgen -dcp_name internetgateway1
-package internetgateway1
@@ -1,2 +0,0 @@
-//go:generate goupnpdcpgen -dcp_name internetgateway2
-package internetgateway2
@@ -1,5 +0,0 @@
-package config
-
-type API struct {
-	HTTPHeaders map[string][]string // HTTP headers to return with the API.
-}
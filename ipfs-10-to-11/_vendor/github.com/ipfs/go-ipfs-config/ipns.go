@@ -1,8 +0,0 @@
-package config
-
-type Ipns struct {
-	RepublishPeriod string
-	RecordLifetime  string
-
-	ResolveCacheSize int
-}
@@ -1,5 +0,0 @@
-package config
-
-type Provider struct {
-	Strategy string // Which keys to announce
-}
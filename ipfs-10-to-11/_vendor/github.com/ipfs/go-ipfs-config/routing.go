@@ -1,9 +0,0 @@
-package config
-
-// Routing defines configuration options for libp2p routing
-type Routing struct {
-	// Type sets default daemon routing mode.
-	//
-	// Can be one of "dht", "dhtclient", "dhtserver", "none", or unset.
-	Type string
-}
@@ -1,3 +0,0 @@
-package pb
-
-//go:generate protoc --gogo_out=. header.proto
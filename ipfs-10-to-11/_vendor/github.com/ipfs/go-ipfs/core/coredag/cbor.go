@@ -1,32 +0,0 @@
-package coredag
-
-import (
-	"io"
-	"io/ioutil"
-
-	ipldcbor "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-cbor"
-	ipld "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-format"
-)
-
-func cborJSONParser(r io.Reader, mhType uint64, mhLen int) ([]ipld.Node, error) {
-	nd, err := ipldcbor.FromJSON(r, mhType, mhLen)
-	if err != nil {
-		return nil, err
-	}
-
-	return []ipld.Node{nd}, nil
-}
-
-func cborRawParser(r io.Reader, mhType uint64, mhLen int) ([]ipld.Node, error) {
-	data, err := ioutil.ReadAll(r)
-	if err != nil {
-		return nil, err
-	}
-
-	nd, err := ipldcbor.Decode(data, mhType, mhLen)
-	if err != nil {
-		return nil, err
-	}
-
-	return []ipld.Node{nd}, nil
-}
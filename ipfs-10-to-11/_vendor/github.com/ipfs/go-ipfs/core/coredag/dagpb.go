@@ -1,66 +0,0 @@
-package coredag
-
-import (
-	"io"
-	"io/ioutil"
-	"math"
-
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-merkledag"
-
-	cid "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-cid"
-	ipld "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-format"
-	mh "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multihash"
-)
-
-func dagpbJSONParser(r io.Reader, mhType uint64, mhLen int) ([]ipld.Node, error) {
-	data, err := ioutil.ReadAll(r)
-	if err != nil {
-		return nil, err
-	}
-
-	nd := &merkledag.ProtoNode{}
-
-	err = nd.UnmarshalJSON(data)
-	if err != nil {
-		return nil, err
-	}
-
-	nd.SetCidBuilder(cidPrefix(mhType, mhLen))
-
-	return []ipld.Node{nd}, nil
-}
-
-func dagpbRawParser(r io.Reader, mhType uint64, mhLen int) ([]ipld.Node, error) {
-	data, err := ioutil.ReadAll(r)
-	if err != nil {
-		return nil, err
-	}
-
-	nd, err := merkledag.DecodeProtobuf(data)
-	if err != nil {
-		return nil, err
-	}
-
-	nd.SetCidBuilder(cidPrefix(mhType, mhLen))
-
-	return []ipld.Node{nd}, nil
-}
-
-func cidPrefix(mhType uint64, mhLen int) *cid.Prefix {
-	if mhType == math.MaxUint64 {
-		mhType = mh.SHA2_256
-	}
-
-	prefix := &cid.Prefix{
-		MhType:   mhType,
-		MhLength: mhLen,
-		Version:  1,
-		Codec:    cid.DagProtobuf,
-	}
-
-	if mhType == mh.SHA2_256 {
-		prefix.Version = 0
-	}
-
-	return prefix
-}
@@ -1,23 +0,0 @@
-package libp2p
-
-import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p"
-	relay "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-circuit"
-)
-
-func Relay(enableRelay, enableHop bool) func() (opts Libp2pOpts, err error) {
-	return func() (opts Libp2pOpts, err error) {
-		if enableRelay {
-			relayOpts := []relay.RelayOpt{}
-			if enableHop {
-				relayOpts = append(relayOpts, relay.OptHop)
-			}
-			opts.Opts = append(opts.Opts, libp2p.EnableRelay(relayOpts...))
-		} else {
-			opts.Opts = append(opts.Opts, libp2p.DisableRelay())
-		}
-		return
-	}
-}
-
-var AutoRelay = simpleOpt(libp2p.ChainOptions(libp2p.EnableAutoRelay(), libp2p.DefaultStaticRelays()))
@@ -1,112 +0,0 @@
-package libp2p
-
-import (
-	"context"
-	"sort"
-	"time"
-
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/core/node/helpers"
-
-	host "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/host"
-	routing "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/routing"
-	ddht "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-kad-dht/dual"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-pubsub"
-	namesys "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-pubsub-router"
-	record "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-record"
-	routinghelpers "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-routing-helpers"
-
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/go.uber.org/fx"
-)
-
-type BaseIpfsRouting routing.Routing
-
-type Router struct {
-	routing.Routing
-
-	Priority int // less = more important
-}
-
-type p2pRouterOut struct {
-	fx.Out
-
-	Router Router `group:"routers"`
-}
-
-func BaseRouting(lc fx.Lifecycle, in BaseIpfsRouting) (out p2pRouterOut, dr *ddht.DHT) {
-	if dht, ok := in.(*ddht.DHT); ok {
-		dr = dht
-
-		lc.Append(fx.Hook{
-			OnStop: func(ctx context.Context) error {
-				return dr.Close()
-			},
-		})
-	}
-
-	return p2pRouterOut{
-		Router: Router{
-			Priority: 1000,
-			Routing:  in,
-		},
-	}, dr
-}
-
-type p2pOnlineRoutingIn struct {
-	fx.In
-
-	Routers   []Router `group:"routers"`
-	Validator record.Validator
-}
-
-func Routing(in p2pOnlineRoutingIn) routing.Routing {
-	routers := in.Routers
-
-	sort.SliceStable(routers, func(i, j int) bool {
-		return routers[i].Priority < routers[j].Priority
-	})
-
-	irouters := make([]routing.Routing, len(routers))
-	for i, v := range routers {
-		irouters[i] = v.Routing
-	}
-
-	return routinghelpers.Tiered{
-		Routers:   irouters,
-		Validator: in.Validator,
-	}
-}
-
-type p2pPSRoutingIn struct {
-	fx.In
-
-	BaseIpfsRouting BaseIpfsRouting
-	Validator       record.Validator
-	Host            host.Host
-	PubSub          *pubsub.PubSub `optional:"true"`
-}
-
-func PubsubRouter(mctx helpers.MetricsCtx, lc fx.Lifecycle, in p2pPSRoutingIn) (p2pRouterOut, *namesys.PubsubValueStore, error) {
-	psRouter, err := namesys.NewPubsubValueStore(
-		helpers.LifecycleCtx(mctx, lc),
-		in.Host,
-		in.PubSub,
-		in.Validator,
-		namesys.WithRebroadcastInterval(time.Minute),
-	)
-
-	if err != nil {
-		return p2pRouterOut{}, nil, err
-	}
-
-	return p2pRouterOut{
-		Router: Router{
-			Routing: &routinghelpers.Compose{
-				ValueStore: &routinghelpers.LimitedValueStore{
-					ValueStore: psRouter,
-					Namespaces: []string{"ipns"},
-				},
-			},
-			Priority: 100,
-		},
-	}, psRouter, nil
-}
@@ -1,4 +0,0 @@
-/*
-IPFS is a global, versioned, peer-to-peer filesystem
-*/
-package ipfs
@@ -1,53 +0,0 @@
-package p2p
-
-import (
-	logging "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log"
-	p2phost "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/host"
-	peer "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/peer"
-	pstore "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/peerstore"
-)
-
-var log = logging.Logger("p2p-mount")
-
-// P2P structure holds information on currently running streams/Listeners
-type P2P struct {
-	ListenersLocal *Listeners
-	ListenersP2P   *Listeners
-	Streams        *StreamRegistry
-
-	identity  peer.ID
-	peerHost  p2phost.Host
-	peerstore pstore.Peerstore
-}
-
-// New creates new P2P struct
-func New(identity peer.ID, peerHost p2phost.Host, peerstore pstore.Peerstore) *P2P {
-	return &P2P{
-		identity:  identity,
-		peerHost:  peerHost,
-		peerstore: peerstore,
-
-		ListenersLocal: newListenersLocal(),
-		ListenersP2P:   newListenersP2P(peerHost),
-
-		Streams: &StreamRegistry{
-			Streams:     map[uint64]*Stream{},
-			ConnManager: peerHost.ConnManager(),
-			conns:       map[peer.ID]int{},
-		},
-	}
-}
-
-// CheckProtoExists checks whether a proto handler is registered to
-// mux handler
-func (p2p *P2P) CheckProtoExists(proto string) bool {
-	protos := p2p.peerHost.Mux().Protocols()
-
-	for _, p := range protos {
-		if p != proto {
-			continue
-		}
-		return true
-	}
-	return false
-}
@@ -1,13 +0,0 @@
-package plugin
-
-import (
-	coreiface "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/interface-go-ipfs-core"
-)
-
-// PluginDaemon is an interface for daemon plugins. These plugins will be run on
-// the daemon and will be given access to an implementation of the CoreAPI.
-type PluginDaemon interface {
-	Plugin
-
-	Start(coreiface.CoreAPI) error
-}
@@ -1,14 +0,0 @@
-package plugin
-
-import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/repo/fsrepo"
-)
-
-// PluginDatastore is an interface that can be implemented to add handlers for
-// for different datastores
-type PluginDatastore interface {
-	Plugin
-
-	DatastoreTypeName() string
-	DatastoreConfigParser() fsrepo.ConfigFromMap
-}
@@ -1,17 +0,0 @@
-// +build noplugin
-
-package loader
-
-import (
-	"errors"
-
-	iplugin "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/plugin"
-)
-
-func init() {
-	loadPluginFunc = nopluginLoadPlugin
-}
-
-func nopluginLoadPlugin(string) ([]iplugin.Plugin, error) {
-	return nil, errors.New("not built with plugin support")
-}
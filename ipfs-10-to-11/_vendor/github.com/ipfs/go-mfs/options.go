@@ -1,7 +0,0 @@
-package mfs
-
-type Flags struct {
-	Read  bool
-	Write bool
-	Sync  bool
-}
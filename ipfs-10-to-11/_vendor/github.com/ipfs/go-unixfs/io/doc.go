@@ -1,3 +0,0 @@
-// Package io implements convenience objects for working with the ipfs
-// unixfs data format.
-package io
@@ -1,13 +0,0 @@
-package iface
-
-import (
-	ipld "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-format"
-)
-
-// APIDagService extends ipld.DAGService
-type APIDagService interface {
-	ipld.DAGService
-
-	// Pinning returns special NodeAdder which recursively pins added nodes
-	Pinning() ipld.NodeAdder
-}
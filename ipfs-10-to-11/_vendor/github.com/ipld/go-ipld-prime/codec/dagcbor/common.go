@@ -1,3 +0,0 @@
-package dagcbor
-
-const linkTag = 42
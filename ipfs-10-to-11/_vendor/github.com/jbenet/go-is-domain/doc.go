@@ -1,13 +0,0 @@
-/*
-Package isdomain package allows users to check whether strings represent domain names.
-
-	import (
-		isd "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/go-is-domain"
-	)
-
-	isd.IsDomain("foo.com") // true
-	isd.IsDomain("foo.bar.com.") // true
-	isd.IsDomain("foo.bar.baz") // false
-
-*/
-package isdomain
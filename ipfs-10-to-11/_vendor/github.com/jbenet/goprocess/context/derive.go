@@ -1,19 +0,0 @@
-package goprocessctx
-
-import (
-	"context"
-
-	goprocess "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/jbenet/goprocess"
-)
-
-// OnClosingContext derives a context from a given goprocess that will
-// be 'Done' when the process is closing
-func OnClosingContext(p goprocess.Process) context.Context {
-	return WithProcessClosing(context.Background(), p)
-}
-
-// OnClosedContext derives a context from a given goprocess that will
-// be 'Done' when the process is closed
-func OnClosedContext(p goprocess.Process) context.Context {
-	return WithProcessClosed(context.Background(), p)
-}
@@ -1,39 +0,0 @@
-package addrutil
-
-import (
-	ma "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multiaddr"
-)
-
-// SubtractFilter returns a filter func that filters all of the given addresses
-func SubtractFilter(addrs ...ma.Multiaddr) func(ma.Multiaddr) bool {
-	addrmap := make(map[string]bool, len(addrs))
-	for _, a := range addrs {
-		addrmap[string(a.Bytes())] = true
-	}
-
-	return func(a ma.Multiaddr) bool {
-		return !addrmap[string(a.Bytes())]
-	}
-}
-
-// IsFDCostlyTransport returns true for transports that require a new file
-// descriptor per connection created
-func IsFDCostlyTransport(a ma.Multiaddr) bool {
-	res := false
-
-	ma.ForEach(a, func(c ma.Component) bool {
-		if c.Protocol().Code == ma.P_TCP {
-			res = true
-			return false
-		}
-		return true
-	})
-	return res
-}
-
-// FilterNeg returns a negated version of the passed in filter
-func FilterNeg(f func(ma.Multiaddr) bool) func(ma.Multiaddr) bool {
-	return func(a ma.Multiaddr) bool {
-		return !f(a)
-	}
-}
@@ -1,11 +0,0 @@
-package host
-
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/peer"
-
-// InfoFromHost returns a peer.AddrInfo struct with the Host's ID and all of its Addrs.
-func InfoFromHost(h Host) *peer.AddrInfo {
-	return &peer.AddrInfo{
-		ID:    h.ID(),
-		Addrs: h.Addrs(),
-	}
-}
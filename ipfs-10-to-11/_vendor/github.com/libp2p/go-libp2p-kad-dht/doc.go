@@ -1,3 +0,0 @@
-// Package dht implements a distributed hash table that satisfies the ipfs routing
-// interface. This DHT is modeled after kademlia with S/Kademlia modifications.
-package dht
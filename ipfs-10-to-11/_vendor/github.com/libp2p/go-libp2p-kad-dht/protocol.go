@@ -1,12 +0,0 @@
-package dht
-
-import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/protocol"
-)
-
-var (
-	// ProtocolDHT is the default DHT protocol.
-	ProtocolDHT protocol.ID = "/ipfs/kad/1.0.0"
-	// DefaultProtocols spoken by the DHT.
-	DefaultProtocols = []protocol.ID{ProtocolDHT}
-)
@@ -1,13 +0,0 @@
-package record
-
-import (
-	pb "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-record/pb"
-)
-
-// MakePutRecord creates a dht record for the given key/value pair
-func MakePutRecord(key string, value []byte) *pb.Record {
-	record := new(pb.Record)
-	record.Key = []byte(key)
-	record.Value = value
-	return record
-}
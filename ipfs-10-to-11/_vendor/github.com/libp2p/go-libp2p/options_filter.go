@@ -1,36 +0,0 @@
-package libp2p
-
-import (
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/connmgr"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/control"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/network"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/libp2p/go-libp2p-core/peer"
-
-	ma "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/multiformats/go-multiaddr"
-)
-
-// filtersConnectionGater is an adapter that turns multiaddr.Filter into a
-// connmgr.ConnectionGater.
-type filtersConnectionGater ma.Filters
-
-var _ connmgr.ConnectionGater = (*filtersConnectionGater)(nil)
-
-func (f *filtersConnectionGater) InterceptAddrDial(_ peer.ID, addr ma.Multiaddr) (allow bool) {
-	return !(*ma.Filters)(f).AddrBlocked(addr)
-}
-
-func (f *filtersConnectionGater) InterceptPeerDial(p peer.ID) (allow bool) {
-	return true
-}
-
-func (f *filtersConnectionGater) InterceptAccept(connAddr network.ConnMultiaddrs) (allow bool) {
-	return !(*ma.Filters)(f).AddrBlocked(connAddr.RemoteMultiaddr())
-}
-
-func (f *filtersConnectionGater) InterceptSecured(_ network.Direction, _ peer.ID, connAddr network.ConnMultiaddrs) (allow bool) {
-	return !(*ma.Filters)(f).AddrBlocked(connAddr.RemoteMultiaddr())
-}
-
-func (f *filtersConnectionGater) InterceptUpgraded(_ network.Conn) (allow bool, reason control.DisconnectReason) {
-	return true, 0
-}
@@ -1,7 +0,0 @@
-package relay
-
-import (
-	logging "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-log"
-)
-
-var log = logging.Logger("autorelay")
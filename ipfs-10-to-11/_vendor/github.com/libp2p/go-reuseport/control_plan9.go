@@ -1,9 +0,0 @@
-package reuseport
-
-import (
-	"syscall"
-)
-
-func Control(network, address string, c syscall.RawConn) error {
-	return nil
-}
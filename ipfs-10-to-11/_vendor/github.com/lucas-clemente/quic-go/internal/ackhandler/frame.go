@@ -1,9 +0,0 @@
-package ackhandler
-
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/lucas-clemente/quic-go/internal/wire"
-
-type Frame struct {
-	wire.Frame // nil if the frame has already been acknowledged in another packet
-	OnLost     func(wire.Frame)
-	OnAcked    func(wire.Frame)
-}
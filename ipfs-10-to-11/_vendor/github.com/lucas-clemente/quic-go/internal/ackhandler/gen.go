@@ -1,3 +0,0 @@
-package ackhandler
-
-//go:generate genny -pkg ackhandler -in ../utils/linkedlist/linkedlist.go -out packet_linkedlist.go gen Item=Packet
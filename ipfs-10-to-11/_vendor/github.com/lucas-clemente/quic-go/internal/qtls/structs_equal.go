@@ -1,21 +0,0 @@
-// +build !go1.15
-
-package qtls
-
-import "reflect"
-
-func structsEqual(a, b interface{}) bool {
-	sa := reflect.ValueOf(a).Elem()
-	sb := reflect.ValueOf(b).Elem()
-	if sa.NumField() != sb.NumField() {
-		return false
-	}
-	for i := 0; i < sa.NumField(); i++ {
-		fa := sa.Type().Field(i)
-		fb := sb.Type().Field(i)
-		if !reflect.DeepEqual(fa.Index, fb.Index) || fa.Name != fb.Name || fa.Anonymous != fb.Anonymous || fa.Offset != fb.Offset || !reflect.DeepEqual(fa.Type, fb.Type) {
-			return false
-		}
-	}
-	return true
-}
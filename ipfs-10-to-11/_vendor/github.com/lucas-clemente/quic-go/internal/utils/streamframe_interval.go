@@ -1,9 +0,0 @@
-package utils
-
-import "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/lucas-clemente/quic-go/internal/protocol"
-
-// ByteInterval is an interval from one ByteCount to the other
-type ByteInterval struct {
-	Start protocol.ByteCount
-	End   protocol.ByteCount
-}
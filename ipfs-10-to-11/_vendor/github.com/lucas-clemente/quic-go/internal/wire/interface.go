@@ -1,19 +0,0 @@
-package wire
-
-import (
-	"bytes"
-
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/lucas-clemente/quic-go/internal/protocol"
-)
-
-// A Frame in QUIC
-type Frame interface {
-	Write(b *bytes.Buffer, version protocol.VersionNumber) error
-	Length(version protocol.VersionNumber) protocol.ByteCount
-}
-
-// A FrameParser parses QUIC frames, one by one.
-type FrameParser interface {
-	ParseNext(*bytes.Reader, protocol.EncryptionLevel) (Frame, error)
-	SetAckDelayExponent(uint8)
-}
@@ -1,2 +0,0 @@
-// Deprecated: use github.com/multiformats/go-multiaddr/net
-package manet
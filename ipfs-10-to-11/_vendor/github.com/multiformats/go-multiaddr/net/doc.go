@@ -1,5 +0,0 @@
-// Package manet provides Multiaddr specific versions of common
-// functions in stdlib's net package. This means wrappers of
-// standard net symbols like net.Dial and net.Listen, as well
-// as conversion to/from net.Addr.
-package manet
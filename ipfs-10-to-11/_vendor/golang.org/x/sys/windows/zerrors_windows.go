@@ -1,6853 +0,0 @@
-// Code generated by 'mkerrors.bash'; DO NOT EDIT.
-
-package windows
-
-import "syscall"
-
-const (
-	FACILITY_NULL                                                                           = 0
-	FACILITY_RPC                                                                            = 1
-	FACILITY_DISPATCH                                                                       = 2
-	FACILITY_STORAGE                                                                        = 3
-	FACILITY_ITF                                                                            = 4
-	FACILITY_WIN32                                                                          = 7
-	FACILITY_WINDOWS                                                                        = 8
-	FACILITY_SSPI                                                                           = 9
-	FACILITY_SECURITY                                                                       = 9
-	FACILITY_CONTROL                                                                        = 10
-	FACILITY_CERT                                                                           = 11
-	FACILITY_INTERNET                                                                       = 12
-	FACILITY_MEDIASERVER                                                                    = 13
-	FACILITY_MSMQ                                                                           = 14
-	FACILITY_SETUPAPI                                                                       = 15
-	FACILITY_SCARD                                                                          = 16
-	FACILITY_COMPLUS                                                                        = 17
-	FACILITY_AAF                                                                            = 18
-	FACILITY_URT                                                                            = 19
-	FACILITY_ACS                                                                            = 20
-	FACILITY_DPLAY                                                                          = 21
-	FACILITY_UMI                                                                            = 22
-	FACILITY_SXS                                                                            = 23
-	FACILITY_WINDOWS_CE                                                                     = 24
-	FACILITY_HTTP                                                                           = 25
-	FACILITY_USERMODE_COMMONLOG                                                             = 26
-	FACILITY_WER                                                                            = 27
-	FACILITY_USERMODE_FILTER_MANAGER                                                        = 31
-	FACILITY_BACKGROUNDCOPY                                                                 = 32
-	FACILITY_CONFIGURATION                                                                  = 33
-	FACILITY_WIA                                                                            = 33
-	FACILITY_STATE_MANAGEMENT                                                               = 34
-	FACILITY_METADIRECTORY                                                                  = 35
-	FACILITY_WINDOWSUPDATE                                                                  = 36
-	FACILITY_DIRECTORYSERVICE                                                               = 37
-	FACILITY_GRAPHICS                                                                       = 38
-	FACILITY_SHELL                                                                          = 39
-	FACILITY_NAP                                                                            = 39
-	FACILITY_TPM_SERVICES                                                                   = 40
-	FACILITY_TPM_SOFTWARE                                                                   = 41
-	FACILITY_UI                                                                             = 42
-	FACILITY_XAML                                                                           = 43
-	FACILITY_ACTION_QUEUE                                                                   = 44
-	FACILITY_PLA                                                                            = 48
-	FACILITY_WINDOWS_SETUP                                                                  = 48
-	FACILITY_FVE                                                                            = 49
-	FACILITY_FWP                                                                            = 50
-	FACILITY_WINRM                                                                          = 51
-	FACILITY_NDIS                                                                           = 52
-	FACILITY_USERMODE_HYPERVISOR                                                            = 53
-	FACILITY_CMI                                                                            = 54
-	FACILITY_USERMODE_VIRTUALIZATION                                                        = 55
-	FACILITY_USERMODE_VOLMGR                                                                = 56
-	FACILITY_BCD                                                                            = 57
-	FACILITY_USERMODE_VHD                                                                   = 58
-	FACILITY_USERMODE_HNS                                                                   = 59
-	FACILITY_SDIAG                                                                          = 60
-	FACILITY_WEBSERVICES                                                                    = 61
-	FACILITY_WINPE                                                                          = 61
-	FACILITY_WPN                                                                            = 62
-	FACILITY_WINDOWS_STORE                                                                  = 63
-	FACILITY_INPUT                                                                          = 64
-	FACILITY_EAP                                                                            = 66
-	FACILITY_WINDOWS_DEFENDER                                                               = 80
-	FACILITY_OPC                                                                            = 81
-	FACILITY_XPS                                                                            = 82
-	FACILITY_MBN                                                                            = 84
-	FACILITY_POWERSHELL                                                                     = 84
-	FACILITY_RAS                                                                            = 83
-	FACILITY_P2P_INT                                                                        = 98
-	FACILITY_P2P                                                                            = 99
-	FACILITY_DAF                                                                            = 100
-	FACILITY_BLUETOOTH_ATT                                                                  = 101
-	FACILITY_AUDIO                                                                          = 102
-	FACILITY_STATEREPOSITORY                                                                = 103
-	FACILITY_VISUALCPP                                                                      = 109
-	FACILITY_SCRIPT                                                                         = 112
-	FACILITY_PARSE                                                                          = 113
-	FACILITY_BLB                                                                            = 120
-	FACILITY_BLB_CLI                                                                        = 121
-	FACILITY_WSBAPP                                                                         = 122
-	FACILITY_BLBUI                                                                          = 128
-	FACILITY_USN                                                                            = 129
-	FACILITY_USERMODE_VOLSNAP                                                               = 130
-	FACILITY_TIERING                                                                        = 131
-	FACILITY_WSB_ONLINE                                                                     = 133
-	FACILITY_ONLINE_ID                                                                      = 134
-	FACILITY_DEVICE_UPDATE_AGENT                                                            = 135
-	FACILITY_DRVSERVICING                                                                   = 136
-	FACILITY_DLS                                                                            = 153
-	FACILITY_DELIVERY_OPTIMIZATION                                                          = 208
-	FACILITY_USERMODE_SPACES                                                                = 231
-	FACILITY_USER_MODE_SECURITY_CORE                                                        = 232
-	FACILITY_USERMODE_LICENSING                                                             = 234
-	FACILITY_SOS                                                                            = 160
-	FACILITY_DEBUGGERS                                                                      = 176
-	FACILITY_SPP                                                                            = 256
-	FACILITY_RESTORE                                                                        = 256
-	FACILITY_DMSERVER                                                                       = 256
-	FACILITY_DEPLOYMENT_SERVICES_SERVER                                                     = 257
-	FACILITY_DEPLOYMENT_SERVICES_IMAGING                                                    = 258
-	FACILITY_DEPLOYMENT_SERVICES_MANAGEMENT                                                 = 259
-	FACILITY_DEPLOYMENT_SERVICES_UTIL                                                       = 260
-	FACILITY_DEPLOYMENT_SERVICES_BINLSVC                                                    = 261
-	FACILITY_DEPLOYMENT_SERVICES_PXE                                                        = 263
-	FACILITY_DEPLOYMENT_SERVICES_TFTP                                                       = 264
-	FACILITY_DEPLOYMENT_SERVICES_TRANSPORT_MANAGEMENT                                       = 272
-	FACILITY_DEPLOYMENT_SERVICES_DRIVER_PROVISIONING                                        = 278
-	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_SERVER                                           = 289
-	FACILITY_DEPLOYMENT_SERVICES_MULTICAST_CLIENT                                           = 290
-	FACILITY_DEPLOYMENT_SERVICES_CONTENT_PROVIDER                                           = 293
-	FACILITY_LINGUISTIC_SERVICES                                                            = 305
-	FACILITY_AUDIOSTREAMING                                                                 = 1094
-	FACILITY_ACCELERATOR                                                                    = 1536
-	FACILITY_WMAAECMA                                                                       = 1996
-	FACILITY_DIRECTMUSIC                                                                    = 2168
-	FACILITY_DIRECT3D10                                                                     = 2169
-	FACILITY_DXGI                                                                           = 2170
-	FACILITY_DXGI_DDI                                                                       = 2171
-	FACILITY_DIRECT3D11                                                                     = 2172
-	FACILITY_DIRECT3D11_DEBUG                                                               = 2173
-	FACILITY_DIRECT3D12                                                                     = 2174
-	FACILITY_DIRECT3D12_DEBUG                                                               = 2175
-	FACILITY_LEAP                                                                           = 2184
-	FACILITY_AUDCLNT                                                                        = 2185
-	FACILITY_WINCODEC_DWRITE_DWM                                                            = 2200
-	FACILITY_WINML                                                                          = 2192
-	FACILITY_DIRECT2D                                                                       = 2201
-	FACILITY_DEFRAG                                                                         = 2304
-	FACILITY_USERMODE_SDBUS                                                                 = 2305
-	FACILITY_JSCRIPT                                                                        = 2306
-	FACILITY_PIDGENX                                                                        = 2561
-	FACILITY_EAS                                                                            = 85
-	FACILITY_WEB                                                                            = 885
-	FACILITY_WEB_SOCKET                                                                     = 886
-	FACILITY_MOBILE                                                                         = 1793
-	FACILITY_SQLITE                                                                         = 1967
-	FACILITY_UTC                                                                            = 1989
-	FACILITY_WEP                                                                            = 2049
-	FACILITY_SYNCENGINE                                                                     = 2050
-	FACILITY_XBOX                                                                           = 2339
-	FACILITY_PIX                                                                            = 2748
-	ERROR_SUCCESS                                                             syscall.Errno = 0
-	NO_ERROR                                                                                = 0
-	SEC_E_OK                                                                  Handle        = 0x00000000
-	ERROR_INVALID_FUNCTION                                                    syscall.Errno = 1
-	ERROR_FILE_NOT_FOUND                                                      syscall.Errno = 2
-	ERROR_PATH_NOT_FOUND                                                      syscall.Errno = 3
-	ERROR_TOO_MANY_OPEN_FILES                                                 syscall.Errno = 4
-	ERROR_ACCESS_DENIED                                                       syscall.Errno = 5
-	ERROR_INVALID_HANDLE                                                      syscall.Errno = 6
-	ERROR_ARENA_TRASHED                                                       syscall.Errno = 7
-	ERROR_NOT_ENOUGH_MEMORY                                                   syscall.Errno = 8
-	ERROR_INVALID_BLOCK                                                       syscall.Errno = 9
-	ERROR_BAD_ENVIRONMENT                                                     syscall.Errno = 10
-	ERROR_BAD_FORMAT                                                          syscall.Errno = 11
-	ERROR_INVALID_ACCESS                                                      syscall.Errno = 12
-	ERROR_INVALID_DATA                                                        syscall.Errno = 13
-	ERROR_OUTOFMEMORY                                                         syscall.Errno = 14
-	ERROR_INVALID_DRIVE                                                       syscall.Errno = 15
-	ERROR_CURRENT_DIRECTORY                                                   syscall.Errno = 16
-	ERROR_NOT_SAME_DEVICE                                                     syscall.Errno = 17
-	ERROR_NO_MORE_FILES                                                       syscall.Errno = 18
-	ERROR_WRITE_PROTECT                                                       syscall.Errno = 19
-	ERROR_BAD_UNIT                                                            syscall.Errno = 20
-	ERROR_NOT_READY                                                           syscall.Errno = 21
-	ERROR_BAD_COMMAND                                                         syscall.Errno = 22
-	ERROR_CRC                                                                 syscall.Errno = 23
-	ERROR_BAD_LENGTH                                                          syscall.Errno = 24
-	ERROR_SEEK                                                                syscall.Errno = 25
-	ERROR_NOT_DOS_DISK                                                        syscall.Errno = 26
-	ERROR_SECTOR_NOT_FOUND                                                    syscall.Errno = 27
-	ERROR_OUT_OF_PAPER                                                        syscall.Errno = 28
-	ERROR_WRITE_FAULT                                                         syscall.Errno = 29
-	ERROR_READ_FAULT                                                          syscall.Errno = 30
-	ERROR_GEN_FAILURE                                                         syscall.Errno = 31
-	ERROR_SHARING_VIOLATION                                                   syscall.Errno = 32
-	ERROR_LOCK_VIOLATION                                                      syscall.Errno = 33
-	ERROR_WRONG_DISK                                                          syscall.Errno = 34
-	ERROR_SHARING_BUFFER_EXCEEDED                                             syscall.Errno = 36
-	ERROR_HANDLE_EOF                                                          syscall.Errno = 38
-	ERROR_HANDLE_DISK_FULL                                                    syscall.Errno = 39
-	ERROR_NOT_SUPPORTED                                                       syscall.Errno = 50
-	ERROR_REM_NOT_LIST                                                        syscall.Errno = 51
-	ERROR_DUP_NAME                                                            syscall.Errno = 52
-	ERROR_BAD_NETPATH                                                         syscall.Errno = 53
-	ERROR_NETWORK_BUSY                                                        syscall.Errno = 54
-	ERROR_DEV_NOT_EXIST                                                       syscall.Errno = 55
-	ERROR_TOO_MANY_CMDS                                                       syscall.Errno = 56
-	ERROR_ADAP_HDW_ERR                                                        syscall.Errno = 57
-	ERROR_BAD_NET_RESP                                                        syscall.Errno = 58
-	ERROR_UNEXP_NET_ERR                                                       syscall.Errno = 59
-	ERROR_BAD_REM_ADAP                                                        syscall.Errno = 60
-	ERROR_PRINTQ_FULL                                                         syscall.Errno = 61
-	ERROR_NO_SPOOL_SPACE                                                      syscall.Errno = 62
-	ERROR_PRINT_CANCELLED                                                     syscall.Errno = 63
-	ERROR_NETNAME_DELETED                                                     syscall.Errno = 64
-	ERROR_NETWORK_ACCESS_DENIED                                               syscall.Errno = 65
-	ERROR_BAD_DEV_TYPE                                                        syscall.Errno = 66
-	ERROR_BAD_NET_NAME                                                        syscall.Errno = 67
-	ERROR_TOO_MANY_NAMES                                                      syscall.Errno = 68
-	ERROR_TOO_MANY_SESS                                                       syscall.Errno = 69
-	ERROR_SHARING_PAUSED                                                      syscall.Errno = 70
-	ERROR_REQ_NOT_ACCEP                                                       syscall.Errno = 71
-	ERROR_REDIR_PAUSED                                                        syscall.Errno = 72
-	ERROR_FILE_EXISTS                                                         syscall.Errno = 80
-	ERROR_CANNOT_MAKE                                                         syscall.Errno = 82
-	ERROR_FAIL_I24                                                            syscall.Errno = 83
-	ERROR_OUT_OF_STRUCTURES                                                   syscall.Errno = 84
-	ERROR_ALREADY_ASSIGNED                                                    syscall.Errno = 85
-	ERROR_INVALID_PASSWORD                                                    syscall.Errno = 86
-	ERROR_INVALID_PARAMETER                                                   syscall.Errno = 87
-	ERROR_NET_WRITE_FAULT                                                     syscall.Errno = 88
-	ERROR_NO_PROC_SLOTS                                                       syscall.Errno = 89
-	ERROR_TOO_MANY_SEMAPHORES                                                 syscall.Errno = 100
-	ERROR_EXCL_SEM_ALREADY_OWNED                                              syscall.Errno = 101
-	ERROR_SEM_IS_SET                                                          syscall.Errno = 102
-	ERROR_TOO_MANY_SEM_REQUESTS                                               syscall.Errno = 103
-	ERROR_INVALID_AT_INTERRUPT_TIME                                           syscall.Errno = 104
-	ERROR_SEM_OWNER_DIED                                                      syscall.Errno = 105
-	ERROR_SEM_USER_LIMIT                                                      syscall.Errno = 106
-	ERROR_DISK_CHANGE                                                         syscall.Errno = 107
-	ERROR_DRIVE_LOCKED                                                        syscall.Errno = 108
-	ERROR_BROKEN_PIPE                                                         syscall.Errno = 109
-	ERROR_OPEN_FAILED                                                         syscall.Errno = 110
-	ERROR_BUFFER_OVERFLOW                                                     syscall.Errno = 111
-	ERROR_DISK_FULL                                                           syscall.Errno = 112
-	ERROR_NO_MORE_SEARCH_HANDLES                                              syscall.Errno = 113
-	ERROR_INVALID_TARGET_HANDLE                                               syscall.Errno = 114
-	ERROR_INVALID_CATEGORY                                                    syscall.Errno = 117
-	ERROR_INVALID_VERIFY_SWITCH                                               syscall.Errno = 118
-	ERROR_BAD_DRIVER_LEVEL                                                    syscall.Errno = 119
-	ERROR_CALL_NOT_IMPLEMENTED                                                syscall.Errno = 120
-	ERROR_SEM_TIMEOUT                                                         syscall.Errno = 121
-	ERROR_INSUFFICIENT_BUFFER                                                 syscall.Errno = 122
-	ERROR_INVALID_NAME                                                        syscall.Errno = 123
-	ERROR_INVALID_LEVEL                                                       syscall.Errno = 124
-	ERROR_NO_VOLUME_LABEL                                                     syscall.Errno = 125
-	ERROR_MOD_NOT_FOUND                                                       syscall.Errno = 126
-	ERROR_PROC_NOT_FOUND                                                      syscall.Errno = 127
-	ERROR_WAIT_NO_CHILDREN                                                    syscall.Errno = 128
-	ERROR_CHILD_NOT_COMPLETE                                                  syscall.Errno = 129
-	ERROR_DIRECT_ACCESS_HANDLE                                                syscall.Errno = 130
-	ERROR_NEGATIVE_SEEK                                                       syscall.Errno = 131
-	ERROR_SEEK_ON_DEVICE                                                      syscall.Errno = 132
-	ERROR_IS_JOIN_TARGET                                                      syscall.Errno = 133
-	ERROR_IS_JOINED                                                           syscall.Errno = 134
-	ERROR_IS_SUBSTED                                                          syscall.Errno = 135
-	ERROR_NOT_JOINED                                                          syscall.Errno = 136
-	ERROR_NOT_SUBSTED                                                         syscall.Errno = 137
-	ERROR_JOIN_TO_JOIN                                                        syscall.Errno = 138
-	ERROR_SUBST_TO_SUBST                                                      syscall.Errno = 139
-	ERROR_JOIN_TO_SUBST                                                       syscall.Errno = 140
-	ERROR_SUBST_TO_JOIN                                                       syscall.Errno = 141
-	ERROR_BUSY_DRIVE                                                          syscall.Errno = 142
-	ERROR_SAME_DRIVE                                                          syscall.Errno = 143
-	ERROR_DIR_NOT_ROOT                                                        syscall.Errno = 144
-	ERROR_DIR_NOT_EMPTY                                                       syscall.Errno = 145
-	ERROR_IS_SUBST_PATH                                                       syscall.Errno = 146
-	ERROR_IS_JOIN_PATH                                                        syscall.Errno = 147
-	ERROR_PATH_BUSY                                                           syscall.Errno = 148
-	ERROR_IS_SUBST_TARGET                                                     syscall.Errno = 149
-	ERROR_SYSTEM_TRACE                                                        syscall.Errno = 150
-	ERROR_INVALID_EVENT_COUNT                                                 syscall.Errno = 151
-	ERROR_TOO_MANY_MUXWAITERS                                                 syscall.Errno = 152
-	ERROR_INVALID_LIST_FORMAT                                                 syscall.Errno = 153
-	ERROR_LABEL_TOO_LONG                                                      syscall.Errno = 154
-	ERROR_TOO_MANY_TCBS                                                       syscall.Errno = 155
-	ERROR_SIGNAL_REFUSED                                                      syscall.Errno = 156
-	ERROR_DISCARDED                                                           syscall.Errno = 157
-	ERROR_NOT_LOCKED                                                          syscall.Errno = 158
-	ERROR_BAD_THREADID_ADDR                                                   syscall.Errno = 159
-	ERROR_BAD_ARGUMENTS                                                       syscall.Errno = 160
-	ERROR_BAD_PATHNAME                                                        syscall.Errno = 161
-	ERROR_SIGNAL_PENDING                                                      syscall.Errno = 162
-	ERROR_MAX_THRDS_REACHED                                                   syscall.Errno = 164
-	ERROR_LOCK_FAILED                                                         syscall.Errno = 167
-	ERROR_BUSY                                                                syscall.Errno = 170
-	ERROR_DEVICE_SUPPORT_IN_PROGRESS                                          syscall.Errno = 171
-	ERROR_CANCEL_VIOLATION                                                    syscall.Errno = 173
-	ERROR_ATOMIC_LOCKS_NOT_SUPPORTED                                          syscall.Errno = 174
-	ERROR_INVALID_SEGMENT_NUMBER                                              syscall.Errno = 180
-	ERROR_INVALID_ORDINAL                                                     syscall.Errno = 182
-	ERROR_ALREADY_EXISTS                                                      syscall.Errno = 183
-	ERROR_INVALID_FLAG_NUMBER                                                 syscall.Errno = 186
-	ERROR_SEM_NOT_FOUND                                                       syscall.Errno = 187
-	ERROR_INVALID_STARTING_CODESEG                                            syscall.Errno = 188
-	ERROR_INVALID_STACKSEG                                                    syscall.Errno = 189
-	ERROR_INVALID_MODULETYPE                                                  syscall.Errno = 190
-	ERROR_INVALID_EXE_SIGNATURE                                               syscall.Errno = 191
-	ERROR_EXE_MARKED_INVALID                                                  syscall.Errno = 192
-	ERROR_BAD_EXE_FORMAT                                                      syscall.Errno = 193
-	ERROR_ITERATED_DATA_EXCEEDS_64k                                           syscall.Errno = 194
-	ERROR_INVALID_MINALLOCSIZE                                                syscall.Errno = 195
-	ERROR_DYNLINK_FROM_INVALID_RING                                           syscall.Errno = 196
-	ERROR_IOPL_NOT_ENABLED                                                    syscall.Errno = 197
-	ERROR_INVALID_SEGDPL                                                      syscall.Errno = 198
-	ERROR_AUTODATASEG_EXCEEDS_64k                                             syscall.Errno = 199
-	ERROR_RING2SEG_MUST_BE_MOVABLE                                            syscall.Errno = 200
-	ERROR_RELOC_CHAIN_XEEDS_SEGLIM                                            syscall.Errno = 201
-	ERROR_INFLOOP_IN_RELOC_CHAIN                                              syscall.Errno = 202
-	ERROR_ENVVAR_NOT_FOUND                                                    syscall.Errno = 203
-	ERROR_NO_SIGNAL_SENT                                                      syscall.Errno = 205
-	ERROR_FILENAME_EXCED_RANGE                                                syscall.Errno = 206
-	ERROR_RING2_STACK_IN_USE                                                  syscall.Errno = 207
-	ERROR_META_EXPANSION_TOO_LONG                                             syscall.Errno = 208
-	ERROR_INVALID_SIGNAL_NUMBER                                               syscall.Errno = 209
-	ERROR_THREAD_1_INACTIVE                                                   syscall.Errno = 210
-	ERROR_LOCKED                                                              syscall.Errno = 212
-	ERROR_TOO_MANY_MODULES                                                    syscall.Errno = 214
-	ERROR_NESTING_NOT_ALLOWED                                                 syscall.Errno = 215
-	ERROR_EXE_MACHINE_TYPE_MISMATCH                                           syscall.Errno = 216
-	ERROR_EXE_CANNOT_MODIFY_SIGNED_BINARY                                     syscall.Errno = 217
-	ERROR_EXE_CANNOT_MODIFY_STRONG_SIGNED_BINARY                              syscall.Errno = 218
-	ERROR_FILE_CHECKED_OUT                                                    syscall.Errno = 220
-	ERROR_CHECKOUT_REQUIRED                                                   syscall.Errno = 221
-	ERROR_BAD_FILE_TYPE                                                       syscall.Errno = 222
-	ERROR_FILE_TOO_LARGE                                                      syscall.Errno = 223
-	ERROR_FORMS_AUTH_REQUIRED                                                 syscall.Errno = 224
-	ERROR_VIRUS_INFECTED                                                      syscall.Errno = 225
-	ERROR_VIRUS_DELETED                                                       syscall.Errno = 226
-	ERROR_PIPE_LOCAL                                                          syscall.Errno = 229
-	ERROR_BAD_PIPE                                                            syscall.Errno = 230
-	ERROR_PIPE_BUSY                                                           syscall.Errno = 231
-	ERROR_NO_DATA                                                             syscall.Errno = 232
-	ERROR_PIPE_NOT_CONNECTED                                                  syscall.Errno = 233
-	ERROR_MORE_DATA                                                           syscall.Errno = 234
-	ERROR_NO_WORK_DONE                                                        syscall.Errno = 235
-	ERROR_VC_DISCONNECTED                                                     syscall.Errno = 240
-	ERROR_INVALID_EA_NAME                                                     syscall.Errno = 254
-	ERROR_EA_LIST_INCONSISTENT                                                syscall.Errno = 255
-	WAIT_TIMEOUT                                                              syscall.Errno = 258
-	ERROR_NO_MORE_ITEMS                                                       syscall.Errno = 259
-	ERROR_CANNOT_COPY                                                         syscall.Errno = 266
-	ERROR_DIRECTORY                                                           syscall.Errno = 267
-	ERROR_EAS_DIDNT_FIT                                                       syscall.Errno = 275
-	ERROR_EA_FILE_CORRUPT                                                     syscall.Errno = 276
-	ERROR_EA_TABLE_FULL                                                       syscall.Errno = 277
-	ERROR_INVALID_EA_HANDLE                                                   syscall.Errno = 278
-	ERROR_EAS_NOT_SUPPORTED                                                   syscall.Errno = 282
-	ERROR_NOT_OWNER                                                           syscall.Errno = 288
-	ERROR_TOO_MANY_POSTS                                                      syscall.Errno = 298
-	ERROR_PARTIAL_COPY                                                        syscall.Errno = 299
-	ERROR_OPLOCK_NOT_GRANTED                                                  syscall.Errno = 300
-	ERROR_INVALID_OPLOCK_PROTOCOL                                             syscall.Errno = 301
-	ERROR_DISK_TOO_FRAGMENTED                                                 syscall.Errno = 302
-	ERROR_DELETE_PENDING                                                      syscall.Errno = 303
-	ERROR_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING                syscall.Errno = 304
-	ERROR_SHORT_NAMES_NOT_ENABLED_ON_VOLUME                                   syscall.Errno = 305
-	ERROR_SECURITY_STREAM_IS_INCONSISTENT                                     syscall.Errno = 306
-	ERROR_INVALID_LOCK_RANGE                                                  syscall.Errno = 307
-	ERROR_IMAGE_SUBSYSTEM_NOT_PRESENT                                         syscall.Errno = 308
-	ERROR_NOTIFICATION_GUID_ALREADY_DEFINED                                   syscall.Errno = 309
-	ERROR_INVALID_EXCEPTION_HANDLER                                           syscall.Errno = 310
-	ERROR_DUPLICATE_PRIVILEGES                                                syscall.Errno = 311
-	ERROR_NO_RANGES_PROCESSED                                                 syscall.Errno = 312
-	ERROR_NOT_ALLOWED_ON_SYSTEM_FILE                                          syscall.Errno = 313
-	ERROR_DISK_RESOURCES_EXHAUSTED                                            syscall.Errno = 314
-	ERROR_INVALID_TOKEN                                                       syscall.Errno = 315
-	ERROR_DEVICE_FEATURE_NOT_SUPPORTED                                        syscall.Errno = 316
-	ERROR_MR_MID_NOT_FOUND                                                    syscall.Errno = 317
-	ERROR_SCOPE_NOT_FOUND                                                     syscall.Errno = 318
-	ERROR_UNDEFINED_SCOPE                                                     syscall.Errno = 319
-	ERROR_INVALID_CAP                                                         syscall.Errno = 320
-	ERROR_DEVICE_UNREACHABLE                                                  syscall.Errno = 321
-	ERROR_DEVICE_NO_RESOURCES                                                 syscall.Errno = 322
-	ERROR_DATA_CHECKSUM_ERROR                                                 syscall.Errno = 323
-	ERROR_INTERMIXED_KERNEL_EA_OPERATION                                      syscall.Errno = 324
-	ERROR_FILE_LEVEL_TRIM_NOT_SUPPORTED                                       syscall.Errno = 326
-	ERROR_OFFSET_ALIGNMENT_VIOLATION                                          syscall.Errno = 327
-	ERROR_INVALID_FIELD_IN_PARAMETER_LIST                                     syscall.Errno = 328
-	ERROR_OPERATION_IN_PROGRESS                                               syscall.Errno = 329
-	ERROR_BAD_DEVICE_PATH                                                     syscall.Errno = 330
-	ERROR_TOO_MANY_DESCRIPTORS                                                syscall.Errno = 331
-	ERROR_SCRUB_DATA_DISABLED                                                 syscall.Errno = 332
-	ERROR_NOT_REDUNDANT_STORAGE                                               syscall.Errno = 333
-	ERROR_RESIDENT_FILE_NOT_SUPPORTED                                         syscall.Errno = 334
-	ERROR_COMPRESSED_FILE_NOT_SUPPORTED                                       syscall.Errno = 335
-	ERROR_DIRECTORY_NOT_SUPPORTED                                             syscall.Errno = 336
-	ERROR_NOT_READ_FROM_COPY                                                  syscall.Errno = 337
-	ERROR_FT_WRITE_FAILURE                                                    syscall.Errno = 338
-	ERROR_FT_DI_SCAN_REQUIRED                                                 syscall.Errno = 339
-	ERROR_INVALID_KERNEL_INFO_VERSION                                         syscall.Errno = 340
-	ERROR_INVALID_PEP_INFO_VERSION                                            syscall.Errno = 341
-	ERROR_OBJECT_NOT_EXTERNALLY_BACKED                                        syscall.Errno = 342
-	ERROR_EXTERNAL_BACKING_PROVIDER_UNKNOWN                                   syscall.Errno = 343
-	ERROR_COMPRESSION_NOT_BENEFICIAL                                          syscall.Errno = 344
-	ERROR_STORAGE_TOPOLOGY_ID_MISMATCH                                        syscall.Errno = 345
-	ERROR_BLOCKED_BY_PARENTAL_CONTROLS                                        syscall.Errno = 346
-	ERROR_BLOCK_TOO_MANY_REFERENCES                                           syscall.Errno = 347
-	ERROR_MARKED_TO_DISALLOW_WRITES                                           syscall.Errno = 348
-	ERROR_ENCLAVE_FAILURE                                                     syscall.Errno = 349
-	ERROR_FAIL_NOACTION_REBOOT                                                syscall.Errno = 350
-	ERROR_FAIL_SHUTDOWN                                                       syscall.Errno = 351
-	ERROR_FAIL_RESTART                                                        syscall.Errno = 352
-	ERROR_MAX_SESSIONS_REACHED                                                syscall.Errno = 353
-	ERROR_NETWORK_ACCESS_DENIED_EDP                                           syscall.Errno = 354
-	ERROR_DEVICE_HINT_NAME_BUFFER_TOO_SMALL                                   syscall.Errno = 355
-	ERROR_EDP_POLICY_DENIES_OPERATION                                         syscall.Errno = 356
-	ERROR_EDP_DPL_POLICY_CANT_BE_SATISFIED                                    syscall.Errno = 357
-	ERROR_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT                               syscall.Errno = 358
-	ERROR_DEVICE_IN_MAINTENANCE                                               syscall.Errno = 359
-	ERROR_NOT_SUPPORTED_ON_DAX                                                syscall.Errno = 360
-	ERROR_DAX_MAPPING_EXISTS                                                  syscall.Errno = 361
-	ERROR_CLOUD_FILE_PROVIDER_NOT_RUNNING                                     syscall.Errno = 362
-	ERROR_CLOUD_FILE_METADATA_CORRUPT                                         syscall.Errno = 363
-	ERROR_CLOUD_FILE_METADATA_TOO_LARGE                                       syscall.Errno = 364
-	ERROR_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE                                  syscall.Errno = 365
-	ERROR_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH                          syscall.Errno = 366
-	ERROR_CHILD_PROCESS_BLOCKED                                               syscall.Errno = 367
-	ERROR_STORAGE_LOST_DATA_PERSISTENCE                                       syscall.Errno = 368
-	ERROR_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE                              syscall.Errno = 369
-	ERROR_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT                         syscall.Errno = 370
-	ERROR_FILE_SYSTEM_VIRTUALIZATION_BUSY                                     syscall.Errno = 371
-	ERROR_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN                         syscall.Errno = 372
-	ERROR_GDI_HANDLE_LEAK                                                     syscall.Errno = 373
-	ERROR_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS                                  syscall.Errno = 374
-	ERROR_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED                           syscall.Errno = 375
-	ERROR_NOT_A_CLOUD_FILE                                                    syscall.Errno = 376
-	ERROR_CLOUD_FILE_NOT_IN_SYNC                                              syscall.Errno = 377
-	ERROR_CLOUD_FILE_ALREADY_CONNECTED                                        syscall.Errno = 378
-	ERROR_CLOUD_FILE_NOT_SUPPORTED                                            syscall.Errno = 379
-	ERROR_CLOUD_FILE_INVALID_REQUEST                                          syscall.Errno = 380
-	ERROR_CLOUD_FILE_READ_ONLY_VOLUME                                         syscall.Errno = 381
-	ERROR_CLOUD_FILE_CONNECTED_PROVIDER_ONLY                                  syscall.Errno = 382
-	ERROR_CLOUD_FILE_VALIDATION_FAILED                                        syscall.Errno = 383
-	ERROR_SMB1_NOT_AVAILABLE                                                  syscall.Errno = 384
-	ERROR_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION                        syscall.Errno = 385
-	ERROR_CLOUD_FILE_AUTHENTICATION_FAILED                                    syscall.Errno = 386
-	ERROR_CLOUD_FILE_INSUFFICIENT_RESOURCES                                   syscall.Errno = 387
-	ERROR_CLOUD_FILE_NETWORK_UNAVAILABLE                                      syscall.Errno = 388
-	ERROR_CLOUD_FILE_UNSUCCESSFUL                                             syscall.Errno = 389
-	ERROR_CLOUD_FILE_NOT_UNDER_SYNC_ROOT                                      syscall.Errno = 390
-	ERROR_CLOUD_FILE_IN_USE                                                   syscall.Errno = 391
-	ERROR_CLOUD_FILE_PINNED                                                   syscall.Errno = 392
-	ERROR_CLOUD_FILE_REQUEST_ABORTED                                          syscall.Errno = 393
-	ERROR_CLOUD_FILE_PROPERTY_CORRUPT                                         syscall.Errno = 394
-	ERROR_CLOUD_FILE_ACCESS_DENIED                                            syscall.Errno = 395
-	ERROR_CLOUD_FILE_INCOMPATIBLE_HARDLINKS                                   syscall.Errno = 396
-	ERROR_CLOUD_FILE_PROPERTY_LOCK_CONFLICT                                   syscall.Errno = 397
-	ERROR_CLOUD_FILE_REQUEST_CANCELED                                         syscall.Errno = 398
-	ERROR_EXTERNAL_SYSKEY_NOT_SUPPORTED                                       syscall.Errno = 399
-	ERROR_THREAD_MODE_ALREADY_BACKGROUND                                      syscall.Errno = 400
-	ERROR_THREAD_MODE_NOT_BACKGROUND                                          syscall.Errno = 401
-	ERROR_PROCESS_MODE_ALREADY_BACKGROUND                                     syscall.Errno = 402
-	ERROR_PROCESS_MODE_NOT_BACKGROUND                                         syscall.Errno = 403
-	ERROR_CLOUD_FILE_PROVIDER_TERMINATED                                      syscall.Errno = 404
-	ERROR_NOT_A_CLOUD_SYNC_ROOT                                               syscall.Errno = 405
-	ERROR_FILE_PROTECTED_UNDER_DPL                                            syscall.Errno = 406
-	ERROR_VOLUME_NOT_CLUSTER_ALIGNED                                          syscall.Errno = 407
-	ERROR_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND                              syscall.Errno = 408
-	ERROR_APPX_FILE_NOT_ENCRYPTED                                             syscall.Errno = 409
-	ERROR_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED                                  syscall.Errno = 410
-	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET                        syscall.Errno = 411
-	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE                         syscall.Errno = 412
-	ERROR_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER                         syscall.Errno = 413
-	ERROR_LINUX_SUBSYSTEM_NOT_PRESENT                                         syscall.Errno = 414
-	ERROR_FT_READ_FAILURE                                                     syscall.Errno = 415
-	ERROR_STORAGE_RESERVE_ID_INVALID                                          syscall.Errno = 416
-	ERROR_STORAGE_RESERVE_DOES_NOT_EXIST                                      syscall.Errno = 417
-	ERROR_STORAGE_RESERVE_ALREADY_EXISTS                                      syscall.Errno = 418
-	ERROR_STORAGE_RESERVE_NOT_EMPTY                                           syscall.Errno = 419
-	ERROR_NOT_A_DAX_VOLUME                                                    syscall.Errno = 420
-	ERROR_NOT_DAX_MAPPABLE                                                    syscall.Errno = 421
-	ERROR_TIME_CRITICAL_THREAD                                                syscall.Errno = 422
-	ERROR_DPL_NOT_SUPPORTED_FOR_USER                                          syscall.Errno = 423
-	ERROR_CASE_DIFFERING_NAMES_IN_DIR                                         syscall.Errno = 424
-	ERROR_CAPAUTHZ_NOT_DEVUNLOCKED                                            syscall.Errno = 450
-	ERROR_CAPAUTHZ_CHANGE_TYPE                                                syscall.Errno = 451
-	ERROR_CAPAUTHZ_NOT_PROVISIONED                                            syscall.Errno = 452
-	ERROR_CAPAUTHZ_NOT_AUTHORIZED                                             syscall.Errno = 453
-	ERROR_CAPAUTHZ_NO_POLICY                                                  syscall.Errno = 454
-	ERROR_CAPAUTHZ_DB_CORRUPTED                                               syscall.Errno = 455
-	ERROR_CAPAUTHZ_SCCD_INVALID_CATALOG                                       syscall.Errno = 456
-	ERROR_CAPAUTHZ_SCCD_NO_AUTH_ENTITY                                        syscall.Errno = 457
-	ERROR_CAPAUTHZ_SCCD_PARSE_ERROR                                           syscall.Errno = 458
-	ERROR_CAPAUTHZ_SCCD_DEV_MODE_REQUIRED                                     syscall.Errno = 459
-	ERROR_CAPAUTHZ_SCCD_NO_CAPABILITY_MATCH                                   syscall.Errno = 460
-	ERROR_PNP_QUERY_REMOVE_DEVICE_TIMEOUT                                     syscall.Errno = 480
-	ERROR_PNP_QUERY_REMOVE_RELATED_DEVICE_TIMEOUT                             syscall.Errno = 481
-	ERROR_PNP_QUERY_REMOVE_UNRELATED_DEVICE_TIMEOUT                           syscall.Errno = 482
-	ERROR_DEVICE_HARDWARE_ERROR                                               syscall.Errno = 483
-	ERROR_INVALID_ADDRESS                                                     syscall.Errno = 487
-	ERROR_VRF_CFG_ENABLED                                                     syscall.Errno = 1183
-	ERROR_PARTITION_TERMINATING                                               syscall.Errno = 1184
-	ERROR_USER_PROFILE_LOAD                                                   syscall.Errno = 500
-	ERROR_ARITHMETIC_OVERFLOW                                                 syscall.Errno = 534
-	ERROR_PIPE_CONNECTED                                                      syscall.Errno = 535
-	ERROR_PIPE_LISTENING                                                      syscall.Errno = 536
-	ERROR_VERIFIER_STOP                                                       syscall.Errno = 537
-	ERROR_ABIOS_ERROR                                                         syscall.Errno = 538
-	ERROR_WX86_WARNING                                                        syscall.Errno = 539
-	ERROR_WX86_ERROR                                                          syscall.Errno = 540
-	ERROR_TIMER_NOT_CANCELED                                                  syscall.Errno = 541
-	ERROR_UNWIND                                                              syscall.Errno = 542
-	ERROR_BAD_STACK                                                           syscall.Errno = 543
-	ERROR_INVALID_UNWIND_TARGET                                               syscall.Errno = 544
-	ERROR_INVALID_PORT_ATTRIBUTES                                             syscall.Errno = 545
-	ERROR_PORT_MESSAGE_TOO_LONG                                               syscall.Errno = 546
-	ERROR_INVALID_QUOTA_LOWER                                                 syscall.Errno = 547
-	ERROR_DEVICE_ALREADY_ATTACHED                                             syscall.Errno = 548
-	ERROR_INSTRUCTION_MISALIGNMENT                                            syscall.Errno = 549
-	ERROR_PROFILING_NOT_STARTED                                               syscall.Errno = 550
-	ERROR_PROFILING_NOT_STOPPED                                               syscall.Errno = 551
-	ERROR_COULD_NOT_INTERPRET                                                 syscall.Errno = 552
-	ERROR_PROFILING_AT_LIMIT                                                  syscall.Errno = 553
-	ERROR_CANT_WAIT                                                           syscall.Errno = 554
-	ERROR_CANT_TERMINATE_SELF                                                 syscall.Errno = 555
-	ERROR_UNEXPECTED_MM_CREATE_ERR                                            syscall.Errno = 556
-	ERROR_UNEXPECTED_MM_MAP_ERROR                                             syscall.Errno = 557
-	ERROR_UNEXPECTED_MM_EXTEND_ERR                                            syscall.Errno = 558
-	ERROR_BAD_FUNCTION_TABLE                                                  syscall.Errno = 559
-	ERROR_NO_GUID_TRANSLATION                                                 syscall.Errno = 560
-	ERROR_INVALID_LDT_SIZE                                                    syscall.Errno = 561
-	ERROR_INVALID_LDT_OFFSET                                                  syscall.Errno = 563
-	ERROR_INVALID_LDT_DESCRIPTOR                                              syscall.Errno = 564
-	ERROR_TOO_MANY_THREADS                                                    syscall.Errno = 565
-	ERROR_THREAD_NOT_IN_PROCESS                                               syscall.Errno = 566
-	ERROR_PAGEFILE_QUOTA_EXCEEDED                                             syscall.Errno = 567
-	ERROR_LOGON_SERVER_CONFLICT                                               syscall.Errno = 568
-	ERROR_SYNCHRONIZATION_REQUIRED                                            syscall.Errno = 569
-	ERROR_NET_OPEN_FAILED                                                     syscall.Errno = 570
-	ERROR_IO_PRIVILEGE_FAILED                                                 syscall.Errno = 571
-	ERROR_CONTROL_C_EXIT                                                      syscall.Errno = 572
-	ERROR_MISSING_SYSTEMFILE                                                  syscall.Errno = 573
-	ERROR_UNHANDLED_EXCEPTION                                                 syscall.Errno = 574
-	ERROR_APP_INIT_FAILURE                                                    syscall.Errno = 575
-	ERROR_PAGEFILE_CREATE_FAILED                                              syscall.Errno = 576
-	ERROR_INVALID_IMAGE_HASH                                                  syscall.Errno = 577
-	ERROR_NO_PAGEFILE                                                         syscall.Errno = 578
-	ERROR_ILLEGAL_FLOAT_CONTEXT                                               syscall.Errno = 579
-	ERROR_NO_EVENT_PAIR                                                       syscall.Errno = 580
-	ERROR_DOMAIN_CTRLR_CONFIG_ERROR                                           syscall.Errno = 581
-	ERROR_ILLEGAL_CHARACTER                                                   syscall.Errno = 582
-	ERROR_UNDEFINED_CHARACTER                                                 syscall.Errno = 583
-	ERROR_FLOPPY_VOLUME                                                       syscall.Errno = 584
-	ERROR_BIOS_FAILED_TO_CONNECT_INTERRUPT                                    syscall.Errno = 585
-	ERROR_BACKUP_CONTROLLER                                                   syscall.Errno = 586
-	ERROR_MUTANT_LIMIT_EXCEEDED                                               syscall.Errno = 587
-	ERROR_FS_DRIVER_REQUIRED                                                  syscall.Errno = 588
-	ERROR_CANNOT_LOAD_REGISTRY_FILE                                           syscall.Errno = 589
-	ERROR_DEBUG_ATTACH_FAILED                                                 syscall.Errno = 590
-	ERROR_SYSTEM_PROCESS_TERMINATED                                           syscall.Errno = 591
-	ERROR_DATA_NOT_ACCEPTED                                                   syscall.Errno = 592
-	ERROR_VDM_HARD_ERROR                                                      syscall.Errno = 593
-	ERROR_DRIVER_CANCEL_TIMEOUT                                               syscall.Errno = 594
-	ERROR_REPLY_MESSAGE_MISMATCH                                              syscall.Errno = 595
-	ERROR_LOST_WRITEBEHIND_DATA                                               syscall.Errno = 596
-	ERROR_CLIENT_SERVER_PARAMETERS_INVALID                                    syscall.Errno = 597
-	ERROR_NOT_TINY_STREAM                                                     syscall.Errno = 598
-	ERROR_STACK_OVERFLOW_READ                                                 syscall.Errno = 599
-	ERROR_CONVERT_TO_LARGE                                                    syscall.Errno = 600
-	ERROR_FOUND_OUT_OF_SCOPE                                                  syscall.Errno = 601
-	ERROR_ALLOCATE_BUCKET                                                     syscall.Errno = 602
-	ERROR_MARSHALL_OVERFLOW                                                   syscall.Errno = 603
-	ERROR_INVALID_VARIANT                                                     syscall.Errno = 604
-	ERROR_BAD_COMPRESSION_BUFFER                                              syscall.Errno = 605
-	ERROR_AUDIT_FAILED                                                        syscall.Errno = 606
-	ERROR_TIMER_RESOLUTION_NOT_SET                                            syscall.Errno = 607
-	ERROR_INSUFFICIENT_LOGON_INFO                                             syscall.Errno = 608
-	ERROR_BAD_DLL_ENTRYPOINT                                                  syscall.Errno = 609
-	ERROR_BAD_SERVICE_ENTRYPOINT                                              syscall.Errno = 610
-	ERROR_IP_ADDRESS_CONFLICT1                                                syscall.Errno = 611
-	ERROR_IP_ADDRESS_CONFLICT2                                                syscall.Errno = 612
-	ERROR_REGISTRY_QUOTA_LIMIT                                                syscall.Errno = 613
-	ERROR_NO_CALLBACK_ACTIVE                                                  syscall.Errno = 614
-	ERROR_PWD_TOO_SHORT                                                       syscall.Errno = 615
-	ERROR_PWD_TOO_RECENT                                                      syscall.Errno = 616
-	ERROR_PWD_HISTORY_CONFLICT                                                syscall.Errno = 617
-	ERROR_UNSUPPORTED_COMPRESSION                                             syscall.Errno = 618
-	ERROR_INVALID_HW_PROFILE                                                  syscall.Errno = 619
-	ERROR_INVALID_PLUGPLAY_DEVICE_PATH                                        syscall.Errno = 620
-	ERROR_QUOTA_LIST_INCONSISTENT                                             syscall.Errno = 621
-	ERROR_EVALUATION_EXPIRATION                                               syscall.Errno = 622
-	ERROR_ILLEGAL_DLL_RELOCATION                                              syscall.Errno = 623
-	ERROR_DLL_INIT_FAILED_LOGOFF                                              syscall.Errno = 624
-	ERROR_VALIDATE_CONTINUE                                                   syscall.Errno = 625
-	ERROR_NO_MORE_MATCHES                                                     syscall.Errno = 626
-	ERROR_RANGE_LIST_CONFLICT                                                 syscall.Errno = 627
-	ERROR_SERVER_SID_MISMATCH                                                 syscall.Errno = 628
-	ERROR_CANT_ENABLE_DENY_ONLY                                               syscall.Errno = 629
-	ERROR_FLOAT_MULTIPLE_FAULTS                                               syscall.Errno = 630
-	ERROR_FLOAT_MULTIPLE_TRAPS                                                syscall.Errno = 631
-	ERROR_NOINTERFACE                                                         syscall.Errno = 632
-	ERROR_DRIVER_FAILED_SLEEP                                                 syscall.Errno = 633
-	ERROR_CORRUPT_SYSTEM_FILE                                                 syscall.Errno = 634
-	ERROR_COMMITMENT_MINIMUM                                                  syscall.Errno = 635
-	ERROR_PNP_RESTART_ENUMERATION                                             syscall.Errno = 636
-	ERROR_SYSTEM_IMAGE_BAD_SIGNATURE                                          syscall.Errno = 637
-	ERROR_PNP_REBOOT_REQUIRED                                                 syscall.Errno = 638
-	ERROR_INSUFFICIENT_POWER                                                  syscall.Errno = 639
-	ERROR_MULTIPLE_FAULT_VIOLATION                                            syscall.Errno = 640
-	ERROR_SYSTEM_SHUTDOWN                                                     syscall.Errno = 641
-	ERROR_PORT_NOT_SET                                                        syscall.Errno = 642
-	ERROR_DS_VERSION_CHECK_FAILURE                                            syscall.Errno = 643
-	ERROR_RANGE_NOT_FOUND                                                     syscall.Errno = 644
-	ERROR_NOT_SAFE_MODE_DRIVER                                                syscall.Errno = 646
-	ERROR_FAILED_DRIVER_ENTRY                                                 syscall.Errno = 647
-	ERROR_DEVICE_ENUMERATION_ERROR                                            syscall.Errno = 648
-	ERROR_MOUNT_POINT_NOT_RESOLVED                                            syscall.Errno = 649
-	ERROR_INVALID_DEVICE_OBJECT_PARAMETER                                     syscall.Errno = 650
-	ERROR_MCA_OCCURED                                                         syscall.Errno = 651
-	ERROR_DRIVER_DATABASE_ERROR                                               syscall.Errno = 652
-	ERROR_SYSTEM_HIVE_TOO_LARGE                                               syscall.Errno = 653
-	ERROR_DRIVER_FAILED_PRIOR_UNLOAD                                          syscall.Errno = 654
-	ERROR_VOLSNAP_PREPARE_HIBERNATE                                           syscall.Errno = 655
-	ERROR_HIBERNATION_FAILURE                                                 syscall.Errno = 656
-	ERROR_PWD_TOO_LONG                                                        syscall.Errno = 657
-	ERROR_FILE_SYSTEM_LIMITATION                                              syscall.Errno = 665
-	ERROR_ASSERTION_FAILURE                                                   syscall.Errno = 668
-	ERROR_ACPI_ERROR                                                          syscall.Errno = 669
-	ERROR_WOW_ASSERTION                                                       syscall.Errno = 670
-	ERROR_PNP_BAD_MPS_TABLE                                                   syscall.Errno = 671
-	ERROR_PNP_TRANSLATION_FAILED                                              syscall.Errno = 672
-	ERROR_PNP_IRQ_TRANSLATION_FAILED                                          syscall.Errno = 673
-	ERROR_PNP_INVALID_ID                                                      syscall.Errno = 674
-	ERROR_WAKE_SYSTEM_DEBUGGER                                                syscall.Errno = 675
-	ERROR_HANDLES_CLOSED                                                      syscall.Errno = 676
-	ERROR_EXTRANEOUS_INFORMATION                                              syscall.Errno = 677
-	ERROR_RXACT_COMMIT_NECESSARY                                              syscall.Errno = 678
-	ERROR_MEDIA_CHECK                                                         syscall.Errno = 679
-	ERROR_GUID_SUBSTITUTION_MADE                                              syscall.Errno = 680
-	ERROR_STOPPED_ON_SYMLINK                                                  syscall.Errno = 681
-	ERROR_LONGJUMP                                                            syscall.Errno = 682
-	ERROR_PLUGPLAY_QUERY_VETOED                                               syscall.Errno = 683
-	ERROR_UNWIND_CONSOLIDATE                                                  syscall.Errno = 684
-	ERROR_REGISTRY_HIVE_RECOVERED                                             syscall.Errno = 685
-	ERROR_DLL_MIGHT_BE_INSECURE                                               syscall.Errno = 686
-	ERROR_DLL_MIGHT_BE_INCOMPATIBLE                                           syscall.Errno = 687
-	ERROR_DBG_EXCEPTION_NOT_HANDLED                                           syscall.Errno = 688
-	ERROR_DBG_REPLY_LATER                                                     syscall.Errno = 689
-	ERROR_DBG_UNABLE_TO_PROVIDE_HANDLE                                        syscall.Errno = 690
-	ERROR_DBG_TERMINATE_THREAD                                                syscall.Errno = 691
-	ERROR_DBG_TERMINATE_PROCESS                                               syscall.Errno = 692
-	ERROR_DBG_CONTROL_C                                                       syscall.Errno = 693
-	ERROR_DBG_PRINTEXCEPTION_C                                                syscall.Errno = 694
-	ERROR_DBG_RIPEXCEPTION                                                    syscall.Errno = 695
-	ERROR_DBG_CONTROL_BREAK                                                   syscall.Errno = 696
-	ERROR_DBG_COMMAND_EXCEPTION                                               syscall.Errno = 697
-	ERROR_OBJECT_NAME_EXISTS                                                  syscall.Errno = 698
-	ERROR_THREAD_WAS_SUSPENDED                                                syscall.Errno = 699
-	ERROR_IMAGE_NOT_AT_BASE                                                   syscall.Errno = 700
-	ERROR_RXACT_STATE_CREATED                                                 syscall.Errno = 701
-	ERROR_SEGMENT_NOTIFICATION                                                syscall.Errno = 702
-	ERROR_BAD_CURRENT_DIRECTORY                                               syscall.Errno = 703
-	ERROR_FT_READ_RECOVERY_FROM_BACKUP                                        syscall.Errno = 704
-	ERROR_FT_WRITE_RECOVERY                                                   syscall.Errno = 705
-	ERROR_IMAGE_MACHINE_TYPE_MISMATCH                                         syscall.Errno = 706
-	ERROR_RECEIVE_PARTIAL                                                     syscall.Errno = 707
-	ERROR_RECEIVE_EXPEDITED                                                   syscall.Errno = 708
-	ERROR_RECEIVE_PARTIAL_EXPEDITED                                           syscall.Errno = 709
-	ERROR_EVENT_DONE                                                          syscall.Errno = 710
-	ERROR_EVENT_PENDING                                                       syscall.Errno = 711
-	ERROR_CHECKING_FILE_SYSTEM                                                syscall.Errno = 712
-	ERROR_FATAL_APP_EXIT                                                      syscall.Errno = 713
-	ERROR_PREDEFINED_HANDLE                                                   syscall.Errno = 714
-	ERROR_WAS_UNLOCKED                                                        syscall.Errno = 715
-	ERROR_SERVICE_NOTIFICATION                                                syscall.Errno = 716
-	ERROR_WAS_LOCKED                                                          syscall.Errno = 717
-	ERROR_LOG_HARD_ERROR                                                      syscall.Errno = 718
-	ERROR_ALREADY_WIN32                                                       syscall.Errno = 719
-	ERROR_IMAGE_MACHINE_TYPE_MISMATCH_EXE                                     syscall.Errno = 720
-	ERROR_NO_YIELD_PERFORMED                                                  syscall.Errno = 721
-	ERROR_TIMER_RESUME_IGNORED                                                syscall.Errno = 722
-	ERROR_ARBITRATION_UNHANDLED                                               syscall.Errno = 723
-	ERROR_CARDBUS_NOT_SUPPORTED                                               syscall.Errno = 724
-	ERROR_MP_PROCESSOR_MISMATCH                                               syscall.Errno = 725
-	ERROR_HIBERNATED                                                          syscall.Errno = 726
-	ERROR_RESUME_HIBERNATION                                                  syscall.Errno = 727
-	ERROR_FIRMWARE_UPDATED                                                    syscall.Errno = 728
-	ERROR_DRIVERS_LEAKING_LOCKED_PAGES                                        syscall.Errno = 729
-	ERROR_WAKE_SYSTEM                                                         syscall.Errno = 730
-	ERROR_WAIT_1                                                              syscall.Errno = 731
-	ERROR_WAIT_2                                                              syscall.Errno = 732
-	ERROR_WAIT_3                                                              syscall.Errno = 733
-	ERROR_WAIT_63                                                             syscall.Errno = 734
-	ERROR_ABANDONED_WAIT_0                                                    syscall.Errno = 735
-	ERROR_ABANDONED_WAIT_63                                                   syscall.Errno = 736
-	ERROR_USER_APC                                                            syscall.Errno = 737
-	ERROR_KERNEL_APC                                                          syscall.Errno = 738
-	ERROR_ALERTED                                                             syscall.Errno = 739
-	ERROR_ELEVATION_REQUIRED                                                  syscall.Errno = 740
-	ERROR_REPARSE                                                             syscall.Errno = 741
-	ERROR_OPLOCK_BREAK_IN_PROGRESS                                            syscall.Errno = 742
-	ERROR_VOLUME_MOUNTED                                                      syscall.Errno = 743
-	ERROR_RXACT_COMMITTED                                                     syscall.Errno = 744
-	ERROR_NOTIFY_CLEANUP                                                      syscall.Errno = 745
-	ERROR_PRIMARY_TRANSPORT_CONNECT_FAILED                                    syscall.Errno = 746
-	ERROR_PAGE_FAULT_TRANSITION                                               syscall.Errno = 747
-	ERROR_PAGE_FAULT_DEMAND_ZERO                                              syscall.Errno = 748
-	ERROR_PAGE_FAULT_COPY_ON_WRITE                                            syscall.Errno = 749
-	ERROR_PAGE_FAULT_GUARD_PAGE                                               syscall.Errno = 750
-	ERROR_PAGE_FAULT_PAGING_FILE                                              syscall.Errno = 751
-	ERROR_CACHE_PAGE_LOCKED                                                   syscall.Errno = 752
-	ERROR_CRASH_DUMP                                                          syscall.Errno = 753
-	ERROR_BUFFER_ALL_ZEROS                                                    syscall.Errno = 754
-	ERROR_REPARSE_OBJECT                                                      syscall.Errno = 755
-	ERROR_RESOURCE_REQUIREMENTS_CHANGED                                       syscall.Errno = 756
-	ERROR_TRANSLATION_COMPLETE                                                syscall.Errno = 757
-	ERROR_NOTHING_TO_TERMINATE                                                syscall.Errno = 758
-	ERROR_PROCESS_NOT_IN_JOB                                                  syscall.Errno = 759
-	ERROR_PROCESS_IN_JOB                                                      syscall.Errno = 760
-	ERROR_VOLSNAP_HIBERNATE_READY                                             syscall.Errno = 761
-	ERROR_FSFILTER_OP_COMPLETED_SUCCESSFULLY                                  syscall.Errno = 762
-	ERROR_INTERRUPT_VECTOR_ALREADY_CONNECTED                                  syscall.Errno = 763
-	ERROR_INTERRUPT_STILL_CONNECTED                                           syscall.Errno = 764
-	ERROR_WAIT_FOR_OPLOCK                                                     syscall.Errno = 765
-	ERROR_DBG_EXCEPTION_HANDLED                                               syscall.Errno = 766
-	ERROR_DBG_CONTINUE                                                        syscall.Errno = 767
-	ERROR_CALLBACK_POP_STACK                                                  syscall.Errno = 768
-	ERROR_COMPRESSION_DISABLED                                                syscall.Errno = 769
-	ERROR_CANTFETCHBACKWARDS                                                  syscall.Errno = 770
-	ERROR_CANTSCROLLBACKWARDS                                                 syscall.Errno = 771
-	ERROR_ROWSNOTRELEASED                                                     syscall.Errno = 772
-	ERROR_BAD_ACCESSOR_FLAGS                                                  syscall.Errno = 773
-	ERROR_ERRORS_ENCOUNTERED                                                  syscall.Errno = 774
-	ERROR_NOT_CAPABLE                                                         syscall.Errno = 775
-	ERROR_REQUEST_OUT_OF_SEQUENCE                                             syscall.Errno = 776
-	ERROR_VERSION_PARSE_ERROR                                                 syscall.Errno = 777
-	ERROR_BADSTARTPOSITION                                                    syscall.Errno = 778
-	ERROR_MEMORY_HARDWARE                                                     syscall.Errno = 779
-	ERROR_DISK_REPAIR_DISABLED                                                syscall.Errno = 780
-	ERROR_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE             syscall.Errno = 781
-	ERROR_SYSTEM_POWERSTATE_TRANSITION                                        syscall.Errno = 782
-	ERROR_SYSTEM_POWERSTATE_COMPLEX_TRANSITION                                syscall.Errno = 783
-	ERROR_MCA_EXCEPTION                                                       syscall.Errno = 784
-	ERROR_ACCESS_AUDIT_BY_POLICY                                              syscall.Errno = 785
-	ERROR_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY                               syscall.Errno = 786
-	ERROR_ABANDON_HIBERFILE                                                   syscall.Errno = 787
-	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED                          syscall.Errno = 788
-	ERROR_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR                          syscall.Errno = 789
-	ERROR_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR                              syscall.Errno = 790
-	ERROR_BAD_MCFG_TABLE                                                      syscall.Errno = 791
-	ERROR_DISK_REPAIR_REDIRECTED                                              syscall.Errno = 792
-	ERROR_DISK_REPAIR_UNSUCCESSFUL                                            syscall.Errno = 793
-	ERROR_CORRUPT_LOG_OVERFULL                                                syscall.Errno = 794
-	ERROR_CORRUPT_LOG_CORRUPTED                                               syscall.Errno = 795
-	ERROR_CORRUPT_LOG_UNAVAILABLE                                             syscall.Errno = 796
-	ERROR_CORRUPT_LOG_DELETED_FULL                                            syscall.Errno = 797
-	ERROR_CORRUPT_LOG_CLEARED                                                 syscall.Errno = 798
-	ERROR_ORPHAN_NAME_EXHAUSTED                                               syscall.Errno = 799
-	ERROR_OPLOCK_SWITCHED_TO_NEW_HANDLE                                       syscall.Errno = 800
-	ERROR_CANNOT_GRANT_REQUESTED_OPLOCK                                       syscall.Errno = 801
-	ERROR_CANNOT_BREAK_OPLOCK                                                 syscall.Errno = 802
-	ERROR_OPLOCK_HANDLE_CLOSED                                                syscall.Errno = 803
-	ERROR_NO_ACE_CONDITION                                                    syscall.Errno = 804
-	ERROR_INVALID_ACE_CONDITION                                               syscall.Errno = 805
-	ERROR_FILE_HANDLE_REVOKED                                                 syscall.Errno = 806
-	ERROR_IMAGE_AT_DIFFERENT_BASE                                             syscall.Errno = 807
-	ERROR_ENCRYPTED_IO_NOT_POSSIBLE                                           syscall.Errno = 808
-	ERROR_FILE_METADATA_OPTIMIZATION_IN_PROGRESS                              syscall.Errno = 809
-	ERROR_QUOTA_ACTIVITY                                                      syscall.Errno = 810
-	ERROR_HANDLE_REVOKED                                                      syscall.Errno = 811
-	ERROR_CALLBACK_INVOKE_INLINE                                              syscall.Errno = 812
-	ERROR_CPU_SET_INVALID                                                     syscall.Errno = 813
-	ERROR_ENCLAVE_NOT_TERMINATED                                              syscall.Errno = 814
-	ERROR_ENCLAVE_VIOLATION                                                   syscall.Errno = 815
-	ERROR_EA_ACCESS_DENIED                                                    syscall.Errno = 994
-	ERROR_OPERATION_ABORTED                                                   syscall.Errno = 995
-	ERROR_IO_INCOMPLETE                                                       syscall.Errno = 996
-	ERROR_IO_PENDING                                                          syscall.Errno = 997
-	ERROR_NOACCESS                                                            syscall.Errno = 998
-	ERROR_SWAPERROR                                                           syscall.Errno = 999
-	ERROR_STACK_OVERFLOW                                                      syscall.Errno = 1001
-	ERROR_INVALID_MESSAGE                                                     syscall.Errno = 1002
-	ERROR_CAN_NOT_COMPLETE                                                    syscall.Errno = 1003
-	ERROR_INVALID_FLAGS                                                       syscall.Errno = 1004
-	ERROR_UNRECOGNIZED_VOLUME                                                 syscall.Errno = 1005
-	ERROR_FILE_INVALID                                                        syscall.Errno = 1006
-	ERROR_FULLSCREEN_MODE                                                     syscall.Errno = 1007
-	ERROR_NO_TOKEN                                                            syscall.Errno = 1008
-	ERROR_BADDB                                                               syscall.Errno = 1009
-	ERROR_BADKEY                                                              syscall.Errno = 1010
-	ERROR_CANTOPEN                                                            syscall.Errno = 1011
-	ERROR_CANTREAD                                                            syscall.Errno = 1012
-	ERROR_CANTWRITE                                                           syscall.Errno = 1013
-	ERROR_REGISTRY_RECOVERED                                                  syscall.Errno = 1014
-	ERROR_REGISTRY_CORRUPT                                                    syscall.Errno = 1015
-	ERROR_REGISTRY_IO_FAILED                                                  syscall.Errno = 1016
-	ERROR_NOT_REGISTRY_FILE                                                   syscall.Errno = 1017
-	ERROR_KEY_DELETED                                                         syscall.Errno = 1018
-	ERROR_NO_LOG_SPACE                                                        syscall.Errno = 1019
-	ERROR_KEY_HAS_CHILDREN                                                    syscall.Errno = 1020
-	ERROR_CHILD_MUST_BE_VOLATILE                                              syscall.Errno = 1021
-	ERROR_NOTIFY_ENUM_DIR                                                     syscall.Errno = 1022
-	ERROR_DEPENDENT_SERVICES_RUNNING                                          syscall.Errno = 1051
-	ERROR_INVALID_SERVICE_CONTROL                                             syscall.Errno = 1052
-	ERROR_SERVICE_REQUEST_TIMEOUT                                             syscall.Errno = 1053
-	ERROR_SERVICE_NO_THREAD                                                   syscall.Errno = 1054
-	ERROR_SERVICE_DATABASE_LOCKED                                             syscall.Errno = 1055
-	ERROR_SERVICE_ALREADY_RUNNING                                             syscall.Errno = 1056
-	ERROR_INVALID_SERVICE_ACCOUNT                                             syscall.Errno = 1057
-	ERROR_SERVICE_DISABLED                                                    syscall.Errno = 1058
-	ERROR_CIRCULAR_DEPENDENCY                                                 syscall.Errno = 1059
-	ERROR_SERVICE_DOES_NOT_EXIST                                              syscall.Errno = 1060
-	ERROR_SERVICE_CANNOT_ACCEPT_CTRL                                          syscall.Errno = 1061
-	ERROR_SERVICE_NOT_ACTIVE                                                  syscall.Errno = 1062
-	ERROR_FAILED_SERVICE_CONTROLLER_CONNECT                                   syscall.Errno = 1063
-	ERROR_EXCEPTION_IN_SERVICE                                                syscall.Errno = 1064
-	ERROR_DATABASE_DOES_NOT_EXIST                                             syscall.Errno = 1065
-	ERROR_SERVICE_SPECIFIC_ERROR                                              syscall.Errno = 1066
-	ERROR_PROCESS_ABORTED                                                     syscall.Errno = 1067
-	ERROR_SERVICE_DEPENDENCY_FAIL                                             syscall.Errno = 1068
-	ERROR_SERVICE_LOGON_FAILED                                                syscall.Errno = 1069
-	ERROR_SERVICE_START_HANG                                                  syscall.Errno = 1070
-	ERROR_INVALID_SERVICE_LOCK                                                syscall.Errno = 1071
-	ERROR_SERVICE_MARKED_FOR_DELETE                                           syscall.Errno = 1072
-	ERROR_SERVICE_EXISTS                                                      syscall.Errno = 1073
-	ERROR_ALREADY_RUNNING_LKG                                                 syscall.Errno = 1074
-	ERROR_SERVICE_DEPENDENCY_DELETED                                          syscall.Errno = 1075
-	ERROR_BOOT_ALREADY_ACCEPTED                                               syscall.Errno = 1076
-	ERROR_SERVICE_NEVER_STARTED                                               syscall.Errno = 1077
-	ERROR_DUPLICATE_SERVICE_NAME                                              syscall.Errno = 1078
-	ERROR_DIFFERENT_SERVICE_ACCOUNT                                           syscall.Errno = 1079
-	ERROR_CANNOT_DETECT_DRIVER_FAILURE                                        syscall.Errno = 1080
-	ERROR_CANNOT_DETECT_PROCESS_ABORT                                         syscall.Errno = 1081
-	ERROR_NO_RECOVERY_PROGRAM                                                 syscall.Errno = 1082
-	ERROR_SERVICE_NOT_IN_EXE                                                  syscall.Errno = 1083
-	ERROR_NOT_SAFEBOOT_SERVICE                                                syscall.Errno = 1084
-	ERROR_END_OF_MEDIA                                                        syscall.Errno = 1100
-	ERROR_FILEMARK_DETECTED                                                   syscall.Errno = 1101
-	ERROR_BEGINNING_OF_MEDIA                                                  syscall.Errno = 1102
-	ERROR_SETMARK_DETECTED                                                    syscall.Errno = 1103
-	ERROR_NO_DATA_DETECTED                                                    syscall.Errno = 1104
-	ERROR_PARTITION_FAILURE                                                   syscall.Errno = 1105
-	ERROR_INVALID_BLOCK_LENGTH                                                syscall.Errno = 1106
-	ERROR_DEVICE_NOT_PARTITIONED                                              syscall.Errno = 1107
-	ERROR_UNABLE_TO_LOCK_MEDIA                                                syscall.Errno = 1108
-	ERROR_UNABLE_TO_UNLOAD_MEDIA                                              syscall.Errno = 1109
-	ERROR_MEDIA_CHANGED                                                       syscall.Errno = 1110
-	ERROR_BUS_RESET                                                           syscall.Errno = 1111
-	ERROR_NO_MEDIA_IN_DRIVE                                                   syscall.Errno = 1112
-	ERROR_NO_UNICODE_TRANSLATION                                              syscall.Errno = 1113
-	ERROR_DLL_INIT_FAILED                                                     syscall.Errno = 1114
-	ERROR_SHUTDOWN_IN_PROGRESS                                                syscall.Errno = 1115
-	ERROR_NO_SHUTDOWN_IN_PROGRESS                                             syscall.Errno = 1116
-	ERROR_IO_DEVICE                                                           syscall.Errno = 1117
-	ERROR_SERIAL_NO_DEVICE                                                    syscall.Errno = 1118
-	ERROR_IRQ_BUSY                                                            syscall.Errno = 1119
-	ERROR_MORE_WRITES                                                         syscall.Errno = 1120
-	ERROR_COUNTER_TIMEOUT                                                     syscall.Errno = 1121
-	ERROR_FLOPPY_ID_MARK_NOT_FOUND                                            syscall.Errno = 1122
-	ERROR_FLOPPY_WRONG_CYLINDER                                               syscall.Errno = 1123
-	ERROR_FLOPPY_UNKNOWN_ERROR                                                syscall.Errno = 1124
-	ERROR_FLOPPY_BAD_REGISTERS                                                syscall.Errno = 1125
-	ERROR_DISK_RECALIBRATE_FAILED                                             syscall.Errno = 1126
-	ERROR_DISK_OPERATION_FAILED                                               syscall.Errno = 1127
-	ERROR_DISK_RESET_FAILED                                                   syscall.Errno = 1128
-	ERROR_EOM_OVERFLOW                                                        syscall.Errno = 1129
-	ERROR_NOT_ENOUGH_SERVER_MEMORY                                            syscall.Errno = 1130
-	ERROR_POSSIBLE_DEADLOCK                                                   syscall.Errno = 1131
-	ERROR_MAPPED_ALIGNMENT                                                    syscall.Errno = 1132
-	ERROR_SET_POWER_STATE_VETOED                                              syscall.Errno = 1140
-	ERROR_SET_POWER_STATE_FAILED                                              syscall.Errno = 1141
-	ERROR_TOO_MANY_LINKS                                                      syscall.Errno = 1142
-	ERROR_OLD_WIN_VERSION                                                     syscall.Errno = 1150
-	ERROR_APP_WRONG_OS                                                        syscall.Errno = 1151
-	ERROR_SINGLE_INSTANCE_APP                                                 syscall.Errno = 1152
-	ERROR_RMODE_APP                                                           syscall.Errno = 1153
-	ERROR_INVALID_DLL                                                         syscall.Errno = 1154
-	ERROR_NO_ASSOCIATION                                                      syscall.Errno = 1155
-	ERROR_DDE_FAIL                                                            syscall.Errno = 1156
-	ERROR_DLL_NOT_FOUND                                                       syscall.Errno = 1157
-	ERROR_NO_MORE_USER_HANDLES                                                syscall.Errno = 1158
-	ERROR_MESSAGE_SYNC_ONLY                                                   syscall.Errno = 1159
-	ERROR_SOURCE_ELEMENT_EMPTY                                                syscall.Errno = 1160
-	ERROR_DESTINATION_ELEMENT_FULL                                            syscall.Errno = 1161
-	ERROR_ILLEGAL_ELEMENT_ADDRESS                                             syscall.Errno = 1162
-	ERROR_MAGAZINE_NOT_PRESENT                                                syscall.Errno = 1163
-	ERROR_DEVICE_REINITIALIZATION_NEEDED                                      syscall.Errno = 1164
-	ERROR_DEVICE_REQUIRES_CLEANING                                            syscall.Errno = 1165
-	ERROR_DEVICE_DOOR_OPEN                                                    syscall.Errno = 1166
-	ERROR_DEVICE_NOT_CONNECTED                                                syscall.Errno = 1167
-	ERROR_NOT_FOUND                                                           syscall.Errno = 1168
-	ERROR_NO_MATCH                                                            syscall.Errno = 1169
-	ERROR_SET_NOT_FOUND                                                       syscall.Errno = 1170
-	ERROR_POINT_NOT_FOUND                                                     syscall.Errno = 1171
-	ERROR_NO_TRACKING_SERVICE                                                 syscall.Errno = 1172
-	ERROR_NO_VOLUME_ID                                                        syscall.Errno = 1173
-	ERROR_UNABLE_TO_REMOVE_REPLACED                                           syscall.Errno = 1175
-	ERROR_UNABLE_TO_MOVE_REPLACEMENT                                          syscall.Errno = 1176
-	ERROR_UNABLE_TO_MOVE_REPLACEMENT_2                                        syscall.Errno = 1177
-	ERROR_JOURNAL_DELETE_IN_PROGRESS                                          syscall.Errno = 1178
-	ERROR_JOURNAL_NOT_ACTIVE                                                  syscall.Errno = 1179
-	ERROR_POTENTIAL_FILE_FOUND                                                syscall.Errno = 1180
-	ERROR_JOURNAL_ENTRY_DELETED                                               syscall.Errno = 1181
-	ERROR_SHUTDOWN_IS_SCHEDULED                                               syscall.Errno = 1190
-	ERROR_SHUTDOWN_USERS_LOGGED_ON                                            syscall.Errno = 1191
-	ERROR_BAD_DEVICE                                                          syscall.Errno = 1200
-	ERROR_CONNECTION_UNAVAIL                                                  syscall.Errno = 1201
-	ERROR_DEVICE_ALREADY_REMEMBERED                                           syscall.Errno = 1202
-	ERROR_NO_NET_OR_BAD_PATH                                                  syscall.Errno = 1203
-	ERROR_BAD_PROVIDER                                                        syscall.Errno = 1204
-	ERROR_CANNOT_OPEN_PROFILE                                                 syscall.Errno = 1205
-	ERROR_BAD_PROFILE                                                         syscall.Errno = 1206
-	ERROR_NOT_CONTAINER                                                       syscall.Errno = 1207
-	ERROR_EXTENDED_ERROR                                                      syscall.Errno = 1208
-	ERROR_INVALID_GROUPNAME                                                   syscall.Errno = 1209
-	ERROR_INVALID_COMPUTERNAME                                                syscall.Errno = 1210
-	ERROR_INVALID_EVENTNAME                                                   syscall.Errno = 1211
-	ERROR_INVALID_DOMAINNAME                                                  syscall.Errno = 1212
-	ERROR_INVALID_SERVICENAME                                                 syscall.Errno = 1213
-	ERROR_INVALID_NETNAME                                                     syscall.Errno = 1214
-	ERROR_INVALID_SHARENAME                                                   syscall.Errno = 1215
-	ERROR_INVALID_PASSWORDNAME                                                syscall.Errno = 1216
-	ERROR_INVALID_MESSAGENAME                                                 syscall.Errno = 1217
-	ERROR_INVALID_MESSAGEDEST                                                 syscall.Errno = 1218
-	ERROR_SESSION_CREDENTIAL_CONFLICT                                         syscall.Errno = 1219
-	ERROR_REMOTE_SESSION_LIMIT_EXCEEDED                                       syscall.Errno = 1220
-	ERROR_DUP_DOMAINNAME                                                      syscall.Errno = 1221
-	ERROR_NO_NETWORK                                                          syscall.Errno = 1222
-	ERROR_CANCELLED                                                           syscall.Errno = 1223
-	ERROR_USER_MAPPED_FILE                                                    syscall.Errno = 1224
-	ERROR_CONNECTION_REFUSED                                                  syscall.Errno = 1225
-	ERROR_GRACEFUL_DISCONNECT                                                 syscall.Errno = 1226
-	ERROR_ADDRESS_ALREADY_ASSOCIATED                                          syscall.Errno = 1227
-	ERROR_ADDRESS_NOT_ASSOCIATED                                              syscall.Errno = 1228
-	ERROR_CONNECTION_INVALID                                                  syscall.Errno = 1229
-	ERROR_CONNECTION_ACTIVE                                                   syscall.Errno = 1230
-	ERROR_NETWORK_UNREACHABLE                                                 syscall.Errno = 1231
-	ERROR_HOST_UNREACHABLE                                                    syscall.Errno = 1232
-	ERROR_PROTOCOL_UNREACHABLE                                                syscall.Errno = 1233
-	ERROR_PORT_UNREACHABLE                                                    syscall.Errno = 1234
-	ERROR_REQUEST_ABORTED                                                     syscall.Errno = 1235
-	ERROR_CONNECTION_ABORTED                                                  syscall.Errno = 1236
-	ERROR_RETRY                                                               syscall.Errno = 1237
-	ERROR_CONNECTION_COUNT_LIMIT                                              syscall.Errno = 1238
-	ERROR_LOGIN_TIME_RESTRICTION                                              syscall.Errno = 1239
-	ERROR_LOGIN_WKSTA_RESTRICTION                                             syscall.Errno = 1240
-	ERROR_INCORRECT_ADDRESS                                                   syscall.Errno = 1241
-	ERROR_ALREADY_REGISTERED                                                  syscall.Errno = 1242
-	ERROR_SERVICE_NOT_FOUND                                                   syscall.Errno = 1243
-	ERROR_NOT_AUTHENTICATED                                                   syscall.Errno = 1244
-	ERROR_NOT_LOGGED_ON                                                       syscall.Errno = 1245
-	ERROR_CONTINUE                                                            syscall.Errno = 1246
-	ERROR_ALREADY_INITIALIZED                                                 syscall.Errno = 1247
-	ERROR_NO_MORE_DEVICES                                                     syscall.Errno = 1248
-	ERROR_NO_SUCH_SITE                                                        syscall.Errno = 1249
-	ERROR_DOMAIN_CONTROLLER_EXISTS                                            syscall.Errno = 1250
-	ERROR_ONLY_IF_CONNECTED                                                   syscall.Errno = 1251
-	ERROR_OVERRIDE_NOCHANGES                                                  syscall.Errno = 1252
-	ERROR_BAD_USER_PROFILE                                                    syscall.Errno = 1253
-	ERROR_NOT_SUPPORTED_ON_SBS                                                syscall.Errno = 1254
-	ERROR_SERVER_SHUTDOWN_IN_PROGRESS                                         syscall.Errno = 1255
-	ERROR_HOST_DOWN                                                           syscall.Errno = 1256
-	ERROR_NON_ACCOUNT_SID                                                     syscall.Errno = 1257
-	ERROR_NON_DOMAIN_SID                                                      syscall.Errno = 1258
-	ERROR_APPHELP_BLOCK                                                       syscall.Errno = 1259
-	ERROR_ACCESS_DISABLED_BY_POLICY                                           syscall.Errno = 1260
-	ERROR_REG_NAT_CONSUMPTION                                                 syscall.Errno = 1261
-	ERROR_CSCSHARE_OFFLINE                                                    syscall.Errno = 1262
-	ERROR_PKINIT_FAILURE                                                      syscall.Errno = 1263
-	ERROR_SMARTCARD_SUBSYSTEM_FAILURE                                         syscall.Errno = 1264
-	ERROR_DOWNGRADE_DETECTED                                                  syscall.Errno = 1265
-	ERROR_MACHINE_LOCKED                                                      syscall.Errno = 1271
-	ERROR_SMB_GUEST_LOGON_BLOCKED                                             syscall.Errno = 1272
-	ERROR_CALLBACK_SUPPLIED_INVALID_DATA                                      syscall.Errno = 1273
-	ERROR_SYNC_FOREGROUND_REFRESH_REQUIRED                                    syscall.Errno = 1274
-	ERROR_DRIVER_BLOCKED                                                      syscall.Errno = 1275
-	ERROR_INVALID_IMPORT_OF_NON_DLL                                           syscall.Errno = 1276
-	ERROR_ACCESS_DISABLED_WEBBLADE                                            syscall.Errno = 1277
-	ERROR_ACCESS_DISABLED_WEBBLADE_TAMPER                                     syscall.Errno = 1278
-	ERROR_RECOVERY_FAILURE                                                    syscall.Errno = 1279
-	ERROR_ALREADY_FIBER                                                       syscall.Errno = 1280
-	ERROR_ALREADY_THREAD                                                      syscall.Errno = 1281
-	ERROR_STACK_BUFFER_OVERRUN                                                syscall.Errno = 1282
-	ERROR_PARAMETER_QUOTA_EXCEEDED                                            syscall.Errno = 1283
-	ERROR_DEBUGGER_INACTIVE                                                   syscall.Errno = 1284
-	ERROR_DELAY_LOAD_FAILED                                                   syscall.Errno = 1285
-	ERROR_VDM_DISALLOWED                                                      syscall.Errno = 1286
-	ERROR_UNIDENTIFIED_ERROR                                                  syscall.Errno = 1287
-	ERROR_INVALID_CRUNTIME_PARAMETER                                          syscall.Errno = 1288
-	ERROR_BEYOND_VDL                                                          syscall.Errno = 1289
-	ERROR_INCOMPATIBLE_SERVICE_SID_TYPE                                       syscall.Errno = 1290
-	ERROR_DRIVER_PROCESS_TERMINATED                                           syscall.Errno = 1291
-	ERROR_IMPLEMENTATION_LIMIT                                                syscall.Errno = 1292
-	ERROR_PROCESS_IS_PROTECTED                                                syscall.Errno = 1293
-	ERROR_SERVICE_NOTIFY_CLIENT_LAGGING                                       syscall.Errno = 1294
-	ERROR_DISK_QUOTA_EXCEEDED                                                 syscall.Errno = 1295
-	ERROR_CONTENT_BLOCKED                                                     syscall.Errno = 1296
-	ERROR_INCOMPATIBLE_SERVICE_PRIVILEGE                                      syscall.Errno = 1297
-	ERROR_APP_HANG                                                            syscall.Errno = 1298
-	ERROR_INVALID_LABEL                                                       syscall.Errno = 1299
-	ERROR_NOT_ALL_ASSIGNED                                                    syscall.Errno = 1300
-	ERROR_SOME_NOT_MAPPED                                                     syscall.Errno = 1301
-	ERROR_NO_QUOTAS_FOR_ACCOUNT                                               syscall.Errno = 1302
-	ERROR_LOCAL_USER_SESSION_KEY                                              syscall.Errno = 1303
-	ERROR_NULL_LM_PASSWORD                                                    syscall.Errno = 1304
-	ERROR_UNKNOWN_REVISION                                                    syscall.Errno = 1305
-	ERROR_REVISION_MISMATCH                                                   syscall.Errno = 1306
-	ERROR_INVALID_OWNER                                                       syscall.Errno = 1307
-	ERROR_INVALID_PRIMARY_GROUP                                               syscall.Errno = 1308
-	ERROR_NO_IMPERSONATION_TOKEN                                              syscall.Errno = 1309
-	ERROR_CANT_DISABLE_MANDATORY                                              syscall.Errno = 1310
-	ERROR_NO_LOGON_SERVERS                                                    syscall.Errno = 1311
-	ERROR_NO_SUCH_LOGON_SESSION                                               syscall.Errno = 1312
-	ERROR_NO_SUCH_PRIVILEGE                                                   syscall.Errno = 1313
-	ERROR_PRIVILEGE_NOT_HELD                                                  syscall.Errno = 1314
-	ERROR_INVALID_ACCOUNT_NAME                                                syscall.Errno = 1315
-	ERROR_USER_EXISTS                                                         syscall.Errno = 1316
-	ERROR_NO_SUCH_USER                                                        syscall.Errno = 1317
-	ERROR_GROUP_EXISTS                                                        syscall.Errno = 1318
-	ERROR_NO_SUCH_GROUP                                                       syscall.Errno = 1319
-	ERROR_MEMBER_IN_GROUP                                                     syscall.Errno = 1320
-	ERROR_MEMBER_NOT_IN_GROUP                                                 syscall.Errno = 1321
-	ERROR_LAST_ADMIN                                                          syscall.Errno = 1322
-	ERROR_WRONG_PASSWORD                                                      syscall.Errno = 1323
-	ERROR_ILL_FORMED_PASSWORD                                                 syscall.Errno = 1324
-	ERROR_PASSWORD_RESTRICTION                                                syscall.Errno = 1325
-	ERROR_LOGON_FAILURE                                                       syscall.Errno = 1326
-	ERROR_ACCOUNT_RESTRICTION                                                 syscall.Errno = 1327
-	ERROR_INVALID_LOGON_HOURS                                                 syscall.Errno = 1328
-	ERROR_INVALID_WORKSTATION                                                 syscall.Errno = 1329
-	ERROR_PASSWORD_EXPIRED                                                    syscall.Errno = 1330
-	ERROR_ACCOUNT_DISABLED                                                    syscall.Errno = 1331
-	ERROR_NONE_MAPPED                                                         syscall.Errno = 1332
-	ERROR_TOO_MANY_LUIDS_REQUESTED                                            syscall.Errno = 1333
-	ERROR_LUIDS_EXHAUSTED                                                     syscall.Errno = 1334
-	ERROR_INVALID_SUB_AUTHORITY                                               syscall.Errno = 1335
-	ERROR_INVALID_ACL                                                         syscall.Errno = 1336
-	ERROR_INVALID_SID                                                         syscall.Errno = 1337
-	ERROR_INVALID_SECURITY_DESCR                                              syscall.Errno = 1338
-	ERROR_BAD_INHERITANCE_ACL                                                 syscall.Errno = 1340
-	ERROR_SERVER_DISABLED                                                     syscall.Errno = 1341
-	ERROR_SERVER_NOT_DISABLED                                                 syscall.Errno = 1342
-	ERROR_INVALID_ID_AUTHORITY                                                syscall.Errno = 1343
-	ERROR_ALLOTTED_SPACE_EXCEEDED                                             syscall.Errno = 1344
-	ERROR_INVALID_GROUP_ATTRIBUTES                                            syscall.Errno = 1345
-	ERROR_BAD_IMPERSONATION_LEVEL                                             syscall.Errno = 1346
-	ERROR_CANT_OPEN_ANONYMOUS                                                 syscall.Errno = 1347
-	ERROR_BAD_VALIDATION_CLASS                                                syscall.Errno = 1348
-	ERROR_BAD_TOKEN_TYPE                                                      syscall.Errno = 1349
-	ERROR_NO_SECURITY_ON_OBJECT                                               syscall.Errno = 1350
-	ERROR_CANT_ACCESS_DOMAIN_INFO                                             syscall.Errno = 1351
-	ERROR_INVALID_SERVER_STATE                                                syscall.Errno = 1352
-	ERROR_INVALID_DOMAIN_STATE                                                syscall.Errno = 1353
-	ERROR_INVALID_DOMAIN_ROLE                                                 syscall.Errno = 1354
-	ERROR_NO_SUCH_DOMAIN                                                      syscall.Errno = 1355
-	ERROR_DOMAIN_EXISTS                                                       syscall.Errno = 1356
-	ERROR_DOMAIN_LIMIT_EXCEEDED                                               syscall.Errno = 1357
-	ERROR_INTERNAL_DB_CORRUPTION                                              syscall.Errno = 1358
-	ERROR_INTERNAL_ERROR                                                      syscall.Errno = 1359
-	ERROR_GENERIC_NOT_MAPPED                                                  syscall.Errno = 1360
-	ERROR_BAD_DESCRIPTOR_FORMAT                                               syscall.Errno = 1361
-	ERROR_NOT_LOGON_PROCESS                                                   syscall.Errno = 1362
-	ERROR_LOGON_SESSION_EXISTS                                                syscall.Errno = 1363
-	ERROR_NO_SUCH_PACKAGE                                                     syscall.Errno = 1364
-	ERROR_BAD_LOGON_SESSION_STATE                                             syscall.Errno = 1365
-	ERROR_LOGON_SESSION_COLLISION                                             syscall.Errno = 1366
-	ERROR_INVALID_LOGON_TYPE                                                  syscall.Errno = 1367
-	ERROR_CANNOT_IMPERSONATE                                                  syscall.Errno = 1368
-	ERROR_RXACT_INVALID_STATE                                                 syscall.Errno = 1369
-	ERROR_RXACT_COMMIT_FAILURE                                                syscall.Errno = 1370
-	ERROR_SPECIAL_ACCOUNT                                                     syscall.Errno = 1371
-	ERROR_SPECIAL_GROUP                                                       syscall.Errno = 1372
-	ERROR_SPECIAL_USER                                                        syscall.Errno = 1373
-	ERROR_MEMBERS_PRIMARY_GROUP                                               syscall.Errno = 1374
-	ERROR_TOKEN_ALREADY_IN_USE                                                syscall.Errno = 1375
-	ERROR_NO_SUCH_ALIAS                                                       syscall.Errno = 1376
-	ERROR_MEMBER_NOT_IN_ALIAS                                                 syscall.Errno = 1377
-	ERROR_MEMBER_IN_ALIAS                                                     syscall.Errno = 1378
-	ERROR_ALIAS_EXISTS                                                        syscall.Errno = 1379
-	ERROR_LOGON_NOT_GRANTED                                                   syscall.Errno = 1380
-	ERROR_TOO_MANY_SECRETS                                                    syscall.Errno = 1381
-	ERROR_SECRET_TOO_LONG                                                     syscall.Errno = 1382
-	ERROR_INTERNAL_DB_ERROR                                                   syscall.Errno = 1383
-	ERROR_TOO_MANY_CONTEXT_IDS                                                syscall.Errno = 1384
-	ERROR_LOGON_TYPE_NOT_GRANTED                                              syscall.Errno = 1385
-	ERROR_NT_CROSS_ENCRYPTION_REQUIRED                                        syscall.Errno = 1386
-	ERROR_NO_SUCH_MEMBER                                                      syscall.Errno = 1387
-	ERROR_INVALID_MEMBER                                                      syscall.Errno = 1388
-	ERROR_TOO_MANY_SIDS                                                       syscall.Errno = 1389
-	ERROR_LM_CROSS_ENCRYPTION_REQUIRED                                        syscall.Errno = 1390
-	ERROR_NO_INHERITANCE                                                      syscall.Errno = 1391
-	ERROR_FILE_CORRUPT                                                        syscall.Errno = 1392
-	ERROR_DISK_CORRUPT                                                        syscall.Errno = 1393
-	ERROR_NO_USER_SESSION_KEY                                                 syscall.Errno = 1394
-	ERROR_LICENSE_QUOTA_EXCEEDED                                              syscall.Errno = 1395
-	ERROR_WRONG_TARGET_NAME                                                   syscall.Errno = 1396
-	ERROR_MUTUAL_AUTH_FAILED                                                  syscall.Errno = 1397
-	ERROR_TIME_SKEW                                                           syscall.Errno = 1398
-	ERROR_CURRENT_DOMAIN_NOT_ALLOWED                                          syscall.Errno = 1399
-	ERROR_INVALID_WINDOW_HANDLE                                               syscall.Errno = 1400
-	ERROR_INVALID_MENU_HANDLE                                                 syscall.Errno = 1401
-	ERROR_INVALID_CURSOR_HANDLE                                               syscall.Errno = 1402
-	ERROR_INVALID_ACCEL_HANDLE                                                syscall.Errno = 1403
-	ERROR_INVALID_HOOK_HANDLE                                                 syscall.Errno = 1404
-	ERROR_INVALID_DWP_HANDLE                                                  syscall.Errno = 1405
-	ERROR_TLW_WITH_WSCHILD                                                    syscall.Errno = 1406
-	ERROR_CANNOT_FIND_WND_CLASS                                               syscall.Errno = 1407
-	ERROR_WINDOW_OF_OTHER_THREAD                                              syscall.Errno = 1408
-	ERROR_HOTKEY_ALREADY_REGISTERED                                           syscall.Errno = 1409
-	ERROR_CLASS_ALREADY_EXISTS                                                syscall.Errno = 1410
-	ERROR_CLASS_DOES_NOT_EXIST                                                syscall.Errno = 1411
-	ERROR_CLASS_HAS_WINDOWS                                                   syscall.Errno = 1412
-	ERROR_INVALID_INDEX                                                       syscall.Errno = 1413
-	ERROR_INVALID_ICON_HANDLE                                                 syscall.Errno = 1414
-	ERROR_PRIVATE_DIALOG_INDEX                                                syscall.Errno = 1415
-	ERROR_LISTBOX_ID_NOT_FOUND                                                syscall.Errno = 1416
-	ERROR_NO_WILDCARD_CHARACTERS                                              syscall.Errno = 1417
-	ERROR_CLIPBOARD_NOT_OPEN                                                  syscall.Errno = 1418
-	ERROR_HOTKEY_NOT_REGISTERED                                               syscall.Errno = 1419
-	ERROR_WINDOW_NOT_DIALOG                                                   syscall.Errno = 1420
-	ERROR_CONTROL_ID_NOT_FOUND                                                syscall.Errno = 1421
-	ERROR_INVALID_COMBOBOX_MESSAGE                                            syscall.Errno = 1422
-	ERROR_WINDOW_NOT_COMBOBOX                                                 syscall.Errno = 1423
-	ERROR_INVALID_EDIT_HEIGHT                                                 syscall.Errno = 1424
-	ERROR_DC_NOT_FOUND                                                        syscall.Errno = 1425
-	ERROR_INVALID_HOOK_FILTER                                                 syscall.Errno = 1426
-	ERROR_INVALID_FILTER_PROC                                                 syscall.Errno = 1427
-	ERROR_HOOK_NEEDS_HMOD                                                     syscall.Errno = 1428
-	ERROR_GLOBAL_ONLY_HOOK                                                    syscall.Errno = 1429
-	ERROR_JOURNAL_HOOK_SET                                                    syscall.Errno = 1430
-	ERROR_HOOK_NOT_INSTALLED                                                  syscall.Errno = 1431
-	ERROR_INVALID_LB_MESSAGE                                                  syscall.Errno = 1432
-	ERROR_SETCOUNT_ON_BAD_LB                                                  syscall.Errno = 1433
-	ERROR_LB_WITHOUT_TABSTOPS                                                 syscall.Errno = 1434
-	ERROR_DESTROY_OBJECT_OF_OTHER_THREAD                                      syscall.Errno = 1435
-	ERROR_CHILD_WINDOW_MENU                                                   syscall.Errno = 1436
-	ERROR_NO_SYSTEM_MENU                                                      syscall.Errno = 1437
-	ERROR_INVALID_MSGBOX_STYLE                                                syscall.Errno = 1438
-	ERROR_INVALID_SPI_VALUE                                                   syscall.Errno = 1439
-	ERROR_SCREEN_ALREADY_LOCKED                                               syscall.Errno = 1440
-	ERROR_HWNDS_HAVE_DIFF_PARENT                                              syscall.Errno = 1441
-	ERROR_NOT_CHILD_WINDOW                                                    syscall.Errno = 1442
-	ERROR_INVALID_GW_COMMAND                                                  syscall.Errno = 1443
-	ERROR_INVALID_THREAD_ID                                                   syscall.Errno = 1444
-	ERROR_NON_MDICHILD_WINDOW                                                 syscall.Errno = 1445
-	ERROR_POPUP_ALREADY_ACTIVE                                                syscall.Errno = 1446
-	ERROR_NO_SCROLLBARS                                                       syscall.Errno = 1447
-	ERROR_INVALID_SCROLLBAR_RANGE                                             syscall.Errno = 1448
-	ERROR_INVALID_SHOWWIN_COMMAND                                             syscall.Errno = 1449
-	ERROR_NO_SYSTEM_RESOURCES                                                 syscall.Errno = 1450
-	ERROR_NONPAGED_SYSTEM_RESOURCES                                           syscall.Errno = 1451
-	ERROR_PAGED_SYSTEM_RESOURCES                                              syscall.Errno = 1452
-	ERROR_WORKING_SET_QUOTA                                                   syscall.Errno = 1453
-	ERROR_PAGEFILE_QUOTA                                                      syscall.Errno = 1454
-	ERROR_COMMITMENT_LIMIT                                                    syscall.Errno = 1455
-	ERROR_MENU_ITEM_NOT_FOUND                                                 syscall.Errno = 1456
-	ERROR_INVALID_KEYBOARD_HANDLE                                             syscall.Errno = 1457
-	ERROR_HOOK_TYPE_NOT_ALLOWED                                               syscall.Errno = 1458
-	ERROR_REQUIRES_INTERACTIVE_WINDOWSTATION                                  syscall.Errno = 1459
-	ERROR_TIMEOUT                                                             syscall.Errno = 1460
-	ERROR_INVALID_MONITOR_HANDLE                                              syscall.Errno = 1461
-	ERROR_INCORRECT_SIZE                                                      syscall.Errno = 1462
-	ERROR_SYMLINK_CLASS_DISABLED                                              syscall.Errno = 1463
-	ERROR_SYMLINK_NOT_SUPPORTED                                               syscall.Errno = 1464
-	ERROR_XML_PARSE_ERROR                                                     syscall.Errno = 1465
-	ERROR_XMLDSIG_ERROR                                                       syscall.Errno = 1466
-	ERROR_RESTART_APPLICATION                                                 syscall.Errno = 1467
-	ERROR_WRONG_COMPARTMENT                                                   syscall.Errno = 1468
-	ERROR_AUTHIP_FAILURE                                                      syscall.Errno = 1469
-	ERROR_NO_NVRAM_RESOURCES                                                  syscall.Errno = 1470
-	ERROR_NOT_GUI_PROCESS                                                     syscall.Errno = 1471
-	ERROR_EVENTLOG_FILE_CORRUPT                                               syscall.Errno = 1500
-	ERROR_EVENTLOG_CANT_START                                                 syscall.Errno = 1501
-	ERROR_LOG_FILE_FULL                                                       syscall.Errno = 1502
-	ERROR_EVENTLOG_FILE_CHANGED                                               syscall.Errno = 1503
-	ERROR_CONTAINER_ASSIGNED                                                  syscall.Errno = 1504
-	ERROR_JOB_NO_CONTAINER                                                    syscall.Errno = 1505
-	ERROR_INVALID_TASK_NAME                                                   syscall.Errno = 1550
-	ERROR_INVALID_TASK_INDEX                                                  syscall.Errno = 1551
-	ERROR_THREAD_ALREADY_IN_TASK                                              syscall.Errno = 1552
-	ERROR_INSTALL_SERVICE_FAILURE                                             syscall.Errno = 1601
-	ERROR_INSTALL_USEREXIT                                                    syscall.Errno = 1602
-	ERROR_INSTALL_FAILURE                                                     syscall.Errno = 1603
-	ERROR_INSTALL_SUSPEND                                                     syscall.Errno = 1604
-	ERROR_UNKNOWN_PRODUCT                                                     syscall.Errno = 1605
-	ERROR_UNKNOWN_FEATURE                                                     syscall.Errno = 1606
-	ERROR_UNKNOWN_COMPONENT                                                   syscall.Errno = 1607
-	ERROR_UNKNOWN_PROPERTY                                                    syscall.Errno = 1608
-	ERROR_INVALID_HANDLE_STATE                                                syscall.Errno = 1609
-	ERROR_BAD_CONFIGURATION                                                   syscall.Errno = 1610
-	ERROR_INDEX_ABSENT                                                        syscall.Errno = 1611
-	ERROR_INSTALL_SOURCE_ABSENT                                               syscall.Errno = 1612
-	ERROR_INSTALL_PACKAGE_VERSION                                             syscall.Errno = 1613
-	ERROR_PRODUCT_UNINSTALLED                                                 syscall.Errno = 1614
-	ERROR_BAD_QUERY_SYNTAX                                                    syscall.Errno = 1615
-	ERROR_INVALID_FIELD                                                       syscall.Errno = 1616
-	ERROR_DEVICE_REMOVED                                                      syscall.Errno = 1617
-	ERROR_INSTALL_ALREADY_RUNNING                                             syscall.Errno = 1618
-	ERROR_INSTALL_PACKAGE_OPEN_FAILED                                         syscall.Errno = 1619
-	ERROR_INSTALL_PACKAGE_INVALID                                             syscall.Errno = 1620
-	ERROR_INSTALL_UI_FAILURE                                                  syscall.Errno = 1621
-	ERROR_INSTALL_LOG_FAILURE                                                 syscall.Errno = 1622
-	ERROR_INSTALL_LANGUAGE_UNSUPPORTED                                        syscall.Errno = 1623
-	ERROR_INSTALL_TRANSFORM_FAILURE                                           syscall.Errno = 1624
-	ERROR_INSTALL_PACKAGE_REJECTED                                            syscall.Errno = 1625
-	ERROR_FUNCTION_NOT_CALLED                                                 syscall.Errno = 1626
-	ERROR_FUNCTION_FAILED                                                     syscall.Errno = 1627
-	ERROR_INVALID_TABLE                                                       syscall.Errno = 1628
-	ERROR_DATATYPE_MISMATCH                                                   syscall.Errno = 1629
-	ERROR_UNSUPPORTED_TYPE                                                    syscall.Errno = 1630
-	ERROR_CREATE_FAILED                                                       syscall.Errno = 1631
-	ERROR_INSTALL_TEMP_UNWRITABLE                                             syscall.Errno = 1632
-	ERROR_INSTALL_PLATFORM_UNSUPPORTED                                        syscall.Errno = 1633
-	ERROR_INSTALL_NOTUSED                                                     syscall.Errno = 1634
-	ERROR_PATCH_PACKAGE_OPEN_FAILED                                           syscall.Errno = 1635
-	ERROR_PATCH_PACKAGE_INVALID                                               syscall.Errno = 1636
-	ERROR_PATCH_PACKAGE_UNSUPPORTED                                           syscall.Errno = 1637
-	ERROR_PRODUCT_VERSION                                                     syscall.Errno = 1638
-	ERROR_INVALID_COMMAND_LINE                                                syscall.Errno = 1639
-	ERROR_INSTALL_REMOTE_DISALLOWED                                           syscall.Errno = 1640
-	ERROR_SUCCESS_REBOOT_INITIATED                                            syscall.Errno = 1641
-	ERROR_PATCH_TARGET_NOT_FOUND                                              syscall.Errno = 1642
-	ERROR_PATCH_PACKAGE_REJECTED                                              syscall.Errno = 1643
-	ERROR_INSTALL_TRANSFORM_REJECTED                                          syscall.Errno = 1644
-	ERROR_INSTALL_REMOTE_PROHIBITED                                           syscall.Errno = 1645
-	ERROR_PATCH_REMOVAL_UNSUPPORTED                                           syscall.Errno = 1646
-	ERROR_UNKNOWN_PATCH                                                       syscall.Errno = 1647
-	ERROR_PATCH_NO_SEQUENCE                                                   syscall.Errno = 1648
-	ERROR_PATCH_REMOVAL_DISALLOWED                                            syscall.Errno = 1649
-	ERROR_INVALID_PATCH_XML                                                   syscall.Errno = 1650
-	ERROR_PATCH_MANAGED_ADVERTISED_PRODUCT                                    syscall.Errno = 1651
-	ERROR_INSTALL_SERVICE_SAFEBOOT                                            syscall.Errno = 1652
-	ERROR_FAIL_FAST_EXCEPTION                                                 syscall.Errno = 1653
-	ERROR_INSTALL_REJECTED                                                    syscall.Errno = 1654
-	ERROR_DYNAMIC_CODE_BLOCKED                                                syscall.Errno = 1655
-	ERROR_NOT_SAME_OBJECT                                                     syscall.Errno = 1656
-	ERROR_STRICT_CFG_VIOLATION                                                syscall.Errno = 1657
-	ERROR_SET_CONTEXT_DENIED                                                  syscall.Errno = 1660
-	ERROR_CROSS_PARTITION_VIOLATION                                           syscall.Errno = 1661
-	RPC_S_INVALID_STRING_BINDING                                              syscall.Errno = 1700
-	RPC_S_WRONG_KIND_OF_BINDING                                               syscall.Errno = 1701
-	RPC_S_INVALID_BINDING                                                     syscall.Errno = 1702
-	RPC_S_PROTSEQ_NOT_SUPPORTED                                               syscall.Errno = 1703
-	RPC_S_INVALID_RPC_PROTSEQ                                                 syscall.Errno = 1704
-	RPC_S_INVALID_STRING_UUID                                                 syscall.Errno = 1705
-	RPC_S_INVALID_ENDPOINT_FORMAT                                             syscall.Errno = 1706
-	RPC_S_INVALID_NET_ADDR                                                    syscall.Errno = 1707
-	RPC_S_NO_ENDPOINT_FOUND                                                   syscall.Errno = 1708
-	RPC_S_INVALID_TIMEOUT                                                     syscall.Errno = 1709
-	RPC_S_OBJECT_NOT_FOUND                                                    syscall.Errno = 1710
-	RPC_S_ALREADY_REGISTERED                                                  syscall.Errno = 1711
-	RPC_S_TYPE_ALREADY_REGISTERED                                             syscall.Errno = 1712
-	RPC_S_ALREADY_LISTENING                                                   syscall.Errno = 1713
-	RPC_S_NO_PROTSEQS_REGISTERED                                              syscall.Errno = 1714
-	RPC_S_NOT_LISTENING                                                       syscall.Errno = 1715
-	RPC_S_UNKNOWN_MGR_TYPE                                                    syscall.Errno = 1716
-	RPC_S_UNKNOWN_IF                                                          syscall.Errno = 1717
-	RPC_S_NO_BINDINGS                                                         syscall.Errno = 1718
-	RPC_S_NO_PROTSEQS                                                         syscall.Errno = 1719
-	RPC_S_CANT_CREATE_ENDPOINT                                                syscall.Errno = 1720
-	RPC_S_OUT_OF_RESOURCES                                                    syscall.Errno = 1721
-	RPC_S_SERVER_UNAVAILABLE                                                  syscall.Errno = 1722
-	RPC_S_SERVER_TOO_BUSY                                                     syscall.Errno = 1723
-	RPC_S_INVALID_NETWORK_OPTIONS                                             syscall.Errno = 1724
-	RPC_S_NO_CALL_ACTIVE                                                      syscall.Errno = 1725
-	RPC_S_CALL_FAILED                                                         syscall.Errno = 1726
-	RPC_S_CALL_FAILED_DNE                                                     syscall.Errno = 1727
-	RPC_S_PROTOCOL_ERROR                                                      syscall.Errno = 1728
-	RPC_S_PROXY_ACCESS_DENIED                                                 syscall.Errno = 1729
-	RPC_S_UNSUPPORTED_TRANS_SYN                                               syscall.Errno = 1730
-	RPC_S_UNSUPPORTED_TYPE                                                    syscall.Errno = 1732
-	RPC_S_INVALID_TAG                                                         syscall.Errno = 1733
-	RPC_S_INVALID_BOUND                                                       syscall.Errno = 1734
-	RPC_S_NO_ENTRY_NAME                                                       syscall.Errno = 1735
-	RPC_S_INVALID_NAME_SYNTAX                                                 syscall.Errno = 1736
-	RPC_S_UNSUPPORTED_NAME_SYNTAX                                             syscall.Errno = 1737
-	RPC_S_UUID_NO_ADDRESS                                                     syscall.Errno = 1739
-	RPC_S_DUPLICATE_ENDPOINT                                                  syscall.Errno = 1740
-	RPC_S_UNKNOWN_AUTHN_TYPE                                                  syscall.Errno = 1741
-	RPC_S_MAX_CALLS_TOO_SMALL                                                 syscall.Errno = 1742
-	RPC_S_STRING_TOO_LONG                                                     syscall.Errno = 1743
-	RPC_S_PROTSEQ_NOT_FOUND                                                   syscall.Errno = 1744
-	RPC_S_PROCNUM_OUT_OF_RANGE                                                syscall.Errno = 1745
-	RPC_S_BINDING_HAS_NO_AUTH                                                 syscall.Errno = 1746
-	RPC_S_UNKNOWN_AUTHN_SERVICE                                               syscall.Errno = 1747
-	RPC_S_UNKNOWN_AUTHN_LEVEL                                                 syscall.Errno = 1748
-	RPC_S_INVALID_AUTH_IDENTITY                                               syscall.Errno = 1749
-	RPC_S_UNKNOWN_AUTHZ_SERVICE                                               syscall.Errno = 1750
-	EPT_S_INVALID_ENTRY                                                       syscall.Errno = 1751
-	EPT_S_CANT_PERFORM_OP                                                     syscall.Errno = 1752
-	EPT_S_NOT_REGISTERED                                                      syscall.Errno = 1753
-	RPC_S_NOTHING_TO_EXPORT                                                   syscall.Errno = 1754
-	RPC_S_INCOMPLETE_NAME                                                     syscall.Errno = 1755
-	RPC_S_INVALID_VERS_OPTION                                                 syscall.Errno = 1756
-	RPC_S_NO_MORE_MEMBERS                                                     syscall.Errno = 1757
-	RPC_S_NOT_ALL_OBJS_UNEXPORTED                                             syscall.Errno = 1758
-	RPC_S_INTERFACE_NOT_FOUND                                                 syscall.Errno = 1759
-	RPC_S_ENTRY_ALREADY_EXISTS                                                syscall.Errno = 1760
-	RPC_S_ENTRY_NOT_FOUND                                                     syscall.Errno = 1761
-	RPC_S_NAME_SERVICE_UNAVAILABLE                                            syscall.Errno = 1762
-	RPC_S_INVALID_NAF_ID                                                      syscall.Errno = 1763
-	RPC_S_CANNOT_SUPPORT                                                      syscall.Errno = 1764
-	RPC_S_NO_CONTEXT_AVAILABLE                                                syscall.Errno = 1765
-	RPC_S_INTERNAL_ERROR                                                      syscall.Errno = 1766
-	RPC_S_ZERO_DIVIDE                                                         syscall.Errno = 1767
-	RPC_S_ADDRESS_ERROR                                                       syscall.Errno = 1768
-	RPC_S_FP_DIV_ZERO                                                         syscall.Errno = 1769
-	RPC_S_FP_UNDERFLOW                                                        syscall.Errno = 1770
-	RPC_S_FP_OVERFLOW                                                         syscall.Errno = 1771
-	RPC_X_NO_MORE_ENTRIES                                                     syscall.Errno = 1772
-	RPC_X_SS_CHAR_TRANS_OPEN_FAIL                                             syscall.Errno = 1773
-	RPC_X_SS_CHAR_TRANS_SHORT_FILE                                            syscall.Errno = 1774
-	RPC_X_SS_IN_NULL_CONTEXT                                                  syscall.Errno = 1775
-	RPC_X_SS_CONTEXT_DAMAGED                                                  syscall.Errno = 1777
-	RPC_X_SS_HANDLES_MISMATCH                                                 syscall.Errno = 1778
-	RPC_X_SS_CANNOT_GET_CALL_HANDLE                                           syscall.Errno = 1779
-	RPC_X_NULL_REF_POINTER                                                    syscall.Errno = 1780
-	RPC_X_ENUM_VALUE_OUT_OF_RANGE                                             syscall.Errno = 1781
-	RPC_X_BYTE_COUNT_TOO_SMALL                                                syscall.Errno = 1782
-	RPC_X_BAD_STUB_DATA                                                       syscall.Errno = 1783
-	ERROR_INVALID_USER_BUFFER                                                 syscall.Errno = 1784
-	ERROR_UNRECOGNIZED_MEDIA                                                  syscall.Errno = 1785
-	ERROR_NO_TRUST_LSA_SECRET                                                 syscall.Errno = 1786
-	ERROR_NO_TRUST_SAM_ACCOUNT                                                syscall.Errno = 1787
-	ERROR_TRUSTED_DOMAIN_FAILURE                                              syscall.Errno = 1788
-	ERROR_TRUSTED_RELATIONSHIP_FAILURE                                        syscall.Errno = 1789
-	ERROR_TRUST_FAILURE                                                       syscall.Errno = 1790
-	RPC_S_CALL_IN_PROGRESS                                                    syscall.Errno = 1791
-	ERROR_NETLOGON_NOT_STARTED                                                syscall.Errno = 1792
-	ERROR_ACCOUNT_EXPIRED                                                     syscall.Errno = 1793
-	ERROR_REDIRECTOR_HAS_OPEN_HANDLES                                         syscall.Errno = 1794
-	ERROR_PRINTER_DRIVER_ALREADY_INSTALLED                                    syscall.Errno = 1795
-	ERROR_UNKNOWN_PORT                                                        syscall.Errno = 1796
-	ERROR_UNKNOWN_PRINTER_DRIVER                                              syscall.Errno = 1797
-	ERROR_UNKNOWN_PRINTPROCESSOR                                              syscall.Errno = 1798
-	ERROR_INVALID_SEPARATOR_FILE                                              syscall.Errno = 1799
-	ERROR_INVALID_PRIORITY                                                    syscall.Errno = 1800
-	ERROR_INVALID_PRINTER_NAME                                                syscall.Errno = 1801
-	ERROR_PRINTER_ALREADY_EXISTS                                              syscall.Errno = 1802
-	ERROR_INVALID_PRINTER_COMMAND                                             syscall.Errno = 1803
-	ERROR_INVALID_DATATYPE                                                    syscall.Errno = 1804
-	ERROR_INVALID_ENVIRONMENT                                                 syscall.Errno = 1805
-	RPC_S_NO_MORE_BINDINGS                                                    syscall.Errno = 1806
-	ERROR_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT                                   syscall.Errno = 1807
-	ERROR_NOLOGON_WORKSTATION_TRUST_ACCOUNT                                   syscall.Errno = 1808
-	ERROR_NOLOGON_SERVER_TRUST_ACCOUNT                                        syscall.Errno = 1809
-	ERROR_DOMAIN_TRUST_INCONSISTENT                                           syscall.Errno = 1810
-	ERROR_SERVER_HAS_OPEN_HANDLES                                             syscall.Errno = 1811
-	ERROR_RESOURCE_DATA_NOT_FOUND                                             syscall.Errno = 1812
-	ERROR_RESOURCE_TYPE_NOT_FOUND                                             syscall.Errno = 1813
-	ERROR_RESOURCE_NAME_NOT_FOUND                                             syscall.Errno = 1814
-	ERROR_RESOURCE_LANG_NOT_FOUND                                             syscall.Errno = 1815
-	ERROR_NOT_ENOUGH_QUOTA                                                    syscall.Errno = 1816
-	RPC_S_NO_INTERFACES                                                       syscall.Errno = 1817
-	RPC_S_CALL_CANCELLED                                                      syscall.Errno = 1818
-	RPC_S_BINDING_INCOMPLETE                                                  syscall.Errno = 1819
-	RPC_S_COMM_FAILURE                                                        syscall.Errno = 1820
-	RPC_S_UNSUPPORTED_AUTHN_LEVEL                                             syscall.Errno = 1821
-	RPC_S_NO_PRINC_NAME                                                       syscall.Errno = 1822
-	RPC_S_NOT_RPC_ERROR                                                       syscall.Errno = 1823
-	RPC_S_UUID_LOCAL_ONLY                                                     syscall.Errno = 1824
-	RPC_S_SEC_PKG_ERROR                                                       syscall.Errno = 1825
-	RPC_S_NOT_CANCELLED                                                       syscall.Errno = 1826
-	RPC_X_INVALID_ES_ACTION                                                   syscall.Errno = 1827
-	RPC_X_WRONG_ES_VERSION                                                    syscall.Errno = 1828
-	RPC_X_WRONG_STUB_VERSION                                                  syscall.Errno = 1829
-	RPC_X_INVALID_PIPE_OBJECT                                                 syscall.Errno = 1830
-	RPC_X_WRONG_PIPE_ORDER                                                    syscall.Errno = 1831
-	RPC_X_WRONG_PIPE_VERSION                                                  syscall.Errno = 1832
-	RPC_S_COOKIE_AUTH_FAILED                                                  syscall.Errno = 1833
-	RPC_S_DO_NOT_DISTURB                                                      syscall.Errno = 1834
-	RPC_S_SYSTEM_HANDLE_COUNT_EXCEEDED                                        syscall.Errno = 1835
-	RPC_S_SYSTEM_HANDLE_TYPE_MISMATCH                                         syscall.Errno = 1836
-	RPC_S_GROUP_MEMBER_NOT_FOUND                                              syscall.Errno = 1898
-	EPT_S_CANT_CREATE                                                         syscall.Errno = 1899
-	RPC_S_INVALID_OBJECT                                                      syscall.Errno = 1900
-	ERROR_INVALID_TIME                                                        syscall.Errno = 1901
-	ERROR_INVALID_FORM_NAME                                                   syscall.Errno = 1902
-	ERROR_INVALID_FORM_SIZE                                                   syscall.Errno = 1903
-	ERROR_ALREADY_WAITING                                                     syscall.Errno = 1904
-	ERROR_PRINTER_DELETED                                                     syscall.Errno = 1905
-	ERROR_INVALID_PRINTER_STATE                                               syscall.Errno = 1906
-	ERROR_PASSWORD_MUST_CHANGE                                                syscall.Errno = 1907
-	ERROR_DOMAIN_CONTROLLER_NOT_FOUND                                         syscall.Errno = 1908
-	ERROR_ACCOUNT_LOCKED_OUT                                                  syscall.Errno = 1909
-	OR_INVALID_OXID                                                           syscall.Errno = 1910
-	OR_INVALID_OID                                                            syscall.Errno = 1911
-	OR_INVALID_SET                                                            syscall.Errno = 1912
-	RPC_S_SEND_INCOMPLETE                                                     syscall.Errno = 1913
-	RPC_S_INVALID_ASYNC_HANDLE                                                syscall.Errno = 1914
-	RPC_S_INVALID_ASYNC_CALL                                                  syscall.Errno = 1915
-	RPC_X_PIPE_CLOSED                                                         syscall.Errno = 1916
-	RPC_X_PIPE_DISCIPLINE_ERROR                                               syscall.Errno = 1917
-	RPC_X_PIPE_EMPTY                                                          syscall.Errno = 1918
-	ERROR_NO_SITENAME                                                         syscall.Errno = 1919
-	ERROR_CANT_ACCESS_FILE                                                    syscall.Errno = 1920
-	ERROR_CANT_RESOLVE_FILENAME                                               syscall.Errno = 1921
-	RPC_S_ENTRY_TYPE_MISMATCH                                                 syscall.Errno = 1922
-	RPC_S_NOT_ALL_OBJS_EXPORTED                                               syscall.Errno = 1923
-	RPC_S_INTERFACE_NOT_EXPORTED                                              syscall.Errno = 1924
-	RPC_S_PROFILE_NOT_ADDED                                                   syscall.Errno = 1925
-	RPC_S_PRF_ELT_NOT_ADDED                                                   syscall.Errno = 1926
-	RPC_S_PRF_ELT_NOT_REMOVED                                                 syscall.Errno = 1927
-	RPC_S_GRP_ELT_NOT_ADDED                                                   syscall.Errno = 1928
-	RPC_S_GRP_ELT_NOT_REMOVED                                                 syscall.Errno = 1929
-	ERROR_KM_DRIVER_BLOCKED                                                   syscall.Errno = 1930
-	ERROR_CONTEXT_EXPIRED                                                     syscall.Errno = 1931
-	ERROR_PER_USER_TRUST_QUOTA_EXCEEDED                                       syscall.Errno = 1932
-	ERROR_ALL_USER_TRUST_QUOTA_EXCEEDED                                       syscall.Errno = 1933
-	ERROR_USER_DELETE_TRUST_QUOTA_EXCEEDED                                    syscall.Errno = 1934
-	ERROR_AUTHENTICATION_FIREWALL_FAILED                                      syscall.Errno = 1935
-	ERROR_REMOTE_PRINT_CONNECTIONS_BLOCKED                                    syscall.Errno = 1936
-	ERROR_NTLM_BLOCKED                                                        syscall.Errno = 1937
-	ERROR_PASSWORD_CHANGE_REQUIRED                                            syscall.Errno = 1938
-	ERROR_LOST_MODE_LOGON_RESTRICTION                                         syscall.Errno = 1939
-	ERROR_INVALID_PIXEL_FORMAT                                                syscall.Errno = 2000
-	ERROR_BAD_DRIVER                                                          syscall.Errno = 2001
-	ERROR_INVALID_WINDOW_STYLE                                                syscall.Errno = 2002
-	ERROR_METAFILE_NOT_SUPPORTED                                              syscall.Errno = 2003
-	ERROR_TRANSFORM_NOT_SUPPORTED                                             syscall.Errno = 2004
-	ERROR_CLIPPING_NOT_SUPPORTED                                              syscall.Errno = 2005
-	ERROR_INVALID_CMM                                                         syscall.Errno = 2010
-	ERROR_INVALID_PROFILE                                                     syscall.Errno = 2011
-	ERROR_TAG_NOT_FOUND                                                       syscall.Errno = 2012
-	ERROR_TAG_NOT_PRESENT                                                     syscall.Errno = 2013
-	ERROR_DUPLICATE_TAG                                                       syscall.Errno = 2014
-	ERROR_PROFILE_NOT_ASSOCIATED_WITH_DEVICE                                  syscall.Errno = 2015
-	ERROR_PROFILE_NOT_FOUND                                                   syscall.Errno = 2016
-	ERROR_INVALID_COLORSPACE                                                  syscall.Errno = 2017
-	ERROR_ICM_NOT_ENABLED                                                     syscall.Errno = 2018
-	ERROR_DELETING_ICM_XFORM                                                  syscall.Errno = 2019
-	ERROR_INVALID_TRANSFORM                                                   syscall.Errno = 2020
-	ERROR_COLORSPACE_MISMATCH                                                 syscall.Errno = 2021
-	ERROR_INVALID_COLORINDEX                                                  syscall.Errno = 2022
-	ERROR_PROFILE_DOES_NOT_MATCH_DEVICE                                       syscall.Errno = 2023
-	ERROR_CONNECTED_OTHER_PASSWORD                                            syscall.Errno = 2108
-	ERROR_CONNECTED_OTHER_PASSWORD_DEFAULT                                    syscall.Errno = 2109
-	ERROR_BAD_USERNAME                                                        syscall.Errno = 2202
-	ERROR_NOT_CONNECTED                                                       syscall.Errno = 2250
-	ERROR_OPEN_FILES                                                          syscall.Errno = 2401
-	ERROR_ACTIVE_CONNECTIONS                                                  syscall.Errno = 2402
-	ERROR_DEVICE_IN_USE                                                       syscall.Errno = 2404
-	ERROR_UNKNOWN_PRINT_MONITOR                                               syscall.Errno = 3000
-	ERROR_PRINTER_DRIVER_IN_USE                                               syscall.Errno = 3001
-	ERROR_SPOOL_FILE_NOT_FOUND                                                syscall.Errno = 3002
-	ERROR_SPL_NO_STARTDOC                                                     syscall.Errno = 3003
-	ERROR_SPL_NO_ADDJOB                                                       syscall.Errno = 3004
-	ERROR_PRINT_PROCESSOR_ALREADY_INSTALLED                                   syscall.Errno = 3005
-	ERROR_PRINT_MONITOR_ALREADY_INSTALLED                                     syscall.Errno = 3006
-	ERROR_INVALID_PRINT_MONITOR                                               syscall.Errno = 3007
-	ERROR_PRINT_MONITOR_IN_USE                                                syscall.Errno = 3008
-	ERROR_PRINTER_HAS_JOBS_QUEUED                                             syscall.Errno = 3009
-	ERROR_SUCCESS_REBOOT_REQUIRED                                             syscall.Errno = 3010
-	ERROR_SUCCESS_RESTART_REQUIRED                                            syscall.Errno = 3011
-	ERROR_PRINTER_NOT_FOUND                                                   syscall.Errno = 3012
-	ERROR_PRINTER_DRIVER_WARNED                                               syscall.Errno = 3013
-	ERROR_PRINTER_DRIVER_BLOCKED                                              syscall.Errno = 3014
-	ERROR_PRINTER_DRIVER_PACKAGE_IN_USE                                       syscall.Errno = 3015
-	ERROR_CORE_DRIVER_PACKAGE_NOT_FOUND                                       syscall.Errno = 3016
-	ERROR_FAIL_REBOOT_REQUIRED                                                syscall.Errno = 3017
-	ERROR_FAIL_REBOOT_INITIATED                                               syscall.Errno = 3018
-	ERROR_PRINTER_DRIVER_DOWNLOAD_NEEDED                                      syscall.Errno = 3019
-	ERROR_PRINT_JOB_RESTART_REQUIRED                                          syscall.Errno = 3020
-	ERROR_INVALID_PRINTER_DRIVER_MANIFEST                                     syscall.Errno = 3021
-	ERROR_PRINTER_NOT_SHAREABLE                                               syscall.Errno = 3022
-	ERROR_REQUEST_PAUSED                                                      syscall.Errno = 3050
-	ERROR_APPEXEC_CONDITION_NOT_SATISFIED                                     syscall.Errno = 3060
-	ERROR_APPEXEC_HANDLE_INVALIDATED                                          syscall.Errno = 3061
-	ERROR_APPEXEC_INVALID_HOST_GENERATION                                     syscall.Errno = 3062
-	ERROR_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION                             syscall.Errno = 3063
-	ERROR_APPEXEC_INVALID_HOST_STATE                                          syscall.Errno = 3064
-	ERROR_APPEXEC_NO_DONOR                                                    syscall.Errno = 3065
-	ERROR_APPEXEC_HOST_ID_MISMATCH                                            syscall.Errno = 3066
-	ERROR_APPEXEC_UNKNOWN_USER                                                syscall.Errno = 3067
-	ERROR_IO_REISSUE_AS_CACHED                                                syscall.Errno = 3950
-	ERROR_WINS_INTERNAL                                                       syscall.Errno = 4000
-	ERROR_CAN_NOT_DEL_LOCAL_WINS                                              syscall.Errno = 4001
-	ERROR_STATIC_INIT                                                         syscall.Errno = 4002
-	ERROR_INC_BACKUP                                                          syscall.Errno = 4003
-	ERROR_FULL_BACKUP                                                         syscall.Errno = 4004
-	ERROR_REC_NON_EXISTENT                                                    syscall.Errno = 4005
-	ERROR_RPL_NOT_ALLOWED                                                     syscall.Errno = 4006
-	PEERDIST_ERROR_CONTENTINFO_VERSION_UNSUPPORTED                            syscall.Errno = 4050
-	PEERDIST_ERROR_CANNOT_PARSE_CONTENTINFO                                   syscall.Errno = 4051
-	PEERDIST_ERROR_MISSING_DATA                                               syscall.Errno = 4052
-	PEERDIST_ERROR_NO_MORE                                                    syscall.Errno = 4053
-	PEERDIST_ERROR_NOT_INITIALIZED                                            syscall.Errno = 4054
-	PEERDIST_ERROR_ALREADY_INITIALIZED                                        syscall.Errno = 4055
-	PEERDIST_ERROR_SHUTDOWN_IN_PROGRESS                                       syscall.Errno = 4056
-	PEERDIST_ERROR_INVALIDATED                                                syscall.Errno = 4057
-	PEERDIST_ERROR_ALREADY_EXISTS                                             syscall.Errno = 4058
-	PEERDIST_ERROR_OPERATION_NOTFOUND                                         syscall.Errno = 4059
-	PEERDIST_ERROR_ALREADY_COMPLETED                                          syscall.Errno = 4060
-	PEERDIST_ERROR_OUT_OF_BOUNDS                                              syscall.Errno = 4061
-	PEERDIST_ERROR_VERSION_UNSUPPORTED                                        syscall.Errno = 4062
-	PEERDIST_ERROR_INVALID_CONFIGURATION                                      syscall.Errno = 4063
-	PEERDIST_ERROR_NOT_LICENSED                                               syscall.Errno = 4064
-	PEERDIST_ERROR_SERVICE_UNAVAILABLE                                        syscall.Errno = 4065
-	PEERDIST_ERROR_TRUST_FAILURE                                              syscall.Errno = 4066
-	ERROR_DHCP_ADDRESS_CONFLICT                                               syscall.Errno = 4100
-	ERROR_WMI_GUID_NOT_FOUND                                                  syscall.Errno = 4200
-	ERROR_WMI_INSTANCE_NOT_FOUND                                              syscall.Errno = 4201
-	ERROR_WMI_ITEMID_NOT_FOUND                                                syscall.Errno = 4202
-	ERROR_WMI_TRY_AGAIN                                                       syscall.Errno = 4203
-	ERROR_WMI_DP_NOT_FOUND                                                    syscall.Errno = 4204
-	ERROR_WMI_UNRESOLVED_INSTANCE_REF                                         syscall.Errno = 4205
-	ERROR_WMI_ALREADY_ENABLED                                                 syscall.Errno = 4206
-	ERROR_WMI_GUID_DISCONNECTED                                               syscall.Errno = 4207
-	ERROR_WMI_SERVER_UNAVAILABLE                                              syscall.Errno = 4208
-	ERROR_WMI_DP_FAILED                                                       syscall.Errno = 4209
-	ERROR_WMI_INVALID_MOF                                                     syscall.Errno = 4210
-	ERROR_WMI_INVALID_REGINFO                                                 syscall.Errno = 4211
-	ERROR_WMI_ALREADY_DISABLED                                                syscall.Errno = 4212
-	ERROR_WMI_READ_ONLY                                                       syscall.Errno = 4213
-	ERROR_WMI_SET_FAILURE                                                     syscall.Errno = 4214
-	ERROR_NOT_APPCONTAINER                                                    syscall.Errno = 4250
-	ERROR_APPCONTAINER_REQUIRED                                               syscall.Errno = 4251
-	ERROR_NOT_SUPPORTED_IN_APPCONTAINER                                       syscall.Errno = 4252
-	ERROR_INVALID_PACKAGE_SID_LENGTH                                          syscall.Errno = 4253
-	ERROR_INVALID_MEDIA                                                       syscall.Errno = 4300
-	ERROR_INVALID_LIBRARY                                                     syscall.Errno = 4301
-	ERROR_INVALID_MEDIA_POOL                                                  syscall.Errno = 4302
-	ERROR_DRIVE_MEDIA_MISMATCH                                                syscall.Errno = 4303
-	ERROR_MEDIA_OFFLINE                                                       syscall.Errno = 4304
-	ERROR_LIBRARY_OFFLINE                                                     syscall.Errno = 4305
-	ERROR_EMPTY                                                               syscall.Errno = 4306
-	ERROR_NOT_EMPTY                                                           syscall.Errno = 4307
-	ERROR_MEDIA_UNAVAILABLE                                                   syscall.Errno = 4308
-	ERROR_RESOURCE_DISABLED                                                   syscall.Errno = 4309
-	ERROR_INVALID_CLEANER                                                     syscall.Errno = 4310
-	ERROR_UNABLE_TO_CLEAN                                                     syscall.Errno = 4311
-	ERROR_OBJECT_NOT_FOUND                                                    syscall.Errno = 4312
-	ERROR_DATABASE_FAILURE                                                    syscall.Errno = 4313
-	ERROR_DATABASE_FULL                                                       syscall.Errno = 4314
-	ERROR_MEDIA_INCOMPATIBLE                                                  syscall.Errno = 4315
-	ERROR_RESOURCE_NOT_PRESENT                                                syscall.Errno = 4316
-	ERROR_INVALID_OPERATION                                                   syscall.Errno = 4317
-	ERROR_MEDIA_NOT_AVAILABLE                                                 syscall.Errno = 4318
-	ERROR_DEVICE_NOT_AVAILABLE                                                syscall.Errno = 4319
-	ERROR_REQUEST_REFUSED                                                     syscall.Errno = 4320
-	ERROR_INVALID_DRIVE_OBJECT                                                syscall.Errno = 4321
-	ERROR_LIBRARY_FULL                                                        syscall.Errno = 4322
-	ERROR_MEDIUM_NOT_ACCESSIBLE                                               syscall.Errno = 4323
-	ERROR_UNABLE_TO_LOAD_MEDIUM                                               syscall.Errno = 4324
-	ERROR_UNABLE_TO_INVENTORY_DRIVE                                           syscall.Errno = 4325
-	ERROR_UNABLE_TO_INVENTORY_SLOT                                            syscall.Errno = 4326
-	ERROR_UNABLE_TO_INVENTORY_TRANSPORT                                       syscall.Errno = 4327
-	ERROR_TRANSPORT_FULL                                                      syscall.Errno = 4328
-	ERROR_CONTROLLING_IEPORT                                                  syscall.Errno = 4329
-	ERROR_UNABLE_TO_EJECT_MOUNTED_MEDIA                                       syscall.Errno = 4330
-	ERROR_CLEANER_SLOT_SET                                                    syscall.Errno = 4331
-	ERROR_CLEANER_SLOT_NOT_SET                                                syscall.Errno = 4332
-	ERROR_CLEANER_CARTRIDGE_SPENT                                             syscall.Errno = 4333
-	ERROR_UNEXPECTED_OMID                                                     syscall.Errno = 4334
-	ERROR_CANT_DELETE_LAST_ITEM                                               syscall.Errno = 4335
-	ERROR_MESSAGE_EXCEEDS_MAX_SIZE                                            syscall.Errno = 4336
-	ERROR_VOLUME_CONTAINS_SYS_FILES                                           syscall.Errno = 4337
-	ERROR_INDIGENOUS_TYPE                                                     syscall.Errno = 4338
-	ERROR_NO_SUPPORTING_DRIVES                                                syscall.Errno = 4339
-	ERROR_CLEANER_CARTRIDGE_INSTALLED                                         syscall.Errno = 4340
-	ERROR_IEPORT_FULL                                                         syscall.Errno = 4341
-	ERROR_FILE_OFFLINE                                                        syscall.Errno = 4350
-	ERROR_REMOTE_STORAGE_NOT_ACTIVE                                           syscall.Errno = 4351
-	ERROR_REMOTE_STORAGE_MEDIA_ERROR                                          syscall.Errno = 4352
-	ERROR_NOT_A_REPARSE_POINT                                                 syscall.Errno = 4390
-	ERROR_REPARSE_ATTRIBUTE_CONFLICT                                          syscall.Errno = 4391
-	ERROR_INVALID_REPARSE_DATA                                                syscall.Errno = 4392
-	ERROR_REPARSE_TAG_INVALID                                                 syscall.Errno = 4393
-	ERROR_REPARSE_TAG_MISMATCH                                                syscall.Errno = 4394
-	ERROR_REPARSE_POINT_ENCOUNTERED                                           syscall.Errno = 4395
-	ERROR_APP_DATA_NOT_FOUND                                                  syscall.Errno = 4400
-	ERROR_APP_DATA_EXPIRED                                                    syscall.Errno = 4401
-	ERROR_APP_DATA_CORRUPT                                                    syscall.Errno = 4402
-	ERROR_APP_DATA_LIMIT_EXCEEDED                                             syscall.Errno = 4403
-	ERROR_APP_DATA_REBOOT_REQUIRED                                            syscall.Errno = 4404
-	ERROR_SECUREBOOT_ROLLBACK_DETECTED                                        syscall.Errno = 4420
-	ERROR_SECUREBOOT_POLICY_VIOLATION                                         syscall.Errno = 4421
-	ERROR_SECUREBOOT_INVALID_POLICY                                           syscall.Errno = 4422
-	ERROR_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND                               syscall.Errno = 4423
-	ERROR_SECUREBOOT_POLICY_NOT_SIGNED                                        syscall.Errno = 4424
-	ERROR_SECUREBOOT_NOT_ENABLED                                              syscall.Errno = 4425
-	ERROR_SECUREBOOT_FILE_REPLACED                                            syscall.Errno = 4426
-	ERROR_SECUREBOOT_POLICY_NOT_AUTHORIZED                                    syscall.Errno = 4427
-	ERROR_SECUREBOOT_POLICY_UNKNOWN                                           syscall.Errno = 4428
-	ERROR_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION                       syscall.Errno = 4429
-	ERROR_SECUREBOOT_PLATFORM_ID_MISMATCH                                     syscall.Errno = 4430
-	ERROR_SECUREBOOT_POLICY_ROLLBACK_DETECTED                                 syscall.Errno = 4431
-	ERROR_SECUREBOOT_POLICY_UPGRADE_MISMATCH                                  syscall.Errno = 4432
-	ERROR_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING                             syscall.Errno = 4433
-	ERROR_SECUREBOOT_NOT_BASE_POLICY                                          syscall.Errno = 4434
-	ERROR_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY                                  syscall.Errno = 4435
-	ERROR_OFFLOAD_READ_FLT_NOT_SUPPORTED                                      syscall.Errno = 4440
-	ERROR_OFFLOAD_WRITE_FLT_NOT_SUPPORTED                                     syscall.Errno = 4441
-	ERROR_OFFLOAD_READ_FILE_NOT_SUPPORTED                                     syscall.Errno = 4442
-	ERROR_OFFLOAD_WRITE_FILE_NOT_SUPPORTED                                    syscall.Errno = 4443
-	ERROR_ALREADY_HAS_STREAM_ID                                               syscall.Errno = 4444
-	ERROR_SMR_GARBAGE_COLLECTION_REQUIRED                                     syscall.Errno = 4445
-	ERROR_WOF_WIM_HEADER_CORRUPT                                              syscall.Errno = 4446
-	ERROR_WOF_WIM_RESOURCE_TABLE_CORRUPT                                      syscall.Errno = 4447
-	ERROR_WOF_FILE_RESOURCE_TABLE_CORRUPT                                     syscall.Errno = 4448
-	ERROR_VOLUME_NOT_SIS_ENABLED                                              syscall.Errno = 4500
-	ERROR_SYSTEM_INTEGRITY_ROLLBACK_DETECTED                                  syscall.Errno = 4550
-	ERROR_SYSTEM_INTEGRITY_POLICY_VIOLATION                                   syscall.Errno = 4551
-	ERROR_SYSTEM_INTEGRITY_INVALID_POLICY                                     syscall.Errno = 4552
-	ERROR_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED                                  syscall.Errno = 4553
-	ERROR_VSM_NOT_INITIALIZED                                                 syscall.Errno = 4560
-	ERROR_VSM_DMA_PROTECTION_NOT_IN_USE                                       syscall.Errno = 4561
-	ERROR_PLATFORM_MANIFEST_NOT_AUTHORIZED                                    syscall.Errno = 4570
-	ERROR_PLATFORM_MANIFEST_INVALID                                           syscall.Errno = 4571
-	ERROR_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED                               syscall.Errno = 4572
-	ERROR_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED                            syscall.Errno = 4573
-	ERROR_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND                               syscall.Errno = 4574
-	ERROR_PLATFORM_MANIFEST_NOT_ACTIVE                                        syscall.Errno = 4575
-	ERROR_PLATFORM_MANIFEST_NOT_SIGNED                                        syscall.Errno = 4576
-	ERROR_DEPENDENT_RESOURCE_EXISTS                                           syscall.Errno = 5001
-	ERROR_DEPENDENCY_NOT_FOUND                                                syscall.Errno = 5002
-	ERROR_DEPENDENCY_ALREADY_EXISTS                                           syscall.Errno = 5003
-	ERROR_RESOURCE_NOT_ONLINE                                                 syscall.Errno = 5004
-	ERROR_HOST_NODE_NOT_AVAILABLE                                             syscall.Errno = 5005
-	ERROR_RESOURCE_NOT_AVAILABLE                                              syscall.Errno = 5006
-	ERROR_RESOURCE_NOT_FOUND                                                  syscall.Errno = 5007
-	ERROR_SHUTDOWN_CLUSTER                                                    syscall.Errno = 5008
-	ERROR_CANT_EVICT_ACTIVE_NODE                                              syscall.Errno = 5009
-	ERROR_OBJECT_ALREADY_EXISTS                                               syscall.Errno = 5010
-	ERROR_OBJECT_IN_LIST                                                      syscall.Errno = 5011
-	ERROR_GROUP_NOT_AVAILABLE                                                 syscall.Errno = 5012
-	ERROR_GROUP_NOT_FOUND                                                     syscall.Errno = 5013
-	ERROR_GROUP_NOT_ONLINE                                                    syscall.Errno = 5014
-	ERROR_HOST_NODE_NOT_RESOURCE_OWNER                                        syscall.Errno = 5015
-	ERROR_HOST_NODE_NOT_GROUP_OWNER                                           syscall.Errno = 5016
-	ERROR_RESMON_CREATE_FAILED                                                syscall.Errno = 5017
-	ERROR_RESMON_ONLINE_FAILED                                                syscall.Errno = 5018
-	ERROR_RESOURCE_ONLINE                                                     syscall.Errno = 5019
-	ERROR_QUORUM_RESOURCE                                                     syscall.Errno = 5020
-	ERROR_NOT_QUORUM_CAPABLE                                                  syscall.Errno = 5021
-	ERROR_CLUSTER_SHUTTING_DOWN                                               syscall.Errno = 5022
-	ERROR_INVALID_STATE                                                       syscall.Errno = 5023
-	ERROR_RESOURCE_PROPERTIES_STORED                                          syscall.Errno = 5024
-	ERROR_NOT_QUORUM_CLASS                                                    syscall.Errno = 5025
-	ERROR_CORE_RESOURCE                                                       syscall.Errno = 5026
-	ERROR_QUORUM_RESOURCE_ONLINE_FAILED                                       syscall.Errno = 5027
-	ERROR_QUORUMLOG_OPEN_FAILED                                               syscall.Errno = 5028
-	ERROR_CLUSTERLOG_CORRUPT                                                  syscall.Errno = 5029
-	ERROR_CLUSTERLOG_RECORD_EXCEEDS_MAXSIZE                                   syscall.Errno = 5030
-	ERROR_CLUSTERLOG_EXCEEDS_MAXSIZE                                          syscall.Errno = 5031
-	ERROR_CLUSTERLOG_CHKPOINT_NOT_FOUND                                       syscall.Errno = 5032
-	ERROR_CLUSTERLOG_NOT_ENOUGH_SPACE                                         syscall.Errno = 5033
-	ERROR_QUORUM_OWNER_ALIVE                                                  syscall.Errno = 5034
-	ERROR_NETWORK_NOT_AVAILABLE                                               syscall.Errno = 5035
-	ERROR_NODE_NOT_AVAILABLE                                                  syscall.Errno = 5036
-	ERROR_ALL_NODES_NOT_AVAILABLE                                             syscall.Errno = 5037
-	ERROR_RESOURCE_FAILED                                                     syscall.Errno = 5038
-	ERROR_CLUSTER_INVALID_NODE                                                syscall.Errno = 5039
-	ERROR_CLUSTER_NODE_EXISTS                                                 syscall.Errno = 5040
-	ERROR_CLUSTER_JOIN_IN_PROGRESS                                            syscall.Errno = 5041
-	ERROR_CLUSTER_NODE_NOT_FOUND                                              syscall.Errno = 5042
-	ERROR_CLUSTER_LOCAL_NODE_NOT_FOUND                                        syscall.Errno = 5043
-	ERROR_CLUSTER_NETWORK_EXISTS                                              syscall.Errno = 5044
-	ERROR_CLUSTER_NETWORK_NOT_FOUND                                           syscall.Errno = 5045
-	ERROR_CLUSTER_NETINTERFACE_EXISTS                                         syscall.Errno = 5046
-	ERROR_CLUSTER_NETINTERFACE_NOT_FOUND                                      syscall.Errno = 5047
-	ERROR_CLUSTER_INVALID_REQUEST                                             syscall.Errno = 5048
-	ERROR_CLUSTER_INVALID_NETWORK_PROVIDER                                    syscall.Errno = 5049
-	ERROR_CLUSTER_NODE_DOWN                                                   syscall.Errno = 5050
-	ERROR_CLUSTER_NODE_UNREACHABLE                                            syscall.Errno = 5051
-	ERROR_CLUSTER_NODE_NOT_MEMBER                                             syscall.Errno = 5052
-	ERROR_CLUSTER_JOIN_NOT_IN_PROGRESS                                        syscall.Errno = 5053
-	ERROR_CLUSTER_INVALID_NETWORK                                             syscall.Errno = 5054
-	ERROR_CLUSTER_NODE_UP                                                     syscall.Errno = 5056
-	ERROR_CLUSTER_IPADDR_IN_USE                                               syscall.Errno = 5057
-	ERROR_CLUSTER_NODE_NOT_PAUSED                                             syscall.Errno = 5058
-	ERROR_CLUSTER_NO_SECURITY_CONTEXT                                         syscall.Errno = 5059
-	ERROR_CLUSTER_NETWORK_NOT_INTERNAL                                        syscall.Errno = 5060
-	ERROR_CLUSTER_NODE_ALREADY_UP                                             syscall.Errno = 5061
-	ERROR_CLUSTER_NODE_ALREADY_DOWN                                           syscall.Errno = 5062
-	ERROR_CLUSTER_NETWORK_ALREADY_ONLINE                                      syscall.Errno = 5063
-	ERROR_CLUSTER_NETWORK_ALREADY_OFFLINE                                     syscall.Errno = 5064
-	ERROR_CLUSTER_NODE_ALREADY_MEMBER                                         syscall.Errno = 5065
-	ERROR_CLUSTER_LAST_INTERNAL_NETWORK                                       syscall.Errno = 5066
-	ERROR_CLUSTER_NETWORK_HAS_DEPENDENTS                                      syscall.Errno = 5067
-	ERROR_INVALID_OPERATION_ON_QUORUM                                         syscall.Errno = 5068
-	ERROR_DEPENDENCY_NOT_ALLOWED                                              syscall.Errno = 5069
-	ERROR_CLUSTER_NODE_PAUSED                                                 syscall.Errno = 5070
-	ERROR_NODE_CANT_HOST_RESOURCE                                             syscall.Errno = 5071
-	ERROR_CLUSTER_NODE_NOT_READY                                              syscall.Errno = 5072
-	ERROR_CLUSTER_NODE_SHUTTING_DOWN                                          syscall.Errno = 5073
-	ERROR_CLUSTER_JOIN_ABORTED                                                syscall.Errno = 5074
-	ERROR_CLUSTER_INCOMPATIBLE_VERSIONS                                       syscall.Errno = 5075
-	ERROR_CLUSTER_MAXNUM_OF_RESOURCES_EXCEEDED                                syscall.Errno = 5076
-	ERROR_CLUSTER_SYSTEM_CONFIG_CHANGED                                       syscall.Errno = 5077
-	ERROR_CLUSTER_RESOURCE_TYPE_NOT_FOUND                                     syscall.Errno = 5078
-	ERROR_CLUSTER_RESTYPE_NOT_SUPPORTED                                       syscall.Errno = 5079
-	ERROR_CLUSTER_RESNAME_NOT_FOUND                                           syscall.Errno = 5080
-	ERROR_CLUSTER_NO_RPC_PACKAGES_REGISTERED                                  syscall.Errno = 5081
-	ERROR_CLUSTER_OWNER_NOT_IN_PREFLIST                                       syscall.Errno = 5082
-	ERROR_CLUSTER_DATABASE_SEQMISMATCH                                        syscall.Errno = 5083
-	ERROR_RESMON_INVALID_STATE                                                syscall.Errno = 5084
-	ERROR_CLUSTER_GUM_NOT_LOCKER                                              syscall.Errno = 5085
-	ERROR_QUORUM_DISK_NOT_FOUND                                               syscall.Errno = 5086
-	ERROR_DATABASE_BACKUP_CORRUPT                                             syscall.Errno = 5087
-	ERROR_CLUSTER_NODE_ALREADY_HAS_DFS_ROOT                                   syscall.Errno = 5088
-	ERROR_RESOURCE_PROPERTY_UNCHANGEABLE                                      syscall.Errno = 5089
-	ERROR_NO_ADMIN_ACCESS_POINT                                               syscall.Errno = 5090
-	ERROR_CLUSTER_MEMBERSHIP_INVALID_STATE                                    syscall.Errno = 5890
-	ERROR_CLUSTER_QUORUMLOG_NOT_FOUND                                         syscall.Errno = 5891
-	ERROR_CLUSTER_MEMBERSHIP_HALT                                             syscall.Errno = 5892
-	ERROR_CLUSTER_INSTANCE_ID_MISMATCH                                        syscall.Errno = 5893
-	ERROR_CLUSTER_NETWORK_NOT_FOUND_FOR_IP                                    syscall.Errno = 5894
-	ERROR_CLUSTER_PROPERTY_DATA_TYPE_MISMATCH                                 syscall.Errno = 5895
-	ERROR_CLUSTER_EVICT_WITHOUT_CLEANUP                                       syscall.Errno = 5896
-	ERROR_CLUSTER_PARAMETER_MISMATCH                                          syscall.Errno = 5897
-	ERROR_NODE_CANNOT_BE_CLUSTERED                                            syscall.Errno = 5898
-	ERROR_CLUSTER_WRONG_OS_VERSION                                            syscall.Errno = 5899
-	ERROR_CLUSTER_CANT_CREATE_DUP_CLUSTER_NAME                                syscall.Errno = 5900
-	ERROR_CLUSCFG_ALREADY_COMMITTED                                           syscall.Errno = 5901
-	ERROR_CLUSCFG_ROLLBACK_FAILED                                             syscall.Errno = 5902
-	ERROR_CLUSCFG_SYSTEM_DISK_DRIVE_LETTER_CONFLICT                           syscall.Errno = 5903
-	ERROR_CLUSTER_OLD_VERSION                                                 syscall.Errno = 5904
-	ERROR_CLUSTER_MISMATCHED_COMPUTER_ACCT_NAME                               syscall.Errno = 5905
-	ERROR_CLUSTER_NO_NET_ADAPTERS                                             syscall.Errno = 5906
-	ERROR_CLUSTER_POISONED                                                    syscall.Errno = 5907
-	ERROR_CLUSTER_GROUP_MOVING                                                syscall.Errno = 5908
-	ERROR_CLUSTER_RESOURCE_TYPE_BUSY                                          syscall.Errno = 5909
-	ERROR_RESOURCE_CALL_TIMED_OUT                                             syscall.Errno = 5910
-	ERROR_INVALID_CLUSTER_IPV6_ADDRESS                                        syscall.Errno = 5911
-	ERROR_CLUSTER_INTERNAL_INVALID_FUNCTION                                   syscall.Errno = 5912
-	ERROR_CLUSTER_PARAMETER_OUT_OF_BOUNDS                                     syscall.Errno = 5913
-	ERROR_CLUSTER_PARTIAL_SEND                                                syscall.Errno = 5914
-	ERROR_CLUSTER_REGISTRY_INVALID_FUNCTION                                   syscall.Errno = 5915
-	ERROR_CLUSTER_INVALID_STRING_TERMINATION                                  syscall.Errno = 5916
-	ERROR_CLUSTER_INVALID_STRING_FORMAT                                       syscall.Errno = 5917
-	ERROR_CLUSTER_DATABASE_TRANSACTION_IN_PROGRESS                            syscall.Errno = 5918
-	ERROR_CLUSTER_DATABASE_TRANSACTION_NOT_IN_PROGRESS                        syscall.Errno = 5919
-	ERROR_CLUSTER_NULL_DATA                                                   syscall.Errno = 5920
-	ERROR_CLUSTER_PARTIAL_READ                                                syscall.Errno = 5921
-	ERROR_CLUSTER_PARTIAL_WRITE                                               syscall.Errno = 5922
-	ERROR_CLUSTER_CANT_DESERIALIZE_DATA                                       syscall.Errno = 5923
-	ERROR_DEPENDENT_RESOURCE_PROPERTY_CONFLICT                                syscall.Errno = 5924
-	ERROR_CLUSTER_NO_QUORUM                                                   syscall.Errno = 5925
-	ERROR_CLUSTER_INVALID_IPV6_NETWORK                                        syscall.Errno = 5926
-	ERROR_CLUSTER_INVALID_IPV6_TUNNEL_NETWORK                                 syscall.Errno = 5927
-	ERROR_QUORUM_NOT_ALLOWED_IN_THIS_GROUP                                    syscall.Errno = 5928
-	ERROR_DEPENDENCY_TREE_TOO_COMPLEX                                         syscall.Errno = 5929
-	ERROR_EXCEPTION_IN_RESOURCE_CALL                                          syscall.Errno = 5930
-	ERROR_CLUSTER_RHS_FAILED_INITIALIZATION                                   syscall.Errno = 5931
-	ERROR_CLUSTER_NOT_INSTALLED                                               syscall.Errno = 5932
-	ERROR_CLUSTER_RESOURCES_MUST_BE_ONLINE_ON_THE_SAME_NODE                   syscall.Errno = 5933
-	ERROR_CLUSTER_MAX_NODES_IN_CLUSTER                                        syscall.Errno = 5934
-	ERROR_CLUSTER_TOO_MANY_NODES                                              syscall.Errno = 5935
-	ERROR_CLUSTER_OBJECT_ALREADY_USED                                         syscall.Errno = 5936
-	ERROR_NONCORE_GROUPS_FOUND                                                syscall.Errno = 5937
-	ERROR_FILE_SHARE_RESOURCE_CONFLICT                                        syscall.Errno = 5938
-	ERROR_CLUSTER_EVICT_INVALID_REQUEST                                       syscall.Errno = 5939
-	ERROR_CLUSTER_SINGLETON_RESOURCE                                          syscall.Errno = 5940
-	ERROR_CLUSTER_GROUP_SINGLETON_RESOURCE                                    syscall.Errno = 5941
-	ERROR_CLUSTER_RESOURCE_PROVIDER_FAILED                                    syscall.Errno = 5942
-	ERROR_CLUSTER_RESOURCE_CONFIGURATION_ERROR                                syscall.Errno = 5943
-	ERROR_CLUSTER_GROUP_BUSY                                                  syscall.Errno = 5944
-	ERROR_CLUSTER_NOT_SHARED_VOLUME                                           syscall.Errno = 5945
-	ERROR_CLUSTER_INVALID_SECURITY_DESCRIPTOR                                 syscall.Errno = 5946
-	ERROR_CLUSTER_SHARED_VOLUMES_IN_USE                                       syscall.Errno = 5947
-	ERROR_CLUSTER_USE_SHARED_VOLUMES_API                                      syscall.Errno = 5948
-	ERROR_CLUSTER_BACKUP_IN_PROGRESS                                          syscall.Errno = 5949
-	ERROR_NON_CSV_PATH                                                        syscall.Errno = 5950
-	ERROR_CSV_VOLUME_NOT_LOCAL                                                syscall.Errno = 5951
-	ERROR_CLUSTER_WATCHDOG_TERMINATING                                        syscall.Errno = 5952
-	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_INCOMPATIBLE_NODES                     syscall.Errno = 5953
-	ERROR_CLUSTER_INVALID_NODE_WEIGHT                                         syscall.Errno = 5954
-	ERROR_CLUSTER_RESOURCE_VETOED_CALL                                        syscall.Errno = 5955
-	ERROR_RESMON_SYSTEM_RESOURCES_LACKING                                     syscall.Errno = 5956
-	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_DESTINATION    syscall.Errno = 5957
-	ERROR_CLUSTER_RESOURCE_VETOED_MOVE_NOT_ENOUGH_RESOURCES_ON_SOURCE         syscall.Errno = 5958
-	ERROR_CLUSTER_GROUP_QUEUED                                                syscall.Errno = 5959
-	ERROR_CLUSTER_RESOURCE_LOCKED_STATUS                                      syscall.Errno = 5960
-	ERROR_CLUSTER_SHARED_VOLUME_FAILOVER_NOT_ALLOWED                          syscall.Errno = 5961
-	ERROR_CLUSTER_NODE_DRAIN_IN_PROGRESS                                      syscall.Errno = 5962
-	ERROR_CLUSTER_DISK_NOT_CONNECTED                                          syscall.Errno = 5963
-	ERROR_DISK_NOT_CSV_CAPABLE                                                syscall.Errno = 5964
-	ERROR_RESOURCE_NOT_IN_AVAILABLE_STORAGE                                   syscall.Errno = 5965
-	ERROR_CLUSTER_SHARED_VOLUME_REDIRECTED                                    syscall.Errno = 5966
-	ERROR_CLUSTER_SHARED_VOLUME_NOT_REDIRECTED                                syscall.Errno = 5967
-	ERROR_CLUSTER_CANNOT_RETURN_PROPERTIES                                    syscall.Errno = 5968
-	ERROR_CLUSTER_RESOURCE_CONTAINS_UNSUPPORTED_DIFF_AREA_FOR_SHARED_VOLUMES  syscall.Errno = 5969
-	ERROR_CLUSTER_RESOURCE_IS_IN_MAINTENANCE_MODE                             syscall.Errno = 5970
-	ERROR_CLUSTER_AFFINITY_CONFLICT                                           syscall.Errno = 5971
-	ERROR_CLUSTER_RESOURCE_IS_REPLICA_VIRTUAL_MACHINE                         syscall.Errno = 5972
-	ERROR_CLUSTER_UPGRADE_INCOMPATIBLE_VERSIONS                               syscall.Errno = 5973
-	ERROR_CLUSTER_UPGRADE_FIX_QUORUM_NOT_SUPPORTED                            syscall.Errno = 5974
-	ERROR_CLUSTER_UPGRADE_RESTART_REQUIRED                                    syscall.Errno = 5975
-	ERROR_CLUSTER_UPGRADE_IN_PROGRESS                                         syscall.Errno = 5976
-	ERROR_CLUSTER_UPGRADE_INCOMPLETE                                          syscall.Errno = 5977
-	ERROR_CLUSTER_NODE_IN_GRACE_PERIOD                                        syscall.Errno = 5978
-	ERROR_CLUSTER_CSV_IO_PAUSE_TIMEOUT                                        syscall.Errno = 5979
-	ERROR_NODE_NOT_ACTIVE_CLUSTER_MEMBER                                      syscall.Errno = 5980
-	ERROR_CLUSTER_RESOURCE_NOT_MONITORED                                      syscall.Errno = 5981
-	ERROR_CLUSTER_RESOURCE_DOES_NOT_SUPPORT_UNMONITORED                       syscall.Errno = 5982
-	ERROR_CLUSTER_RESOURCE_IS_REPLICATED                                      syscall.Errno = 5983
-	ERROR_CLUSTER_NODE_ISOLATED                                               syscall.Errno = 5984
-	ERROR_CLUSTER_NODE_QUARANTINED                                            syscall.Errno = 5985
-	ERROR_CLUSTER_DATABASE_UPDATE_CONDITION_FAILED                            syscall.Errno = 5986
-	ERROR_CLUSTER_SPACE_DEGRADED                                              syscall.Errno = 5987
-	ERROR_CLUSTER_TOKEN_DELEGATION_NOT_SUPPORTED                              syscall.Errno = 5988
-	ERROR_CLUSTER_CSV_INVALID_HANDLE                                          syscall.Errno = 5989
-	ERROR_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR                           syscall.Errno = 5990
-	ERROR_GROUPSET_NOT_AVAILABLE                                              syscall.Errno = 5991
-	ERROR_GROUPSET_NOT_FOUND                                                  syscall.Errno = 5992
-	ERROR_GROUPSET_CANT_PROVIDE                                               syscall.Errno = 5993
-	ERROR_CLUSTER_FAULT_DOMAIN_PARENT_NOT_FOUND                               syscall.Errno = 5994
-	ERROR_CLUSTER_FAULT_DOMAIN_INVALID_HIERARCHY                              syscall.Errno = 5995
-	ERROR_CLUSTER_FAULT_DOMAIN_FAILED_S2D_VALIDATION                          syscall.Errno = 5996
-	ERROR_CLUSTER_FAULT_DOMAIN_S2D_CONNECTIVITY_LOSS                          syscall.Errno = 5997
-	ERROR_CLUSTER_INVALID_INFRASTRUCTURE_FILESERVER_NAME                      syscall.Errno = 5998
-	ERROR_CLUSTERSET_MANAGEMENT_CLUSTER_UNREACHABLE                           syscall.Errno = 5999
-	ERROR_ENCRYPTION_FAILED                                                   syscall.Errno = 6000
-	ERROR_DECRYPTION_FAILED                                                   syscall.Errno = 6001
-	ERROR_FILE_ENCRYPTED                                                      syscall.Errno = 6002
-	ERROR_NO_RECOVERY_POLICY                                                  syscall.Errno = 6003
-	ERROR_NO_EFS                                                              syscall.Errno = 6004
-	ERROR_WRONG_EFS                                                           syscall.Errno = 6005
-	ERROR_NO_USER_KEYS                                                        syscall.Errno = 6006
-	ERROR_FILE_NOT_ENCRYPTED                                                  syscall.Errno = 6007
-	ERROR_NOT_EXPORT_FORMAT                                                   syscall.Errno = 6008
-	ERROR_FILE_READ_ONLY                                                      syscall.Errno = 6009
-	ERROR_DIR_EFS_DISALLOWED                                                  syscall.Errno = 6010
-	ERROR_EFS_SERVER_NOT_TRUSTED                                              syscall.Errno = 6011
-	ERROR_BAD_RECOVERY_POLICY                                                 syscall.Errno = 6012
-	ERROR_EFS_ALG_BLOB_TOO_BIG                                                syscall.Errno = 6013
-	ERROR_VOLUME_NOT_SUPPORT_EFS                                              syscall.Errno = 6014
-	ERROR_EFS_DISABLED                                                        syscall.Errno = 6015
-	ERROR_EFS_VERSION_NOT_SUPPORT                                             syscall.Errno = 6016
-	ERROR_CS_ENCRYPTION_INVALID_SERVER_RESPONSE                               syscall.Errno = 6017
-	ERROR_CS_ENCRYPTION_UNSUPPORTED_SERVER                                    syscall.Errno = 6018
-	ERROR_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE                               syscall.Errno = 6019
-	ERROR_CS_ENCRYPTION_NEW_ENCRYPTED_FILE                                    syscall.Errno = 6020
-	ERROR_CS_ENCRYPTION_FILE_NOT_CSE                                          syscall.Errno = 6021
-	ERROR_ENCRYPTION_POLICY_DENIES_OPERATION                                  syscall.Errno = 6022
-	ERROR_NO_BROWSER_SERVERS_FOUND                                            syscall.Errno = 6118
-	SCHED_E_SERVICE_NOT_LOCALSYSTEM                                           syscall.Errno = 6200
-	ERROR_LOG_SECTOR_INVALID                                                  syscall.Errno = 6600
-	ERROR_LOG_SECTOR_PARITY_INVALID                                           syscall.Errno = 6601
-	ERROR_LOG_SECTOR_REMAPPED                                                 syscall.Errno = 6602
-	ERROR_LOG_BLOCK_INCOMPLETE                                                syscall.Errno = 6603
-	ERROR_LOG_INVALID_RANGE                                                   syscall.Errno = 6604
-	ERROR_LOG_BLOCKS_EXHAUSTED                                                syscall.Errno = 6605
-	ERROR_LOG_READ_CONTEXT_INVALID                                            syscall.Errno = 6606
-	ERROR_LOG_RESTART_INVALID                                                 syscall.Errno = 6607
-	ERROR_LOG_BLOCK_VERSION                                                   syscall.Errno = 6608
-	ERROR_LOG_BLOCK_INVALID                                                   syscall.Errno = 6609
-	ERROR_LOG_READ_MODE_INVALID                                               syscall.Errno = 6610
-	ERROR_LOG_NO_RESTART                                                      syscall.Errno = 6611
-	ERROR_LOG_METADATA_CORRUPT                                                syscall.Errno = 6612
-	ERROR_LOG_METADATA_INVALID                                                syscall.Errno = 6613
-	ERROR_LOG_METADATA_INCONSISTENT                                           syscall.Errno = 6614
-	ERROR_LOG_RESERVATION_INVALID                                             syscall.Errno = 6615
-	ERROR_LOG_CANT_DELETE                                                     syscall.Errno = 6616
-	ERROR_LOG_CONTAINER_LIMIT_EXCEEDED                                        syscall.Errno = 6617
-	ERROR_LOG_START_OF_LOG                                                    syscall.Errno = 6618
-	ERROR_LOG_POLICY_ALREADY_INSTALLED                                        syscall.Errno = 6619
-	ERROR_LOG_POLICY_NOT_INSTALLED                                            syscall.Errno = 6620
-	ERROR_LOG_POLICY_INVALID                                                  syscall.Errno = 6621
-	ERROR_LOG_POLICY_CONFLICT                                                 syscall.Errno = 6622
-	ERROR_LOG_PINNED_ARCHIVE_TAIL                                             syscall.Errno = 6623
-	ERROR_LOG_RECORD_NONEXISTENT                                              syscall.Errno = 6624
-	ERROR_LOG_RECORDS_RESERVED_INVALID                                        syscall.Errno = 6625
-	ERROR_LOG_SPACE_RESERVED_INVALID                                          syscall.Errno = 6626
-	ERROR_LOG_TAIL_INVALID                                                    syscall.Errno = 6627
-	ERROR_LOG_FULL                                                            syscall.Errno = 6628
-	ERROR_COULD_NOT_RESIZE_LOG                                                syscall.Errno = 6629
-	ERROR_LOG_MULTIPLEXED                                                     syscall.Errno = 6630
-	ERROR_LOG_DEDICATED                                                       syscall.Errno = 6631
-	ERROR_LOG_ARCHIVE_NOT_IN_PROGRESS                                         syscall.Errno = 6632
-	ERROR_LOG_ARCHIVE_IN_PROGRESS                                             syscall.Errno = 6633
-	ERROR_LOG_EPHEMERAL                                                       syscall.Errno = 6634
-	ERROR_LOG_NOT_ENOUGH_CONTAINERS                                           syscall.Errno = 6635
-	ERROR_LOG_CLIENT_ALREADY_REGISTERED                                       syscall.Errno = 6636
-	ERROR_LOG_CLIENT_NOT_REGISTERED                                           syscall.Errno = 6637
-	ERROR_LOG_FULL_HANDLER_IN_PROGRESS                                        syscall.Errno = 6638
-	ERROR_LOG_CONTAINER_READ_FAILED                                           syscall.Errno = 6639
-	ERROR_LOG_CONTAINER_WRITE_FAILED                                          syscall.Errno = 6640
-	ERROR_LOG_CONTAINER_OPEN_FAILED                                           syscall.Errno = 6641
-	ERROR_LOG_CONTAINER_STATE_INVALID                                         syscall.Errno = 6642
-	ERROR_LOG_STATE_INVALID                                                   syscall.Errno = 6643
-	ERROR_LOG_PINNED                                                          syscall.Errno = 6644
-	ERROR_LOG_METADATA_FLUSH_FAILED                                           syscall.Errno = 6645
-	ERROR_LOG_INCONSISTENT_SECURITY                                           syscall.Errno = 6646
-	ERROR_LOG_APPENDED_FLUSH_FAILED                                           syscall.Errno = 6647
-	ERROR_LOG_PINNED_RESERVATION                                              syscall.Errno = 6648
-	ERROR_INVALID_TRANSACTION                                                 syscall.Errno = 6700
-	ERROR_TRANSACTION_NOT_ACTIVE                                              syscall.Errno = 6701
-	ERROR_TRANSACTION_REQUEST_NOT_VALID                                       syscall.Errno = 6702
-	ERROR_TRANSACTION_NOT_REQUESTED                                           syscall.Errno = 6703
-	ERROR_TRANSACTION_ALREADY_ABORTED                                         syscall.Errno = 6704
-	ERROR_TRANSACTION_ALREADY_COMMITTED                                       syscall.Errno = 6705
-	ERROR_TM_INITIALIZATION_FAILED                                            syscall.Errno = 6706
-	ERROR_RESOURCEMANAGER_READ_ONLY                                           syscall.Errno = 6707
-	ERROR_TRANSACTION_NOT_JOINED                                              syscall.Errno = 6708
-	ERROR_TRANSACTION_SUPERIOR_EXISTS                                         syscall.Errno = 6709
-	ERROR_CRM_PROTOCOL_ALREADY_EXISTS                                         syscall.Errno = 6710
-	ERROR_TRANSACTION_PROPAGATION_FAILED                                      syscall.Errno = 6711
-	ERROR_CRM_PROTOCOL_NOT_FOUND                                              syscall.Errno = 6712
-	ERROR_TRANSACTION_INVALID_MARSHALL_BUFFER                                 syscall.Errno = 6713
-	ERROR_CURRENT_TRANSACTION_NOT_VALID                                       syscall.Errno = 6714
-	ERROR_TRANSACTION_NOT_FOUND                                               syscall.Errno = 6715
-	ERROR_RESOURCEMANAGER_NOT_FOUND                                           syscall.Errno = 6716
-	ERROR_ENLISTMENT_NOT_FOUND                                                syscall.Errno = 6717
-	ERROR_TRANSACTIONMANAGER_NOT_FOUND                                        syscall.Errno = 6718
-	ERROR_TRANSACTIONMANAGER_NOT_ONLINE                                       syscall.Errno = 6719
-	ERROR_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION                          syscall.Errno = 6720
-	ERROR_TRANSACTION_NOT_ROOT                                                syscall.Errno = 6721
-	ERROR_TRANSACTION_OBJECT_EXPIRED                                          syscall.Errno = 6722
-	ERROR_TRANSACTION_RESPONSE_NOT_ENLISTED                                   syscall.Errno = 6723
-	ERROR_TRANSACTION_RECORD_TOO_LONG                                         syscall.Errno = 6724
-	ERROR_IMPLICIT_TRANSACTION_NOT_SUPPORTED                                  syscall.Errno = 6725
-	ERROR_TRANSACTION_INTEGRITY_VIOLATED                                      syscall.Errno = 6726
-	ERROR_TRANSACTIONMANAGER_IDENTITY_MISMATCH                                syscall.Errno = 6727
-	ERROR_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT                                    syscall.Errno = 6728
-	ERROR_TRANSACTION_MUST_WRITETHROUGH                                       syscall.Errno = 6729
-	ERROR_TRANSACTION_NO_SUPERIOR                                             syscall.Errno = 6730
-	ERROR_HEURISTIC_DAMAGE_POSSIBLE                                           syscall.Errno = 6731
-	ERROR_TRANSACTIONAL_CONFLICT                                              syscall.Errno = 6800
-	ERROR_RM_NOT_ACTIVE                                                       syscall.Errno = 6801
-	ERROR_RM_METADATA_CORRUPT                                                 syscall.Errno = 6802
-	ERROR_DIRECTORY_NOT_RM                                                    syscall.Errno = 6803
-	ERROR_TRANSACTIONS_UNSUPPORTED_REMOTE                                     syscall.Errno = 6805
-	ERROR_LOG_RESIZE_INVALID_SIZE                                             syscall.Errno = 6806
-	ERROR_OBJECT_NO_LONGER_EXISTS                                             syscall.Errno = 6807
-	ERROR_STREAM_MINIVERSION_NOT_FOUND                                        syscall.Errno = 6808
-	ERROR_STREAM_MINIVERSION_NOT_VALID                                        syscall.Errno = 6809
-	ERROR_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION                 syscall.Errno = 6810
-	ERROR_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT                            syscall.Errno = 6811
-	ERROR_CANT_CREATE_MORE_STREAM_MINIVERSIONS                                syscall.Errno = 6812
-	ERROR_REMOTE_FILE_VERSION_MISMATCH                                        syscall.Errno = 6814
-	ERROR_HANDLE_NO_LONGER_VALID                                              syscall.Errno = 6815
-	ERROR_NO_TXF_METADATA                                                     syscall.Errno = 6816
-	ERROR_LOG_CORRUPTION_DETECTED                                             syscall.Errno = 6817
-	ERROR_CANT_RECOVER_WITH_HANDLE_OPEN                                       syscall.Errno = 6818
-	ERROR_RM_DISCONNECTED                                                     syscall.Errno = 6819
-	ERROR_ENLISTMENT_NOT_SUPERIOR                                             syscall.Errno = 6820
-	ERROR_RECOVERY_NOT_NEEDED                                                 syscall.Errno = 6821
-	ERROR_RM_ALREADY_STARTED                                                  syscall.Errno = 6822
-	ERROR_FILE_IDENTITY_NOT_PERSISTENT                                        syscall.Errno = 6823
-	ERROR_CANT_BREAK_TRANSACTIONAL_DEPENDENCY                                 syscall.Errno = 6824
-	ERROR_CANT_CROSS_RM_BOUNDARY                                              syscall.Errno = 6825
-	ERROR_TXF_DIR_NOT_EMPTY                                                   syscall.Errno = 6826
-	ERROR_INDOUBT_TRANSACTIONS_EXIST                                          syscall.Errno = 6827
-	ERROR_TM_VOLATILE                                                         syscall.Errno = 6828
-	ERROR_ROLLBACK_TIMER_EXPIRED                                              syscall.Errno = 6829
-	ERROR_TXF_ATTRIBUTE_CORRUPT                                               syscall.Errno = 6830
-	ERROR_EFS_NOT_ALLOWED_IN_TRANSACTION                                      syscall.Errno = 6831
-	ERROR_TRANSACTIONAL_OPEN_NOT_ALLOWED                                      syscall.Errno = 6832
-	ERROR_LOG_GROWTH_FAILED                                                   syscall.Errno = 6833
-	ERROR_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE                               syscall.Errno = 6834
-	ERROR_TXF_METADATA_ALREADY_PRESENT                                        syscall.Errno = 6835
-	ERROR_TRANSACTION_SCOPE_CALLBACKS_NOT_SET                                 syscall.Errno = 6836
-	ERROR_TRANSACTION_REQUIRED_PROMOTION                                      syscall.Errno = 6837
-	ERROR_CANNOT_EXECUTE_FILE_IN_TRANSACTION                                  syscall.Errno = 6838
-	ERROR_TRANSACTIONS_NOT_FROZEN                                             syscall.Errno = 6839
-	ERROR_TRANSACTION_FREEZE_IN_PROGRESS                                      syscall.Errno = 6840
-	ERROR_NOT_SNAPSHOT_VOLUME                                                 syscall.Errno = 6841
-	ERROR_NO_SAVEPOINT_WITH_OPEN_FILES                                        syscall.Errno = 6842
-	ERROR_DATA_LOST_REPAIR                                                    syscall.Errno = 6843
-	ERROR_SPARSE_NOT_ALLOWED_IN_TRANSACTION                                   syscall.Errno = 6844
-	ERROR_TM_IDENTITY_MISMATCH                                                syscall.Errno = 6845
-	ERROR_FLOATED_SECTION                                                     syscall.Errno = 6846
-	ERROR_CANNOT_ACCEPT_TRANSACTED_WORK                                       syscall.Errno = 6847
-	ERROR_CANNOT_ABORT_TRANSACTIONS                                           syscall.Errno = 6848
-	ERROR_BAD_CLUSTERS                                                        syscall.Errno = 6849
-	ERROR_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION                              syscall.Errno = 6850
-	ERROR_VOLUME_DIRTY                                                        syscall.Errno = 6851
-	ERROR_NO_LINK_TRACKING_IN_TRANSACTION                                     syscall.Errno = 6852
-	ERROR_OPERATION_NOT_SUPPORTED_IN_TRANSACTION                              syscall.Errno = 6853
-	ERROR_EXPIRED_HANDLE                                                      syscall.Errno = 6854
-	ERROR_TRANSACTION_NOT_ENLISTED                                            syscall.Errno = 6855
-	ERROR_CTX_WINSTATION_NAME_INVALID                                         syscall.Errno = 7001
-	ERROR_CTX_INVALID_PD                                                      syscall.Errno = 7002
-	ERROR_CTX_PD_NOT_FOUND                                                    syscall.Errno = 7003
-	ERROR_CTX_WD_NOT_FOUND                                                    syscall.Errno = 7004
-	ERROR_CTX_CANNOT_MAKE_EVENTLOG_ENTRY                                      syscall.Errno = 7005
-	ERROR_CTX_SERVICE_NAME_COLLISION                                          syscall.Errno = 7006
-	ERROR_CTX_CLOSE_PENDING                                                   syscall.Errno = 7007
-	ERROR_CTX_NO_OUTBUF                                                       syscall.Errno = 7008
-	ERROR_CTX_MODEM_INF_NOT_FOUND                                             syscall.Errno = 7009
-	ERROR_CTX_INVALID_MODEMNAME                                               syscall.Errno = 7010
-	ERROR_CTX_MODEM_RESPONSE_ERROR                                            syscall.Errno = 7011
-	ERROR_CTX_MODEM_RESPONSE_TIMEOUT                                          syscall.Errno = 7012
-	ERROR_CTX_MODEM_RESPONSE_NO_CARRIER                                       syscall.Errno = 7013
-	ERROR_CTX_MODEM_RESPONSE_NO_DIALTONE                                      syscall.Errno = 7014
-	ERROR_CTX_MODEM_RESPONSE_BUSY                                             syscall.Errno = 7015
-	ERROR_CTX_MODEM_RESPONSE_VOICE                                            syscall.Errno = 7016
-	ERROR_CTX_TD_ERROR                                                        syscall.Errno = 7017
-	ERROR_CTX_WINSTATION_NOT_FOUND                                            syscall.Errno = 7022
-	ERROR_CTX_WINSTATION_ALREADY_EXISTS                                       syscall.Errno = 7023
-	ERROR_CTX_WINSTATION_BUSY                                                 syscall.Errno = 7024
-	ERROR_CTX_BAD_VIDEO_MODE                                                  syscall.Errno = 7025
-	ERROR_CTX_GRAPHICS_INVALID                                                syscall.Errno = 7035
-	ERROR_CTX_LOGON_DISABLED                                                  syscall.Errno = 7037
-	ERROR_CTX_NOT_CONSOLE                                                     syscall.Errno = 7038
-	ERROR_CTX_CLIENT_QUERY_TIMEOUT                                            syscall.Errno = 7040
-	ERROR_CTX_CONSOLE_DISCONNECT                                              syscall.Errno = 7041
-	ERROR_CTX_CONSOLE_CONNECT                                                 syscall.Errno = 7042
-	ERROR_CTX_SHADOW_DENIED                                                   syscall.Errno = 7044
-	ERROR_CTX_WINSTATION_ACCESS_DENIED                                        syscall.Errno = 7045
-	ERROR_CTX_INVALID_WD                                                      syscall.Errno = 7049
-	ERROR_CTX_SHADOW_INVALID                                                  syscall.Errno = 7050
-	ERROR_CTX_SHADOW_DISABLED                                                 syscall.Errno = 7051
-	ERROR_CTX_CLIENT_LICENSE_IN_USE                                           syscall.Errno = 7052
-	ERROR_CTX_CLIENT_LICENSE_NOT_SET                                          syscall.Errno = 7053
-	ERROR_CTX_LICENSE_NOT_AVAILABLE                                           syscall.Errno = 7054
-	ERROR_CTX_LICENSE_CLIENT_INVALID                                          syscall.Errno = 7055
-	ERROR_CTX_LICENSE_EXPIRED                                                 syscall.Errno = 7056
-	ERROR_CTX_SHADOW_NOT_RUNNING                                              syscall.Errno = 7057
-	ERROR_CTX_SHADOW_ENDED_BY_MODE_CHANGE                                     syscall.Errno = 7058
-	ERROR_ACTIVATION_COUNT_EXCEEDED                                           syscall.Errno = 7059
-	ERROR_CTX_WINSTATIONS_DISABLED                                            syscall.Errno = 7060
-	ERROR_CTX_ENCRYPTION_LEVEL_REQUIRED                                       syscall.Errno = 7061
-	ERROR_CTX_SESSION_IN_USE                                                  syscall.Errno = 7062
-	ERROR_CTX_NO_FORCE_LOGOFF                                                 syscall.Errno = 7063
-	ERROR_CTX_ACCOUNT_RESTRICTION                                             syscall.Errno = 7064
-	ERROR_RDP_PROTOCOL_ERROR                                                  syscall.Errno = 7065
-	ERROR_CTX_CDM_CONNECT                                                     syscall.Errno = 7066
-	ERROR_CTX_CDM_DISCONNECT                                                  syscall.Errno = 7067
-	ERROR_CTX_SECURITY_LAYER_ERROR                                            syscall.Errno = 7068
-	ERROR_TS_INCOMPATIBLE_SESSIONS                                            syscall.Errno = 7069
-	ERROR_TS_VIDEO_SUBSYSTEM_ERROR                                            syscall.Errno = 7070
-	FRS_ERR_INVALID_API_SEQUENCE                                              syscall.Errno = 8001
-	FRS_ERR_STARTING_SERVICE                                                  syscall.Errno = 8002
-	FRS_ERR_STOPPING_SERVICE                                                  syscall.Errno = 8003
-	FRS_ERR_INTERNAL_API                                                      syscall.Errno = 8004
-	FRS_ERR_INTERNAL                                                          syscall.Errno = 8005
-	FRS_ERR_SERVICE_COMM                                                      syscall.Errno = 8006
-	FRS_ERR_INSUFFICIENT_PRIV                                                 syscall.Errno = 8007
-	FRS_ERR_AUTHENTICATION                                                    syscall.Errno = 8008
-	FRS_ERR_PARENT_INSUFFICIENT_PRIV                                          syscall.Errno = 8009
-	FRS_ERR_PARENT_AUTHENTICATION                                             syscall.Errno = 8010
-	FRS_ERR_CHILD_TO_PARENT_COMM                                              syscall.Errno = 8011
-	FRS_ERR_PARENT_TO_CHILD_COMM                                              syscall.Errno = 8012
-	FRS_ERR_SYSVOL_POPULATE                                                   syscall.Errno = 8013
-	FRS_ERR_SYSVOL_POPULATE_TIMEOUT                                           syscall.Errno = 8014
-	FRS_ERR_SYSVOL_IS_BUSY                                                    syscall.Errno = 8015
-	FRS_ERR_SYSVOL_DEMOTE                                                     syscall.Errno = 8016
-	FRS_ERR_INVALID_SERVICE_PARAMETER                                         syscall.Errno = 8017
-	DS_S_SUCCESS                                                                            = ERROR_SUCCESS
-	ERROR_DS_NOT_INSTALLED                                                    syscall.Errno = 8200
-	ERROR_DS_MEMBERSHIP_EVALUATED_LOCALLY                                     syscall.Errno = 8201
-	ERROR_DS_NO_ATTRIBUTE_OR_VALUE                                            syscall.Errno = 8202
-	ERROR_DS_INVALID_ATTRIBUTE_SYNTAX                                         syscall.Errno = 8203
-	ERROR_DS_ATTRIBUTE_TYPE_UNDEFINED                                         syscall.Errno = 8204
-	ERROR_DS_ATTRIBUTE_OR_VALUE_EXISTS                                        syscall.Errno = 8205
-	ERROR_DS_BUSY                                                             syscall.Errno = 8206
-	ERROR_DS_UNAVAILABLE                                                      syscall.Errno = 8207
-	ERROR_DS_NO_RIDS_ALLOCATED                                                syscall.Errno = 8208
-	ERROR_DS_NO_MORE_RIDS                                                     syscall.Errno = 8209
-	ERROR_DS_INCORRECT_ROLE_OWNER                                             syscall.Errno = 8210
-	ERROR_DS_RIDMGR_INIT_ERROR                                                syscall.Errno = 8211
-	ERROR_DS_OBJ_CLASS_VIOLATION                                              syscall.Errno = 8212
-	ERROR_DS_CANT_ON_NON_LEAF                                                 syscall.Errno = 8213
-	ERROR_DS_CANT_ON_RDN                                                      syscall.Errno = 8214
-	ERROR_DS_CANT_MOD_OBJ_CLASS                                               syscall.Errno = 8215
-	ERROR_DS_CROSS_DOM_MOVE_ERROR                                             syscall.Errno = 8216
-	ERROR_DS_GC_NOT_AVAILABLE                                                 syscall.Errno = 8217
-	ERROR_SHARED_POLICY                                                       syscall.Errno = 8218
-	ERROR_POLICY_OBJECT_NOT_FOUND                                             syscall.Errno = 8219
-	ERROR_POLICY_ONLY_IN_DS                                                   syscall.Errno = 8220
-	ERROR_PROMOTION_ACTIVE                                                    syscall.Errno = 8221
-	ERROR_NO_PROMOTION_ACTIVE                                                 syscall.Errno = 8222
-	ERROR_DS_OPERATIONS_ERROR                                                 syscall.Errno = 8224
-	ERROR_DS_PROTOCOL_ERROR                                                   syscall.Errno = 8225
-	ERROR_DS_TIMELIMIT_EXCEEDED                                               syscall.Errno = 8226
-	ERROR_DS_SIZELIMIT_EXCEEDED                                               syscall.Errno = 8227
-	ERROR_DS_ADMIN_LIMIT_EXCEEDED                                             syscall.Errno = 8228
-	ERROR_DS_COMPARE_FALSE                                                    syscall.Errno = 8229
-	ERROR_DS_COMPARE_TRUE                                                     syscall.Errno = 8230
-	ERROR_DS_AUTH_METHOD_NOT_SUPPORTED                                        syscall.Errno = 8231
-	ERROR_DS_STRONG_AUTH_REQUIRED                                             syscall.Errno = 8232
-	ERROR_DS_INAPPROPRIATE_AUTH                                               syscall.Errno = 8233
-	ERROR_DS_AUTH_UNKNOWN                                                     syscall.Errno = 8234
-	ERROR_DS_REFERRAL                                                         syscall.Errno = 8235
-	ERROR_DS_UNAVAILABLE_CRIT_EXTENSION                                       syscall.Errno = 8236
-	ERROR_DS_CONFIDENTIALITY_REQUIRED                                         syscall.Errno = 8237
-	ERROR_DS_INAPPROPRIATE_MATCHING                                           syscall.Errno = 8238
-	ERROR_DS_CONSTRAINT_VIOLATION                                             syscall.Errno = 8239
-	ERROR_DS_NO_SUCH_OBJECT                                                   syscall.Errno = 8240
-	ERROR_DS_ALIAS_PROBLEM                                                    syscall.Errno = 8241
-	ERROR_DS_INVALID_DN_SYNTAX                                                syscall.Errno = 8242
-	ERROR_DS_IS_LEAF                                                          syscall.Errno = 8243
-	ERROR_DS_ALIAS_DEREF_PROBLEM                                              syscall.Errno = 8244
-	ERROR_DS_UNWILLING_TO_PERFORM                                             syscall.Errno = 8245
-	ERROR_DS_LOOP_DETECT                                                      syscall.Errno = 8246
-	ERROR_DS_NAMING_VIOLATION                                                 syscall.Errno = 8247
-	ERROR_DS_OBJECT_RESULTS_TOO_LARGE                                         syscall.Errno = 8248
-	ERROR_DS_AFFECTS_MULTIPLE_DSAS                                            syscall.Errno = 8249
-	ERROR_DS_SERVER_DOWN                                                      syscall.Errno = 8250
-	ERROR_DS_LOCAL_ERROR                                                      syscall.Errno = 8251
-	ERROR_DS_ENCODING_ERROR                                                   syscall.Errno = 8252
-	ERROR_DS_DECODING_ERROR                                                   syscall.Errno = 8253
-	ERROR_DS_FILTER_UNKNOWN                                                   syscall.Errno = 8254
-	ERROR_DS_PARAM_ERROR                                                      syscall.Errno = 8255
-	ERROR_DS_NOT_SUPPORTED                                                    syscall.Errno = 8256
-	ERROR_DS_NO_RESULTS_RETURNED                                              syscall.Errno = 8257
-	ERROR_DS_CONTROL_NOT_FOUND                                                syscall.Errno = 8258
-	ERROR_DS_CLIENT_LOOP                                                      syscall.Errno = 8259
-	ERROR_DS_REFERRAL_LIMIT_EXCEEDED                                          syscall.Errno = 8260
-	ERROR_DS_SORT_CONTROL_MISSING                                             syscall.Errno = 8261
-	ERROR_DS_OFFSET_RANGE_ERROR                                               syscall.Errno = 8262
-	ERROR_DS_RIDMGR_DISABLED                                                  syscall.Errno = 8263
-	ERROR_DS_ROOT_MUST_BE_NC                                                  syscall.Errno = 8301
-	ERROR_DS_ADD_REPLICA_INHIBITED                                            syscall.Errno = 8302
-	ERROR_DS_ATT_NOT_DEF_IN_SCHEMA                                            syscall.Errno = 8303
-	ERROR_DS_MAX_OBJ_SIZE_EXCEEDED                                            syscall.Errno = 8304
-	ERROR_DS_OBJ_STRING_NAME_EXISTS                                           syscall.Errno = 8305
-	ERROR_DS_NO_RDN_DEFINED_IN_SCHEMA                                         syscall.Errno = 8306
-	ERROR_DS_RDN_DOESNT_MATCH_SCHEMA                                          syscall.Errno = 8307
-	ERROR_DS_NO_REQUESTED_ATTS_FOUND                                          syscall.Errno = 8308
-	ERROR_DS_USER_BUFFER_TO_SMALL                                             syscall.Errno = 8309
-	ERROR_DS_ATT_IS_NOT_ON_OBJ                                                syscall.Errno = 8310
-	ERROR_DS_ILLEGAL_MOD_OPERATION                                            syscall.Errno = 8311
-	ERROR_DS_OBJ_TOO_LARGE                                                    syscall.Errno = 8312
-	ERROR_DS_BAD_INSTANCE_TYPE                                                syscall.Errno = 8313
-	ERROR_DS_MASTERDSA_REQUIRED                                               syscall.Errno = 8314
-	ERROR_DS_OBJECT_CLASS_REQUIRED                                            syscall.Errno = 8315
-	ERROR_DS_MISSING_REQUIRED_ATT                                             syscall.Errno = 8316
-	ERROR_DS_ATT_NOT_DEF_FOR_CLASS                                            syscall.Errno = 8317
-	ERROR_DS_ATT_ALREADY_EXISTS                                               syscall.Errno = 8318
-	ERROR_DS_CANT_ADD_ATT_VALUES                                              syscall.Errno = 8320
-	ERROR_DS_SINGLE_VALUE_CONSTRAINT                                          syscall.Errno = 8321
-	ERROR_DS_RANGE_CONSTRAINT                                                 syscall.Errno = 8322
-	ERROR_DS_ATT_VAL_ALREADY_EXISTS                                           syscall.Errno = 8323
-	ERROR_DS_CANT_REM_MISSING_ATT                                             syscall.Errno = 8324
-	ERROR_DS_CANT_REM_MISSING_ATT_VAL                                         syscall.Errno = 8325
-	ERROR_DS_ROOT_CANT_BE_SUBREF                                              syscall.Errno = 8326
-	ERROR_DS_NO_CHAINING                                                      syscall.Errno = 8327
-	ERROR_DS_NO_CHAINED_EVAL                                                  syscall.Errno = 8328
-	ERROR_DS_NO_PARENT_OBJECT                                                 syscall.Errno = 8329
-	ERROR_DS_PARENT_IS_AN_ALIAS                                               syscall.Errno = 8330
-	ERROR_DS_CANT_MIX_MASTER_AND_REPS                                         syscall.Errno = 8331
-	ERROR_DS_CHILDREN_EXIST                                                   syscall.Errno = 8332
-	ERROR_DS_OBJ_NOT_FOUND                                                    syscall.Errno = 8333
-	ERROR_DS_ALIASED_OBJ_MISSING                                              syscall.Errno = 8334
-	ERROR_DS_BAD_NAME_SYNTAX                                                  syscall.Errno = 8335
-	ERROR_DS_ALIAS_POINTS_TO_ALIAS                                            syscall.Errno = 8336
-	ERROR_DS_CANT_DEREF_ALIAS                                                 syscall.Errno = 8337
-	ERROR_DS_OUT_OF_SCOPE                                                     syscall.Errno = 8338
-	ERROR_DS_OBJECT_BEING_REMOVED                                             syscall.Errno = 8339
-	ERROR_DS_CANT_DELETE_DSA_OBJ                                              syscall.Errno = 8340
-	ERROR_DS_GENERIC_ERROR                                                    syscall.Errno = 8341
-	ERROR_DS_DSA_MUST_BE_INT_MASTER                                           syscall.Errno = 8342
-	ERROR_DS_CLASS_NOT_DSA                                                    syscall.Errno = 8343
-	ERROR_DS_INSUFF_ACCESS_RIGHTS                                             syscall.Errno = 8344
-	ERROR_DS_ILLEGAL_SUPERIOR                                                 syscall.Errno = 8345
-	ERROR_DS_ATTRIBUTE_OWNED_BY_SAM                                           syscall.Errno = 8346
-	ERROR_DS_NAME_TOO_MANY_PARTS                                              syscall.Errno = 8347
-	ERROR_DS_NAME_TOO_LONG                                                    syscall.Errno = 8348
-	ERROR_DS_NAME_VALUE_TOO_LONG                                              syscall.Errno = 8349
-	ERROR_DS_NAME_UNPARSEABLE                                                 syscall.Errno = 8350
-	ERROR_DS_NAME_TYPE_UNKNOWN                                                syscall.Errno = 8351
-	ERROR_DS_NOT_AN_OBJECT                                                    syscall.Errno = 8352
-	ERROR_DS_SEC_DESC_TOO_SHORT                                               syscall.Errno = 8353
-	ERROR_DS_SEC_DESC_INVALID                                                 syscall.Errno = 8354
-	ERROR_DS_NO_DELETED_NAME                                                  syscall.Errno = 8355
-	ERROR_DS_SUBREF_MUST_HAVE_PARENT                                          syscall.Errno = 8356
-	ERROR_DS_NCNAME_MUST_BE_NC                                                syscall.Errno = 8357
-	ERROR_DS_CANT_ADD_SYSTEM_ONLY                                             syscall.Errno = 8358
-	ERROR_DS_CLASS_MUST_BE_CONCRETE                                           syscall.Errno = 8359
-	ERROR_DS_INVALID_DMD                                                      syscall.Errno = 8360
-	ERROR_DS_OBJ_GUID_EXISTS                                                  syscall.Errno = 8361
-	ERROR_DS_NOT_ON_BACKLINK                                                  syscall.Errno = 8362
-	ERROR_DS_NO_CROSSREF_FOR_NC                                               syscall.Errno = 8363
-	ERROR_DS_SHUTTING_DOWN                                                    syscall.Errno = 8364
-	ERROR_DS_UNKNOWN_OPERATION                                                syscall.Errno = 8365
-	ERROR_DS_INVALID_ROLE_OWNER                                               syscall.Errno = 8366
-	ERROR_DS_COULDNT_CONTACT_FSMO                                             syscall.Errno = 8367
-	ERROR_DS_CROSS_NC_DN_RENAME                                               syscall.Errno = 8368
-	ERROR_DS_CANT_MOD_SYSTEM_ONLY                                             syscall.Errno = 8369
-	ERROR_DS_REPLICATOR_ONLY                                                  syscall.Errno = 8370
-	ERROR_DS_OBJ_CLASS_NOT_DEFINED                                            syscall.Errno = 8371
-	ERROR_DS_OBJ_CLASS_NOT_SUBCLASS                                           syscall.Errno = 8372
-	ERROR_DS_NAME_REFERENCE_INVALID                                           syscall.Errno = 8373
-	ERROR_DS_CROSS_REF_EXISTS                                                 syscall.Errno = 8374
-	ERROR_DS_CANT_DEL_MASTER_CROSSREF                                         syscall.Errno = 8375
-	ERROR_DS_SUBTREE_NOTIFY_NOT_NC_HEAD                                       syscall.Errno = 8376
-	ERROR_DS_NOTIFY_FILTER_TOO_COMPLEX                                        syscall.Errno = 8377
-	ERROR_DS_DUP_RDN                                                          syscall.Errno = 8378
-	ERROR_DS_DUP_OID                                                          syscall.Errno = 8379
-	ERROR_DS_DUP_MAPI_ID                                                      syscall.Errno = 8380
-	ERROR_DS_DUP_SCHEMA_ID_GUID                                               syscall.Errno = 8381
-	ERROR_DS_DUP_LDAP_DISPLAY_NAME                                            syscall.Errno = 8382
-	ERROR_DS_SEMANTIC_ATT_TEST                                                syscall.Errno = 8383
-	ERROR_DS_SYNTAX_MISMATCH                                                  syscall.Errno = 8384
-	ERROR_DS_EXISTS_IN_MUST_HAVE                                              syscall.Errno = 8385
-	ERROR_DS_EXISTS_IN_MAY_HAVE                                               syscall.Errno = 8386
-	ERROR_DS_NONEXISTENT_MAY_HAVE                                             syscall.Errno = 8387
-	ERROR_DS_NONEXISTENT_MUST_HAVE                                            syscall.Errno = 8388
-	ERROR_DS_AUX_CLS_TEST_FAIL                                                syscall.Errno = 8389
-	ERROR_DS_NONEXISTENT_POSS_SUP                                             syscall.Errno = 8390
-	ERROR_DS_SUB_CLS_TEST_FAIL                                                syscall.Errno = 8391
-	ERROR_DS_BAD_RDN_ATT_ID_SYNTAX                                            syscall.Errno = 8392
-	ERROR_DS_EXISTS_IN_AUX_CLS                                                syscall.Errno = 8393
-	ERROR_DS_EXISTS_IN_SUB_CLS                                                syscall.Errno = 8394
-	ERROR_DS_EXISTS_IN_POSS_SUP                                               syscall.Errno = 8395
-	ERROR_DS_RECALCSCHEMA_FAILED                                              syscall.Errno = 8396
-	ERROR_DS_TREE_DELETE_NOT_FINISHED                                         syscall.Errno = 8397
-	ERROR_DS_CANT_DELETE                                                      syscall.Errno = 8398
-	ERROR_DS_ATT_SCHEMA_REQ_ID                                                syscall.Errno = 8399
-	ERROR_DS_BAD_ATT_SCHEMA_SYNTAX                                            syscall.Errno = 8400
-	ERROR_DS_CANT_CACHE_ATT                                                   syscall.Errno = 8401
-	ERROR_DS_CANT_CACHE_CLASS                                                 syscall.Errno = 8402
-	ERROR_DS_CANT_REMOVE_ATT_CACHE                                            syscall.Errno = 8403
-	ERROR_DS_CANT_REMOVE_CLASS_CACHE                                          syscall.Errno = 8404
-	ERROR_DS_CANT_RETRIEVE_DN                                                 syscall.Errno = 8405
-	ERROR_DS_MISSING_SUPREF                                                   syscall.Errno = 8406
-	ERROR_DS_CANT_RETRIEVE_INSTANCE                                           syscall.Errno = 8407
-	ERROR_DS_CODE_INCONSISTENCY                                               syscall.Errno = 8408
-	ERROR_DS_DATABASE_ERROR                                                   syscall.Errno = 8409
-	ERROR_DS_GOVERNSID_MISSING                                                syscall.Errno = 8410
-	ERROR_DS_MISSING_EXPECTED_ATT                                             syscall.Errno = 8411
-	ERROR_DS_NCNAME_MISSING_CR_REF                                            syscall.Errno = 8412
-	ERROR_DS_SECURITY_CHECKING_ERROR                                          syscall.Errno = 8413
-	ERROR_DS_SCHEMA_NOT_LOADED                                                syscall.Errno = 8414
-	ERROR_DS_SCHEMA_ALLOC_FAILED                                              syscall.Errno = 8415
-	ERROR_DS_ATT_SCHEMA_REQ_SYNTAX                                            syscall.Errno = 8416
-	ERROR_DS_GCVERIFY_ERROR                                                   syscall.Errno = 8417
-	ERROR_DS_DRA_SCHEMA_MISMATCH                                              syscall.Errno = 8418
-	ERROR_DS_CANT_FIND_DSA_OBJ                                                syscall.Errno = 8419
-	ERROR_DS_CANT_FIND_EXPECTED_NC                                            syscall.Errno = 8420
-	ERROR_DS_CANT_FIND_NC_IN_CACHE                                            syscall.Errno = 8421
-	ERROR_DS_CANT_RETRIEVE_CHILD                                              syscall.Errno = 8422
-	ERROR_DS_SECURITY_ILLEGAL_MODIFY                                          syscall.Errno = 8423
-	ERROR_DS_CANT_REPLACE_HIDDEN_REC                                          syscall.Errno = 8424
-	ERROR_DS_BAD_HIERARCHY_FILE                                               syscall.Errno = 8425
-	ERROR_DS_BUILD_HIERARCHY_TABLE_FAILED                                     syscall.Errno = 8426
-	ERROR_DS_CONFIG_PARAM_MISSING                                             syscall.Errno = 8427
-	ERROR_DS_COUNTING_AB_INDICES_FAILED                                       syscall.Errno = 8428
-	ERROR_DS_HIERARCHY_TABLE_MALLOC_FAILED                                    syscall.Errno = 8429
-	ERROR_DS_INTERNAL_FAILURE                                                 syscall.Errno = 8430
-	ERROR_DS_UNKNOWN_ERROR                                                    syscall.Errno = 8431
-	ERROR_DS_ROOT_REQUIRES_CLASS_TOP                                          syscall.Errno = 8432
-	ERROR_DS_REFUSING_FSMO_ROLES                                              syscall.Errno = 8433
-	ERROR_DS_MISSING_FSMO_SETTINGS                                            syscall.Errno = 8434
-	ERROR_DS_UNABLE_TO_SURRENDER_ROLES                                        syscall.Errno = 8435
-	ERROR_DS_DRA_GENERIC                                                      syscall.Errno = 8436
-	ERROR_DS_DRA_INVALID_PARAMETER                                            syscall.Errno = 8437
-	ERROR_DS_DRA_BUSY                                                         syscall.Errno = 8438
-	ERROR_DS_DRA_BAD_DN                                                       syscall.Errno = 8439
-	ERROR_DS_DRA_BAD_NC                                                       syscall.Errno = 8440
-	ERROR_DS_DRA_DN_EXISTS                                                    syscall.Errno = 8441
-	ERROR_DS_DRA_INTERNAL_ERROR                                               syscall.Errno = 8442
-	ERROR_DS_DRA_INCONSISTENT_DIT                                             syscall.Errno = 8443
-	ERROR_DS_DRA_CONNECTION_FAILED                                            syscall.Errno = 8444
-	ERROR_DS_DRA_BAD_INSTANCE_TYPE                                            syscall.Errno = 8445
-	ERROR_DS_DRA_OUT_OF_MEM                                                   syscall.Errno = 8446
-	ERROR_DS_DRA_MAIL_PROBLEM                                                 syscall.Errno = 8447
-	ERROR_DS_DRA_REF_ALREADY_EXISTS                                           syscall.Errno = 8448
-	ERROR_DS_DRA_REF_NOT_FOUND                                                syscall.Errno = 8449
-	ERROR_DS_DRA_OBJ_IS_REP_SOURCE                                            syscall.Errno = 8450
-	ERROR_DS_DRA_DB_ERROR                                                     syscall.Errno = 8451
-	ERROR_DS_DRA_NO_REPLICA                                                   syscall.Errno = 8452
-	ERROR_DS_DRA_ACCESS_DENIED                                                syscall.Errno = 8453
-	ERROR_DS_DRA_NOT_SUPPORTED                                                syscall.Errno = 8454
-	ERROR_DS_DRA_RPC_CANCELLED                                                syscall.Errno = 8455
-	ERROR_DS_DRA_SOURCE_DISABLED                                              syscall.Errno = 8456
-	ERROR_DS_DRA_SINK_DISABLED                                                syscall.Errno = 8457
-	ERROR_DS_DRA_NAME_COLLISION                                               syscall.Errno = 8458
-	ERROR_DS_DRA_SOURCE_REINSTALLED                                           syscall.Errno = 8459
-	ERROR_DS_DRA_MISSING_PARENT                                               syscall.Errno = 8460
-	ERROR_DS_DRA_PREEMPTED                                                    syscall.Errno = 8461
-	ERROR_DS_DRA_ABANDON_SYNC                                                 syscall.Errno = 8462
-	ERROR_DS_DRA_SHUTDOWN                                                     syscall.Errno = 8463
-	ERROR_DS_DRA_INCOMPATIBLE_PARTIAL_SET                                     syscall.Errno = 8464
-	ERROR_DS_DRA_SOURCE_IS_PARTIAL_REPLICA                                    syscall.Errno = 8465
-	ERROR_DS_DRA_EXTN_CONNECTION_FAILED                                       syscall.Errno = 8466
-	ERROR_DS_INSTALL_SCHEMA_MISMATCH                                          syscall.Errno = 8467
-	ERROR_DS_DUP_LINK_ID                                                      syscall.Errno = 8468
-	ERROR_DS_NAME_ERROR_RESOLVING                                             syscall.Errno = 8469
-	ERROR_DS_NAME_ERROR_NOT_FOUND                                             syscall.Errno = 8470
-	ERROR_DS_NAME_ERROR_NOT_UNIQUE                                            syscall.Errno = 8471
-	ERROR_DS_NAME_ERROR_NO_MAPPING                                            syscall.Errno = 8472
-	ERROR_DS_NAME_ERROR_DOMAIN_ONLY                                           syscall.Errno = 8473
-	ERROR_DS_NAME_ERROR_NO_SYNTACTICAL_MAPPING                                syscall.Errno = 8474
-	ERROR_DS_CONSTRUCTED_ATT_MOD                                              syscall.Errno = 8475
-	ERROR_DS_WRONG_OM_OBJ_CLASS                                               syscall.Errno = 8476
-	ERROR_DS_DRA_REPL_PENDING                                                 syscall.Errno = 8477
-	ERROR_DS_DS_REQUIRED                                                      syscall.Errno = 8478
-	ERROR_DS_INVALID_LDAP_DISPLAY_NAME                                        syscall.Errno = 8479
-	ERROR_DS_NON_BASE_SEARCH                                                  syscall.Errno = 8480
-	ERROR_DS_CANT_RETRIEVE_ATTS                                               syscall.Errno = 8481
-	ERROR_DS_BACKLINK_WITHOUT_LINK                                            syscall.Errno = 8482
-	ERROR_DS_EPOCH_MISMATCH                                                   syscall.Errno = 8483
-	ERROR_DS_SRC_NAME_MISMATCH                                                syscall.Errno = 8484
-	ERROR_DS_SRC_AND_DST_NC_IDENTICAL                                         syscall.Errno = 8485
-	ERROR_DS_DST_NC_MISMATCH                                                  syscall.Errno = 8486
-	ERROR_DS_NOT_AUTHORITIVE_FOR_DST_NC                                       syscall.Errno = 8487
-	ERROR_DS_SRC_GUID_MISMATCH                                                syscall.Errno = 8488
-	ERROR_DS_CANT_MOVE_DELETED_OBJECT                                         syscall.Errno = 8489
-	ERROR_DS_PDC_OPERATION_IN_PROGRESS                                        syscall.Errno = 8490
-	ERROR_DS_CROSS_DOMAIN_CLEANUP_REQD                                        syscall.Errno = 8491
-	ERROR_DS_ILLEGAL_XDOM_MOVE_OPERATION                                      syscall.Errno = 8492
-	ERROR_DS_CANT_WITH_ACCT_GROUP_MEMBERSHPS                                  syscall.Errno = 8493
-	ERROR_DS_NC_MUST_HAVE_NC_PARENT                                           syscall.Errno = 8494
-	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE                                        syscall.Errno = 8495
-	ERROR_DS_DST_DOMAIN_NOT_NATIVE                                            syscall.Errno = 8496
-	ERROR_DS_MISSING_INFRASTRUCTURE_CONTAINER                                 syscall.Errno = 8497
-	ERROR_DS_CANT_MOVE_ACCOUNT_GROUP                                          syscall.Errno = 8498
-	ERROR_DS_CANT_MOVE_RESOURCE_GROUP                                         syscall.Errno = 8499
-	ERROR_DS_INVALID_SEARCH_FLAG                                              syscall.Errno = 8500
-	ERROR_DS_NO_TREE_DELETE_ABOVE_NC                                          syscall.Errno = 8501
-	ERROR_DS_COULDNT_LOCK_TREE_FOR_DELETE                                     syscall.Errno = 8502
-	ERROR_DS_COULDNT_IDENTIFY_OBJECTS_FOR_TREE_DELETE                         syscall.Errno = 8503
-	ERROR_DS_SAM_INIT_FAILURE                                                 syscall.Errno = 8504
-	ERROR_DS_SENSITIVE_GROUP_VIOLATION                                        syscall.Errno = 8505
-	ERROR_DS_CANT_MOD_PRIMARYGROUPID                                          syscall.Errno = 8506
-	ERROR_DS_ILLEGAL_BASE_SCHEMA_MOD                                          syscall.Errno = 8507
-	ERROR_DS_NONSAFE_SCHEMA_CHANGE                                            syscall.Errno = 8508
-	ERROR_DS_SCHEMA_UPDATE_DISALLOWED                                         syscall.Errno = 8509
-	ERROR_DS_CANT_CREATE_UNDER_SCHEMA                                         syscall.Errno = 8510
-	ERROR_DS_INSTALL_NO_SRC_SCH_VERSION                                       syscall.Errno = 8511
-	ERROR_DS_INSTALL_NO_SCH_VERSION_IN_INIFILE                                syscall.Errno = 8512
-	ERROR_DS_INVALID_GROUP_TYPE                                               syscall.Errno = 8513
-	ERROR_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN                               syscall.Errno = 8514
-	ERROR_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN                                syscall.Errno = 8515
-	ERROR_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER                                    syscall.Errno = 8516
-	ERROR_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER                                syscall.Errno = 8517
-	ERROR_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER                                 syscall.Errno = 8518
-	ERROR_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER                              syscall.Errno = 8519
-	ERROR_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER                         syscall.Errno = 8520
-	ERROR_DS_HAVE_PRIMARY_MEMBERS                                             syscall.Errno = 8521
-	ERROR_DS_STRING_SD_CONVERSION_FAILED                                      syscall.Errno = 8522
-	ERROR_DS_NAMING_MASTER_GC                                                 syscall.Errno = 8523
-	ERROR_DS_DNS_LOOKUP_FAILURE                                               syscall.Errno = 8524
-	ERROR_DS_COULDNT_UPDATE_SPNS                                              syscall.Errno = 8525
-	ERROR_DS_CANT_RETRIEVE_SD                                                 syscall.Errno = 8526
-	ERROR_DS_KEY_NOT_UNIQUE                                                   syscall.Errno = 8527
-	ERROR_DS_WRONG_LINKED_ATT_SYNTAX                                          syscall.Errno = 8528
-	ERROR_DS_SAM_NEED_BOOTKEY_PASSWORD                                        syscall.Errno = 8529
-	ERROR_DS_SAM_NEED_BOOTKEY_FLOPPY                                          syscall.Errno = 8530
-	ERROR_DS_CANT_START                                                       syscall.Errno = 8531
-	ERROR_DS_INIT_FAILURE                                                     syscall.Errno = 8532
-	ERROR_DS_NO_PKT_PRIVACY_ON_CONNECTION                                     syscall.Errno = 8533
-	ERROR_DS_SOURCE_DOMAIN_IN_FOREST                                          syscall.Errno = 8534
-	ERROR_DS_DESTINATION_DOMAIN_NOT_IN_FOREST                                 syscall.Errno = 8535
-	ERROR_DS_DESTINATION_AUDITING_NOT_ENABLED                                 syscall.Errno = 8536
-	ERROR_DS_CANT_FIND_DC_FOR_SRC_DOMAIN                                      syscall.Errno = 8537
-	ERROR_DS_SRC_OBJ_NOT_GROUP_OR_USER                                        syscall.Errno = 8538
-	ERROR_DS_SRC_SID_EXISTS_IN_FOREST                                         syscall.Errno = 8539
-	ERROR_DS_SRC_AND_DST_OBJECT_CLASS_MISMATCH                                syscall.Errno = 8540
-	ERROR_SAM_INIT_FAILURE                                                    syscall.Errno = 8541
-	ERROR_DS_DRA_SCHEMA_INFO_SHIP                                             syscall.Errno = 8542
-	ERROR_DS_DRA_SCHEMA_CONFLICT                                              syscall.Errno = 8543
-	ERROR_DS_DRA_EARLIER_SCHEMA_CONFLICT                                      syscall.Errno = 8544
-	ERROR_DS_DRA_OBJ_NC_MISMATCH                                              syscall.Errno = 8545
-	ERROR_DS_NC_STILL_HAS_DSAS                                                syscall.Errno = 8546
-	ERROR_DS_GC_REQUIRED                                                      syscall.Errno = 8547
-	ERROR_DS_LOCAL_MEMBER_OF_LOCAL_ONLY                                       syscall.Errno = 8548
-	ERROR_DS_NO_FPO_IN_UNIVERSAL_GROUPS                                       syscall.Errno = 8549
-	ERROR_DS_CANT_ADD_TO_GC                                                   syscall.Errno = 8550
-	ERROR_DS_NO_CHECKPOINT_WITH_PDC                                           syscall.Errno = 8551
-	ERROR_DS_SOURCE_AUDITING_NOT_ENABLED                                      syscall.Errno = 8552
-	ERROR_DS_CANT_CREATE_IN_NONDOMAIN_NC                                      syscall.Errno = 8553
-	ERROR_DS_INVALID_NAME_FOR_SPN                                             syscall.Errno = 8554
-	ERROR_DS_FILTER_USES_CONTRUCTED_ATTRS                                     syscall.Errno = 8555
-	ERROR_DS_UNICODEPWD_NOT_IN_QUOTES                                         syscall.Errno = 8556
-	ERROR_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED                                   syscall.Errno = 8557
-	ERROR_DS_MUST_BE_RUN_ON_DST_DC                                            syscall.Errno = 8558
-	ERROR_DS_SRC_DC_MUST_BE_SP4_OR_GREATER                                    syscall.Errno = 8559
-	ERROR_DS_CANT_TREE_DELETE_CRITICAL_OBJ                                    syscall.Errno = 8560
-	ERROR_DS_INIT_FAILURE_CONSOLE                                             syscall.Errno = 8561
-	ERROR_DS_SAM_INIT_FAILURE_CONSOLE                                         syscall.Errno = 8562
-	ERROR_DS_FOREST_VERSION_TOO_HIGH                                          syscall.Errno = 8563
-	ERROR_DS_DOMAIN_VERSION_TOO_HIGH                                          syscall.Errno = 8564
-	ERROR_DS_FOREST_VERSION_TOO_LOW                                           syscall.Errno = 8565
-	ERROR_DS_DOMAIN_VERSION_TOO_LOW                                           syscall.Errno = 8566
-	ERROR_DS_INCOMPATIBLE_VERSION                                             syscall.Errno = 8567
-	ERROR_DS_LOW_DSA_VERSION                                                  syscall.Errno = 8568
-	ERROR_DS_NO_BEHAVIOR_VERSION_IN_MIXEDDOMAIN                               syscall.Errno = 8569
-	ERROR_DS_NOT_SUPPORTED_SORT_ORDER                                         syscall.Errno = 8570
-	ERROR_DS_NAME_NOT_UNIQUE                                                  syscall.Errno = 8571
-	ERROR_DS_MACHINE_ACCOUNT_CREATED_PRENT4                                   syscall.Errno = 8572
-	ERROR_DS_OUT_OF_VERSION_STORE                                             syscall.Errno = 8573
-	ERROR_DS_INCOMPATIBLE_CONTROLS_USED                                       syscall.Errno = 8574
-	ERROR_DS_NO_REF_DOMAIN                                                    syscall.Errno = 8575
-	ERROR_DS_RESERVED_LINK_ID                                                 syscall.Errno = 8576
-	ERROR_DS_LINK_ID_NOT_AVAILABLE                                            syscall.Errno = 8577
-	ERROR_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER                                    syscall.Errno = 8578
-	ERROR_DS_MODIFYDN_DISALLOWED_BY_INSTANCE_TYPE                             syscall.Errno = 8579
-	ERROR_DS_NO_OBJECT_MOVE_IN_SCHEMA_NC                                      syscall.Errno = 8580
-	ERROR_DS_MODIFYDN_DISALLOWED_BY_FLAG                                      syscall.Errno = 8581
-	ERROR_DS_MODIFYDN_WRONG_GRANDPARENT                                       syscall.Errno = 8582
-	ERROR_DS_NAME_ERROR_TRUST_REFERRAL                                        syscall.Errno = 8583
-	ERROR_NOT_SUPPORTED_ON_STANDARD_SERVER                                    syscall.Errno = 8584
-	ERROR_DS_CANT_ACCESS_REMOTE_PART_OF_AD                                    syscall.Errno = 8585
-	ERROR_DS_CR_IMPOSSIBLE_TO_VALIDATE_V2                                     syscall.Errno = 8586
-	ERROR_DS_THREAD_LIMIT_EXCEEDED                                            syscall.Errno = 8587
-	ERROR_DS_NOT_CLOSEST                                                      syscall.Errno = 8588
-	ERROR_DS_CANT_DERIVE_SPN_WITHOUT_SERVER_REF                               syscall.Errno = 8589
-	ERROR_DS_SINGLE_USER_MODE_FAILED                                          syscall.Errno = 8590
-	ERROR_DS_NTDSCRIPT_SYNTAX_ERROR                                           syscall.Errno = 8591
-	ERROR_DS_NTDSCRIPT_PROCESS_ERROR                                          syscall.Errno = 8592
-	ERROR_DS_DIFFERENT_REPL_EPOCHS                                            syscall.Errno = 8593
-	ERROR_DS_DRS_EXTENSIONS_CHANGED                                           syscall.Errno = 8594
-	ERROR_DS_REPLICA_SET_CHANGE_NOT_ALLOWED_ON_DISABLED_CR                    syscall.Errno = 8595
-	ERROR_DS_NO_MSDS_INTID                                                    syscall.Errno = 8596
-	ERROR_DS_DUP_MSDS_INTID                                                   syscall.Errno = 8597
-	ERROR_DS_EXISTS_IN_RDNATTID                                               syscall.Errno = 8598
-	ERROR_DS_AUTHORIZATION_FAILED                                             syscall.Errno = 8599
-	ERROR_DS_INVALID_SCRIPT                                                   syscall.Errno = 8600
-	ERROR_DS_REMOTE_CROSSREF_OP_FAILED                                        syscall.Errno = 8601
-	ERROR_DS_CROSS_REF_BUSY                                                   syscall.Errno = 8602
-	ERROR_DS_CANT_DERIVE_SPN_FOR_DELETED_DOMAIN                               syscall.Errno = 8603
-	ERROR_DS_CANT_DEMOTE_WITH_WRITEABLE_NC                                    syscall.Errno = 8604
-	ERROR_DS_DUPLICATE_ID_FOUND                                               syscall.Errno = 8605
-	ERROR_DS_INSUFFICIENT_ATTR_TO_CREATE_OBJECT                               syscall.Errno = 8606
-	ERROR_DS_GROUP_CONVERSION_ERROR                                           syscall.Errno = 8607
-	ERROR_DS_CANT_MOVE_APP_BASIC_GROUP                                        syscall.Errno = 8608
-	ERROR_DS_CANT_MOVE_APP_QUERY_GROUP                                        syscall.Errno = 8609
-	ERROR_DS_ROLE_NOT_VERIFIED                                                syscall.Errno = 8610
-	ERROR_DS_WKO_CONTAINER_CANNOT_BE_SPECIAL                                  syscall.Errno = 8611
-	ERROR_DS_DOMAIN_RENAME_IN_PROGRESS                                        syscall.Errno = 8612
-	ERROR_DS_EXISTING_AD_CHILD_NC                                             syscall.Errno = 8613
-	ERROR_DS_REPL_LIFETIME_EXCEEDED                                           syscall.Errno = 8614
-	ERROR_DS_DISALLOWED_IN_SYSTEM_CONTAINER                                   syscall.Errno = 8615
-	ERROR_DS_LDAP_SEND_QUEUE_FULL                                             syscall.Errno = 8616
-	ERROR_DS_DRA_OUT_SCHEDULE_WINDOW                                          syscall.Errno = 8617
-	ERROR_DS_POLICY_NOT_KNOWN                                                 syscall.Errno = 8618
-	ERROR_NO_SITE_SETTINGS_OBJECT                                             syscall.Errno = 8619
-	ERROR_NO_SECRETS                                                          syscall.Errno = 8620
-	ERROR_NO_WRITABLE_DC_FOUND                                                syscall.Errno = 8621
-	ERROR_DS_NO_SERVER_OBJECT                                                 syscall.Errno = 8622
-	ERROR_DS_NO_NTDSA_OBJECT                                                  syscall.Errno = 8623
-	ERROR_DS_NON_ASQ_SEARCH                                                   syscall.Errno = 8624
-	ERROR_DS_AUDIT_FAILURE                                                    syscall.Errno = 8625
-	ERROR_DS_INVALID_SEARCH_FLAG_SUBTREE                                      syscall.Errno = 8626
-	ERROR_DS_INVALID_SEARCH_FLAG_TUPLE                                        syscall.Errno = 8627
-	ERROR_DS_HIERARCHY_TABLE_TOO_DEEP                                         syscall.Errno = 8628
-	ERROR_DS_DRA_CORRUPT_UTD_VECTOR                                           syscall.Errno = 8629
-	ERROR_DS_DRA_SECRETS_DENIED                                               syscall.Errno = 8630
-	ERROR_DS_RESERVED_MAPI_ID                                                 syscall.Errno = 8631
-	ERROR_DS_MAPI_ID_NOT_AVAILABLE                                            syscall.Errno = 8632
-	ERROR_DS_DRA_MISSING_KRBTGT_SECRET                                        syscall.Errno = 8633
-	ERROR_DS_DOMAIN_NAME_EXISTS_IN_FOREST                                     syscall.Errno = 8634
-	ERROR_DS_FLAT_NAME_EXISTS_IN_FOREST                                       syscall.Errno = 8635
-	ERROR_INVALID_USER_PRINCIPAL_NAME                                         syscall.Errno = 8636
-	ERROR_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS                               syscall.Errno = 8637
-	ERROR_DS_OID_NOT_FOUND                                                    syscall.Errno = 8638
-	ERROR_DS_DRA_RECYCLED_TARGET                                              syscall.Errno = 8639
-	ERROR_DS_DISALLOWED_NC_REDIRECT                                           syscall.Errno = 8640
-	ERROR_DS_HIGH_ADLDS_FFL                                                   syscall.Errno = 8641
-	ERROR_DS_HIGH_DSA_VERSION                                                 syscall.Errno = 8642
-	ERROR_DS_LOW_ADLDS_FFL                                                    syscall.Errno = 8643
-	ERROR_DOMAIN_SID_SAME_AS_LOCAL_WORKSTATION                                syscall.Errno = 8644
-	ERROR_DS_UNDELETE_SAM_VALIDATION_FAILED                                   syscall.Errno = 8645
-	ERROR_INCORRECT_ACCOUNT_TYPE                                              syscall.Errno = 8646
-	ERROR_DS_SPN_VALUE_NOT_UNIQUE_IN_FOREST                                   syscall.Errno = 8647
-	ERROR_DS_UPN_VALUE_NOT_UNIQUE_IN_FOREST                                   syscall.Errno = 8648
-	ERROR_DS_MISSING_FOREST_TRUST                                             syscall.Errno = 8649
-	ERROR_DS_VALUE_KEY_NOT_UNIQUE                                             syscall.Errno = 8650
-	DNS_ERROR_RESPONSE_CODES_BASE                                             syscall.Errno = 9000
-	DNS_ERROR_RCODE_NO_ERROR                                                                = ERROR_SUCCESS
-	DNS_ERROR_MASK                                                            syscall.Errno = 0x00002328
-	DNS_ERROR_RCODE_FORMAT_ERROR                                              syscall.Errno = 9001
-	DNS_ERROR_RCODE_SERVER_FAILURE                                            syscall.Errno = 9002
-	DNS_ERROR_RCODE_NAME_ERROR                                                syscall.Errno = 9003
-	DNS_ERROR_RCODE_NOT_IMPLEMENTED                                           syscall.Errno = 9004
-	DNS_ERROR_RCODE_REFUSED                                                   syscall.Errno = 9005
-	DNS_ERROR_RCODE_YXDOMAIN                                                  syscall.Errno = 9006
-	DNS_ERROR_RCODE_YXRRSET                                                   syscall.Errno = 9007
-	DNS_ERROR_RCODE_NXRRSET                                                   syscall.Errno = 9008
-	DNS_ERROR_RCODE_NOTAUTH                                                   syscall.Errno = 9009
-	DNS_ERROR_RCODE_NOTZONE                                                   syscall.Errno = 9010
-	DNS_ERROR_RCODE_BADSIG                                                    syscall.Errno = 9016
-	DNS_ERROR_RCODE_BADKEY                                                    syscall.Errno = 9017
-	DNS_ERROR_RCODE_BADTIME                                                   syscall.Errno = 9018
-	DNS_ERROR_RCODE_LAST                                                                    = DNS_ERROR_RCODE_BADTIME
-	DNS_ERROR_DNSSEC_BASE                                                     syscall.Errno = 9100
-	DNS_ERROR_KEYMASTER_REQUIRED                                              syscall.Errno = 9101
-	DNS_ERROR_NOT_ALLOWED_ON_SIGNED_ZONE                                      syscall.Errno = 9102
-	DNS_ERROR_NSEC3_INCOMPATIBLE_WITH_RSA_SHA1                                syscall.Errno = 9103
-	DNS_ERROR_NOT_ENOUGH_SIGNING_KEY_DESCRIPTORS                              syscall.Errno = 9104
-	DNS_ERROR_UNSUPPORTED_ALGORITHM                                           syscall.Errno = 9105
-	DNS_ERROR_INVALID_KEY_SIZE                                                syscall.Errno = 9106
-	DNS_ERROR_SIGNING_KEY_NOT_ACCESSIBLE                                      syscall.Errno = 9107
-	DNS_ERROR_KSP_DOES_NOT_SUPPORT_PROTECTION                                 syscall.Errno = 9108
-	DNS_ERROR_UNEXPECTED_DATA_PROTECTION_ERROR                                syscall.Errno = 9109
-	DNS_ERROR_UNEXPECTED_CNG_ERROR                                            syscall.Errno = 9110
-	DNS_ERROR_UNKNOWN_SIGNING_PARAMETER_VERSION                               syscall.Errno = 9111
-	DNS_ERROR_KSP_NOT_ACCESSIBLE                                              syscall.Errno = 9112
-	DNS_ERROR_TOO_MANY_SKDS                                                   syscall.Errno = 9113
-	DNS_ERROR_INVALID_ROLLOVER_PERIOD                                         syscall.Errno = 9114
-	DNS_ERROR_INVALID_INITIAL_ROLLOVER_OFFSET                                 syscall.Errno = 9115
-	DNS_ERROR_ROLLOVER_IN_PROGRESS                                            syscall.Errno = 9116
-	DNS_ERROR_STANDBY_KEY_NOT_PRESENT                                         syscall.Errno = 9117
-	DNS_ERROR_NOT_ALLOWED_ON_ZSK                                              syscall.Errno = 9118
-	DNS_ERROR_NOT_ALLOWED_ON_ACTIVE_SKD                                       syscall.Errno = 9119
-	DNS_ERROR_ROLLOVER_ALREADY_QUEUED                                         syscall.Errno = 9120
-	DNS_ERROR_NOT_ALLOWED_ON_UNSIGNED_ZONE                                    syscall.Errno = 9121
-	DNS_ERROR_BAD_KEYMASTER                                                   syscall.Errno = 9122
-	DNS_ERROR_INVALID_SIGNATURE_VALIDITY_PERIOD                               syscall.Errno = 9123
-	DNS_ERROR_INVALID_NSEC3_ITERATION_COUNT                                   syscall.Errno = 9124
-	DNS_ERROR_DNSSEC_IS_DISABLED                                              syscall.Errno = 9125
-	DNS_ERROR_INVALID_XML                                                     syscall.Errno = 9126
-	DNS_ERROR_NO_VALID_TRUST_ANCHORS                                          syscall.Errno = 9127
-	DNS_ERROR_ROLLOVER_NOT_POKEABLE                                           syscall.Errno = 9128
-	DNS_ERROR_NSEC3_NAME_COLLISION                                            syscall.Errno = 9129
-	DNS_ERROR_NSEC_INCOMPATIBLE_WITH_NSEC3_RSA_SHA1                           syscall.Errno = 9130
-	DNS_ERROR_PACKET_FMT_BASE                                                 syscall.Errno = 9500
-	DNS_INFO_NO_RECORDS                                                       syscall.Errno = 9501
-	DNS_ERROR_BAD_PACKET                                                      syscall.Errno = 9502
-	DNS_ERROR_NO_PACKET                                                       syscall.Errno = 9503
-	DNS_ERROR_RCODE                                                           syscall.Errno = 9504
-	DNS_ERROR_UNSECURE_PACKET                                                 syscall.Errno = 9505
-	DNS_STATUS_PACKET_UNSECURE                                                              = DNS_ERROR_UNSECURE_PACKET
-	DNS_REQUEST_PENDING                                                       syscall.Errno = 9506
-	DNS_ERROR_NO_MEMORY                                                                     = ERROR_OUTOFMEMORY
-	DNS_ERROR_INVALID_NAME                                                                  = ERROR_INVALID_NAME
-	DNS_ERROR_INVALID_DATA                                                                  = ERROR_INVALID_DATA
-	DNS_ERROR_GENERAL_API_BASE                                                syscall.Errno = 9550
-	DNS_ERROR_INVALID_TYPE                                                    syscall.Errno = 9551
-	DNS_ERROR_INVALID_IP_ADDRESS                                              syscall.Errno = 9552
-	DNS_ERROR_INVALID_PROPERTY                                                syscall.Errno = 9553
-	DNS_ERROR_TRY_AGAIN_LATER                                                 syscall.Errno = 9554
-	DNS_ERROR_NOT_UNIQUE                                                      syscall.Errno = 9555
-	DNS_ERROR_NON_RFC_NAME                                                    syscall.Errno = 9556
-	DNS_STATUS_FQDN                                                           syscall.Errno = 9557
-	DNS_STATUS_DOTTED_NAME                                                    syscall.Errno = 9558
-	DNS_STATUS_SINGLE_PART_NAME                                               syscall.Errno = 9559
-	DNS_ERROR_INVALID_NAME_CHAR                                               syscall.Errno = 9560
-	DNS_ERROR_NUMERIC_NAME                                                    syscall.Errno = 9561
-	DNS_ERROR_NOT_ALLOWED_ON_ROOT_SERVER                                      syscall.Errno = 9562
-	DNS_ERROR_NOT_ALLOWED_UNDER_DELEGATION                                    syscall.Errno = 9563
-	DNS_ERROR_CANNOT_FIND_ROOT_HINTS                                          syscall.Errno = 9564
-	DNS_ERROR_INCONSISTENT_ROOT_HINTS                                         syscall.Errno = 9565
-	DNS_ERROR_DWORD_VALUE_TOO_SMALL                                           syscall.Errno = 9566
-	DNS_ERROR_DWORD_VALUE_TOO_LARGE                                           syscall.Errno = 9567
-	DNS_ERROR_BACKGROUND_LOADING                                              syscall.Errno = 9568
-	DNS_ERROR_NOT_ALLOWED_ON_RODC                                             syscall.Errno = 9569
-	DNS_ERROR_NOT_ALLOWED_UNDER_DNAME                                         syscall.Errno = 9570
-	DNS_ERROR_DELEGATION_REQUIRED                                             syscall.Errno = 9571
-	DNS_ERROR_INVALID_POLICY_TABLE                                            syscall.Errno = 9572
-	DNS_ERROR_ADDRESS_REQUIRED                                                syscall.Errno = 9573
-	DNS_ERROR_ZONE_BASE                                                       syscall.Errno = 9600
-	DNS_ERROR_ZONE_DOES_NOT_EXIST                                             syscall.Errno = 9601
-	DNS_ERROR_NO_ZONE_INFO                                                    syscall.Errno = 9602
-	DNS_ERROR_INVALID_ZONE_OPERATION                                          syscall.Errno = 9603
-	DNS_ERROR_ZONE_CONFIGURATION_ERROR                                        syscall.Errno = 9604
-	DNS_ERROR_ZONE_HAS_NO_SOA_RECORD                                          syscall.Errno = 9605
-	DNS_ERROR_ZONE_HAS_NO_NS_RECORDS                                          syscall.Errno = 9606
-	DNS_ERROR_ZONE_LOCKED                                                     syscall.Errno = 9607
-	DNS_ERROR_ZONE_CREATION_FAILED                                            syscall.Errno = 9608
-	DNS_ERROR_ZONE_ALREADY_EXISTS                                             syscall.Errno = 9609
-	DNS_ERROR_AUTOZONE_ALREADY_EXISTS                                         syscall.Errno = 9610
-	DNS_ERROR_INVALID_ZONE_TYPE                                               syscall.Errno = 9611
-	DNS_ERROR_SECONDARY_REQUIRES_MASTER_IP                                    syscall.Errno = 9612
-	DNS_ERROR_ZONE_NOT_SECONDARY                                              syscall.Errno = 9613
-	DNS_ERROR_NEED_SECONDARY_ADDRESSES                                        syscall.Errno = 9614
-	DNS_ERROR_WINS_INIT_FAILED                                                syscall.Errno = 9615
-	DNS_ERROR_NEED_WINS_SERVERS                                               syscall.Errno = 9616
-	DNS_ERROR_NBSTAT_INIT_FAILED                                              syscall.Errno = 9617
-	DNS_ERROR_SOA_DELETE_INVALID                                              syscall.Errno = 9618
-	DNS_ERROR_FORWARDER_ALREADY_EXISTS                                        syscall.Errno = 9619
-	DNS_ERROR_ZONE_REQUIRES_MASTER_IP                                         syscall.Errno = 9620
-	DNS_ERROR_ZONE_IS_SHUTDOWN                                                syscall.Errno = 9621
-	DNS_ERROR_ZONE_LOCKED_FOR_SIGNING                                         syscall.Errno = 9622
-	DNS_ERROR_DATAFILE_BASE                                                   syscall.Errno = 9650
-	DNS_ERROR_PRIMARY_REQUIRES_DATAFILE                                       syscall.Errno = 9651
-	DNS_ERROR_INVALID_DATAFILE_NAME                                           syscall.Errno = 9652
-	DNS_ERROR_DATAFILE_OPEN_FAILURE                                           syscall.Errno = 9653
-	DNS_ERROR_FILE_WRITEBACK_FAILED                                           syscall.Errno = 9654
-	DNS_ERROR_DATAFILE_PARSING                                                syscall.Errno = 9655
-	DNS_ERROR_DATABASE_BASE                                                   syscall.Errno = 9700
-	DNS_ERROR_RECORD_DOES_NOT_EXIST                                           syscall.Errno = 9701
-	DNS_ERROR_RECORD_FORMAT                                                   syscall.Errno = 9702
-	DNS_ERROR_NODE_CREATION_FAILED                                            syscall.Errno = 9703
-	DNS_ERROR_UNKNOWN_RECORD_TYPE                                             syscall.Errno = 9704
-	DNS_ERROR_RECORD_TIMED_OUT                                                syscall.Errno = 9705
-	DNS_ERROR_NAME_NOT_IN_ZONE                                                syscall.Errno = 9706
-	DNS_ERROR_CNAME_LOOP                                                      syscall.Errno = 9707
-	DNS_ERROR_NODE_IS_CNAME                                                   syscall.Errno = 9708
-	DNS_ERROR_CNAME_COLLISION                                                 syscall.Errno = 9709
-	DNS_ERROR_RECORD_ONLY_AT_ZONE_ROOT                                        syscall.Errno = 9710
-	DNS_ERROR_RECORD_ALREADY_EXISTS                                           syscall.Errno = 9711
-	DNS_ERROR_SECONDARY_DATA                                                  syscall.Errno = 9712
-	DNS_ERROR_NO_CREATE_CACHE_DATA                                            syscall.Errno = 9713
-	DNS_ERROR_NAME_DOES_NOT_EXIST                                             syscall.Errno = 9714
-	DNS_WARNING_PTR_CREATE_FAILED                                             syscall.Errno = 9715
-	DNS_WARNING_DOMAIN_UNDELETED                                              syscall.Errno = 9716
-	DNS_ERROR_DS_UNAVAILABLE                                                  syscall.Errno = 9717
-	DNS_ERROR_DS_ZONE_ALREADY_EXISTS                                          syscall.Errno = 9718
-	DNS_ERROR_NO_BOOTFILE_IF_DS_ZONE                                          syscall.Errno = 9719
-	DNS_ERROR_NODE_IS_DNAME                                                   syscall.Errno = 9720
-	DNS_ERROR_DNAME_COLLISION                                                 syscall.Errno = 9721
-	DNS_ERROR_ALIAS_LOOP                                                      syscall.Errno = 9722
-	DNS_ERROR_OPERATION_BASE                                                  syscall.Errno = 9750
-	DNS_INFO_AXFR_COMPLETE                                                    syscall.Errno = 9751
-	DNS_ERROR_AXFR                                                            syscall.Errno = 9752
-	DNS_INFO_ADDED_LOCAL_WINS                                                 syscall.Errno = 9753
-	DNS_ERROR_SECURE_BASE                                                     syscall.Errno = 9800
-	DNS_STATUS_CONTINUE_NEEDED                                                syscall.Errno = 9801
-	DNS_ERROR_SETUP_BASE                                                      syscall.Errno = 9850
-	DNS_ERROR_NO_TCPIP                                                        syscall.Errno = 9851
-	DNS_ERROR_NO_DNS_SERVERS                                                  syscall.Errno = 9852
-	DNS_ERROR_DP_BASE                                                         syscall.Errno = 9900
-	DNS_ERROR_DP_DOES_NOT_EXIST                                               syscall.Errno = 9901
-	DNS_ERROR_DP_ALREADY_EXISTS                                               syscall.Errno = 9902
-	DNS_ERROR_DP_NOT_ENLISTED                                                 syscall.Errno = 9903
-	DNS_ERROR_DP_ALREADY_ENLISTED                                             syscall.Errno = 9904
-	DNS_ERROR_DP_NOT_AVAILABLE                                                syscall.Errno = 9905
-	DNS_ERROR_DP_FSMO_ERROR                                                   syscall.Errno = 9906
-	DNS_ERROR_RRL_NOT_ENABLED                                                 syscall.Errno = 9911
-	DNS_ERROR_RRL_INVALID_WINDOW_SIZE                                         syscall.Errno = 9912
-	DNS_ERROR_RRL_INVALID_IPV4_PREFIX                                         syscall.Errno = 9913
-	DNS_ERROR_RRL_INVALID_IPV6_PREFIX                                         syscall.Errno = 9914
-	DNS_ERROR_RRL_INVALID_TC_RATE                                             syscall.Errno = 9915
-	DNS_ERROR_RRL_INVALID_LEAK_RATE                                           syscall.Errno = 9916
-	DNS_ERROR_RRL_LEAK_RATE_LESSTHAN_TC_RATE                                  syscall.Errno = 9917
-	DNS_ERROR_VIRTUALIZATION_INSTANCE_ALREADY_EXISTS                          syscall.Errno = 9921
-	DNS_ERROR_VIRTUALIZATION_INSTANCE_DOES_NOT_EXIST                          syscall.Errno = 9922
-	DNS_ERROR_VIRTUALIZATION_TREE_LOCKED                                      syscall.Errno = 9923
-	DNS_ERROR_INVAILD_VIRTUALIZATION_INSTANCE_NAME                            syscall.Errno = 9924
-	DNS_ERROR_DEFAULT_VIRTUALIZATION_INSTANCE                                 syscall.Errno = 9925
-	DNS_ERROR_ZONESCOPE_ALREADY_EXISTS                                        syscall.Errno = 9951
-	DNS_ERROR_ZONESCOPE_DOES_NOT_EXIST                                        syscall.Errno = 9952
-	DNS_ERROR_DEFAULT_ZONESCOPE                                               syscall.Errno = 9953
-	DNS_ERROR_INVALID_ZONESCOPE_NAME                                          syscall.Errno = 9954
-	DNS_ERROR_NOT_ALLOWED_WITH_ZONESCOPES                                     syscall.Errno = 9955
-	DNS_ERROR_LOAD_ZONESCOPE_FAILED                                           syscall.Errno = 9956
-	DNS_ERROR_ZONESCOPE_FILE_WRITEBACK_FAILED                                 syscall.Errno = 9957
-	DNS_ERROR_INVALID_SCOPE_NAME                                              syscall.Errno = 9958
-	DNS_ERROR_SCOPE_DOES_NOT_EXIST                                            syscall.Errno = 9959
-	DNS_ERROR_DEFAULT_SCOPE                                                   syscall.Errno = 9960
-	DNS_ERROR_INVALID_SCOPE_OPERATION                                         syscall.Errno = 9961
-	DNS_ERROR_SCOPE_LOCKED                                                    syscall.Errno = 9962
-	DNS_ERROR_SCOPE_ALREADY_EXISTS                                            syscall.Errno = 9963
-	DNS_ERROR_POLICY_ALREADY_EXISTS                                           syscall.Errno = 9971
-	DNS_ERROR_POLICY_DOES_NOT_EXIST                                           syscall.Errno = 9972
-	DNS_ERROR_POLICY_INVALID_CRITERIA                                         syscall.Errno = 9973
-	DNS_ERROR_POLICY_INVALID_SETTINGS                                         syscall.Errno = 9974
-	DNS_ERROR_CLIENT_SUBNET_IS_ACCESSED                                       syscall.Errno = 9975
-	DNS_ERROR_CLIENT_SUBNET_DOES_NOT_EXIST                                    syscall.Errno = 9976
-	DNS_ERROR_CLIENT_SUBNET_ALREADY_EXISTS                                    syscall.Errno = 9977
-	DNS_ERROR_SUBNET_DOES_NOT_EXIST                                           syscall.Errno = 9978
-	DNS_ERROR_SUBNET_ALREADY_EXISTS                                           syscall.Errno = 9979
-	DNS_ERROR_POLICY_LOCKED                                                   syscall.Errno = 9980
-	DNS_ERROR_POLICY_INVALID_WEIGHT                                           syscall.Errno = 9981
-	DNS_ERROR_POLICY_INVALID_NAME                                             syscall.Errno = 9982
-	DNS_ERROR_POLICY_MISSING_CRITERIA                                         syscall.Errno = 9983
-	DNS_ERROR_INVALID_CLIENT_SUBNET_NAME                                      syscall.Errno = 9984
-	DNS_ERROR_POLICY_PROCESSING_ORDER_INVALID                                 syscall.Errno = 9985
-	DNS_ERROR_POLICY_SCOPE_MISSING                                            syscall.Errno = 9986
-	DNS_ERROR_POLICY_SCOPE_NOT_ALLOWED                                        syscall.Errno = 9987
-	DNS_ERROR_SERVERSCOPE_IS_REFERENCED                                       syscall.Errno = 9988
-	DNS_ERROR_ZONESCOPE_IS_REFERENCED                                         syscall.Errno = 9989
-	DNS_ERROR_POLICY_INVALID_CRITERIA_CLIENT_SUBNET                           syscall.Errno = 9990
-	DNS_ERROR_POLICY_INVALID_CRITERIA_TRANSPORT_PROTOCOL                      syscall.Errno = 9991
-	DNS_ERROR_POLICY_INVALID_CRITERIA_NETWORK_PROTOCOL                        syscall.Errno = 9992
-	DNS_ERROR_POLICY_INVALID_CRITERIA_INTERFACE                               syscall.Errno = 9993
-	DNS_ERROR_POLICY_INVALID_CRITERIA_FQDN                                    syscall.Errno = 9994
-	DNS_ERROR_POLICY_INVALID_CRITERIA_QUERY_TYPE                              syscall.Errno = 9995
-	DNS_ERROR_POLICY_INVALID_CRITERIA_TIME_OF_DAY                             syscall.Errno = 9996
-	WSABASEERR                                                                syscall.Errno = 10000
-	WSAEINTR                                                                  syscall.Errno = 10004
-	WSAEBADF                                                                  syscall.Errno = 10009
-	WSAEACCES                                                                 syscall.Errno = 10013
-	WSAEFAULT                                                                 syscall.Errno = 10014
-	WSAEINVAL                                                                 syscall.Errno = 10022
-	WSAEMFILE                                                                 syscall.Errno = 10024
-	WSAEWOULDBLOCK                                                            syscall.Errno = 10035
-	WSAEINPROGRESS                                                            syscall.Errno = 10036
-	WSAEALREADY                                                               syscall.Errno = 10037
-	WSAENOTSOCK                                                               syscall.Errno = 10038
-	WSAEDESTADDRREQ                                                           syscall.Errno = 10039
-	WSAEMSGSIZE                                                               syscall.Errno = 10040
-	WSAEPROTOTYPE                                                             syscall.Errno = 10041
-	WSAENOPROTOOPT                                                            syscall.Errno = 10042
-	WSAEPROTONOSUPPORT                                                        syscall.Errno = 10043
-	WSAESOCKTNOSUPPORT                                                        syscall.Errno = 10044
-	WSAEOPNOTSUPP                                                             syscall.Errno = 10045
-	WSAEPFNOSUPPORT                                                           syscall.Errno = 10046
-	WSAEAFNOSUPPORT                                                           syscall.Errno = 10047
-	WSAEADDRINUSE                                                             syscall.Errno = 10048
-	WSAEADDRNOTAVAIL                                                          syscall.Errno = 10049
-	WSAENETDOWN                                                               syscall.Errno = 10050
-	WSAENETUNREACH                                                            syscall.Errno = 10051
-	WSAENETRESET                                                              syscall.Errno = 10052
-	WSAECONNABORTED                                                           syscall.Errno = 10053
-	WSAECONNRESET                                                             syscall.Errno = 10054
-	WSAENOBUFS                                                                syscall.Errno = 10055
-	WSAEISCONN                                                                syscall.Errno = 10056
-	WSAENOTCONN                                                               syscall.Errno = 10057
-	WSAESHUTDOWN                                                              syscall.Errno = 10058
-	WSAETOOMANYREFS                                                           syscall.Errno = 10059
-	WSAETIMEDOUT                                                              syscall.Errno = 10060
-	WSAECONNREFUSED                                                           syscall.Errno = 10061
-	WSAELOOP                                                                  syscall.Errno = 10062
-	WSAENAMETOOLONG                                                           syscall.Errno = 10063
-	WSAEHOSTDOWN                                                              syscall.Errno = 10064
-	WSAEHOSTUNREACH                                                           syscall.Errno = 10065
-	WSAENOTEMPTY                                                              syscall.Errno = 10066
-	WSAEPROCLIM                                                               syscall.Errno = 10067
-	WSAEUSERS                                                                 syscall.Errno = 10068
-	WSAEDQUOT                                                                 syscall.Errno = 10069
-	WSAESTALE                                                                 syscall.Errno = 10070
-	WSAEREMOTE                                                                syscall.Errno = 10071
-	WSASYSNOTREADY                                                            syscall.Errno = 10091
-	WSAVERNOTSUPPORTED                                                        syscall.Errno = 10092
-	WSANOTINITIALISED                                                         syscall.Errno = 10093
-	WSAEDISCON                                                                syscall.Errno = 10101
-	WSAENOMORE                                                                syscall.Errno = 10102
-	WSAECANCELLED                                                             syscall.Errno = 10103
-	WSAEINVALIDPROCTABLE                                                      syscall.Errno = 10104
-	WSAEINVALIDPROVIDER                                                       syscall.Errno = 10105
-	WSAEPROVIDERFAILEDINIT                                                    syscall.Errno = 10106
-	WSASYSCALLFAILURE                                                         syscall.Errno = 10107
-	WSASERVICE_NOT_FOUND                                                      syscall.Errno = 10108
-	WSATYPE_NOT_FOUND                                                         syscall.Errno = 10109
-	WSA_E_NO_MORE                                                             syscall.Errno = 10110
-	WSA_E_CANCELLED                                                           syscall.Errno = 10111
-	WSAEREFUSED                                                               syscall.Errno = 10112
-	WSAHOST_NOT_FOUND                                                         syscall.Errno = 11001
-	WSATRY_AGAIN                                                              syscall.Errno = 11002
-	WSANO_RECOVERY                                                            syscall.Errno = 11003
-	WSANO_DATA                                                                syscall.Errno = 11004
-	WSA_QOS_RECEIVERS                                                         syscall.Errno = 11005
-	WSA_QOS_SENDERS                                                           syscall.Errno = 11006
-	WSA_QOS_NO_SENDERS                                                        syscall.Errno = 11007
-	WSA_QOS_NO_RECEIVERS                                                      syscall.Errno = 11008
-	WSA_QOS_REQUEST_CONFIRMED                                                 syscall.Errno = 11009
-	WSA_QOS_ADMISSION_FAILURE                                                 syscall.Errno = 11010
-	WSA_QOS_POLICY_FAILURE                                                    syscall.Errno = 11011
-	WSA_QOS_BAD_STYLE                                                         syscall.Errno = 11012
-	WSA_QOS_BAD_OBJECT                                                        syscall.Errno = 11013
-	WSA_QOS_TRAFFIC_CTRL_ERROR                                                syscall.Errno = 11014
-	WSA_QOS_GENERIC_ERROR                                                     syscall.Errno = 11015
-	WSA_QOS_ESERVICETYPE                                                      syscall.Errno = 11016
-	WSA_QOS_EFLOWSPEC                                                         syscall.Errno = 11017
-	WSA_QOS_EPROVSPECBUF                                                      syscall.Errno = 11018
-	WSA_QOS_EFILTERSTYLE                                                      syscall.Errno = 11019
-	WSA_QOS_EFILTERTYPE                                                       syscall.Errno = 11020
-	WSA_QOS_EFILTERCOUNT                                                      syscall.Errno = 11021
-	WSA_QOS_EOBJLENGTH                                                        syscall.Errno = 11022
-	WSA_QOS_EFLOWCOUNT                                                        syscall.Errno = 11023
-	WSA_QOS_EUNKOWNPSOBJ                                                      syscall.Errno = 11024
-	WSA_QOS_EPOLICYOBJ                                                        syscall.Errno = 11025
-	WSA_QOS_EFLOWDESC                                                         syscall.Errno = 11026
-	WSA_QOS_EPSFLOWSPEC                                                       syscall.Errno = 11027
-	WSA_QOS_EPSFILTERSPEC                                                     syscall.Errno = 11028
-	WSA_QOS_ESDMODEOBJ                                                        syscall.Errno = 11029
-	WSA_QOS_ESHAPERATEOBJ                                                     syscall.Errno = 11030
-	WSA_QOS_RESERVED_PETYPE                                                   syscall.Errno = 11031
-	WSA_SECURE_HOST_NOT_FOUND                                                 syscall.Errno = 11032
-	WSA_IPSEC_NAME_POLICY_ERROR                                               syscall.Errno = 11033
-	ERROR_IPSEC_QM_POLICY_EXISTS                                              syscall.Errno = 13000
-	ERROR_IPSEC_QM_POLICY_NOT_FOUND                                           syscall.Errno = 13001
-	ERROR_IPSEC_QM_POLICY_IN_USE                                              syscall.Errno = 13002
-	ERROR_IPSEC_MM_POLICY_EXISTS                                              syscall.Errno = 13003
-	ERROR_IPSEC_MM_POLICY_NOT_FOUND                                           syscall.Errno = 13004
-	ERROR_IPSEC_MM_POLICY_IN_USE                                              syscall.Errno = 13005
-	ERROR_IPSEC_MM_FILTER_EXISTS                                              syscall.Errno = 13006
-	ERROR_IPSEC_MM_FILTER_NOT_FOUND                                           syscall.Errno = 13007
-	ERROR_IPSEC_TRANSPORT_FILTER_EXISTS                                       syscall.Errno = 13008
-	ERROR_IPSEC_TRANSPORT_FILTER_NOT_FOUND                                    syscall.Errno = 13009
-	ERROR_IPSEC_MM_AUTH_EXISTS                                                syscall.Errno = 13010
-	ERROR_IPSEC_MM_AUTH_NOT_FOUND                                             syscall.Errno = 13011
-	ERROR_IPSEC_MM_AUTH_IN_USE                                                syscall.Errno = 13012
-	ERROR_IPSEC_DEFAULT_MM_POLICY_NOT_FOUND                                   syscall.Errno = 13013
-	ERROR_IPSEC_DEFAULT_MM_AUTH_NOT_FOUND                                     syscall.Errno = 13014
-	ERROR_IPSEC_DEFAULT_QM_POLICY_NOT_FOUND                                   syscall.Errno = 13015
-	ERROR_IPSEC_TUNNEL_FILTER_EXISTS                                          syscall.Errno = 13016
-	ERROR_IPSEC_TUNNEL_FILTER_NOT_FOUND                                       syscall.Errno = 13017
-	ERROR_IPSEC_MM_FILTER_PENDING_DELETION                                    syscall.Errno = 13018
-	ERROR_IPSEC_TRANSPORT_FILTER_PENDING_DELETION                             syscall.Errno = 13019
-	ERROR_IPSEC_TUNNEL_FILTER_PENDING_DELETION                                syscall.Errno = 13020
-	ERROR_IPSEC_MM_POLICY_PENDING_DELETION                                    syscall.Errno = 13021
-	ERROR_IPSEC_MM_AUTH_PENDING_DELETION                                      syscall.Errno = 13022
-	ERROR_IPSEC_QM_POLICY_PENDING_DELETION                                    syscall.Errno = 13023
-	WARNING_IPSEC_MM_POLICY_PRUNED                                            syscall.Errno = 13024
-	WARNING_IPSEC_QM_POLICY_PRUNED                                            syscall.Errno = 13025
-	ERROR_IPSEC_IKE_NEG_STATUS_BEGIN                                          syscall.Errno = 13800
-	ERROR_IPSEC_IKE_AUTH_FAIL                                                 syscall.Errno = 13801
-	ERROR_IPSEC_IKE_ATTRIB_FAIL                                               syscall.Errno = 13802
-	ERROR_IPSEC_IKE_NEGOTIATION_PENDING                                       syscall.Errno = 13803
-	ERROR_IPSEC_IKE_GENERAL_PROCESSING_ERROR                                  syscall.Errno = 13804
-	ERROR_IPSEC_IKE_TIMED_OUT                                                 syscall.Errno = 13805
-	ERROR_IPSEC_IKE_NO_CERT                                                   syscall.Errno = 13806
-	ERROR_IPSEC_IKE_SA_DELETED                                                syscall.Errno = 13807
-	ERROR_IPSEC_IKE_SA_REAPED                                                 syscall.Errno = 13808
-	ERROR_IPSEC_IKE_MM_ACQUIRE_DROP                                           syscall.Errno = 13809
-	ERROR_IPSEC_IKE_QM_ACQUIRE_DROP                                           syscall.Errno = 13810
-	ERROR_IPSEC_IKE_QUEUE_DROP_MM                                             syscall.Errno = 13811
-	ERROR_IPSEC_IKE_QUEUE_DROP_NO_MM                                          syscall.Errno = 13812
-	ERROR_IPSEC_IKE_DROP_NO_RESPONSE                                          syscall.Errno = 13813
-	ERROR_IPSEC_IKE_MM_DELAY_DROP                                             syscall.Errno = 13814
-	ERROR_IPSEC_IKE_QM_DELAY_DROP                                             syscall.Errno = 13815
-	ERROR_IPSEC_IKE_ERROR                                                     syscall.Errno = 13816
-	ERROR_IPSEC_IKE_CRL_FAILED                                                syscall.Errno = 13817
-	ERROR_IPSEC_IKE_INVALID_KEY_USAGE                                         syscall.Errno = 13818
-	ERROR_IPSEC_IKE_INVALID_CERT_TYPE                                         syscall.Errno = 13819
-	ERROR_IPSEC_IKE_NO_PRIVATE_KEY                                            syscall.Errno = 13820
-	ERROR_IPSEC_IKE_SIMULTANEOUS_REKEY                                        syscall.Errno = 13821
-	ERROR_IPSEC_IKE_DH_FAIL                                                   syscall.Errno = 13822
-	ERROR_IPSEC_IKE_CRITICAL_PAYLOAD_NOT_RECOGNIZED                           syscall.Errno = 13823
-	ERROR_IPSEC_IKE_INVALID_HEADER                                            syscall.Errno = 13824
-	ERROR_IPSEC_IKE_NO_POLICY                                                 syscall.Errno = 13825
-	ERROR_IPSEC_IKE_INVALID_SIGNATURE                                         syscall.Errno = 13826
-	ERROR_IPSEC_IKE_KERBEROS_ERROR                                            syscall.Errno = 13827
-	ERROR_IPSEC_IKE_NO_PUBLIC_KEY                                             syscall.Errno = 13828
-	ERROR_IPSEC_IKE_PROCESS_ERR                                               syscall.Errno = 13829
-	ERROR_IPSEC_IKE_PROCESS_ERR_SA                                            syscall.Errno = 13830
-	ERROR_IPSEC_IKE_PROCESS_ERR_PROP                                          syscall.Errno = 13831
-	ERROR_IPSEC_IKE_PROCESS_ERR_TRANS                                         syscall.Errno = 13832
-	ERROR_IPSEC_IKE_PROCESS_ERR_KE                                            syscall.Errno = 13833
-	ERROR_IPSEC_IKE_PROCESS_ERR_ID                                            syscall.Errno = 13834
-	ERROR_IPSEC_IKE_PROCESS_ERR_CERT                                          syscall.Errno = 13835
-	ERROR_IPSEC_IKE_PROCESS_ERR_CERT_REQ                                      syscall.Errno = 13836
-	ERROR_IPSEC_IKE_PROCESS_ERR_HASH                                          syscall.Errno = 13837
-	ERROR_IPSEC_IKE_PROCESS_ERR_SIG                                           syscall.Errno = 13838
-	ERROR_IPSEC_IKE_PROCESS_ERR_NONCE                                         syscall.Errno = 13839
-	ERROR_IPSEC_IKE_PROCESS_ERR_NOTIFY                                        syscall.Errno = 13840
-	ERROR_IPSEC_IKE_PROCESS_ERR_DELETE                                        syscall.Errno = 13841
-	ERROR_IPSEC_IKE_PROCESS_ERR_VENDOR                                        syscall.Errno = 13842
-	ERROR_IPSEC_IKE_INVALID_PAYLOAD                                           syscall.Errno = 13843
-	ERROR_IPSEC_IKE_LOAD_SOFT_SA                                              syscall.Errno = 13844
-	ERROR_IPSEC_IKE_SOFT_SA_TORN_DOWN                                         syscall.Errno = 13845
-	ERROR_IPSEC_IKE_INVALID_COOKIE                                            syscall.Errno = 13846
-	ERROR_IPSEC_IKE_NO_PEER_CERT                                              syscall.Errno = 13847
-	ERROR_IPSEC_IKE_PEER_CRL_FAILED                                           syscall.Errno = 13848
-	ERROR_IPSEC_IKE_POLICY_CHANGE                                             syscall.Errno = 13849
-	ERROR_IPSEC_IKE_NO_MM_POLICY                                              syscall.Errno = 13850
-	ERROR_IPSEC_IKE_NOTCBPRIV                                                 syscall.Errno = 13851
-	ERROR_IPSEC_IKE_SECLOADFAIL                                               syscall.Errno = 13852
-	ERROR_IPSEC_IKE_FAILSSPINIT                                               syscall.Errno = 13853
-	ERROR_IPSEC_IKE_FAILQUERYSSP                                              syscall.Errno = 13854
-	ERROR_IPSEC_IKE_SRVACQFAIL                                                syscall.Errno = 13855
-	ERROR_IPSEC_IKE_SRVQUERYCRED                                              syscall.Errno = 13856
-	ERROR_IPSEC_IKE_GETSPIFAIL                                                syscall.Errno = 13857
-	ERROR_IPSEC_IKE_INVALID_FILTER                                            syscall.Errno = 13858
-	ERROR_IPSEC_IKE_OUT_OF_MEMORY                                             syscall.Errno = 13859
-	ERROR_IPSEC_IKE_ADD_UPDATE_KEY_FAILED                                     syscall.Errno = 13860
-	ERROR_IPSEC_IKE_INVALID_POLICY                                            syscall.Errno = 13861
-	ERROR_IPSEC_IKE_UNKNOWN_DOI                                               syscall.Errno = 13862
-	ERROR_IPSEC_IKE_INVALID_SITUATION                                         syscall.Errno = 13863
-	ERROR_IPSEC_IKE_DH_FAILURE                                                syscall.Errno = 13864
-	ERROR_IPSEC_IKE_INVALID_GROUP                                             syscall.Errno = 13865
-	ERROR_IPSEC_IKE_ENCRYPT                                                   syscall.Errno = 13866
-	ERROR_IPSEC_IKE_DECRYPT                                                   syscall.Errno = 13867
-	ERROR_IPSEC_IKE_POLICY_MATCH                                              syscall.Errno = 13868
-	ERROR_IPSEC_IKE_UNSUPPORTED_ID                                            syscall.Errno = 13869
-	ERROR_IPSEC_IKE_INVALID_HASH                                              syscall.Errno = 13870
-	ERROR_IPSEC_IKE_INVALID_HASH_ALG                                          syscall.Errno = 13871
-	ERROR_IPSEC_IKE_INVALID_HASH_SIZE                                         syscall.Errno = 13872
-	ERROR_IPSEC_IKE_INVALID_ENCRYPT_ALG                                       syscall.Errno = 13873
-	ERROR_IPSEC_IKE_INVALID_AUTH_ALG                                          syscall.Errno = 13874
-	ERROR_IPSEC_IKE_INVALID_SIG                                               syscall.Errno = 13875
-	ERROR_IPSEC_IKE_LOAD_FAILED                                               syscall.Errno = 13876
-	ERROR_IPSEC_IKE_RPC_DELETE                                                syscall.Errno = 13877
-	ERROR_IPSEC_IKE_BENIGN_REINIT                                             syscall.Errno = 13878
-	ERROR_IPSEC_IKE_INVALID_RESPONDER_LIFETIME_NOTIFY                         syscall.Errno = 13879
-	ERROR_IPSEC_IKE_INVALID_MAJOR_VERSION                                     syscall.Errno = 13880
-	ERROR_IPSEC_IKE_INVALID_CERT_KEYLEN                                       syscall.Errno = 13881
-	ERROR_IPSEC_IKE_MM_LIMIT                                                  syscall.Errno = 13882
-	ERROR_IPSEC_IKE_NEGOTIATION_DISABLED                                      syscall.Errno = 13883
-	ERROR_IPSEC_IKE_QM_LIMIT                                                  syscall.Errno = 13884
-	ERROR_IPSEC_IKE_MM_EXPIRED                                                syscall.Errno = 13885
-	ERROR_IPSEC_IKE_PEER_MM_ASSUMED_INVALID                                   syscall.Errno = 13886
-	ERROR_IPSEC_IKE_CERT_CHAIN_POLICY_MISMATCH                                syscall.Errno = 13887
-	ERROR_IPSEC_IKE_UNEXPECTED_MESSAGE_ID                                     syscall.Errno = 13888
-	ERROR_IPSEC_IKE_INVALID_AUTH_PAYLOAD                                      syscall.Errno = 13889
-	ERROR_IPSEC_IKE_DOS_COOKIE_SENT                                           syscall.Errno = 13890
-	ERROR_IPSEC_IKE_SHUTTING_DOWN                                             syscall.Errno = 13891
-	ERROR_IPSEC_IKE_CGA_AUTH_FAILED                                           syscall.Errno = 13892
-	ERROR_IPSEC_IKE_PROCESS_ERR_NATOA                                         syscall.Errno = 13893
-	ERROR_IPSEC_IKE_INVALID_MM_FOR_QM                                         syscall.Errno = 13894
-	ERROR_IPSEC_IKE_QM_EXPIRED                                                syscall.Errno = 13895
-	ERROR_IPSEC_IKE_TOO_MANY_FILTERS                                          syscall.Errno = 13896
-	ERROR_IPSEC_IKE_NEG_STATUS_END                                            syscall.Errno = 13897
-	ERROR_IPSEC_IKE_KILL_DUMMY_NAP_TUNNEL                                     syscall.Errno = 13898
-	ERROR_IPSEC_IKE_INNER_IP_ASSIGNMENT_FAILURE                               syscall.Errno = 13899
-	ERROR_IPSEC_IKE_REQUIRE_CP_PAYLOAD_MISSING                                syscall.Errno = 13900
-	ERROR_IPSEC_KEY_MODULE_IMPERSONATION_NEGOTIATION_PENDING                  syscall.Errno = 13901
-	ERROR_IPSEC_IKE_COEXISTENCE_SUPPRESS                                      syscall.Errno = 13902
-	ERROR_IPSEC_IKE_RATELIMIT_DROP                                            syscall.Errno = 13903
-	ERROR_IPSEC_IKE_PEER_DOESNT_SUPPORT_MOBIKE                                syscall.Errno = 13904
-	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE                                     syscall.Errno = 13905
-	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_FAILURE                         syscall.Errno = 13906
-	ERROR_IPSEC_IKE_AUTHORIZATION_FAILURE_WITH_OPTIONAL_RETRY                 syscall.Errno = 13907
-	ERROR_IPSEC_IKE_STRONG_CRED_AUTHORIZATION_AND_CERTMAP_FAILURE             syscall.Errno = 13908
-	ERROR_IPSEC_IKE_NEG_STATUS_EXTENDED_END                                   syscall.Errno = 13909
-	ERROR_IPSEC_BAD_SPI                                                       syscall.Errno = 13910
-	ERROR_IPSEC_SA_LIFETIME_EXPIRED                                           syscall.Errno = 13911
-	ERROR_IPSEC_WRONG_SA                                                      syscall.Errno = 13912
-	ERROR_IPSEC_REPLAY_CHECK_FAILED                                           syscall.Errno = 13913
-	ERROR_IPSEC_INVALID_PACKET                                                syscall.Errno = 13914
-	ERROR_IPSEC_INTEGRITY_CHECK_FAILED                                        syscall.Errno = 13915
-	ERROR_IPSEC_CLEAR_TEXT_DROP                                               syscall.Errno = 13916
-	ERROR_IPSEC_AUTH_FIREWALL_DROP                                            syscall.Errno = 13917
-	ERROR_IPSEC_THROTTLE_DROP                                                 syscall.Errno = 13918
-	ERROR_IPSEC_DOSP_BLOCK                                                    syscall.Errno = 13925
-	ERROR_IPSEC_DOSP_RECEIVED_MULTICAST                                       syscall.Errno = 13926
-	ERROR_IPSEC_DOSP_INVALID_PACKET                                           syscall.Errno = 13927
-	ERROR_IPSEC_DOSP_STATE_LOOKUP_FAILED                                      syscall.Errno = 13928
-	ERROR_IPSEC_DOSP_MAX_ENTRIES                                              syscall.Errno = 13929
-	ERROR_IPSEC_DOSP_KEYMOD_NOT_ALLOWED                                       syscall.Errno = 13930
-	ERROR_IPSEC_DOSP_NOT_INSTALLED                                            syscall.Errno = 13931
-	ERROR_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES                              syscall.Errno = 13932
-	ERROR_SXS_SECTION_NOT_FOUND                                               syscall.Errno = 14000
-	ERROR_SXS_CANT_GEN_ACTCTX                                                 syscall.Errno = 14001
-	ERROR_SXS_INVALID_ACTCTXDATA_FORMAT                                       syscall.Errno = 14002
-	ERROR_SXS_ASSEMBLY_NOT_FOUND                                              syscall.Errno = 14003
-	ERROR_SXS_MANIFEST_FORMAT_ERROR                                           syscall.Errno = 14004
-	ERROR_SXS_MANIFEST_PARSE_ERROR                                            syscall.Errno = 14005
-	ERROR_SXS_ACTIVATION_CONTEXT_DISABLED                                     syscall.Errno = 14006
-	ERROR_SXS_KEY_NOT_FOUND                                                   syscall.Errno = 14007
-	ERROR_SXS_VERSION_CONFLICT                                                syscall.Errno = 14008
-	ERROR_SXS_WRONG_SECTION_TYPE                                              syscall.Errno = 14009
-	ERROR_SXS_THREAD_QUERIES_DISABLED                                         syscall.Errno = 14010
-	ERROR_SXS_PROCESS_DEFAULT_ALREADY_SET                                     syscall.Errno = 14011
-	ERROR_SXS_UNKNOWN_ENCODING_GROUP                                          syscall.Errno = 14012
-	ERROR_SXS_UNKNOWN_ENCODING                                                syscall.Errno = 14013
-	ERROR_SXS_INVALID_XML_NAMESPACE_URI                                       syscall.Errno = 14014
-	ERROR_SXS_ROOT_MANIFEST_DEPENDENCY_NOT_INSTALLED                          syscall.Errno = 14015
-	ERROR_SXS_LEAF_MANIFEST_DEPENDENCY_NOT_INSTALLED                          syscall.Errno = 14016
-	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE                             syscall.Errno = 14017
-	ERROR_SXS_MANIFEST_MISSING_REQUIRED_DEFAULT_NAMESPACE                     syscall.Errno = 14018
-	ERROR_SXS_MANIFEST_INVALID_REQUIRED_DEFAULT_NAMESPACE                     syscall.Errno = 14019
-	ERROR_SXS_PRIVATE_MANIFEST_CROSS_PATH_WITH_REPARSE_POINT                  syscall.Errno = 14020
-	ERROR_SXS_DUPLICATE_DLL_NAME                                              syscall.Errno = 14021
-	ERROR_SXS_DUPLICATE_WINDOWCLASS_NAME                                      syscall.Errno = 14022
-	ERROR_SXS_DUPLICATE_CLSID                                                 syscall.Errno = 14023
-	ERROR_SXS_DUPLICATE_IID                                                   syscall.Errno = 14024
-	ERROR_SXS_DUPLICATE_TLBID                                                 syscall.Errno = 14025
-	ERROR_SXS_DUPLICATE_PROGID                                                syscall.Errno = 14026
-	ERROR_SXS_DUPLICATE_ASSEMBLY_NAME                                         syscall.Errno = 14027
-	ERROR_SXS_FILE_HASH_MISMATCH                                              syscall.Errno = 14028
-	ERROR_SXS_POLICY_PARSE_ERROR                                              syscall.Errno = 14029
-	ERROR_SXS_XML_E_MISSINGQUOTE                                              syscall.Errno = 14030
-	ERROR_SXS_XML_E_COMMENTSYNTAX                                             syscall.Errno = 14031
-	ERROR_SXS_XML_E_BADSTARTNAMECHAR                                          syscall.Errno = 14032
-	ERROR_SXS_XML_E_BADNAMECHAR                                               syscall.Errno = 14033
-	ERROR_SXS_XML_E_BADCHARINSTRING                                           syscall.Errno = 14034
-	ERROR_SXS_XML_E_XMLDECLSYNTAX                                             syscall.Errno = 14035
-	ERROR_SXS_XML_E_BADCHARDATA                                               syscall.Errno = 14036
-	ERROR_SXS_XML_E_MISSINGWHITESPACE                                         syscall.Errno = 14037
-	ERROR_SXS_XML_E_EXPECTINGTAGEND                                           syscall.Errno = 14038
-	ERROR_SXS_XML_E_MISSINGSEMICOLON                                          syscall.Errno = 14039
-	ERROR_SXS_XML_E_UNBALANCEDPAREN                                           syscall.Errno = 14040
-	ERROR_SXS_XML_E_INTERNALERROR                                             syscall.Errno = 14041
-	ERROR_SXS_XML_E_UNEXPECTED_WHITESPACE                                     syscall.Errno = 14042
-	ERROR_SXS_XML_E_INCOMPLETE_ENCODING                                       syscall.Errno = 14043
-	ERROR_SXS_XML_E_MISSING_PAREN                                             syscall.Errno = 14044
-	ERROR_SXS_XML_E_EXPECTINGCLOSEQUOTE                                       syscall.Errno = 14045
-	ERROR_SXS_XML_E_MULTIPLE_COLONS                                           syscall.Errno = 14046
-	ERROR_SXS_XML_E_INVALID_DECIMAL                                           syscall.Errno = 14047
-	ERROR_SXS_XML_E_INVALID_HEXIDECIMAL                                       syscall.Errno = 14048
-	ERROR_SXS_XML_E_INVALID_UNICODE                                           syscall.Errno = 14049
-	ERROR_SXS_XML_E_WHITESPACEORQUESTIONMARK                                  syscall.Errno = 14050
-	ERROR_SXS_XML_E_UNEXPECTEDENDTAG                                          syscall.Errno = 14051
-	ERROR_SXS_XML_E_UNCLOSEDTAG                                               syscall.Errno = 14052
-	ERROR_SXS_XML_E_DUPLICATEATTRIBUTE                                        syscall.Errno = 14053
-	ERROR_SXS_XML_E_MULTIPLEROOTS                                             syscall.Errno = 14054
-	ERROR_SXS_XML_E_INVALIDATROOTLEVEL                                        syscall.Errno = 14055
-	ERROR_SXS_XML_E_BADXMLDECL                                                syscall.Errno = 14056
-	ERROR_SXS_XML_E_MISSINGROOT                                               syscall.Errno = 14057
-	ERROR_SXS_XML_E_UNEXPECTEDEOF                                             syscall.Errno = 14058
-	ERROR_SXS_XML_E_BADPEREFINSUBSET                                          syscall.Errno = 14059
-	ERROR_SXS_XML_E_UNCLOSEDSTARTTAG                                          syscall.Errno = 14060
-	ERROR_SXS_XML_E_UNCLOSEDENDTAG                                            syscall.Errno = 14061
-	ERROR_SXS_XML_E_UNCLOSEDSTRING                                            syscall.Errno = 14062
-	ERROR_SXS_XML_E_UNCLOSEDCOMMENT                                           syscall.Errno = 14063
-	ERROR_SXS_XML_E_UNCLOSEDDECL                                              syscall.Errno = 14064
-	ERROR_SXS_XML_E_UNCLOSEDCDATA                                             syscall.Errno = 14065
-	ERROR_SXS_XML_E_RESERVEDNAMESPACE                                         syscall.Errno = 14066
-	ERROR_SXS_XML_E_INVALIDENCODING                                           syscall.Errno = 14067
-	ERROR_SXS_XML_E_INVALIDSWITCH                                             syscall.Errno = 14068
-	ERROR_SXS_XML_E_BADXMLCASE                                                syscall.Errno = 14069
-	ERROR_SXS_XML_E_INVALID_STANDALONE                                        syscall.Errno = 14070
-	ERROR_SXS_XML_E_UNEXPECTED_STANDALONE                                     syscall.Errno = 14071
-	ERROR_SXS_XML_E_INVALID_VERSION                                           syscall.Errno = 14072
-	ERROR_SXS_XML_E_MISSINGEQUALS                                             syscall.Errno = 14073
-	ERROR_SXS_PROTECTION_RECOVERY_FAILED                                      syscall.Errno = 14074
-	ERROR_SXS_PROTECTION_PUBLIC_KEY_TOO_SHORT                                 syscall.Errno = 14075
-	ERROR_SXS_PROTECTION_CATALOG_NOT_VALID                                    syscall.Errno = 14076
-	ERROR_SXS_UNTRANSLATABLE_HRESULT                                          syscall.Errno = 14077
-	ERROR_SXS_PROTECTION_CATALOG_FILE_MISSING                                 syscall.Errno = 14078
-	ERROR_SXS_MISSING_ASSEMBLY_IDENTITY_ATTRIBUTE                             syscall.Errno = 14079
-	ERROR_SXS_INVALID_ASSEMBLY_IDENTITY_ATTRIBUTE_NAME                        syscall.Errno = 14080
-	ERROR_SXS_ASSEMBLY_MISSING                                                syscall.Errno = 14081
-	ERROR_SXS_CORRUPT_ACTIVATION_STACK                                        syscall.Errno = 14082
-	ERROR_SXS_CORRUPTION                                                      syscall.Errno = 14083
-	ERROR_SXS_EARLY_DEACTIVATION                                              syscall.Errno = 14084
-	ERROR_SXS_INVALID_DEACTIVATION                                            syscall.Errno = 14085
-	ERROR_SXS_MULTIPLE_DEACTIVATION                                           syscall.Errno = 14086
-	ERROR_SXS_PROCESS_TERMINATION_REQUESTED                                   syscall.Errno = 14087
-	ERROR_SXS_RELEASE_ACTIVATION_CONTEXT                                      syscall.Errno = 14088
-	ERROR_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY                         syscall.Errno = 14089
-	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE                                syscall.Errno = 14090
-	ERROR_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME                                 syscall.Errno = 14091
-	ERROR_SXS_IDENTITY_DUPLICATE_ATTRIBUTE                                    syscall.Errno = 14092
-	ERROR_SXS_IDENTITY_PARSE_ERROR                                            syscall.Errno = 14093
-	ERROR_MALFORMED_SUBSTITUTION_STRING                                       syscall.Errno = 14094
-	ERROR_SXS_INCORRECT_PUBLIC_KEY_TOKEN                                      syscall.Errno = 14095
-	ERROR_UNMAPPED_SUBSTITUTION_STRING                                        syscall.Errno = 14096
-	ERROR_SXS_ASSEMBLY_NOT_LOCKED                                             syscall.Errno = 14097
-	ERROR_SXS_COMPONENT_STORE_CORRUPT                                         syscall.Errno = 14098
-	ERROR_ADVANCED_INSTALLER_FAILED                                           syscall.Errno = 14099
-	ERROR_XML_ENCODING_MISMATCH                                               syscall.Errno = 14100
-	ERROR_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT                   syscall.Errno = 14101
-	ERROR_SXS_IDENTITIES_DIFFERENT                                            syscall.Errno = 14102
-	ERROR_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT                                    syscall.Errno = 14103
-	ERROR_SXS_FILE_NOT_PART_OF_ASSEMBLY                                       syscall.Errno = 14104
-	ERROR_SXS_MANIFEST_TOO_BIG                                                syscall.Errno = 14105
-	ERROR_SXS_SETTING_NOT_REGISTERED                                          syscall.Errno = 14106
-	ERROR_SXS_TRANSACTION_CLOSURE_INCOMPLETE                                  syscall.Errno = 14107
-	ERROR_SMI_PRIMITIVE_INSTALLER_FAILED                                      syscall.Errno = 14108
-	ERROR_GENERIC_COMMAND_FAILED                                              syscall.Errno = 14109
-	ERROR_SXS_FILE_HASH_MISSING                                               syscall.Errno = 14110
-	ERROR_EVT_INVALID_CHANNEL_PATH                                            syscall.Errno = 15000
-	ERROR_EVT_INVALID_QUERY                                                   syscall.Errno = 15001
-	ERROR_EVT_PUBLISHER_METADATA_NOT_FOUND                                    syscall.Errno = 15002
-	ERROR_EVT_EVENT_TEMPLATE_NOT_FOUND                                        syscall.Errno = 15003
-	ERROR_EVT_INVALID_PUBLISHER_NAME                                          syscall.Errno = 15004
-	ERROR_EVT_INVALID_EVENT_DATA                                              syscall.Errno = 15005
-	ERROR_EVT_CHANNEL_NOT_FOUND                                               syscall.Errno = 15007
-	ERROR_EVT_MALFORMED_XML_TEXT                                              syscall.Errno = 15008
-	ERROR_EVT_SUBSCRIPTION_TO_DIRECT_CHANNEL                                  syscall.Errno = 15009
-	ERROR_EVT_CONFIGURATION_ERROR                                             syscall.Errno = 15010
-	ERROR_EVT_QUERY_RESULT_STALE                                              syscall.Errno = 15011
-	ERROR_EVT_QUERY_RESULT_INVALID_POSITION                                   syscall.Errno = 15012
-	ERROR_EVT_NON_VALIDATING_MSXML                                            syscall.Errno = 15013
-	ERROR_EVT_FILTER_ALREADYSCOPED                                            syscall.Errno = 15014
-	ERROR_EVT_FILTER_NOTELTSET                                                syscall.Errno = 15015
-	ERROR_EVT_FILTER_INVARG                                                   syscall.Errno = 15016
-	ERROR_EVT_FILTER_INVTEST                                                  syscall.Errno = 15017
-	ERROR_EVT_FILTER_INVTYPE                                                  syscall.Errno = 15018
-	ERROR_EVT_FILTER_PARSEERR                                                 syscall.Errno = 15019
-	ERROR_EVT_FILTER_UNSUPPORTEDOP                                            syscall.Errno = 15020
-	ERROR_EVT_FILTER_UNEXPECTEDTOKEN                                          syscall.Errno = 15021
-	ERROR_EVT_INVALID_OPERATION_OVER_ENABLED_DIRECT_CHANNEL                   syscall.Errno = 15022
-	ERROR_EVT_INVALID_CHANNEL_PROPERTY_VALUE                                  syscall.Errno = 15023
-	ERROR_EVT_INVALID_PUBLISHER_PROPERTY_VALUE                                syscall.Errno = 15024
-	ERROR_EVT_CHANNEL_CANNOT_ACTIVATE                                         syscall.Errno = 15025
-	ERROR_EVT_FILTER_TOO_COMPLEX                                              syscall.Errno = 15026
-	ERROR_EVT_MESSAGE_NOT_FOUND                                               syscall.Errno = 15027
-	ERROR_EVT_MESSAGE_ID_NOT_FOUND                                            syscall.Errno = 15028
-	ERROR_EVT_UNRESOLVED_VALUE_INSERT                                         syscall.Errno = 15029
-	ERROR_EVT_UNRESOLVED_PARAMETER_INSERT                                     syscall.Errno = 15030
-	ERROR_EVT_MAX_INSERTS_REACHED                                             syscall.Errno = 15031
-	ERROR_EVT_EVENT_DEFINITION_NOT_FOUND                                      syscall.Errno = 15032
-	ERROR_EVT_MESSAGE_LOCALE_NOT_FOUND                                        syscall.Errno = 15033
-	ERROR_EVT_VERSION_TOO_OLD                                                 syscall.Errno = 15034
-	ERROR_EVT_VERSION_TOO_NEW                                                 syscall.Errno = 15035
-	ERROR_EVT_CANNOT_OPEN_CHANNEL_OF_QUERY                                    syscall.Errno = 15036
-	ERROR_EVT_PUBLISHER_DISABLED                                              syscall.Errno = 15037
-	ERROR_EVT_FILTER_OUT_OF_RANGE                                             syscall.Errno = 15038
-	ERROR_EC_SUBSCRIPTION_CANNOT_ACTIVATE                                     syscall.Errno = 15080
-	ERROR_EC_LOG_DISABLED                                                     syscall.Errno = 15081
-	ERROR_EC_CIRCULAR_FORWARDING                                              syscall.Errno = 15082
-	ERROR_EC_CREDSTORE_FULL                                                   syscall.Errno = 15083
-	ERROR_EC_CRED_NOT_FOUND                                                   syscall.Errno = 15084
-	ERROR_EC_NO_ACTIVE_CHANNEL                                                syscall.Errno = 15085
-	ERROR_MUI_FILE_NOT_FOUND                                                  syscall.Errno = 15100
-	ERROR_MUI_INVALID_FILE                                                    syscall.Errno = 15101
-	ERROR_MUI_INVALID_RC_CONFIG                                               syscall.Errno = 15102
-	ERROR_MUI_INVALID_LOCALE_NAME                                             syscall.Errno = 15103
-	ERROR_MUI_INVALID_ULTIMATEFALLBACK_NAME                                   syscall.Errno = 15104
-	ERROR_MUI_FILE_NOT_LOADED                                                 syscall.Errno = 15105
-	ERROR_RESOURCE_ENUM_USER_STOP                                             syscall.Errno = 15106
-	ERROR_MUI_INTLSETTINGS_UILANG_NOT_INSTALLED                               syscall.Errno = 15107
-	ERROR_MUI_INTLSETTINGS_INVALID_LOCALE_NAME                                syscall.Errno = 15108
-	ERROR_MRM_RUNTIME_NO_DEFAULT_OR_NEUTRAL_RESOURCE                          syscall.Errno = 15110
-	ERROR_MRM_INVALID_PRICONFIG                                               syscall.Errno = 15111
-	ERROR_MRM_INVALID_FILE_TYPE                                               syscall.Errno = 15112
-	ERROR_MRM_UNKNOWN_QUALIFIER                                               syscall.Errno = 15113
-	ERROR_MRM_INVALID_QUALIFIER_VALUE                                         syscall.Errno = 15114
-	ERROR_MRM_NO_CANDIDATE                                                    syscall.Errno = 15115
-	ERROR_MRM_NO_MATCH_OR_DEFAULT_CANDIDATE                                   syscall.Errno = 15116
-	ERROR_MRM_RESOURCE_TYPE_MISMATCH                                          syscall.Errno = 15117
-	ERROR_MRM_DUPLICATE_MAP_NAME                                              syscall.Errno = 15118
-	ERROR_MRM_DUPLICATE_ENTRY                                                 syscall.Errno = 15119
-	ERROR_MRM_INVALID_RESOURCE_IDENTIFIER                                     syscall.Errno = 15120
-	ERROR_MRM_FILEPATH_TOO_LONG                                               syscall.Errno = 15121
-	ERROR_MRM_UNSUPPORTED_DIRECTORY_TYPE                                      syscall.Errno = 15122
-	ERROR_MRM_INVALID_PRI_FILE                                                syscall.Errno = 15126
-	ERROR_MRM_NAMED_RESOURCE_NOT_FOUND                                        syscall.Errno = 15127
-	ERROR_MRM_MAP_NOT_FOUND                                                   syscall.Errno = 15135
-	ERROR_MRM_UNSUPPORTED_PROFILE_TYPE                                        syscall.Errno = 15136
-	ERROR_MRM_INVALID_QUALIFIER_OPERATOR                                      syscall.Errno = 15137
-	ERROR_MRM_INDETERMINATE_QUALIFIER_VALUE                                   syscall.Errno = 15138
-	ERROR_MRM_AUTOMERGE_ENABLED                                               syscall.Errno = 15139
-	ERROR_MRM_TOO_MANY_RESOURCES                                              syscall.Errno = 15140
-	ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_MERGE                                 syscall.Errno = 15141
-	ERROR_MRM_UNSUPPORTED_FILE_TYPE_FOR_LOAD_UNLOAD_PRI_FILE                  syscall.Errno = 15142
-	ERROR_MRM_NO_CURRENT_VIEW_ON_THREAD                                       syscall.Errno = 15143
-	ERROR_DIFFERENT_PROFILE_RESOURCE_MANAGER_EXIST                            syscall.Errno = 15144
-	ERROR_OPERATION_NOT_ALLOWED_FROM_SYSTEM_COMPONENT                         syscall.Errno = 15145
-	ERROR_MRM_DIRECT_REF_TO_NON_DEFAULT_RESOURCE                              syscall.Errno = 15146
-	ERROR_MRM_GENERATION_COUNT_MISMATCH                                       syscall.Errno = 15147
-	ERROR_PRI_MERGE_VERSION_MISMATCH                                          syscall.Errno = 15148
-	ERROR_PRI_MERGE_MISSING_SCHEMA                                            syscall.Errno = 15149
-	ERROR_PRI_MERGE_LOAD_FILE_FAILED                                          syscall.Errno = 15150
-	ERROR_PRI_MERGE_ADD_FILE_FAILED                                           syscall.Errno = 15151
-	ERROR_PRI_MERGE_WRITE_FILE_FAILED                                         syscall.Errno = 15152
-	ERROR_PRI_MERGE_MULTIPLE_PACKAGE_FAMILIES_NOT_ALLOWED                     syscall.Errno = 15153
-	ERROR_PRI_MERGE_MULTIPLE_MAIN_PACKAGES_NOT_ALLOWED                        syscall.Errno = 15154
-	ERROR_PRI_MERGE_BUNDLE_PACKAGES_NOT_ALLOWED                               syscall.Errno = 15155
-	ERROR_PRI_MERGE_MAIN_PACKAGE_REQUIRED                                     syscall.Errno = 15156
-	ERROR_PRI_MERGE_RESOURCE_PACKAGE_REQUIRED                                 syscall.Errno = 15157
-	ERROR_PRI_MERGE_INVALID_FILE_NAME                                         syscall.Errno = 15158
-	ERROR_MRM_PACKAGE_NOT_FOUND                                               syscall.Errno = 15159
-	ERROR_MCA_INVALID_CAPABILITIES_STRING                                     syscall.Errno = 15200
-	ERROR_MCA_INVALID_VCP_VERSION                                             syscall.Errno = 15201
-	ERROR_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION                             syscall.Errno = 15202
-	ERROR_MCA_MCCS_VERSION_MISMATCH                                           syscall.Errno = 15203
-	ERROR_MCA_UNSUPPORTED_MCCS_VERSION                                        syscall.Errno = 15204
-	ERROR_MCA_INTERNAL_ERROR                                                  syscall.Errno = 15205
-	ERROR_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED                                syscall.Errno = 15206
-	ERROR_MCA_UNSUPPORTED_COLOR_TEMPERATURE                                   syscall.Errno = 15207
-	ERROR_AMBIGUOUS_SYSTEM_DEVICE                                             syscall.Errno = 15250
-	ERROR_SYSTEM_DEVICE_NOT_FOUND                                             syscall.Errno = 15299
-	ERROR_HASH_NOT_SUPPORTED                                                  syscall.Errno = 15300
-	ERROR_HASH_NOT_PRESENT                                                    syscall.Errno = 15301
-	ERROR_SECONDARY_IC_PROVIDER_NOT_REGISTERED                                syscall.Errno = 15321
-	ERROR_GPIO_CLIENT_INFORMATION_INVALID                                     syscall.Errno = 15322
-	ERROR_GPIO_VERSION_NOT_SUPPORTED                                          syscall.Errno = 15323
-	ERROR_GPIO_INVALID_REGISTRATION_PACKET                                    syscall.Errno = 15324
-	ERROR_GPIO_OPERATION_DENIED                                               syscall.Errno = 15325
-	ERROR_GPIO_INCOMPATIBLE_CONNECT_MODE                                      syscall.Errno = 15326
-	ERROR_GPIO_INTERRUPT_ALREADY_UNMASKED                                     syscall.Errno = 15327
-	ERROR_CANNOT_SWITCH_RUNLEVEL                                              syscall.Errno = 15400
-	ERROR_INVALID_RUNLEVEL_SETTING                                            syscall.Errno = 15401
-	ERROR_RUNLEVEL_SWITCH_TIMEOUT                                             syscall.Errno = 15402
-	ERROR_RUNLEVEL_SWITCH_AGENT_TIMEOUT                                       syscall.Errno = 15403
-	ERROR_RUNLEVEL_SWITCH_IN_PROGRESS                                         syscall.Errno = 15404
-	ERROR_SERVICES_FAILED_AUTOSTART                                           syscall.Errno = 15405
-	ERROR_COM_TASK_STOP_PENDING                                               syscall.Errno = 15501
-	ERROR_INSTALL_OPEN_PACKAGE_FAILED                                         syscall.Errno = 15600
-	ERROR_INSTALL_PACKAGE_NOT_FOUND                                           syscall.Errno = 15601
-	ERROR_INSTALL_INVALID_PACKAGE                                             syscall.Errno = 15602
-	ERROR_INSTALL_RESOLVE_DEPENDENCY_FAILED                                   syscall.Errno = 15603
-	ERROR_INSTALL_OUT_OF_DISK_SPACE                                           syscall.Errno = 15604
-	ERROR_INSTALL_NETWORK_FAILURE                                             syscall.Errno = 15605
-	ERROR_INSTALL_REGISTRATION_FAILURE                                        syscall.Errno = 15606
-	ERROR_INSTALL_DEREGISTRATION_FAILURE                                      syscall.Errno = 15607
-	ERROR_INSTALL_CANCEL                                                      syscall.Errno = 15608
-	ERROR_INSTALL_FAILED                                                      syscall.Errno = 15609
-	ERROR_REMOVE_FAILED                                                       syscall.Errno = 15610
-	ERROR_PACKAGE_ALREADY_EXISTS                                              syscall.Errno = 15611
-	ERROR_NEEDS_REMEDIATION                                                   syscall.Errno = 15612
-	ERROR_INSTALL_PREREQUISITE_FAILED                                         syscall.Errno = 15613
-	ERROR_PACKAGE_REPOSITORY_CORRUPTED                                        syscall.Errno = 15614
-	ERROR_INSTALL_POLICY_FAILURE                                              syscall.Errno = 15615
-	ERROR_PACKAGE_UPDATING                                                    syscall.Errno = 15616
-	ERROR_DEPLOYMENT_BLOCKED_BY_POLICY                                        syscall.Errno = 15617
-	ERROR_PACKAGES_IN_USE                                                     syscall.Errno = 15618
-	ERROR_RECOVERY_FILE_CORRUPT                                               syscall.Errno = 15619
-	ERROR_INVALID_STAGED_SIGNATURE                                            syscall.Errno = 15620
-	ERROR_DELETING_EXISTING_APPLICATIONDATA_STORE_FAILED                      syscall.Errno = 15621
-	ERROR_INSTALL_PACKAGE_DOWNGRADE                                           syscall.Errno = 15622
-	ERROR_SYSTEM_NEEDS_REMEDIATION                                            syscall.Errno = 15623
-	ERROR_APPX_INTEGRITY_FAILURE_CLR_NGEN                                     syscall.Errno = 15624
-	ERROR_RESILIENCY_FILE_CORRUPT                                             syscall.Errno = 15625
-	ERROR_INSTALL_FIREWALL_SERVICE_NOT_RUNNING                                syscall.Errno = 15626
-	ERROR_PACKAGE_MOVE_FAILED                                                 syscall.Errno = 15627
-	ERROR_INSTALL_VOLUME_NOT_EMPTY                                            syscall.Errno = 15628
-	ERROR_INSTALL_VOLUME_OFFLINE                                              syscall.Errno = 15629
-	ERROR_INSTALL_VOLUME_CORRUPT                                              syscall.Errno = 15630
-	ERROR_NEEDS_REGISTRATION                                                  syscall.Errno = 15631
-	ERROR_INSTALL_WRONG_PROCESSOR_ARCHITECTURE                                syscall.Errno = 15632
-	ERROR_DEV_SIDELOAD_LIMIT_EXCEEDED                                         syscall.Errno = 15633
-	ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE                      syscall.Errno = 15634
-	ERROR_PACKAGE_NOT_SUPPORTED_ON_FILESYSTEM                                 syscall.Errno = 15635
-	ERROR_PACKAGE_MOVE_BLOCKED_BY_STREAMING                                   syscall.Errno = 15636
-	ERROR_INSTALL_OPTIONAL_PACKAGE_APPLICATIONID_NOT_UNIQUE                   syscall.Errno = 15637
-	ERROR_PACKAGE_STAGING_ONHOLD                                              syscall.Errno = 15638
-	ERROR_INSTALL_INVALID_RELATED_SET_UPDATE                                  syscall.Errno = 15639
-	ERROR_INSTALL_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_FULLTRUST_CAPABILITY syscall.Errno = 15640
-	ERROR_DEPLOYMENT_BLOCKED_BY_USER_LOG_OFF                                  syscall.Errno = 15641
-	ERROR_PROVISION_OPTIONAL_PACKAGE_REQUIRES_MAIN_PACKAGE_PROVISIONED        syscall.Errno = 15642
-	ERROR_PACKAGES_REPUTATION_CHECK_FAILED                                    syscall.Errno = 15643
-	ERROR_PACKAGES_REPUTATION_CHECK_TIMEDOUT                                  syscall.Errno = 15644
-	ERROR_DEPLOYMENT_OPTION_NOT_SUPPORTED                                     syscall.Errno = 15645
-	ERROR_APPINSTALLER_ACTIVATION_BLOCKED                                     syscall.Errno = 15646
-	ERROR_REGISTRATION_FROM_REMOTE_DRIVE_NOT_SUPPORTED                        syscall.Errno = 15647
-	APPMODEL_ERROR_NO_PACKAGE                                                 syscall.Errno = 15700
-	APPMODEL_ERROR_PACKAGE_RUNTIME_CORRUPT                                    syscall.Errno = 15701
-	APPMODEL_ERROR_PACKAGE_IDENTITY_CORRUPT                                   syscall.Errno = 15702
-	APPMODEL_ERROR_NO_APPLICATION                                             syscall.Errno = 15703
-	APPMODEL_ERROR_DYNAMIC_PROPERTY_READ_FAILED                               syscall.Errno = 15704
-	APPMODEL_ERROR_DYNAMIC_PROPERTY_INVALID                                   syscall.Errno = 15705
-	APPMODEL_ERROR_PACKAGE_NOT_AVAILABLE                                      syscall.Errno = 15706
-	ERROR_STATE_LOAD_STORE_FAILED                                             syscall.Errno = 15800
-	ERROR_STATE_GET_VERSION_FAILED                                            syscall.Errno = 15801
-	ERROR_STATE_SET_VERSION_FAILED                                            syscall.Errno = 15802
-	ERROR_STATE_STRUCTURED_RESET_FAILED                                       syscall.Errno = 15803
-	ERROR_STATE_OPEN_CONTAINER_FAILED                                         syscall.Errno = 15804
-	ERROR_STATE_CREATE_CONTAINER_FAILED                                       syscall.Errno = 15805
-	ERROR_STATE_DELETE_CONTAINER_FAILED                                       syscall.Errno = 15806
-	ERROR_STATE_READ_SETTING_FAILED                                           syscall.Errno = 15807
-	ERROR_STATE_WRITE_SETTING_FAILED                                          syscall.Errno = 15808
-	ERROR_STATE_DELETE_SETTING_FAILED                                         syscall.Errno = 15809
-	ERROR_STATE_QUERY_SETTING_FAILED                                          syscall.Errno = 15810
-	ERROR_STATE_READ_COMPOSITE_SETTING_FAILED                                 syscall.Errno = 15811
-	ERROR_STATE_WRITE_COMPOSITE_SETTING_FAILED                                syscall.Errno = 15812
-	ERROR_STATE_ENUMERATE_CONTAINER_FAILED                                    syscall.Errno = 15813
-	ERROR_STATE_ENUMERATE_SETTINGS_FAILED                                     syscall.Errno = 15814
-	ERROR_STATE_COMPOSITE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                   syscall.Errno = 15815
-	ERROR_STATE_SETTING_VALUE_SIZE_LIMIT_EXCEEDED                             syscall.Errno = 15816
-	ERROR_STATE_SETTING_NAME_SIZE_LIMIT_EXCEEDED                              syscall.Errno = 15817
-	ERROR_STATE_CONTAINER_NAME_SIZE_LIMIT_EXCEEDED                            syscall.Errno = 15818
-	ERROR_API_UNAVAILABLE                                                     syscall.Errno = 15841
-	STORE_ERROR_UNLICENSED                                                    syscall.Errno = 15861
-	STORE_ERROR_UNLICENSED_USER                                               syscall.Errno = 15862
-	STORE_ERROR_PENDING_COM_TRANSACTION                                       syscall.Errno = 15863
-	STORE_ERROR_LICENSE_REVOKED                                               syscall.Errno = 15864
-	SEVERITY_SUCCESS                                                          syscall.Errno = 0
-	SEVERITY_ERROR                                                            syscall.Errno = 1
-	FACILITY_NT_BIT                                                                         = 0x10000000
-	E_NOT_SET                                                                               = ERROR_NOT_FOUND
-	E_NOT_VALID_STATE                                                                       = ERROR_INVALID_STATE
-	E_NOT_SUFFICIENT_BUFFER                                                                 = ERROR_INSUFFICIENT_BUFFER
-	E_TIME_CRITICAL_THREAD                                                                  = ERROR_TIME_CRITICAL_THREAD
-	NOERROR                                                                   syscall.Errno = 0
-	E_UNEXPECTED                                                              Handle        = 0x8000FFFF
-	E_NOTIMPL                                                                 Handle        = 0x80004001
-	E_OUTOFMEMORY                                                             Handle        = 0x8007000E
-	E_INVALIDARG                                                              Handle        = 0x80070057
-	E_NOINTERFACE                                                             Handle        = 0x80004002
-	E_POINTER                                                                 Handle        = 0x80004003
-	E_HANDLE                                                                  Handle        = 0x80070006
-	E_ABORT                                                                   Handle        = 0x80004004
-	E_FAIL                                                                    Handle        = 0x80004005
-	E_ACCESSDENIED                                                            Handle        = 0x80070005
-	E_PENDING                                                                 Handle        = 0x8000000A
-	E_BOUNDS                                                                  Handle        = 0x8000000B
-	E_CHANGED_STATE                                                           Handle        = 0x8000000C
-	E_ILLEGAL_STATE_CHANGE                                                    Handle        = 0x8000000D
-	E_ILLEGAL_METHOD_CALL                                                     Handle        = 0x8000000E
-	RO_E_METADATA_NAME_NOT_FOUND                                              Handle        = 0x8000000F
-	RO_E_METADATA_NAME_IS_NAMESPACE                                           Handle        = 0x80000010
-	RO_E_METADATA_INVALID_TYPE_FORMAT                                         Handle        = 0x80000011
-	RO_E_INVALID_METADATA_FILE                                                Handle        = 0x80000012
-	RO_E_CLOSED                                                               Handle        = 0x80000013
-	RO_E_EXCLUSIVE_WRITE                                                      Handle        = 0x80000014
-	RO_E_CHANGE_NOTIFICATION_IN_PROGRESS                                      Handle        = 0x80000015
-	RO_E_ERROR_STRING_NOT_FOUND                                               Handle        = 0x80000016
-	E_STRING_NOT_NULL_TERMINATED                                              Handle        = 0x80000017
-	E_ILLEGAL_DELEGATE_ASSIGNMENT                                             Handle        = 0x80000018
-	E_ASYNC_OPERATION_NOT_STARTED                                             Handle        = 0x80000019
-	E_APPLICATION_EXITING                                                     Handle        = 0x8000001A
-	E_APPLICATION_VIEW_EXITING                                                Handle        = 0x8000001B
-	RO_E_MUST_BE_AGILE                                                        Handle        = 0x8000001C
-	RO_E_UNSUPPORTED_FROM_MTA                                                 Handle        = 0x8000001D
-	RO_E_COMMITTED                                                            Handle        = 0x8000001E
-	RO_E_BLOCKED_CROSS_ASTA_CALL                                              Handle        = 0x8000001F
-	RO_E_CANNOT_ACTIVATE_FULL_TRUST_SERVER                                    Handle        = 0x80000020
-	RO_E_CANNOT_ACTIVATE_UNIVERSAL_APPLICATION_SERVER                         Handle        = 0x80000021
-	CO_E_INIT_TLS                                                             Handle        = 0x80004006
-	CO_E_INIT_SHARED_ALLOCATOR                                                Handle        = 0x80004007
-	CO_E_INIT_MEMORY_ALLOCATOR                                                Handle        = 0x80004008
-	CO_E_INIT_CLASS_CACHE                                                     Handle        = 0x80004009
-	CO_E_INIT_RPC_CHANNEL                                                     Handle        = 0x8000400A
-	CO_E_INIT_TLS_SET_CHANNEL_CONTROL                                         Handle        = 0x8000400B
-	CO_E_INIT_TLS_CHANNEL_CONTROL                                             Handle        = 0x8000400C
-	CO_E_INIT_UNACCEPTED_USER_ALLOCATOR                                       Handle        = 0x8000400D
-	CO_E_INIT_SCM_MUTEX_EXISTS                                                Handle        = 0x8000400E
-	CO_E_INIT_SCM_FILE_MAPPING_EXISTS                                         Handle        = 0x8000400F
-	CO_E_INIT_SCM_MAP_VIEW_OF_FILE                                            Handle        = 0x80004010
-	CO_E_INIT_SCM_EXEC_FAILURE                                                Handle        = 0x80004011
-	CO_E_INIT_ONLY_SINGLE_THREADED                                            Handle        = 0x80004012
-	CO_E_CANT_REMOTE                                                          Handle        = 0x80004013
-	CO_E_BAD_SERVER_NAME                                                      Handle        = 0x80004014
-	CO_E_WRONG_SERVER_IDENTITY                                                Handle        = 0x80004015
-	CO_E_OLE1DDE_DISABLED                                                     Handle        = 0x80004016
-	CO_E_RUNAS_SYNTAX                                                         Handle        = 0x80004017
-	CO_E_CREATEPROCESS_FAILURE                                                Handle        = 0x80004018
-	CO_E_RUNAS_CREATEPROCESS_FAILURE                                          Handle        = 0x80004019
-	CO_E_RUNAS_LOGON_FAILURE                                                  Handle        = 0x8000401A
-	CO_E_LAUNCH_PERMSSION_DENIED                                              Handle        = 0x8000401B
-	CO_E_START_SERVICE_FAILURE                                                Handle        = 0x8000401C
-	CO_E_REMOTE_COMMUNICATION_FAILURE                                         Handle        = 0x8000401D
-	CO_E_SERVER_START_TIMEOUT                                                 Handle        = 0x8000401E
-	CO_E_CLSREG_INCONSISTENT                                                  Handle        = 0x8000401F
-	CO_E_IIDREG_INCONSISTENT                                                  Handle        = 0x80004020
-	CO_E_NOT_SUPPORTED                                                        Handle        = 0x80004021
-	CO_E_RELOAD_DLL                                                           Handle        = 0x80004022
-	CO_E_MSI_ERROR                                                            Handle        = 0x80004023
-	CO_E_ATTEMPT_TO_CREATE_OUTSIDE_CLIENT_CONTEXT                             Handle        = 0x80004024
-	CO_E_SERVER_PAUSED                                                        Handle        = 0x80004025
-	CO_E_SERVER_NOT_PAUSED                                                    Handle        = 0x80004026
-	CO_E_CLASS_DISABLED                                                       Handle        = 0x80004027
-	CO_E_CLRNOTAVAILABLE                                                      Handle        = 0x80004028
-	CO_E_ASYNC_WORK_REJECTED                                                  Handle        = 0x80004029
-	CO_E_SERVER_INIT_TIMEOUT                                                  Handle        = 0x8000402A
-	CO_E_NO_SECCTX_IN_ACTIVATE                                                Handle        = 0x8000402B
-	CO_E_TRACKER_CONFIG                                                       Handle        = 0x80004030
-	CO_E_THREADPOOL_CONFIG                                                    Handle        = 0x80004031
-	CO_E_SXS_CONFIG                                                           Handle        = 0x80004032
-	CO_E_MALFORMED_SPN                                                        Handle        = 0x80004033
-	CO_E_UNREVOKED_REGISTRATION_ON_APARTMENT_SHUTDOWN                         Handle        = 0x80004034
-	CO_E_PREMATURE_STUB_RUNDOWN                                               Handle        = 0x80004035
-	S_OK                                                                      Handle        = 0
-	S_FALSE                                                                   Handle        = 1
-	OLE_E_FIRST                                                               Handle        = 0x80040000
-	OLE_E_LAST                                                                Handle        = 0x800400FF
-	OLE_S_FIRST                                                               Handle        = 0x00040000
-	OLE_S_LAST                                                                Handle        = 0x000400FF
-	OLE_E_OLEVERB                                                             Handle        = 0x80040000
-	OLE_E_ADVF                                                                Handle        = 0x80040001
-	OLE_E_ENUM_NOMORE                                                         Handle        = 0x80040002
-	OLE_E_ADVISENOTSUPPORTED                                                  Handle        = 0x80040003
-	OLE_E_NOCONNECTION                                                        Handle        = 0x80040004
-	OLE_E_NOTRUNNING                                                          Handle        = 0x80040005
-	OLE_E_NOCACHE                                                             Handle        = 0x80040006
-	OLE_E_BLANK                                                               Handle        = 0x80040007
-	OLE_E_CLASSDIFF                                                           Handle        = 0x80040008
-	OLE_E_CANT_GETMONIKER                                                     Handle        = 0x80040009
-	OLE_E_CANT_BINDTOSOURCE                                                   Handle        = 0x8004000A
-	OLE_E_STATIC                                                              Handle        = 0x8004000B
-	OLE_E_PROMPTSAVECANCELLED                                                 Handle        = 0x8004000C
-	OLE_E_INVALIDRECT                                                         Handle        = 0x8004000D
-	OLE_E_WRONGCOMPOBJ                                                        Handle        = 0x8004000E
-	OLE_E_INVALIDHWND                                                         Handle        = 0x8004000F
-	OLE_E_NOT_INPLACEACTIVE                                                   Handle        = 0x80040010
-	OLE_E_CANTCONVERT                                                         Handle        = 0x80040011
-	OLE_E_NOSTORAGE                                                           Handle        = 0x80040012
-	DV_E_FORMATETC                                                            Handle        = 0x80040064
-	DV_E_DVTARGETDEVICE                                                       Handle        = 0x80040065
-	DV_E_STGMEDIUM                                                            Handle        = 0x80040066
-	DV_E_STATDATA                                                             Handle        = 0x80040067
-	DV_E_LINDEX                                                               Handle        = 0x80040068
-	DV_E_TYMED                                                                Handle        = 0x80040069
-	DV_E_CLIPFORMAT                                                           Handle        = 0x8004006A
-	DV_E_DVASPECT                                                             Handle        = 0x8004006B
-	DV_E_DVTARGETDEVICE_SIZE                                                  Handle        = 0x8004006C
-	DV_E_NOIVIEWOBJECT                                                        Handle        = 0x8004006D
-	DRAGDROP_E_FIRST                                                          syscall.Errno = 0x80040100
-	DRAGDROP_E_LAST                                                           syscall.Errno = 0x8004010F
-	DRAGDROP_S_FIRST                                                          syscall.Errno = 0x00040100
-	DRAGDROP_S_LAST                                                           syscall.Errno = 0x0004010F
-	DRAGDROP_E_NOTREGISTERED                                                  Handle        = 0x80040100
-	DRAGDROP_E_ALREADYREGISTERED                                              Handle        = 0x80040101
-	DRAGDROP_E_INVALIDHWND                                                    Handle        = 0x80040102
-	DRAGDROP_E_CONCURRENT_DRAG_ATTEMPTED                                      Handle        = 0x80040103
-	CLASSFACTORY_E_FIRST                                                      syscall.Errno = 0x80040110
-	CLASSFACTORY_E_LAST                                                       syscall.Errno = 0x8004011F
-	CLASSFACTORY_S_FIRST                                                      syscall.Errno = 0x00040110
-	CLASSFACTORY_S_LAST                                                       syscall.Errno = 0x0004011F
-	CLASS_E_NOAGGREGATION                                                     Handle        = 0x80040110
-	CLASS_E_CLASSNOTAVAILABLE                                                 Handle        = 0x80040111
-	CLASS_E_NOTLICENSED                                                       Handle        = 0x80040112
-	MARSHAL_E_FIRST                                                           syscall.Errno = 0x80040120
-	MARSHAL_E_LAST                                                            syscall.Errno = 0x8004012F
-	MARSHAL_S_FIRST                                                           syscall.Errno = 0x00040120
-	MARSHAL_S_LAST                                                            syscall.Errno = 0x0004012F
-	DATA_E_FIRST                                                              syscall.Errno = 0x80040130
-	DATA_E_LAST                                                               syscall.Errno = 0x8004013F
-	DATA_S_FIRST                                                              syscall.Errno = 0x00040130
-	DATA_S_LAST                                                               syscall.Errno = 0x0004013F
-	VIEW_E_FIRST                                                              syscall.Errno = 0x80040140
-	VIEW_E_LAST                                                               syscall.Errno = 0x8004014F
-	VIEW_S_FIRST                                                              syscall.Errno = 0x00040140
-	VIEW_S_LAST                                                               syscall.Errno = 0x0004014F
-	VIEW_E_DRAW                                                               Handle        = 0x80040140
-	REGDB_E_FIRST                                                             syscall.Errno = 0x80040150
-	REGDB_E_LAST                                                              syscall.Errno = 0x8004015F
-	REGDB_S_FIRST                                                             syscall.Errno = 0x00040150
-	REGDB_S_LAST                                                              syscall.Errno = 0x0004015F
-	REGDB_E_READREGDB                                                         Handle        = 0x80040150
-	REGDB_E_WRITEREGDB                                                        Handle        = 0x80040151
-	REGDB_E_KEYMISSING                                                        Handle        = 0x80040152
-	REGDB_E_INVALIDVALUE                                                      Handle        = 0x80040153
-	REGDB_E_CLASSNOTREG                                                       Handle        = 0x80040154
-	REGDB_E_IIDNOTREG                                                         Handle        = 0x80040155
-	REGDB_E_BADTHREADINGMODEL                                                 Handle        = 0x80040156
-	REGDB_E_PACKAGEPOLICYVIOLATION                                            Handle        = 0x80040157
-	CAT_E_FIRST                                                               syscall.Errno = 0x80040160
-	CAT_E_LAST                                                                syscall.Errno = 0x80040161
-	CAT_E_CATIDNOEXIST                                                        Handle        = 0x80040160
-	CAT_E_NODESCRIPTION                                                       Handle        = 0x80040161
-	CS_E_FIRST                                                                syscall.Errno = 0x80040164
-	CS_E_LAST                                                                 syscall.Errno = 0x8004016F
-	CS_E_PACKAGE_NOTFOUND                                                     Handle        = 0x80040164
-	CS_E_NOT_DELETABLE                                                        Handle        = 0x80040165
-	CS_E_CLASS_NOTFOUND                                                       Handle        = 0x80040166
-	CS_E_INVALID_VERSION                                                      Handle        = 0x80040167
-	CS_E_NO_CLASSSTORE                                                        Handle        = 0x80040168
-	CS_E_OBJECT_NOTFOUND                                                      Handle        = 0x80040169
-	CS_E_OBJECT_ALREADY_EXISTS                                                Handle        = 0x8004016A
-	CS_E_INVALID_PATH                                                         Handle        = 0x8004016B
-	CS_E_NETWORK_ERROR                                                        Handle        = 0x8004016C
-	CS_E_ADMIN_LIMIT_EXCEEDED                                                 Handle        = 0x8004016D
-	CS_E_SCHEMA_MISMATCH                                                      Handle        = 0x8004016E
-	CS_E_INTERNAL_ERROR                                                       Handle        = 0x8004016F
-	CACHE_E_FIRST                                                             syscall.Errno = 0x80040170
-	CACHE_E_LAST                                                              syscall.Errno = 0x8004017F
-	CACHE_S_FIRST                                                             syscall.Errno = 0x00040170
-	CACHE_S_LAST                                                              syscall.Errno = 0x0004017F
-	CACHE_E_NOCACHE_UPDATED                                                   Handle        = 0x80040170
-	OLEOBJ_E_FIRST                                                            syscall.Errno = 0x80040180
-	OLEOBJ_E_LAST                                                             syscall.Errno = 0x8004018F
-	OLEOBJ_S_FIRST                                                            syscall.Errno = 0x00040180
-	OLEOBJ_S_LAST                                                             syscall.Errno = 0x0004018F
-	OLEOBJ_E_NOVERBS                                                          Handle        = 0x80040180
-	OLEOBJ_E_INVALIDVERB                                                      Handle        = 0x80040181
-	CLIENTSITE_E_FIRST                                                        syscall.Errno = 0x80040190
-	CLIENTSITE_E_LAST                                                         syscall.Errno = 0x8004019F
-	CLIENTSITE_S_FIRST                                                        syscall.Errno = 0x00040190
-	CLIENTSITE_S_LAST                                                         syscall.Errno = 0x0004019F
-	INPLACE_E_NOTUNDOABLE                                                     Handle        = 0x800401A0
-	INPLACE_E_NOTOOLSPACE                                                     Handle        = 0x800401A1
-	INPLACE_E_FIRST                                                           syscall.Errno = 0x800401A0
-	INPLACE_E_LAST                                                            syscall.Errno = 0x800401AF
-	INPLACE_S_FIRST                                                           syscall.Errno = 0x000401A0
-	INPLACE_S_LAST                                                            syscall.Errno = 0x000401AF
-	ENUM_E_FIRST                                                              syscall.Errno = 0x800401B0
-	ENUM_E_LAST                                                               syscall.Errno = 0x800401BF
-	ENUM_S_FIRST                                                              syscall.Errno = 0x000401B0
-	ENUM_S_LAST                                                               syscall.Errno = 0x000401BF
-	CONVERT10_E_FIRST                                                         syscall.Errno = 0x800401C0
-	CONVERT10_E_LAST                                                          syscall.Errno = 0x800401CF
-	CONVERT10_S_FIRST                                                         syscall.Errno = 0x000401C0
-	CONVERT10_S_LAST                                                          syscall.Errno = 0x000401CF
-	CONVERT10_E_OLESTREAM_GET                                                 Handle        = 0x800401C0
-	CONVERT10_E_OLESTREAM_PUT                                                 Handle        = 0x800401C1
-	CONVERT10_E_OLESTREAM_FMT                                                 Handle        = 0x800401C2
-	CONVERT10_E_OLESTREAM_BITMAP_TO_DIB                                       Handle        = 0x800401C3
-	CONVERT10_E_STG_FMT                                                       Handle        = 0x800401C4
-	CONVERT10_E_STG_NO_STD_STREAM                                             Handle        = 0x800401C5
-	CONVERT10_E_STG_DIB_TO_BITMAP                                             Handle        = 0x800401C6
-	CLIPBRD_E_FIRST                                                           syscall.Errno = 0x800401D0
-	CLIPBRD_E_LAST                                                            syscall.Errno = 0x800401DF
-	CLIPBRD_S_FIRST                                                           syscall.Errno = 0x000401D0
-	CLIPBRD_S_LAST                                                            syscall.Errno = 0x000401DF
-	CLIPBRD_E_CANT_OPEN                                                       Handle        = 0x800401D0
-	CLIPBRD_E_CANT_EMPTY                                                      Handle        = 0x800401D1
-	CLIPBRD_E_CANT_SET                                                        Handle        = 0x800401D2
-	CLIPBRD_E_BAD_DATA                                                        Handle        = 0x800401D3
-	CLIPBRD_E_CANT_CLOSE                                                      Handle        = 0x800401D4
-	MK_E_FIRST                                                                syscall.Errno = 0x800401E0
-	MK_E_LAST                                                                 syscall.Errno = 0x800401EF
-	MK_S_FIRST                                                                syscall.Errno = 0x000401E0
-	MK_S_LAST                                                                 syscall.Errno = 0x000401EF
-	MK_E_CONNECTMANUALLY                                                      Handle        = 0x800401E0
-	MK_E_EXCEEDEDDEADLINE                                                     Handle        = 0x800401E1
-	MK_E_NEEDGENERIC                                                          Handle        = 0x800401E2
-	MK_E_UNAVAILABLE                                                          Handle        = 0x800401E3
-	MK_E_SYNTAX                                                               Handle        = 0x800401E4
-	MK_E_NOOBJECT                                                             Handle        = 0x800401E5
-	MK_E_INVALIDEXTENSION                                                     Handle        = 0x800401E6
-	MK_E_INTERMEDIATEINTERFACENOTSUPPORTED                                    Handle        = 0x800401E7
-	MK_E_NOTBINDABLE                                                          Handle        = 0x800401E8
-	MK_E_NOTBOUND                                                             Handle        = 0x800401E9
-	MK_E_CANTOPENFILE                                                         Handle        = 0x800401EA
-	MK_E_MUSTBOTHERUSER                                                       Handle        = 0x800401EB
-	MK_E_NOINVERSE                                                            Handle        = 0x800401EC
-	MK_E_NOSTORAGE                                                            Handle        = 0x800401ED
-	MK_E_NOPREFIX                                                             Handle        = 0x800401EE
-	MK_E_ENUMERATION_FAILED                                                   Handle        = 0x800401EF
-	CO_E_FIRST                                                                syscall.Errno = 0x800401F0
-	CO_E_LAST                                                                 syscall.Errno = 0x800401FF
-	CO_S_FIRST                                                                syscall.Errno = 0x000401F0
-	CO_S_LAST                                                                 syscall.Errno = 0x000401FF
-	CO_E_NOTINITIALIZED                                                       Handle        = 0x800401F0
-	CO_E_ALREADYINITIALIZED                                                   Handle        = 0x800401F1
-	CO_E_CANTDETERMINECLASS                                                   Handle        = 0x800401F2
-	CO_E_CLASSSTRING                                                          Handle        = 0x800401F3
-	CO_E_IIDSTRING                                                            Handle        = 0x800401F4
-	CO_E_APPNOTFOUND                                                          Handle        = 0x800401F5
-	CO_E_APPSINGLEUSE                                                         Handle        = 0x800401F6
-	CO_E_ERRORINAPP                                                           Handle        = 0x800401F7
-	CO_E_DLLNOTFOUND                                                          Handle        = 0x800401F8
-	CO_E_ERRORINDLL                                                           Handle        = 0x800401F9
-	CO_E_WRONGOSFORAPP                                                        Handle        = 0x800401FA
-	CO_E_OBJNOTREG                                                            Handle        = 0x800401FB
-	CO_E_OBJISREG                                                             Handle        = 0x800401FC
-	CO_E_OBJNOTCONNECTED                                                      Handle        = 0x800401FD
-	CO_E_APPDIDNTREG                                                          Handle        = 0x800401FE
-	CO_E_RELEASED                                                             Handle        = 0x800401FF
-	EVENT_E_FIRST                                                             syscall.Errno = 0x80040200
-	EVENT_E_LAST                                                              syscall.Errno = 0x8004021F
-	EVENT_S_FIRST                                                             syscall.Errno = 0x00040200
-	EVENT_S_LAST                                                              syscall.Errno = 0x0004021F
-	EVENT_S_SOME_SUBSCRIBERS_FAILED                                           Handle        = 0x00040200
-	EVENT_E_ALL_SUBSCRIBERS_FAILED                                            Handle        = 0x80040201
-	EVENT_S_NOSUBSCRIBERS                                                     Handle        = 0x00040202
-	EVENT_E_QUERYSYNTAX                                                       Handle        = 0x80040203
-	EVENT_E_QUERYFIELD                                                        Handle        = 0x80040204
-	EVENT_E_INTERNALEXCEPTION                                                 Handle        = 0x80040205
-	EVENT_E_INTERNALERROR                                                     Handle        = 0x80040206
-	EVENT_E_INVALID_PER_USER_SID                                              Handle        = 0x80040207
-	EVENT_E_USER_EXCEPTION                                                    Handle        = 0x80040208
-	EVENT_E_TOO_MANY_METHODS                                                  Handle        = 0x80040209
-	EVENT_E_MISSING_EVENTCLASS                                                Handle        = 0x8004020A
-	EVENT_E_NOT_ALL_REMOVED                                                   Handle        = 0x8004020B
-	EVENT_E_COMPLUS_NOT_INSTALLED                                             Handle        = 0x8004020C
-	EVENT_E_CANT_MODIFY_OR_DELETE_UNCONFIGURED_OBJECT                         Handle        = 0x8004020D
-	EVENT_E_CANT_MODIFY_OR_DELETE_CONFIGURED_OBJECT                           Handle        = 0x8004020E
-	EVENT_E_INVALID_EVENT_CLASS_PARTITION                                     Handle        = 0x8004020F
-	EVENT_E_PER_USER_SID_NOT_LOGGED_ON                                        Handle        = 0x80040210
-	TPC_E_INVALID_PROPERTY                                                    Handle        = 0x80040241
-	TPC_E_NO_DEFAULT_TABLET                                                   Handle        = 0x80040212
-	TPC_E_UNKNOWN_PROPERTY                                                    Handle        = 0x8004021B
-	TPC_E_INVALID_INPUT_RECT                                                  Handle        = 0x80040219
-	TPC_E_INVALID_STROKE                                                      Handle        = 0x80040222
-	TPC_E_INITIALIZE_FAIL                                                     Handle        = 0x80040223
-	TPC_E_NOT_RELEVANT                                                        Handle        = 0x80040232
-	TPC_E_INVALID_PACKET_DESCRIPTION                                          Handle        = 0x80040233
-	TPC_E_RECOGNIZER_NOT_REGISTERED                                           Handle        = 0x80040235
-	TPC_E_INVALID_RIGHTS                                                      Handle        = 0x80040236
-	TPC_E_OUT_OF_ORDER_CALL                                                   Handle        = 0x80040237
-	TPC_E_QUEUE_FULL                                                          Handle        = 0x80040238
-	TPC_E_INVALID_CONFIGURATION                                               Handle        = 0x80040239
-	TPC_E_INVALID_DATA_FROM_RECOGNIZER                                        Handle        = 0x8004023A
-	TPC_S_TRUNCATED                                                           Handle        = 0x00040252
-	TPC_S_INTERRUPTED                                                         Handle        = 0x00040253
-	TPC_S_NO_DATA_TO_PROCESS                                                  Handle        = 0x00040254
-	XACT_E_FIRST                                                              syscall.Errno = 0x8004D000
-	XACT_E_LAST                                                               syscall.Errno = 0x8004D02B
-	XACT_S_FIRST                                                              syscall.Errno = 0x0004D000
-	XACT_S_LAST                                                               syscall.Errno = 0x0004D010
-	XACT_E_ALREADYOTHERSINGLEPHASE                                            Handle        = 0x8004D000
-	XACT_E_CANTRETAIN                                                         Handle        = 0x8004D001
-	XACT_E_COMMITFAILED                                                       Handle        = 0x8004D002
-	XACT_E_COMMITPREVENTED                                                    Handle        = 0x8004D003
-	XACT_E_HEURISTICABORT                                                     Handle        = 0x8004D004
-	XACT_E_HEURISTICCOMMIT                                                    Handle        = 0x8004D005
-	XACT_E_HEURISTICDAMAGE                                                    Handle        = 0x8004D006
-	XACT_E_HEURISTICDANGER                                                    Handle        = 0x8004D007
-	XACT_E_ISOLATIONLEVEL                                                     Handle        = 0x8004D008
-	XACT_E_NOASYNC                                                            Handle        = 0x8004D009
-	XACT_E_NOENLIST                                                           Handle        = 0x8004D00A
-	XACT_E_NOISORETAIN                                                        Handle        = 0x8004D00B
-	XACT_E_NORESOURCE                                                         Handle        = 0x8004D00C
-	XACT_E_NOTCURRENT                                                         Handle        = 0x8004D00D
-	XACT_E_NOTRANSACTION                                                      Handle        = 0x8004D00E
-	XACT_E_NOTSUPPORTED                                                       Handle        = 0x8004D00F
-	XACT_E_UNKNOWNRMGRID                                                      Handle        = 0x8004D010
-	XACT_E_WRONGSTATE                                                         Handle        = 0x8004D011
-	XACT_E_WRONGUOW                                                           Handle        = 0x8004D012
-	XACT_E_XTIONEXISTS                                                        Handle        = 0x8004D013
-	XACT_E_NOIMPORTOBJECT                                                     Handle        = 0x8004D014
-	XACT_E_INVALIDCOOKIE                                                      Handle        = 0x8004D015
-	XACT_E_INDOUBT                                                            Handle        = 0x8004D016
-	XACT_E_NOTIMEOUT                                                          Handle        = 0x8004D017
-	XACT_E_ALREADYINPROGRESS                                                  Handle        = 0x8004D018
-	XACT_E_ABORTED                                                            Handle        = 0x8004D019
-	XACT_E_LOGFULL                                                            Handle        = 0x8004D01A
-	XACT_E_TMNOTAVAILABLE                                                     Handle        = 0x8004D01B
-	XACT_E_CONNECTION_DOWN                                                    Handle        = 0x8004D01C
-	XACT_E_CONNECTION_DENIED                                                  Handle        = 0x8004D01D
-	XACT_E_REENLISTTIMEOUT                                                    Handle        = 0x8004D01E
-	XACT_E_TIP_CONNECT_FAILED                                                 Handle        = 0x8004D01F
-	XACT_E_TIP_PROTOCOL_ERROR                                                 Handle        = 0x8004D020
-	XACT_E_TIP_PULL_FAILED                                                    Handle        = 0x8004D021
-	XACT_E_DEST_TMNOTAVAILABLE                                                Handle        = 0x8004D022
-	XACT_E_TIP_DISABLED                                                       Handle        = 0x8004D023
-	XACT_E_NETWORK_TX_DISABLED                                                Handle        = 0x8004D024
-	XACT_E_PARTNER_NETWORK_TX_DISABLED                                        Handle        = 0x8004D025
-	XACT_E_XA_TX_DISABLED                                                     Handle        = 0x8004D026
-	XACT_E_UNABLE_TO_READ_DTC_CONFIG                                          Handle        = 0x8004D027
-	XACT_E_UNABLE_TO_LOAD_DTC_PROXY                                           Handle        = 0x8004D028
-	XACT_E_ABORTING                                                           Handle        = 0x8004D029
-	XACT_E_PUSH_COMM_FAILURE                                                  Handle        = 0x8004D02A
-	XACT_E_PULL_COMM_FAILURE                                                  Handle        = 0x8004D02B
-	XACT_E_LU_TX_DISABLED                                                     Handle        = 0x8004D02C
-	XACT_E_CLERKNOTFOUND                                                      Handle        = 0x8004D080
-	XACT_E_CLERKEXISTS                                                        Handle        = 0x8004D081
-	XACT_E_RECOVERYINPROGRESS                                                 Handle        = 0x8004D082
-	XACT_E_TRANSACTIONCLOSED                                                  Handle        = 0x8004D083
-	XACT_E_INVALIDLSN                                                         Handle        = 0x8004D084
-	XACT_E_REPLAYREQUEST                                                      Handle        = 0x8004D085
-	XACT_S_ASYNC                                                              Handle        = 0x0004D000
-	XACT_S_DEFECT                                                             Handle        = 0x0004D001
-	XACT_S_READONLY                                                           Handle        = 0x0004D002
-	XACT_S_SOMENORETAIN                                                       Handle        = 0x0004D003
-	XACT_S_OKINFORM                                                           Handle        = 0x0004D004
-	XACT_S_MADECHANGESCONTENT                                                 Handle        = 0x0004D005
-	XACT_S_MADECHANGESINFORM                                                  Handle        = 0x0004D006
-	XACT_S_ALLNORETAIN                                                        Handle        = 0x0004D007
-	XACT_S_ABORTING                                                           Handle        = 0x0004D008
-	XACT_S_SINGLEPHASE                                                        Handle        = 0x0004D009
-	XACT_S_LOCALLY_OK                                                         Handle        = 0x0004D00A
-	XACT_S_LASTRESOURCEMANAGER                                                Handle        = 0x0004D010
-	CONTEXT_E_FIRST                                                           syscall.Errno = 0x8004E000
-	CONTEXT_E_LAST                                                            syscall.Errno = 0x8004E02F
-	CONTEXT_S_FIRST                                                           syscall.Errno = 0x0004E000
-	CONTEXT_S_LAST                                                            syscall.Errno = 0x0004E02F
-	CONTEXT_E_ABORTED                                                         Handle        = 0x8004E002
-	CONTEXT_E_ABORTING                                                        Handle        = 0x8004E003
-	CONTEXT_E_NOCONTEXT                                                       Handle        = 0x8004E004
-	CONTEXT_E_WOULD_DEADLOCK                                                  Handle        = 0x8004E005
-	CONTEXT_E_SYNCH_TIMEOUT                                                   Handle        = 0x8004E006
-	CONTEXT_E_OLDREF                                                          Handle        = 0x8004E007
-	CONTEXT_E_ROLENOTFOUND                                                    Handle        = 0x8004E00C
-	CONTEXT_E_TMNOTAVAILABLE                                                  Handle        = 0x8004E00F
-	CO_E_ACTIVATIONFAILED                                                     Handle        = 0x8004E021
-	CO_E_ACTIVATIONFAILED_EVENTLOGGED                                         Handle        = 0x8004E022
-	CO_E_ACTIVATIONFAILED_CATALOGERROR                                        Handle        = 0x8004E023
-	CO_E_ACTIVATIONFAILED_TIMEOUT                                             Handle        = 0x8004E024
-	CO_E_INITIALIZATIONFAILED                                                 Handle        = 0x8004E025
-	CONTEXT_E_NOJIT                                                           Handle        = 0x8004E026
-	CONTEXT_E_NOTRANSACTION                                                   Handle        = 0x8004E027
-	CO_E_THREADINGMODEL_CHANGED                                               Handle        = 0x8004E028
-	CO_E_NOIISINTRINSICS                                                      Handle        = 0x8004E029
-	CO_E_NOCOOKIES                                                            Handle        = 0x8004E02A
-	CO_E_DBERROR                                                              Handle        = 0x8004E02B
-	CO_E_NOTPOOLED                                                            Handle        = 0x8004E02C
-	CO_E_NOTCONSTRUCTED                                                       Handle        = 0x8004E02D
-	CO_E_NOSYNCHRONIZATION                                                    Handle        = 0x8004E02E
-	CO_E_ISOLEVELMISMATCH                                                     Handle        = 0x8004E02F
-	CO_E_CALL_OUT_OF_TX_SCOPE_NOT_ALLOWED                                     Handle        = 0x8004E030
-	CO_E_EXIT_TRANSACTION_SCOPE_NOT_CALLED                                    Handle        = 0x8004E031
-	OLE_S_USEREG                                                              Handle        = 0x00040000
-	OLE_S_STATIC                                                              Handle        = 0x00040001
-	OLE_S_MAC_CLIPFORMAT                                                      Handle        = 0x00040002
-	DRAGDROP_S_DROP                                                           Handle        = 0x00040100
-	DRAGDROP_S_CANCEL                                                         Handle        = 0x00040101
-	DRAGDROP_S_USEDEFAULTCURSORS                                              Handle        = 0x00040102
-	DATA_S_SAMEFORMATETC                                                      Handle        = 0x00040130
-	VIEW_S_ALREADY_FROZEN                                                     Handle        = 0x00040140
-	CACHE_S_FORMATETC_NOTSUPPORTED                                            Handle        = 0x00040170
-	CACHE_S_SAMECACHE                                                         Handle        = 0x00040171
-	CACHE_S_SOMECACHES_NOTUPDATED                                             Handle        = 0x00040172
-	OLEOBJ_S_INVALIDVERB                                                      Handle        = 0x00040180
-	OLEOBJ_S_CANNOT_DOVERB_NOW                                                Handle        = 0x00040181
-	OLEOBJ_S_INVALIDHWND                                                      Handle        = 0x00040182
-	INPLACE_S_TRUNCATED                                                       Handle        = 0x000401A0
-	CONVERT10_S_NO_PRESENTATION                                               Handle        = 0x000401C0
-	MK_S_REDUCED_TO_SELF                                                      Handle        = 0x000401E2
-	MK_S_ME                                                                   Handle        = 0x000401E4
-	MK_S_HIM                                                                  Handle        = 0x000401E5
-	MK_S_US                                                                   Handle        = 0x000401E6
-	MK_S_MONIKERALREADYREGISTERED                                             Handle        = 0x000401E7
-	SCHED_S_TASK_READY                                                        Handle        = 0x00041300
-	SCHED_S_TASK_RUNNING                                                      Handle        = 0x00041301
-	SCHED_S_TASK_DISABLED                                                     Handle        = 0x00041302
-	SCHED_S_TASK_HAS_NOT_RUN                                                  Handle        = 0x00041303
-	SCHED_S_TASK_NO_MORE_RUNS                                                 Handle        = 0x00041304
-	SCHED_S_TASK_NOT_SCHEDULED                                                Handle        = 0x00041305
-	SCHED_S_TASK_TERMINATED                                                   Handle        = 0x00041306
-	SCHED_S_TASK_NO_VALID_TRIGGERS                                            Handle        = 0x00041307
-	SCHED_S_EVENT_TRIGGER                                                     Handle        = 0x00041308
-	SCHED_E_TRIGGER_NOT_FOUND                                                 Handle        = 0x80041309
-	SCHED_E_TASK_NOT_READY                                                    Handle        = 0x8004130A
-	SCHED_E_TASK_NOT_RUNNING                                                  Handle        = 0x8004130B
-	SCHED_E_SERVICE_NOT_INSTALLED                                             Handle        = 0x8004130C
-	SCHED_E_CANNOT_OPEN_TASK                                                  Handle        = 0x8004130D
-	SCHED_E_INVALID_TASK                                                      Handle        = 0x8004130E
-	SCHED_E_ACCOUNT_INFORMATION_NOT_SET                                       Handle        = 0x8004130F
-	SCHED_E_ACCOUNT_NAME_NOT_FOUND                                            Handle        = 0x80041310
-	SCHED_E_ACCOUNT_DBASE_CORRUPT                                             Handle        = 0x80041311
-	SCHED_E_NO_SECURITY_SERVICES                                              Handle        = 0x80041312
-	SCHED_E_UNKNOWN_OBJECT_VERSION                                            Handle        = 0x80041313
-	SCHED_E_UNSUPPORTED_ACCOUNT_OPTION                                        Handle        = 0x80041314
-	SCHED_E_SERVICE_NOT_RUNNING                                               Handle        = 0x80041315
-	SCHED_E_UNEXPECTEDNODE                                                    Handle        = 0x80041316
-	SCHED_E_NAMESPACE                                                         Handle        = 0x80041317
-	SCHED_E_INVALIDVALUE                                                      Handle        = 0x80041318
-	SCHED_E_MISSINGNODE                                                       Handle        = 0x80041319
-	SCHED_E_MALFORMEDXML                                                      Handle        = 0x8004131A
-	SCHED_S_SOME_TRIGGERS_FAILED                                              Handle        = 0x0004131B
-	SCHED_S_BATCH_LOGON_PROBLEM                                               Handle        = 0x0004131C
-	SCHED_E_TOO_MANY_NODES                                                    Handle        = 0x8004131D
-	SCHED_E_PAST_END_BOUNDARY                                                 Handle        = 0x8004131E
-	SCHED_E_ALREADY_RUNNING                                                   Handle        = 0x8004131F
-	SCHED_E_USER_NOT_LOGGED_ON                                                Handle        = 0x80041320
-	SCHED_E_INVALID_TASK_HASH                                                 Handle        = 0x80041321
-	SCHED_E_SERVICE_NOT_AVAILABLE                                             Handle        = 0x80041322
-	SCHED_E_SERVICE_TOO_BUSY                                                  Handle        = 0x80041323
-	SCHED_E_TASK_ATTEMPTED                                                    Handle        = 0x80041324
-	SCHED_S_TASK_QUEUED                                                       Handle        = 0x00041325
-	SCHED_E_TASK_DISABLED                                                     Handle        = 0x80041326
-	SCHED_E_TASK_NOT_V1_COMPAT                                                Handle        = 0x80041327
-	SCHED_E_START_ON_DEMAND                                                   Handle        = 0x80041328
-	SCHED_E_TASK_NOT_UBPM_COMPAT                                              Handle        = 0x80041329
-	SCHED_E_DEPRECATED_FEATURE_USED                                           Handle        = 0x80041330
-	CO_E_CLASS_CREATE_FAILED                                                  Handle        = 0x80080001
-	CO_E_SCM_ERROR                                                            Handle        = 0x80080002
-	CO_E_SCM_RPC_FAILURE                                                      Handle        = 0x80080003
-	CO_E_BAD_PATH                                                             Handle        = 0x80080004
-	CO_E_SERVER_EXEC_FAILURE                                                  Handle        = 0x80080005
-	CO_E_OBJSRV_RPC_FAILURE                                                   Handle        = 0x80080006
-	MK_E_NO_NORMALIZED                                                        Handle        = 0x80080007
-	CO_E_SERVER_STOPPING                                                      Handle        = 0x80080008
-	MEM_E_INVALID_ROOT                                                        Handle        = 0x80080009
-	MEM_E_INVALID_LINK                                                        Handle        = 0x80080010
-	MEM_E_INVALID_SIZE                                                        Handle        = 0x80080011
-	CO_S_NOTALLINTERFACES                                                     Handle        = 0x00080012
-	CO_S_MACHINENAMENOTFOUND                                                  Handle        = 0x00080013
-	CO_E_MISSING_DISPLAYNAME                                                  Handle        = 0x80080015
-	CO_E_RUNAS_VALUE_MUST_BE_AAA                                              Handle        = 0x80080016
-	CO_E_ELEVATION_DISABLED                                                   Handle        = 0x80080017
-	APPX_E_PACKAGING_INTERNAL                                                 Handle        = 0x80080200
-	APPX_E_INTERLEAVING_NOT_ALLOWED                                           Handle        = 0x80080201
-	APPX_E_RELATIONSHIPS_NOT_ALLOWED                                          Handle        = 0x80080202
-	APPX_E_MISSING_REQUIRED_FILE                                              Handle        = 0x80080203
-	APPX_E_INVALID_MANIFEST                                                   Handle        = 0x80080204
-	APPX_E_INVALID_BLOCKMAP                                                   Handle        = 0x80080205
-	APPX_E_CORRUPT_CONTENT                                                    Handle        = 0x80080206
-	APPX_E_BLOCK_HASH_INVALID                                                 Handle        = 0x80080207
-	APPX_E_REQUESTED_RANGE_TOO_LARGE                                          Handle        = 0x80080208
-	APPX_E_INVALID_SIP_CLIENT_DATA                                            Handle        = 0x80080209
-	APPX_E_INVALID_KEY_INFO                                                   Handle        = 0x8008020A
-	APPX_E_INVALID_CONTENTGROUPMAP                                            Handle        = 0x8008020B
-	APPX_E_INVALID_APPINSTALLER                                               Handle        = 0x8008020C
-	APPX_E_DELTA_BASELINE_VERSION_MISMATCH                                    Handle        = 0x8008020D
-	APPX_E_DELTA_PACKAGE_MISSING_FILE                                         Handle        = 0x8008020E
-	APPX_E_INVALID_DELTA_PACKAGE                                              Handle        = 0x8008020F
-	APPX_E_DELTA_APPENDED_PACKAGE_NOT_ALLOWED                                 Handle        = 0x80080210
-	APPX_E_INVALID_PACKAGING_LAYOUT                                           Handle        = 0x80080211
-	APPX_E_INVALID_PACKAGESIGNCONFIG                                          Handle        = 0x80080212
-	APPX_E_RESOURCESPRI_NOT_ALLOWED                                           Handle        = 0x80080213
-	APPX_E_FILE_COMPRESSION_MISMATCH                                          Handle        = 0x80080214
-	APPX_E_INVALID_PAYLOAD_PACKAGE_EXTENSION                                  Handle        = 0x80080215
-	APPX_E_INVALID_ENCRYPTION_EXCLUSION_FILE_LIST                             Handle        = 0x80080216
-	BT_E_SPURIOUS_ACTIVATION                                                  Handle        = 0x80080300
-	DISP_E_UNKNOWNINTERFACE                                                   Handle        = 0x80020001
-	DISP_E_MEMBERNOTFOUND                                                     Handle        = 0x80020003
-	DISP_E_PARAMNOTFOUND                                                      Handle        = 0x80020004
-	DISP_E_TYPEMISMATCH                                                       Handle        = 0x80020005
-	DISP_E_UNKNOWNNAME                                                        Handle        = 0x80020006
-	DISP_E_NONAMEDARGS                                                        Handle        = 0x80020007
-	DISP_E_BADVARTYPE                                                         Handle        = 0x80020008
-	DISP_E_EXCEPTION                                                          Handle        = 0x80020009
-	DISP_E_OVERFLOW                                                           Handle        = 0x8002000A
-	DISP_E_BADINDEX                                                           Handle        = 0x8002000B
-	DISP_E_UNKNOWNLCID                                                        Handle        = 0x8002000C
-	DISP_E_ARRAYISLOCKED                                                      Handle        = 0x8002000D
-	DISP_E_BADPARAMCOUNT                                                      Handle        = 0x8002000E
-	DISP_E_PARAMNOTOPTIONAL                                                   Handle        = 0x8002000F
-	DISP_E_BADCALLEE                                                          Handle        = 0x80020010
-	DISP_E_NOTACOLLECTION                                                     Handle        = 0x80020011
-	DISP_E_DIVBYZERO                                                          Handle        = 0x80020012
-	DISP_E_BUFFERTOOSMALL                                                     Handle        = 0x80020013
-	TYPE_E_BUFFERTOOSMALL                                                     Handle        = 0x80028016
-	TYPE_E_FIELDNOTFOUND                                                      Handle        = 0x80028017
-	TYPE_E_INVDATAREAD                                                        Handle        = 0x80028018
-	TYPE_E_UNSUPFORMAT                                                        Handle        = 0x80028019
-	TYPE_E_REGISTRYACCESS                                                     Handle        = 0x8002801C
-	TYPE_E_LIBNOTREGISTERED                                                   Handle        = 0x8002801D
-	TYPE_E_UNDEFINEDTYPE                                                      Handle        = 0x80028027
-	TYPE_E_QUALIFIEDNAMEDISALLOWED                                            Handle        = 0x80028028
-	TYPE_E_INVALIDSTATE                                                       Handle        = 0x80028029
-	TYPE_E_WRONGTYPEKIND                                                      Handle        = 0x8002802A
-	TYPE_E_ELEMENTNOTFOUND                                                    Handle        = 0x8002802B
-	TYPE_E_AMBIGUOUSNAME                                                      Handle        = 0x8002802C
-	TYPE_E_NAMECONFLICT                                                       Handle        = 0x8002802D
-	TYPE_E_UNKNOWNLCID                                                        Handle        = 0x8002802E
-	TYPE_E_DLLFUNCTIONNOTFOUND                                                Handle        = 0x8002802F
-	TYPE_E_BADMODULEKIND                                                      Handle        = 0x800288BD
-	TYPE_E_SIZETOOBIG                                                         Handle        = 0x800288C5
-	TYPE_E_DUPLICATEID                                                        Handle        = 0x800288C6
-	TYPE_E_INVALIDID                                                          Handle        = 0x800288CF
-	TYPE_E_TYPEMISMATCH                                                       Handle        = 0x80028CA0
-	TYPE_E_OUTOFBOUNDS                                                        Handle        = 0x80028CA1
-	TYPE_E_IOERROR                                                            Handle        = 0x80028CA2
-	TYPE_E_CANTCREATETMPFILE                                                  Handle        = 0x80028CA3
-	TYPE_E_CANTLOADLIBRARY                                                    Handle        = 0x80029C4A
-	TYPE_E_INCONSISTENTPROPFUNCS                                              Handle        = 0x80029C83
-	TYPE_E_CIRCULARTYPE                                                       Handle        = 0x80029C84
-	STG_E_INVALIDFUNCTION                                                     Handle        = 0x80030001
-	STG_E_FILENOTFOUND                                                        Handle        = 0x80030002
-	STG_E_PATHNOTFOUND                                                        Handle        = 0x80030003
-	STG_E_TOOMANYOPENFILES                                                    Handle        = 0x80030004
-	STG_E_ACCESSDENIED                                                        Handle        = 0x80030005
-	STG_E_INVALIDHANDLE                                                       Handle        = 0x80030006
-	STG_E_INSUFFICIENTMEMORY                                                  Handle        = 0x80030008
-	STG_E_INVALIDPOINTER                                                      Handle        = 0x80030009
-	STG_E_NOMOREFILES                                                         Handle        = 0x80030012
-	STG_E_DISKISWRITEPROTECTED                                                Handle        = 0x80030013
-	STG_E_SEEKERROR                                                           Handle        = 0x80030019
-	STG_E_WRITEFAULT                                                          Handle        = 0x8003001D
-	STG_E_READFAULT                                                           Handle        = 0x8003001E
-	STG_E_SHAREVIOLATION                                                      Handle        = 0x80030020
-	STG_E_LOCKVIOLATION                                                       Handle        = 0x80030021
-	STG_E_FILEALREADYEXISTS                                                   Handle        = 0x80030050
-	STG_E_INVALIDPARAMETER                                                    Handle        = 0x80030057
-	STG_E_MEDIUMFULL                                                          Handle        = 0x80030070
-	STG_E_PROPSETMISMATCHED                                                   Handle        = 0x800300F0
-	STG_E_ABNORMALAPIEXIT                                                     Handle        = 0x800300FA
-	STG_E_INVALIDHEADER                                                       Handle        = 0x800300FB
-	STG_E_INVALIDNAME                                                         Handle        = 0x800300FC
-	STG_E_UNKNOWN                                                             Handle        = 0x800300FD
-	STG_E_UNIMPLEMENTEDFUNCTION                                               Handle        = 0x800300FE
-	STG_E_INVALIDFLAG                                                         Handle        = 0x800300FF
-	STG_E_INUSE                                                               Handle        = 0x80030100
-	STG_E_NOTCURRENT                                                          Handle        = 0x80030101
-	STG_E_REVERTED                                                            Handle        = 0x80030102
-	STG_E_CANTSAVE                                                            Handle        = 0x80030103
-	STG_E_OLDFORMAT                                                           Handle        = 0x80030104
-	STG_E_OLDDLL                                                              Handle        = 0x80030105
-	STG_E_SHAREREQUIRED                                                       Handle        = 0x80030106
-	STG_E_NOTFILEBASEDSTORAGE                                                 Handle        = 0x80030107
-	STG_E_EXTANTMARSHALLINGS                                                  Handle        = 0x80030108
-	STG_E_DOCFILECORRUPT                                                      Handle        = 0x80030109
-	STG_E_BADBASEADDRESS                                                      Handle        = 0x80030110
-	STG_E_DOCFILETOOLARGE                                                     Handle        = 0x80030111
-	STG_E_NOTSIMPLEFORMAT                                                     Handle        = 0x80030112
-	STG_E_INCOMPLETE                                                          Handle        = 0x80030201
-	STG_E_TERMINATED                                                          Handle        = 0x80030202
-	STG_S_CONVERTED                                                           Handle        = 0x00030200
-	STG_S_BLOCK                                                               Handle        = 0x00030201
-	STG_S_RETRYNOW                                                            Handle        = 0x00030202
-	STG_S_MONITORING                                                          Handle        = 0x00030203
-	STG_S_MULTIPLEOPENS                                                       Handle        = 0x00030204
-	STG_S_CONSOLIDATIONFAILED                                                 Handle        = 0x00030205
-	STG_S_CANNOTCONSOLIDATE                                                   Handle        = 0x00030206
-	STG_S_POWER_CYCLE_REQUIRED                                                Handle        = 0x00030207
-	STG_E_FIRMWARE_SLOT_INVALID                                               Handle        = 0x80030208
-	STG_E_FIRMWARE_IMAGE_INVALID                                              Handle        = 0x80030209
-	STG_E_DEVICE_UNRESPONSIVE                                                 Handle        = 0x8003020A
-	STG_E_STATUS_COPY_PROTECTION_FAILURE                                      Handle        = 0x80030305
-	STG_E_CSS_AUTHENTICATION_FAILURE                                          Handle        = 0x80030306
-	STG_E_CSS_KEY_NOT_PRESENT                                                 Handle        = 0x80030307
-	STG_E_CSS_KEY_NOT_ESTABLISHED                                             Handle        = 0x80030308
-	STG_E_CSS_SCRAMBLED_SECTOR                                                Handle        = 0x80030309
-	STG_E_CSS_REGION_MISMATCH                                                 Handle        = 0x8003030A
-	STG_E_RESETS_EXHAUSTED                                                    Handle        = 0x8003030B
-	RPC_E_CALL_REJECTED                                                       Handle        = 0x80010001
-	RPC_E_CALL_CANCELED                                                       Handle        = 0x80010002
-	RPC_E_CANTPOST_INSENDCALL                                                 Handle        = 0x80010003
-	RPC_E_CANTCALLOUT_INASYNCCALL                                             Handle        = 0x80010004
-	RPC_E_CANTCALLOUT_INEXTERNALCALL                                          Handle        = 0x80010005
-	RPC_E_CONNECTION_TERMINATED                                               Handle        = 0x80010006
-	RPC_E_SERVER_DIED                                                         Handle        = 0x80010007
-	RPC_E_CLIENT_DIED                                                         Handle        = 0x80010008
-	RPC_E_INVALID_DATAPACKET                                                  Handle        = 0x80010009
-	RPC_E_CANTTRANSMIT_CALL                                                   Handle        = 0x8001000A
-	RPC_E_CLIENT_CANTMARSHAL_DATA                                             Handle        = 0x8001000B
-	RPC_E_CLIENT_CANTUNMARSHAL_DATA                                           Handle        = 0x8001000C
-	RPC_E_SERVER_CANTMARSHAL_DATA                                             Handle        = 0x8001000D
-	RPC_E_SERVER_CANTUNMARSHAL_DATA                                           Handle        = 0x8001000E
-	RPC_E_INVALID_DATA                                                        Handle        = 0x8001000F
-	RPC_E_INVALID_PARAMETER                                                   Handle        = 0x80010010
-	RPC_E_CANTCALLOUT_AGAIN                                                   Handle        = 0x80010011
-	RPC_E_SERVER_DIED_DNE                                                     Handle        = 0x80010012
-	RPC_E_SYS_CALL_FAILED                                                     Handle        = 0x80010100
-	RPC_E_OUT_OF_RESOURCES                                                    Handle        = 0x80010101
-	RPC_E_ATTEMPTED_MULTITHREAD                                               Handle        = 0x80010102
-	RPC_E_NOT_REGISTERED                                                      Handle        = 0x80010103
-	RPC_E_FAULT                                                               Handle        = 0x80010104
-	RPC_E_SERVERFAULT                                                         Handle        = 0x80010105
-	RPC_E_CHANGED_MODE                                                        Handle        = 0x80010106
-	RPC_E_INVALIDMETHOD                                                       Handle        = 0x80010107
-	RPC_E_DISCONNECTED                                                        Handle        = 0x80010108
-	RPC_E_RETRY                                                               Handle        = 0x80010109
-	RPC_E_SERVERCALL_RETRYLATER                                               Handle        = 0x8001010A
-	RPC_E_SERVERCALL_REJECTED                                                 Handle        = 0x8001010B
-	RPC_E_INVALID_CALLDATA                                                    Handle        = 0x8001010C
-	RPC_E_CANTCALLOUT_ININPUTSYNCCALL                                         Handle        = 0x8001010D
-	RPC_E_WRONG_THREAD                                                        Handle        = 0x8001010E
-	RPC_E_THREAD_NOT_INIT                                                     Handle        = 0x8001010F
-	RPC_E_VERSION_MISMATCH                                                    Handle        = 0x80010110
-	RPC_E_INVALID_HEADER                                                      Handle        = 0x80010111
-	RPC_E_INVALID_EXTENSION                                                   Handle        = 0x80010112
-	RPC_E_INVALID_IPID                                                        Handle        = 0x80010113
-	RPC_E_INVALID_OBJECT                                                      Handle        = 0x80010114
-	RPC_S_CALLPENDING                                                         Handle        = 0x80010115
-	RPC_S_WAITONTIMER                                                         Handle        = 0x80010116
-	RPC_E_CALL_COMPLETE                                                       Handle        = 0x80010117
-	RPC_E_UNSECURE_CALL                                                       Handle        = 0x80010118
-	RPC_E_TOO_LATE                                                            Handle        = 0x80010119
-	RPC_E_NO_GOOD_SECURITY_PACKAGES                                           Handle        = 0x8001011A
-	RPC_E_ACCESS_DENIED                                                       Handle        = 0x8001011B
-	RPC_E_REMOTE_DISABLED                                                     Handle        = 0x8001011C
-	RPC_E_INVALID_OBJREF                                                      Handle        = 0x8001011D
-	RPC_E_NO_CONTEXT                                                          Handle        = 0x8001011E
-	RPC_E_TIMEOUT                                                             Handle        = 0x8001011F
-	RPC_E_NO_SYNC                                                             Handle        = 0x80010120
-	RPC_E_FULLSIC_REQUIRED                                                    Handle        = 0x80010121
-	RPC_E_INVALID_STD_NAME                                                    Handle        = 0x80010122
-	CO_E_FAILEDTOIMPERSONATE                                                  Handle        = 0x80010123
-	CO_E_FAILEDTOGETSECCTX                                                    Handle        = 0x80010124
-	CO_E_FAILEDTOOPENTHREADTOKEN                                              Handle        = 0x80010125
-	CO_E_FAILEDTOGETTOKENINFO                                                 Handle        = 0x80010126
-	CO_E_TRUSTEEDOESNTMATCHCLIENT                                             Handle        = 0x80010127
-	CO_E_FAILEDTOQUERYCLIENTBLANKET                                           Handle        = 0x80010128
-	CO_E_FAILEDTOSETDACL                                                      Handle        = 0x80010129
-	CO_E_ACCESSCHECKFAILED                                                    Handle        = 0x8001012A
-	CO_E_NETACCESSAPIFAILED                                                   Handle        = 0x8001012B
-	CO_E_WRONGTRUSTEENAMESYNTAX                                               Handle        = 0x8001012C
-	CO_E_INVALIDSID                                                           Handle        = 0x8001012D
-	CO_E_CONVERSIONFAILED                                                     Handle        = 0x8001012E
-	CO_E_NOMATCHINGSIDFOUND                                                   Handle        = 0x8001012F
-	CO_E_LOOKUPACCSIDFAILED                                                   Handle        = 0x80010130
-	CO_E_NOMATCHINGNAMEFOUND                                                  Handle        = 0x80010131
-	CO_E_LOOKUPACCNAMEFAILED                                                  Handle        = 0x80010132
-	CO_E_SETSERLHNDLFAILED                                                    Handle        = 0x80010133
-	CO_E_FAILEDTOGETWINDIR                                                    Handle        = 0x80010134
-	CO_E_PATHTOOLONG                                                          Handle        = 0x80010135
-	CO_E_FAILEDTOGENUUID                                                      Handle        = 0x80010136
-	CO_E_FAILEDTOCREATEFILE                                                   Handle        = 0x80010137
-	CO_E_FAILEDTOCLOSEHANDLE                                                  Handle        = 0x80010138
-	CO_E_EXCEEDSYSACLLIMIT                                                    Handle        = 0x80010139
-	CO_E_ACESINWRONGORDER                                                     Handle        = 0x8001013A
-	CO_E_INCOMPATIBLESTREAMVERSION                                            Handle        = 0x8001013B
-	CO_E_FAILEDTOOPENPROCESSTOKEN                                             Handle        = 0x8001013C
-	CO_E_DECODEFAILED                                                         Handle        = 0x8001013D
-	CO_E_ACNOTINITIALIZED                                                     Handle        = 0x8001013F
-	CO_E_CANCEL_DISABLED                                                      Handle        = 0x80010140
-	RPC_E_UNEXPECTED                                                          Handle        = 0x8001FFFF
-	ERROR_AUDITING_DISABLED                                                   Handle        = 0xC0090001
-	ERROR_ALL_SIDS_FILTERED                                                   Handle        = 0xC0090002
-	ERROR_BIZRULES_NOT_ENABLED                                                Handle        = 0xC0090003
-	NTE_BAD_UID                                                               Handle        = 0x80090001
-	NTE_BAD_HASH                                                              Handle        = 0x80090002
-	NTE_BAD_KEY                                                               Handle        = 0x80090003
-	NTE_BAD_LEN                                                               Handle        = 0x80090004
-	NTE_BAD_DATA                                                              Handle        = 0x80090005
-	NTE_BAD_SIGNATURE                                                         Handle        = 0x80090006
-	NTE_BAD_VER                                                               Handle        = 0x80090007
-	NTE_BAD_ALGID                                                             Handle        = 0x80090008
-	NTE_BAD_FLAGS                                                             Handle        = 0x80090009
-	NTE_BAD_TYPE                                                              Handle        = 0x8009000A
-	NTE_BAD_KEY_STATE                                                         Handle        = 0x8009000B
-	NTE_BAD_HASH_STATE                                                        Handle        = 0x8009000C
-	NTE_NO_KEY                                                                Handle        = 0x8009000D
-	NTE_NO_MEMORY                                                             Handle        = 0x8009000E
-	NTE_EXISTS                                                                Handle        = 0x8009000F
-	NTE_PERM                                                                  Handle        = 0x80090010
-	NTE_NOT_FOUND                                                             Handle        = 0x80090011
-	NTE_DOUBLE_ENCRYPT                                                        Handle        = 0x80090012
-	NTE_BAD_PROVIDER                                                          Handle        = 0x80090013
-	NTE_BAD_PROV_TYPE                                                         Handle        = 0x80090014
-	NTE_BAD_PUBLIC_KEY                                                        Handle        = 0x80090015
-	NTE_BAD_KEYSET                                                            Handle        = 0x80090016
-	NTE_PROV_TYPE_NOT_DEF                                                     Handle        = 0x80090017
-	NTE_PROV_TYPE_ENTRY_BAD                                                   Handle        = 0x80090018
-	NTE_KEYSET_NOT_DEF                                                        Handle        = 0x80090019
-	NTE_KEYSET_ENTRY_BAD                                                      Handle        = 0x8009001A
-	NTE_PROV_TYPE_NO_MATCH                                                    Handle        = 0x8009001B
-	NTE_SIGNATURE_FILE_BAD                                                    Handle        = 0x8009001C
-	NTE_PROVIDER_DLL_FAIL                                                     Handle        = 0x8009001D
-	NTE_PROV_DLL_NOT_FOUND                                                    Handle        = 0x8009001E
-	NTE_BAD_KEYSET_PARAM                                                      Handle        = 0x8009001F
-	NTE_FAIL                                                                  Handle        = 0x80090020
-	NTE_SYS_ERR                                                               Handle        = 0x80090021
-	NTE_SILENT_CONTEXT                                                        Handle        = 0x80090022
-	NTE_TOKEN_KEYSET_STORAGE_FULL                                             Handle        = 0x80090023
-	NTE_TEMPORARY_PROFILE                                                     Handle        = 0x80090024
-	NTE_FIXEDPARAMETER                                                        Handle        = 0x80090025
-	NTE_INVALID_HANDLE                                                        Handle        = 0x80090026
-	NTE_INVALID_PARAMETER                                                     Handle        = 0x80090027
-	NTE_BUFFER_TOO_SMALL                                                      Handle        = 0x80090028
-	NTE_NOT_SUPPORTED                                                         Handle        = 0x80090029
-	NTE_NO_MORE_ITEMS                                                         Handle        = 0x8009002A
-	NTE_BUFFERS_OVERLAP                                                       Handle        = 0x8009002B
-	NTE_DECRYPTION_FAILURE                                                    Handle        = 0x8009002C
-	NTE_INTERNAL_ERROR                                                        Handle        = 0x8009002D
-	NTE_UI_REQUIRED                                                           Handle        = 0x8009002E
-	NTE_HMAC_NOT_SUPPORTED                                                    Handle        = 0x8009002F
-	NTE_DEVICE_NOT_READY                                                      Handle        = 0x80090030
-	NTE_AUTHENTICATION_IGNORED                                                Handle        = 0x80090031
-	NTE_VALIDATION_FAILED                                                     Handle        = 0x80090032
-	NTE_INCORRECT_PASSWORD                                                    Handle        = 0x80090033
-	NTE_ENCRYPTION_FAILURE                                                    Handle        = 0x80090034
-	NTE_DEVICE_NOT_FOUND                                                      Handle        = 0x80090035
-	NTE_USER_CANCELLED                                                        Handle        = 0x80090036
-	NTE_PASSWORD_CHANGE_REQUIRED                                              Handle        = 0x80090037
-	NTE_NOT_ACTIVE_CONSOLE                                                    Handle        = 0x80090038
-	SEC_E_INSUFFICIENT_MEMORY                                                 Handle        = 0x80090300
-	SEC_E_INVALID_HANDLE                                                      Handle        = 0x80090301
-	SEC_E_UNSUPPORTED_FUNCTION                                                Handle        = 0x80090302
-	SEC_E_TARGET_UNKNOWN                                                      Handle        = 0x80090303
-	SEC_E_INTERNAL_ERROR                                                      Handle        = 0x80090304
-	SEC_E_SECPKG_NOT_FOUND                                                    Handle        = 0x80090305
-	SEC_E_NOT_OWNER                                                           Handle        = 0x80090306
-	SEC_E_CANNOT_INSTALL                                                      Handle        = 0x80090307
-	SEC_E_INVALID_TOKEN                                                       Handle        = 0x80090308
-	SEC_E_CANNOT_PACK                                                         Handle        = 0x80090309
-	SEC_E_QOP_NOT_SUPPORTED                                                   Handle        = 0x8009030A
-	SEC_E_NO_IMPERSONATION                                                    Handle        = 0x8009030B
-	SEC_E_LOGON_DENIED                                                        Handle        = 0x8009030C
-	SEC_E_UNKNOWN_CREDENTIALS                                                 Handle        = 0x8009030D
-	SEC_E_NO_CREDENTIALS                                                      Handle        = 0x8009030E
-	SEC_E_MESSAGE_ALTERED                                                     Handle        = 0x8009030F
-	SEC_E_OUT_OF_SEQUENCE                                                     Handle        = 0x80090310
-	SEC_E_NO_AUTHENTICATING_AUTHORITY                                         Handle        = 0x80090311
-	SEC_I_CONTINUE_NEEDED                                                     Handle        = 0x00090312
-	SEC_I_COMPLETE_NEEDED                                                     Handle        = 0x00090313
-	SEC_I_COMPLETE_AND_CONTINUE                                               Handle        = 0x00090314
-	SEC_I_LOCAL_LOGON                                                         Handle        = 0x00090315
-	SEC_E_BAD_PKGID                                                           Handle        = 0x80090316
-	SEC_E_CONTEXT_EXPIRED                                                     Handle        = 0x80090317
-	SEC_I_CONTEXT_EXPIRED                                                     Handle        = 0x00090317
-	SEC_E_INCOMPLETE_MESSAGE                                                  Handle        = 0x80090318
-	SEC_E_INCOMPLETE_CREDENTIALS                                              Handle        = 0x80090320
-	SEC_E_BUFFER_TOO_SMALL                                                    Handle        = 0x80090321
-	SEC_I_INCOMPLETE_CREDENTIALS                                              Handle        = 0x00090320
-	SEC_I_RENEGOTIATE                                                         Handle        = 0x00090321
-	SEC_E_WRONG_PRINCIPAL                                                     Handle        = 0x80090322
-	SEC_I_NO_LSA_CONTEXT                                                      Handle        = 0x00090323
-	SEC_E_TIME_SKEW                                                           Handle        = 0x80090324
-	SEC_E_UNTRUSTED_ROOT                                                      Handle        = 0x80090325
-	SEC_E_ILLEGAL_MESSAGE                                                     Handle        = 0x80090326
-	SEC_E_CERT_UNKNOWN                                                        Handle        = 0x80090327
-	SEC_E_CERT_EXPIRED                                                        Handle        = 0x80090328
-	SEC_E_ENCRYPT_FAILURE                                                     Handle        = 0x80090329
-	SEC_E_DECRYPT_FAILURE                                                     Handle        = 0x80090330
-	SEC_E_ALGORITHM_MISMATCH                                                  Handle        = 0x80090331
-	SEC_E_SECURITY_QOS_FAILED                                                 Handle        = 0x80090332
-	SEC_E_UNFINISHED_CONTEXT_DELETED                                          Handle        = 0x80090333
-	SEC_E_NO_TGT_REPLY                                                        Handle        = 0x80090334
-	SEC_E_NO_IP_ADDRESSES                                                     Handle        = 0x80090335
-	SEC_E_WRONG_CREDENTIAL_HANDLE                                             Handle        = 0x80090336
-	SEC_E_CRYPTO_SYSTEM_INVALID                                               Handle        = 0x80090337
-	SEC_E_MAX_REFERRALS_EXCEEDED                                              Handle        = 0x80090338
-	SEC_E_MUST_BE_KDC                                                         Handle        = 0x80090339
-	SEC_E_STRONG_CRYPTO_NOT_SUPPORTED                                         Handle        = 0x8009033A
-	SEC_E_TOO_MANY_PRINCIPALS                                                 Handle        = 0x8009033B
-	SEC_E_NO_PA_DATA                                                          Handle        = 0x8009033C
-	SEC_E_PKINIT_NAME_MISMATCH                                                Handle        = 0x8009033D
-	SEC_E_SMARTCARD_LOGON_REQUIRED                                            Handle        = 0x8009033E
-	SEC_E_SHUTDOWN_IN_PROGRESS                                                Handle        = 0x8009033F
-	SEC_E_KDC_INVALID_REQUEST                                                 Handle        = 0x80090340
-	SEC_E_KDC_UNABLE_TO_REFER                                                 Handle        = 0x80090341
-	SEC_E_KDC_UNKNOWN_ETYPE                                                   Handle        = 0x80090342
-	SEC_E_UNSUPPORTED_PREAUTH                                                 Handle        = 0x80090343
-	SEC_E_DELEGATION_REQUIRED                                                 Handle        = 0x80090345
-	SEC_E_BAD_BINDINGS                                                        Handle        = 0x80090346
-	SEC_E_MULTIPLE_ACCOUNTS                                                   Handle        = 0x80090347
-	SEC_E_NO_KERB_KEY                                                         Handle        = 0x80090348
-	SEC_E_CERT_WRONG_USAGE                                                    Handle        = 0x80090349
-	SEC_E_DOWNGRADE_DETECTED                                                  Handle        = 0x80090350
-	SEC_E_SMARTCARD_CERT_REVOKED                                              Handle        = 0x80090351
-	SEC_E_ISSUING_CA_UNTRUSTED                                                Handle        = 0x80090352
-	SEC_E_REVOCATION_OFFLINE_C                                                Handle        = 0x80090353
-	SEC_E_PKINIT_CLIENT_FAILURE                                               Handle        = 0x80090354
-	SEC_E_SMARTCARD_CERT_EXPIRED                                              Handle        = 0x80090355
-	SEC_E_NO_S4U_PROT_SUPPORT                                                 Handle        = 0x80090356
-	SEC_E_CROSSREALM_DELEGATION_FAILURE                                       Handle        = 0x80090357
-	SEC_E_REVOCATION_OFFLINE_KDC                                              Handle        = 0x80090358
-	SEC_E_ISSUING_CA_UNTRUSTED_KDC                                            Handle        = 0x80090359
-	SEC_E_KDC_CERT_EXPIRED                                                    Handle        = 0x8009035A
-	SEC_E_KDC_CERT_REVOKED                                                    Handle        = 0x8009035B
-	SEC_I_SIGNATURE_NEEDED                                                    Handle        = 0x0009035C
-	SEC_E_INVALID_PARAMETER                                                   Handle        = 0x8009035D
-	SEC_E_DELEGATION_POLICY                                                   Handle        = 0x8009035E
-	SEC_E_POLICY_NLTM_ONLY                                                    Handle        = 0x8009035F
-	SEC_I_NO_RENEGOTIATION                                                    Handle        = 0x00090360
-	SEC_E_NO_CONTEXT                                                          Handle        = 0x80090361
-	SEC_E_PKU2U_CERT_FAILURE                                                  Handle        = 0x80090362
-	SEC_E_MUTUAL_AUTH_FAILED                                                  Handle        = 0x80090363
-	SEC_I_MESSAGE_FRAGMENT                                                    Handle        = 0x00090364
-	SEC_E_ONLY_HTTPS_ALLOWED                                                  Handle        = 0x80090365
-	SEC_I_CONTINUE_NEEDED_MESSAGE_OK                                          Handle        = 0x00090366
-	SEC_E_APPLICATION_PROTOCOL_MISMATCH                                       Handle        = 0x80090367
-	SEC_I_ASYNC_CALL_PENDING                                                  Handle        = 0x00090368
-	SEC_E_INVALID_UPN_NAME                                                    Handle        = 0x80090369
-	SEC_E_NO_SPM                                                                            = SEC_E_INTERNAL_ERROR
-	SEC_E_NOT_SUPPORTED                                                                     = SEC_E_UNSUPPORTED_FUNCTION
-	CRYPT_E_MSG_ERROR                                                         Handle        = 0x80091001
-	CRYPT_E_UNKNOWN_ALGO                                                      Handle        = 0x80091002
-	CRYPT_E_OID_FORMAT                                                        Handle        = 0x80091003
-	CRYPT_E_INVALID_MSG_TYPE                                                  Handle        = 0x80091004
-	CRYPT_E_UNEXPECTED_ENCODING                                               Handle        = 0x80091005
-	CRYPT_E_AUTH_ATTR_MISSING                                                 Handle        = 0x80091006
-	CRYPT_E_HASH_VALUE                                                        Handle        = 0x80091007
-	CRYPT_E_INVALID_INDEX                                                     Handle        = 0x80091008
-	CRYPT_E_ALREADY_DECRYPTED                                                 Handle        = 0x80091009
-	CRYPT_E_NOT_DECRYPTED                                                     Handle        = 0x8009100A
-	CRYPT_E_RECIPIENT_NOT_FOUND                                               Handle        = 0x8009100B
-	CRYPT_E_CONTROL_TYPE                                                      Handle        = 0x8009100C
-	CRYPT_E_ISSUER_SERIALNUMBER                                               Handle        = 0x8009100D
-	CRYPT_E_SIGNER_NOT_FOUND                                                  Handle        = 0x8009100E
-	CRYPT_E_ATTRIBUTES_MISSING                                                Handle        = 0x8009100F
-	CRYPT_E_STREAM_MSG_NOT_READY                                              Handle        = 0x80091010
-	CRYPT_E_STREAM_INSUFFICIENT_DATA                                          Handle        = 0x80091011
-	CRYPT_I_NEW_PROTECTION_REQUIRED                                           Handle        = 0x00091012
-	CRYPT_E_BAD_LEN                                                           Handle        = 0x80092001
-	CRYPT_E_BAD_ENCODE                                                        Handle        = 0x80092002
-	CRYPT_E_FILE_ERROR                                                        Handle        = 0x80092003
-	CRYPT_E_NOT_FOUND                                                         Handle        = 0x80092004
-	CRYPT_E_EXISTS                                                            Handle        = 0x80092005
-	CRYPT_E_NO_PROVIDER                                                       Handle        = 0x80092006
-	CRYPT_E_SELF_SIGNED                                                       Handle        = 0x80092007
-	CRYPT_E_DELETED_PREV                                                      Handle        = 0x80092008
-	CRYPT_E_NO_MATCH                                                          Handle        = 0x80092009
-	CRYPT_E_UNEXPECTED_MSG_TYPE                                               Handle        = 0x8009200A
-	CRYPT_E_NO_KEY_PROPERTY                                                   Handle        = 0x8009200B
-	CRYPT_E_NO_DECRYPT_CERT                                                   Handle        = 0x8009200C
-	CRYPT_E_BAD_MSG                                                           Handle        = 0x8009200D
-	CRYPT_E_NO_SIGNER                                                         Handle        = 0x8009200E
-	CRYPT_E_PENDING_CLOSE                                                     Handle        = 0x8009200F
-	CRYPT_E_REVOKED                                                           Handle        = 0x80092010
-	CRYPT_E_NO_REVOCATION_DLL                                                 Handle        = 0x80092011
-	CRYPT_E_NO_REVOCATION_CHECK                                               Handle        = 0x80092012
-	CRYPT_E_REVOCATION_OFFLINE                                                Handle        = 0x80092013
-	CRYPT_E_NOT_IN_REVOCATION_DATABASE                                        Handle        = 0x80092014
-	CRYPT_E_INVALID_NUMERIC_STRING                                            Handle        = 0x80092020
-	CRYPT_E_INVALID_PRINTABLE_STRING                                          Handle        = 0x80092021
-	CRYPT_E_INVALID_IA5_STRING                                                Handle        = 0x80092022
-	CRYPT_E_INVALID_X500_STRING                                               Handle        = 0x80092023
-	CRYPT_E_NOT_CHAR_STRING                                                   Handle        = 0x80092024
-	CRYPT_E_FILERESIZED                                                       Handle        = 0x80092025
-	CRYPT_E_SECURITY_SETTINGS                                                 Handle        = 0x80092026
-	CRYPT_E_NO_VERIFY_USAGE_DLL                                               Handle        = 0x80092027
-	CRYPT_E_NO_VERIFY_USAGE_CHECK                                             Handle        = 0x80092028
-	CRYPT_E_VERIFY_USAGE_OFFLINE                                              Handle        = 0x80092029
-	CRYPT_E_NOT_IN_CTL                                                        Handle        = 0x8009202A
-	CRYPT_E_NO_TRUSTED_SIGNER                                                 Handle        = 0x8009202B
-	CRYPT_E_MISSING_PUBKEY_PARA                                               Handle        = 0x8009202C
-	CRYPT_E_OBJECT_LOCATOR_OBJECT_NOT_FOUND                                   Handle        = 0x8009202D
-	CRYPT_E_OSS_ERROR                                                         Handle        = 0x80093000
-	OSS_MORE_BUF                                                              Handle        = 0x80093001
-	OSS_NEGATIVE_UINTEGER                                                     Handle        = 0x80093002
-	OSS_PDU_RANGE                                                             Handle        = 0x80093003
-	OSS_MORE_INPUT                                                            Handle        = 0x80093004
-	OSS_DATA_ERROR                                                            Handle        = 0x80093005
-	OSS_BAD_ARG                                                               Handle        = 0x80093006
-	OSS_BAD_VERSION                                                           Handle        = 0x80093007
-	OSS_OUT_MEMORY                                                            Handle        = 0x80093008
-	OSS_PDU_MISMATCH                                                          Handle        = 0x80093009
-	OSS_LIMITED                                                               Handle        = 0x8009300A
-	OSS_BAD_PTR                                                               Handle        = 0x8009300B
-	OSS_BAD_TIME                                                              Handle        = 0x8009300C
-	OSS_INDEFINITE_NOT_SUPPORTED                                              Handle        = 0x8009300D
-	OSS_MEM_ERROR                                                             Handle        = 0x8009300E
-	OSS_BAD_TABLE                                                             Handle        = 0x8009300F
-	OSS_TOO_LONG                                                              Handle        = 0x80093010
-	OSS_CONSTRAINT_VIOLATED                                                   Handle        = 0x80093011
-	OSS_FATAL_ERROR                                                           Handle        = 0x80093012
-	OSS_ACCESS_SERIALIZATION_ERROR                                            Handle        = 0x80093013
-	OSS_NULL_TBL                                                              Handle        = 0x80093014
-	OSS_NULL_FCN                                                              Handle        = 0x80093015
-	OSS_BAD_ENCRULES                                                          Handle        = 0x80093016
-	OSS_UNAVAIL_ENCRULES                                                      Handle        = 0x80093017
-	OSS_CANT_OPEN_TRACE_WINDOW                                                Handle        = 0x80093018
-	OSS_UNIMPLEMENTED                                                         Handle        = 0x80093019
-	OSS_OID_DLL_NOT_LINKED                                                    Handle        = 0x8009301A
-	OSS_CANT_OPEN_TRACE_FILE                                                  Handle        = 0x8009301B
-	OSS_TRACE_FILE_ALREADY_OPEN                                               Handle        = 0x8009301C
-	OSS_TABLE_MISMATCH                                                        Handle        = 0x8009301D
-	OSS_TYPE_NOT_SUPPORTED                                                    Handle        = 0x8009301E
-	OSS_REAL_DLL_NOT_LINKED                                                   Handle        = 0x8009301F
-	OSS_REAL_CODE_NOT_LINKED                                                  Handle        = 0x80093020
-	OSS_OUT_OF_RANGE                                                          Handle        = 0x80093021
-	OSS_COPIER_DLL_NOT_LINKED                                                 Handle        = 0x80093022
-	OSS_CONSTRAINT_DLL_NOT_LINKED                                             Handle        = 0x80093023
-	OSS_COMPARATOR_DLL_NOT_LINKED                                             Handle        = 0x80093024
-	OSS_COMPARATOR_CODE_NOT_LINKED                                            Handle        = 0x80093025
-	OSS_MEM_MGR_DLL_NOT_LINKED                                                Handle        = 0x80093026
-	OSS_PDV_DLL_NOT_LINKED                                                    Handle        = 0x80093027
-	OSS_PDV_CODE_NOT_LINKED                                                   Handle        = 0x80093028
-	OSS_API_DLL_NOT_LINKED                                                    Handle        = 0x80093029
-	OSS_BERDER_DLL_NOT_LINKED                                                 Handle        = 0x8009302A
-	OSS_PER_DLL_NOT_LINKED                                                    Handle        = 0x8009302B
-	OSS_OPEN_TYPE_ERROR                                                       Handle        = 0x8009302C
-	OSS_MUTEX_NOT_CREATED                                                     Handle        = 0x8009302D
-	OSS_CANT_CLOSE_TRACE_FILE                                                 Handle        = 0x8009302E
-	CRYPT_E_ASN1_ERROR                                                        Handle        = 0x80093100
-	CRYPT_E_ASN1_INTERNAL                                                     Handle        = 0x80093101
-	CRYPT_E_ASN1_EOD                                                          Handle        = 0x80093102
-	CRYPT_E_ASN1_CORRUPT                                                      Handle        = 0x80093103
-	CRYPT_E_ASN1_LARGE                                                        Handle        = 0x80093104
-	CRYPT_E_ASN1_CONSTRAINT                                                   Handle        = 0x80093105
-	CRYPT_E_ASN1_MEMORY                                                       Handle        = 0x80093106
-	CRYPT_E_ASN1_OVERFLOW                                                     Handle        = 0x80093107
-	CRYPT_E_ASN1_BADPDU                                                       Handle        = 0x80093108
-	CRYPT_E_ASN1_BADARGS                                                      Handle        = 0x80093109
-	CRYPT_E_ASN1_BADREAL                                                      Handle        = 0x8009310A
-	CRYPT_E_ASN1_BADTAG                                                       Handle        = 0x8009310B
-	CRYPT_E_ASN1_CHOICE                                                       Handle        = 0x8009310C
-	CRYPT_E_ASN1_RULE                                                         Handle        = 0x8009310D
-	CRYPT_E_ASN1_UTF8                                                         Handle        = 0x8009310E
-	CRYPT_E_ASN1_PDU_TYPE                                                     Handle        = 0x80093133
-	CRYPT_E_ASN1_NYI                                                          Handle        = 0x80093134
-	CRYPT_E_ASN1_EXTENDED                                                     Handle        = 0x80093201
-	CRYPT_E_ASN1_NOEOD                                                        Handle        = 0x80093202
-	CERTSRV_E_BAD_REQUESTSUBJECT                                              Handle        = 0x80094001
-	CERTSRV_E_NO_REQUEST                                                      Handle        = 0x80094002
-	CERTSRV_E_BAD_REQUESTSTATUS                                               Handle        = 0x80094003
-	CERTSRV_E_PROPERTY_EMPTY                                                  Handle        = 0x80094004
-	CERTSRV_E_INVALID_CA_CERTIFICATE                                          Handle        = 0x80094005
-	CERTSRV_E_SERVER_SUSPENDED                                                Handle        = 0x80094006
-	CERTSRV_E_ENCODING_LENGTH                                                 Handle        = 0x80094007
-	CERTSRV_E_ROLECONFLICT                                                    Handle        = 0x80094008
-	CERTSRV_E_RESTRICTEDOFFICER                                               Handle        = 0x80094009
-	CERTSRV_E_KEY_ARCHIVAL_NOT_CONFIGURED                                     Handle        = 0x8009400A
-	CERTSRV_E_NO_VALID_KRA                                                    Handle        = 0x8009400B
-	CERTSRV_E_BAD_REQUEST_KEY_ARCHIVAL                                        Handle        = 0x8009400C
-	CERTSRV_E_NO_CAADMIN_DEFINED                                              Handle        = 0x8009400D
-	CERTSRV_E_BAD_RENEWAL_CERT_ATTRIBUTE                                      Handle        = 0x8009400E
-	CERTSRV_E_NO_DB_SESSIONS                                                  Handle        = 0x8009400F
-	CERTSRV_E_ALIGNMENT_FAULT                                                 Handle        = 0x80094010
-	CERTSRV_E_ENROLL_DENIED                                                   Handle        = 0x80094011
-	CERTSRV_E_TEMPLATE_DENIED                                                 Handle        = 0x80094012
-	CERTSRV_E_DOWNLEVEL_DC_SSL_OR_UPGRADE                                     Handle        = 0x80094013
-	CERTSRV_E_ADMIN_DENIED_REQUEST                                            Handle        = 0x80094014
-	CERTSRV_E_NO_POLICY_SERVER                                                Handle        = 0x80094015
-	CERTSRV_E_WEAK_SIGNATURE_OR_KEY                                           Handle        = 0x80094016
-	CERTSRV_E_KEY_ATTESTATION_NOT_SUPPORTED                                   Handle        = 0x80094017
-	CERTSRV_E_ENCRYPTION_CERT_REQUIRED                                        Handle        = 0x80094018
-	CERTSRV_E_UNSUPPORTED_CERT_TYPE                                           Handle        = 0x80094800
-	CERTSRV_E_NO_CERT_TYPE                                                    Handle        = 0x80094801
-	CERTSRV_E_TEMPLATE_CONFLICT                                               Handle        = 0x80094802
-	CERTSRV_E_SUBJECT_ALT_NAME_REQUIRED                                       Handle        = 0x80094803
-	CERTSRV_E_ARCHIVED_KEY_REQUIRED                                           Handle        = 0x80094804
-	CERTSRV_E_SMIME_REQUIRED                                                  Handle        = 0x80094805
-	CERTSRV_E_BAD_RENEWAL_SUBJECT                                             Handle        = 0x80094806
-	CERTSRV_E_BAD_TEMPLATE_VERSION                                            Handle        = 0x80094807
-	CERTSRV_E_TEMPLATE_POLICY_REQUIRED                                        Handle        = 0x80094808
-	CERTSRV_E_SIGNATURE_POLICY_REQUIRED                                       Handle        = 0x80094809
-	CERTSRV_E_SIGNATURE_COUNT                                                 Handle        = 0x8009480A
-	CERTSRV_E_SIGNATURE_REJECTED                                              Handle        = 0x8009480B
-	CERTSRV_E_ISSUANCE_POLICY_REQUIRED                                        Handle        = 0x8009480C
-	CERTSRV_E_SUBJECT_UPN_REQUIRED                                            Handle        = 0x8009480D
-	CERTSRV_E_SUBJECT_DIRECTORY_GUID_REQUIRED                                 Handle        = 0x8009480E
-	CERTSRV_E_SUBJECT_DNS_REQUIRED                                            Handle        = 0x8009480F
-	CERTSRV_E_ARCHIVED_KEY_UNEXPECTED                                         Handle        = 0x80094810
-	CERTSRV_E_KEY_LENGTH                                                      Handle        = 0x80094811
-	CERTSRV_E_SUBJECT_EMAIL_REQUIRED                                          Handle        = 0x80094812
-	CERTSRV_E_UNKNOWN_CERT_TYPE                                               Handle        = 0x80094813
-	CERTSRV_E_CERT_TYPE_OVERLAP                                               Handle        = 0x80094814
-	CERTSRV_E_TOO_MANY_SIGNATURES                                             Handle        = 0x80094815
-	CERTSRV_E_RENEWAL_BAD_PUBLIC_KEY                                          Handle        = 0x80094816
-	CERTSRV_E_INVALID_EK                                                      Handle        = 0x80094817
-	CERTSRV_E_INVALID_IDBINDING                                               Handle        = 0x80094818
-	CERTSRV_E_INVALID_ATTESTATION                                             Handle        = 0x80094819
-	CERTSRV_E_KEY_ATTESTATION                                                 Handle        = 0x8009481A
-	CERTSRV_E_CORRUPT_KEY_ATTESTATION                                         Handle        = 0x8009481B
-	CERTSRV_E_EXPIRED_CHALLENGE                                               Handle        = 0x8009481C
-	CERTSRV_E_INVALID_RESPONSE                                                Handle        = 0x8009481D
-	CERTSRV_E_INVALID_REQUESTID                                               Handle        = 0x8009481E
-	CERTSRV_E_REQUEST_PRECERTIFICATE_MISMATCH                                 Handle        = 0x8009481F
-	CERTSRV_E_PENDING_CLIENT_RESPONSE                                         Handle        = 0x80094820
-	XENROLL_E_KEY_NOT_EXPORTABLE                                              Handle        = 0x80095000
-	XENROLL_E_CANNOT_ADD_ROOT_CERT                                            Handle        = 0x80095001
-	XENROLL_E_RESPONSE_KA_HASH_NOT_FOUND                                      Handle        = 0x80095002
-	XENROLL_E_RESPONSE_UNEXPECTED_KA_HASH                                     Handle        = 0x80095003
-	XENROLL_E_RESPONSE_KA_HASH_MISMATCH                                       Handle        = 0x80095004
-	XENROLL_E_KEYSPEC_SMIME_MISMATCH                                          Handle        = 0x80095005
-	TRUST_E_SYSTEM_ERROR                                                      Handle        = 0x80096001
-	TRUST_E_NO_SIGNER_CERT                                                    Handle        = 0x80096002
-	TRUST_E_COUNTER_SIGNER                                                    Handle        = 0x80096003
-	TRUST_E_CERT_SIGNATURE                                                    Handle        = 0x80096004
-	TRUST_E_TIME_STAMP                                                        Handle        = 0x80096005
-	TRUST_E_BAD_DIGEST                                                        Handle        = 0x80096010
-	TRUST_E_MALFORMED_SIGNATURE                                               Handle        = 0x80096011
-	TRUST_E_BASIC_CONSTRAINTS                                                 Handle        = 0x80096019
-	TRUST_E_FINANCIAL_CRITERIA                                                Handle        = 0x8009601E
-	MSSIPOTF_E_OUTOFMEMRANGE                                                  Handle        = 0x80097001
-	MSSIPOTF_E_CANTGETOBJECT                                                  Handle        = 0x80097002
-	MSSIPOTF_E_NOHEADTABLE                                                    Handle        = 0x80097003
-	MSSIPOTF_E_BAD_MAGICNUMBER                                                Handle        = 0x80097004
-	MSSIPOTF_E_BAD_OFFSET_TABLE                                               Handle        = 0x80097005
-	MSSIPOTF_E_TABLE_TAGORDER                                                 Handle        = 0x80097006
-	MSSIPOTF_E_TABLE_LONGWORD                                                 Handle        = 0x80097007
-	MSSIPOTF_E_BAD_FIRST_TABLE_PLACEMENT                                      Handle        = 0x80097008
-	MSSIPOTF_E_TABLES_OVERLAP                                                 Handle        = 0x80097009
-	MSSIPOTF_E_TABLE_PADBYTES                                                 Handle        = 0x8009700A
-	MSSIPOTF_E_FILETOOSMALL                                                   Handle        = 0x8009700B
-	MSSIPOTF_E_TABLE_CHECKSUM                                                 Handle        = 0x8009700C
-	MSSIPOTF_E_FILE_CHECKSUM                                                  Handle        = 0x8009700D
-	MSSIPOTF_E_FAILED_POLICY                                                  Handle        = 0x80097010
-	MSSIPOTF_E_FAILED_HINTS_CHECK                                             Handle        = 0x80097011
-	MSSIPOTF_E_NOT_OPENTYPE                                                   Handle        = 0x80097012
-	MSSIPOTF_E_FILE                                                           Handle        = 0x80097013
-	MSSIPOTF_E_CRYPT                                                          Handle        = 0x80097014
-	MSSIPOTF_E_BADVERSION                                                     Handle        = 0x80097015
-	MSSIPOTF_E_DSIG_STRUCTURE                                                 Handle        = 0x80097016
-	MSSIPOTF_E_PCONST_CHECK                                                   Handle        = 0x80097017
-	MSSIPOTF_E_STRUCTURE                                                      Handle        = 0x80097018
-	ERROR_CRED_REQUIRES_CONFIRMATION                                          Handle        = 0x80097019
-	NTE_OP_OK                                                                 syscall.Errno = 0
-	TRUST_E_PROVIDER_UNKNOWN                                                  Handle        = 0x800B0001
-	TRUST_E_ACTION_UNKNOWN                                                    Handle        = 0x800B0002
-	TRUST_E_SUBJECT_FORM_UNKNOWN                                              Handle        = 0x800B0003
-	TRUST_E_SUBJECT_NOT_TRUSTED                                               Handle        = 0x800B0004
-	DIGSIG_E_ENCODE                                                           Handle        = 0x800B0005
-	DIGSIG_E_DECODE                                                           Handle        = 0x800B0006
-	DIGSIG_E_EXTENSIBILITY                                                    Handle        = 0x800B0007
-	DIGSIG_E_CRYPTO                                                           Handle        = 0x800B0008
-	PERSIST_E_SIZEDEFINITE                                                    Handle        = 0x800B0009
-	PERSIST_E_SIZEINDEFINITE                                                  Handle        = 0x800B000A
-	PERSIST_E_NOTSELFSIZING                                                   Handle        = 0x800B000B
-	TRUST_E_NOSIGNATURE                                                       Handle        = 0x800B0100
-	CERT_E_EXPIRED                                                            Handle        = 0x800B0101
-	CERT_E_VALIDITYPERIODNESTING                                              Handle        = 0x800B0102
-	CERT_E_ROLE                                                               Handle        = 0x800B0103
-	CERT_E_PATHLENCONST                                                       Handle        = 0x800B0104
-	CERT_E_CRITICAL                                                           Handle        = 0x800B0105
-	CERT_E_PURPOSE                                                            Handle        = 0x800B0106
-	CERT_E_ISSUERCHAINING                                                     Handle        = 0x800B0107
-	CERT_E_MALFORMED                                                          Handle        = 0x800B0108
-	CERT_E_UNTRUSTEDROOT                                                      Handle        = 0x800B0109
-	CERT_E_CHAINING                                                           Handle        = 0x800B010A
-	TRUST_E_FAIL                                                              Handle        = 0x800B010B
-	CERT_E_REVOKED                                                            Handle        = 0x800B010C
-	CERT_E_UNTRUSTEDTESTROOT                                                  Handle        = 0x800B010D
-	CERT_E_REVOCATION_FAILURE                                                 Handle        = 0x800B010E
-	CERT_E_CN_NO_MATCH                                                        Handle        = 0x800B010F
-	CERT_E_WRONG_USAGE                                                        Handle        = 0x800B0110
-	TRUST_E_EXPLICIT_DISTRUST                                                 Handle        = 0x800B0111
-	CERT_E_UNTRUSTEDCA                                                        Handle        = 0x800B0112
-	CERT_E_INVALID_POLICY                                                     Handle        = 0x800B0113
-	CERT_E_INVALID_NAME                                                       Handle        = 0x800B0114
-	SPAPI_E_EXPECTED_SECTION_NAME                                             Handle        = 0x800F0000
-	SPAPI_E_BAD_SECTION_NAME_LINE                                             Handle        = 0x800F0001
-	SPAPI_E_SECTION_NAME_TOO_LONG                                             Handle        = 0x800F0002
-	SPAPI_E_GENERAL_SYNTAX                                                    Handle        = 0x800F0003
-	SPAPI_E_WRONG_INF_STYLE                                                   Handle        = 0x800F0100
-	SPAPI_E_SECTION_NOT_FOUND                                                 Handle        = 0x800F0101
-	SPAPI_E_LINE_NOT_FOUND                                                    Handle        = 0x800F0102
-	SPAPI_E_NO_BACKUP                                                         Handle        = 0x800F0103
-	SPAPI_E_NO_ASSOCIATED_CLASS                                               Handle        = 0x800F0200
-	SPAPI_E_CLASS_MISMATCH                                                    Handle        = 0x800F0201
-	SPAPI_E_DUPLICATE_FOUND                                                   Handle        = 0x800F0202
-	SPAPI_E_NO_DRIVER_SELECTED                                                Handle        = 0x800F0203
-	SPAPI_E_KEY_DOES_NOT_EXIST                                                Handle        = 0x800F0204
-	SPAPI_E_INVALID_DEVINST_NAME                                              Handle        = 0x800F0205
-	SPAPI_E_INVALID_CLASS                                                     Handle        = 0x800F0206
-	SPAPI_E_DEVINST_ALREADY_EXISTS                                            Handle        = 0x800F0207
-	SPAPI_E_DEVINFO_NOT_REGISTERED                                            Handle        = 0x800F0208
-	SPAPI_E_INVALID_REG_PROPERTY                                              Handle        = 0x800F0209
-	SPAPI_E_NO_INF                                                            Handle        = 0x800F020A
-	SPAPI_E_NO_SUCH_DEVINST                                                   Handle        = 0x800F020B
-	SPAPI_E_CANT_LOAD_CLASS_ICON                                              Handle        = 0x800F020C
-	SPAPI_E_INVALID_CLASS_INSTALLER                                           Handle        = 0x800F020D
-	SPAPI_E_DI_DO_DEFAULT                                                     Handle        = 0x800F020E
-	SPAPI_E_DI_NOFILECOPY                                                     Handle        = 0x800F020F
-	SPAPI_E_INVALID_HWPROFILE                                                 Handle        = 0x800F0210
-	SPAPI_E_NO_DEVICE_SELECTED                                                Handle        = 0x800F0211
-	SPAPI_E_DEVINFO_LIST_LOCKED                                               Handle        = 0x800F0212
-	SPAPI_E_DEVINFO_DATA_LOCKED                                               Handle        = 0x800F0213
-	SPAPI_E_DI_BAD_PATH                                                       Handle        = 0x800F0214
-	SPAPI_E_NO_CLASSINSTALL_PARAMS                                            Handle        = 0x800F0215
-	SPAPI_E_FILEQUEUE_LOCKED                                                  Handle        = 0x800F0216
-	SPAPI_E_BAD_SERVICE_INSTALLSECT                                           Handle        = 0x800F0217
-	SPAPI_E_NO_CLASS_DRIVER_LIST                                              Handle        = 0x800F0218
-	SPAPI_E_NO_ASSOCIATED_SERVICE                                             Handle        = 0x800F0219
-	SPAPI_E_NO_DEFAULT_DEVICE_INTERFACE                                       Handle        = 0x800F021A
-	SPAPI_E_DEVICE_INTERFACE_ACTIVE                                           Handle        = 0x800F021B
-	SPAPI_E_DEVICE_INTERFACE_REMOVED                                          Handle        = 0x800F021C
-	SPAPI_E_BAD_INTERFACE_INSTALLSECT                                         Handle        = 0x800F021D
-	SPAPI_E_NO_SUCH_INTERFACE_CLASS                                           Handle        = 0x800F021E
-	SPAPI_E_INVALID_REFERENCE_STRING                                          Handle        = 0x800F021F
-	SPAPI_E_INVALID_MACHINENAME                                               Handle        = 0x800F0220
-	SPAPI_E_REMOTE_COMM_FAILURE                                               Handle        = 0x800F0221
-	SPAPI_E_MACHINE_UNAVAILABLE                                               Handle        = 0x800F0222
-	SPAPI_E_NO_CONFIGMGR_SERVICES                                             Handle        = 0x800F0223
-	SPAPI_E_INVALID_PROPPAGE_PROVIDER                                         Handle        = 0x800F0224
-	SPAPI_E_NO_SUCH_DEVICE_INTERFACE                                          Handle        = 0x800F0225
-	SPAPI_E_DI_POSTPROCESSING_REQUIRED                                        Handle        = 0x800F0226
-	SPAPI_E_INVALID_COINSTALLER                                               Handle        = 0x800F0227
-	SPAPI_E_NO_COMPAT_DRIVERS                                                 Handle        = 0x800F0228
-	SPAPI_E_NO_DEVICE_ICON                                                    Handle        = 0x800F0229
-	SPAPI_E_INVALID_INF_LOGCONFIG                                             Handle        = 0x800F022A
-	SPAPI_E_DI_DONT_INSTALL                                                   Handle        = 0x800F022B
-	SPAPI_E_INVALID_FILTER_DRIVER                                             Handle        = 0x800F022C
-	SPAPI_E_NON_WINDOWS_NT_DRIVER                                             Handle        = 0x800F022D
-	SPAPI_E_NON_WINDOWS_DRIVER                                                Handle        = 0x800F022E
-	SPAPI_E_NO_CATALOG_FOR_OEM_INF                                            Handle        = 0x800F022F
-	SPAPI_E_DEVINSTALL_QUEUE_NONNATIVE                                        Handle        = 0x800F0230
-	SPAPI_E_NOT_DISABLEABLE                                                   Handle        = 0x800F0231
-	SPAPI_E_CANT_REMOVE_DEVINST                                               Handle        = 0x800F0232
-	SPAPI_E_INVALID_TARGET                                                    Handle        = 0x800F0233
-	SPAPI_E_DRIVER_NONNATIVE                                                  Handle        = 0x800F0234
-	SPAPI_E_IN_WOW64                                                          Handle        = 0x800F0235
-	SPAPI_E_SET_SYSTEM_RESTORE_POINT                                          Handle        = 0x800F0236
-	SPAPI_E_INCORRECTLY_COPIED_INF                                            Handle        = 0x800F0237
-	SPAPI_E_SCE_DISABLED                                                      Handle        = 0x800F0238
-	SPAPI_E_UNKNOWN_EXCEPTION                                                 Handle        = 0x800F0239
-	SPAPI_E_PNP_REGISTRY_ERROR                                                Handle        = 0x800F023A
-	SPAPI_E_REMOTE_REQUEST_UNSUPPORTED                                        Handle        = 0x800F023B
-	SPAPI_E_NOT_AN_INSTALLED_OEM_INF                                          Handle        = 0x800F023C
-	SPAPI_E_INF_IN_USE_BY_DEVICES                                             Handle        = 0x800F023D
-	SPAPI_E_DI_FUNCTION_OBSOLETE                                              Handle        = 0x800F023E
-	SPAPI_E_NO_AUTHENTICODE_CATALOG                                           Handle        = 0x800F023F
-	SPAPI_E_AUTHENTICODE_DISALLOWED                                           Handle        = 0x800F0240
-	SPAPI_E_AUTHENTICODE_TRUSTED_PUBLISHER                                    Handle        = 0x800F0241
-	SPAPI_E_AUTHENTICODE_TRUST_NOT_ESTABLISHED                                Handle        = 0x800F0242
-	SPAPI_E_AUTHENTICODE_PUBLISHER_NOT_TRUSTED                                Handle        = 0x800F0243
-	SPAPI_E_SIGNATURE_OSATTRIBUTE_MISMATCH                                    Handle        = 0x800F0244
-	SPAPI_E_ONLY_VALIDATE_VIA_AUTHENTICODE                                    Handle        = 0x800F0245
-	SPAPI_E_DEVICE_INSTALLER_NOT_READY                                        Handle        = 0x800F0246
-	SPAPI_E_DRIVER_STORE_ADD_FAILED                                           Handle        = 0x800F0247
-	SPAPI_E_DEVICE_INSTALL_BLOCKED                                            Handle        = 0x800F0248
-	SPAPI_E_DRIVER_INSTALL_BLOCKED                                            Handle        = 0x800F0249
-	SPAPI_E_WRONG_INF_TYPE                                                    Handle        = 0x800F024A
-	SPAPI_E_FILE_HASH_NOT_IN_CATALOG                                          Handle        = 0x800F024B
-	SPAPI_E_DRIVER_STORE_DELETE_FAILED                                        Handle        = 0x800F024C
-	SPAPI_E_UNRECOVERABLE_STACK_OVERFLOW                                      Handle        = 0x800F0300
-	SPAPI_E_ERROR_NOT_INSTALLED                                               Handle        = 0x800F1000
-	SCARD_S_SUCCESS                                                                         = S_OK
-	SCARD_F_INTERNAL_ERROR                                                    Handle        = 0x80100001
-	SCARD_E_CANCELLED                                                         Handle        = 0x80100002
-	SCARD_E_INVALID_HANDLE                                                    Handle        = 0x80100003
-	SCARD_E_INVALID_PARAMETER                                                 Handle        = 0x80100004
-	SCARD_E_INVALID_TARGET                                                    Handle        = 0x80100005
-	SCARD_E_NO_MEMORY                                                         Handle        = 0x80100006
-	SCARD_F_WAITED_TOO_LONG                                                   Handle        = 0x80100007
-	SCARD_E_INSUFFICIENT_BUFFER                                               Handle        = 0x80100008
-	SCARD_E_UNKNOWN_READER                                                    Handle        = 0x80100009
-	SCARD_E_TIMEOUT                                                           Handle        = 0x8010000A
-	SCARD_E_SHARING_VIOLATION                                                 Handle        = 0x8010000B
-	SCARD_E_NO_SMARTCARD                                                      Handle        = 0x8010000C
-	SCARD_E_UNKNOWN_CARD                                                      Handle        = 0x8010000D
-	SCARD_E_CANT_DISPOSE                                                      Handle        = 0x8010000E
-	SCARD_E_PROTO_MISMATCH                                                    Handle        = 0x8010000F
-	SCARD_E_NOT_READY                                                         Handle        = 0x80100010
-	SCARD_E_INVALID_VALUE                                                     Handle        = 0x80100011
-	SCARD_E_SYSTEM_CANCELLED                                                  Handle        = 0x80100012
-	SCARD_F_COMM_ERROR                                                        Handle        = 0x80100013
-	SCARD_F_UNKNOWN_ERROR                                                     Handle        = 0x80100014
-	SCARD_E_INVALID_ATR                                                       Handle        = 0x80100015
-	SCARD_E_NOT_TRANSACTED                                                    Handle        = 0x80100016
-	SCARD_E_READER_UNAVAILABLE                                                Handle        = 0x80100017
-	SCARD_P_SHUTDOWN                                                          Handle        = 0x80100018
-	SCARD_E_PCI_TOO_SMALL                                                     Handle        = 0x80100019
-	SCARD_E_READER_UNSUPPORTED                                                Handle        = 0x8010001A
-	SCARD_E_DUPLICATE_READER                                                  Handle        = 0x8010001B
-	SCARD_E_CARD_UNSUPPORTED                                                  Handle        = 0x8010001C
-	SCARD_E_NO_SERVICE                                                        Handle        = 0x8010001D
-	SCARD_E_SERVICE_STOPPED                                                   Handle        = 0x8010001E
-	SCARD_E_UNEXPECTED                                                        Handle        = 0x8010001F
-	SCARD_E_ICC_INSTALLATION                                                  Handle        = 0x80100020
-	SCARD_E_ICC_CREATEORDER                                                   Handle        = 0x80100021
-	SCARD_E_UNSUPPORTED_FEATURE                                               Handle        = 0x80100022
-	SCARD_E_DIR_NOT_FOUND                                                     Handle        = 0x80100023
-	SCARD_E_FILE_NOT_FOUND                                                    Handle        = 0x80100024
-	SCARD_E_NO_DIR                                                            Handle        = 0x80100025
-	SCARD_E_NO_FILE                                                           Handle        = 0x80100026
-	SCARD_E_NO_ACCESS                                                         Handle        = 0x80100027
-	SCARD_E_WRITE_TOO_MANY                                                    Handle        = 0x80100028
-	SCARD_E_BAD_SEEK                                                          Handle        = 0x80100029
-	SCARD_E_INVALID_CHV                                                       Handle        = 0x8010002A
-	SCARD_E_UNKNOWN_RES_MNG                                                   Handle        = 0x8010002B
-	SCARD_E_NO_SUCH_CERTIFICATE                                               Handle        = 0x8010002C
-	SCARD_E_CERTIFICATE_UNAVAILABLE                                           Handle        = 0x8010002D
-	SCARD_E_NO_READERS_AVAILABLE                                              Handle        = 0x8010002E
-	SCARD_E_COMM_DATA_LOST                                                    Handle        = 0x8010002F
-	SCARD_E_NO_KEY_CONTAINER                                                  Handle        = 0x80100030
-	SCARD_E_SERVER_TOO_BUSY                                                   Handle        = 0x80100031
-	SCARD_E_PIN_CACHE_EXPIRED                                                 Handle        = 0x80100032
-	SCARD_E_NO_PIN_CACHE                                                      Handle        = 0x80100033
-	SCARD_E_READ_ONLY_CARD                                                    Handle        = 0x80100034
-	SCARD_W_UNSUPPORTED_CARD                                                  Handle        = 0x80100065
-	SCARD_W_UNRESPONSIVE_CARD                                                 Handle        = 0x80100066
-	SCARD_W_UNPOWERED_CARD                                                    Handle        = 0x80100067
-	SCARD_W_RESET_CARD                                                        Handle        = 0x80100068
-	SCARD_W_REMOVED_CARD                                                      Handle        = 0x80100069
-	SCARD_W_SECURITY_VIOLATION                                                Handle        = 0x8010006A
-	SCARD_W_WRONG_CHV                                                         Handle        = 0x8010006B
-	SCARD_W_CHV_BLOCKED                                                       Handle        = 0x8010006C
-	SCARD_W_EOF                                                               Handle        = 0x8010006D
-	SCARD_W_CANCELLED_BY_USER                                                 Handle        = 0x8010006E
-	SCARD_W_CARD_NOT_AUTHENTICATED                                            Handle        = 0x8010006F
-	SCARD_W_CACHE_ITEM_NOT_FOUND                                              Handle        = 0x80100070
-	SCARD_W_CACHE_ITEM_STALE                                                  Handle        = 0x80100071
-	SCARD_W_CACHE_ITEM_TOO_BIG                                                Handle        = 0x80100072
-	COMADMIN_E_OBJECTERRORS                                                   Handle        = 0x80110401
-	COMADMIN_E_OBJECTINVALID                                                  Handle        = 0x80110402
-	COMADMIN_E_KEYMISSING                                                     Handle        = 0x80110403
-	COMADMIN_E_ALREADYINSTALLED                                               Handle        = 0x80110404
-	COMADMIN_E_APP_FILE_WRITEFAIL                                             Handle        = 0x80110407
-	COMADMIN_E_APP_FILE_READFAIL                                              Handle        = 0x80110408
-	COMADMIN_E_APP_FILE_VERSION                                               Handle        = 0x80110409
-	COMADMIN_E_BADPATH                                                        Handle        = 0x8011040A
-	COMADMIN_E_APPLICATIONEXISTS                                              Handle        = 0x8011040B
-	COMADMIN_E_ROLEEXISTS                                                     Handle        = 0x8011040C
-	COMADMIN_E_CANTCOPYFILE                                                   Handle        = 0x8011040D
-	COMADMIN_E_NOUSER                                                         Handle        = 0x8011040F
-	COMADMIN_E_INVALIDUSERIDS                                                 Handle        = 0x80110410
-	COMADMIN_E_NOREGISTRYCLSID                                                Handle        = 0x80110411
-	COMADMIN_E_BADREGISTRYPROGID                                              Handle        = 0x80110412
-	COMADMIN_E_AUTHENTICATIONLEVEL                                            Handle        = 0x80110413
-	COMADMIN_E_USERPASSWDNOTVALID                                             Handle        = 0x80110414
-	COMADMIN_E_CLSIDORIIDMISMATCH                                             Handle        = 0x80110418
-	COMADMIN_E_REMOTEINTERFACE                                                Handle        = 0x80110419
-	COMADMIN_E_DLLREGISTERSERVER                                              Handle        = 0x8011041A
-	COMADMIN_E_NOSERVERSHARE                                                  Handle        = 0x8011041B
-	COMADMIN_E_DLLLOADFAILED                                                  Handle        = 0x8011041D
-	COMADMIN_E_BADREGISTRYLIBID                                               Handle        = 0x8011041E
-	COMADMIN_E_APPDIRNOTFOUND                                                 Handle        = 0x8011041F
-	COMADMIN_E_REGISTRARFAILED                                                Handle        = 0x80110423
-	COMADMIN_E_COMPFILE_DOESNOTEXIST                                          Handle        = 0x80110424
-	COMADMIN_E_COMPFILE_LOADDLLFAIL                                           Handle        = 0x80110425
-	COMADMIN_E_COMPFILE_GETCLASSOBJ                                           Handle        = 0x80110426
-	COMADMIN_E_COMPFILE_CLASSNOTAVAIL                                         Handle        = 0x80110427
-	COMADMIN_E_COMPFILE_BADTLB                                                Handle        = 0x80110428
-	COMADMIN_E_COMPFILE_NOTINSTALLABLE                                        Handle        = 0x80110429
-	COMADMIN_E_NOTCHANGEABLE                                                  Handle        = 0x8011042A
-	COMADMIN_E_NOTDELETEABLE                                                  Handle        = 0x8011042B
-	COMADMIN_E_SESSION                                                        Handle        = 0x8011042C
-	COMADMIN_E_COMP_MOVE_LOCKED                                               Handle        = 0x8011042D
-	COMADMIN_E_COMP_MOVE_BAD_DEST                                             Handle        = 0x8011042E
-	COMADMIN_E_REGISTERTLB                                                    Handle        = 0x80110430
-	COMADMIN_E_SYSTEMAPP                                                      Handle        = 0x80110433
-	COMADMIN_E_COMPFILE_NOREGISTRAR                                           Handle        = 0x80110434
-	COMADMIN_E_COREQCOMPINSTALLED                                             Handle        = 0x80110435
-	COMADMIN_E_SERVICENOTINSTALLED                                            Handle        = 0x80110436
-	COMADMIN_E_PROPERTYSAVEFAILED                                             Handle        = 0x80110437
-	COMADMIN_E_OBJECTEXISTS                                                   Handle        = 0x80110438
-	COMADMIN_E_COMPONENTEXISTS                                                Handle        = 0x80110439
-	COMADMIN_E_REGFILE_CORRUPT                                                Handle        = 0x8011043B
-	COMADMIN_E_PROPERTY_OVERFLOW                                              Handle        = 0x8011043C
-	COMADMIN_E_NOTINREGISTRY                                                  Handle        = 0x8011043E
-	COMADMIN_E_OBJECTNOTPOOLABLE                                              Handle        = 0x8011043F
-	COMADMIN_E_APPLID_MATCHES_CLSID                                           Handle        = 0x80110446
-	COMADMIN_E_ROLE_DOES_NOT_EXIST                                            Handle        = 0x80110447
-	COMADMIN_E_START_APP_NEEDS_COMPONENTS                                     Handle        = 0x80110448
-	COMADMIN_E_REQUIRES_DIFFERENT_PLATFORM                                    Handle        = 0x80110449
-	COMADMIN_E_CAN_NOT_EXPORT_APP_PROXY                                       Handle        = 0x8011044A
-	COMADMIN_E_CAN_NOT_START_APP                                              Handle        = 0x8011044B
-	COMADMIN_E_CAN_NOT_EXPORT_SYS_APP                                         Handle        = 0x8011044C
-	COMADMIN_E_CANT_SUBSCRIBE_TO_COMPONENT                                    Handle        = 0x8011044D
-	COMADMIN_E_EVENTCLASS_CANT_BE_SUBSCRIBER                                  Handle        = 0x8011044E
-	COMADMIN_E_LIB_APP_PROXY_INCOMPATIBLE                                     Handle        = 0x8011044F
-	COMADMIN_E_BASE_PARTITION_ONLY                                            Handle        = 0x80110450
-	COMADMIN_E_START_APP_DISABLED                                             Handle        = 0x80110451
-	COMADMIN_E_CAT_DUPLICATE_PARTITION_NAME                                   Handle        = 0x80110457
-	COMADMIN_E_CAT_INVALID_PARTITION_NAME                                     Handle        = 0x80110458
-	COMADMIN_E_CAT_PARTITION_IN_USE                                           Handle        = 0x80110459
-	COMADMIN_E_FILE_PARTITION_DUPLICATE_FILES                                 Handle        = 0x8011045A
-	COMADMIN_E_CAT_IMPORTED_COMPONENTS_NOT_ALLOWED                            Handle        = 0x8011045B
-	COMADMIN_E_AMBIGUOUS_APPLICATION_NAME                                     Handle        = 0x8011045C
-	COMADMIN_E_AMBIGUOUS_PARTITION_NAME                                       Handle        = 0x8011045D
-	COMADMIN_E_REGDB_NOTINITIALIZED                                           Handle        = 0x80110472
-	COMADMIN_E_REGDB_NOTOPEN                                                  Handle        = 0x80110473
-	COMADMIN_E_REGDB_SYSTEMERR                                                Handle        = 0x80110474
-	COMADMIN_E_REGDB_ALREADYRUNNING                                           Handle        = 0x80110475
-	COMADMIN_E_MIG_VERSIONNOTSUPPORTED                                        Handle        = 0x80110480
-	COMADMIN_E_MIG_SCHEMANOTFOUND                                             Handle        = 0x80110481
-	COMADMIN_E_CAT_BITNESSMISMATCH                                            Handle        = 0x80110482
-	COMADMIN_E_CAT_UNACCEPTABLEBITNESS                                        Handle        = 0x80110483
-	COMADMIN_E_CAT_WRONGAPPBITNESS                                            Handle        = 0x80110484
-	COMADMIN_E_CAT_PAUSE_RESUME_NOT_SUPPORTED                                 Handle        = 0x80110485
-	COMADMIN_E_CAT_SERVERFAULT                                                Handle        = 0x80110486
-	COMQC_E_APPLICATION_NOT_QUEUED                                            Handle        = 0x80110600
-	COMQC_E_NO_QUEUEABLE_INTERFACES                                           Handle        = 0x80110601
-	COMQC_E_QUEUING_SERVICE_NOT_AVAILABLE                                     Handle        = 0x80110602
-	COMQC_E_NO_IPERSISTSTREAM                                                 Handle        = 0x80110603
-	COMQC_E_BAD_MESSAGE                                                       Handle        = 0x80110604
-	COMQC_E_UNAUTHENTICATED                                                   Handle        = 0x80110605
-	COMQC_E_UNTRUSTED_ENQUEUER                                                Handle        = 0x80110606
-	MSDTC_E_DUPLICATE_RESOURCE                                                Handle        = 0x80110701
-	COMADMIN_E_OBJECT_PARENT_MISSING                                          Handle        = 0x80110808
-	COMADMIN_E_OBJECT_DOES_NOT_EXIST                                          Handle        = 0x80110809
-	COMADMIN_E_APP_NOT_RUNNING                                                Handle        = 0x8011080A
-	COMADMIN_E_INVALID_PARTITION                                              Handle        = 0x8011080B
-	COMADMIN_E_SVCAPP_NOT_POOLABLE_OR_RECYCLABLE                              Handle        = 0x8011080D
-	COMADMIN_E_USER_IN_SET                                                    Handle        = 0x8011080E
-	COMADMIN_E_CANTRECYCLELIBRARYAPPS                                         Handle        = 0x8011080F
-	COMADMIN_E_CANTRECYCLESERVICEAPPS                                         Handle        = 0x80110811
-	COMADMIN_E_PROCESSALREADYRECYCLED                                         Handle        = 0x80110812
-	COMADMIN_E_PAUSEDPROCESSMAYNOTBERECYCLED                                  Handle        = 0x80110813
-	COMADMIN_E_CANTMAKEINPROCSERVICE                                          Handle        = 0x80110814
-	COMADMIN_E_PROGIDINUSEBYCLSID                                             Handle        = 0x80110815
-	COMADMIN_E_DEFAULT_PARTITION_NOT_IN_SET                                   Handle        = 0x80110816
-	COMADMIN_E_RECYCLEDPROCESSMAYNOTBEPAUSED                                  Handle        = 0x80110817
-	COMADMIN_E_PARTITION_ACCESSDENIED                                         Handle        = 0x80110818
-	COMADMIN_E_PARTITION_MSI_ONLY                                             Handle        = 0x80110819
-	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_1_0_FORMAT                          Handle        = 0x8011081A
-	COMADMIN_E_LEGACYCOMPS_NOT_ALLOWED_IN_NONBASE_PARTITIONS                  Handle        = 0x8011081B
-	COMADMIN_E_COMP_MOVE_SOURCE                                               Handle        = 0x8011081C
-	COMADMIN_E_COMP_MOVE_DEST                                                 Handle        = 0x8011081D
-	COMADMIN_E_COMP_MOVE_PRIVATE                                              Handle        = 0x8011081E
-	COMADMIN_E_BASEPARTITION_REQUIRED_IN_SET                                  Handle        = 0x8011081F
-	COMADMIN_E_CANNOT_ALIAS_EVENTCLASS                                        Handle        = 0x80110820
-	COMADMIN_E_PRIVATE_ACCESSDENIED                                           Handle        = 0x80110821
-	COMADMIN_E_SAFERINVALID                                                   Handle        = 0x80110822
-	COMADMIN_E_REGISTRY_ACCESSDENIED                                          Handle        = 0x80110823
-	COMADMIN_E_PARTITIONS_DISABLED                                            Handle        = 0x80110824
-	WER_S_REPORT_DEBUG                                                        Handle        = 0x001B0000
-	WER_S_REPORT_UPLOADED                                                     Handle        = 0x001B0001
-	WER_S_REPORT_QUEUED                                                       Handle        = 0x001B0002
-	WER_S_DISABLED                                                            Handle        = 0x001B0003
-	WER_S_SUSPENDED_UPLOAD                                                    Handle        = 0x001B0004
-	WER_S_DISABLED_QUEUE                                                      Handle        = 0x001B0005
-	WER_S_DISABLED_ARCHIVE                                                    Handle        = 0x001B0006
-	WER_S_REPORT_ASYNC                                                        Handle        = 0x001B0007
-	WER_S_IGNORE_ASSERT_INSTANCE                                              Handle        = 0x001B0008
-	WER_S_IGNORE_ALL_ASSERTS                                                  Handle        = 0x001B0009
-	WER_S_ASSERT_CONTINUE                                                     Handle        = 0x001B000A
-	WER_S_THROTTLED                                                           Handle        = 0x001B000B
-	WER_S_REPORT_UPLOADED_CAB                                                 Handle        = 0x001B000C
-	WER_E_CRASH_FAILURE                                                       Handle        = 0x801B8000
-	WER_E_CANCELED                                                            Handle        = 0x801B8001
-	WER_E_NETWORK_FAILURE                                                     Handle        = 0x801B8002
-	WER_E_NOT_INITIALIZED                                                     Handle        = 0x801B8003
-	WER_E_ALREADY_REPORTING                                                   Handle        = 0x801B8004
-	WER_E_DUMP_THROTTLED                                                      Handle        = 0x801B8005
-	WER_E_INSUFFICIENT_CONSENT                                                Handle        = 0x801B8006
-	WER_E_TOO_HEAVY                                                           Handle        = 0x801B8007
-	ERROR_FLT_IO_COMPLETE                                                     Handle        = 0x001F0001
-	ERROR_FLT_NO_HANDLER_DEFINED                                              Handle        = 0x801F0001
-	ERROR_FLT_CONTEXT_ALREADY_DEFINED                                         Handle        = 0x801F0002
-	ERROR_FLT_INVALID_ASYNCHRONOUS_REQUEST                                    Handle        = 0x801F0003
-	ERROR_FLT_DISALLOW_FAST_IO                                                Handle        = 0x801F0004
-	ERROR_FLT_INVALID_NAME_REQUEST                                            Handle        = 0x801F0005
-	ERROR_FLT_NOT_SAFE_TO_POST_OPERATION                                      Handle        = 0x801F0006
-	ERROR_FLT_NOT_INITIALIZED                                                 Handle        = 0x801F0007
-	ERROR_FLT_FILTER_NOT_READY                                                Handle        = 0x801F0008
-	ERROR_FLT_POST_OPERATION_CLEANUP                                          Handle        = 0x801F0009
-	ERROR_FLT_INTERNAL_ERROR                                                  Handle        = 0x801F000A
-	ERROR_FLT_DELETING_OBJECT                                                 Handle        = 0x801F000B
-	ERROR_FLT_MUST_BE_NONPAGED_POOL                                           Handle        = 0x801F000C
-	ERROR_FLT_DUPLICATE_ENTRY                                                 Handle        = 0x801F000D
-	ERROR_FLT_CBDQ_DISABLED                                                   Handle        = 0x801F000E
-	ERROR_FLT_DO_NOT_ATTACH                                                   Handle        = 0x801F000F
-	ERROR_FLT_DO_NOT_DETACH                                                   Handle        = 0x801F0010
-	ERROR_FLT_INSTANCE_ALTITUDE_COLLISION                                     Handle        = 0x801F0011
-	ERROR_FLT_INSTANCE_NAME_COLLISION                                         Handle        = 0x801F0012
-	ERROR_FLT_FILTER_NOT_FOUND                                                Handle        = 0x801F0013
-	ERROR_FLT_VOLUME_NOT_FOUND                                                Handle        = 0x801F0014
-	ERROR_FLT_INSTANCE_NOT_FOUND                                              Handle        = 0x801F0015
-	ERROR_FLT_CONTEXT_ALLOCATION_NOT_FOUND                                    Handle        = 0x801F0016
-	ERROR_FLT_INVALID_CONTEXT_REGISTRATION                                    Handle        = 0x801F0017
-	ERROR_FLT_NAME_CACHE_MISS                                                 Handle        = 0x801F0018
-	ERROR_FLT_NO_DEVICE_OBJECT                                                Handle        = 0x801F0019
-	ERROR_FLT_VOLUME_ALREADY_MOUNTED                                          Handle        = 0x801F001A
-	ERROR_FLT_ALREADY_ENLISTED                                                Handle        = 0x801F001B
-	ERROR_FLT_CONTEXT_ALREADY_LINKED                                          Handle        = 0x801F001C
-	ERROR_FLT_NO_WAITER_FOR_REPLY                                             Handle        = 0x801F0020
-	ERROR_FLT_REGISTRATION_BUSY                                               Handle        = 0x801F0023
-	ERROR_HUNG_DISPLAY_DRIVER_THREAD                                          Handle        = 0x80260001
-	DWM_E_COMPOSITIONDISABLED                                                 Handle        = 0x80263001
-	DWM_E_REMOTING_NOT_SUPPORTED                                              Handle        = 0x80263002
-	DWM_E_NO_REDIRECTION_SURFACE_AVAILABLE                                    Handle        = 0x80263003
-	DWM_E_NOT_QUEUING_PRESENTS                                                Handle        = 0x80263004
-	DWM_E_ADAPTER_NOT_FOUND                                                   Handle        = 0x80263005
-	DWM_S_GDI_REDIRECTION_SURFACE                                             Handle        = 0x00263005
-	DWM_E_TEXTURE_TOO_LARGE                                                   Handle        = 0x80263007
-	DWM_S_GDI_REDIRECTION_SURFACE_BLT_VIA_GDI                                 Handle        = 0x00263008
-	ERROR_MONITOR_NO_DESCRIPTOR                                               Handle        = 0x00261001
-	ERROR_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT                                   Handle        = 0x00261002
-	ERROR_MONITOR_INVALID_DESCRIPTOR_CHECKSUM                                 Handle        = 0xC0261003
-	ERROR_MONITOR_INVALID_STANDARD_TIMING_BLOCK                               Handle        = 0xC0261004
-	ERROR_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED                           Handle        = 0xC0261005
-	ERROR_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK                          Handle        = 0xC0261006
-	ERROR_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK                          Handle        = 0xC0261007
-	ERROR_MONITOR_NO_MORE_DESCRIPTOR_DATA                                     Handle        = 0xC0261008
-	ERROR_MONITOR_INVALID_DETAILED_TIMING_BLOCK                               Handle        = 0xC0261009
-	ERROR_MONITOR_INVALID_MANUFACTURE_DATE                                    Handle        = 0xC026100A
-	ERROR_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER                                   Handle        = 0xC0262000
-	ERROR_GRAPHICS_INSUFFICIENT_DMA_BUFFER                                    Handle        = 0xC0262001
-	ERROR_GRAPHICS_INVALID_DISPLAY_ADAPTER                                    Handle        = 0xC0262002
-	ERROR_GRAPHICS_ADAPTER_WAS_RESET                                          Handle        = 0xC0262003
-	ERROR_GRAPHICS_INVALID_DRIVER_MODEL                                       Handle        = 0xC0262004
-	ERROR_GRAPHICS_PRESENT_MODE_CHANGED                                       Handle        = 0xC0262005
-	ERROR_GRAPHICS_PRESENT_OCCLUDED                                           Handle        = 0xC0262006
-	ERROR_GRAPHICS_PRESENT_DENIED                                             Handle        = 0xC0262007
-	ERROR_GRAPHICS_CANNOTCOLORCONVERT                                         Handle        = 0xC0262008
-	ERROR_GRAPHICS_DRIVER_MISMATCH                                            Handle        = 0xC0262009
-	ERROR_GRAPHICS_PARTIAL_DATA_POPULATED                                     Handle        = 0x4026200A
-	ERROR_GRAPHICS_PRESENT_REDIRECTION_DISABLED                               Handle        = 0xC026200B
-	ERROR_GRAPHICS_PRESENT_UNOCCLUDED                                         Handle        = 0xC026200C
-	ERROR_GRAPHICS_WINDOWDC_NOT_AVAILABLE                                     Handle        = 0xC026200D
-	ERROR_GRAPHICS_WINDOWLESS_PRESENT_DISABLED                                Handle        = 0xC026200E
-	ERROR_GRAPHICS_PRESENT_INVALID_WINDOW                                     Handle        = 0xC026200F
-	ERROR_GRAPHICS_PRESENT_BUFFER_NOT_BOUND                                   Handle        = 0xC0262010
-	ERROR_GRAPHICS_VAIL_STATE_CHANGED                                         Handle        = 0xC0262011
-	ERROR_GRAPHICS_NO_VIDEO_MEMORY                                            Handle        = 0xC0262100
-	ERROR_GRAPHICS_CANT_LOCK_MEMORY                                           Handle        = 0xC0262101
-	ERROR_GRAPHICS_ALLOCATION_BUSY                                            Handle        = 0xC0262102
-	ERROR_GRAPHICS_TOO_MANY_REFERENCES                                        Handle        = 0xC0262103
-	ERROR_GRAPHICS_TRY_AGAIN_LATER                                            Handle        = 0xC0262104
-	ERROR_GRAPHICS_TRY_AGAIN_NOW                                              Handle        = 0xC0262105
-	ERROR_GRAPHICS_ALLOCATION_INVALID                                         Handle        = 0xC0262106
-	ERROR_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE                           Handle        = 0xC0262107
-	ERROR_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED                           Handle        = 0xC0262108
-	ERROR_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION                               Handle        = 0xC0262109
-	ERROR_GRAPHICS_INVALID_ALLOCATION_USAGE                                   Handle        = 0xC0262110
-	ERROR_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION                              Handle        = 0xC0262111
-	ERROR_GRAPHICS_ALLOCATION_CLOSED                                          Handle        = 0xC0262112
-	ERROR_GRAPHICS_INVALID_ALLOCATION_INSTANCE                                Handle        = 0xC0262113
-	ERROR_GRAPHICS_INVALID_ALLOCATION_HANDLE                                  Handle        = 0xC0262114
-	ERROR_GRAPHICS_WRONG_ALLOCATION_DEVICE                                    Handle        = 0xC0262115
-	ERROR_GRAPHICS_ALLOCATION_CONTENT_LOST                                    Handle        = 0xC0262116
-	ERROR_GRAPHICS_GPU_EXCEPTION_ON_DEVICE                                    Handle        = 0xC0262200
-	ERROR_GRAPHICS_SKIP_ALLOCATION_PREPARATION                                Handle        = 0x40262201
-	ERROR_GRAPHICS_INVALID_VIDPN_TOPOLOGY                                     Handle        = 0xC0262300
-	ERROR_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED                               Handle        = 0xC0262301
-	ERROR_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED                     Handle        = 0xC0262302
-	ERROR_GRAPHICS_INVALID_VIDPN                                              Handle        = 0xC0262303
-	ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE                               Handle        = 0xC0262304
-	ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET                               Handle        = 0xC0262305
-	ERROR_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED                               Handle        = 0xC0262306
-	ERROR_GRAPHICS_MODE_NOT_PINNED                                            Handle        = 0x00262307
-	ERROR_GRAPHICS_INVALID_VIDPN_SOURCEMODESET                                Handle        = 0xC0262308
-	ERROR_GRAPHICS_INVALID_VIDPN_TARGETMODESET                                Handle        = 0xC0262309
-	ERROR_GRAPHICS_INVALID_FREQUENCY                                          Handle        = 0xC026230A
-	ERROR_GRAPHICS_INVALID_ACTIVE_REGION                                      Handle        = 0xC026230B
-	ERROR_GRAPHICS_INVALID_TOTAL_REGION                                       Handle        = 0xC026230C
-	ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE                          Handle        = 0xC0262310
-	ERROR_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE                          Handle        = 0xC0262311
-	ERROR_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET                             Handle        = 0xC0262312
-	ERROR_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY                                   Handle        = 0xC0262313
-	ERROR_GRAPHICS_MODE_ALREADY_IN_MODESET                                    Handle        = 0xC0262314
-	ERROR_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET                              Handle        = 0xC0262315
-	ERROR_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET                              Handle        = 0xC0262316
-	ERROR_GRAPHICS_SOURCE_ALREADY_IN_SET                                      Handle        = 0xC0262317
-	ERROR_GRAPHICS_TARGET_ALREADY_IN_SET                                      Handle        = 0xC0262318
-	ERROR_GRAPHICS_INVALID_VIDPN_PRESENT_PATH                                 Handle        = 0xC0262319
-	ERROR_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY                              Handle        = 0xC026231A
-	ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET                          Handle        = 0xC026231B
-	ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE                             Handle        = 0xC026231C
-	ERROR_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET                                  Handle        = 0xC026231D
-	ERROR_GRAPHICS_NO_PREFERRED_MODE                                          Handle        = 0x0026231E
-	ERROR_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET                              Handle        = 0xC026231F
-	ERROR_GRAPHICS_STALE_MODESET                                              Handle        = 0xC0262320
-	ERROR_GRAPHICS_INVALID_MONITOR_SOURCEMODESET                              Handle        = 0xC0262321
-	ERROR_GRAPHICS_INVALID_MONITOR_SOURCE_MODE                                Handle        = 0xC0262322
-	ERROR_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN                            Handle        = 0xC0262323
-	ERROR_GRAPHICS_MODE_ID_MUST_BE_UNIQUE                                     Handle        = 0xC0262324
-	ERROR_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION            Handle        = 0xC0262325
-	ERROR_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES                    Handle        = 0xC0262326
-	ERROR_GRAPHICS_PATH_NOT_IN_TOPOLOGY                                       Handle        = 0xC0262327
-	ERROR_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE                      Handle        = 0xC0262328
-	ERROR_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET                      Handle        = 0xC0262329
-	ERROR_GRAPHICS_INVALID_MONITORDESCRIPTORSET                               Handle        = 0xC026232A
-	ERROR_GRAPHICS_INVALID_MONITORDESCRIPTOR                                  Handle        = 0xC026232B
-	ERROR_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET                               Handle        = 0xC026232C
-	ERROR_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET                           Handle        = 0xC026232D
-	ERROR_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE                        Handle        = 0xC026232E
-	ERROR_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE                           Handle        = 0xC026232F
-	ERROR_GRAPHICS_RESOURCES_NOT_RELATED                                      Handle        = 0xC0262330
-	ERROR_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE                                   Handle        = 0xC0262331
-	ERROR_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE                                   Handle        = 0xC0262332
-	ERROR_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET                                  Handle        = 0xC0262333
-	ERROR_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER               Handle        = 0xC0262334
-	ERROR_GRAPHICS_NO_VIDPNMGR                                                Handle        = 0xC0262335
-	ERROR_GRAPHICS_NO_ACTIVE_VIDPN                                            Handle        = 0xC0262336
-	ERROR_GRAPHICS_STALE_VIDPN_TOPOLOGY                                       Handle        = 0xC0262337
-	ERROR_GRAPHICS_MONITOR_NOT_CONNECTED                                      Handle        = 0xC0262338
-	ERROR_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY                                     Handle        = 0xC0262339
-	ERROR_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE                                Handle        = 0xC026233A
-	ERROR_GRAPHICS_INVALID_VISIBLEREGION_SIZE                                 Handle        = 0xC026233B
-	ERROR_GRAPHICS_INVALID_STRIDE                                             Handle        = 0xC026233C
-	ERROR_GRAPHICS_INVALID_PIXELFORMAT                                        Handle        = 0xC026233D
-	ERROR_GRAPHICS_INVALID_COLORBASIS                                         Handle        = 0xC026233E
-	ERROR_GRAPHICS_INVALID_PIXELVALUEACCESSMODE                               Handle        = 0xC026233F
-	ERROR_GRAPHICS_TARGET_NOT_IN_TOPOLOGY                                     Handle        = 0xC0262340
-	ERROR_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT                         Handle        = 0xC0262341
-	ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE                                        Handle        = 0xC0262342
-	ERROR_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN                                   Handle        = 0xC0262343
-	ERROR_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL                            Handle        = 0xC0262344
-	ERROR_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION               Handle        = 0xC0262345
-	ERROR_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED         Handle        = 0xC0262346
-	ERROR_GRAPHICS_INVALID_GAMMA_RAMP                                         Handle        = 0xC0262347
-	ERROR_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED                                   Handle        = 0xC0262348
-	ERROR_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED                                Handle        = 0xC0262349
-	ERROR_GRAPHICS_MODE_NOT_IN_MODESET                                        Handle        = 0xC026234A
-	ERROR_GRAPHICS_DATASET_IS_EMPTY                                           Handle        = 0x0026234B
-	ERROR_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET                                Handle        = 0x0026234C
-	ERROR_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON               Handle        = 0xC026234D
-	ERROR_GRAPHICS_INVALID_PATH_CONTENT_TYPE                                  Handle        = 0xC026234E
-	ERROR_GRAPHICS_INVALID_COPYPROTECTION_TYPE                                Handle        = 0xC026234F
-	ERROR_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS                          Handle        = 0xC0262350
-	ERROR_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED            Handle        = 0x00262351
-	ERROR_GRAPHICS_INVALID_SCANLINE_ORDERING                                  Handle        = 0xC0262352
-	ERROR_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED                               Handle        = 0xC0262353
-	ERROR_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS                           Handle        = 0xC0262354
-	ERROR_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT                                Handle        = 0xC0262355
-	ERROR_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM                             Handle        = 0xC0262356
-	ERROR_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN                          Handle        = 0xC0262357
-	ERROR_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT                  Handle        = 0xC0262358
-	ERROR_GRAPHICS_MAX_NUM_PATHS_REACHED                                      Handle        = 0xC0262359
-	ERROR_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION                         Handle        = 0xC026235A
-	ERROR_GRAPHICS_INVALID_CLIENT_TYPE                                        Handle        = 0xC026235B
-	ERROR_GRAPHICS_CLIENTVIDPN_NOT_SET                                        Handle        = 0xC026235C
-	ERROR_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED                          Handle        = 0xC0262400
-	ERROR_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED                             Handle        = 0xC0262401
-	ERROR_GRAPHICS_UNKNOWN_CHILD_STATUS                                       Handle        = 0x4026242F
-	ERROR_GRAPHICS_NOT_A_LINKED_ADAPTER                                       Handle        = 0xC0262430
-	ERROR_GRAPHICS_LEADLINK_NOT_ENUMERATED                                    Handle        = 0xC0262431
-	ERROR_GRAPHICS_CHAINLINKS_NOT_ENUMERATED                                  Handle        = 0xC0262432
-	ERROR_GRAPHICS_ADAPTER_CHAIN_NOT_READY                                    Handle        = 0xC0262433
-	ERROR_GRAPHICS_CHAINLINKS_NOT_STARTED                                     Handle        = 0xC0262434
-	ERROR_GRAPHICS_CHAINLINKS_NOT_POWERED_ON                                  Handle        = 0xC0262435
-	ERROR_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE                             Handle        = 0xC0262436
-	ERROR_GRAPHICS_LEADLINK_START_DEFERRED                                    Handle        = 0x40262437
-	ERROR_GRAPHICS_NOT_POST_DEVICE_DRIVER                                     Handle        = 0xC0262438
-	ERROR_GRAPHICS_POLLING_TOO_FREQUENTLY                                     Handle        = 0x40262439
-	ERROR_GRAPHICS_START_DEFERRED                                             Handle        = 0x4026243A
-	ERROR_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED                                Handle        = 0xC026243B
-	ERROR_GRAPHICS_DEPENDABLE_CHILD_STATUS                                    Handle        = 0x4026243C
-	ERROR_GRAPHICS_OPM_NOT_SUPPORTED                                          Handle        = 0xC0262500
-	ERROR_GRAPHICS_COPP_NOT_SUPPORTED                                         Handle        = 0xC0262501
-	ERROR_GRAPHICS_UAB_NOT_SUPPORTED                                          Handle        = 0xC0262502
-	ERROR_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS                           Handle        = 0xC0262503
-	ERROR_GRAPHICS_OPM_NO_VIDEO_OUTPUTS_EXIST                                 Handle        = 0xC0262505
-	ERROR_GRAPHICS_OPM_INTERNAL_ERROR                                         Handle        = 0xC026250B
-	ERROR_GRAPHICS_OPM_INVALID_HANDLE                                         Handle        = 0xC026250C
-	ERROR_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH                             Handle        = 0xC026250E
-	ERROR_GRAPHICS_OPM_SPANNING_MODE_ENABLED                                  Handle        = 0xC026250F
-	ERROR_GRAPHICS_OPM_THEATER_MODE_ENABLED                                   Handle        = 0xC0262510
-	ERROR_GRAPHICS_PVP_HFS_FAILED                                             Handle        = 0xC0262511
-	ERROR_GRAPHICS_OPM_INVALID_SRM                                            Handle        = 0xC0262512
-	ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP                           Handle        = 0xC0262513
-	ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP                            Handle        = 0xC0262514
-	ERROR_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA                          Handle        = 0xC0262515
-	ERROR_GRAPHICS_OPM_HDCP_SRM_NEVER_SET                                     Handle        = 0xC0262516
-	ERROR_GRAPHICS_OPM_RESOLUTION_TOO_HIGH                                    Handle        = 0xC0262517
-	ERROR_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE                       Handle        = 0xC0262518
-	ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_NO_LONGER_EXISTS                          Handle        = 0xC026251A
-	ERROR_GRAPHICS_OPM_SESSION_TYPE_CHANGE_IN_PROGRESS                        Handle        = 0xC026251B
-	ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS              Handle        = 0xC026251C
-	ERROR_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST                            Handle        = 0xC026251D
-	ERROR_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR                                  Handle        = 0xC026251E
-	ERROR_GRAPHICS_OPM_VIDEO_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS               Handle        = 0xC026251F
-	ERROR_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED                                Handle        = 0xC0262520
-	ERROR_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST                          Handle        = 0xC0262521
-	ERROR_GRAPHICS_I2C_NOT_SUPPORTED                                          Handle        = 0xC0262580
-	ERROR_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST                                  Handle        = 0xC0262581
-	ERROR_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA                                Handle        = 0xC0262582
-	ERROR_GRAPHICS_I2C_ERROR_RECEIVING_DATA                                   Handle        = 0xC0262583
-	ERROR_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED                                    Handle        = 0xC0262584
-	ERROR_GRAPHICS_DDCCI_INVALID_DATA                                         Handle        = 0xC0262585
-	ERROR_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE          Handle        = 0xC0262586
-	ERROR_GRAPHICS_MCA_INVALID_CAPABILITIES_STRING                            Handle        = 0xC0262587
-	ERROR_GRAPHICS_MCA_INTERNAL_ERROR                                         Handle        = 0xC0262588
-	ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND                              Handle        = 0xC0262589
-	ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH                               Handle        = 0xC026258A
-	ERROR_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM                             Handle        = 0xC026258B
-	ERROR_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE                            Handle        = 0xC026258C
-	ERROR_GRAPHICS_MONITOR_NO_LONGER_EXISTS                                   Handle        = 0xC026258D
-	ERROR_GRAPHICS_DDCCI_CURRENT_CURRENT_VALUE_GREATER_THAN_MAXIMUM_VALUE     Handle        = 0xC02625D8
-	ERROR_GRAPHICS_MCA_INVALID_VCP_VERSION                                    Handle        = 0xC02625D9
-	ERROR_GRAPHICS_MCA_MONITOR_VIOLATES_MCCS_SPECIFICATION                    Handle        = 0xC02625DA
-	ERROR_GRAPHICS_MCA_MCCS_VERSION_MISMATCH                                  Handle        = 0xC02625DB
-	ERROR_GRAPHICS_MCA_UNSUPPORTED_MCCS_VERSION                               Handle        = 0xC02625DC
-	ERROR_GRAPHICS_MCA_INVALID_TECHNOLOGY_TYPE_RETURNED                       Handle        = 0xC02625DE
-	ERROR_GRAPHICS_MCA_UNSUPPORTED_COLOR_TEMPERATURE                          Handle        = 0xC02625DF
-	ERROR_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED                             Handle        = 0xC02625E0
-	ERROR_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME                      Handle        = 0xC02625E1
-	ERROR_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP                     Handle        = 0xC02625E2
-	ERROR_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED                            Handle        = 0xC02625E3
-	ERROR_GRAPHICS_INVALID_POINTER                                            Handle        = 0xC02625E4
-	ERROR_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE                   Handle        = 0xC02625E5
-	ERROR_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL                                  Handle        = 0xC02625E6
-	ERROR_GRAPHICS_INTERNAL_ERROR                                             Handle        = 0xC02625E7
-	ERROR_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS                            Handle        = 0xC02605E8
-	NAP_E_INVALID_PACKET                                                      Handle        = 0x80270001
-	NAP_E_MISSING_SOH                                                         Handle        = 0x80270002
-	NAP_E_CONFLICTING_ID                                                      Handle        = 0x80270003
-	NAP_E_NO_CACHED_SOH                                                       Handle        = 0x80270004
-	NAP_E_STILL_BOUND                                                         Handle        = 0x80270005
-	NAP_E_NOT_REGISTERED                                                      Handle        = 0x80270006
-	NAP_E_NOT_INITIALIZED                                                     Handle        = 0x80270007
-	NAP_E_MISMATCHED_ID                                                       Handle        = 0x80270008
-	NAP_E_NOT_PENDING                                                         Handle        = 0x80270009
-	NAP_E_ID_NOT_FOUND                                                        Handle        = 0x8027000A
-	NAP_E_MAXSIZE_TOO_SMALL                                                   Handle        = 0x8027000B
-	NAP_E_SERVICE_NOT_RUNNING                                                 Handle        = 0x8027000C
-	NAP_S_CERT_ALREADY_PRESENT                                                Handle        = 0x0027000D
-	NAP_E_ENTITY_DISABLED                                                     Handle        = 0x8027000E
-	NAP_E_NETSH_GROUPPOLICY_ERROR                                             Handle        = 0x8027000F
-	NAP_E_TOO_MANY_CALLS                                                      Handle        = 0x80270010
-	NAP_E_SHV_CONFIG_EXISTED                                                  Handle        = 0x80270011
-	NAP_E_SHV_CONFIG_NOT_FOUND                                                Handle        = 0x80270012
-	NAP_E_SHV_TIMEOUT                                                         Handle        = 0x80270013
-	TPM_E_ERROR_MASK                                                          Handle        = 0x80280000
-	TPM_E_AUTHFAIL                                                            Handle        = 0x80280001
-	TPM_E_BADINDEX                                                            Handle        = 0x80280002
-	TPM_E_BAD_PARAMETER                                                       Handle        = 0x80280003
-	TPM_E_AUDITFAILURE                                                        Handle        = 0x80280004
-	TPM_E_CLEAR_DISABLED                                                      Handle        = 0x80280005
-	TPM_E_DEACTIVATED                                                         Handle        = 0x80280006
-	TPM_E_DISABLED                                                            Handle        = 0x80280007
-	TPM_E_DISABLED_CMD                                                        Handle        = 0x80280008
-	TPM_E_FAIL                                                                Handle        = 0x80280009
-	TPM_E_BAD_ORDINAL                                                         Handle        = 0x8028000A
-	TPM_E_INSTALL_DISABLED                                                    Handle        = 0x8028000B
-	TPM_E_INVALID_KEYHANDLE                                                   Handle        = 0x8028000C
-	TPM_E_KEYNOTFOUND                                                         Handle        = 0x8028000D
-	TPM_E_INAPPROPRIATE_ENC                                                   Handle        = 0x8028000E
-	TPM_E_MIGRATEFAIL                                                         Handle        = 0x8028000F
-	TPM_E_INVALID_PCR_INFO                                                    Handle        = 0x80280010
-	TPM_E_NOSPACE                                                             Handle        = 0x80280011
-	TPM_E_NOSRK                                                               Handle        = 0x80280012
-	TPM_E_NOTSEALED_BLOB                                                      Handle        = 0x80280013
-	TPM_E_OWNER_SET                                                           Handle        = 0x80280014
-	TPM_E_RESOURCES                                                           Handle        = 0x80280015
-	TPM_E_SHORTRANDOM                                                         Handle        = 0x80280016
-	TPM_E_SIZE                                                                Handle        = 0x80280017
-	TPM_E_WRONGPCRVAL                                                         Handle        = 0x80280018
-	TPM_E_BAD_PARAM_SIZE                                                      Handle        = 0x80280019
-	TPM_E_SHA_THREAD                                                          Handle        = 0x8028001A
-	TPM_E_SHA_ERROR                                                           Handle        = 0x8028001B
-	TPM_E_FAILEDSELFTEST                                                      Handle        = 0x8028001C
-	TPM_E_AUTH2FAIL                                                           Handle        = 0x8028001D
-	TPM_E_BADTAG                                                              Handle        = 0x8028001E
-	TPM_E_IOERROR                                                             Handle        = 0x8028001F
-	TPM_E_ENCRYPT_ERROR                                                       Handle        = 0x80280020
-	TPM_E_DECRYPT_ERROR                                                       Handle        = 0x80280021
-	TPM_E_INVALID_AUTHHANDLE                                                  Handle        = 0x80280022
-	TPM_E_NO_ENDORSEMENT                                                      Handle        = 0x80280023
-	TPM_E_INVALID_KEYUSAGE                                                    Handle        = 0x80280024
-	TPM_E_WRONG_ENTITYTYPE                                                    Handle        = 0x80280025
-	TPM_E_INVALID_POSTINIT                                                    Handle        = 0x80280026
-	TPM_E_INAPPROPRIATE_SIG                                                   Handle        = 0x80280027
-	TPM_E_BAD_KEY_PROPERTY                                                    Handle        = 0x80280028
-	TPM_E_BAD_MIGRATION                                                       Handle        = 0x80280029
-	TPM_E_BAD_SCHEME                                                          Handle        = 0x8028002A
-	TPM_E_BAD_DATASIZE                                                        Handle        = 0x8028002B
-	TPM_E_BAD_MODE                                                            Handle        = 0x8028002C
-	TPM_E_BAD_PRESENCE                                                        Handle        = 0x8028002D
-	TPM_E_BAD_VERSION                                                         Handle        = 0x8028002E
-	TPM_E_NO_WRAP_TRANSPORT                                                   Handle        = 0x8028002F
-	TPM_E_AUDITFAIL_UNSUCCESSFUL                                              Handle        = 0x80280030
-	TPM_E_AUDITFAIL_SUCCESSFUL                                                Handle        = 0x80280031
-	TPM_E_NOTRESETABLE                                                        Handle        = 0x80280032
-	TPM_E_NOTLOCAL                                                            Handle        = 0x80280033
-	TPM_E_BAD_TYPE                                                            Handle        = 0x80280034
-	TPM_E_INVALID_RESOURCE                                                    Handle        = 0x80280035
-	TPM_E_NOTFIPS                                                             Handle        = 0x80280036
-	TPM_E_INVALID_FAMILY                                                      Handle        = 0x80280037
-	TPM_E_NO_NV_PERMISSION                                                    Handle        = 0x80280038
-	TPM_E_REQUIRES_SIGN                                                       Handle        = 0x80280039
-	TPM_E_KEY_NOTSUPPORTED                                                    Handle        = 0x8028003A
-	TPM_E_AUTH_CONFLICT                                                       Handle        = 0x8028003B
-	TPM_E_AREA_LOCKED                                                         Handle        = 0x8028003C
-	TPM_E_BAD_LOCALITY                                                        Handle        = 0x8028003D
-	TPM_E_READ_ONLY                                                           Handle        = 0x8028003E
-	TPM_E_PER_NOWRITE                                                         Handle        = 0x8028003F
-	TPM_E_FAMILYCOUNT                                                         Handle        = 0x80280040
-	TPM_E_WRITE_LOCKED                                                        Handle        = 0x80280041
-	TPM_E_BAD_ATTRIBUTES                                                      Handle        = 0x80280042
-	TPM_E_INVALID_STRUCTURE                                                   Handle        = 0x80280043
-	TPM_E_KEY_OWNER_CONTROL                                                   Handle        = 0x80280044
-	TPM_E_BAD_COUNTER                                                         Handle        = 0x80280045
-	TPM_E_NOT_FULLWRITE                                                       Handle        = 0x80280046
-	TPM_E_CONTEXT_GAP                                                         Handle        = 0x80280047
-	TPM_E_MAXNVWRITES                                                         Handle        = 0x80280048
-	TPM_E_NOOPERATOR                                                          Handle        = 0x80280049
-	TPM_E_RESOURCEMISSING                                                     Handle        = 0x8028004A
-	TPM_E_DELEGATE_LOCK                                                       Handle        = 0x8028004B
-	TPM_E_DELEGATE_FAMILY                                                     Handle        = 0x8028004C
-	TPM_E_DELEGATE_ADMIN                                                      Handle        = 0x8028004D
-	TPM_E_TRANSPORT_NOTEXCLUSIVE                                              Handle        = 0x8028004E
-	TPM_E_OWNER_CONTROL                                                       Handle        = 0x8028004F
-	TPM_E_DAA_RESOURCES                                                       Handle        = 0x80280050
-	TPM_E_DAA_INPUT_DATA0                                                     Handle        = 0x80280051
-	TPM_E_DAA_INPUT_DATA1                                                     Handle        = 0x80280052
-	TPM_E_DAA_ISSUER_SETTINGS                                                 Handle        = 0x80280053
-	TPM_E_DAA_TPM_SETTINGS                                                    Handle        = 0x80280054
-	TPM_E_DAA_STAGE                                                           Handle        = 0x80280055
-	TPM_E_DAA_ISSUER_VALIDITY                                                 Handle        = 0x80280056
-	TPM_E_DAA_WRONG_W                                                         Handle        = 0x80280057
-	TPM_E_BAD_HANDLE                                                          Handle        = 0x80280058
-	TPM_E_BAD_DELEGATE                                                        Handle        = 0x80280059
-	TPM_E_BADCONTEXT                                                          Handle        = 0x8028005A
-	TPM_E_TOOMANYCONTEXTS                                                     Handle        = 0x8028005B
-	TPM_E_MA_TICKET_SIGNATURE                                                 Handle        = 0x8028005C
-	TPM_E_MA_DESTINATION                                                      Handle        = 0x8028005D
-	TPM_E_MA_SOURCE                                                           Handle        = 0x8028005E
-	TPM_E_MA_AUTHORITY                                                        Handle        = 0x8028005F
-	TPM_E_PERMANENTEK                                                         Handle        = 0x80280061
-	TPM_E_BAD_SIGNATURE                                                       Handle        = 0x80280062
-	TPM_E_NOCONTEXTSPACE                                                      Handle        = 0x80280063
-	TPM_20_E_ASYMMETRIC                                                       Handle        = 0x80280081
-	TPM_20_E_ATTRIBUTES                                                       Handle        = 0x80280082
-	TPM_20_E_HASH                                                             Handle        = 0x80280083
-	TPM_20_E_VALUE                                                            Handle        = 0x80280084
-	TPM_20_E_HIERARCHY                                                        Handle        = 0x80280085
-	TPM_20_E_KEY_SIZE                                                         Handle        = 0x80280087
-	TPM_20_E_MGF                                                              Handle        = 0x80280088
-	TPM_20_E_MODE                                                             Handle        = 0x80280089
-	TPM_20_E_TYPE                                                             Handle        = 0x8028008A
-	TPM_20_E_HANDLE                                                           Handle        = 0x8028008B
-	TPM_20_E_KDF                                                              Handle        = 0x8028008C
-	TPM_20_E_RANGE                                                            Handle        = 0x8028008D
-	TPM_20_E_AUTH_FAIL                                                        Handle        = 0x8028008E
-	TPM_20_E_NONCE                                                            Handle        = 0x8028008F
-	TPM_20_E_PP                                                               Handle        = 0x80280090
-	TPM_20_E_SCHEME                                                           Handle        = 0x80280092
-	TPM_20_E_SIZE                                                             Handle        = 0x80280095
-	TPM_20_E_SYMMETRIC                                                        Handle        = 0x80280096
-	TPM_20_E_TAG                                                              Handle        = 0x80280097
-	TPM_20_E_SELECTOR                                                         Handle        = 0x80280098
-	TPM_20_E_INSUFFICIENT                                                     Handle        = 0x8028009A
-	TPM_20_E_SIGNATURE                                                        Handle        = 0x8028009B
-	TPM_20_E_KEY                                                              Handle        = 0x8028009C
-	TPM_20_E_POLICY_FAIL                                                      Handle        = 0x8028009D
-	TPM_20_E_INTEGRITY                                                        Handle        = 0x8028009F
-	TPM_20_E_TICKET                                                           Handle        = 0x802800A0
-	TPM_20_E_RESERVED_BITS                                                    Handle        = 0x802800A1
-	TPM_20_E_BAD_AUTH                                                         Handle        = 0x802800A2
-	TPM_20_E_EXPIRED                                                          Handle        = 0x802800A3
-	TPM_20_E_POLICY_CC                                                        Handle        = 0x802800A4
-	TPM_20_E_BINDING                                                          Handle        = 0x802800A5
-	TPM_20_E_CURVE                                                            Handle        = 0x802800A6
-	TPM_20_E_ECC_POINT                                                        Handle        = 0x802800A7
-	TPM_20_E_INITIALIZE                                                       Handle        = 0x80280100
-	TPM_20_E_FAILURE                                                          Handle        = 0x80280101
-	TPM_20_E_SEQUENCE                                                         Handle        = 0x80280103
-	TPM_20_E_PRIVATE                                                          Handle        = 0x8028010B
-	TPM_20_E_HMAC                                                             Handle        = 0x80280119
-	TPM_20_E_DISABLED                                                         Handle        = 0x80280120
-	TPM_20_E_EXCLUSIVE                                                        Handle        = 0x80280121
-	TPM_20_E_ECC_CURVE                                                        Handle        = 0x80280123
-	TPM_20_E_AUTH_TYPE                                                        Handle        = 0x80280124
-	TPM_20_E_AUTH_MISSING                                                     Handle        = 0x80280125
-	TPM_20_E_POLICY                                                           Handle        = 0x80280126
-	TPM_20_E_PCR                                                              Handle        = 0x80280127
-	TPM_20_E_PCR_CHANGED                                                      Handle        = 0x80280128
-	TPM_20_E_UPGRADE                                                          Handle        = 0x8028012D
-	TPM_20_E_TOO_MANY_CONTEXTS                                                Handle        = 0x8028012E
-	TPM_20_E_AUTH_UNAVAILABLE                                                 Handle        = 0x8028012F
-	TPM_20_E_REBOOT                                                           Handle        = 0x80280130
-	TPM_20_E_UNBALANCED                                                       Handle        = 0x80280131
-	TPM_20_E_COMMAND_SIZE                                                     Handle        = 0x80280142
-	TPM_20_E_COMMAND_CODE                                                     Handle        = 0x80280143
-	TPM_20_E_AUTHSIZE                                                         Handle        = 0x80280144
-	TPM_20_E_AUTH_CONTEXT                                                     Handle        = 0x80280145
-	TPM_20_E_NV_RANGE                                                         Handle        = 0x80280146
-	TPM_20_E_NV_SIZE                                                          Handle        = 0x80280147
-	TPM_20_E_NV_LOCKED                                                        Handle        = 0x80280148
-	TPM_20_E_NV_AUTHORIZATION                                                 Handle        = 0x80280149
-	TPM_20_E_NV_UNINITIALIZED                                                 Handle        = 0x8028014A
-	TPM_20_E_NV_SPACE                                                         Handle        = 0x8028014B
-	TPM_20_E_NV_DEFINED                                                       Handle        = 0x8028014C
-	TPM_20_E_BAD_CONTEXT                                                      Handle        = 0x80280150
-	TPM_20_E_CPHASH                                                           Handle        = 0x80280151
-	TPM_20_E_PARENT                                                           Handle        = 0x80280152
-	TPM_20_E_NEEDS_TEST                                                       Handle        = 0x80280153
-	TPM_20_E_NO_RESULT                                                        Handle        = 0x80280154
-	TPM_20_E_SENSITIVE                                                        Handle        = 0x80280155
-	TPM_E_COMMAND_BLOCKED                                                     Handle        = 0x80280400
-	TPM_E_INVALID_HANDLE                                                      Handle        = 0x80280401
-	TPM_E_DUPLICATE_VHANDLE                                                   Handle        = 0x80280402
-	TPM_E_EMBEDDED_COMMAND_BLOCKED                                            Handle        = 0x80280403
-	TPM_E_EMBEDDED_COMMAND_UNSUPPORTED                                        Handle        = 0x80280404
-	TPM_E_RETRY                                                               Handle        = 0x80280800
-	TPM_E_NEEDS_SELFTEST                                                      Handle        = 0x80280801
-	TPM_E_DOING_SELFTEST                                                      Handle        = 0x80280802
-	TPM_E_DEFEND_LOCK_RUNNING                                                 Handle        = 0x80280803
-	TPM_20_E_CONTEXT_GAP                                                      Handle        = 0x80280901
-	TPM_20_E_OBJECT_MEMORY                                                    Handle        = 0x80280902
-	TPM_20_E_SESSION_MEMORY                                                   Handle        = 0x80280903
-	TPM_20_E_MEMORY                                                           Handle        = 0x80280904
-	TPM_20_E_SESSION_HANDLES                                                  Handle        = 0x80280905
-	TPM_20_E_OBJECT_HANDLES                                                   Handle        = 0x80280906
-	TPM_20_E_LOCALITY                                                         Handle        = 0x80280907
-	TPM_20_E_YIELDED                                                          Handle        = 0x80280908
-	TPM_20_E_CANCELED                                                         Handle        = 0x80280909
-	TPM_20_E_TESTING                                                          Handle        = 0x8028090A
-	TPM_20_E_NV_RATE                                                          Handle        = 0x80280920
-	TPM_20_E_LOCKOUT                                                          Handle        = 0x80280921
-	TPM_20_E_RETRY                                                            Handle        = 0x80280922
-	TPM_20_E_NV_UNAVAILABLE                                                   Handle        = 0x80280923
-	TBS_E_INTERNAL_ERROR                                                      Handle        = 0x80284001
-	TBS_E_BAD_PARAMETER                                                       Handle        = 0x80284002
-	TBS_E_INVALID_OUTPUT_POINTER                                              Handle        = 0x80284003
-	TBS_E_INVALID_CONTEXT                                                     Handle        = 0x80284004
-	TBS_E_INSUFFICIENT_BUFFER                                                 Handle        = 0x80284005
-	TBS_E_IOERROR                                                             Handle        = 0x80284006
-	TBS_E_INVALID_CONTEXT_PARAM                                               Handle        = 0x80284007
-	TBS_E_SERVICE_NOT_RUNNING                                                 Handle        = 0x80284008
-	TBS_E_TOO_MANY_TBS_CONTEXTS                                               Handle        = 0x80284009
-	TBS_E_TOO_MANY_RESOURCES                                                  Handle        = 0x8028400A
-	TBS_E_SERVICE_START_PENDING                                               Handle        = 0x8028400B
-	TBS_E_PPI_NOT_SUPPORTED                                                   Handle        = 0x8028400C
-	TBS_E_COMMAND_CANCELED                                                    Handle        = 0x8028400D
-	TBS_E_BUFFER_TOO_LARGE                                                    Handle        = 0x8028400E
-	TBS_E_TPM_NOT_FOUND                                                       Handle        = 0x8028400F
-	TBS_E_SERVICE_DISABLED                                                    Handle        = 0x80284010
-	TBS_E_NO_EVENT_LOG                                                        Handle        = 0x80284011
-	TBS_E_ACCESS_DENIED                                                       Handle        = 0x80284012
-	TBS_E_PROVISIONING_NOT_ALLOWED                                            Handle        = 0x80284013
-	TBS_E_PPI_FUNCTION_UNSUPPORTED                                            Handle        = 0x80284014
-	TBS_E_OWNERAUTH_NOT_FOUND                                                 Handle        = 0x80284015
-	TBS_E_PROVISIONING_INCOMPLETE                                             Handle        = 0x80284016
-	TPMAPI_E_INVALID_STATE                                                    Handle        = 0x80290100
-	TPMAPI_E_NOT_ENOUGH_DATA                                                  Handle        = 0x80290101
-	TPMAPI_E_TOO_MUCH_DATA                                                    Handle        = 0x80290102
-	TPMAPI_E_INVALID_OUTPUT_POINTER                                           Handle        = 0x80290103
-	TPMAPI_E_INVALID_PARAMETER                                                Handle        = 0x80290104
-	TPMAPI_E_OUT_OF_MEMORY                                                    Handle        = 0x80290105
-	TPMAPI_E_BUFFER_TOO_SMALL                                                 Handle        = 0x80290106
-	TPMAPI_E_INTERNAL_ERROR                                                   Handle        = 0x80290107
-	TPMAPI_E_ACCESS_DENIED                                                    Handle        = 0x80290108
-	TPMAPI_E_AUTHORIZATION_FAILED                                             Handle        = 0x80290109
-	TPMAPI_E_INVALID_CONTEXT_HANDLE                                           Handle        = 0x8029010A
-	TPMAPI_E_TBS_COMMUNICATION_ERROR                                          Handle        = 0x8029010B
-	TPMAPI_E_TPM_COMMAND_ERROR                                                Handle        = 0x8029010C
-	TPMAPI_E_MESSAGE_TOO_LARGE                                                Handle        = 0x8029010D
-	TPMAPI_E_INVALID_ENCODING                                                 Handle        = 0x8029010E
-	TPMAPI_E_INVALID_KEY_SIZE                                                 Handle        = 0x8029010F
-	TPMAPI_E_ENCRYPTION_FAILED                                                Handle        = 0x80290110
-	TPMAPI_E_INVALID_KEY_PARAMS                                               Handle        = 0x80290111
-	TPMAPI_E_INVALID_MIGRATION_AUTHORIZATION_BLOB                             Handle        = 0x80290112
-	TPMAPI_E_INVALID_PCR_INDEX                                                Handle        = 0x80290113
-	TPMAPI_E_INVALID_DELEGATE_BLOB                                            Handle        = 0x80290114
-	TPMAPI_E_INVALID_CONTEXT_PARAMS                                           Handle        = 0x80290115
-	TPMAPI_E_INVALID_KEY_BLOB                                                 Handle        = 0x80290116
-	TPMAPI_E_INVALID_PCR_DATA                                                 Handle        = 0x80290117
-	TPMAPI_E_INVALID_OWNER_AUTH                                               Handle        = 0x80290118
-	TPMAPI_E_FIPS_RNG_CHECK_FAILED                                            Handle        = 0x80290119
-	TPMAPI_E_EMPTY_TCG_LOG                                                    Handle        = 0x8029011A
-	TPMAPI_E_INVALID_TCG_LOG_ENTRY                                            Handle        = 0x8029011B
-	TPMAPI_E_TCG_SEPARATOR_ABSENT                                             Handle        = 0x8029011C
-	TPMAPI_E_TCG_INVALID_DIGEST_ENTRY                                         Handle        = 0x8029011D
-	TPMAPI_E_POLICY_DENIES_OPERATION                                          Handle        = 0x8029011E
-	TPMAPI_E_NV_BITS_NOT_DEFINED                                              Handle        = 0x8029011F
-	TPMAPI_E_NV_BITS_NOT_READY                                                Handle        = 0x80290120
-	TPMAPI_E_SEALING_KEY_NOT_AVAILABLE                                        Handle        = 0x80290121
-	TPMAPI_E_NO_AUTHORIZATION_CHAIN_FOUND                                     Handle        = 0x80290122
-	TPMAPI_E_SVN_COUNTER_NOT_AVAILABLE                                        Handle        = 0x80290123
-	TPMAPI_E_OWNER_AUTH_NOT_NULL                                              Handle        = 0x80290124
-	TPMAPI_E_ENDORSEMENT_AUTH_NOT_NULL                                        Handle        = 0x80290125
-	TPMAPI_E_AUTHORIZATION_REVOKED                                            Handle        = 0x80290126
-	TPMAPI_E_MALFORMED_AUTHORIZATION_KEY                                      Handle        = 0x80290127
-	TPMAPI_E_AUTHORIZING_KEY_NOT_SUPPORTED                                    Handle        = 0x80290128
-	TPMAPI_E_INVALID_AUTHORIZATION_SIGNATURE                                  Handle        = 0x80290129
-	TPMAPI_E_MALFORMED_AUTHORIZATION_POLICY                                   Handle        = 0x8029012A
-	TPMAPI_E_MALFORMED_AUTHORIZATION_OTHER                                    Handle        = 0x8029012B
-	TPMAPI_E_SEALING_KEY_CHANGED                                              Handle        = 0x8029012C
-	TBSIMP_E_BUFFER_TOO_SMALL                                                 Handle        = 0x80290200
-	TBSIMP_E_CLEANUP_FAILED                                                   Handle        = 0x80290201
-	TBSIMP_E_INVALID_CONTEXT_HANDLE                                           Handle        = 0x80290202
-	TBSIMP_E_INVALID_CONTEXT_PARAM                                            Handle        = 0x80290203
-	TBSIMP_E_TPM_ERROR                                                        Handle        = 0x80290204
-	TBSIMP_E_HASH_BAD_KEY                                                     Handle        = 0x80290205
-	TBSIMP_E_DUPLICATE_VHANDLE                                                Handle        = 0x80290206
-	TBSIMP_E_INVALID_OUTPUT_POINTER                                           Handle        = 0x80290207
-	TBSIMP_E_INVALID_PARAMETER                                                Handle        = 0x80290208
-	TBSIMP_E_RPC_INIT_FAILED                                                  Handle        = 0x80290209
-	TBSIMP_E_SCHEDULER_NOT_RUNNING                                            Handle        = 0x8029020A
-	TBSIMP_E_COMMAND_CANCELED                                                 Handle        = 0x8029020B
-	TBSIMP_E_OUT_OF_MEMORY                                                    Handle        = 0x8029020C
-	TBSIMP_E_LIST_NO_MORE_ITEMS                                               Handle        = 0x8029020D
-	TBSIMP_E_LIST_NOT_FOUND                                                   Handle        = 0x8029020E
-	TBSIMP_E_NOT_ENOUGH_SPACE                                                 Handle        = 0x8029020F
-	TBSIMP_E_NOT_ENOUGH_TPM_CONTEXTS                                          Handle        = 0x80290210
-	TBSIMP_E_COMMAND_FAILED                                                   Handle        = 0x80290211
-	TBSIMP_E_UNKNOWN_ORDINAL                                                  Handle        = 0x80290212
-	TBSIMP_E_RESOURCE_EXPIRED                                                 Handle        = 0x80290213
-	TBSIMP_E_INVALID_RESOURCE                                                 Handle        = 0x80290214
-	TBSIMP_E_NOTHING_TO_UNLOAD                                                Handle        = 0x80290215
-	TBSIMP_E_HASH_TABLE_FULL                                                  Handle        = 0x80290216
-	TBSIMP_E_TOO_MANY_TBS_CONTEXTS                                            Handle        = 0x80290217
-	TBSIMP_E_TOO_MANY_RESOURCES                                               Handle        = 0x80290218
-	TBSIMP_E_PPI_NOT_SUPPORTED                                                Handle        = 0x80290219
-	TBSIMP_E_TPM_INCOMPATIBLE                                                 Handle        = 0x8029021A
-	TBSIMP_E_NO_EVENT_LOG                                                     Handle        = 0x8029021B
-	TPM_E_PPI_ACPI_FAILURE                                                    Handle        = 0x80290300
-	TPM_E_PPI_USER_ABORT                                                      Handle        = 0x80290301
-	TPM_E_PPI_BIOS_FAILURE                                                    Handle        = 0x80290302
-	TPM_E_PPI_NOT_SUPPORTED                                                   Handle        = 0x80290303
-	TPM_E_PPI_BLOCKED_IN_BIOS                                                 Handle        = 0x80290304
-	TPM_E_PCP_ERROR_MASK                                                      Handle        = 0x80290400
-	TPM_E_PCP_DEVICE_NOT_READY                                                Handle        = 0x80290401
-	TPM_E_PCP_INVALID_HANDLE                                                  Handle        = 0x80290402
-	TPM_E_PCP_INVALID_PARAMETER                                               Handle        = 0x80290403
-	TPM_E_PCP_FLAG_NOT_SUPPORTED                                              Handle        = 0x80290404
-	TPM_E_PCP_NOT_SUPPORTED                                                   Handle        = 0x80290405
-	TPM_E_PCP_BUFFER_TOO_SMALL                                                Handle        = 0x80290406
-	TPM_E_PCP_INTERNAL_ERROR                                                  Handle        = 0x80290407
-	TPM_E_PCP_AUTHENTICATION_FAILED                                           Handle        = 0x80290408
-	TPM_E_PCP_AUTHENTICATION_IGNORED                                          Handle        = 0x80290409
-	TPM_E_PCP_POLICY_NOT_FOUND                                                Handle        = 0x8029040A
-	TPM_E_PCP_PROFILE_NOT_FOUND                                               Handle        = 0x8029040B
-	TPM_E_PCP_VALIDATION_FAILED                                               Handle        = 0x8029040C
-	TPM_E_PCP_WRONG_PARENT                                                    Handle        = 0x8029040E
-	TPM_E_KEY_NOT_LOADED                                                      Handle        = 0x8029040F
-	TPM_E_NO_KEY_CERTIFICATION                                                Handle        = 0x80290410
-	TPM_E_KEY_NOT_FINALIZED                                                   Handle        = 0x80290411
-	TPM_E_ATTESTATION_CHALLENGE_NOT_SET                                       Handle        = 0x80290412
-	TPM_E_NOT_PCR_BOUND                                                       Handle        = 0x80290413
-	TPM_E_KEY_ALREADY_FINALIZED                                               Handle        = 0x80290414
-	TPM_E_KEY_USAGE_POLICY_NOT_SUPPORTED                                      Handle        = 0x80290415
-	TPM_E_KEY_USAGE_POLICY_INVALID                                            Handle        = 0x80290416
-	TPM_E_SOFT_KEY_ERROR                                                      Handle        = 0x80290417
-	TPM_E_KEY_NOT_AUTHENTICATED                                               Handle        = 0x80290418
-	TPM_E_PCP_KEY_NOT_AIK                                                     Handle        = 0x80290419
-	TPM_E_KEY_NOT_SIGNING_KEY                                                 Handle        = 0x8029041A
-	TPM_E_LOCKED_OUT                                                          Handle        = 0x8029041B
-	TPM_E_CLAIM_TYPE_NOT_SUPPORTED                                            Handle        = 0x8029041C
-	TPM_E_VERSION_NOT_SUPPORTED                                               Handle        = 0x8029041D
-	TPM_E_BUFFER_LENGTH_MISMATCH                                              Handle        = 0x8029041E
-	TPM_E_PCP_IFX_RSA_KEY_CREATION_BLOCKED                                    Handle        = 0x8029041F
-	TPM_E_PCP_TICKET_MISSING                                                  Handle        = 0x80290420
-	TPM_E_PCP_RAW_POLICY_NOT_SUPPORTED                                        Handle        = 0x80290421
-	TPM_E_PCP_KEY_HANDLE_INVALIDATED                                          Handle        = 0x80290422
-	TPM_E_PCP_UNSUPPORTED_PSS_SALT                                            Handle        = 0x40290423
-	TPM_E_ZERO_EXHAUST_ENABLED                                                Handle        = 0x80290500
-	PLA_E_DCS_NOT_FOUND                                                       Handle        = 0x80300002
-	PLA_E_DCS_IN_USE                                                          Handle        = 0x803000AA
-	PLA_E_TOO_MANY_FOLDERS                                                    Handle        = 0x80300045
-	PLA_E_NO_MIN_DISK                                                         Handle        = 0x80300070
-	PLA_E_DCS_ALREADY_EXISTS                                                  Handle        = 0x803000B7
-	PLA_S_PROPERTY_IGNORED                                                    Handle        = 0x00300100
-	PLA_E_PROPERTY_CONFLICT                                                   Handle        = 0x80300101
-	PLA_E_DCS_SINGLETON_REQUIRED                                              Handle        = 0x80300102
-	PLA_E_CREDENTIALS_REQUIRED                                                Handle        = 0x80300103
-	PLA_E_DCS_NOT_RUNNING                                                     Handle        = 0x80300104
-	PLA_E_CONFLICT_INCL_EXCL_API                                              Handle        = 0x80300105
-	PLA_E_NETWORK_EXE_NOT_VALID                                               Handle        = 0x80300106
-	PLA_E_EXE_ALREADY_CONFIGURED                                              Handle        = 0x80300107
-	PLA_E_EXE_PATH_NOT_VALID                                                  Handle        = 0x80300108
-	PLA_E_DC_ALREADY_EXISTS                                                   Handle        = 0x80300109
-	PLA_E_DCS_START_WAIT_TIMEOUT                                              Handle        = 0x8030010A
-	PLA_E_DC_START_WAIT_TIMEOUT                                               Handle        = 0x8030010B
-	PLA_E_REPORT_WAIT_TIMEOUT                                                 Handle        = 0x8030010C
-	PLA_E_NO_DUPLICATES                                                       Handle        = 0x8030010D
-	PLA_E_EXE_FULL_PATH_REQUIRED                                              Handle        = 0x8030010E
-	PLA_E_INVALID_SESSION_NAME                                                Handle        = 0x8030010F
-	PLA_E_PLA_CHANNEL_NOT_ENABLED                                             Handle        = 0x80300110
-	PLA_E_TASKSCHED_CHANNEL_NOT_ENABLED                                       Handle        = 0x80300111
-	PLA_E_RULES_MANAGER_FAILED                                                Handle        = 0x80300112
-	PLA_E_CABAPI_FAILURE                                                      Handle        = 0x80300113
-	FVE_E_LOCKED_VOLUME                                                       Handle        = 0x80310000
-	FVE_E_NOT_ENCRYPTED                                                       Handle        = 0x80310001
-	FVE_E_NO_TPM_BIOS                                                         Handle        = 0x80310002
-	FVE_E_NO_MBR_METRIC                                                       Handle        = 0x80310003
-	FVE_E_NO_BOOTSECTOR_METRIC                                                Handle        = 0x80310004
-	FVE_E_NO_BOOTMGR_METRIC                                                   Handle        = 0x80310005
-	FVE_E_WRONG_BOOTMGR                                                       Handle        = 0x80310006
-	FVE_E_SECURE_KEY_REQUIRED                                                 Handle        = 0x80310007
-	FVE_E_NOT_ACTIVATED                                                       Handle        = 0x80310008
-	FVE_E_ACTION_NOT_ALLOWED                                                  Handle        = 0x80310009
-	FVE_E_AD_SCHEMA_NOT_INSTALLED                                             Handle        = 0x8031000A
-	FVE_E_AD_INVALID_DATATYPE                                                 Handle        = 0x8031000B
-	FVE_E_AD_INVALID_DATASIZE                                                 Handle        = 0x8031000C
-	FVE_E_AD_NO_VALUES                                                        Handle        = 0x8031000D
-	FVE_E_AD_ATTR_NOT_SET                                                     Handle        = 0x8031000E
-	FVE_E_AD_GUID_NOT_FOUND                                                   Handle        = 0x8031000F
-	FVE_E_BAD_INFORMATION                                                     Handle        = 0x80310010
-	FVE_E_TOO_SMALL                                                           Handle        = 0x80310011
-	FVE_E_SYSTEM_VOLUME                                                       Handle        = 0x80310012
-	FVE_E_FAILED_WRONG_FS                                                     Handle        = 0x80310013
-	FVE_E_BAD_PARTITION_SIZE                                                  Handle        = 0x80310014
-	FVE_E_NOT_SUPPORTED                                                       Handle        = 0x80310015
-	FVE_E_BAD_DATA                                                            Handle        = 0x80310016
-	FVE_E_VOLUME_NOT_BOUND                                                    Handle        = 0x80310017
-	FVE_E_TPM_NOT_OWNED                                                       Handle        = 0x80310018
-	FVE_E_NOT_DATA_VOLUME                                                     Handle        = 0x80310019
-	FVE_E_AD_INSUFFICIENT_BUFFER                                              Handle        = 0x8031001A
-	FVE_E_CONV_READ                                                           Handle        = 0x8031001B
-	FVE_E_CONV_WRITE                                                          Handle        = 0x8031001C
-	FVE_E_KEY_REQUIRED                                                        Handle        = 0x8031001D
-	FVE_E_CLUSTERING_NOT_SUPPORTED                                            Handle        = 0x8031001E
-	FVE_E_VOLUME_BOUND_ALREADY                                                Handle        = 0x8031001F
-	FVE_E_OS_NOT_PROTECTED                                                    Handle        = 0x80310020
-	FVE_E_PROTECTION_DISABLED                                                 Handle        = 0x80310021
-	FVE_E_RECOVERY_KEY_REQUIRED                                               Handle        = 0x80310022
-	FVE_E_FOREIGN_VOLUME                                                      Handle        = 0x80310023
-	FVE_E_OVERLAPPED_UPDATE                                                   Handle        = 0x80310024
-	FVE_E_TPM_SRK_AUTH_NOT_ZERO                                               Handle        = 0x80310025
-	FVE_E_FAILED_SECTOR_SIZE                                                  Handle        = 0x80310026
-	FVE_E_FAILED_AUTHENTICATION                                               Handle        = 0x80310027
-	FVE_E_NOT_OS_VOLUME                                                       Handle        = 0x80310028
-	FVE_E_AUTOUNLOCK_ENABLED                                                  Handle        = 0x80310029
-	FVE_E_WRONG_BOOTSECTOR                                                    Handle        = 0x8031002A
-	FVE_E_WRONG_SYSTEM_FS                                                     Handle        = 0x8031002B
-	FVE_E_POLICY_PASSWORD_REQUIRED                                            Handle        = 0x8031002C
-	FVE_E_CANNOT_SET_FVEK_ENCRYPTED                                           Handle        = 0x8031002D
-	FVE_E_CANNOT_ENCRYPT_NO_KEY                                               Handle        = 0x8031002E
-	FVE_E_BOOTABLE_CDDVD                                                      Handle        = 0x80310030
-	FVE_E_PROTECTOR_EXISTS                                                    Handle        = 0x80310031
-	FVE_E_RELATIVE_PATH                                                       Handle        = 0x80310032
-	FVE_E_PROTECTOR_NOT_FOUND                                                 Handle        = 0x80310033
-	FVE_E_INVALID_KEY_FORMAT                                                  Handle        = 0x80310034
-	FVE_E_INVALID_PASSWORD_FORMAT                                             Handle        = 0x80310035
-	FVE_E_FIPS_RNG_CHECK_FAILED                                               Handle        = 0x80310036
-	FVE_E_FIPS_PREVENTS_RECOVERY_PASSWORD                                     Handle        = 0x80310037
-	FVE_E_FIPS_PREVENTS_EXTERNAL_KEY_EXPORT                                   Handle        = 0x80310038
-	FVE_E_NOT_DECRYPTED                                                       Handle        = 0x80310039
-	FVE_E_INVALID_PROTECTOR_TYPE                                              Handle        = 0x8031003A
-	FVE_E_NO_PROTECTORS_TO_TEST                                               Handle        = 0x8031003B
-	FVE_E_KEYFILE_NOT_FOUND                                                   Handle        = 0x8031003C
-	FVE_E_KEYFILE_INVALID                                                     Handle        = 0x8031003D
-	FVE_E_KEYFILE_NO_VMK                                                      Handle        = 0x8031003E
-	FVE_E_TPM_DISABLED                                                        Handle        = 0x8031003F
-	FVE_E_NOT_ALLOWED_IN_SAFE_MODE                                            Handle        = 0x80310040
-	FVE_E_TPM_INVALID_PCR                                                     Handle        = 0x80310041
-	FVE_E_TPM_NO_VMK                                                          Handle        = 0x80310042
-	FVE_E_PIN_INVALID                                                         Handle        = 0x80310043
-	FVE_E_AUTH_INVALID_APPLICATION                                            Handle        = 0x80310044
-	FVE_E_AUTH_INVALID_CONFIG                                                 Handle        = 0x80310045
-	FVE_E_FIPS_DISABLE_PROTECTION_NOT_ALLOWED                                 Handle        = 0x80310046
-	FVE_E_FS_NOT_EXTENDED                                                     Handle        = 0x80310047
-	FVE_E_FIRMWARE_TYPE_NOT_SUPPORTED                                         Handle        = 0x80310048
-	FVE_E_NO_LICENSE                                                          Handle        = 0x80310049
-	FVE_E_NOT_ON_STACK                                                        Handle        = 0x8031004A
-	FVE_E_FS_MOUNTED                                                          Handle        = 0x8031004B
-	FVE_E_TOKEN_NOT_IMPERSONATED                                              Handle        = 0x8031004C
-	FVE_E_DRY_RUN_FAILED                                                      Handle        = 0x8031004D
-	FVE_E_REBOOT_REQUIRED                                                     Handle        = 0x8031004E
-	FVE_E_DEBUGGER_ENABLED                                                    Handle        = 0x8031004F
-	FVE_E_RAW_ACCESS                                                          Handle        = 0x80310050
-	FVE_E_RAW_BLOCKED                                                         Handle        = 0x80310051
-	FVE_E_BCD_APPLICATIONS_PATH_INCORRECT                                     Handle        = 0x80310052
-	FVE_E_NOT_ALLOWED_IN_VERSION                                              Handle        = 0x80310053
-	FVE_E_NO_AUTOUNLOCK_MASTER_KEY                                            Handle        = 0x80310054
-	FVE_E_MOR_FAILED                                                          Handle        = 0x80310055
-	FVE_E_HIDDEN_VOLUME                                                       Handle        = 0x80310056
-	FVE_E_TRANSIENT_STATE                                                     Handle        = 0x80310057
-	FVE_E_PUBKEY_NOT_ALLOWED                                                  Handle        = 0x80310058
-	FVE_E_VOLUME_HANDLE_OPEN                                                  Handle        = 0x80310059
-	FVE_E_NO_FEATURE_LICENSE                                                  Handle        = 0x8031005A
-	FVE_E_INVALID_STARTUP_OPTIONS                                             Handle        = 0x8031005B
-	FVE_E_POLICY_RECOVERY_PASSWORD_NOT_ALLOWED                                Handle        = 0x8031005C
-	FVE_E_POLICY_RECOVERY_PASSWORD_REQUIRED                                   Handle        = 0x8031005D
-	FVE_E_POLICY_RECOVERY_KEY_NOT_ALLOWED                                     Handle        = 0x8031005E
-	FVE_E_POLICY_RECOVERY_KEY_REQUIRED                                        Handle        = 0x8031005F
-	FVE_E_POLICY_STARTUP_PIN_NOT_ALLOWED                                      Handle        = 0x80310060
-	FVE_E_POLICY_STARTUP_PIN_REQUIRED                                         Handle        = 0x80310061
-	FVE_E_POLICY_STARTUP_KEY_NOT_ALLOWED                                      Handle        = 0x80310062
-	FVE_E_POLICY_STARTUP_KEY_REQUIRED                                         Handle        = 0x80310063
-	FVE_E_POLICY_STARTUP_PIN_KEY_NOT_ALLOWED                                  Handle        = 0x80310064
-	FVE_E_POLICY_STARTUP_PIN_KEY_REQUIRED                                     Handle        = 0x80310065
-	FVE_E_POLICY_STARTUP_TPM_NOT_ALLOWED                                      Handle        = 0x80310066
-	FVE_E_POLICY_STARTUP_TPM_REQUIRED                                         Handle        = 0x80310067
-	FVE_E_POLICY_INVALID_PIN_LENGTH                                           Handle        = 0x80310068
-	FVE_E_KEY_PROTECTOR_NOT_SUPPORTED                                         Handle        = 0x80310069
-	FVE_E_POLICY_PASSPHRASE_NOT_ALLOWED                                       Handle        = 0x8031006A
-	FVE_E_POLICY_PASSPHRASE_REQUIRED                                          Handle        = 0x8031006B
-	FVE_E_FIPS_PREVENTS_PASSPHRASE                                            Handle        = 0x8031006C
-	FVE_E_OS_VOLUME_PASSPHRASE_NOT_ALLOWED                                    Handle        = 0x8031006D
-	FVE_E_INVALID_BITLOCKER_OID                                               Handle        = 0x8031006E
-	FVE_E_VOLUME_TOO_SMALL                                                    Handle        = 0x8031006F
-	FVE_E_DV_NOT_SUPPORTED_ON_FS                                              Handle        = 0x80310070
-	FVE_E_DV_NOT_ALLOWED_BY_GP                                                Handle        = 0x80310071
-	FVE_E_POLICY_USER_CERTIFICATE_NOT_ALLOWED                                 Handle        = 0x80310072
-	FVE_E_POLICY_USER_CERTIFICATE_REQUIRED                                    Handle        = 0x80310073
-	FVE_E_POLICY_USER_CERT_MUST_BE_HW                                         Handle        = 0x80310074
-	FVE_E_POLICY_USER_CONFIGURE_FDV_AUTOUNLOCK_NOT_ALLOWED                    Handle        = 0x80310075
-	FVE_E_POLICY_USER_CONFIGURE_RDV_AUTOUNLOCK_NOT_ALLOWED                    Handle        = 0x80310076
-	FVE_E_POLICY_USER_CONFIGURE_RDV_NOT_ALLOWED                               Handle        = 0x80310077
-	FVE_E_POLICY_USER_ENABLE_RDV_NOT_ALLOWED                                  Handle        = 0x80310078
-	FVE_E_POLICY_USER_DISABLE_RDV_NOT_ALLOWED                                 Handle        = 0x80310079
-	FVE_E_POLICY_INVALID_PASSPHRASE_LENGTH                                    Handle        = 0x80310080
-	FVE_E_POLICY_PASSPHRASE_TOO_SIMPLE                                        Handle        = 0x80310081
-	FVE_E_RECOVERY_PARTITION                                                  Handle        = 0x80310082
-	FVE_E_POLICY_CONFLICT_FDV_RK_OFF_AUK_ON                                   Handle        = 0x80310083
-	FVE_E_POLICY_CONFLICT_RDV_RK_OFF_AUK_ON                                   Handle        = 0x80310084
-	FVE_E_NON_BITLOCKER_OID                                                   Handle        = 0x80310085
-	FVE_E_POLICY_PROHIBITS_SELFSIGNED                                         Handle        = 0x80310086
-	FVE_E_POLICY_CONFLICT_RO_AND_STARTUP_KEY_REQUIRED                         Handle        = 0x80310087
-	FVE_E_CONV_RECOVERY_FAILED                                                Handle        = 0x80310088
-	FVE_E_VIRTUALIZED_SPACE_TOO_BIG                                           Handle        = 0x80310089
-	FVE_E_POLICY_CONFLICT_OSV_RP_OFF_ADB_ON                                   Handle        = 0x80310090
-	FVE_E_POLICY_CONFLICT_FDV_RP_OFF_ADB_ON                                   Handle        = 0x80310091
-	FVE_E_POLICY_CONFLICT_RDV_RP_OFF_ADB_ON                                   Handle        = 0x80310092
-	FVE_E_NON_BITLOCKER_KU                                                    Handle        = 0x80310093
-	FVE_E_PRIVATEKEY_AUTH_FAILED                                              Handle        = 0x80310094
-	FVE_E_REMOVAL_OF_DRA_FAILED                                               Handle        = 0x80310095
-	FVE_E_OPERATION_NOT_SUPPORTED_ON_VISTA_VOLUME                             Handle        = 0x80310096
-	FVE_E_CANT_LOCK_AUTOUNLOCK_ENABLED_VOLUME                                 Handle        = 0x80310097
-	FVE_E_FIPS_HASH_KDF_NOT_ALLOWED                                           Handle        = 0x80310098
-	FVE_E_ENH_PIN_INVALID                                                     Handle        = 0x80310099
-	FVE_E_INVALID_PIN_CHARS                                                   Handle        = 0x8031009A
-	FVE_E_INVALID_DATUM_TYPE                                                  Handle        = 0x8031009B
-	FVE_E_EFI_ONLY                                                            Handle        = 0x8031009C
-	FVE_E_MULTIPLE_NKP_CERTS                                                  Handle        = 0x8031009D
-	FVE_E_REMOVAL_OF_NKP_FAILED                                               Handle        = 0x8031009E
-	FVE_E_INVALID_NKP_CERT                                                    Handle        = 0x8031009F
-	FVE_E_NO_EXISTING_PIN                                                     Handle        = 0x803100A0
-	FVE_E_PROTECTOR_CHANGE_PIN_MISMATCH                                       Handle        = 0x803100A1
-	FVE_E_PIN_PROTECTOR_CHANGE_BY_STD_USER_DISALLOWED                         Handle        = 0x803100A2
-	FVE_E_PROTECTOR_CHANGE_MAX_PIN_CHANGE_ATTEMPTS_REACHED                    Handle        = 0x803100A3
-	FVE_E_POLICY_PASSPHRASE_REQUIRES_ASCII                                    Handle        = 0x803100A4
-	FVE_E_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE                           Handle        = 0x803100A5
-	FVE_E_WIPE_NOT_ALLOWED_ON_TP_STORAGE                                      Handle        = 0x803100A6
-	FVE_E_KEY_LENGTH_NOT_SUPPORTED_BY_EDRIVE                                  Handle        = 0x803100A7
-	FVE_E_NO_EXISTING_PASSPHRASE                                              Handle        = 0x803100A8
-	FVE_E_PROTECTOR_CHANGE_PASSPHRASE_MISMATCH                                Handle        = 0x803100A9
-	FVE_E_PASSPHRASE_TOO_LONG                                                 Handle        = 0x803100AA
-	FVE_E_NO_PASSPHRASE_WITH_TPM                                              Handle        = 0x803100AB
-	FVE_E_NO_TPM_WITH_PASSPHRASE                                              Handle        = 0x803100AC
-	FVE_E_NOT_ALLOWED_ON_CSV_STACK                                            Handle        = 0x803100AD
-	FVE_E_NOT_ALLOWED_ON_CLUSTER                                              Handle        = 0x803100AE
-	FVE_E_EDRIVE_NO_FAILOVER_TO_SW                                            Handle        = 0x803100AF
-	FVE_E_EDRIVE_BAND_IN_USE                                                  Handle        = 0x803100B0
-	FVE_E_EDRIVE_DISALLOWED_BY_GP                                             Handle        = 0x803100B1
-	FVE_E_EDRIVE_INCOMPATIBLE_VOLUME                                          Handle        = 0x803100B2
-	FVE_E_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING                             Handle        = 0x803100B3
-	FVE_E_EDRIVE_DV_NOT_SUPPORTED                                             Handle        = 0x803100B4
-	FVE_E_NO_PREBOOT_KEYBOARD_DETECTED                                        Handle        = 0x803100B5
-	FVE_E_NO_PREBOOT_KEYBOARD_OR_WINRE_DETECTED                               Handle        = 0x803100B6
-	FVE_E_POLICY_REQUIRES_STARTUP_PIN_ON_TOUCH_DEVICE                         Handle        = 0x803100B7
-	FVE_E_POLICY_REQUIRES_RECOVERY_PASSWORD_ON_TOUCH_DEVICE                   Handle        = 0x803100B8
-	FVE_E_WIPE_CANCEL_NOT_APPLICABLE                                          Handle        = 0x803100B9
-	FVE_E_SECUREBOOT_DISABLED                                                 Handle        = 0x803100BA
-	FVE_E_SECUREBOOT_CONFIGURATION_INVALID                                    Handle        = 0x803100BB
-	FVE_E_EDRIVE_DRY_RUN_FAILED                                               Handle        = 0x803100BC
-	FVE_E_SHADOW_COPY_PRESENT                                                 Handle        = 0x803100BD
-	FVE_E_POLICY_INVALID_ENHANCED_BCD_SETTINGS                                Handle        = 0x803100BE
-	FVE_E_EDRIVE_INCOMPATIBLE_FIRMWARE                                        Handle        = 0x803100BF
-	FVE_E_PROTECTOR_CHANGE_MAX_PASSPHRASE_CHANGE_ATTEMPTS_REACHED             Handle        = 0x803100C0
-	FVE_E_PASSPHRASE_PROTECTOR_CHANGE_BY_STD_USER_DISALLOWED                  Handle        = 0x803100C1
-	FVE_E_LIVEID_ACCOUNT_SUSPENDED                                            Handle        = 0x803100C2
-	FVE_E_LIVEID_ACCOUNT_BLOCKED                                              Handle        = 0x803100C3
-	FVE_E_NOT_PROVISIONED_ON_ALL_VOLUMES                                      Handle        = 0x803100C4
-	FVE_E_DE_FIXED_DATA_NOT_SUPPORTED                                         Handle        = 0x803100C5
-	FVE_E_DE_HARDWARE_NOT_COMPLIANT                                           Handle        = 0x803100C6
-	FVE_E_DE_WINRE_NOT_CONFIGURED                                             Handle        = 0x803100C7
-	FVE_E_DE_PROTECTION_SUSPENDED                                             Handle        = 0x803100C8
-	FVE_E_DE_OS_VOLUME_NOT_PROTECTED                                          Handle        = 0x803100C9
-	FVE_E_DE_DEVICE_LOCKEDOUT                                                 Handle        = 0x803100CA
-	FVE_E_DE_PROTECTION_NOT_YET_ENABLED                                       Handle        = 0x803100CB
-	FVE_E_INVALID_PIN_CHARS_DETAILED                                          Handle        = 0x803100CC
-	FVE_E_DEVICE_LOCKOUT_COUNTER_UNAVAILABLE                                  Handle        = 0x803100CD
-	FVE_E_DEVICELOCKOUT_COUNTER_MISMATCH                                      Handle        = 0x803100CE
-	FVE_E_BUFFER_TOO_LARGE                                                    Handle        = 0x803100CF
-	FVE_E_NO_SUCH_CAPABILITY_ON_TARGET                                        Handle        = 0x803100D0
-	FVE_E_DE_PREVENTED_FOR_OS                                                 Handle        = 0x803100D1
-	FVE_E_DE_VOLUME_OPTED_OUT                                                 Handle        = 0x803100D2
-	FVE_E_DE_VOLUME_NOT_SUPPORTED                                             Handle        = 0x803100D3
-	FVE_E_EOW_NOT_SUPPORTED_IN_VERSION                                        Handle        = 0x803100D4
-	FVE_E_ADBACKUP_NOT_ENABLED                                                Handle        = 0x803100D5
-	FVE_E_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT                                  Handle        = 0x803100D6
-	FVE_E_NOT_DE_VOLUME                                                       Handle        = 0x803100D7
-	FVE_E_PROTECTION_CANNOT_BE_DISABLED                                       Handle        = 0x803100D8
-	FVE_E_OSV_KSR_NOT_ALLOWED                                                 Handle        = 0x803100D9
-	FWP_E_CALLOUT_NOT_FOUND                                                   Handle        = 0x80320001
-	FWP_E_CONDITION_NOT_FOUND                                                 Handle        = 0x80320002
-	FWP_E_FILTER_NOT_FOUND                                                    Handle        = 0x80320003
-	FWP_E_LAYER_NOT_FOUND                                                     Handle        = 0x80320004
-	FWP_E_PROVIDER_NOT_FOUND                                                  Handle        = 0x80320005
-	FWP_E_PROVIDER_CONTEXT_NOT_FOUND                                          Handle        = 0x80320006
-	FWP_E_SUBLAYER_NOT_FOUND                                                  Handle        = 0x80320007
-	FWP_E_NOT_FOUND                                                           Handle        = 0x80320008
-	FWP_E_ALREADY_EXISTS                                                      Handle        = 0x80320009
-	FWP_E_IN_USE                                                              Handle        = 0x8032000A
-	FWP_E_DYNAMIC_SESSION_IN_PROGRESS                                         Handle        = 0x8032000B
-	FWP_E_WRONG_SESSION                                                       Handle        = 0x8032000C
-	FWP_E_NO_TXN_IN_PROGRESS                                                  Handle        = 0x8032000D
-	FWP_E_TXN_IN_PROGRESS                                                     Handle        = 0x8032000E
-	FWP_E_TXN_ABORTED                                                         Handle        = 0x8032000F
-	FWP_E_SESSION_ABORTED                                                     Handle        = 0x80320010
-	FWP_E_INCOMPATIBLE_TXN                                                    Handle        = 0x80320011
-	FWP_E_TIMEOUT                                                             Handle        = 0x80320012
-	FWP_E_NET_EVENTS_DISABLED                                                 Handle        = 0x80320013
-	FWP_E_INCOMPATIBLE_LAYER                                                  Handle        = 0x80320014
-	FWP_E_KM_CLIENTS_ONLY                                                     Handle        = 0x80320015
-	FWP_E_LIFETIME_MISMATCH                                                   Handle        = 0x80320016
-	FWP_E_BUILTIN_OBJECT                                                      Handle        = 0x80320017
-	FWP_E_TOO_MANY_CALLOUTS                                                   Handle        = 0x80320018
-	FWP_E_NOTIFICATION_DROPPED                                                Handle        = 0x80320019
-	FWP_E_TRAFFIC_MISMATCH                                                    Handle        = 0x8032001A
-	FWP_E_INCOMPATIBLE_SA_STATE                                               Handle        = 0x8032001B
-	FWP_E_NULL_POINTER                                                        Handle        = 0x8032001C
-	FWP_E_INVALID_ENUMERATOR                                                  Handle        = 0x8032001D
-	FWP_E_INVALID_FLAGS                                                       Handle        = 0x8032001E
-	FWP_E_INVALID_NET_MASK                                                    Handle        = 0x8032001F
-	FWP_E_INVALID_RANGE                                                       Handle        = 0x80320020
-	FWP_E_INVALID_INTERVAL                                                    Handle        = 0x80320021
-	FWP_E_ZERO_LENGTH_ARRAY                                                   Handle        = 0x80320022
-	FWP_E_NULL_DISPLAY_NAME                                                   Handle        = 0x80320023
-	FWP_E_INVALID_ACTION_TYPE                                                 Handle        = 0x80320024
-	FWP_E_INVALID_WEIGHT                                                      Handle        = 0x80320025
-	FWP_E_MATCH_TYPE_MISMATCH                                                 Handle        = 0x80320026
-	FWP_E_TYPE_MISMATCH                                                       Handle        = 0x80320027
-	FWP_E_OUT_OF_BOUNDS                                                       Handle        = 0x80320028
-	FWP_E_RESERVED                                                            Handle        = 0x80320029
-	FWP_E_DUPLICATE_CONDITION                                                 Handle        = 0x8032002A
-	FWP_E_DUPLICATE_KEYMOD                                                    Handle        = 0x8032002B
-	FWP_E_ACTION_INCOMPATIBLE_WITH_LAYER                                      Handle        = 0x8032002C
-	FWP_E_ACTION_INCOMPATIBLE_WITH_SUBLAYER                                   Handle        = 0x8032002D
-	FWP_E_CONTEXT_INCOMPATIBLE_WITH_LAYER                                     Handle        = 0x8032002E
-	FWP_E_CONTEXT_INCOMPATIBLE_WITH_CALLOUT                                   Handle        = 0x8032002F
-	FWP_E_INCOMPATIBLE_AUTH_METHOD                                            Handle        = 0x80320030
-	FWP_E_INCOMPATIBLE_DH_GROUP                                               Handle        = 0x80320031
-	FWP_E_EM_NOT_SUPPORTED                                                    Handle        = 0x80320032
-	FWP_E_NEVER_MATCH                                                         Handle        = 0x80320033
-	FWP_E_PROVIDER_CONTEXT_MISMATCH                                           Handle        = 0x80320034
-	FWP_E_INVALID_PARAMETER                                                   Handle        = 0x80320035
-	FWP_E_TOO_MANY_SUBLAYERS                                                  Handle        = 0x80320036
-	FWP_E_CALLOUT_NOTIFICATION_FAILED                                         Handle        = 0x80320037
-	FWP_E_INVALID_AUTH_TRANSFORM                                              Handle        = 0x80320038
-	FWP_E_INVALID_CIPHER_TRANSFORM                                            Handle        = 0x80320039
-	FWP_E_INCOMPATIBLE_CIPHER_TRANSFORM                                       Handle        = 0x8032003A
-	FWP_E_INVALID_TRANSFORM_COMBINATION                                       Handle        = 0x8032003B
-	FWP_E_DUPLICATE_AUTH_METHOD                                               Handle        = 0x8032003C
-	FWP_E_INVALID_TUNNEL_ENDPOINT                                             Handle        = 0x8032003D
-	FWP_E_L2_DRIVER_NOT_READY                                                 Handle        = 0x8032003E
-	FWP_E_KEY_DICTATOR_ALREADY_REGISTERED                                     Handle        = 0x8032003F
-	FWP_E_KEY_DICTATION_INVALID_KEYING_MATERIAL                               Handle        = 0x80320040
-	FWP_E_CONNECTIONS_DISABLED                                                Handle        = 0x80320041
-	FWP_E_INVALID_DNS_NAME                                                    Handle        = 0x80320042
-	FWP_E_STILL_ON                                                            Handle        = 0x80320043
-	FWP_E_IKEEXT_NOT_RUNNING                                                  Handle        = 0x80320044
-	FWP_E_DROP_NOICMP                                                         Handle        = 0x80320104
-	WS_S_ASYNC                                                                Handle        = 0x003D0000
-	WS_S_END                                                                  Handle        = 0x003D0001
-	WS_E_INVALID_FORMAT                                                       Handle        = 0x803D0000
-	WS_E_OBJECT_FAULTED                                                       Handle        = 0x803D0001
-	WS_E_NUMERIC_OVERFLOW                                                     Handle        = 0x803D0002
-	WS_E_INVALID_OPERATION                                                    Handle        = 0x803D0003
-	WS_E_OPERATION_ABORTED                                                    Handle        = 0x803D0004
-	WS_E_ENDPOINT_ACCESS_DENIED                                               Handle        = 0x803D0005
-	WS_E_OPERATION_TIMED_OUT                                                  Handle        = 0x803D0006
-	WS_E_OPERATION_ABANDONED                                                  Handle        = 0x803D0007
-	WS_E_QUOTA_EXCEEDED                                                       Handle        = 0x803D0008
-	WS_E_NO_TRANSLATION_AVAILABLE                                             Handle        = 0x803D0009
-	WS_E_SECURITY_VERIFICATION_FAILURE                                        Handle        = 0x803D000A
-	WS_E_ADDRESS_IN_USE                                                       Handle        = 0x803D000B
-	WS_E_ADDRESS_NOT_AVAILABLE                                                Handle        = 0x803D000C
-	WS_E_ENDPOINT_NOT_FOUND                                                   Handle        = 0x803D000D
-	WS_E_ENDPOINT_NOT_AVAILABLE                                               Handle        = 0x803D000E
-	WS_E_ENDPOINT_FAILURE                                                     Handle        = 0x803D000F
-	WS_E_ENDPOINT_UNREACHABLE                                                 Handle        = 0x803D0010
-	WS_E_ENDPOINT_ACTION_NOT_SUPPORTED                                        Handle        = 0x803D0011
-	WS_E_ENDPOINT_TOO_BUSY                                                    Handle        = 0x803D0012
-	WS_E_ENDPOINT_FAULT_RECEIVED                                              Handle        = 0x803D0013
-	WS_E_ENDPOINT_DISCONNECTED                                                Handle        = 0x803D0014
-	WS_E_PROXY_FAILURE                                                        Handle        = 0x803D0015
-	WS_E_PROXY_ACCESS_DENIED                                                  Handle        = 0x803D0016
-	WS_E_NOT_SUPPORTED                                                        Handle        = 0x803D0017
-	WS_E_PROXY_REQUIRES_BASIC_AUTH                                            Handle        = 0x803D0018
-	WS_E_PROXY_REQUIRES_DIGEST_AUTH                                           Handle        = 0x803D0019
-	WS_E_PROXY_REQUIRES_NTLM_AUTH                                             Handle        = 0x803D001A
-	WS_E_PROXY_REQUIRES_NEGOTIATE_AUTH                                        Handle        = 0x803D001B
-	WS_E_SERVER_REQUIRES_BASIC_AUTH                                           Handle        = 0x803D001C
-	WS_E_SERVER_REQUIRES_DIGEST_AUTH                                          Handle        = 0x803D001D
-	WS_E_SERVER_REQUIRES_NTLM_AUTH                                            Handle        = 0x803D001E
-	WS_E_SERVER_REQUIRES_NEGOTIATE_AUTH                                       Handle        = 0x803D001F
-	WS_E_INVALID_ENDPOINT_URL                                                 Handle        = 0x803D0020
-	WS_E_OTHER                                                                Handle        = 0x803D0021
-	WS_E_SECURITY_TOKEN_EXPIRED                                               Handle        = 0x803D0022
-	WS_E_SECURITY_SYSTEM_FAILURE                                              Handle        = 0x803D0023
-	ERROR_NDIS_INTERFACE_CLOSING                                              syscall.Errno = 0x80340002
-	ERROR_NDIS_BAD_VERSION                                                    syscall.Errno = 0x80340004
-	ERROR_NDIS_BAD_CHARACTERISTICS                                            syscall.Errno = 0x80340005
-	ERROR_NDIS_ADAPTER_NOT_FOUND                                              syscall.Errno = 0x80340006
-	ERROR_NDIS_OPEN_FAILED                                                    syscall.Errno = 0x80340007
-	ERROR_NDIS_DEVICE_FAILED                                                  syscall.Errno = 0x80340008
-	ERROR_NDIS_MULTICAST_FULL                                                 syscall.Errno = 0x80340009
-	ERROR_NDIS_MULTICAST_EXISTS                                               syscall.Errno = 0x8034000A
-	ERROR_NDIS_MULTICAST_NOT_FOUND                                            syscall.Errno = 0x8034000B
-	ERROR_NDIS_REQUEST_ABORTED                                                syscall.Errno = 0x8034000C
-	ERROR_NDIS_RESET_IN_PROGRESS                                              syscall.Errno = 0x8034000D
-	ERROR_NDIS_NOT_SUPPORTED                                                  syscall.Errno = 0x803400BB
-	ERROR_NDIS_INVALID_PACKET                                                 syscall.Errno = 0x8034000F
-	ERROR_NDIS_ADAPTER_NOT_READY                                              syscall.Errno = 0x80340011
-	ERROR_NDIS_INVALID_LENGTH                                                 syscall.Errno = 0x80340014
-	ERROR_NDIS_INVALID_DATA                                                   syscall.Errno = 0x80340015
-	ERROR_NDIS_BUFFER_TOO_SHORT                                               syscall.Errno = 0x80340016
-	ERROR_NDIS_INVALID_OID                                                    syscall.Errno = 0x80340017
-	ERROR_NDIS_ADAPTER_REMOVED                                                syscall.Errno = 0x80340018
-	ERROR_NDIS_UNSUPPORTED_MEDIA                                              syscall.Errno = 0x80340019
-	ERROR_NDIS_GROUP_ADDRESS_IN_USE                                           syscall.Errno = 0x8034001A
-	ERROR_NDIS_FILE_NOT_FOUND                                                 syscall.Errno = 0x8034001B
-	ERROR_NDIS_ERROR_READING_FILE                                             syscall.Errno = 0x8034001C
-	ERROR_NDIS_ALREADY_MAPPED                                                 syscall.Errno = 0x8034001D
-	ERROR_NDIS_RESOURCE_CONFLICT                                              syscall.Errno = 0x8034001E
-	ERROR_NDIS_MEDIA_DISCONNECTED                                             syscall.Errno = 0x8034001F
-	ERROR_NDIS_INVALID_ADDRESS                                                syscall.Errno = 0x80340022
-	ERROR_NDIS_INVALID_DEVICE_REQUEST                                         syscall.Errno = 0x80340010
-	ERROR_NDIS_PAUSED                                                         syscall.Errno = 0x8034002A
-	ERROR_NDIS_INTERFACE_NOT_FOUND                                            syscall.Errno = 0x8034002B
-	ERROR_NDIS_UNSUPPORTED_REVISION                                           syscall.Errno = 0x8034002C
-	ERROR_NDIS_INVALID_PORT                                                   syscall.Errno = 0x8034002D
-	ERROR_NDIS_INVALID_PORT_STATE                                             syscall.Errno = 0x8034002E
-	ERROR_NDIS_LOW_POWER_STATE                                                syscall.Errno = 0x8034002F
-	ERROR_NDIS_REINIT_REQUIRED                                                syscall.Errno = 0x80340030
-	ERROR_NDIS_NO_QUEUES                                                      syscall.Errno = 0x80340031
-	ERROR_NDIS_DOT11_AUTO_CONFIG_ENABLED                                      syscall.Errno = 0x80342000
-	ERROR_NDIS_DOT11_MEDIA_IN_USE                                             syscall.Errno = 0x80342001
-	ERROR_NDIS_DOT11_POWER_STATE_INVALID                                      syscall.Errno = 0x80342002
-	ERROR_NDIS_PM_WOL_PATTERN_LIST_FULL                                       syscall.Errno = 0x80342003
-	ERROR_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL                                  syscall.Errno = 0x80342004
-	ERROR_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE                       syscall.Errno = 0x80342005
-	ERROR_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE                          syscall.Errno = 0x80342006
-	ERROR_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED                                   syscall.Errno = 0x80342007
-	ERROR_NDIS_DOT11_AP_BAND_NOT_ALLOWED                                      syscall.Errno = 0x80342008
-	ERROR_NDIS_INDICATION_REQUIRED                                            syscall.Errno = 0x00340001
-	ERROR_NDIS_OFFLOAD_POLICY                                                 syscall.Errno = 0xC034100F
-	ERROR_NDIS_OFFLOAD_CONNECTION_REJECTED                                    syscall.Errno = 0xC0341012
-	ERROR_NDIS_OFFLOAD_PATH_REJECTED                                          syscall.Errno = 0xC0341013
-	ERROR_HV_INVALID_HYPERCALL_CODE                                           syscall.Errno = 0xC0350002
-	ERROR_HV_INVALID_HYPERCALL_INPUT                                          syscall.Errno = 0xC0350003
-	ERROR_HV_INVALID_ALIGNMENT                                                syscall.Errno = 0xC0350004
-	ERROR_HV_INVALID_PARAMETER                                                syscall.Errno = 0xC0350005
-	ERROR_HV_ACCESS_DENIED                                                    syscall.Errno = 0xC0350006
-	ERROR_HV_INVALID_PARTITION_STATE                                          syscall.Errno = 0xC0350007
-	ERROR_HV_OPERATION_DENIED                                                 syscall.Errno = 0xC0350008
-	ERROR_HV_UNKNOWN_PROPERTY                                                 syscall.Errno = 0xC0350009
-	ERROR_HV_PROPERTY_VALUE_OUT_OF_RANGE                                      syscall.Errno = 0xC035000A
-	ERROR_HV_INSUFFICIENT_MEMORY                                              syscall.Errno = 0xC035000B
-	ERROR_HV_PARTITION_TOO_DEEP                                               syscall.Errno = 0xC035000C
-	ERROR_HV_INVALID_PARTITION_ID                                             syscall.Errno = 0xC035000D
-	ERROR_HV_INVALID_VP_INDEX                                                 syscall.Errno = 0xC035000E
-	ERROR_HV_INVALID_PORT_ID                                                  syscall.Errno = 0xC0350011
-	ERROR_HV_INVALID_CONNECTION_ID                                            syscall.Errno = 0xC0350012
-	ERROR_HV_INSUFFICIENT_BUFFERS                                             syscall.Errno = 0xC0350013
-	ERROR_HV_NOT_ACKNOWLEDGED                                                 syscall.Errno = 0xC0350014
-	ERROR_HV_INVALID_VP_STATE                                                 syscall.Errno = 0xC0350015
-	ERROR_HV_ACKNOWLEDGED                                                     syscall.Errno = 0xC0350016
-	ERROR_HV_INVALID_SAVE_RESTORE_STATE                                       syscall.Errno = 0xC0350017
-	ERROR_HV_INVALID_SYNIC_STATE                                              syscall.Errno = 0xC0350018
-	ERROR_HV_OBJECT_IN_USE                                                    syscall.Errno = 0xC0350019
-	ERROR_HV_INVALID_PROXIMITY_DOMAIN_INFO                                    syscall.Errno = 0xC035001A
-	ERROR_HV_NO_DATA                                                          syscall.Errno = 0xC035001B
-	ERROR_HV_INACTIVE                                                         syscall.Errno = 0xC035001C
-	ERROR_HV_NO_RESOURCES                                                     syscall.Errno = 0xC035001D
-	ERROR_HV_FEATURE_UNAVAILABLE                                              syscall.Errno = 0xC035001E
-	ERROR_HV_INSUFFICIENT_BUFFER                                              syscall.Errno = 0xC0350033
-	ERROR_HV_INSUFFICIENT_DEVICE_DOMAINS                                      syscall.Errno = 0xC0350038
-	ERROR_HV_CPUID_FEATURE_VALIDATION                                         syscall.Errno = 0xC035003C
-	ERROR_HV_CPUID_XSAVE_FEATURE_VALIDATION                                   syscall.Errno = 0xC035003D
-	ERROR_HV_PROCESSOR_STARTUP_TIMEOUT                                        syscall.Errno = 0xC035003E
-	ERROR_HV_SMX_ENABLED                                                      syscall.Errno = 0xC035003F
-	ERROR_HV_INVALID_LP_INDEX                                                 syscall.Errno = 0xC0350041
-	ERROR_HV_INVALID_REGISTER_VALUE                                           syscall.Errno = 0xC0350050
-	ERROR_HV_INVALID_VTL_STATE                                                syscall.Errno = 0xC0350051
-	ERROR_HV_NX_NOT_DETECTED                                                  syscall.Errno = 0xC0350055
-	ERROR_HV_INVALID_DEVICE_ID                                                syscall.Errno = 0xC0350057
-	ERROR_HV_INVALID_DEVICE_STATE                                             syscall.Errno = 0xC0350058
-	ERROR_HV_PENDING_PAGE_REQUESTS                                            syscall.Errno = 0x00350059
-	ERROR_HV_PAGE_REQUEST_INVALID                                             syscall.Errno = 0xC0350060
-	ERROR_HV_INVALID_CPU_GROUP_ID                                             syscall.Errno = 0xC035006F
-	ERROR_HV_INVALID_CPU_GROUP_STATE                                          syscall.Errno = 0xC0350070
-	ERROR_HV_OPERATION_FAILED                                                 syscall.Errno = 0xC0350071
-	ERROR_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE                              syscall.Errno = 0xC0350072
-	ERROR_HV_INSUFFICIENT_ROOT_MEMORY                                         syscall.Errno = 0xC0350073
-	ERROR_HV_NOT_PRESENT                                                      syscall.Errno = 0xC0351000
-	ERROR_VID_DUPLICATE_HANDLER                                               syscall.Errno = 0xC0370001
-	ERROR_VID_TOO_MANY_HANDLERS                                               syscall.Errno = 0xC0370002
-	ERROR_VID_QUEUE_FULL                                                      syscall.Errno = 0xC0370003
-	ERROR_VID_HANDLER_NOT_PRESENT                                             syscall.Errno = 0xC0370004
-	ERROR_VID_INVALID_OBJECT_NAME                                             syscall.Errno = 0xC0370005
-	ERROR_VID_PARTITION_NAME_TOO_LONG                                         syscall.Errno = 0xC0370006
-	ERROR_VID_MESSAGE_QUEUE_NAME_TOO_LONG                                     syscall.Errno = 0xC0370007
-	ERROR_VID_PARTITION_ALREADY_EXISTS                                        syscall.Errno = 0xC0370008
-	ERROR_VID_PARTITION_DOES_NOT_EXIST                                        syscall.Errno = 0xC0370009
-	ERROR_VID_PARTITION_NAME_NOT_FOUND                                        syscall.Errno = 0xC037000A
-	ERROR_VID_MESSAGE_QUEUE_ALREADY_EXISTS                                    syscall.Errno = 0xC037000B
-	ERROR_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT                                    syscall.Errno = 0xC037000C
-	ERROR_VID_MB_STILL_REFERENCED                                             syscall.Errno = 0xC037000D
-	ERROR_VID_CHILD_GPA_PAGE_SET_CORRUPTED                                    syscall.Errno = 0xC037000E
-	ERROR_VID_INVALID_NUMA_SETTINGS                                           syscall.Errno = 0xC037000F
-	ERROR_VID_INVALID_NUMA_NODE_INDEX                                         syscall.Errno = 0xC0370010
-	ERROR_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED                           syscall.Errno = 0xC0370011
-	ERROR_VID_INVALID_MEMORY_BLOCK_HANDLE                                     syscall.Errno = 0xC0370012
-	ERROR_VID_PAGE_RANGE_OVERFLOW                                             syscall.Errno = 0xC0370013
-	ERROR_VID_INVALID_MESSAGE_QUEUE_HANDLE                                    syscall.Errno = 0xC0370014
-	ERROR_VID_INVALID_GPA_RANGE_HANDLE                                        syscall.Errno = 0xC0370015
-	ERROR_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE                              syscall.Errno = 0xC0370016
-	ERROR_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED                                syscall.Errno = 0xC0370017
-	ERROR_VID_INVALID_PPM_HANDLE                                              syscall.Errno = 0xC0370018
-	ERROR_VID_MBPS_ARE_LOCKED                                                 syscall.Errno = 0xC0370019
-	ERROR_VID_MESSAGE_QUEUE_CLOSED                                            syscall.Errno = 0xC037001A
-	ERROR_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED                                syscall.Errno = 0xC037001B
-	ERROR_VID_STOP_PENDING                                                    syscall.Errno = 0xC037001C
-	ERROR_VID_INVALID_PROCESSOR_STATE                                         syscall.Errno = 0xC037001D
-	ERROR_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT                                 syscall.Errno = 0xC037001E
-	ERROR_VID_KM_INTERFACE_ALREADY_INITIALIZED                                syscall.Errno = 0xC037001F
-	ERROR_VID_MB_PROPERTY_ALREADY_SET_RESET                                   syscall.Errno = 0xC0370020
-	ERROR_VID_MMIO_RANGE_DESTROYED                                            syscall.Errno = 0xC0370021
-	ERROR_VID_INVALID_CHILD_GPA_PAGE_SET                                      syscall.Errno = 0xC0370022
-	ERROR_VID_RESERVE_PAGE_SET_IS_BEING_USED                                  syscall.Errno = 0xC0370023
-	ERROR_VID_RESERVE_PAGE_SET_TOO_SMALL                                      syscall.Errno = 0xC0370024
-	ERROR_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE                          syscall.Errno = 0xC0370025
-	ERROR_VID_MBP_COUNT_EXCEEDED_LIMIT                                        syscall.Errno = 0xC0370026
-	ERROR_VID_SAVED_STATE_CORRUPT                                             syscall.Errno = 0xC0370027
-	ERROR_VID_SAVED_STATE_UNRECOGNIZED_ITEM                                   syscall.Errno = 0xC0370028
-	ERROR_VID_SAVED_STATE_INCOMPATIBLE                                        syscall.Errno = 0xC0370029
-	ERROR_VID_VTL_ACCESS_DENIED                                               syscall.Errno = 0xC037002A
-	ERROR_VMCOMPUTE_TERMINATED_DURING_START                                   syscall.Errno = 0xC0370100
-	ERROR_VMCOMPUTE_IMAGE_MISMATCH                                            syscall.Errno = 0xC0370101
-	ERROR_VMCOMPUTE_HYPERV_NOT_INSTALLED                                      syscall.Errno = 0xC0370102
-	ERROR_VMCOMPUTE_OPERATION_PENDING                                         syscall.Errno = 0xC0370103
-	ERROR_VMCOMPUTE_TOO_MANY_NOTIFICATIONS                                    syscall.Errno = 0xC0370104
-	ERROR_VMCOMPUTE_INVALID_STATE                                             syscall.Errno = 0xC0370105
-	ERROR_VMCOMPUTE_UNEXPECTED_EXIT                                           syscall.Errno = 0xC0370106
-	ERROR_VMCOMPUTE_TERMINATED                                                syscall.Errno = 0xC0370107
-	ERROR_VMCOMPUTE_CONNECT_FAILED                                            syscall.Errno = 0xC0370108
-	ERROR_VMCOMPUTE_TIMEOUT                                                   syscall.Errno = 0xC0370109
-	ERROR_VMCOMPUTE_CONNECTION_CLOSED                                         syscall.Errno = 0xC037010A
-	ERROR_VMCOMPUTE_UNKNOWN_MESSAGE                                           syscall.Errno = 0xC037010B
-	ERROR_VMCOMPUTE_UNSUPPORTED_PROTOCOL_VERSION                              syscall.Errno = 0xC037010C
-	ERROR_VMCOMPUTE_INVALID_JSON                                              syscall.Errno = 0xC037010D
-	ERROR_VMCOMPUTE_SYSTEM_NOT_FOUND                                          syscall.Errno = 0xC037010E
-	ERROR_VMCOMPUTE_SYSTEM_ALREADY_EXISTS                                     syscall.Errno = 0xC037010F
-	ERROR_VMCOMPUTE_SYSTEM_ALREADY_STOPPED                                    syscall.Errno = 0xC0370110
-	ERROR_VMCOMPUTE_PROTOCOL_ERROR                                            syscall.Errno = 0xC0370111
-	ERROR_VMCOMPUTE_INVALID_LAYER                                             syscall.Errno = 0xC0370112
-	ERROR_VMCOMPUTE_WINDOWS_INSIDER_REQUIRED                                  syscall.Errno = 0xC0370113
-	HCS_E_TERMINATED_DURING_START                                             Handle        = 0x80370100
-	HCS_E_IMAGE_MISMATCH                                                      Handle        = 0x80370101
-	HCS_E_HYPERV_NOT_INSTALLED                                                Handle        = 0x80370102
-	HCS_E_INVALID_STATE                                                       Handle        = 0x80370105
-	HCS_E_UNEXPECTED_EXIT                                                     Handle        = 0x80370106
-	HCS_E_TERMINATED                                                          Handle        = 0x80370107
-	HCS_E_CONNECT_FAILED                                                      Handle        = 0x80370108
-	HCS_E_CONNECTION_TIMEOUT                                                  Handle        = 0x80370109
-	HCS_E_CONNECTION_CLOSED                                                   Handle        = 0x8037010A
-	HCS_E_UNKNOWN_MESSAGE                                                     Handle        = 0x8037010B
-	HCS_E_UNSUPPORTED_PROTOCOL_VERSION                                        Handle        = 0x8037010C
-	HCS_E_INVALID_JSON                                                        Handle        = 0x8037010D
-	HCS_E_SYSTEM_NOT_FOUND                                                    Handle        = 0x8037010E
-	HCS_E_SYSTEM_ALREADY_EXISTS                                               Handle        = 0x8037010F
-	HCS_E_SYSTEM_ALREADY_STOPPED                                              Handle        = 0x80370110
-	HCS_E_PROTOCOL_ERROR                                                      Handle        = 0x80370111
-	HCS_E_INVALID_LAYER                                                       Handle        = 0x80370112
-	HCS_E_WINDOWS_INSIDER_REQUIRED                                            Handle        = 0x80370113
-	HCS_E_SERVICE_NOT_AVAILABLE                                               Handle        = 0x80370114
-	HCS_E_OPERATION_NOT_STARTED                                               Handle        = 0x80370115
-	HCS_E_OPERATION_ALREADY_STARTED                                           Handle        = 0x80370116
-	HCS_E_OPERATION_PENDING                                                   Handle        = 0x80370117
-	HCS_E_OPERATION_TIMEOUT                                                   Handle        = 0x80370118
-	HCS_E_OPERATION_SYSTEM_CALLBACK_ALREADY_SET                               Handle        = 0x80370119
-	HCS_E_OPERATION_RESULT_ALLOCATION_FAILED                                  Handle        = 0x8037011A
-	HCS_E_ACCESS_DENIED                                                       Handle        = 0x8037011B
-	HCS_E_GUEST_CRITICAL_ERROR                                                Handle        = 0x8037011C
-	ERROR_VNET_VIRTUAL_SWITCH_NAME_NOT_FOUND                                  syscall.Errno = 0xC0370200
-	ERROR_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED                               syscall.Errno = 0x80370001
-	WHV_E_UNKNOWN_CAPABILITY                                                  Handle        = 0x80370300
-	WHV_E_INSUFFICIENT_BUFFER                                                 Handle        = 0x80370301
-	WHV_E_UNKNOWN_PROPERTY                                                    Handle        = 0x80370302
-	WHV_E_UNSUPPORTED_HYPERVISOR_CONFIG                                       Handle        = 0x80370303
-	WHV_E_INVALID_PARTITION_CONFIG                                            Handle        = 0x80370304
-	WHV_E_GPA_RANGE_NOT_FOUND                                                 Handle        = 0x80370305
-	WHV_E_VP_ALREADY_EXISTS                                                   Handle        = 0x80370306
-	WHV_E_VP_DOES_NOT_EXIST                                                   Handle        = 0x80370307
-	WHV_E_INVALID_VP_STATE                                                    Handle        = 0x80370308
-	WHV_E_INVALID_VP_REGISTER_NAME                                            Handle        = 0x80370309
-	ERROR_VSMB_SAVED_STATE_FILE_NOT_FOUND                                     syscall.Errno = 0xC0370400
-	ERROR_VSMB_SAVED_STATE_CORRUPT                                            syscall.Errno = 0xC0370401
-	ERROR_VOLMGR_INCOMPLETE_REGENERATION                                      syscall.Errno = 0x80380001
-	ERROR_VOLMGR_INCOMPLETE_DISK_MIGRATION                                    syscall.Errno = 0x80380002
-	ERROR_VOLMGR_DATABASE_FULL                                                syscall.Errno = 0xC0380001
-	ERROR_VOLMGR_DISK_CONFIGURATION_CORRUPTED                                 syscall.Errno = 0xC0380002
-	ERROR_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC                               syscall.Errno = 0xC0380003
-	ERROR_VOLMGR_PACK_CONFIG_UPDATE_FAILED                                    syscall.Errno = 0xC0380004
-	ERROR_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME                              syscall.Errno = 0xC0380005
-	ERROR_VOLMGR_DISK_DUPLICATE                                               syscall.Errno = 0xC0380006
-	ERROR_VOLMGR_DISK_DYNAMIC                                                 syscall.Errno = 0xC0380007
-	ERROR_VOLMGR_DISK_ID_INVALID                                              syscall.Errno = 0xC0380008
-	ERROR_VOLMGR_DISK_INVALID                                                 syscall.Errno = 0xC0380009
-	ERROR_VOLMGR_DISK_LAST_VOTER                                              syscall.Errno = 0xC038000A
-	ERROR_VOLMGR_DISK_LAYOUT_INVALID                                          syscall.Errno = 0xC038000B
-	ERROR_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS               syscall.Errno = 0xC038000C
-	ERROR_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED                             syscall.Errno = 0xC038000D
-	ERROR_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL                             syscall.Errno = 0xC038000E
-	ERROR_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS               syscall.Errno = 0xC038000F
-	ERROR_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS                              syscall.Errno = 0xC0380010
-	ERROR_VOLMGR_DISK_MISSING                                                 syscall.Errno = 0xC0380011
-	ERROR_VOLMGR_DISK_NOT_EMPTY                                               syscall.Errno = 0xC0380012
-	ERROR_VOLMGR_DISK_NOT_ENOUGH_SPACE                                        syscall.Errno = 0xC0380013
-	ERROR_VOLMGR_DISK_REVECTORING_FAILED                                      syscall.Errno = 0xC0380014
-	ERROR_VOLMGR_DISK_SECTOR_SIZE_INVALID                                     syscall.Errno = 0xC0380015
-	ERROR_VOLMGR_DISK_SET_NOT_CONTAINED                                       syscall.Errno = 0xC0380016
-	ERROR_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS                                syscall.Errno = 0xC0380017
-	ERROR_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES                                 syscall.Errno = 0xC0380018
-	ERROR_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED                                   syscall.Errno = 0xC0380019
-	ERROR_VOLMGR_EXTENT_ALREADY_USED                                          syscall.Errno = 0xC038001A
-	ERROR_VOLMGR_EXTENT_NOT_CONTIGUOUS                                        syscall.Errno = 0xC038001B
-	ERROR_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION                                  syscall.Errno = 0xC038001C
-	ERROR_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED                                    syscall.Errno = 0xC038001D
-	ERROR_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION                                syscall.Errno = 0xC038001E
-	ERROR_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH                           syscall.Errno = 0xC038001F
-	ERROR_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED                                 syscall.Errno = 0xC0380020
-	ERROR_VOLMGR_INTERLEAVE_LENGTH_INVALID                                    syscall.Errno = 0xC0380021
-	ERROR_VOLMGR_MAXIMUM_REGISTERED_USERS                                     syscall.Errno = 0xC0380022
-	ERROR_VOLMGR_MEMBER_IN_SYNC                                               syscall.Errno = 0xC0380023
-	ERROR_VOLMGR_MEMBER_INDEX_DUPLICATE                                       syscall.Errno = 0xC0380024
-	ERROR_VOLMGR_MEMBER_INDEX_INVALID                                         syscall.Errno = 0xC0380025
-	ERROR_VOLMGR_MEMBER_MISSING                                               syscall.Errno = 0xC0380026
-	ERROR_VOLMGR_MEMBER_NOT_DETACHED                                          syscall.Errno = 0xC0380027
-	ERROR_VOLMGR_MEMBER_REGENERATING                                          syscall.Errno = 0xC0380028
-	ERROR_VOLMGR_ALL_DISKS_FAILED                                             syscall.Errno = 0xC0380029
-	ERROR_VOLMGR_NO_REGISTERED_USERS                                          syscall.Errno = 0xC038002A
-	ERROR_VOLMGR_NO_SUCH_USER                                                 syscall.Errno = 0xC038002B
-	ERROR_VOLMGR_NOTIFICATION_RESET                                           syscall.Errno = 0xC038002C
-	ERROR_VOLMGR_NUMBER_OF_MEMBERS_INVALID                                    syscall.Errno = 0xC038002D
-	ERROR_VOLMGR_NUMBER_OF_PLEXES_INVALID                                     syscall.Errno = 0xC038002E
-	ERROR_VOLMGR_PACK_DUPLICATE                                               syscall.Errno = 0xC038002F
-	ERROR_VOLMGR_PACK_ID_INVALID                                              syscall.Errno = 0xC0380030
-	ERROR_VOLMGR_PACK_INVALID                                                 syscall.Errno = 0xC0380031
-	ERROR_VOLMGR_PACK_NAME_INVALID                                            syscall.Errno = 0xC0380032
-	ERROR_VOLMGR_PACK_OFFLINE                                                 syscall.Errno = 0xC0380033
-	ERROR_VOLMGR_PACK_HAS_QUORUM                                              syscall.Errno = 0xC0380034
-	ERROR_VOLMGR_PACK_WITHOUT_QUORUM                                          syscall.Errno = 0xC0380035
-	ERROR_VOLMGR_PARTITION_STYLE_INVALID                                      syscall.Errno = 0xC0380036
-	ERROR_VOLMGR_PARTITION_UPDATE_FAILED                                      syscall.Errno = 0xC0380037
-	ERROR_VOLMGR_PLEX_IN_SYNC                                                 syscall.Errno = 0xC0380038
-	ERROR_VOLMGR_PLEX_INDEX_DUPLICATE                                         syscall.Errno = 0xC0380039
-	ERROR_VOLMGR_PLEX_INDEX_INVALID                                           syscall.Errno = 0xC038003A
-	ERROR_VOLMGR_PLEX_LAST_ACTIVE                                             syscall.Errno = 0xC038003B
-	ERROR_VOLMGR_PLEX_MISSING                                                 syscall.Errno = 0xC038003C
-	ERROR_VOLMGR_PLEX_REGENERATING                                            syscall.Errno = 0xC038003D
-	ERROR_VOLMGR_PLEX_TYPE_INVALID                                            syscall.Errno = 0xC038003E
-	ERROR_VOLMGR_PLEX_NOT_RAID5                                               syscall.Errno = 0xC038003F
-	ERROR_VOLMGR_PLEX_NOT_SIMPLE                                              syscall.Errno = 0xC0380040
-	ERROR_VOLMGR_STRUCTURE_SIZE_INVALID                                       syscall.Errno = 0xC0380041
-	ERROR_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS                               syscall.Errno = 0xC0380042
-	ERROR_VOLMGR_TRANSACTION_IN_PROGRESS                                      syscall.Errno = 0xC0380043
-	ERROR_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE                                syscall.Errno = 0xC0380044
-	ERROR_VOLMGR_VOLUME_CONTAINS_MISSING_DISK                                 syscall.Errno = 0xC0380045
-	ERROR_VOLMGR_VOLUME_ID_INVALID                                            syscall.Errno = 0xC0380046
-	ERROR_VOLMGR_VOLUME_LENGTH_INVALID                                        syscall.Errno = 0xC0380047
-	ERROR_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE                       syscall.Errno = 0xC0380048
-	ERROR_VOLMGR_VOLUME_NOT_MIRRORED                                          syscall.Errno = 0xC0380049
-	ERROR_VOLMGR_VOLUME_NOT_RETAINED                                          syscall.Errno = 0xC038004A
-	ERROR_VOLMGR_VOLUME_OFFLINE                                               syscall.Errno = 0xC038004B
-	ERROR_VOLMGR_VOLUME_RETAINED                                              syscall.Errno = 0xC038004C
-	ERROR_VOLMGR_NUMBER_OF_EXTENTS_INVALID                                    syscall.Errno = 0xC038004D
-	ERROR_VOLMGR_DIFFERENT_SECTOR_SIZE                                        syscall.Errno = 0xC038004E
-	ERROR_VOLMGR_BAD_BOOT_DISK                                                syscall.Errno = 0xC038004F
-	ERROR_VOLMGR_PACK_CONFIG_OFFLINE                                          syscall.Errno = 0xC0380050
-	ERROR_VOLMGR_PACK_CONFIG_ONLINE                                           syscall.Errno = 0xC0380051
-	ERROR_VOLMGR_NOT_PRIMARY_PACK                                             syscall.Errno = 0xC0380052
-	ERROR_VOLMGR_PACK_LOG_UPDATE_FAILED                                       syscall.Errno = 0xC0380053
-	ERROR_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID                              syscall.Errno = 0xC0380054
-	ERROR_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID                            syscall.Errno = 0xC0380055
-	ERROR_VOLMGR_VOLUME_MIRRORED                                              syscall.Errno = 0xC0380056
-	ERROR_VOLMGR_PLEX_NOT_SIMPLE_SPANNED                                      syscall.Errno = 0xC0380057
-	ERROR_VOLMGR_NO_VALID_LOG_COPIES                                          syscall.Errno = 0xC0380058
-	ERROR_VOLMGR_PRIMARY_PACK_PRESENT                                         syscall.Errno = 0xC0380059
-	ERROR_VOLMGR_NUMBER_OF_DISKS_INVALID                                      syscall.Errno = 0xC038005A
-	ERROR_VOLMGR_MIRROR_NOT_SUPPORTED                                         syscall.Errno = 0xC038005B
-	ERROR_VOLMGR_RAID5_NOT_SUPPORTED                                          syscall.Errno = 0xC038005C
-	ERROR_BCD_NOT_ALL_ENTRIES_IMPORTED                                        syscall.Errno = 0x80390001
-	ERROR_BCD_TOO_MANY_ELEMENTS                                               syscall.Errno = 0xC0390002
-	ERROR_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED                                    syscall.Errno = 0x80390003
-	ERROR_VHD_DRIVE_FOOTER_MISSING                                            syscall.Errno = 0xC03A0001
-	ERROR_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH                                  syscall.Errno = 0xC03A0002
-	ERROR_VHD_DRIVE_FOOTER_CORRUPT                                            syscall.Errno = 0xC03A0003
-	ERROR_VHD_FORMAT_UNKNOWN                                                  syscall.Errno = 0xC03A0004
-	ERROR_VHD_FORMAT_UNSUPPORTED_VERSION                                      syscall.Errno = 0xC03A0005
-	ERROR_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH                                 syscall.Errno = 0xC03A0006
-	ERROR_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION                               syscall.Errno = 0xC03A0007
-	ERROR_VHD_SPARSE_HEADER_CORRUPT                                           syscall.Errno = 0xC03A0008
-	ERROR_VHD_BLOCK_ALLOCATION_FAILURE                                        syscall.Errno = 0xC03A0009
-	ERROR_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT                                  syscall.Errno = 0xC03A000A
-	ERROR_VHD_INVALID_BLOCK_SIZE                                              syscall.Errno = 0xC03A000B
-	ERROR_VHD_BITMAP_MISMATCH                                                 syscall.Errno = 0xC03A000C
-	ERROR_VHD_PARENT_VHD_NOT_FOUND                                            syscall.Errno = 0xC03A000D
-	ERROR_VHD_CHILD_PARENT_ID_MISMATCH                                        syscall.Errno = 0xC03A000E
-	ERROR_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH                                 syscall.Errno = 0xC03A000F
-	ERROR_VHD_METADATA_READ_FAILURE                                           syscall.Errno = 0xC03A0010
-	ERROR_VHD_METADATA_WRITE_FAILURE                                          syscall.Errno = 0xC03A0011
-	ERROR_VHD_INVALID_SIZE                                                    syscall.Errno = 0xC03A0012
-	ERROR_VHD_INVALID_FILE_SIZE                                               syscall.Errno = 0xC03A0013
-	ERROR_VIRTDISK_PROVIDER_NOT_FOUND                                         syscall.Errno = 0xC03A0014
-	ERROR_VIRTDISK_NOT_VIRTUAL_DISK                                           syscall.Errno = 0xC03A0015
-	ERROR_VHD_PARENT_VHD_ACCESS_DENIED                                        syscall.Errno = 0xC03A0016
-	ERROR_VHD_CHILD_PARENT_SIZE_MISMATCH                                      syscall.Errno = 0xC03A0017
-	ERROR_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED                               syscall.Errno = 0xC03A0018
-	ERROR_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT                              syscall.Errno = 0xC03A0019
-	ERROR_VIRTUAL_DISK_LIMITATION                                             syscall.Errno = 0xC03A001A
-	ERROR_VHD_INVALID_TYPE                                                    syscall.Errno = 0xC03A001B
-	ERROR_VHD_INVALID_STATE                                                   syscall.Errno = 0xC03A001C
-	ERROR_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE                               syscall.Errno = 0xC03A001D
-	ERROR_VIRTDISK_DISK_ALREADY_OWNED                                         syscall.Errno = 0xC03A001E
-	ERROR_VIRTDISK_DISK_ONLINE_AND_WRITABLE                                   syscall.Errno = 0xC03A001F
-	ERROR_CTLOG_TRACKING_NOT_INITIALIZED                                      syscall.Errno = 0xC03A0020
-	ERROR_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE                                 syscall.Errno = 0xC03A0021
-	ERROR_CTLOG_VHD_CHANGED_OFFLINE                                           syscall.Errno = 0xC03A0022
-	ERROR_CTLOG_INVALID_TRACKING_STATE                                        syscall.Errno = 0xC03A0023
-	ERROR_CTLOG_INCONSISTENT_TRACKING_FILE                                    syscall.Errno = 0xC03A0024
-	ERROR_VHD_RESIZE_WOULD_TRUNCATE_DATA                                      syscall.Errno = 0xC03A0025
-	ERROR_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE                          syscall.Errno = 0xC03A0026
-	ERROR_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE                        syscall.Errno = 0xC03A0027
-	ERROR_VHD_METADATA_FULL                                                   syscall.Errno = 0xC03A0028
-	ERROR_VHD_INVALID_CHANGE_TRACKING_ID                                      syscall.Errno = 0xC03A0029
-	ERROR_VHD_CHANGE_TRACKING_DISABLED                                        syscall.Errno = 0xC03A002A
-	ERROR_VHD_MISSING_CHANGE_TRACKING_INFORMATION                             syscall.Errno = 0xC03A0030
-	ERROR_QUERY_STORAGE_ERROR                                                 syscall.Errno = 0x803A0001
-	HCN_E_NETWORK_NOT_FOUND                                                   Handle        = 0x803B0001
-	HCN_E_ENDPOINT_NOT_FOUND                                                  Handle        = 0x803B0002
-	HCN_E_LAYER_NOT_FOUND                                                     Handle        = 0x803B0003
-	HCN_E_SWITCH_NOT_FOUND                                                    Handle        = 0x803B0004
-	HCN_E_SUBNET_NOT_FOUND                                                    Handle        = 0x803B0005
-	HCN_E_ADAPTER_NOT_FOUND                                                   Handle        = 0x803B0006
-	HCN_E_PORT_NOT_FOUND                                                      Handle        = 0x803B0007
-	HCN_E_POLICY_NOT_FOUND                                                    Handle        = 0x803B0008
-	HCN_E_VFP_PORTSETTING_NOT_FOUND                                           Handle        = 0x803B0009
-	HCN_E_INVALID_NETWORK                                                     Handle        = 0x803B000A
-	HCN_E_INVALID_NETWORK_TYPE                                                Handle        = 0x803B000B
-	HCN_E_INVALID_ENDPOINT                                                    Handle        = 0x803B000C
-	HCN_E_INVALID_POLICY                                                      Handle        = 0x803B000D
-	HCN_E_INVALID_POLICY_TYPE                                                 Handle        = 0x803B000E
-	HCN_E_INVALID_REMOTE_ENDPOINT_OPERATION                                   Handle        = 0x803B000F
-	HCN_E_NETWORK_ALREADY_EXISTS                                              Handle        = 0x803B0010
-	HCN_E_LAYER_ALREADY_EXISTS                                                Handle        = 0x803B0011
-	HCN_E_POLICY_ALREADY_EXISTS                                               Handle        = 0x803B0012
-	HCN_E_PORT_ALREADY_EXISTS                                                 Handle        = 0x803B0013
-	HCN_E_ENDPOINT_ALREADY_ATTACHED                                           Handle        = 0x803B0014
-	HCN_E_REQUEST_UNSUPPORTED                                                 Handle        = 0x803B0015
-	HCN_E_MAPPING_NOT_SUPPORTED                                               Handle        = 0x803B0016
-	HCN_E_DEGRADED_OPERATION                                                  Handle        = 0x803B0017
-	HCN_E_SHARED_SWITCH_MODIFICATION                                          Handle        = 0x803B0018
-	HCN_E_GUID_CONVERSION_FAILURE                                             Handle        = 0x803B0019
-	HCN_E_REGKEY_FAILURE                                                      Handle        = 0x803B001A
-	HCN_E_INVALID_JSON                                                        Handle        = 0x803B001B
-	HCN_E_INVALID_JSON_REFERENCE                                              Handle        = 0x803B001C
-	HCN_E_ENDPOINT_SHARING_DISABLED                                           Handle        = 0x803B001D
-	HCN_E_INVALID_IP                                                          Handle        = 0x803B001E
-	HCN_E_SWITCH_EXTENSION_NOT_FOUND                                          Handle        = 0x803B001F
-	HCN_E_MANAGER_STOPPED                                                     Handle        = 0x803B0020
-	GCN_E_MODULE_NOT_FOUND                                                    Handle        = 0x803B0021
-	GCN_E_NO_REQUEST_HANDLERS                                                 Handle        = 0x803B0022
-	GCN_E_REQUEST_UNSUPPORTED                                                 Handle        = 0x803B0023
-	GCN_E_RUNTIMEKEYS_FAILED                                                  Handle        = 0x803B0024
-	GCN_E_NETADAPTER_TIMEOUT                                                  Handle        = 0x803B0025
-	GCN_E_NETADAPTER_NOT_FOUND                                                Handle        = 0x803B0026
-	GCN_E_NETCOMPARTMENT_NOT_FOUND                                            Handle        = 0x803B0027
-	GCN_E_NETINTERFACE_NOT_FOUND                                              Handle        = 0x803B0028
-	GCN_E_DEFAULTNAMESPACE_EXISTS                                             Handle        = 0x803B0029
-	SDIAG_E_CANCELLED                                                         syscall.Errno = 0x803C0100
-	SDIAG_E_SCRIPT                                                            syscall.Errno = 0x803C0101
-	SDIAG_E_POWERSHELL                                                        syscall.Errno = 0x803C0102
-	SDIAG_E_MANAGEDHOST                                                       syscall.Errno = 0x803C0103
-	SDIAG_E_NOVERIFIER                                                        syscall.Errno = 0x803C0104
-	SDIAG_S_CANNOTRUN                                                         syscall.Errno = 0x003C0105
-	SDIAG_E_DISABLED                                                          syscall.Errno = 0x803C0106
-	SDIAG_E_TRUST                                                             syscall.Errno = 0x803C0107
-	SDIAG_E_CANNOTRUN                                                         syscall.Errno = 0x803C0108
-	SDIAG_E_VERSION                                                           syscall.Errno = 0x803C0109
-	SDIAG_E_RESOURCE                                                          syscall.Errno = 0x803C010A
-	SDIAG_E_ROOTCAUSE                                                         syscall.Errno = 0x803C010B
-	WPN_E_CHANNEL_CLOSED                                                      Handle        = 0x803E0100
-	WPN_E_CHANNEL_REQUEST_NOT_COMPLETE                                        Handle        = 0x803E0101
-	WPN_E_INVALID_APP                                                         Handle        = 0x803E0102
-	WPN_E_OUTSTANDING_CHANNEL_REQUEST                                         Handle        = 0x803E0103
-	WPN_E_DUPLICATE_CHANNEL                                                   Handle        = 0x803E0104
-	WPN_E_PLATFORM_UNAVAILABLE                                                Handle        = 0x803E0105
-	WPN_E_NOTIFICATION_POSTED                                                 Handle        = 0x803E0106
-	WPN_E_NOTIFICATION_HIDDEN                                                 Handle        = 0x803E0107
-	WPN_E_NOTIFICATION_NOT_POSTED                                             Handle        = 0x803E0108
-	WPN_E_CLOUD_DISABLED                                                      Handle        = 0x803E0109
-	WPN_E_CLOUD_INCAPABLE                                                     Handle        = 0x803E0110
-	WPN_E_CLOUD_AUTH_UNAVAILABLE                                              Handle        = 0x803E011A
-	WPN_E_CLOUD_SERVICE_UNAVAILABLE                                           Handle        = 0x803E011B
-	WPN_E_FAILED_LOCK_SCREEN_UPDATE_INTIALIZATION                             Handle        = 0x803E011C
-	WPN_E_NOTIFICATION_DISABLED                                               Handle        = 0x803E0111
-	WPN_E_NOTIFICATION_INCAPABLE                                              Handle        = 0x803E0112
-	WPN_E_INTERNET_INCAPABLE                                                  Handle        = 0x803E0113
-	WPN_E_NOTIFICATION_TYPE_DISABLED                                          Handle        = 0x803E0114
-	WPN_E_NOTIFICATION_SIZE                                                   Handle        = 0x803E0115
-	WPN_E_TAG_SIZE                                                            Handle        = 0x803E0116
-	WPN_E_ACCESS_DENIED                                                       Handle        = 0x803E0117
-	WPN_E_DUPLICATE_REGISTRATION                                              Handle        = 0x803E0118
-	WPN_E_PUSH_NOTIFICATION_INCAPABLE                                         Handle        = 0x803E0119
-	WPN_E_DEV_ID_SIZE                                                         Handle        = 0x803E0120
-	WPN_E_TAG_ALPHANUMERIC                                                    Handle        = 0x803E012A
-	WPN_E_INVALID_HTTP_STATUS_CODE                                            Handle        = 0x803E012B
-	WPN_E_OUT_OF_SESSION                                                      Handle        = 0x803E0200
-	WPN_E_POWER_SAVE                                                          Handle        = 0x803E0201
-	WPN_E_IMAGE_NOT_FOUND_IN_CACHE                                            Handle        = 0x803E0202
-	WPN_E_ALL_URL_NOT_COMPLETED                                               Handle        = 0x803E0203
-	WPN_E_INVALID_CLOUD_IMAGE                                                 Handle        = 0x803E0204
-	WPN_E_NOTIFICATION_ID_MATCHED                                             Handle        = 0x803E0205
-	WPN_E_CALLBACK_ALREADY_REGISTERED                                         Handle        = 0x803E0206
-	WPN_E_TOAST_NOTIFICATION_DROPPED                                          Handle        = 0x803E0207
-	WPN_E_STORAGE_LOCKED                                                      Handle        = 0x803E0208
-	WPN_E_GROUP_SIZE                                                          Handle        = 0x803E0209
-	WPN_E_GROUP_ALPHANUMERIC                                                  Handle        = 0x803E020A
-	WPN_E_CLOUD_DISABLED_FOR_APP                                              Handle        = 0x803E020B
-	E_MBN_CONTEXT_NOT_ACTIVATED                                               Handle        = 0x80548201
-	E_MBN_BAD_SIM                                                             Handle        = 0x80548202
-	E_MBN_DATA_CLASS_NOT_AVAILABLE                                            Handle        = 0x80548203
-	E_MBN_INVALID_ACCESS_STRING                                               Handle        = 0x80548204
-	E_MBN_MAX_ACTIVATED_CONTEXTS                                              Handle        = 0x80548205
-	E_MBN_PACKET_SVC_DETACHED                                                 Handle        = 0x80548206
-	E_MBN_PROVIDER_NOT_VISIBLE                                                Handle        = 0x80548207
-	E_MBN_RADIO_POWER_OFF                                                     Handle        = 0x80548208
-	E_MBN_SERVICE_NOT_ACTIVATED                                               Handle        = 0x80548209
-	E_MBN_SIM_NOT_INSERTED                                                    Handle        = 0x8054820A
-	E_MBN_VOICE_CALL_IN_PROGRESS                                              Handle        = 0x8054820B
-	E_MBN_INVALID_CACHE                                                       Handle        = 0x8054820C
-	E_MBN_NOT_REGISTERED                                                      Handle        = 0x8054820D
-	E_MBN_PROVIDERS_NOT_FOUND                                                 Handle        = 0x8054820E
-	E_MBN_PIN_NOT_SUPPORTED                                                   Handle        = 0x8054820F
-	E_MBN_PIN_REQUIRED                                                        Handle        = 0x80548210
-	E_MBN_PIN_DISABLED                                                        Handle        = 0x80548211
-	E_MBN_FAILURE                                                             Handle        = 0x80548212
-	E_MBN_INVALID_PROFILE                                                     Handle        = 0x80548218
-	E_MBN_DEFAULT_PROFILE_EXIST                                               Handle        = 0x80548219
-	E_MBN_SMS_ENCODING_NOT_SUPPORTED                                          Handle        = 0x80548220
-	E_MBN_SMS_FILTER_NOT_SUPPORTED                                            Handle        = 0x80548221
-	E_MBN_SMS_INVALID_MEMORY_INDEX                                            Handle        = 0x80548222
-	E_MBN_SMS_LANG_NOT_SUPPORTED                                              Handle        = 0x80548223
-	E_MBN_SMS_MEMORY_FAILURE                                                  Handle        = 0x80548224
-	E_MBN_SMS_NETWORK_TIMEOUT                                                 Handle        = 0x80548225
-	E_MBN_SMS_UNKNOWN_SMSC_ADDRESS                                            Handle        = 0x80548226
-	E_MBN_SMS_FORMAT_NOT_SUPPORTED                                            Handle        = 0x80548227
-	E_MBN_SMS_OPERATION_NOT_ALLOWED                                           Handle        = 0x80548228
-	E_MBN_SMS_MEMORY_FULL                                                     Handle        = 0x80548229
-	PEER_E_IPV6_NOT_INSTALLED                                                 Handle        = 0x80630001
-	PEER_E_NOT_INITIALIZED                                                    Handle        = 0x80630002
-	PEER_E_CANNOT_START_SERVICE                                               Handle        = 0x80630003
-	PEER_E_NOT_LICENSED                                                       Handle        = 0x80630004
-	PEER_E_INVALID_GRAPH                                                      Handle        = 0x80630010
-	PEER_E_DBNAME_CHANGED                                                     Handle        = 0x80630011
-	PEER_E_DUPLICATE_GRAPH                                                    Handle        = 0x80630012
-	PEER_E_GRAPH_NOT_READY                                                    Handle        = 0x80630013
-	PEER_E_GRAPH_SHUTTING_DOWN                                                Handle        = 0x80630014
-	PEER_E_GRAPH_IN_USE                                                       Handle        = 0x80630015
-	PEER_E_INVALID_DATABASE                                                   Handle        = 0x80630016
-	PEER_E_TOO_MANY_ATTRIBUTES                                                Handle        = 0x80630017
-	PEER_E_CONNECTION_NOT_FOUND                                               Handle        = 0x80630103
-	PEER_E_CONNECT_SELF                                                       Handle        = 0x80630106
-	PEER_E_ALREADY_LISTENING                                                  Handle        = 0x80630107
-	PEER_E_NODE_NOT_FOUND                                                     Handle        = 0x80630108
-	PEER_E_CONNECTION_FAILED                                                  Handle        = 0x80630109
-	PEER_E_CONNECTION_NOT_AUTHENTICATED                                       Handle        = 0x8063010A
-	PEER_E_CONNECTION_REFUSED                                                 Handle        = 0x8063010B
-	PEER_E_CLASSIFIER_TOO_LONG                                                Handle        = 0x80630201
-	PEER_E_TOO_MANY_IDENTITIES                                                Handle        = 0x80630202
-	PEER_E_NO_KEY_ACCESS                                                      Handle        = 0x80630203
-	PEER_E_GROUPS_EXIST                                                       Handle        = 0x80630204
-	PEER_E_RECORD_NOT_FOUND                                                   Handle        = 0x80630301
-	PEER_E_DATABASE_ACCESSDENIED                                              Handle        = 0x80630302
-	PEER_E_DBINITIALIZATION_FAILED                                            Handle        = 0x80630303
-	PEER_E_MAX_RECORD_SIZE_EXCEEDED                                           Handle        = 0x80630304
-	PEER_E_DATABASE_ALREADY_PRESENT                                           Handle        = 0x80630305
-	PEER_E_DATABASE_NOT_PRESENT                                               Handle        = 0x80630306
-	PEER_E_IDENTITY_NOT_FOUND                                                 Handle        = 0x80630401
-	PEER_E_EVENT_HANDLE_NOT_FOUND                                             Handle        = 0x80630501
-	PEER_E_INVALID_SEARCH                                                     Handle        = 0x80630601
-	PEER_E_INVALID_ATTRIBUTES                                                 Handle        = 0x80630602
-	PEER_E_INVITATION_NOT_TRUSTED                                             Handle        = 0x80630701
-	PEER_E_CHAIN_TOO_LONG                                                     Handle        = 0x80630703
-	PEER_E_INVALID_TIME_PERIOD                                                Handle        = 0x80630705
-	PEER_E_CIRCULAR_CHAIN_DETECTED                                            Handle        = 0x80630706
-	PEER_E_CERT_STORE_CORRUPTED                                               Handle        = 0x80630801
-	PEER_E_NO_CLOUD                                                           Handle        = 0x80631001
-	PEER_E_CLOUD_NAME_AMBIGUOUS                                               Handle        = 0x80631005
-	PEER_E_INVALID_RECORD                                                     Handle        = 0x80632010
-	PEER_E_NOT_AUTHORIZED                                                     Handle        = 0x80632020
-	PEER_E_PASSWORD_DOES_NOT_MEET_POLICY                                      Handle        = 0x80632021
-	PEER_E_DEFERRED_VALIDATION                                                Handle        = 0x80632030
-	PEER_E_INVALID_GROUP_PROPERTIES                                           Handle        = 0x80632040
-	PEER_E_INVALID_PEER_NAME                                                  Handle        = 0x80632050
-	PEER_E_INVALID_CLASSIFIER                                                 Handle        = 0x80632060
-	PEER_E_INVALID_FRIENDLY_NAME                                              Handle        = 0x80632070
-	PEER_E_INVALID_ROLE_PROPERTY                                              Handle        = 0x80632071
-	PEER_E_INVALID_CLASSIFIER_PROPERTY                                        Handle        = 0x80632072
-	PEER_E_INVALID_RECORD_EXPIRATION                                          Handle        = 0x80632080
-	PEER_E_INVALID_CREDENTIAL_INFO                                            Handle        = 0x80632081
-	PEER_E_INVALID_CREDENTIAL                                                 Handle        = 0x80632082
-	PEER_E_INVALID_RECORD_SIZE                                                Handle        = 0x80632083
-	PEER_E_UNSUPPORTED_VERSION                                                Handle        = 0x80632090
-	PEER_E_GROUP_NOT_READY                                                    Handle        = 0x80632091
-	PEER_E_GROUP_IN_USE                                                       Handle        = 0x80632092
-	PEER_E_INVALID_GROUP                                                      Handle        = 0x80632093
-	PEER_E_NO_MEMBERS_FOUND                                                   Handle        = 0x80632094
-	PEER_E_NO_MEMBER_CONNECTIONS                                              Handle        = 0x80632095
-	PEER_E_UNABLE_TO_LISTEN                                                   Handle        = 0x80632096
-	PEER_E_IDENTITY_DELETED                                                   Handle        = 0x806320A0
-	PEER_E_SERVICE_NOT_AVAILABLE                                              Handle        = 0x806320A1
-	PEER_E_CONTACT_NOT_FOUND                                                  Handle        = 0x80636001
-	PEER_S_GRAPH_DATA_CREATED                                                 Handle        = 0x00630001
-	PEER_S_NO_EVENT_DATA                                                      Handle        = 0x00630002
-	PEER_S_ALREADY_CONNECTED                                                  Handle        = 0x00632000
-	PEER_S_SUBSCRIPTION_EXISTS                                                Handle        = 0x00636000
-	PEER_S_NO_CONNECTIVITY                                                    Handle        = 0x00630005
-	PEER_S_ALREADY_A_MEMBER                                                   Handle        = 0x00630006
-	PEER_E_CANNOT_CONVERT_PEER_NAME                                           Handle        = 0x80634001
-	PEER_E_INVALID_PEER_HOST_NAME                                             Handle        = 0x80634002
-	PEER_E_NO_MORE                                                            Handle        = 0x80634003
-	PEER_E_PNRP_DUPLICATE_PEER_NAME                                           Handle        = 0x80634005
-	PEER_E_INVITE_CANCELLED                                                   Handle        = 0x80637000
-	PEER_E_INVITE_RESPONSE_NOT_AVAILABLE                                      Handle        = 0x80637001
-	PEER_E_NOT_SIGNED_IN                                                      Handle        = 0x80637003
-	PEER_E_PRIVACY_DECLINED                                                   Handle        = 0x80637004
-	PEER_E_TIMEOUT                                                            Handle        = 0x80637005
-	PEER_E_INVALID_ADDRESS                                                    Handle        = 0x80637007
-	PEER_E_FW_EXCEPTION_DISABLED                                              Handle        = 0x80637008
-	PEER_E_FW_BLOCKED_BY_POLICY                                               Handle        = 0x80637009
-	PEER_E_FW_BLOCKED_BY_SHIELDS_UP                                           Handle        = 0x8063700A
-	PEER_E_FW_DECLINED                                                        Handle        = 0x8063700B
-	UI_E_CREATE_FAILED                                                        Handle        = 0x802A0001
-	UI_E_SHUTDOWN_CALLED                                                      Handle        = 0x802A0002
-	UI_E_ILLEGAL_REENTRANCY                                                   Handle        = 0x802A0003
-	UI_E_OBJECT_SEALED                                                        Handle        = 0x802A0004
-	UI_E_VALUE_NOT_SET                                                        Handle        = 0x802A0005
-	UI_E_VALUE_NOT_DETERMINED                                                 Handle        = 0x802A0006
-	UI_E_INVALID_OUTPUT                                                       Handle        = 0x802A0007
-	UI_E_BOOLEAN_EXPECTED                                                     Handle        = 0x802A0008
-	UI_E_DIFFERENT_OWNER                                                      Handle        = 0x802A0009
-	UI_E_AMBIGUOUS_MATCH                                                      Handle        = 0x802A000A
-	UI_E_FP_OVERFLOW                                                          Handle        = 0x802A000B
-	UI_E_WRONG_THREAD                                                         Handle        = 0x802A000C
-	UI_E_STORYBOARD_ACTIVE                                                    Handle        = 0x802A0101
-	UI_E_STORYBOARD_NOT_PLAYING                                               Handle        = 0x802A0102
-	UI_E_START_KEYFRAME_AFTER_END                                             Handle        = 0x802A0103
-	UI_E_END_KEYFRAME_NOT_DETERMINED                                          Handle        = 0x802A0104
-	UI_E_LOOPS_OVERLAP                                                        Handle        = 0x802A0105
-	UI_E_TRANSITION_ALREADY_USED                                              Handle        = 0x802A0106
-	UI_E_TRANSITION_NOT_IN_STORYBOARD                                         Handle        = 0x802A0107
-	UI_E_TRANSITION_ECLIPSED                                                  Handle        = 0x802A0108
-	UI_E_TIME_BEFORE_LAST_UPDATE                                              Handle        = 0x802A0109
-	UI_E_TIMER_CLIENT_ALREADY_CONNECTED                                       Handle        = 0x802A010A
-	UI_E_INVALID_DIMENSION                                                    Handle        = 0x802A010B
-	UI_E_PRIMITIVE_OUT_OF_BOUNDS                                              Handle        = 0x802A010C
-	UI_E_WINDOW_CLOSED                                                        Handle        = 0x802A0201
-	E_BLUETOOTH_ATT_INVALID_HANDLE                                            Handle        = 0x80650001
-	E_BLUETOOTH_ATT_READ_NOT_PERMITTED                                        Handle        = 0x80650002
-	E_BLUETOOTH_ATT_WRITE_NOT_PERMITTED                                       Handle        = 0x80650003
-	E_BLUETOOTH_ATT_INVALID_PDU                                               Handle        = 0x80650004
-	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHENTICATION                               Handle        = 0x80650005
-	E_BLUETOOTH_ATT_REQUEST_NOT_SUPPORTED                                     Handle        = 0x80650006
-	E_BLUETOOTH_ATT_INVALID_OFFSET                                            Handle        = 0x80650007
-	E_BLUETOOTH_ATT_INSUFFICIENT_AUTHORIZATION                                Handle        = 0x80650008
-	E_BLUETOOTH_ATT_PREPARE_QUEUE_FULL                                        Handle        = 0x80650009
-	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_FOUND                                       Handle        = 0x8065000A
-	E_BLUETOOTH_ATT_ATTRIBUTE_NOT_LONG                                        Handle        = 0x8065000B
-	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE                          Handle        = 0x8065000C
-	E_BLUETOOTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH                            Handle        = 0x8065000D
-	E_BLUETOOTH_ATT_UNLIKELY                                                  Handle        = 0x8065000E
-	E_BLUETOOTH_ATT_INSUFFICIENT_ENCRYPTION                                   Handle        = 0x8065000F
-	E_BLUETOOTH_ATT_UNSUPPORTED_GROUP_TYPE                                    Handle        = 0x80650010
-	E_BLUETOOTH_ATT_INSUFFICIENT_RESOURCES                                    Handle        = 0x80650011
-	E_BLUETOOTH_ATT_UNKNOWN_ERROR                                             Handle        = 0x80651000
-	E_AUDIO_ENGINE_NODE_NOT_FOUND                                             Handle        = 0x80660001
-	E_HDAUDIO_EMPTY_CONNECTION_LIST                                           Handle        = 0x80660002
-	E_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED                                   Handle        = 0x80660003
-	E_HDAUDIO_NO_LOGICAL_DEVICES_CREATED                                      Handle        = 0x80660004
-	E_HDAUDIO_NULL_LINKED_LIST_ENTRY                                          Handle        = 0x80660005
-	STATEREPOSITORY_E_CONCURRENCY_LOCKING_FAILURE                             Handle        = 0x80670001
-	STATEREPOSITORY_E_STATEMENT_INPROGRESS                                    Handle        = 0x80670002
-	STATEREPOSITORY_E_CONFIGURATION_INVALID                                   Handle        = 0x80670003
-	STATEREPOSITORY_E_UNKNOWN_SCHEMA_VERSION                                  Handle        = 0x80670004
-	STATEREPOSITORY_ERROR_DICTIONARY_CORRUPTED                                Handle        = 0x80670005
-	STATEREPOSITORY_E_BLOCKED                                                 Handle        = 0x80670006
-	STATEREPOSITORY_E_BUSY_RETRY                                              Handle        = 0x80670007
-	STATEREPOSITORY_E_BUSY_RECOVERY_RETRY                                     Handle        = 0x80670008
-	STATEREPOSITORY_E_LOCKED_RETRY                                            Handle        = 0x80670009
-	STATEREPOSITORY_E_LOCKED_SHAREDCACHE_RETRY                                Handle        = 0x8067000A
-	STATEREPOSITORY_E_TRANSACTION_REQUIRED                                    Handle        = 0x8067000B
-	STATEREPOSITORY_E_BUSY_TIMEOUT_EXCEEDED                                   Handle        = 0x8067000C
-	STATEREPOSITORY_E_BUSY_RECOVERY_TIMEOUT_EXCEEDED                          Handle        = 0x8067000D
-	STATEREPOSITORY_E_LOCKED_TIMEOUT_EXCEEDED                                 Handle        = 0x8067000E
-	STATEREPOSITORY_E_LOCKED_SHAREDCACHE_TIMEOUT_EXCEEDED                     Handle        = 0x8067000F
-	STATEREPOSITORY_E_SERVICE_STOP_IN_PROGRESS                                Handle        = 0x80670010
-	STATEREPOSTORY_E_NESTED_TRANSACTION_NOT_SUPPORTED                         Handle        = 0x80670011
-	STATEREPOSITORY_ERROR_CACHE_CORRUPTED                                     Handle        = 0x80670012
-	STATEREPOSITORY_TRANSACTION_CALLER_ID_CHANGED                             Handle        = 0x00670013
-	STATEREPOSITORY_TRANSACTION_IN_PROGRESS                                   Handle        = 0x00670014
-	ERROR_SPACES_POOL_WAS_DELETED                                             Handle        = 0x00E70001
-	ERROR_SPACES_FAULT_DOMAIN_TYPE_INVALID                                    Handle        = 0x80E70001
-	ERROR_SPACES_INTERNAL_ERROR                                               Handle        = 0x80E70002
-	ERROR_SPACES_RESILIENCY_TYPE_INVALID                                      Handle        = 0x80E70003
-	ERROR_SPACES_DRIVE_SECTOR_SIZE_INVALID                                    Handle        = 0x80E70004
-	ERROR_SPACES_DRIVE_REDUNDANCY_INVALID                                     Handle        = 0x80E70006
-	ERROR_SPACES_NUMBER_OF_DATA_COPIES_INVALID                                Handle        = 0x80E70007
-	ERROR_SPACES_PARITY_LAYOUT_INVALID                                        Handle        = 0x80E70008
-	ERROR_SPACES_INTERLEAVE_LENGTH_INVALID                                    Handle        = 0x80E70009
-	ERROR_SPACES_NUMBER_OF_COLUMNS_INVALID                                    Handle        = 0x80E7000A
-	ERROR_SPACES_NOT_ENOUGH_DRIVES                                            Handle        = 0x80E7000B
-	ERROR_SPACES_EXTENDED_ERROR                                               Handle        = 0x80E7000C
-	ERROR_SPACES_PROVISIONING_TYPE_INVALID                                    Handle        = 0x80E7000D
-	ERROR_SPACES_ALLOCATION_SIZE_INVALID                                      Handle        = 0x80E7000E
-	ERROR_SPACES_ENCLOSURE_AWARE_INVALID                                      Handle        = 0x80E7000F
-	ERROR_SPACES_WRITE_CACHE_SIZE_INVALID                                     Handle        = 0x80E70010
-	ERROR_SPACES_NUMBER_OF_GROUPS_INVALID                                     Handle        = 0x80E70011
-	ERROR_SPACES_DRIVE_OPERATIONAL_STATE_INVALID                              Handle        = 0x80E70012
-	ERROR_SPACES_ENTRY_INCOMPLETE                                             Handle        = 0x80E70013
-	ERROR_SPACES_ENTRY_INVALID                                                Handle        = 0x80E70014
-	ERROR_VOLSNAP_BOOTFILE_NOT_VALID                                          Handle        = 0x80820001
-	ERROR_VOLSNAP_ACTIVATION_TIMEOUT                                          Handle        = 0x80820002
-	ERROR_TIERING_NOT_SUPPORTED_ON_VOLUME                                     Handle        = 0x80830001
-	ERROR_TIERING_VOLUME_DISMOUNT_IN_PROGRESS                                 Handle        = 0x80830002
-	ERROR_TIERING_STORAGE_TIER_NOT_FOUND                                      Handle        = 0x80830003
-	ERROR_TIERING_INVALID_FILE_ID                                             Handle        = 0x80830004
-	ERROR_TIERING_WRONG_CLUSTER_NODE                                          Handle        = 0x80830005
-	ERROR_TIERING_ALREADY_PROCESSING                                          Handle        = 0x80830006
-	ERROR_TIERING_CANNOT_PIN_OBJECT                                           Handle        = 0x80830007
-	ERROR_TIERING_FILE_IS_NOT_PINNED                                          Handle        = 0x80830008
-	ERROR_NOT_A_TIERED_VOLUME                                                 Handle        = 0x80830009
-	ERROR_ATTRIBUTE_NOT_PRESENT                                               Handle        = 0x8083000A
-	ERROR_SECCORE_INVALID_COMMAND                                             Handle        = 0xC0E80000
-	ERROR_NO_APPLICABLE_APP_LICENSES_FOUND                                    Handle        = 0xC0EA0001
-	ERROR_CLIP_LICENSE_NOT_FOUND                                              Handle        = 0xC0EA0002
-	ERROR_CLIP_DEVICE_LICENSE_MISSING                                         Handle        = 0xC0EA0003
-	ERROR_CLIP_LICENSE_INVALID_SIGNATURE                                      Handle        = 0xC0EA0004
-	ERROR_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID                           Handle        = 0xC0EA0005
-	ERROR_CLIP_LICENSE_EXPIRED                                                Handle        = 0xC0EA0006
-	ERROR_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE                               Handle        = 0xC0EA0007
-	ERROR_CLIP_LICENSE_NOT_SIGNED                                             Handle        = 0xC0EA0008
-	ERROR_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE                           Handle        = 0xC0EA0009
-	ERROR_CLIP_LICENSE_DEVICE_ID_MISMATCH                                     Handle        = 0xC0EA000A
-	DXGI_STATUS_OCCLUDED                                                      Handle        = 0x087A0001
-	DXGI_STATUS_CLIPPED                                                       Handle        = 0x087A0002
-	DXGI_STATUS_NO_REDIRECTION                                                Handle        = 0x087A0004
-	DXGI_STATUS_NO_DESKTOP_ACCESS                                             Handle        = 0x087A0005
-	DXGI_STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE                                  Handle        = 0x087A0006
-	DXGI_STATUS_MODE_CHANGED                                                  Handle        = 0x087A0007
-	DXGI_STATUS_MODE_CHANGE_IN_PROGRESS                                       Handle        = 0x087A0008
-	DXGI_ERROR_INVALID_CALL                                                   Handle        = 0x887A0001
-	DXGI_ERROR_NOT_FOUND                                                      Handle        = 0x887A0002
-	DXGI_ERROR_MORE_DATA                                                      Handle        = 0x887A0003
-	DXGI_ERROR_UNSUPPORTED                                                    Handle        = 0x887A0004
-	DXGI_ERROR_DEVICE_REMOVED                                                 Handle        = 0x887A0005
-	DXGI_ERROR_DEVICE_HUNG                                                    Handle        = 0x887A0006
-	DXGI_ERROR_DEVICE_RESET                                                   Handle        = 0x887A0007
-	DXGI_ERROR_WAS_STILL_DRAWING                                              Handle        = 0x887A000A
-	DXGI_ERROR_FRAME_STATISTICS_DISJOINT                                      Handle        = 0x887A000B
-	DXGI_ERROR_GRAPHICS_VIDPN_SOURCE_IN_USE                                   Handle        = 0x887A000C
-	DXGI_ERROR_DRIVER_INTERNAL_ERROR                                          Handle        = 0x887A0020
-	DXGI_ERROR_NONEXCLUSIVE                                                   Handle        = 0x887A0021
-	DXGI_ERROR_NOT_CURRENTLY_AVAILABLE                                        Handle        = 0x887A0022
-	DXGI_ERROR_REMOTE_CLIENT_DISCONNECTED                                     Handle        = 0x887A0023
-	DXGI_ERROR_REMOTE_OUTOFMEMORY                                             Handle        = 0x887A0024
-	DXGI_ERROR_ACCESS_LOST                                                    Handle        = 0x887A0026
-	DXGI_ERROR_WAIT_TIMEOUT                                                   Handle        = 0x887A0027
-	DXGI_ERROR_SESSION_DISCONNECTED                                           Handle        = 0x887A0028
-	DXGI_ERROR_RESTRICT_TO_OUTPUT_STALE                                       Handle        = 0x887A0029
-	DXGI_ERROR_CANNOT_PROTECT_CONTENT                                         Handle        = 0x887A002A
-	DXGI_ERROR_ACCESS_DENIED                                                  Handle        = 0x887A002B
-	DXGI_ERROR_NAME_ALREADY_EXISTS                                            Handle        = 0x887A002C
-	DXGI_ERROR_SDK_COMPONENT_MISSING                                          Handle        = 0x887A002D
-	DXGI_ERROR_NOT_CURRENT                                                    Handle        = 0x887A002E
-	DXGI_ERROR_HW_PROTECTION_OUTOFMEMORY                                      Handle        = 0x887A0030
-	DXGI_ERROR_DYNAMIC_CODE_POLICY_VIOLATION                                  Handle        = 0x887A0031
-	DXGI_ERROR_NON_COMPOSITED_UI                                              Handle        = 0x887A0032
-	DXGI_STATUS_UNOCCLUDED                                                    Handle        = 0x087A0009
-	DXGI_STATUS_DDA_WAS_STILL_DRAWING                                         Handle        = 0x087A000A
-	DXGI_ERROR_MODE_CHANGE_IN_PROGRESS                                        Handle        = 0x887A0025
-	DXGI_STATUS_PRESENT_REQUIRED                                              Handle        = 0x087A002F
-	DXGI_ERROR_CACHE_CORRUPT                                                  Handle        = 0x887A0033
-	DXGI_ERROR_CACHE_FULL                                                     Handle        = 0x887A0034
-	DXGI_ERROR_CACHE_HASH_COLLISION                                           Handle        = 0x887A0035
-	DXGI_ERROR_ALREADY_EXISTS                                                 Handle        = 0x887A0036
-	DXGI_DDI_ERR_WASSTILLDRAWING                                              Handle        = 0x887B0001
-	DXGI_DDI_ERR_UNSUPPORTED                                                  Handle        = 0x887B0002
-	DXGI_DDI_ERR_NONEXCLUSIVE                                                 Handle        = 0x887B0003
-	D3D10_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS                                 Handle        = 0x88790001
-	D3D10_ERROR_FILE_NOT_FOUND                                                Handle        = 0x88790002
-	D3D11_ERROR_TOO_MANY_UNIQUE_STATE_OBJECTS                                 Handle        = 0x887C0001
-	D3D11_ERROR_FILE_NOT_FOUND                                                Handle        = 0x887C0002
-	D3D11_ERROR_TOO_MANY_UNIQUE_VIEW_OBJECTS                                  Handle        = 0x887C0003
-	D3D11_ERROR_DEFERRED_CONTEXT_MAP_WITHOUT_INITIAL_DISCARD                  Handle        = 0x887C0004
-	D3D12_ERROR_ADAPTER_NOT_FOUND                                             Handle        = 0x887E0001
-	D3D12_ERROR_DRIVER_VERSION_MISMATCH                                       Handle        = 0x887E0002
-	D2DERR_WRONG_STATE                                                        Handle        = 0x88990001
-	D2DERR_NOT_INITIALIZED                                                    Handle        = 0x88990002
-	D2DERR_UNSUPPORTED_OPERATION                                              Handle        = 0x88990003
-	D2DERR_SCANNER_FAILED                                                     Handle        = 0x88990004
-	D2DERR_SCREEN_ACCESS_DENIED                                               Handle        = 0x88990005
-	D2DERR_DISPLAY_STATE_INVALID                                              Handle        = 0x88990006
-	D2DERR_ZERO_VECTOR                                                        Handle        = 0x88990007
-	D2DERR_INTERNAL_ERROR                                                     Handle        = 0x88990008
-	D2DERR_DISPLAY_FORMAT_NOT_SUPPORTED                                       Handle        = 0x88990009
-	D2DERR_INVALID_CALL                                                       Handle        = 0x8899000A
-	D2DERR_NO_HARDWARE_DEVICE                                                 Handle        = 0x8899000B
-	D2DERR_RECREATE_TARGET                                                    Handle        = 0x8899000C
-	D2DERR_TOO_MANY_SHADER_ELEMENTS                                           Handle        = 0x8899000D
-	D2DERR_SHADER_COMPILE_FAILED                                              Handle        = 0x8899000E
-	D2DERR_MAX_TEXTURE_SIZE_EXCEEDED                                          Handle        = 0x8899000F
-	D2DERR_UNSUPPORTED_VERSION                                                Handle        = 0x88990010
-	D2DERR_BAD_NUMBER                                                         Handle        = 0x88990011
-	D2DERR_WRONG_FACTORY                                                      Handle        = 0x88990012
-	D2DERR_LAYER_ALREADY_IN_USE                                               Handle        = 0x88990013
-	D2DERR_POP_CALL_DID_NOT_MATCH_PUSH                                        Handle        = 0x88990014
-	D2DERR_WRONG_RESOURCE_DOMAIN                                              Handle        = 0x88990015
-	D2DERR_PUSH_POP_UNBALANCED                                                Handle        = 0x88990016
-	D2DERR_RENDER_TARGET_HAS_LAYER_OR_CLIPRECT                                Handle        = 0x88990017
-	D2DERR_INCOMPATIBLE_BRUSH_TYPES                                           Handle        = 0x88990018
-	D2DERR_WIN32_ERROR                                                        Handle        = 0x88990019
-	D2DERR_TARGET_NOT_GDI_COMPATIBLE                                          Handle        = 0x8899001A
-	D2DERR_TEXT_EFFECT_IS_WRONG_TYPE                                          Handle        = 0x8899001B
-	D2DERR_TEXT_RENDERER_NOT_RELEASED                                         Handle        = 0x8899001C
-	D2DERR_EXCEEDS_MAX_BITMAP_SIZE                                            Handle        = 0x8899001D
-	D2DERR_INVALID_GRAPH_CONFIGURATION                                        Handle        = 0x8899001E
-	D2DERR_INVALID_INTERNAL_GRAPH_CONFIGURATION                               Handle        = 0x8899001F
-	D2DERR_CYCLIC_GRAPH                                                       Handle        = 0x88990020
-	D2DERR_BITMAP_CANNOT_DRAW                                                 Handle        = 0x88990021
-	D2DERR_OUTSTANDING_BITMAP_REFERENCES                                      Handle        = 0x88990022
-	D2DERR_ORIGINAL_TARGET_NOT_BOUND                                          Handle        = 0x88990023
-	D2DERR_INVALID_TARGET                                                     Handle        = 0x88990024
-	D2DERR_BITMAP_BOUND_AS_TARGET                                             Handle        = 0x88990025
-	D2DERR_INSUFFICIENT_DEVICE_CAPABILITIES                                   Handle        = 0x88990026
-	D2DERR_INTERMEDIATE_TOO_LARGE                                             Handle        = 0x88990027
-	D2DERR_EFFECT_IS_NOT_REGISTERED                                           Handle        = 0x88990028
-	D2DERR_INVALID_PROPERTY                                                   Handle        = 0x88990029
-	D2DERR_NO_SUBPROPERTIES                                                   Handle        = 0x8899002A
-	D2DERR_PRINT_JOB_CLOSED                                                   Handle        = 0x8899002B
-	D2DERR_PRINT_FORMAT_NOT_SUPPORTED                                         Handle        = 0x8899002C
-	D2DERR_TOO_MANY_TRANSFORM_INPUTS                                          Handle        = 0x8899002D
-	D2DERR_INVALID_GLYPH_IMAGE                                                Handle        = 0x8899002E
-	DWRITE_E_FILEFORMAT                                                       Handle        = 0x88985000
-	DWRITE_E_UNEXPECTED                                                       Handle        = 0x88985001
-	DWRITE_E_NOFONT                                                           Handle        = 0x88985002
-	DWRITE_E_FILENOTFOUND                                                     Handle        = 0x88985003
-	DWRITE_E_FILEACCESS                                                       Handle        = 0x88985004
-	DWRITE_E_FONTCOLLECTIONOBSOLETE                                           Handle        = 0x88985005
-	DWRITE_E_ALREADYREGISTERED                                                Handle        = 0x88985006
-	DWRITE_E_CACHEFORMAT                                                      Handle        = 0x88985007
-	DWRITE_E_CACHEVERSION                                                     Handle        = 0x88985008
-	DWRITE_E_UNSUPPORTEDOPERATION                                             Handle        = 0x88985009
-	DWRITE_E_TEXTRENDERERINCOMPATIBLE                                         Handle        = 0x8898500A
-	DWRITE_E_FLOWDIRECTIONCONFLICTS                                           Handle        = 0x8898500B
-	DWRITE_E_NOCOLOR                                                          Handle        = 0x8898500C
-	DWRITE_E_REMOTEFONT                                                       Handle        = 0x8898500D
-	DWRITE_E_DOWNLOADCANCELLED                                                Handle        = 0x8898500E
-	DWRITE_E_DOWNLOADFAILED                                                   Handle        = 0x8898500F
-	DWRITE_E_TOOMANYDOWNLOADS                                                 Handle        = 0x88985010
-	WINCODEC_ERR_WRONGSTATE                                                   Handle        = 0x88982F04
-	WINCODEC_ERR_VALUEOUTOFRANGE                                              Handle        = 0x88982F05
-	WINCODEC_ERR_UNKNOWNIMAGEFORMAT                                           Handle        = 0x88982F07
-	WINCODEC_ERR_UNSUPPORTEDVERSION                                           Handle        = 0x88982F0B
-	WINCODEC_ERR_NOTINITIALIZED                                               Handle        = 0x88982F0C
-	WINCODEC_ERR_ALREADYLOCKED                                                Handle        = 0x88982F0D
-	WINCODEC_ERR_PROPERTYNOTFOUND                                             Handle        = 0x88982F40
-	WINCODEC_ERR_PROPERTYNOTSUPPORTED                                         Handle        = 0x88982F41
-	WINCODEC_ERR_PROPERTYSIZE                                                 Handle        = 0x88982F42
-	WINCODEC_ERR_CODECPRESENT                                                 Handle        = 0x88982F43
-	WINCODEC_ERR_CODECNOTHUMBNAIL                                             Handle        = 0x88982F44
-	WINCODEC_ERR_PALETTEUNAVAILABLE                                           Handle        = 0x88982F45
-	WINCODEC_ERR_CODECTOOMANYSCANLINES                                        Handle        = 0x88982F46
-	WINCODEC_ERR_INTERNALERROR                                                Handle        = 0x88982F48
-	WINCODEC_ERR_SOURCERECTDOESNOTMATCHDIMENSIONS                             Handle        = 0x88982F49
-	WINCODEC_ERR_COMPONENTNOTFOUND                                            Handle        = 0x88982F50
-	WINCODEC_ERR_IMAGESIZEOUTOFRANGE                                          Handle        = 0x88982F51
-	WINCODEC_ERR_TOOMUCHMETADATA                                              Handle        = 0x88982F52
-	WINCODEC_ERR_BADIMAGE                                                     Handle        = 0x88982F60
-	WINCODEC_ERR_BADHEADER                                                    Handle        = 0x88982F61
-	WINCODEC_ERR_FRAMEMISSING                                                 Handle        = 0x88982F62
-	WINCODEC_ERR_BADMETADATAHEADER                                            Handle        = 0x88982F63
-	WINCODEC_ERR_BADSTREAMDATA                                                Handle        = 0x88982F70
-	WINCODEC_ERR_STREAMWRITE                                                  Handle        = 0x88982F71
-	WINCODEC_ERR_STREAMREAD                                                   Handle        = 0x88982F72
-	WINCODEC_ERR_STREAMNOTAVAILABLE                                           Handle        = 0x88982F73
-	WINCODEC_ERR_UNSUPPORTEDPIXELFORMAT                                       Handle        = 0x88982F80
-	WINCODEC_ERR_UNSUPPORTEDOPERATION                                         Handle        = 0x88982F81
-	WINCODEC_ERR_INVALIDREGISTRATION                                          Handle        = 0x88982F8A
-	WINCODEC_ERR_COMPONENTINITIALIZEFAILURE                                   Handle        = 0x88982F8B
-	WINCODEC_ERR_INSUFFICIENTBUFFER                                           Handle        = 0x88982F8C
-	WINCODEC_ERR_DUPLICATEMETADATAPRESENT                                     Handle        = 0x88982F8D
-	WINCODEC_ERR_PROPERTYUNEXPECTEDTYPE                                       Handle        = 0x88982F8E
-	WINCODEC_ERR_UNEXPECTEDSIZE                                               Handle        = 0x88982F8F
-	WINCODEC_ERR_INVALIDQUERYREQUEST                                          Handle        = 0x88982F90
-	WINCODEC_ERR_UNEXPECTEDMETADATATYPE                                       Handle        = 0x88982F91
-	WINCODEC_ERR_REQUESTONLYVALIDATMETADATAROOT                               Handle        = 0x88982F92
-	WINCODEC_ERR_INVALIDQUERYCHARACTER                                        Handle        = 0x88982F93
-	WINCODEC_ERR_WIN32ERROR                                                   Handle        = 0x88982F94
-	WINCODEC_ERR_INVALIDPROGRESSIVELEVEL                                      Handle        = 0x88982F95
-	WINCODEC_ERR_INVALIDJPEGSCANINDEX                                         Handle        = 0x88982F96
-	MILERR_OBJECTBUSY                                                         Handle        = 0x88980001
-	MILERR_INSUFFICIENTBUFFER                                                 Handle        = 0x88980002
-	MILERR_WIN32ERROR                                                         Handle        = 0x88980003
-	MILERR_SCANNER_FAILED                                                     Handle        = 0x88980004
-	MILERR_SCREENACCESSDENIED                                                 Handle        = 0x88980005
-	MILERR_DISPLAYSTATEINVALID                                                Handle        = 0x88980006
-	MILERR_NONINVERTIBLEMATRIX                                                Handle        = 0x88980007
-	MILERR_ZEROVECTOR                                                         Handle        = 0x88980008
-	MILERR_TERMINATED                                                         Handle        = 0x88980009
-	MILERR_BADNUMBER                                                          Handle        = 0x8898000A
-	MILERR_INTERNALERROR                                                      Handle        = 0x88980080
-	MILERR_DISPLAYFORMATNOTSUPPORTED                                          Handle        = 0x88980084
-	MILERR_INVALIDCALL                                                        Handle        = 0x88980085
-	MILERR_ALREADYLOCKED                                                      Handle        = 0x88980086
-	MILERR_NOTLOCKED                                                          Handle        = 0x88980087
-	MILERR_DEVICECANNOTRENDERTEXT                                             Handle        = 0x88980088
-	MILERR_GLYPHBITMAPMISSED                                                  Handle        = 0x88980089
-	MILERR_MALFORMEDGLYPHCACHE                                                Handle        = 0x8898008A
-	MILERR_GENERIC_IGNORE                                                     Handle        = 0x8898008B
-	MILERR_MALFORMED_GUIDELINE_DATA                                           Handle        = 0x8898008C
-	MILERR_NO_HARDWARE_DEVICE                                                 Handle        = 0x8898008D
-	MILERR_NEED_RECREATE_AND_PRESENT                                          Handle        = 0x8898008E
-	MILERR_ALREADY_INITIALIZED                                                Handle        = 0x8898008F
-	MILERR_MISMATCHED_SIZE                                                    Handle        = 0x88980090
-	MILERR_NO_REDIRECTION_SURFACE_AVAILABLE                                   Handle        = 0x88980091
-	MILERR_REMOTING_NOT_SUPPORTED                                             Handle        = 0x88980092
-	MILERR_QUEUED_PRESENT_NOT_SUPPORTED                                       Handle        = 0x88980093
-	MILERR_NOT_QUEUING_PRESENTS                                               Handle        = 0x88980094
-	MILERR_NO_REDIRECTION_SURFACE_RETRY_LATER                                 Handle        = 0x88980095
-	MILERR_TOOMANYSHADERELEMNTS                                               Handle        = 0x88980096
-	MILERR_MROW_READLOCK_FAILED                                               Handle        = 0x88980097
-	MILERR_MROW_UPDATE_FAILED                                                 Handle        = 0x88980098
-	MILERR_SHADER_COMPILE_FAILED                                              Handle        = 0x88980099
-	MILERR_MAX_TEXTURE_SIZE_EXCEEDED                                          Handle        = 0x8898009A
-	MILERR_QPC_TIME_WENT_BACKWARD                                             Handle        = 0x8898009B
-	MILERR_DXGI_ENUMERATION_OUT_OF_SYNC                                       Handle        = 0x8898009D
-	MILERR_ADAPTER_NOT_FOUND                                                  Handle        = 0x8898009E
-	MILERR_COLORSPACE_NOT_SUPPORTED                                           Handle        = 0x8898009F
-	MILERR_PREFILTER_NOT_SUPPORTED                                            Handle        = 0x889800A0
-	MILERR_DISPLAYID_ACCESS_DENIED                                            Handle        = 0x889800A1
-	UCEERR_INVALIDPACKETHEADER                                                Handle        = 0x88980400
-	UCEERR_UNKNOWNPACKET                                                      Handle        = 0x88980401
-	UCEERR_ILLEGALPACKET                                                      Handle        = 0x88980402
-	UCEERR_MALFORMEDPACKET                                                    Handle        = 0x88980403
-	UCEERR_ILLEGALHANDLE                                                      Handle        = 0x88980404
-	UCEERR_HANDLELOOKUPFAILED                                                 Handle        = 0x88980405
-	UCEERR_RENDERTHREADFAILURE                                                Handle        = 0x88980406
-	UCEERR_CTXSTACKFRSTTARGETNULL                                             Handle        = 0x88980407
-	UCEERR_CONNECTIONIDLOOKUPFAILED                                           Handle        = 0x88980408
-	UCEERR_BLOCKSFULL                                                         Handle        = 0x88980409
-	UCEERR_MEMORYFAILURE                                                      Handle        = 0x8898040A
-	UCEERR_PACKETRECORDOUTOFRANGE                                             Handle        = 0x8898040B
-	UCEERR_ILLEGALRECORDTYPE                                                  Handle        = 0x8898040C
-	UCEERR_OUTOFHANDLES                                                       Handle        = 0x8898040D
-	UCEERR_UNCHANGABLE_UPDATE_ATTEMPTED                                       Handle        = 0x8898040E
-	UCEERR_NO_MULTIPLE_WORKER_THREADS                                         Handle        = 0x8898040F
-	UCEERR_REMOTINGNOTSUPPORTED                                               Handle        = 0x88980410
-	UCEERR_MISSINGENDCOMMAND                                                  Handle        = 0x88980411
-	UCEERR_MISSINGBEGINCOMMAND                                                Handle        = 0x88980412
-	UCEERR_CHANNELSYNCTIMEDOUT                                                Handle        = 0x88980413
-	UCEERR_CHANNELSYNCABANDONED                                               Handle        = 0x88980414
-	UCEERR_UNSUPPORTEDTRANSPORTVERSION                                        Handle        = 0x88980415
-	UCEERR_TRANSPORTUNAVAILABLE                                               Handle        = 0x88980416
-	UCEERR_FEEDBACK_UNSUPPORTED                                               Handle        = 0x88980417
-	UCEERR_COMMANDTRANSPORTDENIED                                             Handle        = 0x88980418
-	UCEERR_GRAPHICSSTREAMUNAVAILABLE                                          Handle        = 0x88980419
-	UCEERR_GRAPHICSSTREAMALREADYOPEN                                          Handle        = 0x88980420
-	UCEERR_TRANSPORTDISCONNECTED                                              Handle        = 0x88980421
-	UCEERR_TRANSPORTOVERLOADED                                                Handle        = 0x88980422
-	UCEERR_PARTITION_ZOMBIED                                                  Handle        = 0x88980423
-	MILAVERR_NOCLOCK                                                          Handle        = 0x88980500
-	MILAVERR_NOMEDIATYPE                                                      Handle        = 0x88980501
-	MILAVERR_NOVIDEOMIXER                                                     Handle        = 0x88980502
-	MILAVERR_NOVIDEOPRESENTER                                                 Handle        = 0x88980503
-	MILAVERR_NOREADYFRAMES                                                    Handle        = 0x88980504
-	MILAVERR_MODULENOTLOADED                                                  Handle        = 0x88980505
-	MILAVERR_WMPFACTORYNOTREGISTERED                                          Handle        = 0x88980506
-	MILAVERR_INVALIDWMPVERSION                                                Handle        = 0x88980507
-	MILAVERR_INSUFFICIENTVIDEORESOURCES                                       Handle        = 0x88980508
-	MILAVERR_VIDEOACCELERATIONNOTAVAILABLE                                    Handle        = 0x88980509
-	MILAVERR_REQUESTEDTEXTURETOOBIG                                           Handle        = 0x8898050A
-	MILAVERR_SEEKFAILED                                                       Handle        = 0x8898050B
-	MILAVERR_UNEXPECTEDWMPFAILURE                                             Handle        = 0x8898050C
-	MILAVERR_MEDIAPLAYERCLOSED                                                Handle        = 0x8898050D
-	MILAVERR_UNKNOWNHARDWAREERROR                                             Handle        = 0x8898050E
-	MILEFFECTSERR_UNKNOWNPROPERTY                                             Handle        = 0x8898060E
-	MILEFFECTSERR_EFFECTNOTPARTOFGROUP                                        Handle        = 0x8898060F
-	MILEFFECTSERR_NOINPUTSOURCEATTACHED                                       Handle        = 0x88980610
-	MILEFFECTSERR_CONNECTORNOTCONNECTED                                       Handle        = 0x88980611
-	MILEFFECTSERR_CONNECTORNOTASSOCIATEDWITHEFFECT                            Handle        = 0x88980612
-	MILEFFECTSERR_RESERVED                                                    Handle        = 0x88980613
-	MILEFFECTSERR_CYCLEDETECTED                                               Handle        = 0x88980614
-	MILEFFECTSERR_EFFECTINMORETHANONEGRAPH                                    Handle        = 0x88980615
-	MILEFFECTSERR_EFFECTALREADYINAGRAPH                                       Handle        = 0x88980616
-	MILEFFECTSERR_EFFECTHASNOCHILDREN                                         Handle        = 0x88980617
-	MILEFFECTSERR_ALREADYATTACHEDTOLISTENER                                   Handle        = 0x88980618
-	MILEFFECTSERR_NOTAFFINETRANSFORM                                          Handle        = 0x88980619
-	MILEFFECTSERR_EMPTYBOUNDS                                                 Handle        = 0x8898061A
-	MILEFFECTSERR_OUTPUTSIZETOOLARGE                                          Handle        = 0x8898061B
-	DWMERR_STATE_TRANSITION_FAILED                                            Handle        = 0x88980700
-	DWMERR_THEME_FAILED                                                       Handle        = 0x88980701
-	DWMERR_CATASTROPHIC_FAILURE                                               Handle        = 0x88980702
-	DCOMPOSITION_ERROR_WINDOW_ALREADY_COMPOSED                                Handle        = 0x88980800
-	DCOMPOSITION_ERROR_SURFACE_BEING_RENDERED                                 Handle        = 0x88980801
-	DCOMPOSITION_ERROR_SURFACE_NOT_BEING_RENDERED                             Handle        = 0x88980802
-	ONL_E_INVALID_AUTHENTICATION_TARGET                                       Handle        = 0x80860001
-	ONL_E_ACCESS_DENIED_BY_TOU                                                Handle        = 0x80860002
-	ONL_E_INVALID_APPLICATION                                                 Handle        = 0x80860003
-	ONL_E_PASSWORD_UPDATE_REQUIRED                                            Handle        = 0x80860004
-	ONL_E_ACCOUNT_UPDATE_REQUIRED                                             Handle        = 0x80860005
-	ONL_E_FORCESIGNIN                                                         Handle        = 0x80860006
-	ONL_E_ACCOUNT_LOCKED                                                      Handle        = 0x80860007
-	ONL_E_PARENTAL_CONSENT_REQUIRED                                           Handle        = 0x80860008
-	ONL_E_EMAIL_VERIFICATION_REQUIRED                                         Handle        = 0x80860009
-	ONL_E_ACCOUNT_SUSPENDED_COMPROIMISE                                       Handle        = 0x8086000A
-	ONL_E_ACCOUNT_SUSPENDED_ABUSE                                             Handle        = 0x8086000B
-	ONL_E_ACTION_REQUIRED                                                     Handle        = 0x8086000C
-	ONL_CONNECTION_COUNT_LIMIT                                                Handle        = 0x8086000D
-	ONL_E_CONNECTED_ACCOUNT_CAN_NOT_SIGNOUT                                   Handle        = 0x8086000E
-	ONL_E_USER_AUTHENTICATION_REQUIRED                                        Handle        = 0x8086000F
-	ONL_E_REQUEST_THROTTLED                                                   Handle        = 0x80860010
-	FA_E_MAX_PERSISTED_ITEMS_REACHED                                          Handle        = 0x80270220
-	FA_E_HOMEGROUP_NOT_AVAILABLE                                              Handle        = 0x80270222
-	E_MONITOR_RESOLUTION_TOO_LOW                                              Handle        = 0x80270250
-	E_ELEVATED_ACTIVATION_NOT_SUPPORTED                                       Handle        = 0x80270251
-	E_UAC_DISABLED                                                            Handle        = 0x80270252
-	E_FULL_ADMIN_NOT_SUPPORTED                                                Handle        = 0x80270253
-	E_APPLICATION_NOT_REGISTERED                                              Handle        = 0x80270254
-	E_MULTIPLE_EXTENSIONS_FOR_APPLICATION                                     Handle        = 0x80270255
-	E_MULTIPLE_PACKAGES_FOR_FAMILY                                            Handle        = 0x80270256
-	E_APPLICATION_MANAGER_NOT_RUNNING                                         Handle        = 0x80270257
-	S_STORE_LAUNCHED_FOR_REMEDIATION                                          Handle        = 0x00270258
-	S_APPLICATION_ACTIVATION_ERROR_HANDLED_BY_DIALOG                          Handle        = 0x00270259
-	E_APPLICATION_ACTIVATION_TIMED_OUT                                        Handle        = 0x8027025A
-	E_APPLICATION_ACTIVATION_EXEC_FAILURE                                     Handle        = 0x8027025B
-	E_APPLICATION_TEMPORARY_LICENSE_ERROR                                     Handle        = 0x8027025C
-	E_APPLICATION_TRIAL_LICENSE_EXPIRED                                       Handle        = 0x8027025D
-	E_SKYDRIVE_ROOT_TARGET_FILE_SYSTEM_NOT_SUPPORTED                          Handle        = 0x80270260
-	E_SKYDRIVE_ROOT_TARGET_OVERLAP                                            Handle        = 0x80270261
-	E_SKYDRIVE_ROOT_TARGET_CANNOT_INDEX                                       Handle        = 0x80270262
-	E_SKYDRIVE_FILE_NOT_UPLOADED                                              Handle        = 0x80270263
-	E_SKYDRIVE_UPDATE_AVAILABILITY_FAIL                                       Handle        = 0x80270264
-	E_SKYDRIVE_ROOT_TARGET_VOLUME_ROOT_NOT_SUPPORTED                          Handle        = 0x80270265
-	E_SYNCENGINE_FILE_SIZE_OVER_LIMIT                                         Handle        = 0x8802B001
-	E_SYNCENGINE_FILE_SIZE_EXCEEDS_REMAINING_QUOTA                            Handle        = 0x8802B002
-	E_SYNCENGINE_UNSUPPORTED_FILE_NAME                                        Handle        = 0x8802B003
-	E_SYNCENGINE_FOLDER_ITEM_COUNT_LIMIT_EXCEEDED                             Handle        = 0x8802B004
-	E_SYNCENGINE_FILE_SYNC_PARTNER_ERROR                                      Handle        = 0x8802B005
-	E_SYNCENGINE_SYNC_PAUSED_BY_SERVICE                                       Handle        = 0x8802B006
-	E_SYNCENGINE_FILE_IDENTIFIER_UNKNOWN                                      Handle        = 0x8802C002
-	E_SYNCENGINE_SERVICE_AUTHENTICATION_FAILED                                Handle        = 0x8802C003
-	E_SYNCENGINE_UNKNOWN_SERVICE_ERROR                                        Handle        = 0x8802C004
-	E_SYNCENGINE_SERVICE_RETURNED_UNEXPECTED_SIZE                             Handle        = 0x8802C005
-	E_SYNCENGINE_REQUEST_BLOCKED_BY_SERVICE                                   Handle        = 0x8802C006
-	E_SYNCENGINE_REQUEST_BLOCKED_DUE_TO_CLIENT_ERROR                          Handle        = 0x8802C007
-	E_SYNCENGINE_FOLDER_INACCESSIBLE                                          Handle        = 0x8802D001
-	E_SYNCENGINE_UNSUPPORTED_FOLDER_NAME                                      Handle        = 0x8802D002
-	E_SYNCENGINE_UNSUPPORTED_MARKET                                           Handle        = 0x8802D003
-	E_SYNCENGINE_PATH_LENGTH_LIMIT_EXCEEDED                                   Handle        = 0x8802D004
-	E_SYNCENGINE_REMOTE_PATH_LENGTH_LIMIT_EXCEEDED                            Handle        = 0x8802D005
-	E_SYNCENGINE_CLIENT_UPDATE_NEEDED                                         Handle        = 0x8802D006
-	E_SYNCENGINE_PROXY_AUTHENTICATION_REQUIRED                                Handle        = 0x8802D007
-	E_SYNCENGINE_STORAGE_SERVICE_PROVISIONING_FAILED                          Handle        = 0x8802D008
-	E_SYNCENGINE_UNSUPPORTED_REPARSE_POINT                                    Handle        = 0x8802D009
-	E_SYNCENGINE_STORAGE_SERVICE_BLOCKED                                      Handle        = 0x8802D00A
-	E_SYNCENGINE_FOLDER_IN_REDIRECTION                                        Handle        = 0x8802D00B
-	EAS_E_POLICY_NOT_MANAGED_BY_OS                                            Handle        = 0x80550001
-	EAS_E_POLICY_COMPLIANT_WITH_ACTIONS                                       Handle        = 0x80550002
-	EAS_E_REQUESTED_POLICY_NOT_ENFORCEABLE                                    Handle        = 0x80550003
-	EAS_E_CURRENT_USER_HAS_BLANK_PASSWORD                                     Handle        = 0x80550004
-	EAS_E_REQUESTED_POLICY_PASSWORD_EXPIRATION_INCOMPATIBLE                   Handle        = 0x80550005
-	EAS_E_USER_CANNOT_CHANGE_PASSWORD                                         Handle        = 0x80550006
-	EAS_E_ADMINS_HAVE_BLANK_PASSWORD                                          Handle        = 0x80550007
-	EAS_E_ADMINS_CANNOT_CHANGE_PASSWORD                                       Handle        = 0x80550008
-	EAS_E_LOCAL_CONTROLLED_USERS_CANNOT_CHANGE_PASSWORD                       Handle        = 0x80550009
-	EAS_E_PASSWORD_POLICY_NOT_ENFORCEABLE_FOR_CONNECTED_ADMINS                Handle        = 0x8055000A
-	EAS_E_CONNECTED_ADMINS_NEED_TO_CHANGE_PASSWORD                            Handle        = 0x8055000B
-	EAS_E_PASSWORD_POLICY_NOT_ENFORCEABLE_FOR_CURRENT_CONNECTED_USER          Handle        = 0x8055000C
-	EAS_E_CURRENT_CONNECTED_USER_NEED_TO_CHANGE_PASSWORD                      Handle        = 0x8055000D
-	WEB_E_UNSUPPORTED_FORMAT                                                  Handle        = 0x83750001
-	WEB_E_INVALID_XML                                                         Handle        = 0x83750002
-	WEB_E_MISSING_REQUIRED_ELEMENT                                            Handle        = 0x83750003
-	WEB_E_MISSING_REQUIRED_ATTRIBUTE                                          Handle        = 0x83750004
-	WEB_E_UNEXPECTED_CONTENT                                                  Handle        = 0x83750005
-	WEB_E_RESOURCE_TOO_LARGE                                                  Handle        = 0x83750006
-	WEB_E_INVALID_JSON_STRING                                                 Handle        = 0x83750007
-	WEB_E_INVALID_JSON_NUMBER                                                 Handle        = 0x83750008
-	WEB_E_JSON_VALUE_NOT_FOUND                                                Handle        = 0x83750009
-	HTTP_E_STATUS_UNEXPECTED                                                  Handle        = 0x80190001
-	HTTP_E_STATUS_UNEXPECTED_REDIRECTION                                      Handle        = 0x80190003
-	HTTP_E_STATUS_UNEXPECTED_CLIENT_ERROR                                     Handle        = 0x80190004
-	HTTP_E_STATUS_UNEXPECTED_SERVER_ERROR                                     Handle        = 0x80190005
-	HTTP_E_STATUS_AMBIGUOUS                                                   Handle        = 0x8019012C
-	HTTP_E_STATUS_MOVED                                                       Handle        = 0x8019012D
-	HTTP_E_STATUS_REDIRECT                                                    Handle        = 0x8019012E
-	HTTP_E_STATUS_REDIRECT_METHOD                                             Handle        = 0x8019012F
-	HTTP_E_STATUS_NOT_MODIFIED                                                Handle        = 0x80190130
-	HTTP_E_STATUS_USE_PROXY                                                   Handle        = 0x80190131
-	HTTP_E_STATUS_REDIRECT_KEEP_VERB                                          Handle        = 0x80190133
-	HTTP_E_STATUS_BAD_REQUEST                                                 Handle        = 0x80190190
-	HTTP_E_STATUS_DENIED                                                      Handle        = 0x80190191
-	HTTP_E_STATUS_PAYMENT_REQ                                                 Handle        = 0x80190192
-	HTTP_E_STATUS_FORBIDDEN                                                   Handle        = 0x80190193
-	HTTP_E_STATUS_NOT_FOUND                                                   Handle        = 0x80190194
-	HTTP_E_STATUS_BAD_METHOD                                                  Handle        = 0x80190195
-	HTTP_E_STATUS_NONE_ACCEPTABLE                                             Handle        = 0x80190196
-	HTTP_E_STATUS_PROXY_AUTH_REQ                                              Handle        = 0x80190197
-	HTTP_E_STATUS_REQUEST_TIMEOUT                                             Handle        = 0x80190198
-	HTTP_E_STATUS_CONFLICT                                                    Handle        = 0x80190199
-	HTTP_E_STATUS_GONE                                                        Handle        = 0x8019019A
-	HTTP_E_STATUS_LENGTH_REQUIRED                                             Handle        = 0x8019019B
-	HTTP_E_STATUS_PRECOND_FAILED                                              Handle        = 0x8019019C
-	HTTP_E_STATUS_REQUEST_TOO_LARGE                                           Handle        = 0x8019019D
-	HTTP_E_STATUS_URI_TOO_LONG                                                Handle        = 0x8019019E
-	HTTP_E_STATUS_UNSUPPORTED_MEDIA                                           Handle        = 0x8019019F
-	HTTP_E_STATUS_RANGE_NOT_SATISFIABLE                                       Handle        = 0x801901A0
-	HTTP_E_STATUS_EXPECTATION_FAILED                                          Handle        = 0x801901A1
-	HTTP_E_STATUS_SERVER_ERROR                                                Handle        = 0x801901F4
-	HTTP_E_STATUS_NOT_SUPPORTED                                               Handle        = 0x801901F5
-	HTTP_E_STATUS_BAD_GATEWAY                                                 Handle        = 0x801901F6
-	HTTP_E_STATUS_SERVICE_UNAVAIL                                             Handle        = 0x801901F7
-	HTTP_E_STATUS_GATEWAY_TIMEOUT                                             Handle        = 0x801901F8
-	HTTP_E_STATUS_VERSION_NOT_SUP                                             Handle        = 0x801901F9
-	E_INVALID_PROTOCOL_OPERATION                                              Handle        = 0x83760001
-	E_INVALID_PROTOCOL_FORMAT                                                 Handle        = 0x83760002
-	E_PROTOCOL_EXTENSIONS_NOT_SUPPORTED                                       Handle        = 0x83760003
-	E_SUBPROTOCOL_NOT_SUPPORTED                                               Handle        = 0x83760004
-	E_PROTOCOL_VERSION_NOT_SUPPORTED                                          Handle        = 0x83760005
-	INPUT_E_OUT_OF_ORDER                                                      Handle        = 0x80400000
-	INPUT_E_REENTRANCY                                                        Handle        = 0x80400001
-	INPUT_E_MULTIMODAL                                                        Handle        = 0x80400002
-	INPUT_E_PACKET                                                            Handle        = 0x80400003
-	INPUT_E_FRAME                                                             Handle        = 0x80400004
-	INPUT_E_HISTORY                                                           Handle        = 0x80400005
-	INPUT_E_DEVICE_INFO                                                       Handle        = 0x80400006
-	INPUT_E_TRANSFORM                                                         Handle        = 0x80400007
-	INPUT_E_DEVICE_PROPERTY                                                   Handle        = 0x80400008
-	INET_E_INVALID_URL                                                        Handle        = 0x800C0002
-	INET_E_NO_SESSION                                                         Handle        = 0x800C0003
-	INET_E_CANNOT_CONNECT                                                     Handle        = 0x800C0004
-	INET_E_RESOURCE_NOT_FOUND                                                 Handle        = 0x800C0005
-	INET_E_OBJECT_NOT_FOUND                                                   Handle        = 0x800C0006
-	INET_E_DATA_NOT_AVAILABLE                                                 Handle        = 0x800C0007
-	INET_E_DOWNLOAD_FAILURE                                                   Handle        = 0x800C0008
-	INET_E_AUTHENTICATION_REQUIRED                                            Handle        = 0x800C0009
-	INET_E_NO_VALID_MEDIA                                                     Handle        = 0x800C000A
-	INET_E_CONNECTION_TIMEOUT                                                 Handle        = 0x800C000B
-	INET_E_INVALID_REQUEST                                                    Handle        = 0x800C000C
-	INET_E_UNKNOWN_PROTOCOL                                                   Handle        = 0x800C000D
-	INET_E_SECURITY_PROBLEM                                                   Handle        = 0x800C000E
-	INET_E_CANNOT_LOAD_DATA                                                   Handle        = 0x800C000F
-	INET_E_CANNOT_INSTANTIATE_OBJECT                                          Handle        = 0x800C0010
-	INET_E_INVALID_CERTIFICATE                                                Handle        = 0x800C0019
-	INET_E_REDIRECT_FAILED                                                    Handle        = 0x800C0014
-	INET_E_REDIRECT_TO_DIR                                                    Handle        = 0x800C0015
-	ERROR_DBG_CREATE_PROCESS_FAILURE_LOCKDOWN                                 Handle        = 0x80B00001
-	ERROR_DBG_ATTACH_PROCESS_FAILURE_LOCKDOWN                                 Handle        = 0x80B00002
-	ERROR_DBG_CONNECT_SERVER_FAILURE_LOCKDOWN                                 Handle        = 0x80B00003
-	ERROR_DBG_START_SERVER_FAILURE_LOCKDOWN                                   Handle        = 0x80B00004
-	ERROR_IO_PREEMPTED                                                        Handle        = 0x89010001
-	JSCRIPT_E_CANTEXECUTE                                                     Handle        = 0x89020001
-	WEP_E_NOT_PROVISIONED_ON_ALL_VOLUMES                                      Handle        = 0x88010001
-	WEP_E_FIXED_DATA_NOT_SUPPORTED                                            Handle        = 0x88010002
-	WEP_E_HARDWARE_NOT_COMPLIANT                                              Handle        = 0x88010003
-	WEP_E_LOCK_NOT_CONFIGURED                                                 Handle        = 0x88010004
-	WEP_E_PROTECTION_SUSPENDED                                                Handle        = 0x88010005
-	WEP_E_NO_LICENSE                                                          Handle        = 0x88010006
-	WEP_E_OS_NOT_PROTECTED                                                    Handle        = 0x88010007
-	WEP_E_UNEXPECTED_FAIL                                                     Handle        = 0x88010008
-	WEP_E_BUFFER_TOO_LARGE                                                    Handle        = 0x88010009
-	ERROR_SVHDX_ERROR_STORED                                                  Handle        = 0xC05C0000
-	ERROR_SVHDX_ERROR_NOT_AVAILABLE                                           Handle        = 0xC05CFF00
-	ERROR_SVHDX_UNIT_ATTENTION_AVAILABLE                                      Handle        = 0xC05CFF01
-	ERROR_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED                          Handle        = 0xC05CFF02
-	ERROR_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED                         Handle        = 0xC05CFF03
-	ERROR_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED                          Handle        = 0xC05CFF04
-	ERROR_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED                        Handle        = 0xC05CFF05
-	ERROR_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED                   Handle        = 0xC05CFF06
-	ERROR_SVHDX_RESERVATION_CONFLICT                                          Handle        = 0xC05CFF07
-	ERROR_SVHDX_WRONG_FILE_TYPE                                               Handle        = 0xC05CFF08
-	ERROR_SVHDX_VERSION_MISMATCH                                              Handle        = 0xC05CFF09
-	ERROR_VHD_SHARED                                                          Handle        = 0xC05CFF0A
-	ERROR_SVHDX_NO_INITIATOR                                                  Handle        = 0xC05CFF0B
-	ERROR_VHDSET_BACKING_STORAGE_NOT_FOUND                                    Handle        = 0xC05CFF0C
-	ERROR_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP                               Handle        = 0xC05D0000
-	ERROR_SMB_BAD_CLUSTER_DIALECT                                             Handle        = 0xC05D0001
-	WININET_E_OUT_OF_HANDLES                                                  Handle        = 0x80072EE1
-	WININET_E_TIMEOUT                                                         Handle        = 0x80072EE2
-	WININET_E_EXTENDED_ERROR                                                  Handle        = 0x80072EE3
-	WININET_E_INTERNAL_ERROR                                                  Handle        = 0x80072EE4
-	WININET_E_INVALID_URL                                                     Handle        = 0x80072EE5
-	WININET_E_UNRECOGNIZED_SCHEME                                             Handle        = 0x80072EE6
-	WININET_E_NAME_NOT_RESOLVED                                               Handle        = 0x80072EE7
-	WININET_E_PROTOCOL_NOT_FOUND                                              Handle        = 0x80072EE8
-	WININET_E_INVALID_OPTION                                                  Handle        = 0x80072EE9
-	WININET_E_BAD_OPTION_LENGTH                                               Handle        = 0x80072EEA
-	WININET_E_OPTION_NOT_SETTABLE                                             Handle        = 0x80072EEB
-	WININET_E_SHUTDOWN                                                        Handle        = 0x80072EEC
-	WININET_E_INCORRECT_USER_NAME                                             Handle        = 0x80072EED
-	WININET_E_INCORRECT_PASSWORD                                              Handle        = 0x80072EEE
-	WININET_E_LOGIN_FAILURE                                                   Handle        = 0x80072EEF
-	WININET_E_INVALID_OPERATION                                               Handle        = 0x80072EF0
-	WININET_E_OPERATION_CANCELLED                                             Handle        = 0x80072EF1
-	WININET_E_INCORRECT_HANDLE_TYPE                                           Handle        = 0x80072EF2
-	WININET_E_INCORRECT_HANDLE_STATE                                          Handle        = 0x80072EF3
-	WININET_E_NOT_PROXY_REQUEST                                               Handle        = 0x80072EF4
-	WININET_E_REGISTRY_VALUE_NOT_FOUND                                        Handle        = 0x80072EF5
-	WININET_E_BAD_REGISTRY_PARAMETER                                          Handle        = 0x80072EF6
-	WININET_E_NO_DIRECT_ACCESS                                                Handle        = 0x80072EF7
-	WININET_E_NO_CONTEXT                                                      Handle        = 0x80072EF8
-	WININET_E_NO_CALLBACK                                                     Handle        = 0x80072EF9
-	WININET_E_REQUEST_PENDING                                                 Handle        = 0x80072EFA
-	WININET_E_INCORRECT_FORMAT                                                Handle        = 0x80072EFB
-	WININET_E_ITEM_NOT_FOUND                                                  Handle        = 0x80072EFC
-	WININET_E_CANNOT_CONNECT                                                  Handle        = 0x80072EFD
-	WININET_E_CONNECTION_ABORTED                                              Handle        = 0x80072EFE
-	WININET_E_CONNECTION_RESET                                                Handle        = 0x80072EFF
-	WININET_E_FORCE_RETRY                                                     Handle        = 0x80072F00
-	WININET_E_INVALID_PROXY_REQUEST                                           Handle        = 0x80072F01
-	WININET_E_NEED_UI                                                         Handle        = 0x80072F02
-	WININET_E_HANDLE_EXISTS                                                   Handle        = 0x80072F04
-	WININET_E_SEC_CERT_DATE_INVALID                                           Handle        = 0x80072F05
-	WININET_E_SEC_CERT_CN_INVALID                                             Handle        = 0x80072F06
-	WININET_E_HTTP_TO_HTTPS_ON_REDIR                                          Handle        = 0x80072F07
-	WININET_E_HTTPS_TO_HTTP_ON_REDIR                                          Handle        = 0x80072F08
-	WININET_E_MIXED_SECURITY                                                  Handle        = 0x80072F09
-	WININET_E_CHG_POST_IS_NON_SECURE                                          Handle        = 0x80072F0A
-	WININET_E_POST_IS_NON_SECURE                                              Handle        = 0x80072F0B
-	WININET_E_CLIENT_AUTH_CERT_NEEDED                                         Handle        = 0x80072F0C
-	WININET_E_INVALID_CA                                                      Handle        = 0x80072F0D
-	WININET_E_CLIENT_AUTH_NOT_SETUP                                           Handle        = 0x80072F0E
-	WININET_E_ASYNC_THREAD_FAILED                                             Handle        = 0x80072F0F
-	WININET_E_REDIRECT_SCHEME_CHANGE                                          Handle        = 0x80072F10
-	WININET_E_DIALOG_PENDING                                                  Handle        = 0x80072F11
-	WININET_E_RETRY_DIALOG                                                    Handle        = 0x80072F12
-	WININET_E_NO_NEW_CONTAINERS                                               Handle        = 0x80072F13
-	WININET_E_HTTPS_HTTP_SUBMIT_REDIR                                         Handle        = 0x80072F14
-	WININET_E_SEC_CERT_ERRORS                                                 Handle        = 0x80072F17
-	WININET_E_SEC_CERT_REV_FAILED                                             Handle        = 0x80072F19
-	WININET_E_HEADER_NOT_FOUND                                                Handle        = 0x80072F76
-	WININET_E_DOWNLEVEL_SERVER                                                Handle        = 0x80072F77
-	WININET_E_INVALID_SERVER_RESPONSE                                         Handle        = 0x80072F78
-	WININET_E_INVALID_HEADER                                                  Handle        = 0x80072F79
-	WININET_E_INVALID_QUERY_REQUEST                                           Handle        = 0x80072F7A
-	WININET_E_HEADER_ALREADY_EXISTS                                           Handle        = 0x80072F7B
-	WININET_E_REDIRECT_FAILED                                                 Handle        = 0x80072F7C
-	WININET_E_SECURITY_CHANNEL_ERROR                                          Handle        = 0x80072F7D
-	WININET_E_UNABLE_TO_CACHE_FILE                                            Handle        = 0x80072F7E
-	WININET_E_TCPIP_NOT_INSTALLED                                             Handle        = 0x80072F7F
-	WININET_E_DISCONNECTED                                                    Handle        = 0x80072F83
-	WININET_E_SERVER_UNREACHABLE                                              Handle        = 0x80072F84
-	WININET_E_PROXY_SERVER_UNREACHABLE                                        Handle        = 0x80072F85
-	WININET_E_BAD_AUTO_PROXY_SCRIPT                                           Handle        = 0x80072F86
-	WININET_E_UNABLE_TO_DOWNLOAD_SCRIPT                                       Handle        = 0x80072F87
-	WININET_E_SEC_INVALID_CERT                                                Handle        = 0x80072F89
-	WININET_E_SEC_CERT_REVOKED                                                Handle        = 0x80072F8A
-	WININET_E_FAILED_DUETOSECURITYCHECK                                       Handle        = 0x80072F8B
-	WININET_E_NOT_INITIALIZED                                                 Handle        = 0x80072F8C
-	WININET_E_LOGIN_FAILURE_DISPLAY_ENTITY_BODY                               Handle        = 0x80072F8E
-	WININET_E_DECODING_FAILED                                                 Handle        = 0x80072F8F
-	WININET_E_NOT_REDIRECTED                                                  Handle        = 0x80072F80
-	WININET_E_COOKIE_NEEDS_CONFIRMATION                                       Handle        = 0x80072F81
-	WININET_E_COOKIE_DECLINED                                                 Handle        = 0x80072F82
-	WININET_E_REDIRECT_NEEDS_CONFIRMATION                                     Handle        = 0x80072F88
-	SQLITE_E_ERROR                                                            Handle        = 0x87AF0001
-	SQLITE_E_INTERNAL                                                         Handle        = 0x87AF0002
-	SQLITE_E_PERM                                                             Handle        = 0x87AF0003
-	SQLITE_E_ABORT                                                            Handle        = 0x87AF0004
-	SQLITE_E_BUSY                                                             Handle        = 0x87AF0005
-	SQLITE_E_LOCKED                                                           Handle        = 0x87AF0006
-	SQLITE_E_NOMEM                                                            Handle        = 0x87AF0007
-	SQLITE_E_READONLY                                                         Handle        = 0x87AF0008
-	SQLITE_E_INTERRUPT                                                        Handle        = 0x87AF0009
-	SQLITE_E_IOERR                                                            Handle        = 0x87AF000A
-	SQLITE_E_CORRUPT                                                          Handle        = 0x87AF000B
-	SQLITE_E_NOTFOUND                                                         Handle        = 0x87AF000C
-	SQLITE_E_FULL                                                             Handle        = 0x87AF000D
-	SQLITE_E_CANTOPEN                                                         Handle        = 0x87AF000E
-	SQLITE_E_PROTOCOL                                                         Handle        = 0x87AF000F
-	SQLITE_E_EMPTY                                                            Handle        = 0x87AF0010
-	SQLITE_E_SCHEMA                                                           Handle        = 0x87AF0011
-	SQLITE_E_TOOBIG                                                           Handle        = 0x87AF0012
-	SQLITE_E_CONSTRAINT                                                       Handle        = 0x87AF0013
-	SQLITE_E_MISMATCH                                                         Handle        = 0x87AF0014
-	SQLITE_E_MISUSE                                                           Handle        = 0x87AF0015
-	SQLITE_E_NOLFS                                                            Handle        = 0x87AF0016
-	SQLITE_E_AUTH                                                             Handle        = 0x87AF0017
-	SQLITE_E_FORMAT                                                           Handle        = 0x87AF0018
-	SQLITE_E_RANGE                                                            Handle        = 0x87AF0019
-	SQLITE_E_NOTADB                                                           Handle        = 0x87AF001A
-	SQLITE_E_NOTICE                                                           Handle        = 0x87AF001B
-	SQLITE_E_WARNING                                                          Handle        = 0x87AF001C
-	SQLITE_E_ROW                                                              Handle        = 0x87AF0064
-	SQLITE_E_DONE                                                             Handle        = 0x87AF0065
-	SQLITE_E_IOERR_READ                                                       Handle        = 0x87AF010A
-	SQLITE_E_IOERR_SHORT_READ                                                 Handle        = 0x87AF020A
-	SQLITE_E_IOERR_WRITE                                                      Handle        = 0x87AF030A
-	SQLITE_E_IOERR_FSYNC                                                      Handle        = 0x87AF040A
-	SQLITE_E_IOERR_DIR_FSYNC                                                  Handle        = 0x87AF050A
-	SQLITE_E_IOERR_TRUNCATE                                                   Handle        = 0x87AF060A
-	SQLITE_E_IOERR_FSTAT                                                      Handle        = 0x87AF070A
-	SQLITE_E_IOERR_UNLOCK                                                     Handle        = 0x87AF080A
-	SQLITE_E_IOERR_RDLOCK                                                     Handle        = 0x87AF090A
-	SQLITE_E_IOERR_DELETE                                                     Handle        = 0x87AF0A0A
-	SQLITE_E_IOERR_BLOCKED                                                    Handle        = 0x87AF0B0A
-	SQLITE_E_IOERR_NOMEM                                                      Handle        = 0x87AF0C0A
-	SQLITE_E_IOERR_ACCESS                                                     Handle        = 0x87AF0D0A
-	SQLITE_E_IOERR_CHECKRESERVEDLOCK                                          Handle        = 0x87AF0E0A
-	SQLITE_E_IOERR_LOCK                                                       Handle        = 0x87AF0F0A
-	SQLITE_E_IOERR_CLOSE                                                      Handle        = 0x87AF100A
-	SQLITE_E_IOERR_DIR_CLOSE                                                  Handle        = 0x87AF110A
-	SQLITE_E_IOERR_SHMOPEN                                                    Handle        = 0x87AF120A
-	SQLITE_E_IOERR_SHMSIZE                                                    Handle        = 0x87AF130A
-	SQLITE_E_IOERR_SHMLOCK                                                    Handle        = 0x87AF140A
-	SQLITE_E_IOERR_SHMMAP                                                     Handle        = 0x87AF150A
-	SQLITE_E_IOERR_SEEK                                                       Handle        = 0x87AF160A
-	SQLITE_E_IOERR_DELETE_NOENT                                               Handle        = 0x87AF170A
-	SQLITE_E_IOERR_MMAP                                                       Handle        = 0x87AF180A
-	SQLITE_E_IOERR_GETTEMPPATH                                                Handle        = 0x87AF190A
-	SQLITE_E_IOERR_CONVPATH                                                   Handle        = 0x87AF1A0A
-	SQLITE_E_IOERR_VNODE                                                      Handle        = 0x87AF1A02
-	SQLITE_E_IOERR_AUTH                                                       Handle        = 0x87AF1A03
-	SQLITE_E_LOCKED_SHAREDCACHE                                               Handle        = 0x87AF0106
-	SQLITE_E_BUSY_RECOVERY                                                    Handle        = 0x87AF0105
-	SQLITE_E_BUSY_SNAPSHOT                                                    Handle        = 0x87AF0205
-	SQLITE_E_CANTOPEN_NOTEMPDIR                                               Handle        = 0x87AF010E
-	SQLITE_E_CANTOPEN_ISDIR                                                   Handle        = 0x87AF020E
-	SQLITE_E_CANTOPEN_FULLPATH                                                Handle        = 0x87AF030E
-	SQLITE_E_CANTOPEN_CONVPATH                                                Handle        = 0x87AF040E
-	SQLITE_E_CORRUPT_VTAB                                                     Handle        = 0x87AF010B
-	SQLITE_E_READONLY_RECOVERY                                                Handle        = 0x87AF0108
-	SQLITE_E_READONLY_CANTLOCK                                                Handle        = 0x87AF0208
-	SQLITE_E_READONLY_ROLLBACK                                                Handle        = 0x87AF0308
-	SQLITE_E_READONLY_DBMOVED                                                 Handle        = 0x87AF0408
-	SQLITE_E_ABORT_ROLLBACK                                                   Handle        = 0x87AF0204
-	SQLITE_E_CONSTRAINT_CHECK                                                 Handle        = 0x87AF0113
-	SQLITE_E_CONSTRAINT_COMMITHOOK                                            Handle        = 0x87AF0213
-	SQLITE_E_CONSTRAINT_FOREIGNKEY                                            Handle        = 0x87AF0313
-	SQLITE_E_CONSTRAINT_FUNCTION                                              Handle        = 0x87AF0413
-	SQLITE_E_CONSTRAINT_NOTNULL                                               Handle        = 0x87AF0513
-	SQLITE_E_CONSTRAINT_PRIMARYKEY                                            Handle        = 0x87AF0613
-	SQLITE_E_CONSTRAINT_TRIGGER                                               Handle        = 0x87AF0713
-	SQLITE_E_CONSTRAINT_UNIQUE                                                Handle        = 0x87AF0813
-	SQLITE_E_CONSTRAINT_VTAB                                                  Handle        = 0x87AF0913
-	SQLITE_E_CONSTRAINT_ROWID                                                 Handle        = 0x87AF0A13
-	SQLITE_E_NOTICE_RECOVER_WAL                                               Handle        = 0x87AF011B
-	SQLITE_E_NOTICE_RECOVER_ROLLBACK                                          Handle        = 0x87AF021B
-	SQLITE_E_WARNING_AUTOINDEX                                                Handle        = 0x87AF011C
-	UTC_E_TOGGLE_TRACE_STARTED                                                Handle        = 0x87C51001
-	UTC_E_ALTERNATIVE_TRACE_CANNOT_PREEMPT                                    Handle        = 0x87C51002
-	UTC_E_AOT_NOT_RUNNING                                                     Handle        = 0x87C51003
-	UTC_E_SCRIPT_TYPE_INVALID                                                 Handle        = 0x87C51004
-	UTC_E_SCENARIODEF_NOT_FOUND                                               Handle        = 0x87C51005
-	UTC_E_TRACEPROFILE_NOT_FOUND                                              Handle        = 0x87C51006
-	UTC_E_FORWARDER_ALREADY_ENABLED                                           Handle        = 0x87C51007
-	UTC_E_FORWARDER_ALREADY_DISABLED                                          Handle        = 0x87C51008
-	UTC_E_EVENTLOG_ENTRY_MALFORMED                                            Handle        = 0x87C51009
-	UTC_E_DIAGRULES_SCHEMAVERSION_MISMATCH                                    Handle        = 0x87C5100A
-	UTC_E_SCRIPT_TERMINATED                                                   Handle        = 0x87C5100B
-	UTC_E_INVALID_CUSTOM_FILTER                                               Handle        = 0x87C5100C
-	UTC_E_TRACE_NOT_RUNNING                                                   Handle        = 0x87C5100D
-	UTC_E_REESCALATED_TOO_QUICKLY                                             Handle        = 0x87C5100E
-	UTC_E_ESCALATION_ALREADY_RUNNING                                          Handle        = 0x87C5100F
-	UTC_E_PERFTRACK_ALREADY_TRACING                                           Handle        = 0x87C51010
-	UTC_E_REACHED_MAX_ESCALATIONS                                             Handle        = 0x87C51011
-	UTC_E_FORWARDER_PRODUCER_MISMATCH                                         Handle        = 0x87C51012
-	UTC_E_INTENTIONAL_SCRIPT_FAILURE                                          Handle        = 0x87C51013
-	UTC_E_SQM_INIT_FAILED                                                     Handle        = 0x87C51014
-	UTC_E_NO_WER_LOGGER_SUPPORTED                                             Handle        = 0x87C51015
-	UTC_E_TRACERS_DONT_EXIST                                                  Handle        = 0x87C51016
-	UTC_E_WINRT_INIT_FAILED                                                   Handle        = 0x87C51017
-	UTC_E_SCENARIODEF_SCHEMAVERSION_MISMATCH                                  Handle        = 0x87C51018
-	UTC_E_INVALID_FILTER                                                      Handle        = 0x87C51019
-	UTC_E_EXE_TERMINATED                                                      Handle        = 0x87C5101A
-	UTC_E_ESCALATION_NOT_AUTHORIZED                                           Handle        = 0x87C5101B
-	UTC_E_SETUP_NOT_AUTHORIZED                                                Handle        = 0x87C5101C
-	UTC_E_CHILD_PROCESS_FAILED                                                Handle        = 0x87C5101D
-	UTC_E_COMMAND_LINE_NOT_AUTHORIZED                                         Handle        = 0x87C5101E
-	UTC_E_CANNOT_LOAD_SCENARIO_EDITOR_XML                                     Handle        = 0x87C5101F
-	UTC_E_ESCALATION_TIMED_OUT                                                Handle        = 0x87C51020
-	UTC_E_SETUP_TIMED_OUT                                                     Handle        = 0x87C51021
-	UTC_E_TRIGGER_MISMATCH                                                    Handle        = 0x87C51022
-	UTC_E_TRIGGER_NOT_FOUND                                                   Handle        = 0x87C51023
-	UTC_E_SIF_NOT_SUPPORTED                                                   Handle        = 0x87C51024
-	UTC_E_DELAY_TERMINATED                                                    Handle        = 0x87C51025
-	UTC_E_DEVICE_TICKET_ERROR                                                 Handle        = 0x87C51026
-	UTC_E_TRACE_BUFFER_LIMIT_EXCEEDED                                         Handle        = 0x87C51027
-	UTC_E_API_RESULT_UNAVAILABLE                                              Handle        = 0x87C51028
-	UTC_E_RPC_TIMEOUT                                                         Handle        = 0x87C51029
-	UTC_E_RPC_WAIT_FAILED                                                     Handle        = 0x87C5102A
-	UTC_E_API_BUSY                                                            Handle        = 0x87C5102B
-	UTC_E_TRACE_MIN_DURATION_REQUIREMENT_NOT_MET                              Handle        = 0x87C5102C
-	UTC_E_EXCLUSIVITY_NOT_AVAILABLE                                           Handle        = 0x87C5102D
-	UTC_E_GETFILE_FILE_PATH_NOT_APPROVED                                      Handle        = 0x87C5102E
-	UTC_E_ESCALATION_DIRECTORY_ALREADY_EXISTS                                 Handle        = 0x87C5102F
-	UTC_E_TIME_TRIGGER_ON_START_INVALID                                       Handle        = 0x87C51030
-	UTC_E_TIME_TRIGGER_ONLY_VALID_ON_SINGLE_TRANSITION                        Handle        = 0x87C51031
-	UTC_E_TIME_TRIGGER_INVALID_TIME_RANGE                                     Handle        = 0x87C51032
-	UTC_E_MULTIPLE_TIME_TRIGGER_ON_SINGLE_STATE                               Handle        = 0x87C51033
-	UTC_E_BINARY_MISSING                                                      Handle        = 0x87C51034
-	UTC_E_NETWORK_CAPTURE_NOT_ALLOWED                                         Handle        = 0x87C51035
-	UTC_E_FAILED_TO_RESOLVE_CONTAINER_ID                                      Handle        = 0x87C51036
-	UTC_E_UNABLE_TO_RESOLVE_SESSION                                           Handle        = 0x87C51037
-	UTC_E_THROTTLED                                                           Handle        = 0x87C51038
-	UTC_E_UNAPPROVED_SCRIPT                                                   Handle        = 0x87C51039
-	UTC_E_SCRIPT_MISSING                                                      Handle        = 0x87C5103A
-	UTC_E_SCENARIO_THROTTLED                                                  Handle        = 0x87C5103B
-	UTC_E_API_NOT_SUPPORTED                                                   Handle        = 0x87C5103C
-	UTC_E_GETFILE_EXTERNAL_PATH_NOT_APPROVED                                  Handle        = 0x87C5103D
-	UTC_E_TRY_GET_SCENARIO_TIMEOUT_EXCEEDED                                   Handle        = 0x87C5103E
-	UTC_E_CERT_REV_FAILED                                                     Handle        = 0x87C5103F
-	UTC_E_FAILED_TO_START_NDISCAP                                             Handle        = 0x87C51040
-	UTC_E_KERNELDUMP_LIMIT_REACHED                                            Handle        = 0x87C51041
-	UTC_E_MISSING_AGGREGATE_EVENT_TAG                                         Handle        = 0x87C51042
-	UTC_E_INVALID_AGGREGATION_STRUCT                                          Handle        = 0x87C51043
-	UTC_E_ACTION_NOT_SUPPORTED_IN_DESTINATION                                 Handle        = 0x87C51044
-	UTC_E_FILTER_MISSING_ATTRIBUTE                                            Handle        = 0x87C51045
-	UTC_E_FILTER_INVALID_TYPE                                                 Handle        = 0x87C51046
-	UTC_E_FILTER_VARIABLE_NOT_FOUND                                           Handle        = 0x87C51047
-	UTC_E_FILTER_FUNCTION_RESTRICTED                                          Handle        = 0x87C51048
-	UTC_E_FILTER_VERSION_MISMATCH                                             Handle        = 0x87C51049
-	UTC_E_FILTER_INVALID_FUNCTION                                             Handle        = 0x87C51050
-	UTC_E_FILTER_INVALID_FUNCTION_PARAMS                                      Handle        = 0x87C51051
-	UTC_E_FILTER_INVALID_COMMAND                                              Handle        = 0x87C51052
-	UTC_E_FILTER_ILLEGAL_EVAL                                                 Handle        = 0x87C51053
-	UTC_E_TTTRACER_RETURNED_ERROR                                             Handle        = 0x87C51054
-	UTC_E_AGENT_DIAGNOSTICS_TOO_LARGE                                         Handle        = 0x87C51055
-	UTC_E_FAILED_TO_RECEIVE_AGENT_DIAGNOSTICS                                 Handle        = 0x87C51056
-	UTC_E_SCENARIO_HAS_NO_ACTIONS                                             Handle        = 0x87C51057
-	UTC_E_TTTRACER_STORAGE_FULL                                               Handle        = 0x87C51058
-	UTC_E_INSUFFICIENT_SPACE_TO_START_TRACE                                   Handle        = 0x87C51059
-	UTC_E_ESCALATION_CANCELLED_AT_SHUTDOWN                                    Handle        = 0x87C5105A
-	UTC_E_GETFILEINFOACTION_FILE_NOT_APPROVED                                 Handle        = 0x87C5105B
-	WINML_ERR_INVALID_DEVICE                                                  Handle        = 0x88900001
-	WINML_ERR_INVALID_BINDING                                                 Handle        = 0x88900002
-	WINML_ERR_VALUE_NOTFOUND                                                  Handle        = 0x88900003
-	WINML_ERR_SIZE_MISMATCH                                                   Handle        = 0x88900004
-)
@@ -1,212 +1,235 @@
+// Package mg10 moves the pins of a repo from the dag-pb pin sets of the
+// go-ipfs ipld pinner into the records and indexes the dspinner of go-ipfs
+// 0.8 keeps in the datastore, and back. It reads and writes both formats on
+// the datastore directly, without go-ipfs.
 package mg10
 
 import (
-	"context"
+	"errors"
 	"fmt"
-	"path"
-
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-blockservice"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-datastore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-filestore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs-blockstore"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs-exchange-offline"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs-pinner/pinconv"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/plugin/loader"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/repo"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipfs/repo/fsrepo"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-ipld-format"
-	"github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/_vendor/github.com/ipfs/go-merkledag"
 
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "10-to-11"
+func (m Migration) FromVersion() int {
+	return 10
+}
+
+func (m Migration) ToVersion() int {
+	return 11
 }
 
 func (m Migration) Reversible() bool {
 	return true
 }
 
+// Requirements declares that the datastore must be described by the config,
+// and that it is made of datastores the migration can open itself: the pins
+// are kept under "/" and the pin set nodes under "/blocks". Datastore
+// plugins are not loaded.
+func (m Migration) Requirements() []migrate.Requirement {
+	return []migrate.Requirement{
+		migrate.RequireConfigKey("Datastore.Spec"),
+		migrate.RequireMount("/", "flatfs", "badgerds", "levelds"),
+		migrate.RequireMount("/blocks", "flatfs", "badgerds", "levelds"),
+	}
+}
+
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	err := setupPlugins(opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
+	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
-		log.Error("failed to setup plugins", err.Error())
 		return err
 	}
+	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
 
-	// Set to previous version to avoid "needs migration" error.  This is safe
-	// for this migration since repo has not changed.
-	fsrepo.RepoVersion = 10
+	repo := mfsr.RepoPath(opts.Path)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	if !fsrepo.IsInitialized(opts.Path) {
-		return fmt.Errorf("ipfs repo %q not initialized", opts.Path)
+	log.Debug("  - verifying version is '10'")
+	if err := repo.CheckVersion("10"); err != nil {
+		return err
 	}
 
-	log.VLog("  - opening datastore at %q", opts.Path)
-	r, err := fsrepo.Open(opts.Path)
+	log.Debug("  - opening datastore at %q", opts.Path)
+	d, err := convert.Open(opts.Path)
 	if err != nil {
-		return fmt.Errorf("cannot open datastore: %v", err)
+		return fmt.Errorf("cannot open datastore: %w", err)
 	}
-	defer r.Close()
+	defer d.Close()
+	defer opts.Shutdown.OnRelease(d.Close)()
 
-	if err = transferPins(ctx, r); err != nil {
+	log.Info("> Upgrading pinning to use datastore")
+	if err := transferPins(d, opts); err != nil {
 		log.Error("failed to transfer pins:", err.Error())
 		return err
 	}
 
-	err = mfsr.RepoPath(opts.Path).WriteVersion("11")
-	if err != nil {
+	if err := repo.CasVersion("10", "11"); err != nil {
 		log.Error("failed to update version file to 11")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 	return nil
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
-
-	err := setupPlugins(opts.Path)
+	log := opts.Logger()
+	log.Info("reverting migration")
+	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
-		log.Error("failed to setup plugins", err.Error())
 		return err
 	}
+	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
 
-	if !fsrepo.IsInitialized(opts.Path) {
-		return fmt.Errorf("ipfs repo %q not initialized", opts.Path)
+	repo := mfsr.RepoPath(opts.Path)
+	if err := repo.CheckVersion("11"); err != nil {
+		return err
 	}
 
-	log.VLog("  - opening datastore at %q", opts.Path)
-	r, err := fsrepo.Open(opts.Path)
+	log.Debug("  - opening datastore at %q", opts.Path)
+	d, err := convert.Open(opts.Path)
 	if err != nil {
-		return fmt.Errorf("cannot open datastore: %v", err)
+		return fmt.Errorf("cannot open datastore: %w", err)
 	}
-	defer r.Close()
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	defer d.Close()
+	defer opts.Shutdown.OnRelease(d.Close)()
 
-	if err = revertPins(ctx, r); err != nil {
+	log.Info("> Reverting pinning to use ipld storage")
+	if err := revertPins(d, opts); err != nil {
+		log.Error("failed to revert pins:", err.Error())
 		return err
 	}
 
-	err = mfsr.RepoPath(opts.Path).WriteVersion("10")
-	if err != nil {
+	if err := repo.CasVersion("11", "10"); err != nil {
 		log.Error("failed to update version file to 10")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 	return nil
 }
 
-type syncDagService struct {
-	format.DAGService
-	syncFn func() error
-}
-
-func (s *syncDagService) Sync() error {
-	return s.syncFn()
-}
-
-type batchWrap struct {
-	datastore.Datastore
-}
-
-func (d *batchWrap) Batch() (datastore.Batch, error) {
-	return datastore.NewBasicBatch(d), nil
-}
+// transferPins writes a dspinner record for each pin of the pin sets, then
+// deletes the key of the pin root. Pins already indexed by an earlier,
+// interrupted run are not written again.
+func transferPins(d ds.Datastore, opts migrate.Options) error {
+	log := opts.Logger()
+	root, err := d.Get(ds.NewKey(pincheck.PinsKey))
+	if errors.Is(err, ds.ErrNotFound) {
+		log.Info("  - no pins to convert")
+		return nil
+	} else if err != nil {
+		return err
+	}
 
-func setupPlugins(externalPluginsPath string) error {
-	// Load any external plugins if available on externalPluginsPath
-	plugins, err := loader.NewPluginLoader(path.Join(externalPluginsPath, "plugins"))
+	pins := make(map[string][][]byte)
+	r, err := pincheck.Visit(root, dsblocks.Blocks{Datastore: d}, pincheck.Visitor{
+		Pin: func(set string, hash []byte) { pins[set] = append(pins[set], hash) },
+	})
 	if err != nil {
-		return fmt.Errorf("error loading plugins: %s", err)
+		return err
 	}
-
-	// Load preloaded and external plugins
-	if err := plugins.Initialize(); err != nil {
-		return fmt.Errorf("error initializing plugins: %s", err)
+	for _, m := range r.Missing {
+		if m.Internal {
+			return fmt.Errorf("%s is missing, so its pins cannot be converted", m)
+		}
 	}
-
-	if err := plugins.Inject(); err != nil {
-		return fmt.Errorf("error initializing plugins: %s", err)
+	if len(r.Missing) > 0 {
+		log.Warn("  - %d pinned blocks are missing, they stay pinned", len(r.Missing))
 	}
 
-	return nil
-}
-
-func makeStore(r repo.Repo) (datastore.Datastore, format.DAGService, format.DAGService, error) {
-	dstr := r.Datastore()
-	dstore := &batchWrap{dstr}
-
-	bstore := blockstore.NewBlockstore(dstr)
-	bserv := blockservice.New(bstore, offline.Exchange(bstore))
-	dserv := merkledag.NewDAGService(bserv)
-	internalDag := merkledag.NewDAGService(bserv)
-
-	syncFn := func() error {
-		err := dstore.Sync(blockstore.BlockPrefix)
+	if err := d.Put(ds.NewKey(dirtyKey), []byte{1}); err != nil {
+		return err
+	}
+	converted := 0
+	for _, set := range pincheck.Sets {
+		done, err := indexedPins(d, set)
 		if err != nil {
-			return fmt.Errorf("cannot sync blockstore: %v", err)
+			return err
 		}
-		err = dstore.Sync(filestore.FilestorePrefix)
-		if err != nil {
-			return fmt.Errorf("cannot sync filestore: %v", err)
+		for _, c := range pins[set] {
+			if done[string(c)] {
+				continue
+			}
+			if err := addPin(d, set, c); err != nil {
+				return fmt.Errorf("cannot store %s pin: %w", set, err)
+			}
+			done[string(c)] = true
+			converted++
+			opts.Count("mg10.pins_converted", 1)
 		}
-		return nil
 	}
-	syncDs := &syncDagService{dserv, syncFn}
-	syncInternalDag := &syncDagService{internalDag, syncFn}
-
-	return dstore, syncDs, syncInternalDag, nil
-}
-
-func transferPins(ctx context.Context, r repo.Repo) error {
-	log.Log("> Upgrading pinning to use datastore")
-
-	dstore, dserv, internalDag, err := makeStore(r)
-	if err != nil {
+	if err := d.Sync(ds.NewKey(pinsPrefix)); err != nil {
 		return err
 	}
-
-	log.Log("  - importing from ipld pinner")
-
-	_, toDSCount, err := pinconv.ConvertPinsFromIPLDToDS(ctx, dstore, dserv, internalDag)
-	if err != nil {
-		log.Error("failed to convert ipld pin data into datastore")
+	if err := d.Put(ds.NewKey(dirtyKey), []byte{0}); err != nil {
 		return err
 	}
-	log.Log("  - converted %d pins from ipld storage into datastore", toDSCount)
-	return nil
+	if err := d.Sync(ds.NewKey(pinsPrefix)); err != nil {
+		return err
+	}
+	log.Info("  - converted %d pins from ipld storage into datastore", converted)
+
+	// the pin set nodes are left for the garbage collector
+	if err := d.Delete(ds.NewKey(pincheck.PinsKey)); err != nil {
+		return fmt.Errorf("cannot delete old pin state: %w", err)
+	}
+	return d.Sync(ds.NewKey(pincheck.PinsKey))
 }
 
-func revertPins(ctx context.Context, r repo.Repo) error {
-	log.Log("> Reverting pinning to use ipld storage")
+// revertPins writes the pin sets holding the pins of the dspinner, then
+// deletes its records and indexes.
+func revertPins(d ds.Datastore, opts migrate.Options) error {
+	log := opts.Logger()
+	pins := make(map[string][][]byte)
+	count := 0
+	for _, set := range pincheck.Sets {
+		indexed, err := indexedPins(d, set)
+		if err != nil {
+			return err
+		}
+		for c := range indexed {
+			pins[set] = append(pins[set], []byte(c))
+		}
+		count += len(indexed)
+	}
 
-	dstore, dserv, internalDag, err := makeStore(r)
+	w := &pinSetWriter{d: d}
+	root, err := w.writeRoot(pins)
 	if err != nil {
+		return fmt.Errorf("cannot store pin sets: %w", err)
+	}
+	if err := d.Sync(ds.NewKey(dsblocks.Prefix)); err != nil {
+		return err
+	}
+	if err := d.Put(ds.NewKey(pincheck.PinsKey), root); err != nil {
+		return fmt.Errorf("cannot store pin state: %w", err)
+	}
+	if err := d.Sync(ds.NewKey(pincheck.PinsKey)); err != nil {
 		return err
 	}
 
-	_, toIPLDCount, err := pinconv.ConvertPinsFromDSToIPLD(ctx, dstore, dserv, internalDag)
-	if err != nil {
-		log.Error("failed to conver pin data from datastore to ipld pinner")
+	if err := deleteAll(d, pinsPrefix); err != nil {
+		return fmt.Errorf("cannot delete datastore pins: %w", err)
+	}
+	if err := d.Sync(ds.NewKey(pinsPrefix)); err != nil {
 		return err
 	}
-	log.Log("converted %d pins from datastore to ipld storage", toIPLDCount)
+	log.Info("  - converted %d pins from datastore to ipld storage in %d blocks", count, w.nodes)
 	return nil
 }
@@ -0,0 +1,205 @@
+package mg10
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	pb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-merkledag/pb"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+)
+
+// open opens the datastore of r, which must be closed before migrating.
+func open(t *testing.T, r *migrationtest.Repo) ds.Datastore {
+	t.Helper()
+	d, err := convert.Open(r.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func testPins(t *testing.T, n int) [][]byte {
+	var pins [][]byte
+	for i := 0; i < n; i++ {
+		hash, err := mh.Sum([]byte(fmt.Sprint("pin ", i)), mh.SHA2_256, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pins = append(pins, hash)
+	}
+	return pins
+}
+
+// writePins writes pin sets holding pins to the repo at version 10 and
+// returns the hash of their root.
+func writePins(t *testing.T, r *migrationtest.Repo, pins map[string][][]byte) []byte {
+	t.Helper()
+	d := open(t, r)
+	defer d.Close()
+	root, err := (&pinSetWriter{d: d}).writeRoot(pins)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Put(ds.NewKey(pincheck.PinsKey), root); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Sync(ds.NewKey("/")); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func count(t *testing.T, d ds.Datastore, prefix string) int {
+	t.Helper()
+	res, err := d.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := res.Rest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(entries)
+}
+
+func TestApplyRevert(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(b))
+			all := testPins(t, 5)
+			pins := map[string][][]byte{"recursive": all[:3], "direct": all[2:]}
+			root := writePins(t, r, pins)
+
+			r.MustApply(Migration{})
+			r.AssertVersion(11)
+
+			d := open(t, r)
+			if ok, _ := d.Has(ds.NewKey(pincheck.PinsKey)); ok {
+				t.Error("pin root key left after applying")
+			}
+			for set, want := range pins {
+				got, err := indexedPins(d, set)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got) != len(want) {
+					t.Errorf("%d %s pins, want %d", len(got), set, len(want))
+				}
+				for _, c := range want {
+					if !got[string(c)] {
+						t.Errorf("%s pin %x not converted", set, c)
+					}
+				}
+			}
+			if n := count(t, d, pinPrefix); n != 6 {
+				t.Errorf("%d pin records, want 6", n)
+			}
+			if dirty, _ := d.Get(ds.NewKey(dirtyKey)); !bytes.Equal(dirty, []byte{0}) {
+				t.Errorf("dirty flag is %v", dirty)
+			}
+			d.Close()
+
+			r.MustRevert(Migration{})
+			r.AssertVersion(10)
+
+			d = open(t, r)
+			defer d.Close()
+			got, err := d.Get(ds.NewKey(pincheck.PinsKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the same pins make the same pin sets
+			if !bytes.Equal(got, root) {
+				t.Errorf("reverted to pin root %x, want %x", got, root)
+			}
+			if n := count(t, d, pinsPrefix); n != 0 {
+				t.Errorf("%d datastore pin keys left after reverting", n)
+			}
+		})
+	}
+}
+
+func TestApplyManyPins(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(migrationtest.Leveldb))
+	pins := map[string][][]byte{"recursive": testPins(t, maxItems+1)}
+	root := writePins(t, r, pins)
+
+	r.MustApply(Migration{})
+	d := open(t, r)
+	if got, _ := indexedPins(d, "recursive"); len(got) != maxItems+1 {
+		t.Errorf("%d recursive pins, want %d", len(got), maxItems+1)
+	}
+	d.Close()
+
+	r.MustRevert(Migration{})
+	d = open(t, r)
+	defer d.Close()
+	if got, _ := d.Get(ds.NewKey(pincheck.PinsKey)); !bytes.Equal(got, root) {
+		t.Errorf("reverted to pin root %x, want %x", got, root)
+	}
+}
+
+func TestApplyResumes(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10)
+	all := testPins(t, 3)
+	writePins(t, r, map[string][][]byte{"recursive": all})
+
+	// an earlier run converted the first pin, and indexed the second
+	// without writing its record
+	d := open(t, r)
+	if err := addPin(d, "recursive", all[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Put(indexKey("recursive", all[1], "/lost"), []byte{}); err != nil {
+		t.Fatal(err)
+	}
+	d.Sync(ds.NewKey("/"))
+	d.Close()
+
+	r.MustApply(Migration{})
+	d = open(t, r)
+	defer d.Close()
+	if n := count(t, d, pinPrefix); n != 3 {
+		t.Errorf("%d pin records, want 3", n)
+	}
+	if got, _ := indexedPins(d, "recursive"); len(got) != 3 {
+		t.Errorf("%d recursive pins, want 3", len(got))
+	}
+}
+
+func TestApplyMissingSet(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10)
+	root := writePins(t, r, map[string][][]byte{"recursive": testPins(t, 2)})
+
+	// drop the recursive set node
+	d := open(t, r)
+	data, err := d.Get(dsblocks.Keys(root)[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n pb.PBNode
+	if err := n.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range n.Links {
+		if l.GetName() == "recursive" {
+			if err := d.Delete(dsblocks.Keys(l.Hash)[0]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	d.Close()
+
+	if err := r.Apply(Migration{}); err == nil {
+		t.Fatal("expected applying to fail")
+	}
+	r.AssertVersion(10)
+}
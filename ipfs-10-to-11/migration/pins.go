@@ -0,0 +1,134 @@
+package mg10
+
+import (
+	"fmt"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multibase"
+	cbor "github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/cbor"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/polydawn/refmt/obj/atlas"
+)
+
+// The pins of repo version 11 are kept by the go-ipfs dspinner: a CBOR
+// record per pin under pinPrefix, found through an index per mode mapping
+// each CID to the IDs of its records.
+const (
+	pinsPrefix = "/pins"
+	pinPrefix  = "/pins/pin"
+	dirtyKey   = "/pins/state/dirty"
+)
+
+// Pin modes, as numbered in the records.
+const (
+	modeRecursive = 0
+	modeDirect    = 1
+)
+
+// pinIndexes are the index namespaces of each mode, by the name of the
+// pin set holding the mode's pins at repo version 10.
+var pinIndexes = map[string]string{
+	"recursive": "/pins/index/cidRindex",
+	"direct":    "/pins/index/cidDindex",
+}
+
+var pinModes = map[string]int{
+	"recursive": modeRecursive,
+	"direct":    modeDirect,
+}
+
+// pinRecord is a pin as the dspinner stores it, less the metadata and name
+// it omits when empty, as they are for converted pins.
+type pinRecord struct {
+	Cid  []byte
+	Mode int
+}
+
+var pinAtlas = atlas.MustBuild(
+	atlas.BuildEntry(pinRecord{}).StructMap().
+		AddField("Cid", atlas.StructMapEntry{SerialName: "cid"}).
+		AddField("Mode", atlas.StructMapEntry{SerialName: "mode"}).
+		Complete(),
+).WithMapMorphism(atlas.MapMorphism{KeySortMode: atlas.KeySortMode_Strings})
+
+// indexKey returns the index entry of the pin record id for the CID c.
+// Both parts are multibase encoded, as the dspinner's indexes do.
+func indexKey(set string, c []byte, id string) ds.Key {
+	enc := func(b []byte) string {
+		s, _ := multibase.Encode(multibase.Base64url, b)
+		return s
+	}
+	return ds.NewKey(pinIndexes[set]).ChildString(enc(c)).ChildString(enc([]byte(id)))
+}
+
+// addPin writes a pin record for the CID c to the pin set, and its index
+// entry before it, so that go-ipfs drops the entry if the record is never
+// written.
+func addPin(d ds.Datastore, set string, c []byte) error {
+	// the dspinner's IDs keep the leading slash of the key
+	id := ds.RandomKey().String()
+	data, err := cbor.MarshalAtlased(pinRecord{Cid: c, Mode: pinModes[set]}, pinAtlas)
+	if err != nil {
+		return err
+	}
+	if err := d.Put(indexKey(set, c, id), []byte{}); err != nil {
+		return err
+	}
+	return d.Put(ds.NewKey(pinPrefix+id), data)
+}
+
+// indexedPins returns the CIDs indexed in the pin set whose pin record
+// exists, by their bytes as a string.
+func indexedPins(d ds.Datastore, set string) (map[string]bool, error) {
+	prefix := pinIndexes[set]
+	results, err := d.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	depth := len(ds.NewKey(prefix).Namespaces())
+	pins := make(map[string]bool)
+	for r := range results.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		ns := ds.RawKey(r.Key).Namespaces()
+		if len(ns) != depth+2 {
+			continue
+		}
+		_, c, err := multibase.Decode(ns[depth])
+		if err != nil {
+			return nil, fmt.Errorf("pin index key %s: %w", r.Key, err)
+		}
+		_, id, err := multibase.Decode(ns[depth+1])
+		if err != nil {
+			return nil, fmt.Errorf("pin index key %s: %w", r.Key, err)
+		}
+		ok, err := d.Has(ds.NewKey(pinPrefix + string(id)))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			pins[string(c)] = true
+		}
+	}
+	return pins, nil
+}
+
+// deleteAll deletes every key under prefix.
+func deleteAll(d ds.Datastore, prefix string) error {
+	results, err := d.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := d.Delete(ds.RawKey(e.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package mg10
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	pb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-merkledag/pb"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+)
+
+// The pins of repo version 10 are kept by the go-ipfs ipld pinner:
+// pincheck.PinsKey holds the hash of a dag-pb root node linking to a pin
+// set per mode. A set node has fanout links to buckets, which are set nodes
+// themselves or the empty node, followed by links to the pins it holds.
+const (
+	defaultFanout = 256
+	// maxItems is the number of pins a set node holds before they are
+	// spread over its buckets.
+	maxItems = 8192
+)
+
+// pinSetWriter writes the nodes of pin sets as blocks.
+type pinSetWriter struct {
+	d ds.Datastore
+	// nodes counts the blocks written
+	nodes int
+}
+
+// put writes the dag-pb node n and returns its hash, a CIDv0, and its
+// cumulative size as recorded in links to it.
+func (w *pinSetWriter) put(n *pb.PBNode) ([]byte, uint64, error) {
+	data, err := n.Marshal()
+	if err != nil {
+		return nil, 0, err
+	}
+	hash, err := mh.Sum(data, mh.SHA2_256, -1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := w.d.Put(dsblocks.Keys(hash)[0], data); err != nil {
+		return nil, 0, err
+	}
+	w.nodes++
+	size := uint64(len(data))
+	for _, l := range n.Links {
+		size += *l.Tsize
+	}
+	return hash, size, nil
+}
+
+func link(name string, hash []byte, size uint64) *pb.PBLink {
+	return &pb.PBLink{Hash: hash, Name: &name, Tsize: &size}
+}
+
+// writeRoot writes the pin sets and the root node linking to them, and
+// returns the hash of the root. pins maps the name of each set to its pins.
+func (w *pinSetWriter) writeRoot(pins map[string][][]byte) ([]byte, error) {
+	// buckets without pins link to the empty node
+	empty, _, err := w.put(&pb.PBNode{})
+	if err != nil {
+		return nil, err
+	}
+	root := &pb.PBNode{}
+	// links are sorted by name
+	for _, set := range []string{"direct", "recursive"} {
+		hash, size, err := w.writeSet(empty, pins[set], 0)
+		if err != nil {
+			return nil, err
+		}
+		root.Links = append(root.Links, link(set, hash, size))
+	}
+	hash, _, err := w.put(root)
+	return hash, err
+}
+
+// writeSet writes the set node holding pins at depth, and the buckets
+// below it, the way the ipld pinner does, so that the same pins make the
+// same nodes.
+func (w *pinSetWriter) writeSet(empty []byte, pins [][]byte, depth uint32) ([]byte, uint64, error) {
+	n := &pb.PBNode{Data: setHeader(depth)}
+	for i := 0; i < defaultFanout; i++ {
+		n.Links = append(n.Links, link("", empty, 0))
+	}
+
+	if len(pins) < maxItems {
+		items := append([][]byte(nil), pins...)
+		sort.SliceStable(items, func(i, j int) bool { return bytes.Compare(items[i], items[j]) < 0 })
+		for _, c := range items {
+			n.Links = append(n.Links, link("", c, 0))
+		}
+		return w.put(n)
+	}
+
+	buckets := make([][][]byte, defaultFanout)
+	for _, c := range pins {
+		h := bucket(depth, c)
+		buckets[h] = append(buckets[h], c)
+	}
+	for h, items := range buckets {
+		if len(items) == 0 {
+			continue
+		}
+		hash, size, err := w.writeSet(empty, items, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		n.Links[h] = link("", hash, size)
+	}
+	return w.put(n)
+}
+
+// setHeader returns the data of a set node at depth: the varint length of
+// a protobuf message holding the version, the fanout and the depth as the
+// bucketing seed.
+func setHeader(depth uint32) []byte {
+	var fanout [binary.MaxVarintLen64]byte
+	hdr := []byte{0x08, 0x01, 0x10}
+	hdr = append(hdr, fanout[:binary.PutUvarint(fanout[:], defaultFanout)]...)
+	var seed [4]byte
+	binary.LittleEndian.PutUint32(seed[:], depth)
+	hdr = append(append(hdr, 0x1d), seed[:]...)
+
+	data := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(hdr))
+	return append(data[:binary.PutUvarint(data, uint64(len(hdr)))], hdr...)
+}
+
+// bucket returns the bucket of the pin c in a set node at depth.
+func bucket(depth uint32, c []byte) int {
+	var seed [4]byte
+	binary.LittleEndian.PutUint32(seed[:], depth)
+	h := fnv.New32a()
+	h.Write(seed[:])
+	h.Write(c)
+	return int(h.Sum32() % defaultFanout)
+}
@@ -30,8 +30,12 @@ var indirectPinDatastoreKey = dstore.NewKey("/local/pins/indirect/keys")
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "2-to-3"
+func (m Migration) FromVersion() int {
+	return 2
+}
+
+func (m Migration) ToVersion() int {
+	return 3
 }
 
 func (m Migration) Reversible() bool {
@@ -39,10 +43,10 @@ func (m Migration) Reversible() bool {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -51,7 +55,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '2'")
+	log.Debug("  - verifying version is '2'")
 	if err := repo.CheckVersion("2"); err != nil {
 		return err
 	}
@@ -60,20 +64,20 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("pin transfer completed successfuly")
+	log.Info("pin transfer completed successfuly")
 
-	err = repo.WriteVersion("3")
+	err = repo.CasVersion("2", "3")
 	if err != nil {
 		return err
 	}
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -90,7 +94,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 	}
 
 	// 3) change version number back down
-	err = repo.WriteVersion("2")
+	err = repo.CasVersion("3", "2")
 	if err != nil {
 		return err
 	}
@@ -102,7 +106,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 }
 
 func openDatastore(repopath string) (dstore.ThreadSafeDatastore, error) {
-	log.VLog("  - opening datastore at %q", repopath)
+	log.Debug("  - opening datastore at %q", repopath)
 	ldbpath := path.Join(repopath, "datastore")
 	ldb, err := leveldb.NewDatastore(ldbpath, nil)
 	if err != nil {
@@ -137,7 +141,7 @@ func constructDagServ(ds dstore.ThreadSafeDatastore) (dag.DAGService, error) {
 }
 
 func transferPins(repopath string, verbose bool) error {
-	log.Log("beginning pin transfer")
+	log.Info("beginning pin transfer")
 	ds, err := openDatastore(repopath)
 	if err != nil {
 		return err
@@ -149,9 +153,9 @@ func transferPins(repopath string, verbose bool) error {
 	}
 
 	pinner := newpin.NewPinner(ds, dserv)
-	log.VLog("  - created version 3 pinner")
+	log.Debug("  - created version 3 pinner")
 
-	log.VLog("  - loading recursive pins")
+	log.Debug("  - loading recursive pins")
 	recKeys, err := loadOldKeys(ds, recursePinDatastoreKey)
 	if err != nil {
 		return err
@@ -160,9 +164,9 @@ func transferPins(repopath string, verbose bool) error {
 	for _, k := range recKeys {
 		pinner.PinWithMode(k, newpin.Recursive)
 	}
-	log.VLog("  - transfered recursive pins")
+	log.Debug("  - transfered recursive pins")
 
-	log.VLog("  - loading direct pins")
+	log.Debug("  - loading direct pins")
 	dirKeys, err := loadOldKeys(ds, directPinDatastoreKey)
 	if err != nil {
 		return err
@@ -171,13 +175,13 @@ func transferPins(repopath string, verbose bool) error {
 	for _, k := range dirKeys {
 		pinner.PinWithMode(k, newpin.Direct)
 	}
-	log.VLog("  - transfered direct pins")
+	log.Debug("  - transfered direct pins")
 
 	err = pinner.Flush()
 	if err != nil {
 		return err
 	}
-	log.Log("pinner synced to disk")
+	log.Info("pinner synced to disk")
 
 	// ensure that the 'empty object' exists
 	_, err = dserv.Add(new(dag.Node))
@@ -189,30 +193,30 @@ func transferPins(repopath string, verbose bool) error {
 }
 
 func cleanupOldPins(ds dstore.Datastore, verbose bool) error {
-	log.Log("cleaning old pins")
+	log.Info("cleaning old pins")
 	err := cleanupKeyspace(ds, recursePinDatastoreKey)
 	if err != nil {
 		return err
 	}
-	log.VLog("  - cleaned up oldstyle recursive pins")
+	log.Debug("  - cleaned up oldstyle recursive pins")
 
 	err = cleanupKeyspace(ds, directPinDatastoreKey)
 	if err != nil {
 		return err
 	}
-	log.VLog("  - cleaned up oldstyle direct pins")
+	log.Debug("  - cleaned up oldstyle direct pins")
 
 	err = cleanupKeyspace(ds, indirectPinDatastoreKey)
 	if err != nil {
 		return err
 	}
-	log.VLog("  - cleaned up oldstyle indirect pins")
+	log.Debug("  - cleaned up oldstyle indirect pins")
 
 	return nil
 }
 
 func cleanupKeyspace(ds dstore.Datastore, k dstore.Key) error {
-	log.VLog("  - deleting recursePin root key: %q", recursePinDatastoreKey)
+	log.Debug("  - deleting recursePin root key: %q", recursePinDatastoreKey)
 	err := ds.Delete(recursePinDatastoreKey)
 	if err != nil {
 		return err
@@ -225,11 +229,14 @@ func cleanupKeyspace(ds dstore.Datastore, k dstore.Key) error {
 	if err != nil {
 		return err
 	}
+	defer res.Close()
 	for k := range res.Next() {
-		log.VLog("  - deleting pin key: %q", k.Key)
+		if k.Error != nil {
+			return k.Error
+		}
+		log.Debug("  - deleting pin key: %q", k.Key)
 		err := ds.Delete(dstore.NewKey(k.Key))
 		if err != nil {
-			res.Close()
 			return err
 		}
 	}
@@ -238,35 +245,35 @@ func cleanupKeyspace(ds dstore.Datastore, k dstore.Key) error {
 }
 
 func revertPins(repopath string, verbose bool) error {
-	log.VLog("  - reverting pins")
+	log.Debug("  - reverting pins")
 	ds, err := openDatastore(repopath)
 	if err != nil {
 		return err
 	}
 
-	log.VLog("  - construct dagservice")
+	log.Debug("  - construct dagservice")
 	dserv, err := constructDagServ(ds)
 	if err != nil {
 		return err
 	}
 
-	log.VLog("  - load pinner")
+	log.Debug("  - load pinner")
 	pinner, err := newpin.LoadPinner(ds, dserv)
 	if err != nil {
 		return err
 	}
 
-	log.VLog("  - write old recursive keys")
+	log.Debug("  - write old recursive keys")
 	if err := writeOldKeys(ds, recursePinDatastoreKey, pinner.RecursiveKeys()); err != nil {
 		return err
 	}
 
-	log.VLog("  - write old direct keys")
+	log.Debug("  - write old direct keys")
 	if err := writeOldKeys(ds, directPinDatastoreKey, pinner.DirectKeys()); err != nil {
 		return err
 	}
 
-	log.VLog("  - write old indirect pins")
+	log.Debug("  - write old indirect pins")
 	ikeys, err := indirectPins(pinner, dserv)
 	if err != nil {
 		return err
@@ -348,7 +355,7 @@ func loadOldKeys(from dstore.Datastore, k dstore.Key) ([]u.Key, error) {
 }
 
 func writeOldKeys(to dstore.Datastore, k dstore.Key, pins []u.Key) error {
-	log.Log("writing keys: %q", k, pins)
+	log.Info("writing keys: %q", k, pins)
 	b, err := json.Marshal(pins)
 	if err != nil {
 		return err
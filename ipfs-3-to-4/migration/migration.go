@@ -24,13 +24,19 @@ import (
 	base32 "github.com/ipfs/fs-repo-migrations/ipfs-3-to-4/base32"
 	nuflatfs "github.com/ipfs/fs-repo-migrations/ipfs-3-to-4/flatfs"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+	pipeline "github.com/ipfs/fs-repo-migrations/pipeline"
 	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "3-to-4"
+func (m Migration) FromVersion() int {
+	return 3
+}
+
+func (m Migration) ToVersion() int {
+	return 4
 }
 
 func (m Migration) Reversible() bool {
@@ -82,10 +88,10 @@ func newKeyFunc(prefix string) func(util.Key) dstore.Key {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -94,7 +100,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '3'")
+	log.Debug("  - verifying version is '3'")
 	if err := repo.CheckVersion("3"); err != nil {
 		return err
 	}
@@ -104,40 +110,56 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("transfering blocks to new key format")
-	if err := transferBlocks(filepath.Join(opts.Path, "blocks")); err != nil {
+	// the checks run before any key is rewritten, so that a failure leaves
+	// the repo as it was. Blocks are only renamed, which cannot lose them.
+	bs := repoBlocks{oldds: dsold, newds: dsnew}
+	if !opts.FeatureBool("mg3.skip-pin-check", false) {
+		log.Info("checking that pins resolve")
+		if err := checkPins(opts, dsnew, bs); err != nil {
+			return err
+		}
+	}
+	if !opts.FeatureBool("mg3.skip-mfs-check", false) {
+		log.Info("checking that the MFS root resolves")
+		if err := checkFilesRoot(opts, dsnew, bs); err != nil {
+			return err
+		}
+	}
+
+	log.Info("transfering blocks to new key format")
+	if err := transferBlocks(opts, filepath.Join(opts.Path, "blocks")); err != nil {
 		return err
 	}
 
 	/*
-		if err := rewriteKeys(dsold, dsnew, "blocks", newKeyFunc("/blocks/"), validateOldKey, transferBlock); err != nil {
+		if err := rewriteKeys(opts, dsold, dsnew, "blocks", newKeyFunc("/blocks/"), validateOldKey, transferBlock); err != nil {
 			return err
 		}
 	*/
 
-	log.Log("transferring stored public key records")
-	if err := rewriteKeys(dsold, dsnew, "pk", newKeyFunc("/pk/"), validateOldKey, transferPubKey); err != nil {
+	log.Info("transferring stored public key records")
+	if err := rewriteKeys(opts, dsold, dsnew, "pk", newKeyFunc("/pk/"), validateOldKey, transferPubKey); err != nil {
 		return err
 	}
 
-	log.Log("transferring stored ipns records")
-	if err := rewriteKeys(dsold, dsnew, "ipns", newKeyFunc("/ipns/"), validateOldKey, transferIpnsEntries); err != nil {
+	log.Info("transferring stored ipns records")
+	if err := rewriteKeys(opts, dsold, dsnew, "ipns", newKeyFunc("/ipns/"), validateOldKey, transferIpnsEntries); err != nil {
 		return err
 	}
 
-	err = repo.WriteVersion("4")
+	err = repo.CasVersion("3", "4")
 	if err != nil {
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -154,8 +176,8 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("reverting blocks to old key format")
-	if err := rewriteKeys(newds, oldds, "blocks", oldKeyFunc("/blocks/"), validateNewKey, transferBlock); err != nil {
+	log.Info("reverting blocks to old key format")
+	if err := revertBlocks(opts, newds, oldds); err != nil {
 		return err
 	}
 
@@ -163,18 +185,18 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("reverting stored public key records")
-	if err := rewriteKeys(newds, oldds, "pk", oldKeyFunc("/pk/"), validateNewKey, transferPubKey); err != nil {
+	log.Info("reverting stored public key records")
+	if err := rewriteKeys(opts, newds, oldds, "pk", oldKeyFunc("/pk/"), validateNewKey, transferPubKey); err != nil {
 		return err
 	}
 
-	log.Log("reverting stored ipns records")
-	if err := rewriteKeys(newds, oldds, "ipns", oldKeyFunc("/ipns/"), validateNewKey, revertIpnsEntries); err != nil {
+	log.Info("reverting stored ipns records")
+	if err := rewriteKeys(opts, newds, oldds, "ipns", oldKeyFunc("/ipns/"), validateNewKey, revertIpnsEntries); err != nil {
 		return err
 	}
 
 	// 3) change version number back down
-	err = repo.WriteVersion("3")
+	err = repo.CasVersion("4", "3")
 	if err != nil {
 		return err
 	}
@@ -185,8 +207,98 @@ func (m Migration) Revert(opts migrate.Options) error {
 	return nil
 }
 
+// repoBlocks looks up blocks by their new, base32 encoded keys, and by
+// their old keys for the blocks not transferred yet.
+type repoBlocks struct {
+	oldds, newds dstore.Datastore
+}
+
+func (b repoBlocks) Has(hash []byte) (bool, error) {
+	ok, err := b.newds.Has(newKeyFunc("/blocks/")(util.Key(hash)))
+	if ok || err != nil {
+		return ok, err
+	}
+	return b.oldds.Has(oldKeyFunc("/blocks/")(util.Key(hash)))
+}
+
+func (b repoBlocks) Get(hash []byte) ([]byte, error) {
+	v, err := b.newds.Get(newKeyFunc("/blocks/")(util.Key(hash)))
+	if err == dstore.ErrNotFound {
+		v, err = b.oldds.Get(oldKeyFunc("/blocks/")(util.Key(hash)))
+	}
+	if err == dstore.ErrNotFound {
+		return nil, pincheck.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	data, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("block %x is not a []byte", hash)
+	}
+	return data, nil
+}
+
+// checkPins fails if any pin does not resolve to a block in bs, so that
+// a repo that lost pinned content is not migrated.
+func checkPins(opts migrate.Options, ds dstore.Datastore, bs pincheck.Blocks) error {
+	v, err := ds.Get(dstore.NewKey(pincheck.PinsKey))
+	if err == dstore.ErrNotFound {
+		opts.Logger().Info("  - no pins")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	root, ok := v.([]byte)
+	if !ok {
+		return fmt.Errorf("%s is not a []byte", pincheck.PinsKey)
+	}
+
+	r, err := pincheck.Check(root, bs)
+	if err != nil {
+		return fmt.Errorf("checking pins: %w", err)
+	}
+	for _, m := range r.Missing {
+		opts.Logger().Error("missing %s", m)
+	}
+	if err := r.Err(); err != nil {
+		return err
+	}
+	opts.Logger().Info("  - %d recursive and %d direct pins resolve", r.Pins["recursive"], r.Pins["direct"])
+	return nil
+}
+
+// checkFilesRoot fails if any block of the DAG under the MFS root cannot be
+// read from bs. Files added with ipfs files only show up broken when they
+// are next read, long after the migration.
+func checkFilesRoot(opts migrate.Options, ds dstore.Datastore, bs pincheck.Blocks) error {
+	v, err := ds.Get(dstore.NewKey(pincheck.FilesRootKey))
+	if err == dstore.ErrNotFound {
+		opts.Logger().Info("  - no MFS root")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	root, ok := v.([]byte)
+	if !ok {
+		return fmt.Errorf("%s is not a []byte", pincheck.FilesRootKey)
+	}
+
+	r, err := pincheck.CheckDAG(root, bs)
+	if err != nil {
+		return fmt.Errorf("checking MFS root: %w", err)
+	}
+	for _, m := range r.Missing {
+		opts.Logger().Error("MFS %s", m)
+	}
+	if err := r.Err(); err != nil {
+		return fmt.Errorf("MFS root: %w", err)
+	}
+	opts.Logger().Info("  - read %d blocks under the MFS root", r.Blocks)
+	return nil
+}
+
 func openDatastores(repopath string) (a, b dstore.ThreadSafeDatastore, e error) {
-	log.VLog("  - opening datastore at %q", repopath)
+	log.Debug("  - opening datastore at %q", repopath)
 	ldbpath := path.Join(repopath, "datastore")
 	ldb, err := leveldb.NewDatastore(ldbpath, nil)
 	if err != nil {
@@ -228,57 +340,101 @@ func openDatastores(repopath string) (a, b dstore.ThreadSafeDatastore, e error)
 	return oldds, newds, nil
 }
 
-func rewriteKeys(oldds, newds dstore.Datastore, pref string, mkKey mkKeyFunc, valid validFunc, transfer txFunc) error {
-
-	log.Log("gathering keys...")
-	res, err := oldds.Query(dsq.Query{
-		Prefix:   pref,
-		KeysOnly: true,
-	})
-	if err != nil {
-		return err
-	}
-
-	entries, err := res.Rest()
-	if err != nil {
-		return err
-	}
-
-	log.Log("got %d keys, beginning transfer. This will take some time.", len(entries))
-
-	prog := NewProgress(len(entries))
-	for _, e := range entries {
-		prog.Next()
-
-		if !valid(e.Key) {
-			prog.Skip()
-			continue
-		}
+// rewriteKeys moves the keys under pref that valid accepts from oldds to
+// newds, through a pipeline with opts.Workers workers. Keys are streamed
+// from a KeysOnly query and each value is read once, as it is moved, so the
+// keys are never all held in memory.
+func rewriteKeys(opts migrate.Options, oldds, newds dstore.Datastore, pref string, mkKey mkKeyFunc, valid validFunc, transfer txFunc) error {
+	return rewriteFiles(opts, oldds, newds, pref, querySource(oldds, pref), mkKey, valid, transfer, nil)
+}
 
-		curk := dstore.NewKey(e.Key)
-		blk, err := oldds.Get(curk)
+// querySource streams the keys under pref in ds.
+func querySource(ds dstore.Datastore, pref string) pipeline.Source {
+	return func(yield func(pipeline.Item) bool) error {
+		res, err := ds.Query(dsq.Query{
+			Prefix:   pref,
+			KeysOnly: true,
+		})
 		if err != nil {
 			return err
 		}
-
-		blkd, ok := blk.([]byte)
-		if !ok {
-			log.Error("data %q was not a []byte", e.Key)
-			continue
+		defer res.Close()
+		for e := range res.Next() {
+			if e.Error != nil {
+				return e.Error
+			}
+			if !yield(pipeline.Item{Key: e.Key}) {
+				return nil
+			}
 		}
+		return nil
+	}
+}
 
-		err = transfer(newds, curk, blkd, mkKey)
-		if err != nil {
-			return err
+// revertBlocks moves the blocks back from the new flatfs to the old one.
+// Both keep their files in the blocks directory, so blocks are moved by
+// renaming their file rather than by reading and writing it. The shard
+// directories are scanned with opts.Workers at once, leaving out the files
+// already in the old format.
+func revertBlocks(opts migrate.Options, newds, oldds dstore.Datastore) error {
+	dir := filepath.Join(opts.Path, "blocks")
+	src := pipeline.ShardSource(dir, opts.Workers, func(name string) (string, bool) {
+		if !strings.HasSuffix(name, ".data") {
+			return "", false
 		}
-
-		err = oldds.Delete(curk)
+		key := "/blocks/" + strings.TrimSuffix(name, ".data")
+		return key, validateNewKey(key)
+	})
+	files := func(key string) (string, string, bool) {
+		if !validateNewKey(key) {
+			return "", "", false
+		}
+		name := key[strings.LastIndex(key, "/")+1:]
+		k, err := base32.RawStdEncoding.DecodeString(name)
 		if err != nil {
-			return err
+			return "", "", false
 		}
+		// named as nuflatfs and flatfs name them under the /blocks mount
+		from := filepath.Join(dir, name[:5], name+".data")
+		old := dstore.NewKey(strings.TrimPrefix(oldKeyFunc("/blocks/")(util.Key(k)).String(), "/blocks"))
+		safe := hex.EncodeToString(old.Bytes()[1:])
+		return from, filepath.Join(dir, safe[:8], safe+".data"), true
 	}
-	fmt.Println()
+	return rewriteFiles(opts, newds, oldds, "blocks", src, oldKeyFunc("/blocks/"), validateNewKey, transferBlock, files)
+}
 
+// rewriteFiles is rewriteKeys, moving the keys src yields, and renaming the
+// files that files names when it is not nil, see pipeline.Stages.Files.
+func rewriteFiles(opts migrate.Options, oldds, newds dstore.Datastore, pref string, src pipeline.Source, mkKey mkKeyFunc, valid validFunc, transfer txFunc, files func(string) (string, string, bool)) error {
+	stages := pipeline.Stages{
+		Read: func(key string) ([]byte, error) {
+			if !valid(key) {
+				return nil, pipeline.ErrSkip
+			}
+			blk, err := oldds.Get(dstore.NewKey(key))
+			if err != nil {
+				return nil, err
+			}
+			blkd, ok := blk.([]byte)
+			if !ok {
+				log.Error("data %q was not a []byte", key)
+				return nil, pipeline.ErrSkip
+			}
+			return blkd, nil
+		},
+		Write: func(it pipeline.Item) error {
+			return transfer(newds, dstore.NewKey(it.Key), it.Value, mkKey)
+		},
+		Delete: func(key string) error {
+			return oldds.Delete(dstore.NewKey(key))
+		},
+		Files: files,
+	}
+	st, err := pipeline.Run(opts, src, stages, pipeline.Options{Name: "rewriting " + pref + " keys"})
+	if err != nil {
+		return err
+	}
+	opts.Logger().Debug("  - %d keys rewritten, %d skipped", st.Done, st.Skipped)
 	return nil
 }
 
@@ -301,7 +457,7 @@ func transferPubKey(ds dstore.Datastore, oldk dstore.Key, data []byte, mkKey mkK
 
 func transferIpnsEntries(ds dstore.Datastore, oldk dstore.Key, data []byte, mkkey mkKeyFunc) error {
 	if len(oldk.String()) != 40 {
-		log.Log(" - skipping malformed ipns record: %q", oldk)
+		log.Info(" - skipping malformed ipns record: %q", oldk)
 		return nil
 	}
 	dsk := dstore.NewKey("/ipns/" + base32.RawStdEncoding.EncodeToString([]byte(oldk.String()[6:])))
@@ -310,7 +466,7 @@ func transferIpnsEntries(ds dstore.Datastore, oldk dstore.Key, data []byte, mkke
 
 func revertIpnsEntries(ds dstore.Datastore, oldk dstore.Key, data []byte, mkkey mkKeyFunc) error {
 	if len(oldk.String()) != 61 {
-		log.Log(" - skipping malformed ipns record: %q", oldk)
+		log.Info(" - skipping malformed ipns record: %q", oldk)
 		return nil
 	}
 	dec, err := base32.RawStdEncoding.DecodeString(oldk.String()[6:])
@@ -322,26 +478,28 @@ func revertIpnsEntries(ds dstore.Datastore, oldk dstore.Key, data []byte, mkkey
 	return ds.Put(dsk, data)
 }
 
-func transferBlocks(flatfsdir string) error {
-	var keys []string
-	dots := 0
-	filepath.Walk(flatfsdir, func(p string, i os.FileInfo, err error) error {
-		if dots%200 == 0 {
-			fmt.Printf("\r                         \renumerating keys")
+// transferBlocks renames the blocks in flatfsdir to their new keys as the
+// directory is walked, rather than listing them all first. Blocks renamed
+// into shard directories the walk has not listed yet are recognised by
+// their new keys and skipped. The renames are done in batches of
+// opts.BatchSize, each begun with opts.BeginBatch, so that the execution
+// window, pausing, the throttle, the memory limit and shutdown apply.
+func transferBlocks(opts migrate.Options, flatfsdir string) error {
+	prog := NewProgress(0)
+	batched := 0
+	defer func() {
+		if batched > 0 {
+			opts.EndBatch()
 		}
-		if dots%40 == 39 {
-			fmt.Printf(".")
+	}()
+	err := filepath.Walk(flatfsdir, func(p string, i os.FileInfo, err error) error {
+		if err != nil {
+			return err
 		}
-		dots++
-
 		if i.IsDir() {
 			return nil
 		}
 
-		if err != nil {
-			return err
-		}
-
 		if len(p) <= len(flatfsdir)+1 {
 			return nil
 		}
@@ -352,23 +510,24 @@ func transferBlocks(flatfsdir string) error {
 			return nil
 		}
 
-		keys = append(keys, p)
-		return nil
-	})
-
-	prog := NewProgress(len(keys))
-	for _, p := range keys {
 		prog.Next()
-		rel := p[len(flatfsdir)+1:]
-
 		justkey := rel[:len(rel)-5]
 		if validateNewKey(justkey) {
 			prog.Skip()
-			fmt.Printf("skipping %s, already in new format\n", justkey)
-			continue
+			return nil
+		}
+
+		if batched == 0 && !opts.BeginBatch() {
+			return migrate.ErrInterrupted
+		}
+		if batched++; batched == opts.BatchSize {
+			defer func() {
+				opts.EndBatch()
+				batched = 0
+			}()
 		}
 
-		_, fi := filepath.Split(rel[:len(rel)-5])
+		_, fi := filepath.Split(justkey)
 		k, err := hex.DecodeString(fi)
 		if err != nil {
 			fmt.Printf("failed to decode: %s\n", p)
@@ -380,6 +539,7 @@ func transferBlocks(flatfsdir string) error {
 			if err != nil {
 				return err
 			}
+			opts.Transferred(int64(len(data)))
 
 			key := blocks.NewBlock(data).Key()
 			k = []byte(key)
@@ -394,15 +554,14 @@ func transferBlocks(flatfsdir string) error {
 			return err
 		}
 
-		err = rename.Rename(p, nfiname)
-		if err != nil {
-			return err
-		}
-	}
-
+		return rename.Rename(p, nfiname)
+	})
 	fmt.Println()
+	if err != nil {
+		return err
+	}
 
-	err := cleanEmptyDirs(flatfsdir)
+	err = cleanEmptyDirs(flatfsdir)
 	if err != nil {
 		fmt.Println(err)
 	}
@@ -445,6 +604,8 @@ type progress struct {
 	start time.Time
 }
 
+// NewProgress returns a progress counter towards total, or counting up if
+// total is 0, as when keys are streamed rather than listed first.
 func NewProgress(total int) *progress {
 	return &progress{
 		total: total,
@@ -458,12 +619,16 @@ func (p *progress) Skip() {
 
 func (p *progress) Next() {
 	p.current++
-	fmt.Printf("\r[%d / %d]", p.current, p.total)
+	if p.total > 0 {
+		fmt.Printf("\r[%d / %d]", p.current, p.total)
+	} else {
+		fmt.Printf("\r[%d]", p.current)
+	}
 	if p.skipped > 0 {
 		fmt.Printf(" (skipped: %d)", p.skipped)
 	}
 
-	if p.current%10 == 9 {
+	if p.total > 0 && p.current%10 == 9 {
 		took := time.Now().Sub(p.start)
 		av := took / time.Duration(p.current)
 		estim := av * time.Duration(p.total-p.current)
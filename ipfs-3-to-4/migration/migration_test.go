@@ -0,0 +1,62 @@
+package mg3
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	base32 "github.com/ipfs/fs-repo-migrations/ipfs-3-to-4/base32"
+)
+
+func TestTransferBlocksBatches(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mg3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var want []string
+	for i := 0; i < 5; i++ {
+		k := []byte(strings.Repeat(string(rune('a'+i)), 34))
+		name := hex.EncodeToString(k)
+		if err := os.MkdirAll(filepath.Join(dir, name[:8]), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name[:8], name+".data"), k, 0644); err != nil {
+			t.Fatal(err)
+		}
+		nname := base32.RawStdEncoding.EncodeToString(k)
+		want = append(want, filepath.Join(dir, nname[:5], nname+".data"))
+	}
+
+	// nothing is renamed once shutdown has begun
+	opts := migrate.NewOptions(dir, migrate.WithBatchSize(2))
+	opts.Shutdown = migrate.NewShutdown(time.Second)
+	opts.Shutdown.Stop()
+	if err := transferBlocks(opts, dir); err != migrate.ErrInterrupted {
+		t.Fatalf("got error %v, want ErrInterrupted", err)
+	}
+	if _, err := os.Stat(want[0]); err == nil {
+		t.Error("block renamed after shutdown")
+	}
+
+	opts.Shutdown = migrate.NewShutdown(time.Second)
+	if err := transferBlocks(opts, dir); err != nil {
+		t.Fatal(err)
+	}
+	for _, fn := range want {
+		if _, err := os.Stat(fn); err != nil {
+			t.Error(err)
+		}
+	}
+	// every batch was ended, or Stop would wait out its grace period
+	start := time.Now()
+	opts.Shutdown.Stop()
+	if time.Since(start) > time.Second/2 {
+		t.Error("a batch was left open")
+	}
+}
@@ -10,15 +10,18 @@ import (
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
 
 	flatfs "github.com/ipfs/fs-repo-migrations/ipfs-4-to-5/go-ds-flatfs"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "4-to-5"
+func (m Migration) FromVersion() int {
+	return 4
+}
+
+func (m Migration) ToVersion() int {
+	return 5
 }
 
 func (m Migration) Reversible() bool {
@@ -33,10 +36,10 @@ func revertStep2(ffspath string) error {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -45,7 +48,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '4'")
+	log.Debug("  - verifying version is '4'")
 	if err := repo.CheckVersion("4"); err != nil {
 		return err
 	}
@@ -57,7 +60,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 		// old path doesn't exist and the new one does and is a directory
 		if _, err2 := os.Stat(basepath); os.IsNotExist(err2) {
 			if fi, err2 := os.Stat(ffspath); err2 == nil && fi.IsDir() {
-				log.Log("... blocks already renamed to blocks-v4, continuing")
+				log.Info("... blocks already renamed to blocks-v4, continuing")
 				err = nil
 			}
 		}
@@ -77,12 +80,12 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return e
 	}
 
-	log.Log("> Upgrading datastore format to have sharding specification file")
+	log.Info("> Upgrading datastore format to have sharding specification file")
 	if err := flatfs.UpgradeV0toV1(ffspath, 5); err != nil {
 		if os.IsExist(err) {
 			id, err2 := flatfs.ReadShardFunc(ffspath)
 			if err2 == nil && id.String() == flatfs.Prefix(5).String() {
-				log.Log("... datastore already has sharding specification file, continuing")
+				log.Info("... datastore already has sharding specification file, continuing")
 				err = nil
 			}
 		}
@@ -92,10 +95,10 @@ func (m Migration) Apply(opts migrate.Options) error {
 	}
 
 	tempffs := filepath.Join(opts.Path, "blocks-v5")
-	log.Log("> creating a new flatfs datastore with new format")
+	log.Info("> creating a new flatfs datastore with new format")
 	if err := flatfs.Create(tempffs, flatfs.NextToLast(2)); err != nil {
 		if err == flatfs.ErrDatastoreExists {
-			log.Log("... new flatfs datastore already exists continuing")
+			log.Info("... new flatfs datastore already exists continuing")
 			err = nil
 		}
 		if err != nil {
@@ -111,7 +114,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 		if opts.NoRevert {
 			return mainerr
 		}
-		log.Log("attempting to revert...")
+		log.Info("attempting to revert...")
 
 		if _, err := os.Stat(filepath.Join(ffspath, "SHARDING")); os.IsNotExist(err) {
 			flatfs.UpgradeV0toV1(ffspath, 5)
@@ -134,12 +137,12 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return revert1(mainerr)
 	}
 
-	log.Log("> converting current flatfs datastore to new format")
+	log.Info("> converting current flatfs datastore to new format")
 	if err := flatfs.Move(ffspath, tempffs, os.Stdout); err != nil {
 		return revert3(err)
 	}
 
-	log.Log("> moving new datastore into place")
+	log.Info("> moving new datastore into place")
 	if err := os.Remove(ffspath); err != nil {
 		return revert3(fmt.Errorf("removing supposedly empty old flatfs dir: %s", err))
 	}
@@ -156,18 +159,18 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return revert3(mainerr)
 	}
 
-	log.Log("> moving transferred datastore back into place")
+	log.Info("> moving transferred datastore back into place")
 	if err := os.Rename(tempffs, basepath); err != nil {
 		return revert4(fmt.Errorf("moving new datastore into place of the old one: %s", err))
 	}
 
-	err = repo.WriteVersion("5")
+	err = repo.CasVersion("4", "5")
 	if err != nil {
 		log.Error("failed to update version file to 5")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
@@ -189,8 +192,8 @@ func readPhase(file string) (int, error) {
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -239,7 +242,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 			}
 
 		case 5:
-			err = repo.WriteVersion("4")
+			err = repo.CasVersion("5", "4")
 			if err != nil {
 				return err
 			}
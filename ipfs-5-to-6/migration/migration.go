@@ -13,13 +13,16 @@ import (
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "5-to-6"
+func (m Migration) FromVersion() int {
+	return 5
+}
+
+func (m Migration) ToVersion() int {
+	return 6
 }
 
 func (m Migration) Reversible() bool {
@@ -27,10 +30,10 @@ func (m Migration) Reversible() bool {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -39,7 +42,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '5'")
+	log.Debug("  - verifying version is '5'")
 	if err := repo.CheckVersion("5"); err != nil {
 		return err
 	}
@@ -50,7 +53,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 		if os.IsNotExist(err) {
 			_, err2 := os.Stat(v5path)
 			if err2 == nil {
-				log.Log("... config already renamed to config-v5, continuing")
+				log.Info("... config already renamed to config-v5, continuing")
 				err = nil
 			}
 		}
@@ -70,7 +73,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return e
 	}
 
-	log.Log("> Upgrading config to new format")
+	log.Info("> Upgrading config to new format")
 
 	cfg, err := convertFile(v5path, basepath, ver5to6)
 	if err != nil {
@@ -95,13 +98,13 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return revert1(err)
 	}
 
-	err = repo.WriteVersion("6")
+	err = repo.CasVersion("5", "6")
 	if err != nil {
 		log.Error("failed to update version file to 6")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
@@ -123,8 +126,8 @@ func readPhase(file string) (int, error) {
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -160,7 +163,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 				return err
 			}
 		case 3:
-			if err := repo.WriteVersion("5"); err != nil {
+			if err := repo.CasVersion("6", "5"); err != nil {
 				return err
 			}
 			if opts.Verbose {
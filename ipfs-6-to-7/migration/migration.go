@@ -22,8 +22,12 @@ import (
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "6-to-7"
+func (m Migration) FromVersion() int {
+	return 6
+}
+
+func (m Migration) ToVersion() int {
+	return 7
 }
 
 func (m Migration) Reversible() bool {
@@ -68,7 +72,7 @@ func applyForKey(dstore ds.Datastore, k ci.PrivKey) error {
 	_, ipns := namesys.IpnsKeysForID(id)
 	record, err := dstore.Get(dshelp.NewKeyFromBinary([]byte(ipns)))
 	if err == ds.ErrNotFound {
-		log.VLog("no IPNS record for key found")
+		log.Debug("no IPNS record for key found")
 		return nil
 	}
 	if err != nil {
@@ -95,8 +99,8 @@ func applyForKey(dstore ds.Datastore, k ci.PrivKey) error {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
 	r, err := fsrepo.Open(opts.Path)
 	if err != nil {
@@ -117,14 +121,14 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	log.VLog("migrating IPNS record for key: self")
+	log.Debug("migrating IPNS record for key: self")
 	err = applyForKey(dstore, sk)
 	if err != nil {
 		return err
 	}
 
 	for _, keyName := range keys {
-		log.VLog("migrating IPNS record for key:", keyName)
+		log.Debug("migrating IPNS record for key:", keyName)
 		k, err := ks.Get(keyName)
 		if err != nil {
 			return err
@@ -141,7 +145,7 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
@@ -157,7 +161,7 @@ func revertForKey(dstore ds.Datastore, sk ci.PrivKey, k ci.PrivKey) error {
 	newkey := ds.NewKey("/ipns/" + base32.RawStdEncoding.EncodeToString([]byte(id)))
 	val, err := dstore.Get(newkey)
 	if err == ds.ErrNotFound {
-		log.VLog("no IPNS record for key found")
+		log.Debug("no IPNS record for key found")
 		return nil
 	}
 	if err != nil {
@@ -186,8 +190,8 @@ func revertForKey(dstore ds.Datastore, sk ci.PrivKey, k ci.PrivKey) error {
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 
 	// We're downgrading from version 7.
 	fsrepo.RepoVersion = 7
@@ -198,7 +202,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 	}
 	defer r.Close()
 
-	log.VLog("decoding private key")
+	log.Debug("decoding private key")
 
 	sk, err := myKey(r)
 	if err != nil {
@@ -213,11 +217,11 @@ func (m Migration) Revert(opts migrate.Options) error {
 
 	dstore := r.Datastore()
 
-	log.VLog("migrating IPNS record for key: self")
+	log.Debug("migrating IPNS record for key: self")
 	revertForKey(dstore, sk, sk)
 
 	for _, keyName := range keys {
-		log.VLog("migrating IPNS record for key:", keyName)
+		log.Debug("migrating IPNS record for key:", keyName)
 		k, err := ks.Get(keyName)
 		if err != nil {
 			return err
@@ -231,7 +235,7 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
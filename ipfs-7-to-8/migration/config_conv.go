@@ -76,8 +76,8 @@ func convert(in io.Reader, out io.Writer, convFunc convFunc) error {
 	if bootstrapi == nil {
 		bootstrapi, _ := confMap["bootstrap"].([]interface{})
 		if bootstrapi == nil {
-			log.Log("Bootstrap field missing or of the wrong type")
-			log.Log("Nothing to migrate")
+			log.Info("Bootstrap field missing or of the wrong type")
+			log.Info("Nothing to migrate")
 			_, err := out.Write(data)
 			return err
 		}
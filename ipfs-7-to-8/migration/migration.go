@@ -10,13 +10,16 @@ import (
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "7-to-8"
+func (m Migration) FromVersion() int {
+	return 7
+}
+
+func (m Migration) ToVersion() int {
+	return 8
 }
 
 func (m Migration) Reversible() bool {
@@ -24,10 +27,10 @@ func (m Migration) Reversible() bool {
 }
 
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -36,18 +39,23 @@ func (m Migration) Apply(opts migrate.Options) error {
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '7'")
+	log.Debug("  - verifying version is '7'")
 	if err := repo.CheckVersion("7"); err != nil {
 		return err
 	}
 
+	conv, err := opts.BootstrapConv(ver7to8)
+	if err != nil {
+		return err
+	}
+
 	basepath := filepath.Join(opts.Path, "config")
 	v7path := filepath.Join(opts.Path, "config-v7")
 	if err := os.Rename(basepath, v7path); err != nil {
 		if os.IsNotExist(err) {
 			_, err2 := os.Stat(v7path)
 			if err2 == nil {
-				log.Log("... config already renamed to config-v7, continuing")
+				log.Info("... config already renamed to config-v7, continuing")
 				err = nil
 			}
 		}
@@ -56,9 +64,9 @@ func (m Migration) Apply(opts migrate.Options) error {
 		}
 	}
 
-	log.Log("> Upgrading config to new format")
+	log.Info("> Upgrading config to new format")
 
-	if err := convertFile(v7path, basepath, ver7to8); err != nil {
+	if err := convertFile(v7path, basepath, conv); err != nil {
 		if opts.NoRevert {
 			return err
 		}
@@ -69,12 +77,12 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	if err := repo.WriteVersion("8"); err != nil {
+	if err := repo.CasVersion("7", "8"); err != nil {
 		log.Error("failed to update version file to 8")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
@@ -96,8 +104,8 @@ func readPhase(file string) (int, error) {
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
@@ -118,6 +126,11 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return fmt.Errorf("reading revert phase: %s", err)
 	}
 
+	conv, err := opts.BootstrapConv(ver8to7)
+	if err != nil {
+		return err
+	}
+
 	for ; phase < 4; phase++ {
 		switch phase {
 		case 0:
@@ -125,11 +138,11 @@ func (m Migration) Revert(opts migrate.Options) error {
 				return err
 			}
 		case 1:
-			if err := convertFile(v8path, basepath, ver8to7); err != nil {
+			if err := convertFile(v8path, basepath, conv); err != nil {
 				return err
 			}
 		case 2:
-			if err := repo.WriteVersion("7"); err != nil {
+			if err := repo.CasVersion("8", "7"); err != nil {
 				return err
 			}
 			if opts.Verbose {
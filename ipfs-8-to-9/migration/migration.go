@@ -2,6 +2,7 @@ package mg8
 
 import (
 	base32 "encoding/base32"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -10,13 +11,16 @@ import (
 
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "8-to-9"
+func (m Migration) FromVersion() int {
+	return 8
+}
+
+func (m Migration) ToVersion() int {
+	return 9
 }
 
 func (m Migration) Reversible() bool {
@@ -54,12 +58,20 @@ func decode(name string) (string, error) {
 	return string(data), err
 }
 
+// Requirements declares that the repo must have a keystore.
+func (m Migration) Requirements() []migrate.Requirement {
+	return []migrate.Requirement{
+		migrate.RequireDir(keystoreRoot, "keystore directory"),
+	}
+}
+
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	err := m.encodeDecode(
+	err := m.convertKeystore(
 		opts,
+		false,
 		isEncoded, // skip if already encoded
 		encode,
 	)
@@ -68,18 +80,47 @@ func (m Migration) Apply(opts migrate.Options) error {
 		return err
 	}
 
-	err = mfsr.RepoPath(opts.Path).WriteVersion("9")
+	err = mfsr.RepoPath(opts.Path).CasVersion("8", "9")
 	if err != nil {
 		log.Error("failed to update version file to 9")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
 
+// convertKeystore renames the keystore files with encodeDecode, after
+// checking that no key would be lost or overwritten. Unless the
+// mg8.skip-verify feature flag is set, it then checks that the keystore
+// holds the same keys as before, all converted.
+func (m Migration) convertKeystore(opts migrate.Options, revert bool, shouldApplyCodec func(string) bool, codec func(string) (string, error)) error {
+	dir := filepath.Join(opts.Path, keystoreRoot)
+	before, _, err := keyNames(dir, revert)
+	if err != nil {
+		return err
+	}
+	if _, err := planRenames(dir, revert); err != nil {
+		return err
+	}
+
+	if err := m.encodeDecode(opts, shouldApplyCodec, codec); err != nil {
+		return err
+	}
+
+	if opts.FeatureBool("mg8.skip-verify", false) {
+		return nil
+	}
+	if err := verifyKeystore(dir, before, revert); err != nil {
+		return err
+	}
+	opts.Logger().Info("verified %d keys", len(before))
+	return nil
+}
+
 func (m Migration) encodeDecode(opts migrate.Options, shouldApplyCodec func(string) bool, codec func(string) (string, error)) error {
+	log := opts.Logger()
 	keystoreRoot := filepath.Join(opts.Path, keystoreRoot)
 	fileInfos, err := ioutil.ReadDir(keystoreRoot)
 
@@ -89,37 +130,100 @@ func (m Migration) encodeDecode(opts migrate.Options, shouldApplyCodec func(stri
 
 	for _, info := range fileInfos {
 		if info.IsDir() {
-			log.Log("skipping ", info.Name(), " as it is directory!")
+			log.Info("skipping ", info.Name(), " as it is directory!")
+			log.Trace("%s: skipped, directory", info.Name())
 			continue
 		}
 
 		if shouldApplyCodec(info.Name()) {
-			log.Log("skipping ", info.Name(), ". Already in expected format!")
+			log.Info("skipping ", info.Name(), ". Already in expected format!")
+			log.Trace("%s: skipped, already converted", info.Name())
 			continue
 		}
 
-		log.VLog("Renaming key's filename: ", info.Name())
+		log.Debug("Renaming key's filename: ", info.Name())
 		encodedName, err := codec(info.Name())
 		if err != nil {
+			log.Trace("%s: errored: %s", info.Name(), err)
 			return err
 		}
 
 		src := filepath.Join(keystoreRoot, info.Name())
 		dest := filepath.Join(keystoreRoot, encodedName)
 
-		if err := os.Rename(src, dest); err != nil {
+		if !opts.BeginBatch() {
+			return migrate.ErrInterrupted
+		}
+		err = os.Rename(src, dest)
+		opts.EndBatch()
+		if err != nil {
+			log.Trace("%s: errored: %s", info.Name(), err)
 			return err
 		}
+		log.Trace("%s: renamed to %s", info.Name(), encodedName)
+		opts.Count("mg8.keys_renamed", 1)
 	}
 	return nil
 }
 
+// EstimateWork counts the keystore files that would be renamed. Renames do
+// not rewrite data, so no bytes are reported.
+func (m Migration) EstimateWork(opts migrate.Options) (migrate.Estimate, error) {
+	var est migrate.Estimate
+	fileInfos, err := ioutil.ReadDir(filepath.Join(opts.Path, keystoreRoot))
+	if err != nil {
+		return est, err
+	}
+
+	for _, info := range fileInfos {
+		if info.IsDir() {
+			continue
+		}
+		if isEncoded(info.Name()) == opts.Revert {
+			est.Keys++
+		}
+	}
+	return est, nil
+}
+
+// ModelSpace reports that renaming keystore files takes no space.
+func (m Migration) ModelSpace(opts migrate.Options) (migrate.SpaceModel, error) {
+	return migrate.InPlace("keystore file names"), nil
+}
+
+// DryRun lists the keystore files to rename and counts those already in
+// the target format. Files that cannot be renamed without losing or
+// overwriting a key are reported as notes; Apply would refuse to run.
+// Renames need no backup.
+func (m Migration) DryRun(opts migrate.Options) (migrate.DryRunReport, error) {
+	var r migrate.DryRunReport
+	dir := filepath.Join(opts.Path, keystoreRoot)
+	keys, _, err := keyNames(dir, opts.Revert)
+	if err != nil {
+		return r, err
+	}
+
+	renames, err := planRenames(dir, opts.Revert)
+	if errors.Is(err, ErrKeystoreUnsafe) {
+		r.Notef("%s", err)
+	} else if err != nil {
+		return r, err
+	}
+	for _, rn := range renames {
+		r.Changef("%s -> %s", rn.From, rn.To)
+	}
+	r.Count("rename", int64(len(renames)))
+	r.Count("already converted", int64(len(keys)-len(renames)))
+	return r, nil
+}
+
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 
-	err := m.encodeDecode(
+	err := m.convertKeystore(
 		opts,
+		true,
 		func(name string) bool {
 			return !isEncoded(name) // skip if not encoded
 		},
@@ -130,13 +234,13 @@ func (m Migration) Revert(opts migrate.Options) error {
 		return err
 	}
 
-	err = mfsr.RepoPath(opts.Path).WriteVersion("8")
+	err = mfsr.RepoPath(opts.Path).CasVersion("9", "8")
 	if err != nil {
 		log.Error("failed to update version file to 8")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
@@ -0,0 +1,63 @@
+package mg8
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestApplyRevert(t *testing.T) {
+	r := migrationtest.NewRepo(t, 8, migrationtest.WithKeys("self", "foo", "with space", "ünïcode"))
+
+	r.MustApply(Migration{})
+	r.AssertVersion(9)
+	r.AssertKeystore(9)
+
+	r.MustRevert(Migration{})
+	r.AssertVersion(8)
+	r.AssertKeystore(8)
+}
+
+func TestDryRun(t *testing.T) {
+	r := migrationtest.NewRepo(t, 8, migrationtest.WithKeys("self", "foo"))
+
+	report, err := Migration{}.DryRun(r.Options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := report.Counts["rename"]; n != 2 {
+		t.Fatalf("expected 2 keys to rename, got %d", n)
+	}
+	r.AssertKeystore(8)
+
+	r.MustApply(Migration{})
+	report, err = Migration{}.DryRun(r.Options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := report.Counts["already converted"]; n != 2 {
+		t.Fatalf("expected 2 converted keys, got %d", n)
+	}
+}
+
+func TestApplyRefusesCollision(t *testing.T) {
+	r := migrationtest.NewRepo(t, 8, migrationtest.WithKeys("self", "foo"))
+
+	// a stray copy of "foo" in the new format would be overwritten
+	encoded, err := encode("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(r.Path, keystoreRoot, encoded), []byte("other"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Apply(Migration{}); !errors.Is(err, ErrKeystoreUnsafe) {
+		t.Fatalf("expected ErrKeystoreUnsafe, got %v", err)
+	}
+	r.AssertVersion(8)
+	r.AssertExists(filepath.Join(keystoreRoot, "foo"))
+}
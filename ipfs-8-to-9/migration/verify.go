@@ -0,0 +1,115 @@
+package mg8
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ErrKeystoreUnsafe is returned, wrapped, when renaming the keystore would
+// lose or overwrite a key, or when a key went missing while renaming.
+var ErrKeystoreUnsafe = errors.New("keystore cannot be converted safely")
+
+// rename is a keystore file to be renamed.
+type rename struct {
+	From, To string
+}
+
+// keyName returns the name of the key stored in the keystore file name,
+// in either format.
+func keyName(file string) string {
+	if name, err := decode(file); err == nil {
+		return name
+	}
+	return file
+}
+
+// planRenames lists the renames that convert the keystore at dir to the
+// new format, or back to the old one if revert is set. Each file must map
+// to a file name that holds the same key, and no two files may hold the
+// same key, or one would overwrite the other; such problems are returned
+// as an error wrapping ErrKeystoreUnsafe, with the renames that are safe.
+func planRenames(dir string, revert bool) ([]rename, error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var renames []rename
+	var problems []string
+	owner := make(map[string]string) // key name -> file holding it
+	for _, info := range fileInfos {
+		if info.IsDir() {
+			continue
+		}
+		file := info.Name()
+		key := keyName(file)
+		if prev, ok := owner[key]; ok {
+			problems = append(problems, fmt.Sprintf("%s and %s both hold key %q", prev, file, key))
+			continue
+		}
+		owner[key] = file
+
+		if isEncoded(file) != revert {
+			continue // already converted
+		}
+		var to string
+		if revert {
+			to, err = decode(file)
+		} else {
+			to, err = encode(file)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", file, err))
+			continue
+		}
+		if keyName(to) != key {
+			problems = append(problems, fmt.Sprintf("%s: renaming to %s would change the key name to %q", file, to, keyName(to)))
+			continue
+		}
+		renames = append(renames, rename{From: file, To: to})
+	}
+
+	if len(problems) > 0 {
+		return renames, fmt.Errorf("%w:\n  %s", ErrKeystoreUnsafe, strings.Join(problems, "\n  "))
+	}
+	return renames, nil
+}
+
+// keyNames returns the sorted names of the keys in the keystore at dir,
+// and the files not in the expected format.
+func keyNames(dir string, revert bool) (keys, misplaced []string, err error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, info := range fileInfos {
+		if info.IsDir() {
+			continue
+		}
+		keys = append(keys, keyName(info.Name()))
+		if isEncoded(info.Name()) == revert {
+			misplaced = append(misplaced, info.Name())
+		}
+	}
+	sort.Strings(keys)
+	return keys, misplaced, nil
+}
+
+// verifyKeystore checks that the keystore at dir holds exactly the keys
+// named in before, every one of them in the expected format.
+func verifyKeystore(dir string, before []string, revert bool) error {
+	after, misplaced, err := keyNames(dir, revert)
+	if err != nil {
+		return err
+	}
+	if len(misplaced) > 0 {
+		return fmt.Errorf("%w: not converted: %s", ErrKeystoreUnsafe, strings.Join(misplaced, ", "))
+	}
+	if strings.Join(after, "\x00") != strings.Join(before, "\x00") {
+		return fmt.Errorf("%w: keys before %q, after %q", ErrKeystoreUnsafe, before, after)
+	}
+	return nil
+}
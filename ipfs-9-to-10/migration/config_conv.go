@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/ipfs/fs-repo-migrations/configrules"
 	"github.com/ipfs/fs-repo-migrations/ipfs-6-to-7/gx/ipfs/QmdYwCmx8pZRkzdcd8MhmLJqYVoVTC1aGsy5Q4reMGLNLg/atomicfile"
 	log "github.com/ipfs/fs-repo-migrations/stump"
 )
@@ -87,7 +88,7 @@ func convert(in io.Reader, out io.Writer, convBootstrap convArray, convAddresses
 func convertBootstrap(confMap map[string]interface{}, conv convArray) {
 	bootstrapi, _ := confMap["Bootstrap"].([]interface{})
 	if bootstrapi == nil {
-		log.Log("No Bootstrap field in config, skipping")
+		log.Info("No Bootstrap field in config, skipping")
 		return
 	}
 	confMap["Bootstrap"] = conv(toStringArray(bootstrapi))
@@ -97,7 +98,7 @@ func convertBootstrap(confMap map[string]interface{}, conv convArray) {
 func convertAddresses(confMap map[string]interface{}, conv convAddrs) {
 	addressesi, _ := confMap["Addresses"].(map[string]interface{})
 	if addressesi == nil {
-		log.Log("Addresses field missing or of the wrong type")
+		log.Info("Addresses field missing or of the wrong type")
 		return
 	}
 
@@ -124,33 +125,31 @@ func toStringArray(el interface{}) []string {
 	return list
 }
 
+// quicBootstrap adds the QUIC address of the bootstrapper whose TCP
+// address is in the config.
+var quicBootstrap = configrules.Rules{{
+	Op:    configrules.OpAppend,
+	Path:  "Bootstrap",
+	Value: quicBootstrapAddr,
+	If:    []configrules.Condition{{Path: "Bootstrap", Contains: ip4BootstrapAddr}},
+}}
+
 // Add QUIC Bootstrap address
 func ver9to10Bootstrap(bootstrap []string) []string {
-	hasOld := false
-	hasNew := false
-	res := make([]string, 0, len(bootstrap)+1)
+	res := make([]interface{}, 0, len(bootstrap)+1)
 	for _, addr := range bootstrap {
 		// Upgrade /ipfs & /p2p. This should have happened in migration
 		// 7-to-8, but that migration wouldn't run at all if we already
 		// had the new bootstrappers.
 		addr = strings.Replace(addr, "/ipfs/Qm", "/p2p/Qm", -1)
 		addr = strings.Replace(addr, "/ipfs/1", "/p2p/1", -1)
-
 		res = append(res, addr)
-		if addr == ip4BootstrapAddr {
-			hasOld = true
-		} else if addr == quicBootstrapAddr {
-			hasNew = true
-		}
 	}
 
-	// If the config has the old IP v4 bootstrapper, add the new QUIC
-	// bootstrapper
-	if hasOld && !hasNew {
-		res = append(res, quicBootstrapAddr)
-	}
-
-	return res
+	cfg := map[string]interface{}{"Bootstrap": res}
+	// Bootstrap is an array, so appending to it cannot fail
+	quicBootstrap.Apply(cfg)
+	return toStringArray(cfg["Bootstrap"])
 }
 
 // For each TCP address, add a QUIC address
@@ -184,3 +183,41 @@ func addQuic(addrs []string) []string {
 
 	return res
 }
+
+// Remove the QUIC Bootstrap address added by ver9to10Bootstrap
+func ver10to9Bootstrap(bootstrap []string) []string {
+	res := make([]string, 0, len(bootstrap))
+	for _, addr := range bootstrap {
+		if addr != quicBootstrapAddr {
+			res = append(res, addr)
+		}
+	}
+	return res
+}
+
+// Remove the QUIC addresses added by ver9to10Addresses
+func ver10to9Addresses(swarm, announce, noAnnounce []string) ([]string, []string, []string) {
+	return removeQuic(swarm), removeQuic(announce), removeQuic(noAnnounce)
+}
+
+var quicRegexp = regexp.MustCompile(`/udp/([0-9]+)/quic$`)
+
+// removeQuic removes each QUIC address for which addQuic would have added
+// it, i.e. that has the TCP address for the same port next to it.
+func removeQuic(addrs []string) []string {
+	tcp := make(map[string]bool)
+	for _, addr := range addrs {
+		if tcpRegexp.MatchString(addr) {
+			tcp[addr] = true
+		}
+	}
+
+	res := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if quicRegexp.MatchString(addr) && tcp[quicRegexp.ReplaceAllString(addr, `/tcp/$1`)] {
+			continue
+		}
+		res = append(res, addr)
+	}
+	return res
+}
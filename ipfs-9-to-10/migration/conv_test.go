@@ -70,6 +70,17 @@ func TestConversion(t *testing.T) {
 	if noSpace(forward) != noSpace(expForwardConfig) {
 		t.Fatalf("Mismatch\nConversion produced:\n%s\nExpected:\n%s\n", forward, expForwardConfig)
 	}
+
+	conf10to9 := new(bytes.Buffer)
+	err = convert(strings.NewReader(forward), conf10to9, ver10to9Bootstrap, ver10to9Addresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backward := conf10to9.String()
+	if noSpace(backward) != noSpace(config) {
+		t.Fatalf("Mismatch\nConversion produced:\n%s\nExpected:\n%s\n", backward, config)
+	}
 }
 
 var whitespaceRe = regexp.MustCompile(`\s`)
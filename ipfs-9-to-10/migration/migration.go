@@ -10,54 +10,95 @@ import (
 	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
-	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 type Migration struct{}
 
-func (m Migration) Versions() string {
-	return "9-to-10"
+func (m Migration) FromVersion() int {
+	return 9
+}
+
+func (m Migration) ToVersion() int {
+	return 10
 }
 
 func (m Migration) Reversible() bool {
 	return true
 }
 
+// Requirements declares the config sections this migration rewrites.
+func (m Migration) Requirements() []migrate.Requirement {
+	return []migrate.Requirement{
+		migrate.RequireConfigKey("Bootstrap"),
+		migrate.RequireConfigKey("Addresses"),
+	}
+}
+
 func (m Migration) Apply(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("applying %s repo migration", m.Versions())
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
 
-	log.VLog("locking repo at %q", opts.Path)
+	log.Debug("locking repo at %q", opts.Path)
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
 	}
 	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
 
 	repo := mfsr.RepoPath(opts.Path)
 
-	log.VLog("  - verifying version is '9'")
+	log.Debug("  - verifying version is '9'")
 	if err := repo.CheckVersion("9"); err != nil {
 		return err
 	}
 
-	log.Log("> Upgrading config to new format")
+	log.Info("> Upgrading config to new format")
 
+	convBootstrap, err := opts.BootstrapConv(ver9to10Bootstrap)
+	if err != nil {
+		return err
+	}
 	path := filepath.Join(opts.Path, "config")
-	if err := convertFile(path, ver9to10Bootstrap, ver9to10Addresses); err != nil {
+	if err := backupConfig(m, opts); err != nil {
+		return fmt.Errorf("backing up config: %w", err)
+	}
+	if err := convertFile(path, convBootstrap, ver9to10Addresses); err != nil {
 		return err
 	}
 
-	if err := repo.WriteVersion("10"); err != nil {
+	if err := repo.CasVersion("9", "10"); err != nil {
 		log.Error("failed to update version file to 10")
 		return err
 	}
 
-	log.Log("updated version file")
+	log.Info("updated version file")
 
 	return nil
 }
 
+// EstimateWork reports the size of the config file, which is the only file
+// this migration rewrites.
+func (m Migration) EstimateWork(opts migrate.Options) (migrate.Estimate, error) {
+	fi, err := os.Stat(filepath.Join(opts.Path, "config"))
+	if err != nil {
+		return migrate.Estimate{}, err
+	}
+	return migrate.Estimate{Keys: 1, Bytes: fi.Size()}, nil
+}
+
+// ModelSpace models the config being written to a temporary file and
+// renamed over the old one, which briefly keeps both.
+func (m Migration) ModelSpace(opts migrate.Options) (migrate.SpaceModel, error) {
+	est, err := m.EstimateWork(opts)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.SpaceModel{
+		{Name: "rewrite config", Peak: est.Bytes, Final: 0},
+	}, nil
+}
+
 func writePhase(file string, phase int) error {
 	return ioutil.WriteFile(file, []byte(fmt.Sprint(phase)), 0666)
 }
@@ -75,20 +116,27 @@ func readPhase(file string) (int, error) {
 }
 
 func (m Migration) Revert(opts migrate.Options) error {
-	log.Verbose = opts.Verbose
-	log.Log("reverting migration")
+	log := opts.Logger()
+	log.Info("reverting migration")
 	lk, err := lock.Lock2(opts.Path)
 	if err != nil {
 		return err
 	}
 	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
 
 	repo := mfsr.RepoPath(opts.Path)
 	if err := repo.CheckVersion("10"); err != nil {
 		return err
 	}
 
-	if err := repo.WriteVersion("9"); err != nil {
+	// without the backup, the runner falls back to Downgrade
+	if err := restoreConfig(m, opts); err != nil {
+		return err
+	}
+	log.Info("restored config from backup")
+
+	if err := repo.CasVersion("10", "9"); err != nil {
 		return err
 	}
 	if opts.Verbose {
@@ -97,3 +145,65 @@ func (m Migration) Revert(opts migrate.Options) error {
 
 	return nil
 }
+
+// Downgrade takes the repo back to version 9 without the config backup, by
+// removing the QUIC addresses Apply adds. QUIC addresses the operator
+// added next to a TCP address for the same port are removed too.
+func (m Migration) Downgrade(opts migrate.Options) error {
+	log := opts.Logger()
+	log.Info("downgrading migration")
+	lk, err := lock.Lock2(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
+
+	repo := mfsr.RepoPath(opts.Path)
+	if err := repo.CheckVersion("10"); err != nil {
+		return err
+	}
+
+	log.Info("> Downgrading config to the old format")
+	if err := convertFile(filepath.Join(opts.Path, "config"), ver10to9Bootstrap, ver10to9Addresses); err != nil {
+		return err
+	}
+
+	if err := repo.CasVersion("10", "9"); err != nil {
+		return err
+	}
+	log.Info("lowered version number to 9")
+	return nil
+}
+
+// backupConfig keeps a copy of the config as it was before the migration,
+// for Revert to put back.
+func backupConfig(m Migration, opts migrate.Options) error {
+	data, err := ioutil.ReadFile(filepath.Join(opts.Path, "config"))
+	if err != nil {
+		return err
+	}
+	w, err := opts.CreateBackup(m, "config")
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// restoreConfig puts back the config saved by backupConfig.
+func restoreConfig(m Migration, opts migrate.Options) error {
+	r, err := opts.OpenBackup(m, "config")
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return mfsr.WriteFileAtomic(filepath.Join(opts.Path, "config"), data, 0600)
+}
@@ -0,0 +1,98 @@
+package mg9
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestApplyBootstrapFile(t *testing.T) {
+	r := migrationtest.NewRepo(t, 9)
+
+	bf := filepath.Join(filepath.Dir(r.Path), "bootstrap.txt")
+	list := "# private network\n/ip4/10.0.0.1/tcp/4001/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ\n\n"
+	if err := ioutil.WriteFile(bf, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r.MustApply(Migration{}, migrate.WithBootstrapFile(bf))
+	r.AssertVersion(10)
+
+	got := r.Config()["Bootstrap"]
+	want := []interface{}{"/ip4/10.0.0.1/tcp/4001/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got bootstrap %v, expected %v", got, want)
+	}
+}
+
+func TestExecutePolicy(t *testing.T) {
+	r := migrationtest.NewRepo(t, 9)
+	before := r.Config()["Addresses"].(map[string]interface{})["Swarm"]
+
+	p := configrules.Policy{
+		Pin:   []string{"Addresses.Swarm"},
+		Force: map[string]interface{}{"Swarm.ConnMgr.HighWater": 900.0},
+	}
+	if err := migrate.Execute(Migration{}, r.Options(migrate.WithPolicy(p))); err != nil {
+		t.Fatal(err)
+	}
+	r.AssertVersion(10)
+
+	cfg := r.Config()
+	if got := cfg["Addresses"].(map[string]interface{})["Swarm"]; !reflect.DeepEqual(got, before) {
+		t.Errorf("pinned Addresses.Swarm changed from %v to %v", before, got)
+	}
+	if v, _ := mfsr.ConfigValue(cfg, "Swarm.ConnMgr.HighWater"); v != 900.0 {
+		t.Errorf("forced Swarm.ConnMgr.HighWater is %v", v)
+	}
+}
+
+func TestRevertRestoresConfig(t *testing.T) {
+	r := migrationtest.NewRepo(t, 9)
+	before := r.Config()
+
+	r.MustApply(Migration{})
+	r.AssertExists(filepath.Join(migrate.BackupsDir, "9-to-10", "config"))
+	if reflect.DeepEqual(r.Config()["Bootstrap"], before["Bootstrap"]) {
+		t.Fatal("applying did not change the bootstrap list")
+	}
+	r.MustRevert(Migration{})
+	r.AssertVersion(9)
+
+	after := r.Config()
+	for _, key := range []string{"Bootstrap", "Addresses.Swarm"} {
+		got, _ := mfsr.ConfigValue(after, key)
+		want, _ := mfsr.ConfigValue(before, key)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s is %v after reverting, expected %v", key, got, want)
+		}
+	}
+}
+
+func TestRevertWithoutBackupDowngrades(t *testing.T) {
+	r := migrationtest.NewRepo(t, 9)
+	before := r.Config()
+
+	r.MustApply(Migration{})
+	if err := os.RemoveAll(filepath.Join(r.Path, migrate.BackupsDir)); err != nil {
+		t.Fatal(err)
+	}
+	r.MustRevert(Migration{})
+	r.AssertVersion(9)
+
+	after := r.Config()
+	for _, key := range []string{"Bootstrap", "Addresses.Swarm"} {
+		got, _ := mfsr.ConfigValue(after, key)
+		want, _ := mfsr.ConfigValue(before, key)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s is %v after downgrading, expected %v", key, got, want)
+		}
+	}
+}
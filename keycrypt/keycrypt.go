@@ -0,0 +1,273 @@
+// Package keycrypt encrypts the private keys in a repo's keystore with a
+// passphrase, and decrypts them again. Each key file is sealed on its own
+// with AES-256-GCM, under a key derived from the passphrase with
+// PBKDF2-HMAC-SHA256 and a random salt stored in the file, and the config
+// records the scheme at ConfigKey so that tools can tell an encrypted
+// keystore from a plain one.
+//
+// Larger files, such as migration backups and snapshot archives, are
+// encrypted as streams with NewWriter and read with NewReader.
+package keycrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Scheme names the encryption in the config.
+const Scheme = "pbkdf2-sha256-aes256gcm"
+
+// ConfigKey is the config key recording the scheme of an encrypted
+// keystore. It is absent for a plain one.
+const ConfigKey = "Keystore.Encryption"
+
+// DefaultIterations is the default PBKDF2 iteration count.
+const DefaultIterations = 600000
+
+// MaxIterations is the highest iteration count accepted, so that a crafted
+// file cannot keep Open busy for hours.
+const MaxIterations = 10 * DefaultIterations
+
+const (
+	saltSize  = 16
+	nonceSize = 12
+	keySize   = 32
+)
+
+// magic starts every encrypted key file. It is followed by the iteration
+// count as a big endian uint32, the salt, the nonce and the sealed key.
+var magic = []byte("IPFSKEY\x01")
+
+const headerSize = 8 + 4 + saltSize + nonceSize
+
+// ErrPassphrase is returned when a key cannot be decrypted, because the
+// passphrase is wrong or the file was modified.
+var ErrPassphrase = errors.New("wrong passphrase or corrupted key file")
+
+// Options controls encryption.
+type Options struct {
+	// Iterations is the PBKDF2 iteration count for newly encrypted keys.
+	// It defaults to DefaultIterations.
+	Iterations int
+}
+
+// Result describes an encrypted or decrypted keystore.
+type Result struct {
+	Changed int // key files rewritten
+	Skipped int // key files that already were as requested
+}
+
+// Encrypted reports whether data is an encrypted key file.
+func Encrypted(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Seal encrypts a key with passphrase.
+func Seal(key, passphrase []byte, iterations int) ([]byte, error) {
+	out := make([]byte, headerSize, headerSize+len(key)+16)
+	copy(out, magic)
+	binary.BigEndian.PutUint32(out[8:], uint32(iterations))
+	salt := out[12 : 12+saltSize]
+	nonce := out[12+saltSize : headerSize]
+	if _, err := rand.Read(out[12:headerSize]); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, salt, iterations)
+	if err != nil {
+		return nil, err
+	}
+	// the header is authenticated along with the key
+	return aead.Seal(out, nonce, key, out[:headerSize]), nil
+}
+
+// Open decrypts a key file sealed with Seal.
+func Open(data, passphrase []byte) ([]byte, error) {
+	if !Encrypted(data) || len(data) < headerSize {
+		return nil, errors.New("not an encrypted key file")
+	}
+	iterations := int(binary.BigEndian.Uint32(data[8:]))
+	salt := data[12 : 12+saltSize]
+	nonce := data[12+saltSize : headerSize]
+	aead, err := newAEAD(passphrase, salt, iterations)
+	if err != nil {
+		return nil, err
+	}
+	key, err := aead.Open(nil, nonce, data[headerSize:], data[:headerSize])
+	if err != nil {
+		return nil, ErrPassphrase
+	}
+	return key, nil
+}
+
+func newAEAD(passphrase, salt []byte, iterations int) (cipher.AEAD, error) {
+	if iterations <= 0 || iterations > MaxIterations {
+		return nil, fmt.Errorf("invalid iteration count %d", iterations)
+	}
+	block, err := aes.NewCipher(pbkdf2(passphrase, salt, iterations, keySize))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// pbkdf2 derives a key of the given length with PBKDF2-HMAC-SHA256
+// (RFC 8018).
+func pbkdf2(password, salt []byte, iterations, length int) []byte {
+	prf := hmac.New(sha256.New, password)
+	var out []byte
+	u := make([]byte, 0, sha256.Size)
+	for block := uint32(1); len(out) < length; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		var n [4]byte
+		binary.BigEndian.PutUint32(n[:], block)
+		prf.Write(n[:])
+		u = prf.Sum(u[:0])
+		t := append([]byte(nil), u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		out = append(out, t...)
+	}
+	return out[:length]
+}
+
+// Encrypt encrypts every key in the keystore of the repo at path. Keys
+// that are already encrypted must open with passphrase, so an interrupted
+// run can be finished but the keystore never mixes passphrases. The repo
+// must not be in use.
+func Encrypt(path string, passphrase []byte, opts Options) (Result, error) {
+	if len(passphrase) == 0 {
+		return Result{}, errors.New("empty passphrase")
+	}
+	if opts.Iterations <= 0 {
+		opts.Iterations = DefaultIterations
+	}
+	if _, err := os.Stat(filepath.Join(path, "keystore")); err != nil {
+		return Result{}, err
+	}
+	// recorded first: a keystore that is partly encrypted must not look
+	// plain
+	if err := setScheme(path, Scheme); err != nil {
+		return Result{}, err
+	}
+	return rewrite(path, func(data []byte) ([]byte, error) {
+		if Encrypted(data) {
+			_, err := Open(data, passphrase)
+			return nil, err
+		}
+		return Seal(data, passphrase, opts.Iterations)
+	})
+}
+
+// Decrypt decrypts every key in the keystore of the repo at path. Nothing
+// is written unless every key opens with passphrase. The repo must not be
+// in use.
+func Decrypt(path string, passphrase []byte) (Result, error) {
+	res, err := rewrite(path, func(data []byte) ([]byte, error) {
+		if !Encrypted(data) {
+			return nil, nil
+		}
+		return Open(data, passphrase)
+	})
+	if err != nil {
+		return res, err
+	}
+	return res, setScheme(path, "")
+}
+
+// rewrite applies fn to every key file, and writes back those for which
+// it returns new contents. All files are processed before any is written.
+func rewrite(path string, fn func(data []byte) ([]byte, error)) (Result, error) {
+	var res Result
+	dir := filepath.Join(path, "keystore")
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return res, err
+	}
+
+	type update struct {
+		file string
+		data []byte
+		mode os.FileMode
+	}
+	var updates []update
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		file := filepath.Join(dir, info.Name())
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return res, err
+		}
+		out, err := fn(data)
+		if err != nil {
+			return res, fmt.Errorf("key file %s: %w", info.Name(), err)
+		}
+		if out == nil {
+			res.Skipped++
+			continue
+		}
+		updates = append(updates, update{file, out, info.Mode().Perm()})
+	}
+
+	for _, u := range updates {
+		if err := mfsr.WriteFileAtomic(u.file, u.data, u.mode); err != nil {
+			return res, err
+		}
+		res.Changed++
+	}
+	return res, nil
+}
+
+// setScheme records scheme at ConfigKey, or removes it if scheme is empty.
+func setScheme(path, scheme string) error {
+	rp := mfsr.RepoPath(path)
+	cfg, err := rp.Config()
+	if err != nil {
+		return err
+	}
+	r := configrules.Rule{Op: configrules.OpSet, Path: ConfigKey, Value: scheme}
+	if scheme == "" {
+		r = configrules.Rule{Op: configrules.OpRemove, Path: ConfigKey}
+	}
+	changed, err := r.Apply(cfg)
+	if err != nil || !changed {
+		return err
+	}
+	if ks, ok := cfg["Keystore"].(map[string]interface{}); ok && len(ks) == 0 {
+		delete(cfg, "Keystore")
+	}
+	return rp.WriteConfig(cfg)
+}
+
+// RepoScheme returns the scheme recorded in the config of the repo at
+// path, or "" if its keystore is not encrypted.
+func RepoScheme(path string) (string, error) {
+	cfg, err := mfsr.RepoPath(path).Config()
+	if err != nil {
+		return "", err
+	}
+	v, _ := mfsr.ConfigValue(cfg, ConfigKey)
+	s, _ := v.(string)
+	return s, nil
+}
@@ -0,0 +1,48 @@
+package keycrypt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+func TestPBKDF2(t *testing.T) {
+	// RFC 7914, section 11
+	want := "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+		"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"
+	if got := hex.EncodeToString(pbkdf2([]byte("passwd"), []byte("salt"), 1, 64)); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	key := []byte("private key")
+	sealed, err := Seal(key, []byte("secret"), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Encrypted(sealed) || bytes.Contains(sealed, key) {
+		t.Fatal("key is not encrypted")
+	}
+	got, err := Open(sealed, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, key) {
+		t.Errorf("got %q, want %q", got, key)
+	}
+
+	if _, err := Open(sealed, []byte("wrong")); err != ErrPassphrase {
+		t.Errorf("expected ErrPassphrase, got %v", err)
+	}
+	sealed[9]++ // the iteration count is authenticated
+	if _, err := Open(sealed, []byte("secret")); err != ErrPassphrase {
+		t.Errorf("expected ErrPassphrase for a modified header, got %v", err)
+	}
+
+	binary.BigEndian.PutUint32(sealed[8:], MaxIterations+1)
+	if _, err := Open(sealed, []byte("secret")); err == nil || err == ErrPassphrase {
+		t.Errorf("expected an iteration count error, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package keycrypt_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+// The repo tests are external, as migrationtest imports go-migrate, which
+// imports keycrypt to encrypt backups.
+
+func readKeys(t *testing.T, r *migrationtest.Repo) map[string][]byte {
+	t.Helper()
+	keys := make(map[string][]byte)
+	for _, name := range r.KeystoreFiles() {
+		data, err := ioutil.ReadFile(filepath.Join(r.Path, "keystore", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[name] = data
+	}
+	return keys
+}
+
+func TestRepo(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithKeys("self", "foo", "bar"))
+	plain := readKeys(t, r)
+	pass := []byte("correct horse")
+
+	res, err := keycrypt.Encrypt(r.Path, pass, keycrypt.Options{Iterations: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Changed != 3 {
+		t.Errorf("encrypted %d keys, want 3", res.Changed)
+	}
+	for name, data := range readKeys(t, r) {
+		if !keycrypt.Encrypted(data) {
+			t.Errorf("%s is not encrypted", name)
+		}
+	}
+	if s, err := keycrypt.RepoScheme(r.Path); err != nil || s != keycrypt.Scheme {
+		t.Errorf("config records scheme %q (%v), want %q", s, err, keycrypt.Scheme)
+	}
+	r.AssertKeystore(10)
+
+	// finishing an encryption is fine, with the same passphrase only
+	if res, err := keycrypt.Encrypt(r.Path, pass, keycrypt.Options{Iterations: 10}); err != nil || res.Skipped != 3 {
+		t.Errorf("re-encrypting: %+v, %v", res, err)
+	}
+	if _, err := keycrypt.Encrypt(r.Path, []byte("other"), keycrypt.Options{Iterations: 10}); !errors.Is(err, keycrypt.ErrPassphrase) {
+		t.Errorf("expected keycrypt.ErrPassphrase, got %v", err)
+	}
+
+	before := readKeys(t, r)
+	if _, err := keycrypt.Decrypt(r.Path, []byte("wrong")); !errors.Is(err, keycrypt.ErrPassphrase) {
+		t.Fatalf("expected keycrypt.ErrPassphrase, got %v", err)
+	}
+	for name, data := range readKeys(t, r) {
+		if !bytes.Equal(data, before[name]) {
+			t.Errorf("%s changed by a failed decryption", name)
+		}
+	}
+
+	if _, err := keycrypt.Decrypt(r.Path, pass); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range readKeys(t, r) {
+		if !bytes.Equal(data, plain[name]) {
+			t.Errorf("%s differs from the original key", name)
+		}
+	}
+	if s, _ := keycrypt.RepoScheme(r.Path); s != "" {
+		t.Errorf("config still records scheme %q", s)
+	}
+	if _, ok := r.Config()["Keystore"]; ok {
+		t.Error("empty Keystore section left in the config")
+	}
+}
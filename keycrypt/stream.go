@@ -0,0 +1,225 @@
+package keycrypt
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// StreamSuffix is appended to the names of files encrypted with NewWriter.
+const StreamSuffix = ".enc"
+
+// streamMagic starts every encrypted stream. It is followed by the
+// iteration count as a big endian uint32, the salt and the nonce prefix,
+// then by the sealed chunks.
+var streamMagic = []byte("IPFSENC\x01")
+
+const (
+	// chunkSize is the plaintext size of every chunk but the last.
+	chunkSize   = 64 << 10
+	prefixSize  = nonceSize - 5
+	streamHead  = 8 + 4 + saltSize + prefixSize
+	sealedChunk = chunkSize + 16
+)
+
+// ErrTruncated is returned when an encrypted stream ends before its last
+// chunk.
+var ErrTruncated = errors.New("encrypted stream is truncated")
+
+// Encrypting files larger than a key, such as backups and snapshot
+// archives, uses the STREAM construction: the data is sealed in chunks with
+// AES-256-GCM, each under a nonce made of a random prefix, the chunk number
+// and a flag marking the last chunk, so that chunks cannot be reordered,
+// dropped or cut off without Read failing.
+
+// EncryptedStream reports whether r starts with a stream written by
+// NewWriter, without consuming anything.
+func EncryptedStream(r *bufio.Reader) bool {
+	head, _ := r.Peek(len(streamMagic))
+	return bytes.Equal(head, streamMagic)
+}
+
+// ReadKeyFile reads the key or passphrase in a file. Trailing newlines
+// are dropped, so that a passphrase may be written with echo.
+func ReadKeyFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key := bytes.TrimRight(data, "\r\n")
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key file %s is empty", path)
+	}
+	return key, nil
+}
+
+type streamWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	head   []byte
+	n      uint32
+	buf    []byte
+	err    error
+	closed bool
+}
+
+// NewWriter returns a writer encrypting to w with passphrase, which may be
+// the contents of a key file. The data is only complete once the writer is
+// closed; closing does not close w.
+func NewWriter(w io.Writer, passphrase []byte, iterations int) (io.WriteCloser, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	head := make([]byte, streamHead)
+	copy(head, streamMagic)
+	binary.BigEndian.PutUint32(head[8:], uint32(iterations))
+	if _, err := rand.Read(head[12:]); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, head[12:12+saltSize], iterations)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(head); err != nil {
+		return nil, err
+	}
+	return &streamWriter{w: w, aead: aead, head: head, buf: make([]byte, 0, sealedChunk)}, nil
+}
+
+// nonce returns the nonce of chunk n.
+func nonce(head []byte, n uint32, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, head[12+saltSize:])
+	binary.BigEndian.PutUint32(nonce[prefixSize:], n)
+	if last {
+		nonce[nonceSize-1] = 1
+	}
+	return nonce
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if s.err != nil {
+			return written, s.err
+		}
+		if s.closed {
+			return written, errors.New("write to closed encrypted stream")
+		}
+		// a full chunk is only sealed once more data follows, as the
+		// last chunk is sealed differently
+		if len(s.buf) == chunkSize {
+			s.seal(false)
+			continue
+		}
+		n := copy(s.buf[len(s.buf):chunkSize], p)
+		s.buf = s.buf[:len(s.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, s.err
+}
+
+func (s *streamWriter) seal(last bool) {
+	if s.n == ^uint32(0) {
+		s.err = errors.New("encrypted stream is too long")
+		return
+	}
+	out := s.aead.Seal(s.buf[:0], nonce(s.head, s.n, last), s.buf, s.head)
+	if _, err := s.w.Write(out); err != nil {
+		s.err = err
+	}
+	s.n++
+	s.buf = s.buf[:0]
+}
+
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return s.err
+	}
+	s.closed = true
+	if s.err == nil {
+		s.seal(true)
+	}
+	return s.err
+}
+
+type streamReader struct {
+	r    *bufio.Reader
+	aead cipher.AEAD
+	head []byte
+	n    uint32
+	buf  []byte
+	out  []byte
+	done bool
+}
+
+// NewReader returns a reader decrypting a stream written by NewWriter. Read
+// fails with ErrPassphrase if a chunk does not open, e.g. with the wrong
+// passphrase, and with ErrTruncated if the stream ends early.
+func NewReader(r io.Reader, passphrase []byte) (io.Reader, error) {
+	head := make([]byte, streamHead)
+	if _, err := io.ReadFull(r, head); err != nil || !bytes.HasPrefix(head, streamMagic) {
+		return nil, errors.New("not an encrypted stream")
+	}
+	iterations := int(binary.BigEndian.Uint32(head[8:]))
+	aead, err := newAEAD(passphrase, head[12:12+saltSize], iterations)
+	if err != nil {
+		return nil, err
+	}
+	return &streamReader{r: bufio.NewReader(r), aead: aead, head: head, buf: make([]byte, sealedChunk)}, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.out) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+	return n, nil
+}
+
+// next opens the next chunk, which is the last one if nothing follows it.
+func (s *streamReader) next() error {
+	n, err := io.ReadFull(s.r, s.buf)
+	last := err == io.ErrUnexpectedEOF
+	switch {
+	case err == io.EOF:
+		return ErrTruncated
+	case err != nil && !last:
+		return err
+	case !last:
+		if _, err := s.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+	out, err := s.aead.Open(s.out[:0], nonce(s.head, s.n, last), s.buf[:n], s.head)
+	if err != nil {
+		// a stream cut off after a chunk ends in a chunk not sealed as
+		// the last
+		if _, err := s.aead.Open(nil, nonce(s.head, s.n, false), s.buf[:n], s.head); last && err == nil {
+			return ErrTruncated
+		}
+		return ErrPassphrase
+	}
+	s.out = out
+	s.n++
+	s.done = last
+	return nil
+}
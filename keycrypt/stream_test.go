@@ -0,0 +1,82 @@
+package keycrypt
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func encrypt(t *testing.T, data, passphrase []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, passphrase, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// odd write sizes, to cross chunk boundaries mid-write
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		if _, err := w.Write(data[:n]); err != nil {
+			t.Fatal(err)
+		}
+		data = data[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decrypt(data, passphrase []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(data), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestStream(t *testing.T) {
+	pass := []byte("correct horse")
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 5} {
+		data := make([]byte, size)
+		rand.Read(data)
+		enc := encrypt(t, data, pass)
+		got, err := decrypt(enc, pass)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("size %d: %v", size, err)
+		}
+		if _, err := decrypt(enc, []byte("wrong")); !errors.Is(err, ErrPassphrase) {
+			t.Errorf("size %d: wrong passphrase gave %v", size, err)
+		}
+	}
+
+	data := make([]byte, 2*chunkSize+10)
+	enc := encrypt(t, data, pass)
+	// cut off right after a chunk, which then looks like a whole stream
+	cut := enc[:streamHead+sealedChunk]
+	if _, err := decrypt(cut, pass); !errors.Is(err, ErrTruncated) {
+		t.Errorf("truncated at a chunk boundary: %v", err)
+	}
+	if _, err := decrypt(enc[:streamHead], pass); !errors.Is(err, ErrTruncated) {
+		t.Errorf("no chunks: %v", err)
+	}
+	tampered := append([]byte(nil), enc...)
+	tampered[streamHead+sealedChunk+3] ^= 1
+	if _, err := decrypt(tampered, pass); !errors.Is(err, ErrPassphrase) {
+		t.Errorf("tampered: %v", err)
+	}
+	if _, err := decrypt([]byte("plain text, long enough for a header"), pass); err == nil {
+		t.Error("decrypted a plain file")
+	}
+
+	w, _ := NewWriter(ioutil.Discard, pass, 1)
+	w.Close()
+	if _, err := w.Write([]byte("x")); err == nil {
+		t.Error("wrote to a closed stream")
+	}
+}
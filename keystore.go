@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+)
+
+// passphraseEnv holds the keystore passphrase when no -passphrase-file is
+// given.
+const passphraseEnv = "IPFS_KEYSTORE_PASSPHRASE"
+
+func runKeystore(args []string) error {
+	fs := flag.NewFlagSet("keystore", flag.ExitOnError)
+	encrypt := fs.Bool("encrypt", false, "encrypt the keystore's private keys")
+	decrypt := fs.Bool("decrypt", false, "decrypt the keystore's private keys")
+	passFile := fs.String("passphrase-file", "", "read the passphrase from this file instead of $"+passphraseEnv)
+	iterations := fs.Int("iterations", keycrypt.DefaultIterations, "PBKDF2 iterations for encrypted keys")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to run")
+	fs.Parse(args)
+
+	if *encrypt == *decrypt {
+		return fmt.Errorf("keystore: give one of -encrypt and -decrypt")
+	}
+	pass, err := readPassphrase(*passFile)
+	if err != nil {
+		return err
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	if *encrypt {
+		res, err := keycrypt.Encrypt(ipfsdir, pass, keycrypt.Options{Iterations: *iterations})
+		if err != nil {
+			return fmt.Errorf("encrypting keystore (run again to resume): %w", err)
+		}
+		fmt.Printf("encrypted %d keys, %d already encrypted\n", res.Changed, res.Skipped)
+		return nil
+	}
+	res, err := keycrypt.Decrypt(ipfsdir, pass)
+	if err != nil {
+		return fmt.Errorf("decrypting keystore: %w", err)
+	}
+	fmt.Printf("decrypted %d keys, %d already plain\n", res.Changed, res.Skipped)
+	return nil
+}
+
+// readPassphrase reads the passphrase from file, without a trailing
+// newline, or from the environment if file is empty.
+func readPassphrase(file string) ([]byte, error) {
+	if file == "" {
+		pass := os.Getenv(passphraseEnv)
+		if pass == "" {
+			return nil, fmt.Errorf("keystore: set $%s or give -passphrase-file", passphraseEnv)
+		}
+		return []byte(pass), nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(data, "\r\n"), nil
+}
@@ -1,15 +1,23 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"path"
-	"strconv"
+	"path/filepath"
+	"time"
 
+	"github.com/ipfs/fs-repo-migrations/configrules"
+	"github.com/ipfs/fs-repo-migrations/control"
+	"github.com/ipfs/fs-repo-migrations/dashboard"
 	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
 	mg0 "github.com/ipfs/fs-repo-migrations/ipfs-0-to-1/migration"
 	mg1 "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/migration"
+	repolock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
 	mg10 "github.com/ipfs/fs-repo-migrations/ipfs-10-to-11/migration"
 	homedir "github.com/ipfs/fs-repo-migrations/ipfs-2-to-3/Godeps/_workspace/src/github.com/mitchellh/go-homedir"
 	mg2 "github.com/ipfs/fs-repo-migrations/ipfs-2-to-3/migration"
@@ -20,7 +28,10 @@ import (
 	mg7 "github.com/ipfs/fs-repo-migrations/ipfs-7-to-8/migration"
 	mg8 "github.com/ipfs/fs-repo-migrations/ipfs-8-to-9/migration"
 	mg9 "github.com/ipfs/fs-repo-migrations/ipfs-9-to-10/migration"
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
 	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/snapshot"
+	log "github.com/ipfs/fs-repo-migrations/stump"
 )
 
 var CurrentVersion = 11
@@ -77,50 +88,122 @@ func GetIpfsDir() (string, error) {
 	return "", err
 }
 
-func runMigration(from int, to int) error {
-	fmt.Printf("===> Running migration %d to %d...\n", from, to)
-	path, err := GetIpfsDir()
-	if err != nil {
-		return err
-	}
+// artifacts collects the files produced by every migration in the run.
+var artifacts = &gomigrate.Artifacts{}
 
-	opts := gomigrate.Options{}
-	opts.Path = path
-	opts.Verbose = true
+// shutdown is shared by every migration in the run.
+var shutdown = gomigrate.NewShutdown(gomigrate.DefaultGracePeriod)
 
-	if to > from {
-		err = migrations[from].Apply(opts)
-	} else if to < from {
-		err = migrations[to].Revert(opts)
-	} else {
-		// catch this earlier. expected invariant violated.
-		err = fmt.Errorf("attempt to run migration to same version")
-	}
-	if err != nil {
-		return fmt.Errorf("migration %d to %d failed: %s", from, to, err)
+// stopProfiling writes out the profiles set with -cpuprofile and
+// -memprofile. It must be called before exiting, which skips deferred calls.
+var stopProfiling = func() error { return nil }
+
+// window is the execution window set with -window, if any.
+var window *gomigrate.Window
+
+// throttle is the rate limit set with -max-keys-per-sec and -max-mb-per-sec,
+// if any.
+var throttle *gomigrate.Throttle
+
+// configRules are the site-specific config rules set with -config-rules.
+var configRules configrules.Rules
+
+// policy is the site policy set with -policy.
+var policy configrules.Policy
+
+// bootstrapFile is the bootstrap list set with -bootstrap-file, if any.
+var bootstrapFile string
+
+// features holds the feature flags set with -flag.
+var features = gomigrate.Features{}
+
+// telemetry receives statistics if -telemetry is set.
+var telemetry gomigrate.Telemetry
+
+// pause is worked from the dashboard set with -dashboard, if any.
+var pause *gomigrate.Pause
+
+// backupKey encrypts backup files if -backup-key-file is set.
+var backupKey []byte
+
+// workers is the number of keys migrations move at once, set by -workers.
+var workers = gomigrate.DefaultWorkers
+
+// memoryBudget is the memory in bytes migrations size their batches and
+// queues for, set by -max-memory, and memory holds work back above it.
+var (
+	memoryBudget uint64
+	memory       *gomigrate.MemoryLimit
+)
+
+// migrationOptions returns the options step runs with on the repo at path,
+// as set by the flags.
+func migrationOptions(path string, step gomigrate.Step) gomigrate.Options {
+	opts := gomigrate.NewOptions(path, gomigrate.WithVerbose(true))
+	opts.Artifacts = artifacts
+	opts.Shutdown = shutdown
+	opts.Window = window
+	opts.Throttle = throttle
+	opts.Pause = pause
+	opts.ConfigRules = configRules
+	opts.Policy = policy
+	opts.BootstrapFile = bootstrapFile
+	opts.Features = features
+	opts.Telemetry = telemetry
+	opts.BackupKey = backupKey
+	opts.Workers = workers
+	opts.Memory = memory
+	opts.Revert = step.Revert
+	opts.FitMemory(memoryBudget)
+	return opts
+}
+
+func runMigration(path string, step gomigrate.Step) error {
+	fmt.Printf("===> Running migration %s...\n", step)
+
+	if err := gomigrate.Execute(step.Migration, migrationOptions(path, step)); err != nil {
+		return fmt.Errorf("migration %s failed: %w", step, err)
 	}
-	fmt.Printf("===> Migration %d to %d succeeded!\n", from, to)
+	fmt.Printf("===> Migration %s succeeded!\n", step)
 	return nil
 }
 
-func doMigrate(from, to int) error {
-	step := 1
-	if from > to {
-		step = -1
-	}
+func doMigrate(path string, from, to int) error {
+	return migrateWith(path, from, to, runMigration)
+}
 
-	for cur := from; cur != to; cur += step {
-		err := runMigration(cur, cur+step)
+// migrateWith takes the repo at path from version from to version to,
+// running each step with run. The plan is made again after each step, so
+// that migrations are only checked for applicability against the repo they
+// would run on. It follows the repo if a step moves it.
+func migrateWith(path string, from, to int, run func(path string, step gomigrate.Step) error) error {
+	for v := from; v != to; {
+		step, err := gomigrate.NextStep(migrations, path, v, to)
 		if err != nil {
 			return err
 		}
+		if err := run(path, step); err != nil {
+			return err
+		}
+		v = step.End()
+		if moved := gomigrate.MovedRepoPath(path); moved != path {
+			// the marker moved along with the repo
+			if err := mfsr.RepoPath(moved).EndMigration(); err != nil {
+				return err
+			}
+			if err := gomigrate.JournalPhase(moved, step, gomigrate.PhaseDone, nil); err != nil {
+				return err
+			}
+			path = moved
+		}
 	}
 	return nil
 }
 
 func GetVersion(ipfsdir string) (int, error) {
-	ver, err := mfsr.RepoPath(ipfsdir).Version()
-	if _, ok := err.(mfsr.VersionFileNotFound); ok {
+	vnum, err := mfsr.RepoPath(ipfsdir).VersionNum()
+	var notFound mfsr.VersionFileNotFound
+	if errors.As(err, &notFound) {
 		// No version file in repo == version 0
 		return 0, nil
 	}
@@ -129,11 +212,6 @@ func GetVersion(ipfsdir string) (int, error) {
 		return 0, err
 	}
 
-	vnum, err := strconv.Atoi(ver)
-	if err != nil {
-		return 0, err
-	}
-
 	return vnum, nil
 }
 
@@ -153,23 +231,196 @@ func YesNoPrompt(prompt string) bool {
 }
 
 func main() {
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
 	target := flag.Int("to", CurrentVersion, "specify version to upgrade to")
 	yes := flag.Bool("y", false, "answer yes to all prompts")
 	version := flag.Bool("v", false, "print highest repo version handled and exit")
 	revertOk := flag.Bool("revert-ok", false, "allow running migrations backward")
+	windowStr := flag.String("window", "", "only do heavy work inside this daily window, e.g. \"22:00-06:00\"")
+	flag.StringVar(&bootstrapFile, "bootstrap-file", "", "replace the bootstrap list with the addresses in this file (one per line) instead of updating it")
+	rulesFile := flag.String("config-rules", "", "JSON file of site-specific config rules to apply after migrating")
+	backupKeyFile := flag.String("backup-key-file", "", "encrypt backup files with the key or passphrase in this file")
+	policyFile := flag.String("policy", "", "JSON file of config keys to pin, force or forbid across migrations")
+	maxKeys := flag.Int("max-keys-per-sec", 0, "process at most this many keys per second (0: no limit)")
+	flag.IntVar(&workers, "workers", gomigrate.DefaultWorkers, "number of keys migrations move at once")
+	maxMB := flag.Int("max-mb-per-sec", 0, "read and write at most this many MB of blocks per second (0: no limit)")
+	maxMemory := flag.Int("max-memory", 0, "size batches and queues for, and hold work back above, this many MB of memory (0: no limit)")
+	logFile := flag.String("log-file", "", "also write verbose logs to this file, rotated at 10MB")
+	telemetryFile := flag.String("telemetry", "", "append telemetry events as JSON lines to this file")
+	waitDaemon := flag.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to migrate")
+	logJSON := flag.Bool("log-json", false, "write log lines as JSON objects")
+	logLevel := log.LevelInfo
+	flag.Var(&logLevel, "log-level", "lowest level to log: debug, info, warn or error")
+	trace := flag.Bool("trace", false, "log what happens to each key to the -log-file")
+	traceFile := flag.String("trace-file", "", "log what happens to each key to this file, rotated at 10MB")
+	logTime := flag.Bool("log-time", false, "prefix log lines with the time and the time since the migration started")
+	compactAfter := flag.Bool("compact", false, "compact badger datastores after migrating, to reclaim space")
+	simulateRun := flag.Bool("simulate", false, "run the migrations on a temporary copy of the repo and discard it")
+	snapshotFirst := flag.Bool("snapshot", false, "snapshot the repo before migrating, see the snapshot command")
+	verifyFirst := flag.Bool("verify-blocks", false, "check that every block matches its key before migrating, see the verify command")
+	ignoreSpace := flag.Bool("ignore-space", false, "migrate even if the modelled peak disk usage does not fit in the free space")
+	breakStale := flag.Bool("break-stale-lock", false, "remove a repo lock left by a process that no longer runs on this host")
+	manageDaemon := flag.Bool("manage-daemon", false, "stop the ipfs daemon before migrating and start it again once the repo is migrated")
+	daemonUnit := flag.String("daemon-unit", "", "systemd unit of the daemon for -manage-daemon; without it the daemon is stopped through its API and started with -daemon-cmd")
+	daemonCmd := flag.String("daemon-cmd", "ipfs daemon", "command starting the daemon for -manage-daemon")
+	cpuProfile := flag.String("cpuprofile", "", "write a CPU profile of the run to this file")
+	memProfile := flag.String("memprofile", "", "write a heap profile to this file at the end of the run")
+	pprofAddr := flag.String("pprof", "", "serve net/http/pprof at this address, e.g. 127.0.0.1:6060")
+	dashboardAddr := flag.String("dashboard", "", "serve a progress dashboard with pause controls at this address, e.g. 127.0.0.1:5050")
+	controlAddr := flag.String("control", "", "serve the gRPC control service at this address and wait for Start, e.g. 127.0.0.1:5051")
+	daemonTimeout := flag.Duration("daemon-timeout", 2*time.Minute, "how long to wait for the daemon to stop or start with -manage-daemon")
+	flag.Var(features, "flag", "set a migration feature flag, e.g. mg8.skip-verify=true (repeatable)")
 
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [command] [flags]\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+		printCommands()
+	}
 	flag.Parse()
+	log.SetJSON(*logJSON)
+	log.SetThreshold(logLevel)
+	log.SetTimestamps(*logTime, *logTime)
+
+	if err := features.MergeEnv(); err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
 
 	if *version {
 		fmt.Println(CurrentVersion)
 		return
 	}
 
-	if *target > len(migrations) {
+	if *target > CurrentVersion {
 		fmt.Printf("No known migration to version %d. Try updating this tool.\n", *target)
 		os.Exit(1)
 	}
 
+	if *logFile != "" {
+		lf, err := log.LogToFile(*logFile)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+		defer lf.Close()
+	}
+
+	if *traceFile != "" {
+		tf, err := log.TraceToFile(*traceFile)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+		defer tf.Close()
+	} else if *trace {
+		if *logFile == "" {
+			fmt.Println("ipfs migration: -trace needs -log-file or -trace-file")
+			os.Exit(1)
+		}
+		log.SetTrace(true, nil)
+	}
+
+	if *telemetryFile != "" {
+		tf, err := os.OpenFile(*telemetryFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+		defer tf.Close()
+		telemetry = gomigrate.NewJSONTelemetry(tf)
+	}
+
+	var dash *dashboard.Dashboard
+	if *dashboardAddr != "" || *controlAddr != "" {
+		pause = gomigrate.NewPause()
+		dash = dashboard.New(pause, telemetry)
+		dash.Abort = shutdown.Stop
+		telemetry = dash
+		log.SetTap(dash)
+	}
+	if *dashboardAddr != "" {
+		ln, err := net.Listen("tcp", *dashboardAddr)
+		if err != nil {
+			fmt.Println("ipfs migration: dashboard:", err)
+			os.Exit(1)
+		}
+		defer ln.Close()
+		go http.Serve(ln, dash)
+		fmt.Printf("Dashboard at %s\n", dash.URL(ln.Addr().String()))
+	}
+	var ctl *control.Server
+	if *controlAddr != "" {
+		ln, err := net.Listen("tcp", *controlAddr)
+		if err != nil {
+			fmt.Println("ipfs migration: control:", err)
+			os.Exit(1)
+		}
+		defer ln.Close()
+		ctl = control.New(dash)
+		go http.Serve(ln, ctl.Handler())
+		fmt.Printf("Control service at %s, token %s\n", ln.Addr(), dash.Token)
+	}
+
+	if *windowStr != "" {
+		var err error
+		window, err = gomigrate.ParseWindow(*windowStr)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+	}
+
+	var err error
+	throttle, err = gomigrate.NewThrottle(*maxKeys, int64(*maxMB)<<20)
+	if err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+	if workers < 1 {
+		fmt.Println("ipfs migration: -workers must be at least 1")
+		os.Exit(1)
+	}
+	memoryBudget, err = gomigrate.MemoryBudget(*maxMemory)
+	if err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+	memory = gomigrate.NewMemoryLimit(memoryBudget)
+
+	stopProfiling, err = gomigrate.StartProfiling(*cpuProfile, *memProfile, *pprofAddr)
+	if err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+	// written on interruption too, as shutdown exits the process
+	shutdown.OnRelease(stopProfiling)
+	defer stopProfiling()
+
+	if *rulesFile != "" {
+		configRules, err = configrules.Load(*rulesFile)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+	}
+	if *backupKeyFile != "" {
+		backupKey, err = keycrypt.ReadKeyFile(*backupKeyFile)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+	}
+	if *policyFile != "" {
+		policy, err = configrules.LoadPolicy(*policyFile)
+		if err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+	}
+
 	ipfsdir, err := GetIpfsDir()
 	if err != nil {
 		fmt.Println("ipfs migration: ", err)
@@ -182,8 +433,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := gomigrate.CheckVersionSkew(vnum, CurrentVersion); err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+
 	if vnum > *target && !*revertOk {
-		fmt.Println("ipfs migration: attempt to run backward migration\nTo allow, run this command again with --revert-ok")
+		fmt.Printf("ipfs migration: attempt to run backward migration\nTo allow, run this command again with --revert-ok, or run 'fs-repo-migrations downgrade -to %d'\n", *target)
 		os.Exit(1)
 	}
 
@@ -193,14 +449,155 @@ func main() {
 	}
 
 	fmt.Printf("Found fs-repo version %d at %s\n", vnum, ipfsdir)
+
 	prompt := fmt.Sprintf("Do you want to upgrade this to version %d? [y/n]", *target)
-	if !(*yes || YesNoPrompt(prompt)) {
+	confirmed := *yes
+	if ctl != nil && !confirmed {
+		// Start stands in for the answers to the prompts
+		fmt.Println("Waiting for Start on the control service")
+		select {
+		case <-ctl.Started():
+		case <-shutdown.Stopping():
+			os.Exit(1)
+		}
+		confirmed = true
+	}
+	if *manageDaemon {
+		if *simulateRun {
+			fmt.Println("ipfs migration: -manage-daemon cannot be used with -simulate")
+			os.Exit(1)
+		}
+		// asked before the daemon is stopped rather than after
+		if !(confirmed || YesNoPrompt(prompt)) {
+			os.Exit(1)
+		}
+		confirmed = true
+		if err := stopDaemon(ipfsdir, *daemonUnit, *daemonCmd, *daemonTimeout); err != nil {
+			abort(err)
+		}
+	}
+
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		abort(err)
+	}
+
+	if *verifyFirst {
+		rep, err := verifyBlocks(ipfsdir, false)
+		if err == nil {
+			err = rep.Err()
+		}
+		if err != nil {
+			fmt.Println("ipfs migration: run the verify command with -quarantine to move bad blocks aside")
+			abort(err)
+		}
+	}
+
+	if !*ignoreSpace {
+		steps, err := gomigrate.Plan(migrations, ipfsdir, vnum, *target)
+		if err == nil {
+			var sp gomigrate.SpacePlan
+			if sp, err = gomigrate.PlanSpace(steps, ipfsdir); err == nil {
+				err = checkSpace(ipfsdir, sp)
+			}
+		}
+		if errors.Is(err, gomigrate.ErrNotEnoughSpace) {
+			fmt.Println("ipfs migration: see the estimate command for where the space goes, or pass -ignore-space")
+		}
+		if err != nil {
+			abort(err)
+		}
+	}
+
+	if *simulateRun {
+		if err := simulate(ipfsdir, vnum, *target); err != nil {
+			fmt.Println("ipfs migration: ", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if !(confirmed || YesNoPrompt(prompt)) {
 		os.Exit(1)
 	}
 
-	err = doMigrate(vnum, *target)
+	if *breakStale {
+		owner, broken, err := mfsr.RepoPath(ipfsdir).BreakStale(repolock.LockFile2)
+		if err != nil {
+			abort(err)
+		}
+		if broken {
+			fmt.Printf("removed stale repo lock left by %s\n", owner)
+		}
+	}
+
+	rec, err := gomigrate.Recover(ipfsdir, migrations)
+	if err != nil {
+		abort(err)
+	}
+	if rec != nil {
+		fmt.Printf("ipfs migration: %s\n", rec)
+	}
+	if rec != nil && rec.Action == gomigrate.Revert {
+		s, err := snapshot.Restore(ipfsdir, filepath.Dir(rec.Snapshot), filepath.Base(rec.Snapshot))
+		if err != nil {
+			abort(err)
+		}
+		fmt.Printf("===> Restored snapshot %s of version %d\n", s.Name, s.Version)
+		if vnum = s.Version; vnum == *target {
+			fmt.Println("ipfs migration: already at target version number")
+			startDaemon()
+			return
+		}
+	}
+
+	if *snapshotFirst {
+		s, err := takeSnapshot(ipfsdir, snapshot.DefaultDir(ipfsdir), "")
+		if err == nil {
+			err = gomigrate.JournalSnapshot(ipfsdir, s.Path)
+		}
+		if err != nil {
+			abort(err)
+		}
+	}
+
+	shutdown.Notify()
+	err = doMigrate(ipfsdir, vnum, *target)
+	printArtifacts(ipfsdir)
+	if errors.Is(err, gomigrate.ErrStaleLock) {
+		fmt.Println("ipfs migration: the repo lock was left by a process that no longer runs; run again with -break-stale-lock")
+	} else if errors.Is(err, gomigrate.ErrRepoLocked) {
+		fmt.Println("ipfs migration: the repo is in use; stop the ipfs daemon and try again")
+	}
 	if err != nil {
 		fmt.Println("ipfs migration: ", err)
+		if managedDaemon != nil {
+			fmt.Printf("ipfs migration: leaving the %s stopped as the repo was not migrated\n", managedDaemon)
+		}
+		stopProfiling()
 		os.Exit(1)
 	}
+
+	if *compactAfter {
+		if err := compactRepo(gomigrate.MovedRepoPath(ipfsdir), 1); err != nil {
+			// the repo is migrated all the same
+			fmt.Println("ipfs migration: ", err)
+			startDaemon()
+			stopProfiling()
+			os.Exit(1)
+		}
+	}
+	startDaemon()
+}
+
+func printArtifacts(ipfsdir string) {
+	arts, err := artifacts.Manifest(ipfsdir)
+	if err != nil {
+		fmt.Println("ipfs migration: failed to checksum artifacts: ", err)
+		return
+	}
+	if len(arts) == 0 {
+		return
+	}
+	fmt.Println("===> Artifacts (sha256):")
+	gomigrate.WriteManifest(os.Stdout, arts)
 }
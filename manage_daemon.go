@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/daemon"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	repolock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
+)
+
+// managedDaemon is the daemon stopped with -manage-daemon, to be started
+// again once the repo is migrated. It is nil if none was running.
+var managedDaemon daemon.Controller
+
+// managedPath is the repo of the managed daemon, and managedTimeout how
+// long to wait for it to start.
+var (
+	managedPath    string
+	managedTimeout time.Duration
+)
+
+// stopDaemon stops the daemon using the repo at ipfsdir, through the
+// systemd unit if there is one or else through its API, and waits for it to
+// release the repo lock.
+func stopDaemon(ipfsdir, unit, command string, timeout time.Duration) error {
+	var c daemon.Controller = daemon.API{RepoPath: ipfsdir, Command: strings.Fields(command)}
+	if unit != "" {
+		c = daemon.Systemd{Unit: unit}
+	}
+	fmt.Printf("===> Stopping %s...\n", c)
+	running, err := daemon.StopAndWait(c, ipfsdir, timeout)
+	if err != nil {
+		return err
+	}
+	if !running {
+		fmt.Println("===> The daemon is not running, it will not be started")
+		return nil
+	}
+	managedDaemon, managedPath, managedTimeout = c, ipfsdir, timeout
+	return waitUnlocked(ipfsdir, timeout)
+}
+
+// waitUnlocked waits up to timeout for the repo lock to be released. A
+// stale lock is left for -break-stale-lock to deal with.
+func waitUnlocked(ipfsdir string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		l, err := repolock.Lock2(ipfsdir)
+		if err == nil {
+			return l.Close()
+		}
+		if errors.Is(err, gomigrate.ErrStaleLock) {
+			return nil
+		}
+		if !errors.Is(err, gomigrate.ErrRepoLocked) || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// startDaemon starts the daemon stopped with -manage-daemon again, if any,
+// and waits for it to answer on its API.
+func startDaemon() {
+	if managedDaemon == nil {
+		return
+	}
+	// the 1-to-2 migration moves the repo
+	ipfsdir := gomigrate.MovedRepoPath(managedPath)
+	if api, ok := managedDaemon.(daemon.API); ok {
+		api.RepoPath = ipfsdir
+		managedDaemon = api
+	}
+	fmt.Printf("===> Starting %s...\n", managedDaemon)
+	if err := daemon.StartAndWait(managedDaemon, ipfsdir, managedTimeout); err != nil {
+		fmt.Println("ipfs migration: ", err)
+		os.Exit(1)
+	}
+	managedDaemon = nil
+}
+
+// abort reports an error that stopped the tool before the repo was
+// migrated, starting the daemon stopped with -manage-daemon again.
+func abort(err error) {
+	fmt.Println("ipfs migration: ", err)
+	startDaemon()
+	stopProfiling()
+	os.Exit(1)
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package mfsr
+
+// processAlive reports ok false, as whether a process exists cannot be
+// told on this platform.
+func processAlive(pid int) (alive, ok bool) {
+	return false, false
+}
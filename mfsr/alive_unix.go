@@ -0,0 +1,17 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mfsr
+
+import "syscall"
+
+// processAlive reports whether a process with the given pid exists on this
+// host.
+func processAlive(pid int) (alive, ok bool) {
+	if pid <= 0 {
+		return false, false
+	}
+	err := syscall.Kill(pid, 0)
+	// EPERM: the process exists but belongs to another user
+	return err == nil || err == syscall.EPERM, true
+}
@@ -0,0 +1,88 @@
+package mfsr
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// AnnotationsFile sits next to the version file and holds annotations on
+// the repo version, one "key=value" line each. Keeping them out of the
+// version file leaves that a bare number for older parsers.
+const AnnotationsFile = "version.meta"
+
+// Well-known annotation keys.
+const (
+	// AnnotationMigratedBy names the tool that last migrated the repo.
+	AnnotationMigratedBy = "migrated-by"
+	// AnnotationBackend names the blocks datastore backend.
+	AnnotationBackend = "backend"
+)
+
+func (rp RepoPath) AnnotationsFile() string {
+	return path.Join(string(rp), AnnotationsFile)
+}
+
+// Annotations returns the annotations on the repo version, or an empty map
+// if there are none.
+func (rp RepoPath) Annotations() (map[string]string, error) {
+	ann := make(map[string]string)
+	f, err := os.Open(rp.AnnotationsFile())
+	if os.IsNotExist(err) {
+		return ann, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%s: invalid line %q", rp.AnnotationsFile(), line)
+		}
+		ann[kv[0]] = kv[1]
+	}
+	return ann, s.Err()
+}
+
+// Annotate sets the annotation key to value, or removes it if value is
+// empty. Keys may not contain '=', and neither may contain line breaks.
+func (rp RepoPath) Annotate(key, value string) error {
+	if key == "" || strings.ContainsAny(key, "=\r\n#") || strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("invalid annotation %q=%q", key, value)
+	}
+	ann, err := rp.Annotations()
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		delete(ann, key)
+	} else {
+		ann[key] = value
+	}
+	return rp.writeAnnotations(ann)
+}
+
+func (rp RepoPath) writeAnnotations(ann map[string]string) error {
+	keys := make([]string, 0, len(ann))
+	for k := range ann {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "%s=%s\n", k, ann[k])
+	}
+	return WriteFileAtomic(rp.AnnotationsFile(), buf.Bytes(), 0644)
+}
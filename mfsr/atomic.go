@@ -0,0 +1,57 @@
+package mfsr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// WriteFileAtomic writes data to fn so that a crash leaves either the old or
+// the new content, never a mix: the data goes to a temp file in the same
+// directory, which is synced and renamed over fn, and the directory is then
+// synced so the rename itself is durable.
+func WriteFileAtomic(fn string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(fn)
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(fn)+"-")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmp, fn)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir fsyncs a directory. Windows cannot sync directories, and does not
+// need to for a rename to be durable.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
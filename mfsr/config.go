@@ -0,0 +1,180 @@
+package mfsr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+)
+
+const ConfigFile = "config"
+
+func (rp RepoPath) ConfigFile() string {
+	return path.Join(string(rp), ConfigFile)
+}
+
+// Config reads the repo config as generic JSON.
+func (rp RepoPath) Config() (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(rp.ConfigFile())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := make(map[string]interface{})
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", rp.ConfigFile(), err)
+	}
+	return cfg, nil
+}
+
+// WriteConfig atomically replaces the repo config with cfg, indented the
+// way the config migrations write it.
+func (rp RepoPath) WriteConfig(cfg map[string]interface{}) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(rp.ConfigFile(), append(data, '\n'), 0600)
+}
+
+// ConfigValue looks up a dotted key such as "Datastore.Spec" in cfg.
+func ConfigValue(cfg map[string]interface{}, key string) (interface{}, bool) {
+	var cur interface{} = cfg
+	for _, part := range strings.Split(key, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// Mount is a datastore mounted at a prefix of the repo datastore, as
+// described by Datastore.Spec in the config.
+type Mount struct {
+	// Mountpoint is the key prefix, e.g. "/blocks".
+	Mountpoint string
+	// Type is the backend type, e.g. "flatfs", "levelds" or "badgerds",
+	// with wrappers such as "measure" and "log" stripped.
+	Type string
+	// Spec is the backend's spec, e.g. holding its "path".
+	Spec map[string]interface{}
+}
+
+// Path returns the backend's directory relative to the repo, if it has one.
+func (m Mount) Path() string {
+	p, _ := m.Spec["path"].(string)
+	return p
+}
+
+// Mounts returns the datastore mounts described by Datastore.Spec. A spec
+// that is not a mount is returned as a single mount at "/".
+func (rp RepoPath) Mounts() ([]Mount, error) {
+	cfg, err := rp.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := ConfigValue(cfg, "Datastore.Spec")
+	if !ok {
+		return nil, fmt.Errorf("no Datastore.Spec in %s", rp.ConfigFile())
+	}
+	mounts, err := SpecMounts(v)
+	if err != nil {
+		return nil, fmt.Errorf("Datastore.Spec in %s: %w", rp.ConfigFile(), err)
+	}
+	return mounts, nil
+}
+
+// SpecMounts returns the datastore mounts described by a datastore spec as
+// found in Datastore.Spec.
+func SpecMounts(v interface{}) ([]Mount, error) {
+	spec, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("spec is not an object")
+	}
+
+	if t, _ := spec["type"].(string); t != "mount" {
+		return []Mount{newMount("/", spec)}, nil
+	}
+
+	list, ok := spec["mounts"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("mount spec has no mounts")
+	}
+
+	mounts := make([]Mount, 0, len(list))
+	for _, item := range list {
+		ms, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid mount")
+		}
+		mp, _ := ms["mountpoint"].(string)
+		mounts = append(mounts, newMount(mp, ms))
+	}
+	return mounts, nil
+}
+
+// newMount unwraps spec through wrapper types down to the backend.
+func newMount(mountpoint string, spec map[string]interface{}) Mount {
+	for {
+		t, _ := spec["type"].(string)
+		child, ok := spec["child"].(map[string]interface{})
+		if (t == "measure" || t == "log") && ok {
+			spec = child
+			continue
+		}
+		if _, isMount := spec["mountpoint"]; isMount && ok {
+			// a mount entry whose wrapper type is implied by its child
+			spec = child
+			continue
+		}
+		return Mount{Mountpoint: mountpoint, Type: t, Spec: spec}
+	}
+}
+
+// MountFor returns the mount serving key prefix mountpoint, e.g. "/blocks".
+func MountFor(mounts []Mount, mountpoint string) (Mount, bool) {
+	for _, m := range mounts {
+		if m.Mountpoint == mountpoint {
+			return m, true
+		}
+	}
+	return Mount{}, false
+}
+
+const DatastoreSpecFile = "datastore_spec"
+
+func (rp RepoPath) DatastoreSpecFile() string {
+	return path.Join(string(rp), DatastoreSpecFile)
+}
+
+// DatastoreSpec reads the datastore_spec file, which records the on-disk
+// layout of the datastores for the daemon to check Datastore.Spec against.
+func (rp RepoPath) DatastoreSpec() (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(rp.DatastoreSpecFile())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := make(map[string]interface{})
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", rp.DatastoreSpecFile(), err)
+	}
+	return spec, nil
+}
+
+// WriteDatastoreSpec atomically replaces the datastore_spec file with spec,
+// in the compact form the daemon writes.
+func (rp RepoPath) WriteDatastoreSpec(spec map[string]interface{}) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(rp.DatastoreSpecFile(), data, 0600)
+}
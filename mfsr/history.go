@@ -0,0 +1,38 @@
+package mfsr
+
+import (
+	"time"
+)
+
+// PhaseVersion marks journal entries recording a change of the version
+// file, see WriteVersion.
+const PhaseVersion = "version"
+
+// Tool identifies the program changing repo versions in the journal.
+// Programs may set it at startup.
+var Tool = "fs-repo-migrations"
+
+// VersionTransition is one change of the version file.
+type VersionTransition struct {
+	Old  string    `json:"old"` // empty when the version file was created
+	New  string    `json:"new"`
+	Time time.Time `json:"time"`
+	Tool string    `json:"tool"`
+}
+
+// VersionHistory returns the changes of the version file recorded in the
+// journal, oldest first. Repos last written before the journal existed have
+// none.
+func (rp RepoPath) VersionHistory() ([]VersionTransition, error) {
+	es, err := rp.Journal()
+	if err != nil {
+		return nil, err
+	}
+	var ts []VersionTransition
+	for _, e := range es {
+		if e.Phase == PhaseVersion {
+			ts = append(ts, VersionTransition{Old: e.Old, New: e.New, Time: e.Time, Tool: e.Tool})
+		}
+	}
+	return ts, nil
+}
@@ -0,0 +1,56 @@
+package mfsr
+
+import (
+	"path/filepath"
+	"sync"
+)
+
+type versionHook struct {
+	fn func(old, new string) error
+}
+
+var (
+	versionHooksMu sync.Mutex
+	versionHooks   = make(map[string][]*versionHook)
+)
+
+// OnVersionChange registers fn to run before WriteVersion changes the
+// version file of the repo, e.g. to finish the config in the same step as
+// the version bump. An error from fn leaves the version file as it was and
+// is returned by WriteVersion. The returned func removes the hook again.
+func (rp RepoPath) OnVersionChange(fn func(old, new string) error) (deregister func()) {
+	key := filepath.Clean(string(rp))
+	h := &versionHook{fn: fn}
+	versionHooksMu.Lock()
+	versionHooks[key] = append(versionHooks[key], h)
+	versionHooksMu.Unlock()
+	return func() {
+		versionHooksMu.Lock()
+		defer versionHooksMu.Unlock()
+		hs := versionHooks[key]
+		for i, x := range hs {
+			if x == h {
+				// copied, so a running runVersionHooks keeps its list
+				hs = append(hs[:i:i], hs[i+1:]...)
+				break
+			}
+		}
+		if len(hs) == 0 {
+			delete(versionHooks, key)
+		} else {
+			versionHooks[key] = hs
+		}
+	}
+}
+
+func (rp RepoPath) runVersionHooks(old, new string) error {
+	versionHooksMu.Lock()
+	hs := versionHooks[filepath.Clean(string(rp))]
+	versionHooksMu.Unlock()
+	for _, h := range hs {
+		if err := h.fn(old, new); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package mfsr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+)
+
+// InProgressFile marks a repo that a migration has started on but not
+// finished, so it may be in an intermediate state.
+const InProgressFile = "in-progress"
+
+// ErrMigrationInProgress is returned, wrapped, by CheckVersion while the
+// repo carries another process's in-progress marker.
+var ErrMigrationInProgress = errors.New("repo migration in progress")
+
+// InProgress is the content of the in-progress marker.
+type InProgress struct {
+	Migration string    `json:"migration"`
+	Started   time.Time `json:"started"`
+	Tool      string    `json:"tool"`
+	PID       int       `json:"pid"`
+	Hostname  string    `json:"hostname,omitempty"`
+}
+
+func (p InProgress) String() string {
+	return fmt.Sprintf("%s started %s by %s (pid %d)", p.Migration, p.Started.Local().Format(time.RFC3339), p.Tool, p.PID)
+}
+
+// running reports whether the process that left the marker may still be
+// migrating: it is alive, or ran on another host where that cannot be told.
+func (p InProgress) running() bool {
+	if p.PID == 0 || p.PID == os.Getpid() {
+		return false
+	}
+	if host, err := os.Hostname(); p.Hostname != "" && (err != nil || p.Hostname != host) {
+		return true
+	}
+	alive, ok := processAlive(p.PID)
+	return ok && alive
+}
+
+func (rp RepoPath) InProgressFile() string {
+	return path.Join(string(rp), InProgressFile)
+}
+
+// BeginMigration drops the in-progress marker for migration id, holding a
+// lock while it checks and replaces the marker. A marker left by an
+// interrupted run is replaced, taking the run over, and returned. A marker
+// of a process that may still be running fails with an error wrapping
+// ErrMigrationInProgress.
+func (rp RepoPath) BeginMigration(id string) (prev *InProgress, err error) {
+	unlock, err := lockFile(rp.InProgressFile() + ".lock")
+	if err != nil {
+		return nil, fmt.Errorf("locking in-progress marker: %w", err)
+	}
+	defer func() {
+		if uerr := unlock(); err == nil && uerr != nil {
+			err = fmt.Errorf("unlocking in-progress marker: %w", uerr)
+		}
+	}()
+
+	prev, err = rp.MigrationInProgress()
+	if err != nil {
+		return nil, err
+	}
+	if prev != nil && prev.running() {
+		return nil, fmt.Errorf("%w: %s", ErrMigrationInProgress, prev)
+	}
+
+	host, _ := os.Hostname()
+	data, err := json.Marshal(InProgress{
+		Migration: id,
+		Started:   time.Now().UTC(),
+		Tool:      Tool,
+		PID:       os.Getpid(),
+		Hostname:  host,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prev, WriteFileAtomic(rp.InProgressFile(), data, 0644)
+}
+
+// EndMigration clears the in-progress marker.
+func (rp RepoPath) EndMigration() error {
+	err := os.Remove(rp.InProgressFile())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// MigrationInProgress returns the in-progress marker, or nil if there is
+// none.
+func (rp RepoPath) MigrationInProgress() (*InProgress, error) {
+	data, err := ioutil.ReadFile(rp.InProgressFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var p InProgress
+	if err := json.Unmarshal(data, &p); err != nil {
+		// a marker we cannot read still means the repo is mid-migration
+		return &InProgress{Migration: "unknown"}, nil
+	}
+	return &p, nil
+}
+
+// checkInProgress fails if another process left an in-progress marker. The
+// process holding the marker may check versions as it migrates.
+func (rp RepoPath) checkInProgress() error {
+	p, err := rp.MigrationInProgress()
+	if err != nil {
+		return err
+	}
+	if p != nil && p.PID != os.Getpid() {
+		return fmt.Errorf("%w: %s", ErrMigrationInProgress, p)
+	}
+	return nil
+}
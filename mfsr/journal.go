@@ -0,0 +1,105 @@
+package mfsr
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path"
+	"time"
+)
+
+// JournalFile is the log of everything done to the repo's version, one JSON
+// object per line. It is the write-ahead journal of migration runs: every
+// phase a migration enters is appended and synced before the phase starts,
+// so that after a crash the last entry tells which migration was
+// interrupted and how far it got. Changes of the version file are recorded
+// in it too, and the history of migration runs is read back from it.
+const JournalFile = "migration.journal"
+
+// JournalEntry is one entry in the journal.
+type JournalEntry struct {
+	Time time.Time `json:"time"`
+	// Migration is the step, e.g. "8-to-9", or "9-to-8" when reverting.
+	Migration string `json:"migration,omitempty"`
+	Phase     string `json:"phase"`
+	Tool      string `json:"tool"`
+	PID       int    `json:"pid"`
+	// Snapshot is the snapshot taken before the run, for snapshot entries.
+	Snapshot string `json:"snapshot,omitempty"`
+	// Old and New are the versions before and after, for PhaseVersion
+	// entries.
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (rp RepoPath) JournalFile() string {
+	return path.Join(string(rp), JournalFile)
+}
+
+// AppendJournal appends e to the journal and syncs it to disk. Time, Tool
+// and PID are filled in.
+func (rp RepoPath) AppendJournal(e JournalEntry) error {
+	e.Time = time.Now().UTC()
+	e.Tool = Tool
+	e.PID = os.Getpid()
+
+	f, err := os.OpenFile(rp.JournalFile(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(e)
+	if err == nil {
+		data, err = terminateTorn(f, append(data, '\n'))
+	}
+	if err == nil {
+		_, err = f.Write(data)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// terminateTorn prepends a newline to line if f ends with a line cut short
+// by a crash, so that line is not joined to it.
+func terminateTorn(f *os.File, line []byte) ([]byte, error) {
+	fi, err := f.Stat()
+	if err != nil || fi.Size() == 0 {
+		return line, err
+	}
+	last := make([]byte, 1)
+	if _, err := f.ReadAt(last, fi.Size()-1); err != nil {
+		return nil, err
+	}
+	if last[0] != '\n' {
+		line = append([]byte{'\n'}, line...)
+	}
+	return line, nil
+}
+
+// Journal returns the journal entries, oldest first. Entries that cannot
+// be parsed, e.g. a line cut short by a crash, are skipped.
+func (rp RepoPath) Journal() ([]JournalEntry, error) {
+	f, err := os.Open(rp.JournalFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var es []JournalEntry
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e JournalEntry
+		if json.Unmarshal(s.Bytes(), &e) == nil {
+			es = append(es, e)
+		}
+	}
+	return es, s.Err()
+}
@@ -0,0 +1,25 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package mfsr
+
+import "os"
+
+// lockFile takes an exclusive lock on the file name by creating it, and
+// removes it when the lock is released.
+func lockFile(name string) (unlock func() error, err error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return nil, errLockHeld
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func() error {
+		err := f.Close()
+		if rerr := os.Remove(name); err == nil {
+			err = rerr
+		}
+		return err
+	}, nil
+}
@@ -0,0 +1,44 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mfsr
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock on the file name, creating it. The file
+// is removed when the lock is released, so after locking it is checked to
+// still be the file at name: a process that opened it before the previous
+// holder removed it would otherwise hold a lock nobody else sees.
+func lockFile(name string) (unlock func() error, err error) {
+	for {
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+			f.Close()
+			if err == syscall.EWOULDBLOCK {
+				return nil, errLockHeld
+			}
+			return nil, err
+		}
+		held, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if cur, err := os.Stat(name); err == nil && os.SameFile(held, cur) {
+			return func() error {
+				err := os.Remove(name)
+				if cerr := f.Close(); err == nil {
+					err = cerr
+				}
+				return err
+			}, nil
+		}
+		f.Close()
+	}
+}
@@ -1,21 +1,29 @@
 package mfsr
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 )
 
 const VersionFile = "version"
 
+// ErrWrongRepoVersion is returned, wrapped, when the repo is not at the
+// version a migration expects.
+var ErrWrongRepoVersion = errors.New("versions differ")
+
 type RepoPath string
 
 func (rp RepoPath) VersionFile() string {
 	return path.Join(string(rp), VersionFile)
 }
 
+// Version returns the content of the version file with surrounding
+// whitespace removed. Use VersionNum to validate it.
 func (rp RepoPath) Version() (string, error) {
 	if rp == "" {
 		return "", fmt.Errorf("invalid repo path \"%s\"", rp)
@@ -36,22 +44,120 @@ func (rp RepoPath) Version() (string, error) {
 	return s, nil
 }
 
+// MaxVersion bounds the repo versions ParseVersion accepts.
+const MaxVersion = 1000
+
+// ErrInvalidVersion is returned, wrapped, when the version file does not
+// hold a valid version.
+var ErrInvalidVersion = errors.New("invalid repo version")
+
+// ParseVersion parses the content of a version file: a decimal number from
+// 0 to MaxVersion without leading zeros, optionally followed by a newline.
+// Anything else, such as stray whitespace, is rejected rather than guessed
+// at.
+func ParseVersion(s string) (int, error) {
+	v := strings.TrimSuffix(strings.TrimSuffix(s, "\n"), "\r")
+	valid := v != "" && len(v) <= len(strconv.Itoa(MaxVersion)) && (v == "0" || v[0] != '0')
+	for _, c := range v {
+		valid = valid && c >= '0' && c <= '9'
+	}
+	if !valid {
+		return 0, fmt.Errorf("%w %q; run 'fs-repo-migrations repair' to rewrite the version file", ErrInvalidVersion, s)
+	}
+	n, _ := strconv.Atoi(v)
+	if n > MaxVersion {
+		return 0, fmt.Errorf("%w %d: greater than %d", ErrInvalidVersion, n, MaxVersion)
+	}
+	return n, nil
+}
+
+// VersionNum reads and strictly parses the version file, see ParseVersion.
+func (rp RepoPath) VersionNum() (int, error) {
+	fn := rp.VersionFile()
+	c, err := ioutil.ReadFile(fn)
+	if os.IsNotExist(err) {
+		return 0, VersionFileNotFound(rp)
+	}
+	if err != nil {
+		return 0, err
+	}
+	v, err := ParseVersion(string(c))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", fn, err)
+	}
+	return v, nil
+}
+
+// CheckVersion checks that the repo is at version, returning an error
+// wrapping ErrWrongRepoVersion if not, ErrInvalidVersion if the version
+// file cannot be parsed, or ErrMigrationInProgress if another process is
+// migrating the repo or was interrupted doing so.
 func (rp RepoPath) CheckVersion(version string) error {
-	v, err := rp.Version()
+	want, err := ParseVersion(version)
+	if err != nil {
+		return err
+	}
+	if err := rp.checkInProgress(); err != nil {
+		return err
+	}
+	v, err := rp.VersionNum()
 	if err != nil {
 		return err
 	}
 
-	if v != version {
-		return fmt.Errorf("versions differ (expected: %s, actual:%s)", version, v)
+	if v != want {
+		return fmt.Errorf("%w (expected: %d, actual:%d)", ErrWrongRepoVersion, want, v)
 	}
 
 	return nil
 }
 
+// WriteVersion atomically replaces the version file, so a crash cannot
+// leave it empty or truncated, and records the change in the journal. The
+// hooks registered with OnVersionChange run first.
 func (rp RepoPath) WriteVersion(version string) error {
-	fn := rp.VersionFile()
-	return ioutil.WriteFile(fn, []byte(version+"\n"), 0644)
+	old, err := rp.Version()
+	if _, ok := err.(VersionFileNotFound); ok {
+		old, err = "", nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := rp.runVersionHooks(old, version); err != nil {
+		return err
+	}
+
+	if err := WriteFileAtomic(rp.VersionFile(), []byte(version+"\n"), 0644); err != nil {
+		return err
+	}
+	return rp.AppendJournal(JournalEntry{Phase: PhaseVersion, Old: old, New: version})
+}
+
+// VersionLockFile is the lock held by CasVersion, relative to the repo. It
+// is separate from the repo lock, which migrations hold while they run, and
+// only exists while CasVersion runs.
+const VersionLockFile = "version.lock"
+
+var errLockHeld = errors.New("held by another process")
+
+// CasVersion replaces the version old with new. It holds VersionLockFile
+// while checking and writing, so that of two processes racing to migrate
+// the repo only one succeeds; the other gets an error wrapping
+// ErrWrongRepoVersion, or failing to take the lock.
+func (rp RepoPath) CasVersion(old, new string) error {
+	unlock, err := lockFile(path.Join(string(rp), VersionLockFile))
+	if err != nil {
+		return fmt.Errorf("locking version file: %w", err)
+	}
+
+	err = rp.CheckVersion(old)
+	if err == nil {
+		err = rp.WriteVersion(new)
+	}
+	if uerr := unlock(); err == nil && uerr != nil {
+		err = fmt.Errorf("unlocking version file: %w", uerr)
+	}
+	return err
 }
 
 type VersionFileNotFound string
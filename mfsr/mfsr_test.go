@@ -0,0 +1,181 @@
+package mfsr
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, s := range []string{"0", "9", "11\n", "11\r\n", "1000"} {
+		if _, err := ParseVersion(s); err != nil {
+			t.Errorf("ParseVersion(%q): %s", s, err)
+		}
+	}
+	for _, s := range []string{"", "\n", " 11", "11 \n", "11\n\n", "011", "-1", "1001", "eleven", "1e3"} {
+		if _, err := ParseVersion(s); !errors.Is(err, ErrInvalidVersion) {
+			t.Errorf("ParseVersion(%q) = %v, expected ErrInvalidVersion", s, err)
+		}
+	}
+}
+
+func TestCheckVersionInProgress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mfsr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rp := RepoPath(dir)
+
+	if err := rp.WriteVersion("8"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rp.BeginMigration("8-to-9"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CheckVersion("8"); err != nil {
+		t.Errorf("own marker should not fail CheckVersion: %s", err)
+	}
+
+	// a marker left by another process
+	marker := `{"migration":"8-to-9","pid":-1}`
+	if err := ioutil.WriteFile(rp.InProgressFile(), []byte(marker), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CheckVersion("8"); !errors.Is(err, ErrMigrationInProgress) {
+		t.Errorf("CheckVersion = %v, expected ErrMigrationInProgress", err)
+	}
+
+	if err := rp.EndMigration(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CheckVersion("8"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBeginMigration(t *testing.T) {
+	if _, ok := processAlive(os.Getppid()); !ok {
+		t.Skip("cannot tell whether processes are alive on this platform")
+	}
+	dir, err := ioutil.TempDir("", "mfsr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rp := RepoPath(dir)
+
+	host, _ := os.Hostname()
+	live := fmt.Sprintf(`{"migration":"8-to-9","pid":%d,"hostname":%q}`, os.Getppid(), host)
+	if err := ioutil.WriteFile(rp.InProgressFile(), []byte(live), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rp.BeginMigration("8-to-9"); !errors.Is(err, ErrMigrationInProgress) {
+		t.Errorf("took over the marker of a running process: %v", err)
+	}
+
+	dead := fmt.Sprintf(`{"migration":"8-to-9","pid":%d,"hostname":%q}`, 1<<30, host)
+	if err := ioutil.WriteFile(rp.InProgressFile(), []byte(dead), 0644); err != nil {
+		t.Fatal(err)
+	}
+	prev, err := rp.BeginMigration("8-to-9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev == nil || prev.PID != 1<<30 {
+		t.Errorf("replaced marker %v", prev)
+	}
+	if p, err := rp.MigrationInProgress(); err != nil || p == nil || p.PID != os.Getpid() {
+		t.Errorf("marker %v, %v", p, err)
+	}
+	if _, err := os.Stat(rp.InProgressFile() + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("marker lock left behind: %v", err)
+	}
+}
+
+func TestCasVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mfsr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rp := RepoPath(dir)
+
+	if err := rp.WriteVersion("8"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CasVersion("8", "9"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CasVersion("8", "10"); !errors.Is(err, ErrWrongRepoVersion) {
+		t.Errorf("expected ErrWrongRepoVersion, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, VersionLockFile)); !os.IsNotExist(err) {
+		t.Errorf("%s left in the repo: %v", VersionLockFile, err)
+	}
+
+	unlock, err := lockFile(filepath.Join(dir, VersionLockFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CasVersion("9", "10"); !errors.Is(err, errLockHeld) {
+		t.Errorf("expected the held lock to fail CasVersion, got %v", err)
+	}
+	if err := unlock(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.CasVersion("9", "10"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mfsr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for p, v := range map[string]string{
+		"a/.ipfs":            "9\n",
+		"a/.ipfs/nested":     "1\n",
+		"b/node1/.ipfs":      "11\n",
+		"c/broken":           "eleven",
+		"d/not-a-repo/empty": "",
+	} {
+		p = filepath.Join(dir, p)
+		if err := os.MkdirAll(p, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if v != "" {
+			if err := ioutil.WriteFile(filepath.Join(p, VersionFile), []byte(v), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	repos, err := Scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 3 {
+		t.Fatalf("expected 3 repos, got %v", repos)
+	}
+	for i, want := range []struct {
+		path    string
+		version int
+		bad     bool
+	}{
+		{"a/.ipfs", 9, false},
+		{"b/node1/.ipfs", 11, false},
+		{"c/broken", 0, true},
+	} {
+		r := repos[i]
+		if r.Path != filepath.Join(dir, want.path) || r.Version != want.version || (r.Err != nil) != want.bad {
+			t.Errorf("repo %d: got %+v, expected %+v", i, r, want)
+		}
+	}
+}
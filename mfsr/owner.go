@@ -0,0 +1,164 @@
+package mfsr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+)
+
+// ErrRepoLocked is returned, wrapped, when the repo lock is held by another
+// process.
+var ErrRepoLocked = errors.New("failed to acquire repo lock")
+
+// ErrStaleLock is returned, wrapped, when the repo lock was left behind by
+// a process that no longer exists. It wraps ErrRepoLocked.
+var ErrStaleLock = fmt.Errorf("%w: its owner is no longer running", ErrRepoLocked)
+
+// started approximates the start time of this process.
+var started = time.Now()
+
+// OwnerSuffix names the file next to a lock file where its holder records
+// itself, e.g. "repo.lock.owner". The lock file stays empty, as fcntl locks
+// refuse a non-empty file and one left by a crash would keep the repo
+// locked.
+const OwnerSuffix = ".owner"
+
+// Owner is the process holding a lock, as recorded in its owner file or,
+// for portable locks, in the lock file itself. The OwnerPID field is named
+// as in the lock files of portable locks, which record only that.
+type Owner struct {
+	OwnerPID int
+	Hostname string    `json:",omitempty"`
+	Started  time.Time `json:",omitempty"`
+}
+
+func (o Owner) String() string {
+	s := fmt.Sprintf("pid %d", o.OwnerPID)
+	if o.Hostname != "" {
+		s += " on " + o.Hostname
+	}
+	if !o.Started.IsZero() {
+		s += " started " + o.Started.Local().Format(time.RFC3339)
+	}
+	return s
+}
+
+// Stale reports whether the owner is known to have exited: it ran on this
+// host and no process with its pid exists. A pid reused by another process
+// keeps the lock, which errs on the safe side.
+func (o Owner) Stale() bool {
+	if o.OwnerPID == 0 || o.OwnerPID == os.Getpid() {
+		return false
+	}
+	if host, err := os.Hostname(); o.Hostname != "" && (err != nil || o.Hostname != host) {
+		return false
+	}
+	alive, ok := processAlive(o.OwnerPID)
+	return ok && !alive
+}
+
+// CurrentOwner returns the current process as a lock owner.
+func CurrentOwner() Owner {
+	host, _ := os.Hostname()
+	return Owner{OwnerPID: os.Getpid(), Hostname: host, Started: started}
+}
+
+// WriteOwner records the current process as the holder of the lock file
+// name in the repo. The caller must hold the lock.
+func (rp RepoPath) WriteOwner(name string) error {
+	data, err := json.Marshal(CurrentOwner())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(string(rp), name+OwnerSuffix), append(data, '\n'), 0644)
+}
+
+// RemoveOwner removes the owner file of the lock file name in the repo.
+func (rp RepoPath) RemoveOwner(name string) error {
+	err := os.Remove(path.Join(string(rp), name+OwnerSuffix))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// ReadOwner returns the owner recorded for the lock file name, e.g.
+// "repo.lock", in the repo: in its owner file, or else in the lock file
+// itself. It reports false if neither records an owner.
+func (rp RepoPath) ReadOwner(name string) (Owner, bool, error) {
+	o, ok, err := readOwner(path.Join(string(rp), name+OwnerSuffix))
+	if err != nil || ok {
+		return o, ok, err
+	}
+	return readOwner(path.Join(string(rp), name))
+}
+
+func readOwner(fn string) (Owner, bool, error) {
+	var o Owner
+	data, err := ioutil.ReadFile(fn)
+	if os.IsNotExist(err) {
+		return o, false, nil
+	} else if err != nil {
+		return o, false, err
+	}
+	if len(data) == 0 || json.Unmarshal(data, &o) != nil || o.OwnerPID == 0 {
+		return o, false, nil
+	}
+	return o, true, nil
+}
+
+// BreakStale removes the lock file name in the repo if it records an owner
+// that is stale, as portable locks left behind do, and returns the owner.
+// It returns an error if the lock is held by a process that may still be
+// running, and reports false if there was no lock left behind. A stale
+// owner file next to an empty lock file is removed, but does not lock the
+// repo.
+func (rp RepoPath) BreakStale(name string) (Owner, bool, error) {
+	o, ok, err := readOwner(path.Join(string(rp), name))
+	if err != nil {
+		return o, false, err
+	}
+	if !ok {
+		// the lock file is empty: only refuse if its owner file names a
+		// holder that may still be running
+		o, ok, err = readOwner(path.Join(string(rp), name+OwnerSuffix))
+		if err != nil || !ok {
+			return o, false, err
+		}
+		if !o.Stale() {
+			return o, false, fmt.Errorf("%w at %s/%s by %s, which may still be running", ErrRepoLocked, rp, name, o)
+		}
+		return o, false, rp.RemoveOwner(name)
+	}
+	if !o.Stale() {
+		return o, false, fmt.Errorf("%w at %s/%s by %s, which may still be running", ErrRepoLocked, rp, name, o)
+	}
+	if err := os.Remove(path.Join(string(rp), name)); err != nil && !os.IsNotExist(err) {
+		return o, false, err
+	}
+	return o, true, rp.RemoveOwner(name)
+}
+
+var errRepoLock = `%w at %s/%s
+Is a daemon running? please stop it before running migration`
+
+// LockError explains why the lock file name in the repo could not be
+// taken, naming its owner if it recorded itself. A stale owner file only
+// means that the lock is now held by a process that did not record
+// itself, such as the daemon.
+func (rp RepoPath) LockError(name string) error {
+	if o, ok, err := readOwner(path.Join(string(rp), name)); err == nil && ok {
+		if o.Stale() {
+			return fmt.Errorf("%w at %s/%s, left by %s", ErrStaleLock, rp, name, o)
+		}
+		return fmt.Errorf("%w at %s/%s, held by %s", ErrRepoLocked, rp, name, o)
+	}
+	if o, ok, err := readOwner(path.Join(string(rp), name+OwnerSuffix)); err == nil && ok && !o.Stale() {
+		return fmt.Errorf("%w at %s/%s, held by %s", ErrRepoLocked, rp, name, o)
+	}
+	return fmt.Errorf(errRepoLock, ErrRepoLocked, rp, name)
+}
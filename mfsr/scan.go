@@ -0,0 +1,41 @@
+package mfsr
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// ScannedRepo is a repo found by Scan.
+type ScannedRepo struct {
+	Path    string `json:"path"`
+	Version int    `json:"version"`
+	// Err is set if the version file could not be read or parsed, in which
+	// case Version is meaningless.
+	Err error `json:"-"`
+}
+
+// Scan walks the tree under root and returns every repo in it, identified
+// by its version file, in lexical order. Repos are not searched for nested
+// repos, and directories that cannot be read are skipped.
+func Scan(root string) ([]ScannedRepo, error) {
+	var repos []ScannedRepo
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if p != root && os.IsPermission(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if _, err := os.Stat(filepath.Join(p, VersionFile)); err != nil {
+			return nil
+		}
+
+		v, err := RepoPath(p).VersionNum()
+		repos = append(repos, ScannedRepo{Path: p, Version: v, Err: err})
+		return filepath.SkipDir
+	})
+	return repos, err
+}
@@ -0,0 +1,140 @@
+package migrationtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Options returns migration options for the repo.
+func (r *Repo) Options(opts ...migrate.Option) migrate.Options {
+	return migrate.NewOptions(r.Path, opts...)
+}
+
+// Apply applies m to the repo the way the runner does, see migrate.Execute.
+func (r *Repo) Apply(m migrate.Migration, opts ...migrate.Option) error {
+	return migrate.Execute(m, r.Options(opts...))
+}
+
+// Revert reverts m on the repo the way the runner does.
+func (r *Repo) Revert(m migrate.Migration, opts ...migrate.Option) error {
+	o := r.Options(opts...)
+	o.Revert = true
+	return migrate.Execute(m, o)
+}
+
+// MustApply is like Apply but fails the test on error.
+func (r *Repo) MustApply(m migrate.Migration, opts ...migrate.Option) {
+	r.t.Helper()
+	if err := r.Apply(m, opts...); err != nil {
+		r.t.Fatalf("applying %s: %s", migrate.Versions(m), err)
+	}
+}
+
+// MustRevert is like Revert but fails the test on error.
+func (r *Repo) MustRevert(m migrate.Migration, opts ...migrate.Option) {
+	r.t.Helper()
+	if err := r.Revert(m, opts...); err != nil {
+		r.t.Fatalf("reverting %s: %s", migrate.Versions(m), err)
+	}
+}
+
+// AssertVersion fails the test if the repo is not at version v.
+func (r *Repo) AssertVersion(v int) {
+	r.t.Helper()
+	if err := mfsr.RepoPath(r.Path).CheckVersion(strconv.Itoa(v)); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+// AssertExists fails the test if rel, relative to the repo, does not exist.
+func (r *Repo) AssertExists(rel string) {
+	r.t.Helper()
+	if _, err := os.Stat(filepath.Join(r.Path, rel)); err != nil {
+		r.t.Fatalf("expected %s to exist: %s", rel, err)
+	}
+}
+
+// AssertNotExists fails the test if rel, relative to the repo, exists.
+func (r *Repo) AssertNotExists(rel string) {
+	r.t.Helper()
+	if _, err := os.Stat(filepath.Join(r.Path, rel)); !os.IsNotExist(err) {
+		r.t.Fatalf("expected %s not to exist", rel)
+	}
+}
+
+// Config returns the repo config.
+func (r *Repo) Config() map[string]interface{} {
+	r.t.Helper()
+	cfg, err := mfsr.RepoPath(r.Path).Config()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return cfg
+}
+
+// KeystoreFiles returns the sorted file names in the keystore.
+func (r *Repo) KeystoreFiles() []string {
+	r.t.Helper()
+	infos, err := ioutil.ReadDir(filepath.Join(r.Path, "keystore"))
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AssertKeystore fails the test unless the keystore holds exactly the repo's
+// keys, named as at version v.
+func (r *Repo) AssertKeystore(v int) {
+	r.t.Helper()
+	at := Repo{Version: v}
+	var expected []string
+	for _, name := range r.Keys {
+		expected = append(expected, at.KeystoreName(name))
+	}
+	sort.Strings(expected)
+
+	actual := r.KeystoreFiles()
+	if len(actual) != len(expected) {
+		r.t.Fatalf("expected keystore %q, got %q", expected, actual)
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			r.t.Fatalf("expected keystore %q, got %q", expected, actual)
+		}
+	}
+}
+
+// AssertBlocks fails the test unless every block written when the repo was
+// created can still be read, unchanged, under its original key.
+func (r *Repo) AssertBlocks() {
+	r.t.Helper()
+	d, err := r.openBlocks()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	defer d.Close()
+
+	for key, data := range r.Blocks {
+		got, err := d.Get(ds.NewKey(key))
+		if err != nil {
+			r.t.Fatalf("block %s: %s", key, err)
+		}
+		if !bytes.Equal(got, data) {
+			r.t.Fatalf("block %s: content changed", key)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package migrationtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/namespace"
+	badger "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-badger"
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+	leveldb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-leveldb"
+)
+
+func (r *Repo) openBlocks() (ds.Datastore, error) {
+	var (
+		d   ds.Datastore
+		err error
+	)
+	switch r.Backend {
+	case Flatfs:
+		shard := flatfs.NextToLast(2)
+		if r.Version < 5 {
+			shard = flatfs.Prefix(4)
+		}
+		d, err = flatfs.CreateOrOpen(filepath.Join(r.Path, "blocks"), shard, false)
+	case Leveldb:
+		d, err = leveldb.NewDatastore(filepath.Join(r.Path, "datastore"), nil)
+	case Badger:
+		dir := filepath.Join(r.Path, "badgerds")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+		d, err = badger.NewDatastore(dir, &badger.DefaultOptions)
+	default:
+		return nil, fmt.Errorf("unknown backend %q", r.Backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// leveldb and badger hold more than blocks, so blocks live under a
+	// prefix there
+	if prefix := r.blockPrefix(); prefix != "" {
+		return namespace.Wrap(d, ds.NewKey(prefix)), nil
+	}
+	return d, nil
+}
@@ -0,0 +1,339 @@
+// Package migrationtest builds synthetic ipfs repos at a given version so
+// migrations can be tested end to end: construct a repo, run Apply and
+// Revert, then assert on the result.
+//
+//	r := migrationtest.NewRepo(t, 8, migrationtest.WithKeys("self", "foo"))
+//	r.MustApply(mg8.Migration{})
+//	r.AssertVersion(9)
+//
+// Repos follow the go-ipfs layout for their version as far as the
+// migrations in this repository rely on it: the version file, a config with
+// Identity, Addresses, Bootstrap and Datastore.Spec, a keystore, and blocks
+// stored in flatfs, leveldb or badger.
+package migrationtest
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	dshelp "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ipfs-ds-help"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// Backend is the datastore backend holding blocks.
+type Backend string
+
+const (
+	Flatfs  Backend = "flatfs"
+	Leveldb Backend = "levelds"
+	Badger  Backend = "badgerds"
+)
+
+type settings struct {
+	backend   Backend
+	blocks    int
+	blockSize int
+	keys      []string
+	seed      int64
+	edit      []func(map[string]interface{})
+}
+
+// Option configures a repo built by NewRepo.
+type Option func(*settings)
+
+// WithBackend stores blocks in the given backend. The default is flatfs,
+// except for repos older than version 2, which always use leveldb.
+func WithBackend(b Backend) Option {
+	return func(s *settings) {
+		s.backend = b
+	}
+}
+
+// WithBlocks adds n random blocks of size bytes each.
+func WithBlocks(n, size int) Option {
+	return func(s *settings) {
+		s.blocks = n
+		s.blockSize = size
+	}
+}
+
+// WithKeys adds keystore entries with the given names.
+func WithKeys(names ...string) Option {
+	return func(s *settings) {
+		s.keys = append(s.keys, names...)
+	}
+}
+
+// WithSeed makes the random content of the repo reproducible.
+func WithSeed(seed int64) Option {
+	return func(s *settings) {
+		s.seed = seed
+	}
+}
+
+// WithConfig edits the generated config before it is written.
+func WithConfig(edit func(cfg map[string]interface{})) Option {
+	return func(s *settings) {
+		s.edit = append(s.edit, edit)
+	}
+}
+
+// Repo is a synthetic repo on disk.
+type Repo struct {
+	// Path is the repo directory.
+	Path string
+	// Version is the version the repo was created at.
+	Version int
+	// Backend is where blocks are stored.
+	Backend Backend
+	// Blocks maps the datastore key of each block written, relative to the
+	// blocks prefix, to its content.
+	Blocks map[string][]byte
+	// Keys are the names of the keystore entries written.
+	Keys []string
+
+	t testing.TB
+}
+
+// NewRepo creates a repo at version in a temporary directory that is
+// removed when the test ends.
+func NewRepo(t testing.TB, version int, opts ...Option) *Repo {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "migrationtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	r, err := Create(filepath.Join(dir, ".ipfs"), version, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.t = t
+	return r
+}
+
+// Create creates a repo at version in path, which must not exist yet. Unlike
+// NewRepo it does not need a test, so it can also be used to generate
+// fixtures. The assertion methods of the returned Repo must not be used.
+func Create(path string, version int, opts ...Option) (*Repo, error) {
+	s := settings{backend: Flatfs, blockSize: 256, seed: 1}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	if version < 2 {
+		s.backend = Leveldb
+	}
+
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+
+	r := &Repo{
+		Path:    path,
+		Version: version,
+		Backend: s.backend,
+		Blocks:  make(map[string][]byte),
+		Keys:    s.keys,
+	}
+	rnd := rand.New(rand.NewSource(s.seed))
+
+	if err := r.writeConfig(rnd, s); err != nil {
+		return nil, err
+	}
+	if err := r.writeKeys(rnd); err != nil {
+		return nil, err
+	}
+	if err := r.writeBlocks(rnd, s); err != nil {
+		return nil, err
+	}
+	if version > 0 {
+		if err := mfsr.RepoPath(path).WriteVersion(strconv.Itoa(version)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+var defaultBootstrap = []string{
+	"/dnsaddr/bootstrap.libp2p.io/p2p/QmNnooDu7bfjPFoTZYxMNLWUQJyrVwtbZg5gBMjTezGAJN",
+	"/dnsaddr/bootstrap.libp2p.io/p2p/QmQCU2EcMqAqQPR2i9bChDtGNJchTbq5TbXJJ16u19uLTa",
+	"/dnsaddr/bootstrap.libp2p.io/p2p/QmbLHAnMoJPWSCR5Zhtx6BHJX9KiKNN6tpvbUcqanj75Nb",
+	"/dnsaddr/bootstrap.libp2p.io/p2p/QmcZf59bWwK5XFi76CZX8cbJ4BhTzzA3gU1ZjYZcYW3dwt",
+	"/ip4/104.131.131.82/tcp/4001/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ",
+}
+
+func (r *Repo) writeConfig(rnd *rand.Rand, s settings) error {
+	privKey := make([]byte, 64)
+	rnd.Read(privKey)
+
+	swarm := []interface{}{"/ip4/0.0.0.0/tcp/4001", "/ip6/::/tcp/4001"}
+	bootstrap := make([]interface{}, 0, len(defaultBootstrap)+1)
+	for _, addr := range defaultBootstrap {
+		bootstrap = append(bootstrap, addr)
+	}
+	if r.Version >= 10 {
+		swarm = append(swarm, "/ip4/0.0.0.0/udp/4001/quic", "/ip6/::/udp/4001/quic")
+		bootstrap = append(bootstrap, "/ip4/104.131.131.82/udp/4001/quic/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ")
+	}
+
+	cfg := map[string]interface{}{
+		"Identity": map[string]interface{}{
+			"PeerID":  "QmTestPeer" + strconv.FormatInt(rnd.Int63(), 36),
+			"PrivKey": base64.StdEncoding.EncodeToString(privKey),
+		},
+		"Addresses": map[string]interface{}{
+			"Swarm":      swarm,
+			"Announce":   []interface{}{},
+			"NoAnnounce": []interface{}{},
+			"API":        "/ip4/127.0.0.1/tcp/5001",
+			"Gateway":    "/ip4/127.0.0.1/tcp/8080",
+		},
+		"Bootstrap": bootstrap,
+		"Datastore": map[string]interface{}{
+			"StorageMax": "10GB",
+			"Spec":       r.datastoreSpec(),
+		},
+	}
+	for _, edit := range s.edit {
+		edit(cfg)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(r.Path, mfsr.ConfigFile), data, 0600); err != nil {
+		return err
+	}
+
+	if r.Version >= 6 {
+		return ioutil.WriteFile(filepath.Join(r.Path, "datastore_spec"), []byte(r.diskSpec()), 0600)
+	}
+	return nil
+}
+
+const shardFunc = "/repo/flatfs/shard/v1/next-to-last/2"
+
+func (r *Repo) datastoreSpec() map[string]interface{} {
+	switch r.Backend {
+	case Badger:
+		return map[string]interface{}{
+			"type":   "measure",
+			"prefix": "badger.datastore",
+			"child":  map[string]interface{}{"type": "badgerds", "path": "badgerds", "syncWrites": true},
+		}
+	case Leveldb:
+		return map[string]interface{}{
+			"type":   "measure",
+			"prefix": "leveldb.datastore",
+			"child":  map[string]interface{}{"type": "levelds", "path": "datastore", "compression": "none"},
+		}
+	}
+	return map[string]interface{}{
+		"type": "mount",
+		"mounts": []interface{}{
+			map[string]interface{}{
+				"mountpoint": "/blocks",
+				"type":       "measure",
+				"prefix":     "flatfs.datastore",
+				"child":      map[string]interface{}{"type": "flatfs", "path": "blocks", "shardFunc": shardFunc, "sync": true},
+			},
+			map[string]interface{}{
+				"mountpoint": "/",
+				"type":       "measure",
+				"prefix":     "leveldb.datastore",
+				"child":      map[string]interface{}{"type": "levelds", "path": "datastore", "compression": "none"},
+			},
+		},
+	}
+}
+
+func (r *Repo) diskSpec() string {
+	switch r.Backend {
+	case Badger:
+		return `{"path":"badgerds","type":"badgerds"}`
+	case Leveldb:
+		return `{"path":"datastore","type":"levelds"}`
+	}
+	return `{"mounts":[{"mountpoint":"/blocks","path":"blocks","shardFunc":"` + shardFunc +
+		`","type":"flatfs"},{"mountpoint":"/","path":"datastore","type":"levelds"}],"type":"mount"}`
+}
+
+// KeystoreName returns the file name of keystore entry name at the repo's
+// version. Names were base32-encoded from version 9 on.
+func (r *Repo) KeystoreName(name string) string {
+	if r.Version < 9 {
+		return name
+	}
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	return "key_" + strings.ToLower(enc.EncodeToString([]byte(name)))
+}
+
+func (r *Repo) writeKeys(rnd *rand.Rand) error {
+	dir := filepath.Join(r.Path, "keystore")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	for _, name := range r.Keys {
+		key := make([]byte, 64)
+		rnd.Read(key)
+		if err := ioutil.WriteFile(filepath.Join(dir, r.KeystoreName(name)), key, 0400); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Repo) writeBlocks(rnd *rand.Rand, s settings) error {
+	d, err := r.openBlocks()
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	for i := 0; i < s.blocks; i++ {
+		data := make([]byte, s.blockSize)
+		rnd.Read(data)
+
+		hash, err := mh.Sum(data, mh.SHA2_256, -1)
+		if err != nil {
+			return err
+		}
+		key := dshelp.CidToDsKey(cid.NewCidV1(cid.Raw, hash))
+		if err := d.Put(key, data); err != nil {
+			return err
+		}
+		r.Blocks[key.String()] = data
+	}
+	return nil
+}
+
+// OpenBlocks opens the datastore holding the repo's blocks, with keys
+// relative to the blocks prefix. The caller must close it.
+func (r *Repo) OpenBlocks() (ds.Datastore, error) {
+	return r.openBlocks()
+}
+
+func (r *Repo) blockPrefix() string {
+	switch {
+	case r.Version < 2:
+		return "/b"
+	case r.Backend == Flatfs:
+		return ""
+	}
+	return "/blocks"
+}
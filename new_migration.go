@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/ipfs/fs-repo-migrations/scaffold"
+)
+
+// runNewMigration scaffolds the migration from CurrentVersion to the next
+// version in a source checkout, and registers it in main.go.
+func runNewMigration(args []string) error {
+	fs := flag.NewFlagSet("new-migration", flag.ExitOnError)
+	src := fs.String("src", ".", "root of the fs-repo-migrations source checkout")
+	fs.Parse(args)
+
+	from := CurrentVersion
+	files, err := scaffold.Write(*src, from)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		fmt.Println("wrote", f)
+	}
+	fmt.Printf(`
+Next steps:
+  - implement Apply and Revert in ipfs-%[1]d-to-%[2]d/migration
+  - teach migrationtest to build version %[2]d repos and extend the test
+  - add the first go-ipfs release writing version %[2]d to the table in
+    go-migrate/skew.go and README.md
+  - add a sharness test under sharness/
+`, from, from+1)
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/orphans"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runOrphans(args []string) error {
+	fs := flag.NewFlagSet("orphans", flag.ExitOnError)
+	remove := fs.Bool("remove", false, "remove the blocks not reachable from pins or MFS")
+	yes := fs.Bool("y", false, "remove without asking")
+	batchSize := fs.Int("batch-size", gomigrate.DefaultBatchSize, "number of keys per datastore batch")
+	windowStr := fs.String("window", "", "only sweep inside this daily window, e.g. \"22:00-06:00\", pausing between batches outside it")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to read the repo")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	var window *gomigrate.Window
+	if *windowStr != "" {
+		if window, err = gomigrate.ParseWindow(*windowStr); err != nil {
+			return err
+		}
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+	if *remove && !(*yes || YesNoPrompt("Unpinned blocks outside MFS will be deleted. Continue? [y/n]")) {
+		os.Exit(1)
+	}
+
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	res, err := orphans.Repo(ipfsdir, orphans.Options{
+		Remove:    *remove,
+		BatchSize: *batchSize,
+		Window:    window,
+		Progress: func(scanned, found int64) {
+			progress.Update("read %d blocks, %d orphans", scanned, found)
+		},
+	})
+	progress.Done()
+	if res.Scanned > 0 || err == nil {
+		fmt.Printf("pins:      %d recursive, %d direct\n", res.Pins["recursive"], res.Pins["direct"])
+		fmt.Printf("reachable: %d blocks, %d of them missing\n", res.Reachable, res.Missing)
+		fmt.Printf("orphans:   %d of %d blocks, %d bytes\n", res.Orphans, res.Scanned, res.OrphanBytes)
+		if res.Unknown > 0 {
+			fmt.Printf("kept %d blocks whose keys are neither CIDs nor multihashes\n", res.Unknown)
+		}
+		if *remove {
+			fmt.Printf("removed %d blocks\n", res.Removed)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, u := range res.Unsafe {
+		fmt.Printf("warning: orphans cannot be removed safely: %s\n", u)
+	}
+	return nil
+}
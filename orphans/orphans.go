@@ -0,0 +1,310 @@
+// Package orphans finds the blocks of a repo that nothing refers to, the
+// ones the next garbage collection would remove, and can remove them
+// ahead of a migration that would otherwise rewrite them.
+//
+// It marks every block reachable from the pins and the MFS root, as the
+// ipfs garbage collector does, then sweeps the blocks namespace for blocks
+// not marked. Marking only follows links through dag-pb nodes, so blocks
+// are not removed when the reachable DAGs hold nodes of other codecs, e.g.
+// dag-cbor, whose children would be taken for orphans.
+package orphans
+
+import (
+	"fmt"
+	"strings"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multibase"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+)
+
+// minVersion is the first repo version with base32 block keys.
+const minVersion = 4
+
+// pinsInDatastoreVersion is the first repo version keeping pins in the
+// datastore, indexed by mode, rather than in dag-pb pin sets.
+const pinsInDatastoreVersion = 11
+
+// pinIndexes are the datastore namespaces indexing the pins of each mode
+// from pinsInDatastoreVersion on, under keys holding the multibase encoded
+// CID.
+var pinIndexes = map[string]string{
+	"recursive": "/pins/index/cidRindex",
+	"direct":    "/pins/index/cidDindex",
+}
+
+// Options controls a search for orphans.
+type Options struct {
+	// Remove deletes the orphans found, unless it would not be safe.
+	Remove bool
+	// BatchSize is the number of deletes per datastore batch.
+	BatchSize int
+	// Progress, if not nil, is called every so often while sweeping with
+	// the number of blocks read and orphans found so far.
+	Progress func(scanned, orphans int64)
+	// Window, if not nil, restricts the sweep to a daily execution window:
+	// outside it the sweep pauses between batches.
+	Window *migrate.Window
+}
+
+// Result describes a search for orphans.
+type Result struct {
+	// Pins counts the pins marked from, by mode.
+	Pins map[string]int
+	// Reachable is the number of distinct blocks reachable from the pins
+	// and the MFS root, Missing of which are not in the repo.
+	Reachable int
+	Missing   int
+	// Scanned is the number of blocks in the repo.
+	Scanned int64
+	// Orphans is the number of blocks not reachable, with OrphanBytes
+	// their total size.
+	Orphans     int64
+	OrphanBytes int64
+	// Removed is the number of orphans removed.
+	Removed int64
+	// Unknown counts blocks whose key is neither a CID nor a multihash.
+	// They are never taken for orphans.
+	Unknown int64
+	// Unsafe tells why orphans could not be removed safely.
+	Unsafe []string
+}
+
+// Repo finds the orphan blocks of the repo at path and removes them if
+// opts.Remove is set. If that would not be safe, nothing is removed and an
+// error is returned along with the counts. The repo must not be in use.
+func Repo(path string, opts Options) (Result, error) {
+	res := Result{Pins: make(map[string]int)}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = migrate.DefaultBatchSize
+	}
+	v, err := mfsr.RepoPath(path).VersionNum()
+	if err != nil {
+		return res, err
+	}
+	if v < minVersion {
+		return res, fmt.Errorf("repo version %d is older than %d, whose block keys are not supported", v, minVersion)
+	}
+
+	d, err := convert.Open(path)
+	if err != nil {
+		return res, err
+	}
+	defer d.Close()
+
+	marked, err := mark(d, v, &res)
+	if err != nil {
+		return res, err
+	}
+	remove := opts.Remove && len(res.Unsafe) == 0
+	if err := sweep(d, marked, remove, opts, &res); err != nil {
+		return res, err
+	}
+	if opts.Remove && !remove {
+		return res, fmt.Errorf("not removing orphans: %s", strings.Join(res.Unsafe, "; "))
+	}
+	if !remove {
+		return res, nil
+	}
+	return res, d.Sync(ds.NewKey(dsblocks.Prefix))
+}
+
+// mark returns the multihashes of the blocks reachable from the pins and
+// the MFS root.
+func mark(d ds.Datastore, v int, res *Result) (map[string]bool, error) {
+	bs := dsblocks.Blocks{Datastore: d}
+	var recursive, direct [][]byte
+	if v >= pinsInDatastoreVersion {
+		for mode, prefix := range pinIndexes {
+			pins, err := indexedPins(d, prefix)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s pins: %w", mode, err)
+			}
+			res.Pins[mode] = len(pins)
+			if mode == "recursive" {
+				recursive = pins
+			} else {
+				direct = pins
+			}
+		}
+	} else {
+		root, err := d.Get(ds.NewKey(pincheck.PinsKey))
+		if err != nil && err != ds.ErrNotFound {
+			return nil, err
+		}
+		if err == nil {
+			r, err := pincheck.Visit(root, bs, pincheck.Visitor{
+				// the pin sets are blocks too
+				Node: func(hash []byte) { direct = append(direct, hash) },
+				Pin: func(set string, hash []byte) {
+					if set == "recursive" {
+						recursive = append(recursive, hash)
+					} else {
+						direct = append(direct, hash)
+					}
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("reading pins: %w", err)
+			}
+			res.Pins = r.Pins
+			for _, m := range r.Missing {
+				if m.Internal {
+					res.Unsafe = append(res.Unsafe, fmt.Sprintf("%s is missing, so pins may be unknown", m))
+				}
+			}
+		}
+	}
+
+	filesRoot, err := d.Get(ds.NewKey(pincheck.FilesRootKey))
+	if err == nil {
+		recursive = append(recursive, filesRoot)
+	} else if err != ds.ErrNotFound {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var opaque, undecodable int
+	for _, root := range recursive {
+		r, err := pincheck.MarkDAG(root, bs, seen)
+		if err != nil {
+			return nil, err
+		}
+		opaque += r.Opaque
+		for _, m := range r.Missing {
+			if m.Err == pincheck.ErrNotFound {
+				res.Missing++
+			} else {
+				undecodable++
+			}
+		}
+	}
+	for _, hash := range direct {
+		if !seen[string(hash)] {
+			seen[string(hash)] = true
+			if ok, err := bs.Has(hash); err != nil {
+				return nil, err
+			} else if !ok {
+				res.Missing++
+			}
+		}
+	}
+	if opaque > 0 {
+		res.Unsafe = append(res.Unsafe, fmt.Sprintf("%d reachable blocks are neither dag-pb nor raw and their links are not followed", opaque))
+	}
+	if undecodable > 0 {
+		res.Unsafe = append(res.Unsafe, fmt.Sprintf("%d reachable dag-pb nodes do not decode", undecodable))
+	}
+
+	marked := make(map[string]bool, len(seen))
+	for hash := range seen {
+		marked[string(multihash([]byte(hash)))] = true
+	}
+	res.Reachable = len(marked)
+	return marked, nil
+}
+
+// indexedPins returns the CIDs of the pins in the index under prefix.
+func indexedPins(d ds.Datastore, prefix string) ([][]byte, error) {
+	results, err := d.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	depth := len(ds.NewKey(prefix).Namespaces())
+	var pins [][]byte
+	for r := range results.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		ns := ds.RawKey(r.Key).Namespaces()
+		if len(ns) <= depth {
+			continue
+		}
+		_, c, err := multibase.Decode(ns[depth])
+		if err != nil {
+			return nil, fmt.Errorf("pin index key %s: %w", r.Key, err)
+		}
+		pins = append(pins, c)
+	}
+	return pins, nil
+}
+
+// multihash returns the multihash of a hash found in a link or pin, which
+// is either a multihash or a CID.
+func multihash(hash []byte) []byte {
+	if c, err := cid.Cast(hash); err == nil {
+		return c.Hash()
+	}
+	return hash
+}
+
+// sweep counts, and if remove is set removes, the blocks not in marked.
+func sweep(d ds.Batching, marked map[string]bool, remove bool, opts Options, res *Result) error {
+	results, err := d.Query(query.Query{Prefix: dsblocks.Prefix, KeysOnly: true, ReturnsSizes: true})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	b, err := d.Batch()
+	if err != nil {
+		return err
+	}
+	pending := 0
+	commit := func() error {
+		if pending > 0 && remove {
+			if err := b.Commit(); err != nil {
+				return err
+			}
+			res.Removed += int64(pending)
+			if b, err = d.Batch(); err != nil {
+				return err
+			}
+		}
+		pending = 0
+		if opts.Progress != nil {
+			opts.Progress(res.Scanned, res.Orphans)
+		}
+		return nil
+	}
+
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		res.Scanned++
+		key := ds.RawKey(r.Key)
+		hash, ok := dsblocks.KeyHash(key)
+		if !ok {
+			res.Unknown++
+			continue
+		}
+		if marked[string(hash)] {
+			continue
+		}
+		res.Orphans++
+		if r.Size > 0 {
+			res.OrphanBytes += int64(r.Size)
+		}
+		if remove {
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+		if pending++; pending >= opts.BatchSize {
+			if err := commit(); err != nil {
+				return err
+			}
+			opts.Window.Wait(nil)
+		}
+	}
+	return commit()
+}
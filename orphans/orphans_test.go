@@ -0,0 +1,179 @@
+package orphans
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	pb "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-merkledag/pb"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multibase"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+)
+
+// dag holds blocks to write to a repo, by key.
+type dag map[string][]byte
+
+func sum(t *testing.T, data []byte) mh.Multihash {
+	t.Helper()
+	hash, err := mh.Sum(data, mh.SHA2_256, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
+
+// raw adds a raw block, keyed by its CIDv1, and returns the CID.
+func (d dag) raw(t *testing.T, data []byte) []byte {
+	c := cid.NewCidV1(cid.Raw, sum(t, data)).Bytes()
+	d[dsblocks.Keys(c)[0].String()] = data
+	return c
+}
+
+// node adds a dag-pb node, keyed by its multihash, and returns the CIDv0.
+func (d dag) node(t *testing.T, data []byte, links ...[]byte) []byte {
+	t.Helper()
+	n := pb.PBNode{Data: data}
+	for _, l := range links {
+		n.Links = append(n.Links, &pb.PBLink{Hash: l})
+	}
+	enc, err := n.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sum(t, enc)
+	d[dsblocks.Keys(hash)[0].String()] = enc
+	return hash
+}
+
+// set adds a pin set node without fanout holding items.
+func (d dag) set(t *testing.T, items ...[]byte) []byte {
+	hdr := []byte{0x08, 0x01, 0x10, 0x00}
+	data := make([]byte, binary.MaxVarintLen64)
+	data = append(data[:binary.PutUvarint(data, uint64(len(hdr)))], hdr...)
+	return d.node(t, data, items...)
+}
+
+// put writes keys, and the blocks of d, to the datastore of the repo at
+// path.
+func put(t *testing.T, path string, d dag, keys map[string][]byte) {
+	t.Helper()
+	store, err := convert.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	for k, v := range d {
+		keys[k] = v
+	}
+	for k, v := range keys {
+		if err := store.Put(ds.NewKey(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Sync(ds.NewKey("/")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func indexKey(t *testing.T, prefix string, c []byte) string {
+	enc, err := multibase.Encode(multibase.Base64url, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return prefix + "/" + enc + "/pin"
+}
+
+func TestRepo(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(b), migrationtest.WithBlocks(5, 100))
+			d := dag{}
+			file := d.raw(t, []byte("file"))
+			dir := d.node(t, []byte("dir"), file)
+			direct := d.node(t, []byte("direct"))
+			files := d.node(t, []byte("mfs"))
+			d.raw(t, []byte("orphan"))
+			put(t, r.Path, d, map[string][]byte{
+				indexKey(t, pinIndexes["recursive"], dir): {},
+				indexKey(t, pinIndexes["direct"], direct): {},
+				pincheck.FilesRootKey:                     files,
+			})
+
+			res, err := Repo(r.Path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Scanned != 10 || res.Orphans != 6 || res.Reachable != 4 || res.Missing != 0 || res.Removed != 0 {
+				t.Fatalf("unexpected result %+v", res)
+			}
+			if res.OrphanBytes != 5*100+int64(len("orphan")) {
+				t.Errorf("orphans hold %d bytes", res.OrphanBytes)
+			}
+
+			res, err = Repo(r.Path, Options{Remove: true, BatchSize: 4})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Removed != 6 {
+				t.Errorf("removed %d orphans, want 6", res.Removed)
+			}
+			res, err = Repo(r.Path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Scanned != 4 || res.Orphans != 0 {
+				t.Errorf("after removal: %+v", res)
+			}
+		})
+	}
+}
+
+func TestRepoPinSets(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(migrationtest.Leveldb), migrationtest.WithBlocks(3, 100))
+	d := dag{}
+	file := d.raw(t, []byte("file"))
+	dir := d.node(t, []byte("dir"), file)
+	n := pb.PBNode{Links: []*pb.PBLink{
+		{Name: &pincheck.Sets[0], Hash: d.set(t, dir)},
+		{Name: &pincheck.Sets[1], Hash: d.set(t)},
+	}}
+	enc, err := n.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := sum(t, enc)
+	d[dsblocks.Keys(root)[0].String()] = enc
+	put(t, r.Path, d, map[string][]byte{pincheck.PinsKey: root})
+
+	res, err := Repo(r.Path, Options{Remove: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the pin root and sets are kept with the pinned DAG
+	if res.Removed != 3 || res.Reachable != 5 || res.Pins["recursive"] != 1 {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestRepoUnsafe(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(migrationtest.Leveldb), migrationtest.WithBlocks(3, 100))
+	d := dag{}
+	cbor := cid.NewCidV1(cid.DagCBOR, sum(t, []byte("cbor"))).Bytes()
+	d[dsblocks.Keys(cbor)[0].String()] = []byte("cbor")
+	put(t, r.Path, d, map[string][]byte{indexKey(t, pinIndexes["recursive"], cbor): {}})
+
+	res, err := Repo(r.Path, Options{Remove: true})
+	if err == nil {
+		t.Fatal("removed orphans below a dag-cbor pin")
+	}
+	if res.Removed != 0 || res.Orphans != 3 || len(res.Unsafe) != 1 {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
@@ -0,0 +1,128 @@
+package pincheck
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// FilesRootKey is the datastore key holding the hash of the MFS root.
+const FilesRootKey = "/local/filesroot"
+
+// multicodecs of the blocks a DAG walk tells apart
+const (
+	codecDagPB = 0x70
+	codecRaw   = 0x55
+)
+
+// DAGReport is the outcome of CheckDAG.
+type DAGReport struct {
+	Blocks  int // blocks read
+	Missing []MissingBlock
+	// Opaque counts the blocks of codecs other than dag-pb and raw, e.g.
+	// dag-cbor, whose links were not followed.
+	Opaque int
+}
+
+// MissingBlock is a block of a DAG that could not be read.
+type MissingBlock struct {
+	Path string // link names from the root, e.g. "/docs/a.txt"
+	Hash []byte
+	Err  error // why it could not be read
+}
+
+func (m MissingBlock) String() string {
+	return fmt.Sprintf("%s (%s): %s", m.Path, base58(m.Hash), m.Err)
+}
+
+// Err returns an error listing the unreadable blocks, if there are any.
+func (r DAGReport) Err() error {
+	if len(r.Missing) == 0 {
+		return nil
+	}
+	const shown = 10
+	var list []string
+	for i, m := range r.Missing {
+		if i == shown {
+			list = append(list, fmt.Sprintf("and %d more", len(r.Missing)-shown))
+			break
+		}
+		list = append(list, m.String())
+	}
+	return fmt.Errorf("%d blocks cannot be read: %s", len(r.Missing), strings.Join(list, ", "))
+}
+
+// CheckDAG reads every block reachable from root, such as the MFS root,
+// and reports those that are missing or do not decode. Links are followed
+// through dag-pb nodes; raw and other blocks are only looked up.
+func CheckDAG(root []byte, bs Blocks) (DAGReport, error) {
+	return MarkDAG(root, bs, make(map[string]bool))
+}
+
+// MarkDAG is CheckDAG with the set of hashes already walked, which it adds
+// the hashes it reaches to, so that several DAGs sharing blocks can be
+// walked each block once. Blocks in seen are not counted or looked up.
+func MarkDAG(root []byte, bs Blocks, seen map[string]bool) (DAGReport, error) {
+	var r DAGReport
+	var walk func(path string, hash []byte) error
+	walk = func(path string, hash []byte) error {
+		if seen[string(hash)] {
+			return nil
+		}
+		seen[string(hash)] = true
+
+		codec := hashCodec(hash)
+		if codec != codecDagPB {
+			if codec != codecRaw {
+				r.Opaque++
+			}
+			ok, err := bs.Has(hash)
+			if err != nil {
+				return err
+			}
+			r.Blocks++
+			if !ok {
+				r.Missing = append(r.Missing, MissingBlock{Path: path, Hash: hash, Err: ErrNotFound})
+			}
+			return nil
+		}
+
+		data, err := bs.Get(hash)
+		if err == ErrNotFound {
+			r.Missing = append(r.Missing, MissingBlock{Path: path, Hash: hash, Err: err})
+			return nil
+		} else if err != nil {
+			return err
+		}
+		r.Blocks++
+		n, err := decodeNode(data)
+		if err != nil {
+			r.Missing = append(r.Missing, MissingBlock{Path: path, Hash: hash, Err: err})
+			return nil
+		}
+		for _, l := range n.Links {
+			if err := walk(strings.TrimSuffix(path, "/")+"/"+l.Name, l.Hash); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return r, walk("/", root)
+}
+
+// hashCodec returns the multicodec of the block a link points to: dag-pb
+// for plain multihashes (CIDv0), or the codec of a CIDv1.
+func hashCodec(hash []byte) uint64 {
+	if len(hash) == 34 && hash[0] == 0x12 && hash[1] == 0x20 {
+		return codecDagPB
+	}
+	version, n := binary.Uvarint(hash)
+	if n <= 0 || version != 1 {
+		return codecDagPB
+	}
+	codec, m := binary.Uvarint(hash[n:])
+	if m <= 0 {
+		return codecDagPB
+	}
+	return codec
+}
@@ -0,0 +1,82 @@
+package pincheck
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+)
+
+// rawCid returns a CIDv1 of a raw block, which CheckDAG only looks up.
+func rawCid(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return append([]byte{0x01, 0x55, 0x12, 0x20}, sum[:]...)
+}
+
+func TestCheckDAG(t *testing.T) {
+	bs := memBlocks{}
+	a := bs.node(t, []byte("file a"))
+	leaf := rawCid([]byte("leaf"))
+	bs[string(leaf)] = []byte("leaf")
+	docs := bs.node(t, nil,
+		link{Name: "a.txt", Hash: a},
+		link{Name: "b.txt", Hash: leaf})
+	// the same file linked twice is read once
+	root := bs.node(t, nil,
+		link{Name: "docs", Hash: docs},
+		link{Name: "copy.txt", Hash: a})
+
+	r, err := CheckDAG(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Blocks != 4 {
+		t.Errorf("read %d blocks, want 4", r.Blocks)
+	}
+
+	delete(bs, string(leaf))
+	delete(bs, string(a))
+	r, err = CheckDAG(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Missing) != 2 {
+		t.Fatalf("got %d missing blocks, want 2: %v", len(r.Missing), r.Missing)
+	}
+	if r.Missing[0].Path != "/docs/a.txt" || r.Missing[1].Path != "/docs/b.txt" {
+		t.Errorf("wrong missing paths: %v", r.Missing)
+	}
+	if err := r.Err(); err == nil || !strings.Contains(err.Error(), "2 blocks") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckDAGMissingRoot(t *testing.T) {
+	bs := memBlocks{}
+	root := bs.node(t, []byte("dir"))
+	delete(bs, string(root))
+
+	r, err := CheckDAG(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Missing) != 1 || r.Missing[0].Path != "/" {
+		t.Errorf("want the root missing, got %v", r.Missing)
+	}
+}
+
+func TestCheckDAGUndecodable(t *testing.T) {
+	bs := memBlocks{}
+	bad := bs.put([]byte{0xff, 0xff, 0xff})
+	root := bs.node(t, nil, link{Name: "bad", Hash: bad})
+
+	r, err := CheckDAG(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Missing) != 1 || r.Missing[0].Path != "/bad" || r.Missing[0].Err == ErrNotFound {
+		t.Errorf("want /bad undecodable, got %v", r.Missing)
+	}
+}
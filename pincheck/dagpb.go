@@ -0,0 +1,141 @@
+package pincheck
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// node is a dag-pb node, decoded as far as the checks need.
+type node struct {
+	Data  []byte
+	Links []link
+}
+
+type link struct {
+	Hash []byte
+	Name string
+}
+
+// decodeNode decodes a dag-pb node: a protobuf message whose field 1 is
+// the data and whose repeated field 2 holds the links, each a message
+// whose field 1 is the hash and field 2 the name. It is decoded by hand so
+// that migrations checking pins do not depend on a protobuf library.
+func decodeNode(data []byte) (node, error) {
+	var n node
+	err := protoFields(data, func(field int, wire uint64, val []byte) error {
+		switch field {
+		case 1:
+			if wire != 2 {
+				return fmt.Errorf("dag-pb: wire type %d for data", wire)
+			}
+			n.Data = val
+		case 2:
+			if wire != 2 {
+				return fmt.Errorf("dag-pb: wire type %d for link", wire)
+			}
+			l, err := decodeLink(val)
+			if err != nil {
+				return err
+			}
+			n.Links = append(n.Links, l)
+		}
+		return nil
+	})
+	return n, err
+}
+
+func decodeLink(data []byte) (link, error) {
+	var l link
+	err := protoFields(data, func(field int, wire uint64, val []byte) error {
+		switch field {
+		case 1:
+			if wire != 2 {
+				return fmt.Errorf("dag-pb: wire type %d for link hash", wire)
+			}
+			l.Hash = val
+		case 2:
+			if wire != 2 {
+				return fmt.Errorf("dag-pb: wire type %d for link name", wire)
+			}
+			l.Name = string(val)
+		}
+		return nil
+	})
+	return l, err
+}
+
+var errTruncated = errors.New("dag-pb: truncated message")
+
+// protoFields calls fn with each field of the protobuf message in data.
+// val is the content of length-delimited fields and nil for the others,
+// which the checks do not read.
+func protoFields(data []byte, fn func(field int, wire uint64, val []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 || tag>>3 == 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		wire := tag & 7
+		var val []byte
+		switch wire {
+		case 0: // varint
+			if _, n = binary.Uvarint(data); n <= 0 {
+				return errTruncated
+			}
+		case 1: // fixed64
+			n = 8
+		case 2: // length-delimited
+			size, m := binary.Uvarint(data)
+			if m <= 0 || size > uint64(len(data)-m) {
+				return errTruncated
+			}
+			val = data[m : m+int(size)]
+			n = m + int(size)
+		case 5: // fixed32
+			n = 4
+		default:
+			return fmt.Errorf("dag-pb: unexpected wire type %d", wire)
+		}
+		if n > len(data) {
+			return errTruncated
+		}
+		data = data[n:]
+		if err := fn(int(tag>>3), wire, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58 encodes b as CIDv0 hashes are written, for messages.
+func base58(b []byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	var digits []byte // base 58, least significant first
+	for _, c := range b[zeros:] {
+		carry := int(c)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+	s := make([]byte, 0, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		s = append(s, '1')
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		s = append(s, base58Alphabet[digits[i]])
+	}
+	return string(s)
+}
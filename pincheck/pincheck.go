@@ -0,0 +1,218 @@
+// Package pincheck verifies that a repo's pins and MFS root still resolve
+// to blocks, so that migrations rewriting block keys can refuse to bump the
+// repo version when pinned content or files would be lost.
+//
+// Check reads the pin sets of the go-ipfs pinner used before pins moved
+// into the datastore (repo version 11): the datastore holds at PinsKey the
+// hash of a dag-pb root node, which links to the "recursive" and "direct"
+// sets, each a fanout tree of dag-pb nodes. CheckDAG reads a whole DAG,
+// such as the one under the MFS root at FilesRootKey.
+package pincheck
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PinsKey is the datastore key holding the hash of the pin root node.
+const PinsKey = "/local/pins"
+
+// Sets are the pin sets that are checked, by their link name in the root.
+var Sets = []string{"recursive", "direct"}
+
+// Blocks looks up blocks by the hash found in dag-pb links, a multihash or
+// CID. Migrations implement it on their own datastore, with the key
+// scheme the blocks are in after the rewrite.
+type Blocks interface {
+	Has(hash []byte) (bool, error)
+	// Get returns ErrNotFound if there is no such block.
+	Get(hash []byte) ([]byte, error)
+}
+
+// ErrNotFound is returned by Blocks.Get for missing blocks.
+var ErrNotFound = errors.New("block not found")
+
+// emptyNode is the hash of the empty dag-pb node, which empty fanout
+// buckets link to.
+var emptyNode = func() string {
+	sum := sha256.Sum256(nil)
+	return string(append([]byte{0x12, 0x20}, sum[:]...))
+}()
+
+// Missing is a pin, or a node of a pin set, whose block is missing.
+type Missing struct {
+	Set  string
+	Hash []byte
+	// Internal is set for nodes of the pin set itself, whose pins could
+	// not be checked.
+	Internal bool
+}
+
+func (m Missing) String() string {
+	what := m.Set + " pin"
+	if m.Internal {
+		what = m.Set + " pin set node"
+	}
+	return fmt.Sprintf("%s %s", what, base58(m.Hash))
+}
+
+// Report is the outcome of a check.
+type Report struct {
+	Pins    map[string]int // pins checked, by set
+	Missing []Missing
+}
+
+// Err returns an error listing the missing pins, if there are any.
+func (r Report) Err() error {
+	if len(r.Missing) == 0 {
+		return nil
+	}
+	const shown = 10
+	var list []string
+	for i, m := range r.Missing {
+		if i == shown {
+			list = append(list, fmt.Sprintf("and %d more", len(r.Missing)-shown))
+			break
+		}
+		list = append(list, m.String())
+	}
+	return fmt.Errorf("%d pins do not resolve to a block: %s", len(r.Missing), strings.Join(list, ", "))
+}
+
+// Visitor is told of the hashes a pin set walk comes across.
+type Visitor struct {
+	// Node, if not nil, is called for the root and each pin set node,
+	// including the empty node that empty buckets link to.
+	Node func(hash []byte)
+	// Pin, if not nil, is called for each pin with its set.
+	Pin func(set string, hash []byte)
+}
+
+// Check walks the pin sets under the root node with hash root and checks
+// that every pin has a block. A missing root or set node is reported as
+// missing, not as an error.
+func Check(root []byte, bs Blocks) (Report, error) {
+	return Visit(root, bs, Visitor{})
+}
+
+// Visit is Check, telling v of every pin set node and pin on the way.
+func Visit(root []byte, bs Blocks, v Visitor) (Report, error) {
+	r := Report{Pins: make(map[string]int)}
+	data, err := bs.Get(root)
+	if err == ErrNotFound {
+		r.Missing = append(r.Missing, Missing{Set: "root", Hash: root, Internal: true})
+		return r, nil
+	} else if err != nil {
+		return r, err
+	}
+	n, err := decodeNode(data)
+	if err != nil {
+		return r, fmt.Errorf("pin root: %w", err)
+	}
+	if v.Node != nil {
+		v.Node(root)
+	}
+
+	for _, set := range Sets {
+		for _, l := range n.Links {
+			if l.Name != set {
+				continue
+			}
+			if err := r.walk(set, l.Hash, bs, v); err != nil {
+				return r, fmt.Errorf("%s pins: %w", set, err)
+			}
+		}
+	}
+	return r, nil
+}
+
+// walk checks the items of the pin set node with the given hash and
+// descends into its fanout buckets.
+func (r *Report) walk(set string, hash []byte, bs Blocks, v Visitor) error {
+	if string(hash) == emptyNode {
+		if v.Node != nil {
+			v.Node(hash)
+		}
+		return nil
+	}
+	data, err := bs.Get(hash)
+	if err == ErrNotFound {
+		r.Missing = append(r.Missing, Missing{Set: set, Hash: hash, Internal: true})
+		return nil
+	} else if err != nil {
+		return err
+	}
+	n, err := decodeNode(data)
+	if err != nil {
+		return err
+	}
+	fanout, err := readFanout(n.Data)
+	if err != nil {
+		return err
+	}
+	if v.Node != nil {
+		v.Node(hash)
+	}
+	if fanout > len(n.Links) {
+		return fmt.Errorf("fanout %d exceeds %d links", fanout, len(n.Links))
+	}
+
+	for _, l := range n.Links[fanout:] {
+		r.Pins[set]++
+		if v.Pin != nil {
+			v.Pin(set, l.Hash)
+		}
+		ok, err := bs.Has(l.Hash)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			r.Missing = append(r.Missing, Missing{Set: set, Hash: l.Hash})
+		}
+	}
+	for _, l := range n.Links[:fanout] {
+		if err := r.walk(set, l.Hash, bs, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFanout reads the fanout from the header of a pin set node: a varint
+// length followed by a protobuf message whose field 2 is the fanout.
+func readFanout(data []byte) (int, error) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < size {
+		return 0, errors.New("invalid pin set header")
+	}
+	hdr := data[n : n+int(size)]
+	for len(hdr) > 0 {
+		tag, n := binary.Uvarint(hdr)
+		if n <= 0 {
+			return 0, errors.New("invalid pin set header")
+		}
+		hdr = hdr[n:]
+		switch tag & 7 {
+		case 0: // varint
+			v, n := binary.Uvarint(hdr)
+			if n <= 0 {
+				return 0, errors.New("invalid pin set header")
+			}
+			hdr = hdr[n:]
+			if tag>>3 == 2 {
+				return int(v), nil
+			}
+		case 5: // fixed32
+			if len(hdr) < 4 {
+				return 0, errors.New("invalid pin set header")
+			}
+			hdr = hdr[4:]
+		default:
+			return 0, fmt.Errorf("unexpected wire type %d in pin set header", tag&7)
+		}
+	}
+	return 0, errors.New("pin set header has no fanout")
+}
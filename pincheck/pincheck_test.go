@@ -0,0 +1,127 @@
+package pincheck
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+)
+
+type memBlocks map[string][]byte
+
+func (m memBlocks) Has(hash []byte) (bool, error) {
+	_, ok := m[string(hash)]
+	return ok, nil
+}
+
+func (m memBlocks) Get(hash []byte) ([]byte, error) {
+	data, ok := m[string(hash)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func (m memBlocks) put(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	hash := append([]byte{0x12, 0x20}, sum[:]...)
+	m[string(hash)] = data
+	return hash
+}
+
+func (m memBlocks) node(t *testing.T, data []byte, links ...link) []byte {
+	return m.put(encodeNode(data, links...))
+}
+
+// encodeNode encodes a dag-pb node as go-ipfs does, links first.
+func encodeNode(data []byte, links ...link) []byte {
+	var b []byte
+	varint := func(v uint64) {
+		buf := make([]byte, binary.MaxVarintLen64)
+		b = append(b, buf[:binary.PutUvarint(buf, v)]...)
+	}
+	bytesField := func(field int, val []byte) {
+		varint(uint64(field)<<3 | 2)
+		varint(uint64(len(val)))
+		b = append(b, val...)
+	}
+	for _, l := range links {
+		outer := b
+		b = nil
+		bytesField(1, l.Hash)
+		if l.Name != "" {
+			bytesField(2, []byte(l.Name))
+		}
+		varint(3 << 3) // Tsize
+		varint(uint64(len(l.Hash)))
+		msg := b
+		b = outer
+		bytesField(2, msg)
+	}
+	if data != nil {
+		bytesField(1, data)
+	}
+	return b
+}
+
+// set stores a pin set node with the given buckets and items.
+func (m memBlocks) set(t *testing.T, buckets [][]byte, items ...[]byte) []byte {
+	hdr := []byte{0x08, 0x01, 0x10, byte(len(buckets)), 0x1d, 1, 2, 3, 4}
+	data := make([]byte, binary.MaxVarintLen64)
+	data = append(data[:binary.PutUvarint(data, uint64(len(hdr)))], hdr...)
+	var links []link
+	for _, h := range buckets {
+		links = append(links, link{Hash: h})
+	}
+	for _, h := range items {
+		links = append(links, link{Hash: h})
+	}
+	return m.node(t, data, links...)
+}
+
+func TestCheck(t *testing.T) {
+	bs := memBlocks{}
+	empty := bs.node(t, nil)
+	a := bs.put([]byte("a"))
+	b := bs.put([]byte("b"))
+	c := bs.put([]byte("c"))
+
+	child := bs.set(t, [][]byte{empty, empty}, b, c)
+	recursive := bs.set(t, [][]byte{child, empty}, a)
+	direct := bs.set(t, [][]byte{empty, empty}, c)
+	root := bs.node(t, nil,
+		link{Name: "direct", Hash: direct},
+		link{Name: "recursive", Hash: recursive})
+
+	r, err := Check(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Err() != nil || r.Pins["recursive"] != 3 || r.Pins["direct"] != 1 {
+		t.Fatalf("unexpected report %+v", r)
+	}
+
+	// lose a pinned block and a pin set node
+	delete(bs, string(c))
+	delete(bs, string(child))
+	r, err = Check(root, bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Missing) != 2 || r.Err() == nil {
+		t.Fatalf("expected a recursive set node and the direct pin missing, got %v", r.Missing)
+	}
+	if !r.Missing[0].Internal || r.Missing[1].Internal {
+		t.Fatalf("unexpected missing %v", r.Missing)
+	}
+}
+
+func TestBase58(t *testing.T) {
+	sum := sha256.Sum256(nil)
+	hash := append([]byte{0x12, 0x20}, sum[:]...)
+	if s := base58(hash); s != "QmdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR1n" {
+		t.Errorf("base58 of the empty node's hash is %s", s)
+	}
+	if s := base58([]byte{0, 0, 1}); s != "112" {
+		t.Errorf("base58 of 0x000001 is %s", s)
+	}
+}
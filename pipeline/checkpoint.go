@@ -0,0 +1,86 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Checkpoint records how far an interrupted run got. It only keeps the
+// count of keys done: pipelines that delete what they move resume by
+// querying what is left, and the checkpoint keeps the count across runs
+// and tells the operator that the repo is half way.
+type Checkpoint struct {
+	path string
+
+	mu   sync.Mutex
+	done bool
+
+	// Tag tells runs apart that must not share a count, e.g. "revert".
+	Tag     string    `json:"tag"`
+	Done    int64     `json:"done"`
+	Updated time.Time `json:"updated"`
+}
+
+// LoadCheckpoint reads the checkpoint at path. It returns a fresh
+// checkpoint if there is none, or if it is for a run with another tag.
+func LoadCheckpoint(path, tag string) (*Checkpoint, error) {
+	cp := &Checkpoint{path: path, Tag: tag}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var prev Checkpoint
+	if err := json.Unmarshal(data, &prev); err != nil || prev.Tag != tag {
+		return cp, nil
+	}
+	cp.Done, cp.Updated = prev.Done, prev.Updated
+	return cp, nil
+}
+
+// Add counts n more keys done. It does nothing on a nil *Checkpoint.
+func (cp *Checkpoint) Add(n int64) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	cp.Done += n
+	cp.mu.Unlock()
+}
+
+// Save writes the checkpoint atomically, unless the run is complete.
+func (cp *Checkpoint) Save() error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.done {
+		return nil
+	}
+	cp.Updated = time.Now().UTC()
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp := cp.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, cp.path)
+}
+
+// Remove deletes the checkpoint once the run is complete.
+func (cp *Checkpoint) Remove() error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.done = true
+	err := os.Remove(cp.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,398 @@
+// Package pipeline runs the read, transform, write and delete steps that
+// block-moving migrations apply to every key, with opts.Workers workers fed
+// through a queue of opts.ChanBuffer keys.
+//
+// Each key is one batch of heavy work (see migrate.Options.BeginBatch), so
+// the execution window, pause, throttle and memory limit apply to it and a
+// shutdown never leaves a key half moved. Pipelines that delete what they
+// write resume by themselves: a re-run only finds the keys left. A
+// Checkpoint carries the count done across runs.
+//
+// Keys held in a file each, as in flatfs, are moved by renaming the file
+// when the source and destination share a filesystem, see Stages.Files.
+// ShardSource lists such keys scanning several shard directories at once.
+//
+// The package knows nothing of datastores, whose interfaces differ between
+// the vendored versions the migrations use: the stages are functions on
+// string keys and byte values.
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+// ErrSkip is returned by a stage to leave a key alone. Skipped keys are
+// counted but are not errors.
+var ErrSkip = errors.New("skip key")
+
+// Item is a key and its value.
+type Item struct {
+	Key   string
+	Value []byte
+}
+
+// Source calls yield with every key to process, and stops early if yield
+// returns false. A source that reads values as it goes, like a leveldb
+// iterator, yields them too, saving a Read per key; others yield items
+// with a nil Value.
+type Source func(yield func(it Item) bool) error
+
+// Stages are the steps every key goes through. Read and Write are
+// required. Stages are called by several workers at once.
+type Stages struct {
+	// Read returns the value of key, when the source did not yield it.
+	Read func(key string) ([]byte, error)
+	// Transform, if not nil, returns what to write for an item read.
+	Transform func(it Item) (Item, error)
+	// Write stores an item.
+	Write func(it Item) error
+	// Delete, if not nil, deletes key from the source once what was read
+	// from it is written, making the pipeline a move.
+	Delete func(key string) error
+	// Flush, if not nil, commits what Write and Delete buffered, which
+	// lets them write in datastore batches. It is called every BatchSize
+	// keys, when shutting down, when the window closes and at the end of
+	// the run. Deletes must not be committed before the writes they follow.
+	// Once Flush fails the run stops, Flush is not called again and the
+	// checkpoint is no longer saved.
+	Flush func() error
+	// Files, if not nil, returns the file holding key in the source and
+	// the file it belongs in at the destination, for datastores keeping a
+	// file per key like flatfs. Keys are then moved by renaming their file
+	// instead of going through the other stages, as long as the files are
+	// on the same filesystem. The destination must name the file as Write
+	// would have. ok false leaves the key to the other stages.
+	Files func(key string) (from, to string, ok bool)
+}
+
+// Options controls a run.
+type Options struct {
+	// Name names the run in progress lines, e.g. "moving objects".
+	Name string
+	// Counter, if set, is the telemetry counter of keys done.
+	Counter string
+	// Total is the number of keys expected, including those done in
+	// earlier runs, for progress. Zero if unknown.
+	Total int64
+	// MaxErrors is the number of failed keys tolerated before the run
+	// stops. Zero stops at the first.
+	MaxErrors int
+	// Checkpoint, if not nil, counts the keys done and is saved after
+	// each Flush. Failed keys are not counted.
+	Checkpoint *Checkpoint
+}
+
+// Stats is the outcome of a run.
+type Stats struct {
+	Done    int64
+	Skipped int64
+	Bytes   int64
+}
+
+// KeyError is the error a stage returned for a key.
+type KeyError struct {
+	Key string
+	Err error
+}
+
+func (e KeyError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Err)
+}
+
+func (e KeyError) Unwrap() error {
+	return e.Err
+}
+
+// Errors are the keys that failed in a run. Run returns them once all keys
+// are tried, or once there are more than Options.MaxErrors.
+type Errors []KeyError
+
+// maxShown is the number of key errors Errors.Error lists.
+const maxShown = 5
+
+func (es Errors) Error() string {
+	if len(es) == 1 {
+		return es[0].Error()
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d keys failed:", len(es))
+	for i, e := range es {
+		if i == maxShown {
+			fmt.Fprintf(&b, " and %d more", len(es)-maxShown)
+			break
+		}
+		b.WriteString(" " + e.Error() + ";")
+	}
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// contain reports whether err is the error of one of the keys.
+func (es Errors) contain(err error) bool {
+	for _, e := range es {
+		if e.Err == err {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the first error, so that errors.Is sees through a single
+// failure, e.g. of a full disk.
+func (es Errors) Unwrap() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es[0].Err
+}
+
+type run struct {
+	opts   migrate.Options
+	po     Options
+	stages Stages
+	log    *log.Logger
+	prog   *log.Progress
+
+	base                 int64 // keys done by earlier runs
+	done, skipped, bytes int64
+	copying              int32 // set once renames fail across filesystems
+
+	mu   sync.Mutex
+	errs Errors
+	stop chan struct{} // closed when too many keys failed
+	once sync.Once
+
+	cmu      sync.Mutex
+	flushErr error // the error of the Flush that failed
+}
+
+// Run feeds the keys of src through stages. It returns ErrInterrupted if
+// shutdown begins, and Errors if keys failed.
+func Run(opts migrate.Options, src Source, stages Stages, po Options) (Stats, error) {
+	if stages.Read == nil || stages.Write == nil {
+		return Stats{}, errors.New("pipeline: Read and Write stages are required")
+	}
+	r := &run{
+		opts:   opts,
+		po:     po,
+		stages: stages,
+		log:    opts.Logger(),
+		stop:   make(chan struct{}),
+	}
+	if stages.Flush != nil || po.Checkpoint != nil {
+		defer opts.Shutdown.OnFlush(r.commit)()
+		defer opts.Window.OnPause(r.commit)()
+	}
+	if cp := po.Checkpoint; cp != nil {
+		if cp.Done > 0 {
+			r.log.Info("resuming %s interrupted at %s, %d keys already done", po.Name, cp.Updated.Local().Format(time.RFC3339), cp.Done)
+		}
+		r.base = cp.Done
+	}
+	r.prog = r.log.NewProgress(log.DefaultProgressInterval)
+	defer r.prog.Done()
+
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	keys := make(chan Item, opts.ChanBuffer)
+	interrupted := int32(0)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := range keys {
+				if !r.key(it) {
+					atomic.StoreInt32(&interrupted, 1)
+				}
+			}
+		}()
+	}
+
+	srcErr := src(func(it Item) bool {
+		if atomic.LoadInt32(&interrupted) != 0 {
+			return false
+		}
+		select {
+		case keys <- it:
+			return true
+		case <-r.stop:
+			return false
+		case <-opts.Shutdown.Stopping():
+			return false
+		}
+	})
+	close(keys)
+	wg.Wait()
+
+	st := Stats{Done: r.done, Skipped: r.skipped, Bytes: r.bytes}
+	if atomic.LoadInt32(&interrupted) != 0 {
+		// committed by the shutdown flush, once no key is half done
+		return st, migrate.ErrInterrupted
+	}
+	// a Flush that failed in a batch was reported with its key
+	if err := r.commit(); err != nil && !r.errs.contain(err) {
+		return st, err
+	}
+	switch {
+	case len(r.errs) > 0:
+		return st, r.errs
+	case srcErr != nil:
+		return st, srcErr
+	}
+	select {
+	case <-opts.Shutdown.Stopping():
+		return st, migrate.ErrInterrupted
+	default:
+	}
+	if cp := po.Checkpoint; cp != nil {
+		if err := cp.Remove(); err != nil {
+			return st, err
+		}
+	}
+	return st, nil
+}
+
+// key processes one key as a batch. It returns false if the batch could not
+// begin because shutdown has begun.
+func (r *run) key(src Item) bool {
+	key := src.Key
+	select {
+	case <-r.stop:
+		// too many failures: drain the queue
+		return true
+	default:
+	}
+	if !r.opts.BeginBatch() {
+		return false
+	}
+	n, err := r.stagesFor(src)
+	if err == nil {
+		done := r.base + atomic.AddInt64(&r.done, 1)
+		r.po.Checkpoint.Add(1)
+		if every := int64(r.opts.BatchSize); every > 0 && done%every == 0 {
+			// inside the batch, so the shutdown flush waits for it
+			if err = r.commit(); err != nil {
+				// not done if it was not committed
+				atomic.AddInt64(&r.done, -1)
+				r.po.Checkpoint.Add(-1)
+			}
+		}
+		r.prog.Update("%s: %d%s", r.po.Name, done, r.prog.Remaining(done, r.po.Total))
+	}
+	r.opts.EndBatch()
+	r.opts.Transferred(n)
+
+	switch {
+	case err == ErrSkip:
+		atomic.AddInt64(&r.skipped, 1)
+		r.log.Trace("%s: skipped", key)
+	case err != nil:
+		r.log.Trace("%s: errored: %s", key, err)
+		r.fail(key, err)
+	default:
+		atomic.AddInt64(&r.bytes, n)
+		r.log.Trace("%s: done", key)
+		if r.po.Counter != "" {
+			r.opts.Count(r.po.Counter, 1)
+		}
+	}
+	return true
+}
+
+// stagesFor runs the stages on the item src yielded and returns the bytes
+// read and written.
+func (r *run) stagesFor(src Item) (int64, error) {
+	key := src.Key
+	if r.stages.Files != nil && atomic.LoadInt32(&r.copying) == 0 {
+		if from, to, ok := r.stages.Files(key); ok {
+			err := renameFile(from, to)
+			if !isCrossDevice(err) {
+				return 0, err
+			}
+			if atomic.CompareAndSwapInt32(&r.copying, 0, 1) {
+				r.log.Info("%s: source and destination are on different filesystems, copying instead of renaming", r.po.Name)
+			}
+		}
+	}
+	val, err := src.Value, error(nil)
+	if val == nil {
+		if val, err = r.stages.Read(key); err != nil {
+			return 0, err
+		}
+	}
+	it := Item{Key: key, Value: val}
+	if r.stages.Transform != nil {
+		if it, err = r.stages.Transform(it); err != nil {
+			return int64(len(val)), err
+		}
+	}
+	n := int64(len(val) + len(it.Value))
+	if err := r.stages.Write(it); err != nil {
+		return n, err
+	}
+	if r.stages.Delete != nil {
+		if err := r.stages.Delete(key); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// commit flushes the stages and saves the checkpoint. Once a Flush failed,
+// what it committed is in doubt, so commit neither flushes again nor saves
+// the count, and returns that error: a re-run finds the keys left and
+// resumes from the count last saved.
+func (r *run) commit() error {
+	r.cmu.Lock()
+	defer r.cmu.Unlock()
+	if r.flushErr != nil {
+		return r.flushErr
+	}
+	if r.stages.Flush != nil {
+		if err := r.stages.Flush(); err != nil {
+			r.flushErr = err
+			// nothing written from now on would be committed
+			r.once.Do(func() { close(r.stop) })
+			return err
+		}
+	}
+	if cp := r.po.Checkpoint; cp != nil {
+		return cp.Save()
+	}
+	return nil
+}
+
+// renameFile moves the file from to to, creating the directory of to.
+func renameFile(from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return err
+	}
+	return os.Rename(from, to)
+}
+
+func isCrossDevice(err error) bool {
+	var le *os.LinkError
+	return errors.As(err, &le) && le.Err == syscall.EXDEV
+}
+
+func (r *run) fail(key string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, KeyError{Key: key, Err: err})
+	if len(r.errs) > r.po.MaxErrors {
+		r.once.Do(func() { close(r.stop) })
+	}
+}
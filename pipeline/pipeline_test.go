@@ -0,0 +1,431 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+// store is a map datastore safe for concurrent use.
+type store struct {
+	mu sync.Mutex
+	m  map[string][]byte
+}
+
+func newStore(n int) *store {
+	s := &store{m: make(map[string][]byte)}
+	for i := 0; i < n; i++ {
+		s.m[fmt.Sprintf("/k%03d", i)] = []byte(fmt.Sprintf("v%d", i))
+	}
+	return s
+}
+
+func (s *store) source(yield func(Item) bool) error {
+	s.mu.Lock()
+	var keys []string
+	for k := range s.m {
+		keys = append(keys, k)
+	}
+	s.mu.Unlock()
+	for _, k := range keys {
+		if !yield(Item{Key: k}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *store) get(key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.m[key]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", key)
+	}
+	return v, nil
+}
+
+func (s *store) put(it Item) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[it.Key] = it.Value
+	return nil
+}
+
+func (s *store) delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, key)
+	return nil
+}
+
+func (s *store) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.m)
+}
+
+func testOptions(t *testing.T) migrate.Options {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return migrate.NewOptions(dir, migrate.WithWorkers(4), migrate.WithBatchSize(10), migrate.WithChanBuffer(4))
+}
+
+func move(from, to *store) Stages {
+	return Stages{Read: from.get, Write: to.put, Delete: from.delete}
+}
+
+func TestRunMove(t *testing.T) {
+	from, to := newStore(100), newStore(0)
+	stages := move(from, to)
+	stages.Transform = func(it Item) (Item, error) {
+		if it.Key == "/k007" {
+			return it, ErrSkip
+		}
+		return Item{Key: "/new" + it.Key, Value: append([]byte("x"), it.Value...)}, nil
+	}
+
+	st, err := Run(testOptions(t), from.source, stages, Options{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Done != 99 || st.Skipped != 1 {
+		t.Errorf("done %d, skipped %d; want 99, 1", st.Done, st.Skipped)
+	}
+	if from.len() != 1 || to.len() != 99 {
+		t.Errorf("%d keys left, %d moved; want 1, 99", from.len(), to.len())
+	}
+	if v, _ := to.get("/new/k042"); string(v) != "xv42" {
+		t.Errorf("moved value %q, want %q", v, "xv42")
+	}
+}
+
+// buffered buffers the writes to a store and the deletes from another
+// until Flush.
+type buffered struct {
+	from, to *store
+
+	mu      sync.Mutex
+	puts    []Item
+	deletes []string
+	flushes int
+	fail    int // the flush to fail, counting from 1
+}
+
+func (b *buffered) write(it Item) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.puts = append(b.puts, it)
+	return nil
+}
+
+func (b *buffered) delete(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.deletes = append(b.deletes, key)
+	return nil
+}
+
+func (b *buffered) flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.flushes++; b.flushes == b.fail {
+		return errors.New("disk full")
+	}
+	for _, it := range b.puts {
+		b.to.put(it)
+	}
+	for _, k := range b.deletes {
+		b.from.delete(k)
+	}
+	b.puts, b.deletes = nil, nil
+	return nil
+}
+
+func TestRunFlush(t *testing.T) {
+	b := &buffered{from: newStore(95), to: newStore(0)}
+	stages := Stages{Read: b.from.get, Write: b.write, Delete: b.delete, Flush: b.flush}
+	if _, err := Run(testOptions(t), b.from.source, stages, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if b.from.len() != 0 || b.to.len() != 95 {
+		t.Errorf("%d keys left, %d moved; want 0, 95", b.from.len(), b.to.len())
+	}
+	// every 10 keys, and once at the end
+	if b.flushes != 10 {
+		t.Errorf("flushed %d times, want 10", b.flushes)
+	}
+}
+
+func TestRunFlushError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cpPath := filepath.Join(dir, "checkpoint")
+	cp, err := LoadCheckpoint(cpPath, "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &buffered{from: newStore(95), to: newStore(0), fail: 2}
+	stages := Stages{Read: b.from.get, Write: b.write, Delete: b.delete, Flush: b.flush}
+	st, err := Run(testOptions(t), b.from.source, stages, Options{Checkpoint: cp, MaxErrors: 100})
+	var errs Errors
+	if !errors.As(err, &errs) || errs.Unwrap().Error() != "disk full" {
+		t.Fatalf("got error %v, want the flush error", err)
+	}
+	// nothing is flushed or saved after the failed flush
+	if b.flushes != 2 {
+		t.Errorf("flushed %d times, want 2", b.flushes)
+	}
+	if b.to.len() != 10 || b.from.len() != 85 {
+		t.Errorf("%d keys moved, %d left; want 10, 85", b.to.len(), b.from.len())
+	}
+	cp, err = LoadCheckpoint(cpPath, "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Done != 10 || st.Done < 10 || st.Done >= 20 {
+		t.Errorf("checkpoint at %d, %d done; want 10 saved", cp.Done, st.Done)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	failing := func(it Item) error {
+		if strings.HasSuffix(it.Key, "3") {
+			return errors.New("disk full")
+		}
+		return nil
+	}
+
+	// stops at the first failure
+	from := newStore(100)
+	_, err := Run(testOptions(t), from.source, Stages{Read: from.get, Write: failing, Delete: from.delete}, Options{})
+	var errs Errors
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		t.Fatalf("got error %v, want Errors", err)
+	}
+	if from.len() == 0 {
+		t.Error("run went on after a failure")
+	}
+
+	// tolerates failures up to MaxErrors, and tries every key
+	from = newStore(100)
+	st, err := Run(testOptions(t), from.source, Stages{Read: from.get, Write: failing, Delete: from.delete}, Options{MaxErrors: 10})
+	if !errors.As(err, &errs) || len(errs) != 10 {
+		t.Fatalf("got error %v, want 10 key errors", err)
+	}
+	if st.Done != 90 || from.len() != 10 {
+		t.Errorf("done %d, %d keys left; want 90, 10", st.Done, from.len())
+	}
+	if !strings.HasPrefix(err.Error(), "10 keys failed:") || !strings.HasSuffix(err.Error(), "and 5 more") {
+		t.Errorf("error %q", err)
+	}
+	if errors.Unwrap(err).Error() != "disk full" {
+		t.Errorf("unwrapped %v", errors.Unwrap(err))
+	}
+}
+
+func TestRunInterrupted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cpPath := filepath.Join(dir, "checkpoint")
+	cp, err := LoadCheckpoint(cpPath, "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := testOptions(t)
+	opts.Shutdown = migrate.NewShutdown(time.Second)
+	from, to := newStore(100), newStore(0)
+	stages := move(from, to)
+	var writes int32
+	stages.Write = func(it Item) error {
+		if atomic.AddInt32(&writes, 1) == 25 {
+			go opts.Shutdown.Stop()
+			<-opts.Shutdown.Stopping()
+		}
+		return to.put(it)
+	}
+
+	st, err := Run(opts, from.source, stages, Options{Checkpoint: cp})
+	if err != migrate.ErrInterrupted {
+		t.Fatalf("got error %v, want ErrInterrupted", err)
+	}
+	opts.Shutdown.Stop()
+	if int(st.Done)+from.len() != 100 || from.len()+to.len() != 100 {
+		t.Errorf("done %d, %d left, %d moved: keys lost or half moved", st.Done, from.len(), to.len())
+	}
+
+	// the flush hook saved the count, and a re-run carries on from it
+	cp, err = LoadCheckpoint(cpPath, "apply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Done != st.Done {
+		t.Errorf("checkpoint has %d done, want %d", cp.Done, st.Done)
+	}
+	if other, _ := LoadCheckpoint(cpPath, "revert"); other.Done != 0 {
+		t.Error("checkpoint of another run reused")
+	}
+	_, err = Run(testOptions(t), from.source, move(from, to), Options{Checkpoint: cp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if to.len() != 100 || cp.Done != 100 {
+		t.Errorf("%d moved, checkpoint at %d; want 100", to.len(), cp.Done)
+	}
+	if _, err := ioutil.ReadFile(cpPath); err == nil {
+		t.Error("checkpoint left after a complete run")
+	}
+}
+
+func TestRunRename(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := newStore(0)
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("k%02d", i)
+		if err := ioutil.WriteFile(filepath.Join(dir, key), []byte(key), 0644); err != nil {
+			t.Fatal(err)
+		}
+		src.put(Item{Key: key})
+	}
+	copied := newStore(0)
+	stages := Stages{
+		Read:   src.get,
+		Write:  copied.put,
+		Delete: src.delete,
+		Files: func(key string) (string, string, bool) {
+			return filepath.Join(dir, key), filepath.Join(dir, "new", key[:2], key), key != "k07"
+		},
+	}
+	st, err := Run(testOptions(t), src.source, stages, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Done != 20 || copied.len() != 1 {
+		t.Errorf("done %d, %d copied; want 20, only k07 copied", st.Done, copied.len())
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "new", "k1", "k13")); err != nil || string(data) != "k13" {
+		t.Errorf("renamed file: %q, %v", data, err)
+	}
+
+	if !isCrossDevice(&os.LinkError{Op: "rename", Err: syscall.EXDEV}) || isCrossDevice(os.ErrNotExist) {
+		t.Error("isCrossDevice")
+	}
+}
+
+func TestRunSourceValues(t *testing.T) {
+	from, to := newStore(30), newStore(0)
+	src := func(yield func(Item) bool) error {
+		for i := 0; i < 30; i++ {
+			key := fmt.Sprintf("/k%03d", i)
+			it := Item{Key: key}
+			if i%2 == 0 {
+				it.Value = []byte("yielded")
+			}
+			if !yield(it) {
+				return nil
+			}
+		}
+		return nil
+	}
+	var reads int32
+	stages := move(from, to)
+	stages.Read = func(key string) ([]byte, error) {
+		atomic.AddInt32(&reads, 1)
+		return from.get(key)
+	}
+	if _, err := Run(testOptions(t), src, stages, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 15 || to.len() != 30 {
+		t.Errorf("%d reads, %d moved; want 15, 30", reads, to.len())
+	}
+	if v, _ := to.get("/k004"); string(v) != "yielded" {
+		t.Errorf("moved %q, want the yielded value", v)
+	}
+}
+
+func TestShardSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	want := make(map[string]bool)
+	for i := 0; i < 50; i++ {
+		shard := filepath.Join(dir, fmt.Sprintf("%02d", i%7))
+		if err := os.MkdirAll(shard, 0755); err != nil {
+			t.Fatal(err)
+		}
+		name := fmt.Sprintf("k%03d", i)
+		if i%10 == 0 {
+			// a temporary file, left out
+			name = "put-" + name
+		} else {
+			want["/"+name] = true
+		}
+		if err := ioutil.WriteFile(filepath.Join(shard, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	src := ShardSource(dir, 3, func(name string) (string, bool) {
+		return "/" + name, !strings.HasPrefix(name, "put-")
+	})
+
+	var mu sync.Mutex
+	got := make(map[string]bool)
+	err = src(func(it Item) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if got[it.Key] {
+			t.Errorf("%s yielded twice", it.Key)
+		}
+		got[it.Key] = true
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d keys, want %d", len(got), len(want))
+	}
+	for k := range want {
+		if !got[k] {
+			t.Errorf("%s missing", k)
+		}
+	}
+
+	// stopping early returns once the scanners are done
+	n := 0
+	if err := src(func(Item) bool { n++; return n < 5 }); err != nil || n != 5 {
+		t.Errorf("stopped after %d keys with %v, want 5", n, err)
+	}
+
+	if err := ShardSource(filepath.Join(dir, "missing"), 3, nil)(func(Item) bool { return true }); err == nil {
+		t.Error("no error for a missing directory")
+	}
+}
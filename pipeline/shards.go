@@ -0,0 +1,121 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// scanChunk is the number of names read from a shard directory at a time,
+// and the number of keys buffered ahead of the pipeline.
+const scanChunk = 1024
+
+// ShardSource returns a Source of the keys held in the files of the shard
+// directories under root, as flatfs lays them out, reading up to workers
+// directories at once. Walking hundreds of shard directories one at a time,
+// as the flatfs queries do, leaves a migration silent for a long time
+// before the first key moves; here keys flow as soon as a directory is
+// open. key returns the key a file name holds, or false to leave the file
+// out, e.g. a temporary one. Keys come in no particular order.
+func ShardSource(root string, workers int, key func(name string) (string, bool)) Source {
+	return func(yield func(Item) bool) error {
+		shards, err := ioutil.ReadDir(root)
+		if err != nil {
+			return err
+		}
+		if workers < 1 {
+			workers = 1
+		}
+
+		dirs := make(chan string)
+		keys := make(chan string, scanChunk)
+		stop := make(chan struct{})
+		var (
+			wg      sync.WaitGroup
+			once    sync.Once
+			scanErr error
+		)
+		// fail stops the scan, keeping the first error
+		fail := func(err error) {
+			once.Do(func() {
+				scanErr = err
+				close(stop)
+			})
+		}
+
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for dir := range dirs {
+					if err := scanShard(dir, key, keys, stop); err != nil {
+						fail(err)
+					}
+				}
+			}()
+		}
+		go func() {
+			defer close(dirs)
+			for _, fi := range shards {
+				if !fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+					continue
+				}
+				select {
+				case dirs <- filepath.Join(root, fi.Name()):
+				case <-stop:
+					return
+				}
+			}
+		}()
+		go func() {
+			wg.Wait()
+			close(keys)
+		}()
+
+		for k := range keys {
+			if !yield(Item{Key: k}) {
+				fail(nil)
+				break
+			}
+		}
+		// let the scanners finish before reading their error
+		for range keys {
+		}
+		return scanErr
+	}
+}
+
+// scanShard sends the keys of the files in dir until stop is closed.
+func scanShard(dir string, key func(string) (string, bool), keys chan<- string, stop <-chan struct{}) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	for {
+		names, err := d.Readdirnames(scanChunk)
+		for _, name := range names {
+			if strings.HasPrefix(name, ".") {
+				continue
+			}
+			k, ok := key(name)
+			if !ok {
+				continue
+			}
+			select {
+			case keys <- k:
+			case <-stop:
+				return nil
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/purge"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runPurge(args []string) error {
+	fs := flag.NewFlagSet("purge", flag.ExitOnError)
+	ttl := fs.Duration("provider-ttl", purge.DefaultProviderTTL, "remove provider records older than this")
+	namespaces := fs.String("namespaces", "", "comma separated key prefixes to remove entirely, e.g. /dht")
+	dryRun := fs.Bool("dry-run", false, "only count the records that would be removed")
+	batchSize := fs.Int("batch-size", gomigrate.DefaultBatchSize, "number of keys per datastore batch")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to purge")
+	fs.Parse(args)
+
+	var nss []string
+	for _, ns := range strings.Split(*namespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns == "" {
+			continue
+		}
+		if err := purge.CheckNamespace(ns); err != nil {
+			return err
+		}
+		nss = append(nss, ns)
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+	if vnum != CurrentVersion {
+		return fmt.Errorf("purge: repo is at version %d, migrate it to %d first", vnum, CurrentVersion)
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	res, err := purge.Repo(ipfsdir, purge.Options{
+		ProviderTTL: *ttl,
+		Namespaces:  nss,
+		DryRun:      *dryRun,
+		BatchSize:   *batchSize,
+		Progress: func(scanned, removed int64) {
+			progress.Update("read %d keys, removed %d", scanned, removed)
+		},
+	})
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	verb := "removed"
+	if *dryRun {
+		verb = "would remove"
+	}
+	var removed []string
+	for ns := range res.Removed {
+		removed = append(removed, ns)
+	}
+	sort.Strings(removed)
+	for _, ns := range removed {
+		fmt.Printf("%s: %s %d keys\n", ns, verb, res.Removed[ns])
+	}
+	fmt.Printf("%s %d of %d keys read, %d bytes\n", verb, res.Total(), res.Scanned, res.Bytes)
+	return nil
+}
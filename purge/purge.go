@@ -0,0 +1,180 @@
+// Package purge removes records a node only caches from a repo's
+// datastore: DHT provider records past their expiry, and whole namespaces
+// of other ephemeral data. Older repos can carry gigabytes of such
+// records, which every migration that walks the datastore has to read.
+package purge
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+)
+
+// ProvidersPrefix is the namespace of DHT provider records. Each record
+// is keyed by the content and the providing peer, and holds the time it
+// was received as a varint of Unix nanoseconds.
+const ProvidersPrefix = "/providers"
+
+// DefaultProviderTTL is how long the DHT keeps provider records.
+const DefaultProviderTTL = 48 * time.Hour
+
+// protected are namespaces holding data the node cannot get back.
+var protected = []string{"/", "/blocks", "/local", "/pins"}
+
+// Options controls a purge.
+type Options struct {
+	// ProviderTTL is the age past which provider records are removed. It
+	// defaults to DefaultProviderTTL.
+	ProviderTTL time.Duration
+	// Namespaces are removed entirely, e.g. "/dht".
+	Namespaces []string
+	// Now is the time provider records are aged against. It defaults to
+	// the current time.
+	Now time.Time
+	// DryRun only counts the records that would be removed.
+	DryRun bool
+	// BatchSize is the number of deletes per datastore batch.
+	BatchSize int
+	// Progress, if not nil, is called after each batch with the number of
+	// keys read and removed so far.
+	Progress func(scanned, removed int64)
+}
+
+// Result describes a purge.
+type Result struct {
+	Scanned int64
+	// Removed counts the removed keys by namespace.
+	Removed map[string]int64
+	// Bytes is the size of the removed values.
+	Bytes int64
+}
+
+// Total returns the number of removed keys.
+func (r Result) Total() int64 {
+	var n int64
+	for _, c := range r.Removed {
+		n += c
+	}
+	return n
+}
+
+// CheckNamespace returns an error if ns cannot be purged: it must be a
+// key prefix such as "/dht" that holds no data the node needs to keep.
+func CheckNamespace(ns string) error {
+	if !strings.HasPrefix(ns, "/") {
+		return fmt.Errorf("namespace %q does not start with a slash", ns)
+	}
+	clean := ds.NewKey(ns).String()
+	for _, p := range protected {
+		if clean == p {
+			return fmt.Errorf("namespace %s holds data that cannot be purged", clean)
+		}
+	}
+	return nil
+}
+
+// Repo removes expired provider records and the namespaces in opts from
+// the datastore of the repo at path. The repo must not be in use.
+func Repo(path string, opts Options) (Result, error) {
+	res := Result{Removed: make(map[string]int64)}
+	if opts.ProviderTTL <= 0 {
+		opts.ProviderTTL = DefaultProviderTTL
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = migrate.DefaultBatchSize
+	}
+	for _, ns := range opts.Namespaces {
+		if err := CheckNamespace(ns); err != nil {
+			return res, err
+		}
+	}
+
+	d, err := convert.Open(path)
+	if err != nil {
+		return res, err
+	}
+	defer d.Close()
+
+	cutoff := opts.Now.Add(-opts.ProviderTTL).UnixNano()
+	expired := func(value []byte) bool {
+		t, n := binary.Varint(value)
+		// records that cannot be read are of no use to the DHT either
+		return n <= 0 || t < cutoff
+	}
+	if err := purgePrefix(d, ProvidersPrefix, expired, opts, &res); err != nil {
+		return res, fmt.Errorf("purging %s: %w", ProvidersPrefix, err)
+	}
+	for _, ns := range opts.Namespaces {
+		all := func([]byte) bool { return true }
+		if err := purgePrefix(d, ds.NewKey(ns).String(), all, opts, &res); err != nil {
+			return res, fmt.Errorf("purging %s: %w", ns, err)
+		}
+	}
+	if opts.DryRun {
+		return res, nil
+	}
+	return res, d.Sync(ds.NewKey("/"))
+}
+
+// purgePrefix removes the keys under prefix whose value matches.
+func purgePrefix(d ds.Batching, prefix string, match func(value []byte) bool, opts Options, res *Result) error {
+	results, err := d.Query(query.Query{Prefix: prefix})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	b, err := d.Batch()
+	if err != nil {
+		return err
+	}
+	pending := 0
+	commit := func() error {
+		if pending > 0 && !opts.DryRun {
+			if err := b.Commit(); err != nil {
+				return err
+			}
+			if b, err = d.Batch(); err != nil {
+				return err
+			}
+		}
+		pending = 0
+		if opts.Progress != nil {
+			opts.Progress(res.Scanned, res.Total())
+		}
+		return nil
+	}
+
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		res.Scanned++
+		if !match(r.Value) {
+			continue
+		}
+		if !opts.DryRun {
+			if err := b.Delete(ds.RawKey(r.Key)); err != nil {
+				return err
+			}
+		}
+		res.Removed[prefix]++
+		res.Bytes += int64(len(r.Value))
+		if pending++; pending >= opts.BatchSize {
+			if err := commit(); err != nil {
+				return err
+			}
+		}
+	}
+	return commit()
+}
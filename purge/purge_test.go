@@ -0,0 +1,119 @@
+package purge
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func providerRecord(t time.Time) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutVarint(buf, t.UnixNano())]
+}
+
+// put writes keys to the datastore of the repo at path.
+func put(t *testing.T, path string, keys map[string][]byte) {
+	t.Helper()
+	d, err := convert.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	for k, v := range keys {
+		if err := d.Put(ds.NewKey(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Sync(ds.NewKey("/")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func has(t *testing.T, path string, keys ...string) map[string]bool {
+	t.Helper()
+	d, err := convert.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	found := make(map[string]bool)
+	for _, k := range keys {
+		ok, err := d.Has(ds.NewKey(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		found[k] = ok
+	}
+	return found
+}
+
+func TestRepo(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, b := range []migrationtest.Backend{migrationtest.Leveldb, migrationtest.Badger} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(b), migrationtest.WithBlocks(10, 64))
+			put(t, r.Path, map[string][]byte{
+				"/providers/cid1/peer1": providerRecord(now.Add(-72 * time.Hour)),
+				"/providers/cid1/peer2": providerRecord(now.Add(-time.Hour)),
+				"/providers/cid2/peer1": []byte("garbage"),
+				"/dht/cache/a":          []byte("a"),
+				"/local/filesroot":      []byte("root"),
+			})
+			keys := []string{"/providers/cid1/peer1", "/providers/cid1/peer2",
+				"/providers/cid2/peer1", "/dht/cache/a", "/local/filesroot"}
+
+			opts := Options{Now: now, Namespaces: []string{"/dht"}, DryRun: true}
+			res, err := Repo(r.Path, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Total() != 3 {
+				t.Errorf("dry run would remove %d keys, want 3", res.Total())
+			}
+			for k, ok := range has(t, r.Path, keys...) {
+				if !ok {
+					t.Errorf("dry run removed %s", k)
+				}
+			}
+
+			opts.DryRun = false
+			res, err = Repo(r.Path, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Removed[ProvidersPrefix] != 2 || res.Removed["/dht"] != 1 {
+				t.Errorf("unexpected removals %v", res.Removed)
+			}
+			want := map[string]bool{
+				"/providers/cid1/peer1": false,
+				"/providers/cid1/peer2": true,
+				"/providers/cid2/peer1": false,
+				"/dht/cache/a":          false,
+				"/local/filesroot":      true,
+			}
+			for k, ok := range has(t, r.Path, keys...) {
+				if ok != want[k] {
+					t.Errorf("%s: present %v, want %v", k, ok, want[k])
+				}
+			}
+			r.AssertBlocks()
+		})
+	}
+}
+
+func TestCheckNamespace(t *testing.T) {
+	for _, ns := range []string{"/", "/blocks", "/local/", "dht"} {
+		if err := CheckNamespace(ns); err == nil {
+			t.Errorf("expected %q to be refused", ns)
+		}
+	}
+	if err := CheckNamespace("/dht"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// runRepair rewrites a version file that cannot be parsed, e.g. because an
+// editor added whitespace. The version is taken from -version, or else from
+// the digits in the mangled file.
+func runRepair(args []string) error {
+	fs := flag.NewFlagSet("repair", flag.ExitOnError)
+	version := fs.Int("version", -1, "version to write (default: recover it from the version file)")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	repo := mfsr.RepoPath(ipfsdir)
+
+	if _, err := repo.VersionNum(); err == nil && *version < 0 {
+		fmt.Println("version file is valid, nothing to repair")
+		return nil
+	}
+
+	v := *version
+	if v < 0 {
+		raw, err := repo.Version()
+		if err != nil {
+			return err
+		}
+		v, err = strconv.Atoi(strings.Join(strings.Fields(raw), ""))
+		if err != nil {
+			return fmt.Errorf("cannot recover the version from %q; set it with -version", raw)
+		}
+	}
+	if _, err := mfsr.ParseVersion(strconv.Itoa(v)); err != nil {
+		return err
+	}
+
+	if err := repo.WriteVersion(strconv.Itoa(v)); err != nil {
+		return err
+	}
+	fmt.Printf("wrote version %d to %s\n", v, repo.VersionFile())
+	return nil
+}
@@ -0,0 +1,205 @@
+// Package repocopy copies ipfs repos, hard-linking files that are never
+// modified in place so that even very large repos copy quickly.
+package repocopy
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Stats describes a finished copy.
+type Stats struct {
+	Files  int64 // files copied byte for byte
+	Linked int64 // files hard-linked
+	Reused int64 // files hard-linked from Options.Reuse
+	Bytes  int64 // bytes copied, not counting hard-linked files
+}
+
+// Options controls how a repo is copied.
+type Options struct {
+	// Link hard-links immutable files instead of copying them, when source
+	// and destination are on the same filesystem.
+	Link bool
+	// Skip, if set, is called with each path relative to the source and
+	// skips it, and everything below it, when it returns true.
+	Skip func(rel string, info os.FileInfo) bool
+	// Reuse, if set, is called with each regular file that is about to be
+	// copied, and may return another file with the same contents, e.g. the
+	// same file in an earlier copy, to hard-link instead.
+	Reuse func(rel string, info os.FileInfo) (string, bool)
+}
+
+// Linkable reports whether the file at rel, relative to the repo root, is
+// never modified in place and so may be shared between copies by hard
+// link. Migrations and the daemon replace such files (write new, rename,
+// delete old) but never rewrite them.
+//
+//   - flatfs block files (blocks/**/*.data)
+//   - leveldb tables (*.ldb, *.sst), which are immutable once written
+//
+// Everything else, notably the version file, config, leveldb logs and
+// manifests, and badger value logs, is written in place and must be copied.
+func Linkable(rel string) bool {
+	rel = filepath.ToSlash(rel)
+	switch {
+	case strings.HasPrefix(rel, "blocks/") && strings.HasSuffix(rel, ".data"):
+		return true
+	case strings.HasSuffix(rel, ".ldb"), strings.HasSuffix(rel, ".sst"):
+		return true
+	}
+	return false
+}
+
+// Copy copies the repo at src to dst, which must not exist. Lock files are
+// not copied, so the copy can be locked independently of the original.
+func Copy(src, dst string, opts Options) (Stats, error) {
+	var st Stats
+
+	if _, err := os.Lstat(dst); err == nil {
+		return st, fmt.Errorf("%s already exists", dst)
+	}
+
+	err := filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		if rel != "." && (isLockFile(rel) || (opts.Skip != nil && opts.Skip(rel, info))) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		target := filepath.Join(dst, rel)
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.MkdirAll(target, mode.Perm())
+		case mode&os.ModeSymlink != 0:
+			link, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case !mode.IsRegular():
+			// sockets, pipes: nothing a repo copy needs
+			return nil
+		}
+
+		if opts.Link && Linkable(rel) {
+			if err := os.Link(p, target); err == nil {
+				st.Linked++
+				return nil
+			}
+			// different filesystem or no hard link support: copy
+		}
+		if opts.Reuse != nil {
+			if same, ok := opts.Reuse(rel, info); ok {
+				if err := os.Link(same, target); err == nil {
+					st.Reused++
+					return nil
+				}
+			}
+		}
+		n, err := copyFile(p, target, info)
+		if err != nil {
+			return err
+		}
+		st.Files++
+		st.Bytes += n
+		return nil
+	})
+	return st, err
+}
+
+func isLockFile(rel string) bool {
+	switch filepath.ToSlash(rel) {
+	case "repo.lock", "daemon.lock", "repo.lock.owner", "daemon.lock.owner", "datastore/LOCK", "badgerds/LOCK":
+		return true
+	}
+	return false
+}
+
+// copyFile copies src to dst, preserving permissions and leaving holes in
+// place of long runs of zero bytes, so sparse files stay sparse.
+func copyFile(src, dst string, info os.FileInfo) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := copySparse(out, in)
+	if err == nil {
+		// extend to the full size in case the file ends in a hole
+		err = out.Truncate(n)
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chtimes(dst, info.ModTime(), info.ModTime())
+	}
+	return n, err
+}
+
+const holeBlock = 4096
+
+func copySparse(out *os.File, in io.Reader) (int64, error) {
+	buf := make([]byte, 256*1024)
+	var n int64
+	for {
+		r, err := io.ReadFull(in, buf)
+		if r > 0 {
+			if werr := writeSparse(out, buf[:r], n); werr != nil {
+				return n, werr
+			}
+			n += int64(r)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// writeSparse writes p at offset off, seeking over all-zero blocks instead
+// of writing them.
+func writeSparse(out *os.File, p []byte, off int64) error {
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > holeBlock {
+			chunk = chunk[:holeBlock]
+		}
+		if !allZero(chunk) {
+			if _, err := out.WriteAt(chunk, off); err != nil {
+				return err
+			}
+		}
+		off += int64(len(chunk))
+		p = p[len(chunk):]
+	}
+	return nil
+}
+
+func allZero(p []byte) bool {
+	for _, b := range p {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
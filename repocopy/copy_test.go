@@ -0,0 +1,34 @@
+package repocopy
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestCopy(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb} {
+		r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(b),
+			migrationtest.WithBlocks(50, 1024), migrationtest.WithKeys("self"))
+
+		dst := filepath.Join(filepath.Dir(r.Path), "copy")
+		st, err := Copy(r.Path, dst, Options{Link: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b == migrationtest.Flatfs && st.Linked != int64(len(r.Blocks)) {
+			t.Errorf("expected %d block files to be linked, got %d", len(r.Blocks), st.Linked)
+		}
+
+		c := *r
+		c.Path = dst
+		c.AssertVersion(10)
+		c.AssertKeystore(10)
+		c.AssertBlocks()
+
+		if _, err := Copy(r.Path, dst, Options{}); err == nil {
+			t.Error("expected copying onto an existing directory to fail")
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Package repodiff compares two repos: their versions, their configs key by
+// key, the sets of keys in their datastores, and the contents of a sample
+// of the blocks both hold. It is meant to check that a rehearsal migration
+// or a restored snapshot ended up as expected, so the repos are compared as
+// they are, without migrating either to the other's version.
+//
+// The keys of the first repo are held in memory while the second is read.
+package repodiff
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// DefaultSample is the number of blocks whose contents are compared unless
+// Options.Sample says otherwise.
+const DefaultSample = 100
+
+// maxListed is the number of keys listed for each side of the key set diff;
+// the rest are only counted.
+const maxListed = 100
+
+// Secret stands in for the values of config keys that must not be shown,
+// such as the private key.
+const Secret = "(secret)"
+
+// secretKeys are the config keys whose values are replaced with Secret.
+var secretKeys = map[string]bool{
+	"Identity.PrivKey": true,
+}
+
+// Options controls a diff.
+type Options struct {
+	// Sample is the number of blocks held by both repos whose contents
+	// are compared, DefaultSample if zero. Negative compares all of them.
+	Sample int
+	// Seed picks the sample. Zero picks a different sample every time.
+	Seed int64
+	// Progress, if not nil, is called every so often with the number of
+	// keys read so far.
+	Progress func(keys int64)
+}
+
+// progressEvery is the number of keys between Progress calls.
+const progressEvery = 10000
+
+// Change is a config value that differs between the repos. A is nil if the
+// key is only in B, and B nil if it is only in A. Secret values are replaced
+// with Secret.
+type Change struct {
+	Key  string      `json:"key"`
+	A    interface{} `json:"a"`
+	B    interface{} `json:"b"`
+	only string
+}
+
+func (c Change) String() string {
+	switch c.only {
+	case "a":
+		return fmt.Sprintf("%s: only in A: %v", c.Key, c.A)
+	case "b":
+		return fmt.Sprintf("%s: only in B: %v", c.Key, c.B)
+	}
+	return fmt.Sprintf("%s: %v in A, %v in B", c.Key, c.A, c.B)
+}
+
+func newChange(key string, a, b interface{}, only string) Change {
+	if secretKeys[key] {
+		if a != nil {
+			a = Secret
+		}
+		if b != nil {
+			b = Secret
+		}
+	}
+	return Change{Key: key, A: a, B: b, only: only}
+}
+
+// Report is the outcome of a diff.
+type Report struct {
+	VersionA int      `json:"version_a"`
+	VersionB int      `json:"version_b"`
+	Config   []Change `json:"config,omitempty"`
+
+	// Common counts the keys both datastores hold.
+	Common int64 `json:"common"`
+	// OnlyA and OnlyB count the keys only one datastore holds; the first
+	// ones are listed in OnlyAKeys and OnlyBKeys.
+	OnlyA     int64    `json:"only_a"`
+	OnlyB     int64    `json:"only_b"`
+	OnlyAKeys []string `json:"only_a_keys,omitempty"`
+	OnlyBKeys []string `json:"only_b_keys,omitempty"`
+
+	// Sampled counts the blocks whose contents were compared, and Differ
+	// lists those whose contents are not the same.
+	Sampled int      `json:"sampled"`
+	Differ  []string `json:"differ,omitempty"`
+}
+
+// Same reports whether no difference was found.
+func (r Report) Same() bool {
+	return r.VersionA == r.VersionB && len(r.Config) == 0 && r.OnlyA == 0 && r.OnlyB == 0 && len(r.Differ) == 0
+}
+
+// Diff compares the repos at a and b. Neither may be in use.
+func Diff(a, b string, opts Options) (Report, error) {
+	var r Report
+	var err error
+	if r.VersionA, err = mfsr.RepoPath(a).VersionNum(); err != nil {
+		return r, err
+	}
+	if r.VersionB, err = mfsr.RepoPath(b).VersionNum(); err != nil {
+		return r, err
+	}
+
+	cfgA, err := mfsr.RepoPath(a).Config()
+	if err != nil {
+		return r, err
+	}
+	cfgB, err := mfsr.RepoPath(b).Config()
+	if err != nil {
+		return r, err
+	}
+	r.Config = DiffConfig(cfgA, cfgB)
+
+	da, err := convert.Open(a)
+	if err != nil {
+		return r, fmt.Errorf("opening %s: %w", a, err)
+	}
+	defer da.Close()
+	db, err := convert.Open(b)
+	if err != nil {
+		return r, fmt.Errorf("opening %s: %w", b, err)
+	}
+	defer db.Close()
+
+	var read int64
+	progress := func() {
+		read++
+		if opts.Progress != nil && read%progressEvery == 0 {
+			opts.Progress(read)
+		}
+	}
+
+	keysA := make(map[string]struct{})
+	err = eachKey(da, func(k string) {
+		keysA[k] = struct{}{}
+		progress()
+	})
+	if err != nil {
+		return r, err
+	}
+
+	sample := newReservoir(opts)
+	err = eachKey(db, func(k string) {
+		progress()
+		if _, ok := keysA[k]; !ok {
+			r.OnlyB++
+			r.OnlyBKeys = appendListed(r.OnlyBKeys, k)
+			return
+		}
+		delete(keysA, k)
+		r.Common++
+		if strings.HasPrefix(k, dsblocks.Prefix) {
+			sample.add(k)
+		}
+	})
+	if err != nil {
+		return r, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(read)
+	}
+
+	r.OnlyA = int64(len(keysA))
+	for k := range keysA {
+		r.OnlyAKeys = append(r.OnlyAKeys, k)
+	}
+	sort.Strings(r.OnlyAKeys)
+	if len(r.OnlyAKeys) > maxListed {
+		r.OnlyAKeys = r.OnlyAKeys[:maxListed]
+	}
+	sort.Strings(r.OnlyBKeys)
+
+	sort.Strings(sample.keys)
+	for _, k := range sample.keys {
+		va, err := da.Get(ds.RawKey(k))
+		if err != nil {
+			return r, fmt.Errorf("reading %s from %s: %w", k, a, err)
+		}
+		vb, err := db.Get(ds.RawKey(k))
+		if err != nil {
+			return r, fmt.Errorf("reading %s from %s: %w", k, b, err)
+		}
+		r.Sampled++
+		if !bytes.Equal(va, vb) {
+			r.Differ = append(r.Differ, k)
+		}
+	}
+	return r, nil
+}
+
+// eachKey calls fn with every key in d.
+func eachKey(d ds.Datastore, fn func(string)) error {
+	results, err := d.Query(query.Query{KeysOnly: true})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	for res := range results.Next() {
+		if res.Error != nil {
+			return res.Error
+		}
+		fn(res.Key)
+	}
+	return nil
+}
+
+func appendListed(keys []string, k string) []string {
+	if len(keys) < maxListed {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// reservoir keeps a uniform sample of the keys added to it.
+type reservoir struct {
+	size int
+	seen int
+	keys []string
+	rnd  *rand.Rand
+}
+
+func newReservoir(opts Options) *reservoir {
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	size := opts.Sample
+	if size == 0 {
+		size = DefaultSample
+	}
+	return &reservoir{size: size, rnd: rand.New(rand.NewSource(seed))}
+}
+
+func (s *reservoir) add(k string) {
+	s.seen++
+	if s.size < 0 || len(s.keys) < s.size {
+		s.keys = append(s.keys, k)
+		return
+	}
+	if i := s.rnd.Intn(s.seen); i < s.size {
+		s.keys[i] = k
+	}
+}
+
+// DiffConfig compares two configs key by key, descending into objects and
+// arrays, and returns the differences sorted by key. Keys are dotted paths,
+// with array indexes in brackets, e.g. "Addresses.Swarm[1]".
+func DiffConfig(a, b map[string]interface{}) []Change {
+	var changes []Change
+	diffValue("", a, b, &changes)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+func diffValue(key string, a, b interface{}, changes *[]Change) {
+	switch va := a.(type) {
+	case map[string]interface{}:
+		if vb, ok := b.(map[string]interface{}); ok {
+			for k, x := range va {
+				sub := join(key, k)
+				if y, ok := vb[k]; ok {
+					diffValue(sub, x, y, changes)
+				} else {
+					*changes = append(*changes, newChange(sub, x, nil, "a"))
+				}
+			}
+			for k, y := range vb {
+				if _, ok := va[k]; !ok {
+					*changes = append(*changes, newChange(join(key, k), nil, y, "b"))
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if vb, ok := b.([]interface{}); ok {
+			for i := 0; i < len(va) || i < len(vb); i++ {
+				sub := fmt.Sprintf("%s[%d]", key, i)
+				switch {
+				case i >= len(vb):
+					*changes = append(*changes, newChange(sub, va[i], nil, "a"))
+				case i >= len(va):
+					*changes = append(*changes, newChange(sub, nil, vb[i], "b"))
+				default:
+					diffValue(sub, va[i], vb[i], changes)
+				}
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, newChange(key, a, b, ""))
+	}
+}
+
+func join(key, sub string) string {
+	if key == "" {
+		return sub
+	}
+	return key + "." + sub
+}
@@ -0,0 +1,91 @@
+package repodiff
+
+import (
+	"fmt"
+	"testing"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestDiffConfig(t *testing.T) {
+	a := map[string]interface{}{
+		"Identity":  map[string]interface{}{"PeerID": "Qm1", "PrivKey": "secretA"},
+		"Addresses": map[string]interface{}{"Swarm": []interface{}{"/ip4/0.0.0.0/tcp/4001", "/ip6/::/tcp/4001"}},
+		"Old":       true,
+	}
+	b := map[string]interface{}{
+		"Identity":  map[string]interface{}{"PeerID": "Qm1", "PrivKey": "secretB"},
+		"Addresses": map[string]interface{}{"Swarm": []interface{}{"/ip4/0.0.0.0/tcp/4001", "/ip6/::/tcp/4002", "/ip4/0.0.0.0/udp/4001/quic"}},
+		"New":       1.0,
+	}
+	var got []string
+	for _, c := range DiffConfig(a, b) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"Addresses.Swarm[1]: /ip6/::/tcp/4001 in A, /ip6/::/tcp/4002 in B",
+		"Addresses.Swarm[2]: only in B: /ip4/0.0.0.0/udp/4001/quic",
+		"Identity.PrivKey: (secret) in A, (secret) in B",
+		"New: only in B: 1",
+		"Old: only in A: true",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			ra := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(b), migrationtest.WithBlocks(20, 100))
+			rb := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(b), migrationtest.WithBlocks(20, 100))
+
+			r, err := Diff(ra.Path, rb.Path, Options{Sample: -1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !r.Same() || r.Sampled != 20 {
+				t.Fatalf("identical repos differ: %+v", r)
+			}
+
+			// change a block in B and give it one A does not have
+			d, err := rb.OpenBlocks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var changed string
+			for k := range rb.Blocks {
+				changed = k
+				break
+			}
+			if err := d.Put(ds.NewKey(changed), []byte("corrupt")); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Put(ds.NewKey("/CIQEXTRA"), []byte("extra")); err != nil {
+				t.Fatal(err)
+			}
+			d.Close()
+
+			r, err = Diff(ra.Path, rb.Path, Options{Sample: -1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Same() || r.OnlyA != 0 || r.OnlyB != 1 || r.Common != int64(r.Sampled) {
+				t.Errorf("report %+v", r)
+			}
+			if len(r.Differ) != 1 || len(r.OnlyBKeys) != 1 {
+				t.Errorf("differ %v, only in B %v", r.Differ, r.OnlyBKeys)
+			}
+
+			r, err = Diff(ra.Path, rb.Path, Options{Sample: 5, Seed: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Sampled != 5 {
+				t.Errorf("sampled %d blocks, want 5", r.Sampled)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Package repostats reports what a repo holds: how many blocks and how
+// large, which datastore backends store them, which key format their keys
+// are in and how many pins there are. It is read by the stats command and
+// can feed migration estimators, which mostly scale with the number and
+// size of blocks.
+//
+// Blocks are read through the datastore described by Datastore.Spec, with
+// a KeysOnly query so that their contents are not read. Repos from before
+// the spec existed have their flatfs blocks directory walked instead.
+package repostats
+
+import (
+	"encoding/base32"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	"github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore/query"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/dsblocks"
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/pincheck"
+)
+
+// Key formats of block keys, see KeyFormat.
+const (
+	// CIDv1 keys are the base32 encoded CID, as written from repo version 4
+	// for CIDv1 blocks until keys became multihashes.
+	CIDv1 = "cidv1"
+	// Multihash keys are the base32 encoded multihash, as written for
+	// CIDv0 blocks and, once keys became multihashes, for all blocks.
+	Multihash = "multihash"
+	// Legacy keys are not base32, as written before repo version 4.
+	Legacy = "legacy"
+	// Other keys are base32 but neither a CID nor a multihash.
+	Other = "other"
+)
+
+// rawBase32 is the encoding of block keys from repo version 4 on.
+var rawBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Bucket bounds, each bucket counting blocks up to Max bytes and above the
+// previous bucket's Max. The last bucket, with Max 0, has no bound.
+var bucketMax = []int64{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 0}
+
+// Bucket is a bucket of the block size histogram.
+type Bucket struct {
+	Max   int64 `json:"max"` // 0 for the last, unbounded bucket
+	Count int64 `json:"count"`
+	Bytes int64 `json:"bytes"`
+}
+
+// Stats describes a repo.
+type Stats struct {
+	Version int `json:"version"`
+	// Backends maps each datastore mountpoint to its backend type.
+	Backends map[string]string `json:"backends,omitempty"`
+
+	Blocks     int64    `json:"blocks"`
+	BlockBytes int64    `json:"block_bytes"`
+	Histogram  []Bucket `json:"histogram"`
+	// KeyFormats counts the block keys by format: CIDv1, Multihash, Legacy
+	// or Other.
+	KeyFormats map[string]int64 `json:"key_formats"`
+
+	// Pins counts the pins by mode, "recursive" and "direct". Indirect
+	// pins are not counted.
+	Pins map[string]int64 `json:"pins,omitempty"`
+	// MissingPins counts the pins, or pin set nodes, without a block. They
+	// can only be told apart before pins moved into the datastore.
+	MissingPins int `json:"missing_pins,omitempty"`
+
+	// Notes tells what could not be counted and why.
+	Notes []string `json:"notes,omitempty"`
+}
+
+// Estimate returns the blocks as a migration estimate.
+func (s Stats) Estimate() migrate.Estimate {
+	return migrate.Estimate{Keys: s.Blocks, Bytes: s.BlockBytes}
+}
+
+func (s *Stats) addBlock(name string, size int64) {
+	s.Blocks++
+	s.BlockBytes += size
+	for i := range s.Histogram {
+		if b := &s.Histogram[i]; b.Max == 0 || size <= b.Max {
+			b.Count++
+			b.Bytes += size
+			break
+		}
+	}
+	s.KeyFormats[KeyFormat(name)]++
+}
+
+// Options controls Collect.
+type Options struct {
+	// Progress, if not nil, is called every so often with the number of
+	// blocks read so far.
+	Progress func(blocks int64)
+}
+
+// progressEvery is the number of blocks between Progress calls.
+const progressEvery = 10000
+
+// KeyFormat returns the format of a block key given by its last path
+// component, e.g. a flatfs file name without its extension.
+func KeyFormat(name string) string {
+	b, err := rawBase32.DecodeString(name)
+	if err != nil {
+		return Legacy
+	}
+	if c, err := cid.Cast(b); err == nil && c.Version() == 1 {
+		return CIDv1
+	}
+	if _, err := mh.Cast(b); err == nil {
+		return Multihash
+	}
+	return Other
+}
+
+// Collect gathers the stats of the repo at path. The repo must not be in
+// use.
+func Collect(path string, opts Options) (Stats, error) {
+	s := Stats{KeyFormats: make(map[string]int64)}
+	for _, max := range bucketMax {
+		s.Histogram = append(s.Histogram, Bucket{Max: max})
+	}
+	add := func(name string, size int64) {
+		s.addBlock(name, size)
+		if opts.Progress != nil && s.Blocks%progressEvery == 0 {
+			opts.Progress(s.Blocks)
+		}
+	}
+
+	rp := mfsr.RepoPath(path)
+	v, err := rp.VersionNum()
+	if err != nil {
+		return s, err
+	}
+	s.Version = v
+
+	mounts, err := rp.Mounts()
+	if err != nil {
+		s.Notes = append(s.Notes, "no datastore spec: counted flatfs blocks only, no pins")
+		err = walkFlatfs(filepath.Join(path, "blocks"), add)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return s, err
+	}
+	s.Backends = make(map[string]string)
+	for _, m := range mounts {
+		s.Backends[m.Mountpoint] = m.Type
+	}
+
+	d, err := convert.Open(path)
+	if err != nil {
+		return s, err
+	}
+	defer d.Close()
+
+	if err := queryBlocks(d, add); err != nil {
+		return s, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(s.Blocks)
+	}
+	return s, countPins(d, &s)
+}
+
+// walkFlatfs adds the blocks in the flatfs directory dir.
+func walkFlatfs(dir string, add func(name string, size int64)) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		if name := info.Name(); strings.HasSuffix(name, ".data") {
+			add(strings.TrimSuffix(name, ".data"), info.Size())
+		}
+		return nil
+	})
+}
+
+// queryBlocks adds the blocks under dsblocks.Prefix in d.
+func queryBlocks(d ds.Datastore, add func(name string, size int64)) error {
+	results, err := d.Query(query.Query{Prefix: dsblocks.Prefix, KeysOnly: true, ReturnsSizes: true})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		size := int64(r.Size)
+		if size < 0 {
+			n, err := d.GetSize(ds.RawKey(r.Key))
+			if err != nil {
+				return err
+			}
+			size = int64(n)
+		}
+		add(ds.RawKey(r.Key).BaseNamespace(), size)
+	}
+	return nil
+}
+
+// pinIndexes are the datastore namespaces indexing pins by mode, from repo
+// version 11 on. Each holds a key per pin.
+var pinIndexes = map[string]string{
+	"recursive": "/pins/index/cidRindex",
+	"direct":    "/pins/index/cidDindex",
+}
+
+// pinsInDatastoreVersion is the first repo version keeping pins in the
+// datastore rather than in dag-pb pin sets.
+const pinsInDatastoreVersion = 11
+
+func countPins(d ds.Datastore, s *Stats) error {
+	s.Pins = make(map[string]int64)
+	if s.Version >= pinsInDatastoreVersion {
+		for mode, prefix := range pinIndexes {
+			n, err := countKeys(d, prefix)
+			if err != nil {
+				return err
+			}
+			s.Pins[mode] = n
+		}
+		return nil
+	}
+
+	v, err := d.Get(ds.NewKey(pincheck.PinsKey))
+	if err == ds.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if s.Version < 4 {
+		s.Notes = append(s.Notes, "pins not counted: legacy block keys")
+		return nil
+	}
+	r, err := pincheck.Check(v, dsblocks.Blocks{Datastore: d})
+	if err != nil {
+		return err
+	}
+	for set, n := range r.Pins {
+		s.Pins[set] = int64(n)
+	}
+	s.MissingPins = len(r.Missing)
+	return nil
+}
+
+func countKeys(d ds.Datastore, prefix string) (int64, error) {
+	results, err := d.Query(query.Query{Prefix: prefix, KeysOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+	var n int64
+	for r := range results.Next() {
+		if r.Error != nil {
+			return n, r.Error
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,122 @@
+package repostats
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	cid "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-cid"
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	mh "github.com/ipfs/fs-repo-migrations/_vendor/github.com/multiformats/go-multihash"
+
+	"github.com/ipfs/fs-repo-migrations/convert"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestKeyFormat(t *testing.T) {
+	hash, err := mh.Sum([]byte("block"), mh.SHA2_256, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1 := cid.NewCidV1(cid.DagProtobuf, hash)
+	sum := sha256.Sum256(nil)
+	for key, want := range map[string]string{
+		rawBase32.EncodeToString(v1.Bytes()): CIDv1,
+		rawBase32.EncodeToString(hash):       Multihash,
+		rawBase32.EncodeToString(sum[:4]):    Other,
+		"1220abcdef":                         Legacy,
+		"CIQ=":                               Legacy,
+	} {
+		if got := KeyFormat(key); got != want {
+			t.Errorf("KeyFormat(%q) = %s, want %s", key, got, want)
+		}
+	}
+}
+
+// put writes keys to the datastore of the repo at path.
+func put(t *testing.T, path string, keys map[string][]byte) {
+	t.Helper()
+	d, err := convert.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	for k, v := range keys {
+		if err := d.Put(ds.NewKey(k), v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.Sync(ds.NewKey("/")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	for _, b := range []migrationtest.Backend{migrationtest.Flatfs, migrationtest.Leveldb, migrationtest.Badger} {
+		t.Run(fmt.Sprint(b), func(t *testing.T) {
+			r := migrationtest.NewRepo(t, 11, migrationtest.WithBackend(b), migrationtest.WithBlocks(10, 2000))
+			hash, err := mh.Sum([]byte("big"), mh.SHA2_256, -1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			put(t, r.Path, map[string][]byte{
+				"/blocks/" + rawBase32.EncodeToString(hash): make([]byte, 2<<20),
+				"/pins/index/cidRindex/a/1":                 {1},
+				"/pins/index/cidRindex/b/2":                 {1},
+				"/pins/index/cidDindex/c/3":                 {1},
+			})
+
+			s, err := Collect(r.Path, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Version != 11 {
+				t.Errorf("version %d, want 11", s.Version)
+			}
+			if s.Blocks != 11 || s.BlockBytes != 10*2000+2<<20 {
+				t.Errorf("got %d blocks of %d bytes, want 11 of %d", s.Blocks, s.BlockBytes, 10*2000+2<<20)
+			}
+			if s.KeyFormats[CIDv1] != 10 || s.KeyFormats[Multihash] != 1 {
+				t.Errorf("key formats %v", s.KeyFormats)
+			}
+			for _, bk := range s.Histogram {
+				want := int64(0)
+				switch bk.Max {
+				case 4 << 10:
+					want = 10
+				case 0:
+					want = 1
+				}
+				if bk.Count != want {
+					t.Errorf("bucket up to %d holds %d blocks, want %d", bk.Max, bk.Count, want)
+				}
+			}
+			if s.Pins["recursive"] != 2 || s.Pins["direct"] != 1 {
+				t.Errorf("pins %v, want 2 recursive and 1 direct", s.Pins)
+			}
+			if s.Backends["/"] == "" {
+				t.Errorf("no backend at /: %v", s.Backends)
+			}
+			if s.Estimate().Keys != s.Blocks {
+				t.Errorf("estimate %v", s.Estimate())
+			}
+		})
+	}
+}
+
+func TestCollectMissingPinRoot(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBackend(migrationtest.Leveldb))
+	hash, err := mh.Sum([]byte("gone"), mh.SHA2_256, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put(t, r.Path, map[string][]byte{"/local/pins": hash})
+
+	s, err := Collect(r.Path, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.MissingPins != 1 || len(s.Pins) != 0 {
+		t.Errorf("got %d missing and pins %v, want the root missing", s.MissingPins, s.Pins)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/reshard"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runReshard(args []string) error {
+	fs := flag.NewFlagSet("reshard", flag.ExitOnError)
+	shard := fs.String("shard", "", "shard function to move the blocks to, e.g. /repo/flatfs/shard/v1/next-to-last/3")
+	mountpoint := fs.String("mount", "/blocks", "mountpoint of the flatfs datastore")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to reshard")
+	fs.Parse(args)
+
+	if *shard == "" {
+		return fmt.Errorf("reshard: -shard is required")
+	}
+	to, err := flatfs.ParseShardFunc(*shard)
+	if err != nil {
+		return err
+	}
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	res, err := reshard.Repo(ipfsdir, *mountpoint, to, func(moved int64) {
+		progress.Update("moved %d blocks", moved)
+	})
+	progress.Done()
+	if err != nil {
+		return fmt.Errorf("resharding %s (run again to resume): %w", *mountpoint, err)
+	}
+	if res.From == res.To {
+		fmt.Printf("%s already uses %s\n", *mountpoint, res.To)
+		return nil
+	}
+	fmt.Printf("resharded %s\n", res)
+	return nil
+}
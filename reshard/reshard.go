@@ -0,0 +1,227 @@
+// Package reshard moves the blocks of a flatfs datastore between shard
+// functions in place, e.g. from next-to-last/2 to next-to-last/3. Blocks
+// are renamed into their new shard directory one by one, so an interrupted
+// reshard is resumed by running it again with the same shard function.
+package reshard
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// StateFile marks a flatfs directory that is being resharded. It holds the
+// target shard function, and is removed once SHARDING names it.
+const StateFile = "RESHARDING"
+
+const extension = ".data"
+
+// Result describes a resharded datastore.
+type Result struct {
+	Dir      string
+	From, To string // shard functions
+	Moved    int64  // blocks renamed into another shard directory
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%s: %s -> %s, moved %d blocks", r.Dir, r.From, r.To, r.Moved)
+}
+
+// Repo reshards the flatfs datastore mounted at mountpoint, e.g. "/blocks",
+// and updates its shard function in the config and in datastore_spec. The
+// repo must not be in use.
+func Repo(path, mountpoint string, to *flatfs.ShardIdV1, progress func(moved int64)) (Result, error) {
+	rp := mfsr.RepoPath(path)
+	mounts, err := rp.Mounts()
+	if err != nil {
+		return Result{}, err
+	}
+	m, ok := mfsr.MountFor(mounts, mountpoint)
+	if !ok {
+		return Result{}, fmt.Errorf("no datastore mounted at %s", mountpoint)
+	}
+	if m.Type != "flatfs" || m.Path() == "" {
+		return Result{}, fmt.Errorf("%s is a %s datastore, not flatfs", mountpoint, m.Type)
+	}
+
+	res, err := Dir(filepath.Join(path, m.Path()), to, progress)
+	if err != nil {
+		return res, err
+	}
+
+	// the blocks have moved, so the specs are updated even when there was
+	// nothing left to do: a previous run may have stopped before this
+	cfg, err := rp.Config()
+	if err != nil {
+		return res, err
+	}
+	spec, _ := mfsr.ConfigValue(cfg, "Datastore.Spec")
+	if setShardFunc(spec, m.Path(), to.String()) {
+		if err := rp.WriteConfig(cfg); err != nil {
+			return res, err
+		}
+	}
+
+	disk, err := rp.DatastoreSpec()
+	if os.IsNotExist(err) {
+		return res, nil
+	} else if err != nil {
+		return res, err
+	}
+	if setShardFunc(disk, m.Path(), to.String()) {
+		if err := rp.WriteDatastoreSpec(disk); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// setShardFunc sets the shard function of the flatfs spec with the given
+// path, wherever it is nested in spec. It reports whether spec changed.
+func setShardFunc(spec interface{}, path, shardFunc string) bool {
+	switch v := spec.(type) {
+	case map[string]interface{}:
+		if v["type"] == "flatfs" && v["path"] == path {
+			if v["shardFunc"] == shardFunc {
+				return false
+			}
+			v["shardFunc"] = shardFunc
+			return true
+		}
+		changed := false
+		for _, child := range v {
+			if setShardFunc(child, path, shardFunc) {
+				changed = true
+			}
+		}
+		return changed
+	case []interface{}:
+		changed := false
+		for _, child := range v {
+			if setShardFunc(child, path, shardFunc) {
+				changed = true
+			}
+		}
+		return changed
+	}
+	return false
+}
+
+// Dir reshards the flatfs datastore in dir to shard function to. Progress,
+// if not nil, is called after each block moved.
+func Dir(dir string, to *flatfs.ShardIdV1, progress func(moved int64)) (Result, error) {
+	res := Result{Dir: dir, To: to.String()}
+
+	from, err := flatfs.ReadShardFunc(dir)
+	switch {
+	case err == nil:
+		res.From = from.String()
+	case errors.Is(err, flatfs.ErrShardingFileMissing):
+		// a previous run stopped while replacing SHARDING
+	default:
+		return res, err
+	}
+
+	state := filepath.Join(dir, StateFile)
+	pending, err := ioutil.ReadFile(state)
+	switch {
+	case os.IsNotExist(err):
+		if res.From == "" {
+			return res, flatfs.ErrShardingFileMissing
+		}
+		if res.From == res.To {
+			return res, nil
+		}
+		if err := ioutil.WriteFile(state, []byte(res.To+"\n"), 0644); err != nil {
+			return res, err
+		}
+	case err != nil:
+		return res, err
+	default:
+		if p := strings.TrimSpace(string(pending)); p != res.To {
+			return res, fmt.Errorf("%s: interrupted reshard to %s must be finished first", dir, p)
+		}
+	}
+
+	if err := moveBlocks(dir, to.Func(), &res, progress); err != nil {
+		return res, err
+	}
+
+	for _, fn := range []string{flatfs.SHARDING_FN, flatfs.README_FN} {
+		if err := os.Remove(filepath.Join(dir, fn)); err != nil && !os.IsNotExist(err) {
+			return res, err
+		}
+	}
+	if err := flatfs.WriteShardFunc(dir, to); err != nil {
+		return res, err
+	}
+	if err := flatfs.WriteReadme(dir, to); err != nil {
+		return res, err
+	}
+	return res, os.Remove(state)
+}
+
+// moveBlocks renames every block that is not in the shard directory given
+// by shard into it. Shard directories left empty are removed.
+func moveBlocks(dir string, shard flatfs.ShardFunc, res *Result, progress func(moved int64)) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		sub := filepath.Join(dir, e.Name())
+		names, err := readDirNames(sub)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if strings.HasPrefix(name, "put-") {
+				// left over from an unfinished write
+				if err := os.Remove(filepath.Join(sub, name)); err != nil {
+					return err
+				}
+				continue
+			}
+			if !strings.HasSuffix(name, extension) {
+				continue
+			}
+			target := shard(strings.TrimSuffix(name, extension))
+			if target == e.Name() {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Join(dir, target), 0755); err != nil {
+				return err
+			}
+			if err := os.Rename(filepath.Join(sub, name), filepath.Join(dir, target, name)); err != nil {
+				return err
+			}
+			res.Moved++
+			if progress != nil {
+				progress(res.Moved)
+			}
+		}
+		// fails if the directory holds blocks of the new shard function,
+		// which is fine
+		os.Remove(sub)
+	}
+	return nil
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(-1)
+}
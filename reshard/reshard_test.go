@@ -0,0 +1,98 @@
+package reshard
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ds "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-datastore"
+	flatfs "github.com/ipfs/fs-repo-migrations/_vendor/github.com/ipfs/go-ds-flatfs"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func assertBlocks(t *testing.T, r *migrationtest.Repo) {
+	t.Helper()
+	d, err := flatfs.Open(filepath.Join(r.Path, "blocks"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	for k, want := range r.Blocks {
+		got, err := d.Get(ds.NewKey(k))
+		if err != nil {
+			t.Fatalf("%s: %s", k, err)
+		}
+		if string(got) != string(want) {
+			t.Fatalf("%s: content changed", k)
+		}
+	}
+}
+
+func TestRepo(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(50, 64))
+	to := flatfs.NextToLast(3)
+
+	res, err := Repo(r.Path, "/blocks", to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Moved != 50 {
+		t.Fatalf("expected 50 blocks moved, got %s", res)
+	}
+	assertBlocks(t, r)
+	r.AssertNotExists("blocks/" + StateFile)
+
+	mounts, err := mfsr.RepoPath(r.Path).Mounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := mfsr.MountFor(mounts, "/blocks"); m.Spec["shardFunc"] != to.String() {
+		t.Fatalf("config has shard function %v", m.Spec["shardFunc"])
+	}
+	disk, err := ioutil.ReadFile(filepath.Join(r.Path, "datastore_spec"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(disk), to.String()) {
+		t.Fatalf("datastore_spec not updated: %s", disk)
+	}
+
+	// nothing left to do
+	if res, err := Repo(r.Path, "/blocks", to, nil); err != nil || res.Moved != 0 {
+		t.Fatalf("second run: %s, %v", res, err)
+	}
+
+	if _, err := Repo(r.Path, "/", to, nil); err == nil {
+		t.Fatal("resharded a leveldb datastore")
+	}
+}
+
+func TestDirResume(t *testing.T) {
+	r := migrationtest.NewRepo(t, 11, migrationtest.WithBlocks(20, 64))
+	dir := filepath.Join(r.Path, "blocks")
+	to := flatfs.Prefix(3)
+
+	// as if interrupted after replacing the state but before SHARDING
+	if err := ioutil.WriteFile(filepath.Join(dir, StateFile), []byte(to.String()+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, flatfs.SHARDING_FN)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Dir(dir, flatfs.NextToLast(3), nil); err == nil {
+		t.Fatal("expected a reshard to another function to be refused")
+	}
+	if _, err := Dir(dir, to, nil); err != nil {
+		t.Fatal(err)
+	}
+	id, err := flatfs.ReadShardFunc(dir)
+	if err != nil || id.String() != to.String() {
+		t.Fatalf("SHARDING is %v, %v", id, err)
+	}
+	assertBlocks(t, r)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/identity"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// runRotateIdentity replaces the node's identity with a new ed25519 key.
+// This changes the node's peer ID, so it is never done without asking.
+func runRotateIdentity(args []string) error {
+	fs := flag.NewFlagSet("rotate-identity", flag.ExitOnError)
+	oldKey := fs.String("old-key-name", identity.DefaultOldKeyName, "keystore name to save the old identity key under")
+	yes := fs.Bool("y", false, "answer yes to all prompts")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to run")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	cfg, err := mfsr.RepoPath(ipfsdir).Config()
+	if err != nil {
+		return err
+	}
+	peerID, _ := mfsr.ConfigValue(cfg, "Identity.PeerID")
+
+	fmt.Printf("WARNING: this gives the node at %s a new peer ID in place of %v.\n", ipfsdir, peerID)
+	fmt.Println("Peers, peering configs, allowlists and links that name the old peer ID will no")
+	fmt.Println("longer find this node, and IPNS names published with the self key must be")
+	fmt.Printf("republished with the old key, which is kept in the keystore as %q.\n", *oldKey)
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+	if !(*yes || YesNoPrompt("Do you want to replace the node's identity? [y/n]")) {
+		os.Exit(1)
+	}
+
+	res, err := identity.Rotate(ipfsdir, identity.Options{OldKeyName: *oldKey})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("replaced %s identity %s with ed25519 identity %s\n", res.OldKeyType, res.OldPeerID, res.NewPeerID)
+	fmt.Printf("the old identity is backed up in %s\n", res.Backup)
+	return nil
+}
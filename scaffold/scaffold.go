@@ -0,0 +1,222 @@
+// Package scaffold writes the skeleton of a new migration into a source
+// checkout and registers it with the fs-repo-migrations command.
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"text/template"
+)
+
+// Write writes the skeleton of the migration from version from to from+1
+// under src and registers it in src/main.go. It returns the files written.
+func Write(src string, from int) ([]string, error) {
+	data := struct {
+		From, To int
+		Dir, Pkg string
+	}{from, from + 1, fmt.Sprintf("ipfs-%d-to-%d", from, from+1), fmt.Sprintf("mg%d", from)}
+
+	dir := filepath.Join(src, data.Dir)
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%s already exists", dir)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "migration"), 0755); err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for _, f := range []struct {
+		name string
+		tmpl *template.Template
+	}{
+		{"main.go", scaffoldMain},
+		{"migration/migration.go", scaffoldMigrationGo},
+		{"migration/migration_test.go", scaffoldTest},
+	} {
+		name, tmpl := f.name, f.tmpl
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return written, err
+		}
+		code, err := format.Source(buf.Bytes())
+		if err != nil {
+			return written, fmt.Errorf("%s: %w", name, err)
+		}
+		fn := filepath.Join(dir, filepath.FromSlash(name))
+		if err := ioutil.WriteFile(fn, code, 0644); err != nil {
+			return written, err
+		}
+		written = append(written, fn)
+	}
+
+	mainGo := filepath.Join(src, "main.go")
+	if err := Register(mainGo, data.Dir, data.Pkg, from); err != nil {
+		return written, err
+	}
+	return append(written, mainGo), nil
+}
+
+var (
+	lastImport    = regexp.MustCompile(`(?m)^\tmg\d+ "github.com/ipfs/fs-repo-migrations/ipfs-\d+-to-\d+/migration"\n`)
+	lastEntry     = regexp.MustCompile(`(?m)^\t&mg\d+\.Migration\{\},\n`)
+	versionAssign = regexp.MustCompile(`(?m)^var CurrentVersion = (\d+)$`)
+)
+
+// Register adds the migration in package pkg under dir to the imports and
+// the migrations list of main.go, and bumps CurrentVersion.
+func Register(mainGo, dir, pkg string, from int) error {
+	code, err := ioutil.ReadFile(mainGo)
+	if err != nil {
+		return err
+	}
+	s := string(code)
+
+	m := versionAssign.FindStringSubmatch(s)
+	if m == nil {
+		return fmt.Errorf("%s: CurrentVersion not found", mainGo)
+	}
+	if v, _ := strconv.Atoi(m[1]); v != from {
+		return fmt.Errorf("%s: CurrentVersion is %d, expected %d", mainGo, v, from)
+	}
+	s = versionAssign.ReplaceAllString(s, fmt.Sprintf("var CurrentVersion = %d", from+1))
+
+	insertAfterLast := func(re *regexp.Regexp, line string) bool {
+		locs := re.FindAllStringIndex(s, -1)
+		if locs == nil {
+			return false
+		}
+		end := locs[len(locs)-1][1]
+		s = s[:end] + line + s[end:]
+		return true
+	}
+	imp := fmt.Sprintf("\t%s \"github.com/ipfs/fs-repo-migrations/%s/migration\"\n", pkg, dir)
+	if !insertAfterLast(lastImport, imp) {
+		return fmt.Errorf("%s: migration imports not found", mainGo)
+	}
+	if !insertAfterLast(lastEntry, fmt.Sprintf("\t&%s.Migration{},\n", pkg)) {
+		return fmt.Errorf("%s: migrations list not found", mainGo)
+	}
+
+	// gofmt sorts the new import into place
+	out, err := format.Source([]byte(s))
+	if err != nil {
+		return fmt.Errorf("%s: %w", mainGo, err)
+	}
+	return ioutil.WriteFile(mainGo, out, 0644)
+}
+
+var scaffoldMain = template.Must(template.New("main").Parse(`package main
+
+import (
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	{{.Pkg}} "github.com/ipfs/fs-repo-migrations/{{.Dir}}/migration"
+)
+
+func main() {
+	m := {{.Pkg}}.Migration{}
+	migrate.Main(&m)
+}
+`))
+
+var scaffoldMigrationGo = template.Must(template.New("migration").Parse(`package {{.Pkg}}
+
+import (
+	migrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	lock "github.com/ipfs/fs-repo-migrations/ipfs-1-to-2/repolock"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+type Migration struct{}
+
+func (m Migration) FromVersion() int {
+	return {{.From}}
+}
+
+func (m Migration) ToVersion() int {
+	return {{.To}}
+}
+
+func (m Migration) Reversible() bool {
+	return true
+}
+
+func (m Migration) Apply(opts migrate.Options) error {
+	log := opts.Logger()
+	log.Info("applying %s repo migration", migrate.Versions(m))
+
+	log.Debug("locking repo at %q", opts.Path)
+	lk, err := lock.Lock2(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
+
+	repo := mfsr.RepoPath(opts.Path)
+
+	log.Debug("  - verifying version is '{{.From}}'")
+	if err := repo.CheckVersion("{{.From}}"); err != nil {
+		return err
+	}
+
+	// TODO: convert the repo
+
+	if err := repo.CasVersion("{{.From}}", "{{.To}}"); err != nil {
+		log.Error("failed to update version file to {{.To}}")
+		return err
+	}
+	log.Info("updated version file")
+
+	return nil
+}
+
+func (m Migration) Revert(opts migrate.Options) error {
+	log := opts.Logger()
+	log.Info("reverting migration")
+	lk, err := lock.Lock2(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer lk.Close()
+	defer opts.Shutdown.OnRelease(lk.Close)()
+
+	repo := mfsr.RepoPath(opts.Path)
+	if err := repo.CheckVersion("{{.To}}"); err != nil {
+		return err
+	}
+
+	// TODO: undo the conversion
+
+	if err := repo.CasVersion("{{.To}}", "{{.From}}"); err != nil {
+		return err
+	}
+	log.Info("lowered version number to {{.From}}")
+
+	return nil
+}
+`))
+
+var scaffoldTest = template.Must(template.New("test").Parse(`package {{.Pkg}}
+
+import (
+	"testing"
+
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestApplyRevert(t *testing.T) {
+	r := migrationtest.NewRepo(t, {{.From}})
+
+	r.MustApply(Migration{})
+	r.AssertVersion({{.To}})
+
+	r.MustRevert(Migration{})
+	r.AssertVersion({{.From}})
+}
+`))
@@ -0,0 +1,117 @@
+package scaffold
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestWrite(t *testing.T) {
+	src, err := ioutil.TempDir("", "scaffold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+	copyFile(t, filepath.Join("testdata", "main.go.in"), filepath.Join(src, "main.go"))
+
+	files, err := Write(src, 11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"ipfs-11-to-12/main.go",
+		"ipfs-11-to-12/migration/migration.go",
+		"ipfs-11-to-12/migration/migration_test.go",
+		"main.go",
+	}
+	if len(files) != len(want) {
+		t.Fatalf("wrote %v, want %v", files, want)
+	}
+	for i, fn := range files {
+		rel, err := filepath.Rel(src, fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if filepath.ToSlash(rel) != want[i] {
+			t.Errorf("file %d is %s, want %s", i, rel, want[i])
+		}
+		checkGolden(t, fn, filepath.Join("testdata", "golden", want[i]+".golden"))
+	}
+
+	if _, err := Write(src, 11); err == nil {
+		t.Error("expected an error scaffolding an existing migration")
+	}
+}
+
+func TestRegisterErrors(t *testing.T) {
+	in, err := ioutil.ReadFile(filepath.Join("testdata", "main.go.in"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "scaffold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	mainGo := filepath.Join(dir, "main.go")
+	for _, c := range []struct {
+		name, code string
+		from       int
+		err        string
+	}{
+		{"stale version", string(in), 10, "CurrentVersion is 11, expected 10"},
+		{"no version", strings.Replace(string(in), "var CurrentVersion = 11", "", 1), 11, "CurrentVersion not found"},
+		{"no imports", "package main\n\nvar CurrentVersion = 11\n", 11, "migration imports not found"},
+	} {
+		if err := ioutil.WriteFile(mainGo, []byte(c.code), 0644); err != nil {
+			t.Fatal(err)
+		}
+		err := Register(mainGo, "ipfs-11-to-12", "mg11", c.from)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: got error %v, want %q", c.name, err, c.err)
+		}
+		if out, _ := ioutil.ReadFile(mainGo); string(out) != c.code {
+			t.Errorf("%s: main.go changed on error", c.name)
+		}
+	}
+}
+
+// checkGolden compares the file fn with the golden file, or rewrites the
+// golden file with -update.
+func checkGolden(t *testing.T, fn, golden string) {
+	t.Helper()
+	got, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		copyFile(t, fn, golden)
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s differs from %s:\n%s", fn, golden, got)
+	}
+}
+
+func copyFile(t *testing.T, from, to string) {
+	t.Helper()
+	data, err := ioutil.ReadFile(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(to, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/repocopy"
+)
+
+// simulate runs the migrations from one version to another on a copy of the
+// repo at ipfsdir, reports the outcome, and deletes the copy. The copy is
+// made next to the repo so that block files can be hard-linked rather than
+// copied. The copy is removed on interruption too.
+func simulate(ipfsdir string, from, to int) error {
+	var (
+		mu  sync.Mutex
+		tmp string
+	)
+	removeCopy := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if tmp == "" {
+			return nil
+		}
+		fmt.Printf("===> Removing simulation copy %s\n", tmp)
+		err := os.RemoveAll(tmp)
+		tmp = ""
+		return err
+	}
+	// registered before the copy exists, so that no signal can leak it
+	shutdown.Notify()
+	defer shutdown.OnRelease(removeCopy)()
+	defer removeCopy()
+
+	mu.Lock()
+	dir, err := ioutil.TempDir(filepath.Dir(ipfsdir), ".fs-repo-migrations-simulate-")
+	tmp = dir
+	mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	dst := filepath.Join(dir, filepath.Base(ipfsdir))
+	fmt.Printf("===> Copying repo to %s for simulation...\n", dst)
+	st, err := repocopy.Copy(ipfsdir, dst, repocopy.Options{Link: true})
+	if err != nil {
+		return fmt.Errorf("failed to copy repo: %w", err)
+	}
+	fmt.Printf("===> Copied %d files (%d bytes), hard-linked %d files\n", st.Files, st.Bytes, st.Linked)
+
+	start := time.Now()
+	err = doMigrate(dst, from, to)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Printf("===> Simulation failed after %s\n", elapsed)
+		return err
+	}
+	fmt.Printf("===> Simulation of migration %d to %d succeeded in %s; %s was not modified\n", from, to, elapsed, ipfsdir)
+	return nil
+}
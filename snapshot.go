@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	"github.com/ipfs/fs-repo-migrations/snapshot"
+)
+
+// runSnapshot manages repo snapshots: "snapshot [flags] create [name]",
+// "list", "restore <name>", "remove <name>", "prune", "export <name> <file>"
+// and "import <file> [name]".
+func runSnapshot(args []string) error {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	dir := fs.String("dir", "", "directory snapshots are kept in (default: the repo path with .snapshots appended)")
+	keep := fs.Int("keep", 3, "number of most recent snapshots prune keeps")
+	yes := fs.Bool("y", false, "answer yes to all prompts")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to run")
+	keyFile := fs.String("key-file", "", "encrypt exported archives with the key or passphrase in this file, and decrypt imported ones")
+	fs.Usage = func() {
+		fmt.Println("Usage: fs-repo-migrations snapshot [flags] create [name] | list | restore <name> | remove <name> | prune | export <name> <file> | import <file> [name]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if *dir == "" {
+		*dir = snapshot.DefaultDir(ipfsdir)
+	}
+
+	verb, name := fs.Arg(0), fs.Arg(1)
+	switch verb {
+	case "create":
+		if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+			return err
+		}
+		s, err := takeSnapshot(ipfsdir, *dir, name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created snapshot %s\n", s.Name)
+		return nil
+	case "list":
+		list, err := snapshot.List(*dir)
+		if err != nil {
+			return err
+		}
+		for _, s := range list {
+			fmt.Printf("%-32s version %-3d %s\n", s.Name, s.Version, s.Created.Local().Format("2006-01-02 15:04:05"))
+		}
+		return nil
+	case "restore":
+		if name == "" {
+			return fmt.Errorf("snapshot restore: missing snapshot name")
+		}
+		if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+			return err
+		}
+		prompt := fmt.Sprintf("Do you want to replace %s with snapshot %s? [y/n]", ipfsdir, name)
+		if !(*yes || YesNoPrompt(prompt)) {
+			return fmt.Errorf("snapshot restore: aborted")
+		}
+		s, err := snapshot.Restore(ipfsdir, *dir, name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("restored snapshot %s, repo version %d\n", s.Name, s.Version)
+		return nil
+	case "remove":
+		if name == "" {
+			return fmt.Errorf("snapshot remove: missing snapshot name")
+		}
+		return snapshot.Remove(*dir, name)
+	case "prune":
+		removed, err := snapshot.Prune(*dir, *keep)
+		for _, s := range removed {
+			fmt.Printf("removed snapshot %s\n", s.Name)
+		}
+		return err
+	case "export":
+		if name == "" || fs.Arg(2) == "" {
+			return fmt.Errorf("snapshot export: missing snapshot name or file")
+		}
+		key, err := readKey(*keyFile)
+		if err != nil {
+			return err
+		}
+		return exportSnapshot(*dir, name, fs.Arg(2), key)
+	case "import":
+		if name == "" {
+			return fmt.Errorf("snapshot import: missing file")
+		}
+		key, err := readKey(*keyFile)
+		if err != nil {
+			return err
+		}
+		s, err := importSnapshot(*dir, name, fs.Arg(2), key)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("imported snapshot %s, repo version %d\n", s.Name, s.Version)
+		return nil
+	}
+	fs.Usage()
+	return fmt.Errorf("snapshot: unknown action %q", verb)
+}
+
+// readKey reads the key file at path, if any.
+func readKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return keycrypt.ReadKeyFile(path)
+}
+
+// exportSnapshot writes the snapshot called name in dir to file, encrypted
+// with key if it is not nil.
+func exportSnapshot(dir, name, file string, key []byte) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	var w io.WriteCloser = f
+	if key != nil {
+		if w, err = keycrypt.NewWriter(f, key, 0); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	s, err := snapshot.Export(dir, name, w)
+	if err == nil && key != nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(file)
+		return err
+	}
+	fmt.Printf("exported snapshot %s to %s\n", s.Name, file)
+	if key == nil {
+		fmt.Println("the archive is not encrypted: it holds the repo's private keys and may hold private content, see -key-file")
+	}
+	return nil
+}
+
+// importSnapshot reads the archive in file into dir as name, or under its
+// exported name if name is empty. Encrypted archives need key.
+func importSnapshot(dir, file, name string, key []byte) (snapshot.Snapshot, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return snapshot.Snapshot{}, err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if keycrypt.EncryptedStream(br) {
+		if key == nil {
+			return snapshot.Snapshot{}, fmt.Errorf("%s is encrypted, give its key with -key-file", file)
+		}
+		if r, err = keycrypt.NewReader(br, key); err != nil {
+			return snapshot.Snapshot{}, err
+		}
+	}
+	return snapshot.Import(dir, r, name)
+}
+
+// takeSnapshot snapshots the repo at ipfsdir into dir, reporting how much
+// had to be copied.
+func takeSnapshot(ipfsdir, dir, name string) (snapshot.Snapshot, error) {
+	fmt.Printf("===> Taking snapshot of %s...\n", ipfsdir)
+	s, err := snapshot.Create(ipfsdir, dir, name)
+	if err != nil {
+		return s, fmt.Errorf("failed to snapshot repo: %w", err)
+	}
+	fmt.Printf("===> Snapshot %s at %s: copied %d files (%d bytes), hard-linked %d files from the repo and %d unchanged since the last snapshot\n",
+		s.Name, s.Path, s.Stats.Files, s.Stats.Bytes, s.Stats.Linked, s.Stats.Reused)
+	return s, nil
+}
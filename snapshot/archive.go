@@ -0,0 +1,203 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Archives hold a snapshot in a single tar stream, so that it can be moved
+// to other storage. The first entry is the snapshot's metadata, followed
+// by the snapshot's files under archiveRepo. Archives are written and read
+// as streams, so that they can be encrypted on the way.
+
+const (
+	archiveMeta = "snapshot.json"
+	archiveRepo = "repo"
+)
+
+// ErrNotArchive is returned by Import for streams that are not snapshot
+// archives.
+var ErrNotArchive = errors.New("not a snapshot archive")
+
+// Export writes the snapshot called name in dir to w as a tar archive.
+func Export(dir, name string, w io.Writer) (Snapshot, error) {
+	s, err := Get(dir, name)
+	if err != nil {
+		return s, err
+	}
+	meta, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return s, err
+	}
+	tw := tar.NewWriter(w)
+	err = tw.WriteHeader(&tar.Header{Name: archiveMeta, Mode: 0644, Size: int64(len(meta)), ModTime: s.Created})
+	if err == nil {
+		_, err = tw.Write(meta)
+	}
+	if err != nil {
+		return s, err
+	}
+
+	err = filepath.Walk(s.Path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.Path, p)
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(p); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(archiveRepo, filepath.ToSlash(rel))
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return s, fmt.Errorf("archiving snapshot %s: %w", name, err)
+	}
+	return s, tw.Close()
+}
+
+// Import reads an archive written by Export from r into dir, as name or,
+// if name is empty, under the name it was exported with.
+func Import(dir string, r io.Reader, name string) (Snapshot, error) {
+	var s Snapshot
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != archiveMeta {
+		return s, ErrNotArchive
+	}
+	meta, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(meta, &s); err != nil {
+		return s, fmt.Errorf("%w: %v", ErrNotArchive, err)
+	}
+	if name != "" {
+		s.Name = name
+	}
+	if err := ValidateName(s.Name); err != nil {
+		return s, err
+	}
+	s.Path = filepath.Join(dir, s.Name)
+	// an imported snapshot is complete, so it is the base of none
+	s.Base = ""
+	if _, err := os.Lstat(s.Path); err == nil {
+		return s, fmt.Errorf("snapshot %s already exists", s.Name)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return s, err
+	}
+
+	// extracted aside and renamed, like a new snapshot
+	tmp := s.Path + tmpSuffix
+	if err := os.RemoveAll(tmp); err != nil {
+		return s, err
+	}
+	files := manifest{}
+	err = extract(tr, tmp)
+	if err == nil {
+		err = files.record(tmp)
+	}
+	if err == nil {
+		err = files.write(dir, s.Name)
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+		return s, fmt.Errorf("extracting snapshot: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return s, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, s.Name+metaSuffix), data, 0644); err != nil {
+		return s, err
+	}
+	return s, os.Rename(tmp, s.Path)
+}
+
+// extract writes the repo entries of tr to dest. Entries may not be
+// written through symlinks extracted before them.
+func extract(tr *tar.Reader, dest string) error {
+	links := make(map[string]bool)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := path.Clean(hdr.Name)
+		if name != archiveRepo && !strings.HasPrefix(name, archiveRepo+"/") {
+			return fmt.Errorf("%w: unexpected entry %s", ErrNotArchive, hdr.Name)
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(name, archiveRepo), "/")
+		if strings.HasPrefix(rel, "../") || rel == ".." {
+			return fmt.Errorf("%w: entry %s is outside the repo", ErrNotArchive, hdr.Name)
+		}
+		for dir := path.Dir(rel); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			if links[dir] {
+				return fmt.Errorf("%w: entry %s is under a symlink", ErrNotArchive, hdr.Name)
+			}
+		}
+		p := filepath.Join(dest, filepath.FromSlash(rel))
+		mode := os.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(p, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+			if err := os.Chtimes(p, hdr.ModTime, hdr.ModTime); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, p); err != nil {
+				return err
+			}
+			links[rel] = true
+		default:
+			return fmt.Errorf("%w: entry %s has unsupported type %c", ErrNotArchive, hdr.Name, hdr.Typeflag)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/fs-repo-migrations/repocopy"
+)
+
+// filesSuffix names the file listing a snapshot's mutable files.
+const filesSuffix = ".files.json"
+
+// fileEntry records a file of the repo as it was snapshotted.
+type fileEntry struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"` // Unix nanoseconds
+	SHA256  string `json:"sha256"`
+}
+
+// manifest lists the files that repocopy does not consider Linkable, by
+// path relative to the repo. Linkable files, flatfs blocks and leveldb
+// tables, are named after their contents or never reused under the same
+// name, so their name and size identify them and they are not listed.
+type manifest map[string]fileEntry
+
+func readManifest(dir, name string) (manifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, name+filesSuffix))
+	if os.IsNotExist(err) {
+		return manifest{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	m := manifest{}
+	return m, json.Unmarshal(data, &m)
+}
+
+func (m manifest) write(dir, name string) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, name+filesSuffix), data, 0644)
+}
+
+// reuser returns a repocopy.Options.Reuse function that finds files of
+// the repo at path unchanged in the base snapshot, recording the entries
+// of mutable files it reuses in m.
+func reuser(path string, base Snapshot, prev, m manifest) func(string, os.FileInfo) (string, bool) {
+	return func(rel string, info os.FileInfo) (string, bool) {
+		old := filepath.Join(base.Path, rel)
+		rel = filepath.ToSlash(rel)
+		if repocopy.Linkable(rel) {
+			st, err := os.Stat(old)
+			return old, err == nil && st.Size() == info.Size()
+		}
+
+		e, ok := prev[rel]
+		if !ok || e.Size != info.Size() {
+			return "", false
+		}
+		if e.ModTime != info.ModTime().UnixNano() {
+			// touched, but maybe not changed
+			sum, err := hashFile(filepath.Join(path, filepath.FromSlash(rel)))
+			if err != nil || sum != e.SHA256 {
+				return "", false
+			}
+		}
+		if _, err := os.Stat(old); err != nil {
+			return "", false
+		}
+		m[rel] = fileEntry{Size: info.Size(), ModTime: info.ModTime().UnixNano(), SHA256: e.SHA256}
+		return old, true
+	}
+}
+
+// record adds the mutable files in the snapshot at dir that m does not
+// list yet, i.e. those that were copied.
+func (m manifest) record(dir string) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if _, ok := m[rel]; ok || repocopy.Linkable(rel) {
+			return nil
+		}
+		sum, err := hashFile(p)
+		if err != nil {
+			return err
+		}
+		m[rel] = fileEntry{Size: info.Size(), ModTime: info.ModTime().UnixNano(), SHA256: sum}
+		return nil
+	})
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
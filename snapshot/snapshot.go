@@ -0,0 +1,258 @@
+// Package snapshot keeps full copies of a repo to roll back to, e.g. one
+// taken right before a migration. Snapshots are made with repocopy, which
+// hard-links the files that are never modified in place, flatfs blocks
+// above all, so a snapshot of a large repo on the same filesystem takes
+// little time and space: only the config, leveldb logs and the like are
+// copied.
+//
+// Snapshots are kept next to the repo, in DefaultDir, each in a directory
+// named after it with its metadata in a JSON file beside it. Snapshots are
+// incremental: files unchanged since the latest snapshot in the same
+// directory are hard-linked from it rather than copied, so several can be
+// kept even where the repo's own files cannot be linked, e.g. when the
+// snapshots are on another disk.
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+	"github.com/ipfs/fs-repo-migrations/repocopy"
+)
+
+// DirSuffix is appended to the repo path to get DefaultDir.
+const DirSuffix = ".snapshots"
+
+const (
+	metaSuffix = ".json"
+	// suffix of a snapshot or restored repo being copied
+	tmpSuffix = ".tmp"
+	// suffix the replaced repo is moved to while restoring
+	replacedSuffix = ".pre-restore"
+)
+
+// ErrNotFound is returned for snapshots that do not exist.
+var ErrNotFound = errors.New("no such snapshot")
+
+// Snapshot describes a snapshot.
+type Snapshot struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	// Version is the repo version at the time of the snapshot.
+	Version int `json:"version"`
+	// Base is the snapshot unchanged files were linked from, if any.
+	Base string `json:"base,omitempty"`
+	// Stats tells how much of the repo was copied rather than linked.
+	Stats repocopy.Stats `json:"stats"`
+	// Path is the snapshot's directory.
+	Path string `json:"-"`
+}
+
+// DefaultDir returns the directory the snapshots of the repo at path are
+// kept in: beside it, so that it is likely on the same filesystem.
+func DefaultDir(path string) string {
+	return filepath.Clean(path) + DirSuffix
+}
+
+// ValidateName returns an error unless name can name a snapshot.
+func ValidateName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("empty snapshot name")
+	case strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("snapshot name %q contains a slash", name)
+	case strings.HasPrefix(name, "."):
+		return fmt.Errorf("snapshot name %q starts with a dot", name)
+	case strings.HasSuffix(name, metaSuffix), strings.HasSuffix(name, tmpSuffix):
+		return fmt.Errorf("snapshot name %q has a reserved suffix", name)
+	}
+	return nil
+}
+
+// Create snapshots the repo at path into dir as name. If name is empty, it
+// is made from the repo version and the time. The repo must not be in use.
+func Create(path, dir, name string) (Snapshot, error) {
+	vnum, err := mfsr.RepoPath(path).VersionNum()
+	var notFound mfsr.VersionFileNotFound
+	if errors.As(err, &notFound) {
+		vnum = 0
+	} else if err != nil {
+		return Snapshot{}, err
+	}
+	now := time.Now().UTC()
+	if name == "" {
+		name = fmt.Sprintf("v%d-%s", vnum, now.Format("20060102-150405"))
+	}
+	if err := ValidateName(name); err != nil {
+		return Snapshot{}, err
+	}
+	s := Snapshot{Name: name, Created: now, Version: vnum, Path: filepath.Join(dir, name)}
+	if _, err := os.Lstat(s.Path); err == nil {
+		return s, fmt.Errorf("snapshot %s already exists", name)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return s, err
+	}
+
+	opts := repocopy.Options{Link: true}
+	files := manifest{}
+	list, err := List(dir)
+	if err != nil {
+		return s, err
+	}
+	if len(list) > 0 {
+		base := list[len(list)-1]
+		prev, err := readManifest(dir, base.Name)
+		if err != nil {
+			return s, fmt.Errorf("reading files of snapshot %s: %w", base.Name, err)
+		}
+		s.Base = base.Name
+		opts.Reuse = reuser(path, base, prev, files)
+	}
+
+	// copied aside and renamed, so a snapshot is either complete or absent
+	tmp := s.Path + tmpSuffix
+	if err := os.RemoveAll(tmp); err != nil {
+		return s, err
+	}
+	s.Stats, err = repocopy.Copy(path, tmp, opts)
+	if err == nil {
+		err = files.record(tmp)
+	}
+	if err == nil {
+		err = files.write(dir, name)
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+		return s, fmt.Errorf("copying repo: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return s, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+metaSuffix), data, 0644); err != nil {
+		return s, err
+	}
+	return s, os.Rename(tmp, s.Path)
+}
+
+// List returns the snapshots in dir, oldest first.
+func List(dir string) ([]Snapshot, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var list []Snapshot
+	for _, info := range infos {
+		name := info.Name()
+		if !info.IsDir() || ValidateName(name) != nil {
+			continue
+		}
+		s, err := Get(dir, name)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Created.Before(list[j].Created) })
+	return list, nil
+}
+
+// Get returns the snapshot called name in dir.
+func Get(dir, name string) (Snapshot, error) {
+	if err := ValidateName(name); err != nil {
+		return Snapshot{}, err
+	}
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return Snapshot{}, ErrNotFound
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, name+metaSuffix))
+	if os.IsNotExist(err) {
+		return Snapshot{}, ErrNotFound
+	} else if err != nil {
+		return Snapshot{}, err
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("reading snapshot %s: %w", name, err)
+	}
+	s.Path = path
+	return s, nil
+}
+
+// Restore replaces the repo at path with the snapshot called name in dir.
+// The snapshot is kept. The repo must not be in use.
+func Restore(path, dir, name string) (Snapshot, error) {
+	s, err := Get(dir, name)
+	if err != nil {
+		return s, err
+	}
+
+	path = filepath.Clean(path)
+	replaced := path + replacedSuffix
+	if _, err := os.Lstat(replaced); err == nil {
+		return s, fmt.Errorf("%s is left from an interrupted restore and may be the only copy of the repo; move it away first", replaced)
+	}
+	tmp := path + tmpSuffix
+	if err := os.RemoveAll(tmp); err != nil {
+		return s, err
+	}
+	// linked again, so the snapshot stays as it is whatever happens to
+	// the restored repo
+	if _, err := repocopy.Copy(s.Path, tmp, repocopy.Options{Link: true}); err != nil {
+		os.RemoveAll(tmp)
+		return s, fmt.Errorf("copying snapshot: %w", err)
+	}
+
+	if err := os.Rename(path, replaced); err != nil && !os.IsNotExist(err) {
+		return s, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return s, fmt.Errorf("moving restored repo into place, the replaced repo is at %s: %w", replaced, err)
+	}
+	return s, os.RemoveAll(replaced)
+}
+
+// Remove deletes the snapshot called name in dir.
+func Remove(dir, name string) error {
+	if _, err := Get(dir, name); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(dir, name+filesSuffix)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Remove(filepath.Join(dir, name+metaSuffix))
+}
+
+// Prune removes all but the keep most recent snapshots in dir, and returns
+// those it removed. Files shared by hard link with the kept snapshots stay.
+func Prune(dir string, keep int) ([]Snapshot, error) {
+	list, err := List(dir)
+	if err != nil || len(list) <= keep {
+		return nil, err
+	}
+	var removed []Snapshot
+	for _, s := range list[:len(list)-keep] {
+		if err := Remove(dir, s.Name); err != nil {
+			return removed, err
+		}
+		removed = append(removed, s)
+	}
+	return removed, nil
+}
@@ -0,0 +1,198 @@
+package snapshot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ipfs/fs-repo-migrations/keycrypt"
+	"github.com/ipfs/fs-repo-migrations/migrationtest"
+)
+
+func TestCreateRestore(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBlocks(20, 512), migrationtest.WithKeys("self"))
+	dir := DefaultDir(r.Path)
+
+	s, err := Create(r.Path, dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != 10 || s.Stats.Linked != int64(len(r.Blocks)) {
+		t.Errorf("unexpected snapshot %+v", s)
+	}
+	if _, err := Create(r.Path, dir, s.Name); err == nil {
+		t.Error("expected an existing snapshot to be refused")
+	}
+
+	list, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Name != s.Name || list[0].Path != s.Path {
+		t.Fatalf("unexpected list %+v", list)
+	}
+
+	// break the repo, then go back
+	if err := ioutil.WriteFile(filepath.Join(r.Path, "version"), []byte("11\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(r.Path, "blocks")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(r.Path, dir, s.Name); err != nil {
+		t.Fatal(err)
+	}
+	r.AssertVersion(10)
+	r.AssertKeystore(10)
+	r.AssertBlocks()
+	r.AssertNotExists("../" + filepath.Base(r.Path) + replacedSuffix)
+
+	// the snapshot survives the restore and can be removed
+	if _, err := Get(dir, s.Name); err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(dir, s.Name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Get(dir, s.Name); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"", "a/b", ".hidden", "x.json", "x.tmp"} {
+		if ValidateName(name) == nil {
+			t.Errorf("expected %q to be refused", name)
+		}
+	}
+	if err := ValidateName("pre-upgrade"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIncremental(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBlocks(5, 512))
+	dir := DefaultDir(r.Path)
+
+	s1, err := Create(r.Path, dir, "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s1.Base != "" || s1.Stats.Reused != 0 {
+		t.Errorf("first snapshot is not a full copy: %+v", s1)
+	}
+
+	cfg := filepath.Join(r.Path, "config")
+	if err := ioutil.WriteFile(cfg, []byte(`{"changed": true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// touched but unchanged: reused after comparing hashes
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(r.Path, "version"), future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	s2, err := Create(r.Path, dir, "second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.Base != "first" || s2.Stats.Reused == 0 {
+		t.Errorf("second snapshot reused nothing: %+v", s2)
+	}
+	same := func(rel string) bool {
+		a, err := os.Stat(filepath.Join(s1.Path, rel))
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := os.Stat(filepath.Join(s2.Path, rel))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return os.SameFile(a, b)
+	}
+	if !same("version") {
+		t.Error("unchanged version file was copied")
+	}
+	if same("config") {
+		t.Error("changed config was linked from the previous snapshot")
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s2.Path, "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"changed": true}` {
+		t.Errorf("snapshot has config %q", data)
+	}
+
+	removed, err := Prune(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 1 || removed[0].Name != "first" {
+		t.Errorf("pruned %+v, want the first snapshot", removed)
+	}
+	if _, err := Get(dir, "second"); err != nil {
+		t.Errorf("removing the base broke the snapshot: %v", err)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	r := migrationtest.NewRepo(t, 10, migrationtest.WithBlocks(20, 512), migrationtest.WithKeys("self"))
+	dir := DefaultDir(r.Path)
+	s, err := Create(r.Path, dir, "exported")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// as the snapshot command does with a key file
+	var buf bytes.Buffer
+	w, err := keycrypt.NewWriter(&buf, []byte("secret"), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Export(dir, s.Name, w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte(archiveMeta)) {
+		t.Fatal("archive is not encrypted")
+	}
+
+	tmp, err := ioutil.TempDir("", "snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	other := filepath.Join(tmp, "snapshots")
+	dec, err := keycrypt.NewReader(&buf, []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := Import(other, dec, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.Name != s.Name || imported.Version != 10 || !imported.Created.Equal(s.Created) {
+		t.Errorf("imported %+v, exported %+v", imported, s)
+	}
+
+	// restoring the imported snapshot gives the repo back
+	if err := os.RemoveAll(filepath.Join(r.Path, "blocks")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(r.Path, other, s.Name); err != nil {
+		t.Fatal(err)
+	}
+	r.AssertVersion(10)
+	r.AssertKeystore(10)
+	r.AssertBlocks()
+
+	if _, err := Import(other, bytes.NewReader([]byte("junk")), ""); err != ErrNotArchive {
+		t.Errorf("expected ErrNotArchive, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	"github.com/ipfs/fs-repo-migrations/repostats"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runStats(args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the stats as JSON")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to read the repo")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	s, err := repostats.Collect(ipfsdir, repostats.Options{
+		Progress: func(blocks int64) {
+			progress.Update("read %d blocks", blocks)
+		},
+	})
+	progress.Done()
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	}
+
+	fmt.Printf("version:  %d\n", s.Version)
+	mountpoints := make([]string, 0, len(s.Backends))
+	for mp := range s.Backends {
+		mountpoints = append(mountpoints, mp)
+	}
+	sort.Strings(mountpoints)
+	for _, mp := range mountpoints {
+		fmt.Printf("backend:  %s at %s\n", s.Backends[mp], mp)
+	}
+	fmt.Printf("blocks:   %d, %d bytes\n", s.Blocks, s.BlockBytes)
+	for _, f := range []string{repostats.CIDv1, repostats.Multihash, repostats.Legacy, repostats.Other} {
+		if n := s.KeyFormats[f]; n > 0 {
+			fmt.Printf("  %-9s %d keys\n", f, n)
+		}
+	}
+	fmt.Println("sizes:")
+	prev := int64(0)
+	for _, b := range s.Histogram {
+		if b.Max == 0 {
+			fmt.Printf("  > %-14d %d blocks, %d bytes\n", prev, b.Count, b.Bytes)
+		} else {
+			fmt.Printf("  <= %-13d %d blocks, %d bytes\n", b.Max, b.Count, b.Bytes)
+		}
+		prev = b.Max
+	}
+	if s.Pins != nil {
+		fmt.Printf("pins:     %d recursive, %d direct\n", s.Pins["recursive"], s.Pins["direct"])
+	}
+	if s.MissingPins > 0 {
+		fmt.Printf("warning: %d pins do not resolve to a block\n", s.MissingPins)
+	}
+	for _, n := range s.Notes {
+		fmt.Printf("note: %s\n", n)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	mfsr "github.com/ipfs/fs-repo-migrations/mfsr"
+)
+
+// repoStatus is the output of the status command.
+type repoStatus struct {
+	Path        string                      `json:"path"`
+	Version     int                         `json:"version"`
+	ToolVersion int                         `json:"tool_version"`
+	State       string                      `json:"state"` // "current", "outdated" or "too-new"
+	Skew        *gomigrate.VersionSkewError `json:"skew,omitempty"`
+	// LastChange is the most recent entry in the version history.
+	LastChange *mfsr.VersionTransition `json:"last_change,omitempty"`
+	// InProgress is set if a migration was started and not finished.
+	InProgress *mfsr.InProgress `json:"in_progress,omitempty"`
+	// Recovery tells how the next run recovers from the last migration,
+	// if it did not complete.
+	Recovery *gomigrate.Recovery `json:"recovery,omitempty"`
+	// Annotations are the annotations on the repo version.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func runStatus(args []string) error {
+	fs := flag.NewFlagSet("status", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the status as JSON")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	vnum, err := GetVersion(ipfsdir)
+	if err != nil {
+		return err
+	}
+
+	st := repoStatus{
+		Path:        ipfsdir,
+		Version:     vnum,
+		ToolVersion: CurrentVersion,
+		State:       "current",
+	}
+	st.InProgress, err = mfsr.RepoPath(ipfsdir).MigrationInProgress()
+	if err != nil {
+		return err
+	}
+	st.Recovery, err = gomigrate.Recover(ipfsdir, migrations)
+	if err != nil {
+		return err
+	}
+	st.Annotations, err = mfsr.RepoPath(ipfsdir).Annotations()
+	if err != nil {
+		return err
+	}
+
+	history, err := mfsr.RepoPath(ipfsdir).VersionHistory()
+	if err != nil {
+		return err
+	}
+	if len(history) > 0 {
+		st.LastChange = &history[len(history)-1]
+	}
+
+	var skew *gomigrate.VersionSkewError
+	if err := gomigrate.CheckVersionSkew(vnum, CurrentVersion); errors.As(err, &skew) {
+		st.State = "too-new"
+		st.Skew = skew
+	} else if vnum < CurrentVersion {
+		st.State = "outdated"
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(st)
+	}
+
+	fmt.Printf("repo:    %s\n", st.Path)
+	fmt.Printf("version: %d\n", st.Version)
+	if c := st.LastChange; c != nil {
+		fmt.Printf("changed: %s from %q by %s\n", c.Time.Local().Format(time.RFC3339), c.Old, c.Tool)
+	}
+	keys := make([]string, 0, len(st.Annotations))
+	for k := range st.Annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("%s: %s\n", k, st.Annotations[k])
+	}
+	if st.InProgress != nil {
+		fmt.Printf("warning: migration %s did not finish\n", st.InProgress)
+	}
+	if st.Recovery != nil {
+		fmt.Printf("warning: %s\n", st.Recovery)
+	}
+	switch st.State {
+	case "current":
+		fmt.Println("status:  up to date")
+	case "outdated":
+		fmt.Printf("status:  can be migrated to version %d\n", CurrentVersion)
+	case "too-new":
+		fmt.Printf("status:  %s\n", skew)
+	}
+	return nil
+}
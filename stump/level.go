@@ -0,0 +1,64 @@
+package stump
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level is the severity of a log line.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// LevelTrace is below LevelDebug and only logged once enabled with
+// Logger.SetTrace, see Logger.Trace.
+const LevelTrace = LevelDebug - 1
+
+func (l Level) String() string {
+	switch l {
+	case LevelTrace:
+		return "trace"
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// ParseLevel parses a level name as returned by Level.String.
+func ParseLevel(s string) (Level, error) {
+	for l := LevelTrace; l <= LevelError; l++ {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q", s)
+}
+
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+func (l *Level) UnmarshalText(text []byte) error {
+	return l.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (l *Level) Set(s string) error {
+	v, err := ParseLevel(s)
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
@@ -1,62 +1,118 @@
 package stump
 
 import (
-	"fmt"
 	"io"
-	"os"
-	"strings"
 )
 
-var Verbose bool
+// Default is the Logger used by the package-level functions. Migrations
+// should prefer the Logger passed to them, see migrate.Options.
+var Default = New()
 
-var ErrorPrefix = "ERROR: "
+// Trace logs to Default, see Logger.Trace.
+func Trace(args ...interface{}) {
+	Default.Trace(args...)
+}
+
+// Debug logs to Default, see Logger.Debug.
+func Debug(args ...interface{}) {
+	Default.Debug(args...)
+}
 
-var LogOut io.Writer = os.Stdout
-var ErrOut io.Writer = os.Stdout
+// Info logs to Default, see Logger.Info.
+func Info(args ...interface{}) {
+	Default.Info(args...)
+}
+
+// Warn logs to Default, see Logger.Warn.
+func Warn(args ...interface{}) {
+	Default.Warn(args...)
+}
 
+// Error logs to Default, see Logger.Error.
 func Error(args ...interface{}) {
-	log(ErrOut, ErrorPrefix, args)
+	Default.Error(args...)
 }
 
+// Fatal logs to Default and exits, see Logger.Fatal.
 func Fatal(args ...interface{}) {
-	Error(args...)
-	os.Exit(1)
+	Default.Fatal(args...)
 }
 
+// Log is Info.
+//
+// Deprecated: use Info.
 func Log(args ...interface{}) {
-	log(LogOut, "", args)
+	Default.Info(args...)
 }
 
+// VLog is Debug.
+//
+// Deprecated: use Debug.
 func VLog(args ...interface{}) {
-	if Verbose {
-		log(LogOut, "", args)
-	}
+	Default.Debug(args...)
 }
 
-func log(out io.Writer, prefix string, args []interface{}) {
-	writelog := func(format string, args ...interface{}) {
-		n := strings.Count(format, "%")
-		if n < len(args) {
-			format += strings.Repeat(" %s", len(args)-n)
-		}
-		if !strings.HasSuffix(format, "\n") {
-			format += "\n"
-		}
-		fmt.Fprintf(out, format, args...)
-	}
+// SetVerbose enables debug logging on Default.
+func SetVerbose(v bool) {
+	Default.SetVerbose(v)
+}
+
+// SetThreshold sets the console threshold of Default.
+func SetThreshold(lvl Level) {
+	Default.SetThreshold(lvl)
+}
+
+// SetOutput sets the console writers of Default.
+func SetOutput(out, errOut io.Writer) {
+	Default.SetOutput(out, errOut)
+}
+
+// SetJSON enables JSON output on Default.
+func SetJSON(v bool) {
+	Default.SetJSON(v)
+}
+
+// SetTimestamps enables time prefixes on Default.
+func SetTimestamps(timestamps, elapsed bool) {
+	Default.SetTimestamps(timestamps, elapsed)
+}
+
+// SetTrace enables Trace lines on Default, see Logger.SetTrace.
+func SetTrace(on bool, w io.Writer) {
+	Default.SetTrace(on, w)
+}
+
+// SetSink routes the console records of Default to s.
+func SetSink(s Sink) {
+	Default.SetSink(s)
+}
 
-	if len(args) == 0 {
-		writelog(prefix)
-		return
+// SetTap sends the console records of Default to s as well, see
+// Logger.SetTap.
+func SetTap(s Sink) {
+	Default.SetTap(s)
+}
+
+// LogToFile opens a RotatingFile at path with the default limits and makes
+// it the file of Default, receiving every line. The caller should close it
+// on exit.
+func LogToFile(path string) (*RotatingFile, error) {
+	f, err := OpenRotatingFile(path, DefaultMaxFileSize, DefaultKeepFiles)
+	if err != nil {
+		return nil, err
 	}
+	Default.SetFile(f, LevelDebug)
+	return f, nil
+}
 
-	switch s := args[0].(type) {
-	case string:
-		writelog(prefix+s, args[1:]...)
-	case fmt.Stringer:
-		writelog(prefix+s.String(), args[1:]...)
-	default:
-		format := strings.Repeat("%s ", len(args))
-		writelog(prefix+format, args...)
+// TraceToFile opens a RotatingFile at path with the default limits and
+// enables Trace lines on Default, writing them there. The caller should
+// close it on exit.
+func TraceToFile(path string) (*RotatingFile, error) {
+	f, err := OpenRotatingFile(path, DefaultMaxFileSize, DefaultKeepFiles)
+	if err != nil {
+		return nil, err
 	}
+	Default.SetTrace(true, f)
+	return f, nil
 }
@@ -0,0 +1,352 @@
+package stump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fields are structured values attached to a log call by passing them as
+// its last argument:
+//
+//	stump.Info("moved blocks", stump.Fields{"count": n})
+type Fields map[string]interface{}
+
+// Record is a log line, as passed to a Sink and written in JSON mode.
+type Record struct {
+	Level     Level     `json:"level"`
+	Time      time.Time `json:"time"`
+	Repo      string    `json:"repo,omitempty"`
+	Migration string    `json:"migration,omitempty"`
+	// Elapsed is the time since the migration started, if one is running.
+	Elapsed time.Duration `json:"elapsed_ns,omitempty"`
+	Message string        `json:"message"`
+	Fields  Fields        `json:"fields,omitempty"`
+}
+
+// output is the configuration and destinations shared by a Logger and the
+// loggers derived from it.
+type output struct {
+	mu sync.Mutex
+
+	out, errOut   io.Writer
+	file          io.Writer
+	fileThreshold Level
+	threshold     Level
+	sink          Sink
+	tap           Sink
+
+	trace     bool
+	traceFile io.Writer
+
+	json       bool
+	timestamps bool
+	elapsed    bool
+
+	errorPrefix, warnPrefix string
+}
+
+// Logger writes log lines to the console, or a Sink, and optionally a log
+// file. It is safe for concurrent use. Loggers derived with ForMigration
+// share their parent's configuration and destinations, as do loggers
+// derived with ForRepo.
+type Logger struct {
+	o *output
+
+	repo      string
+	migration string
+	start     time.Time
+	verbose   bool
+}
+
+// New returns a Logger writing to stderr at LevelInfo, leaving stdout to
+// the command's own output.
+func New() *Logger {
+	return &Logger{o: &output{
+		out:           os.Stderr,
+		errOut:        os.Stderr,
+		threshold:     LevelInfo,
+		fileThreshold: LevelDebug,
+		errorPrefix:   "ERROR: ",
+		warnPrefix:    "WARNING: ",
+	}}
+}
+
+// ForMigration returns a Logger for the migration name, which is included
+// in JSON records and starts the clock for elapsed-time prefixes. verbose
+// enables LevelDebug for the returned Logger only.
+func (l *Logger) ForMigration(name string, verbose bool) *Logger {
+	return &Logger{o: l.o, repo: l.repo, migration: name, start: time.Now(), verbose: verbose}
+}
+
+// ForRepo returns a Logger for the repo at path, for runs migrating several
+// repos at once: lines are prefixed with the path, and JSON records include
+// it. Loggers derived from it with ForMigration keep the repo.
+func (l *Logger) ForRepo(path string) *Logger {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	return &Logger{o: l.o, repo: path, verbose: l.verbose}
+}
+
+// SetOutput sets where Debug and Info lines, and Warn and Error lines, are
+// written.
+func (l *Logger) SetOutput(out, errOut io.Writer) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.out, l.o.errOut = out, errOut
+}
+
+// SetThreshold sets the lowest level written to the console.
+func (l *Logger) SetThreshold(lvl Level) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.threshold = lvl
+}
+
+// SetVerbose enables LevelDebug regardless of the threshold.
+func (l *Logger) SetVerbose(v bool) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.verbose = v
+}
+
+// SetFile sends every line at threshold or above to w in addition to the
+// console, so the console can stay terse while the file keeps the detail.
+// A nil w disables the file. See OpenRotatingFile.
+func (l *Logger) SetFile(w io.Writer, threshold Level) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.file, l.o.fileThreshold = w, threshold
+}
+
+// SetTrace enables Trace lines. They are written to w or, if w is nil, to
+// the file set with SetFile, whatever its threshold. They only reach the
+// console if its threshold is LevelTrace.
+func (l *Logger) SetTrace(on bool, w io.Writer) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.trace, l.o.traceFile = on, w
+}
+
+// Tracing reports whether Trace lines are written, so callers can skip
+// building them.
+func (l *Logger) Tracing() bool {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	return l.o.trace
+}
+
+// SetSink sends console records to s instead. A nil s restores console
+// output.
+func (l *Logger) SetSink(s Sink) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.sink = s
+}
+
+// SetTap also sends console records to s, whether they go to the console
+// or a sink, e.g. to show them elsewhere while the console keeps them. s is
+// called with the logger locked and must not log. A nil s removes the tap.
+func (l *Logger) SetTap(s Sink) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.tap = s
+}
+
+// SetJSON makes every line a Record encoded as JSON instead of text, for
+// ingestion by log pipelines.
+func (l *Logger) SetJSON(v bool) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.json = v
+}
+
+// SetTimestamps prefixes text lines with the time in RFC3339 format, and
+// with the time since the migration started if elapsed is set.
+func (l *Logger) SetTimestamps(timestamps, elapsed bool) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.timestamps, l.o.elapsed = timestamps, elapsed
+}
+
+// SetPrefixes sets the prefixes of Warn and Error lines.
+func (l *Logger) SetPrefixes(warn, err string) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	l.o.warnPrefix, l.o.errorPrefix = warn, err
+}
+
+// Enabled reports whether lines at lvl are written to the console.
+func (l *Logger) Enabled(lvl Level) bool {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+	return l.enabled(lvl)
+}
+
+func (l *Logger) enabled(lvl Level) bool {
+	return lvl >= l.o.threshold || (lvl == LevelDebug && l.verbose)
+}
+
+// Trace logs the decision made for a single key, such as whether it was
+// moved, skipped or failed, for debugging repos where only some keys do
+// not convert. See SetTrace.
+func (l *Logger) Trace(args ...interface{}) {
+	l.log(LevelTrace, args)
+}
+
+// Debug logs detail that is only useful when following a migration
+// closely, such as per-key progress.
+func (l *Logger) Debug(args ...interface{}) {
+	l.log(LevelDebug, args)
+}
+
+// Info logs the normal progress of a migration.
+func (l *Logger) Info(args ...interface{}) {
+	l.log(LevelInfo, args)
+}
+
+// Warn logs problems that do not stop the migration.
+func (l *Logger) Warn(args ...interface{}) {
+	l.log(LevelWarn, args)
+}
+
+// Error logs failures. Errors are always written.
+func (l *Logger) Error(args ...interface{}) {
+	l.log(LevelError, args)
+}
+
+// Fatal logs an error and exits.
+func (l *Logger) Fatal(args ...interface{}) {
+	l.Error(args...)
+	os.Exit(1)
+}
+
+func (l *Logger) log(lvl Level, args []interface{}) {
+	rec := l.record(lvl, "")
+	if n := len(args); n > 0 {
+		if f, ok := args[n-1].(Fields); ok {
+			rec.Fields = f
+			args = args[:n-1]
+		}
+	}
+	rec.Message = format(args)
+	l.write(rec)
+}
+
+func (l *Logger) record(lvl Level, msg string) Record {
+	rec := Record{
+		Level:     lvl,
+		Time:      time.Now().UTC(),
+		Repo:      l.repo,
+		Migration: l.migration,
+		Message:   msg,
+	}
+	if !l.start.IsZero() {
+		rec.Elapsed = time.Since(l.start)
+	}
+	return rec
+}
+
+// write sends rec to the console or sink and the file, as configured.
+func (l *Logger) write(rec Record) {
+	l.o.mu.Lock()
+	defer l.o.mu.Unlock()
+
+	if rec.Level == LevelTrace {
+		if !l.o.trace {
+			return
+		}
+		if w := l.o.traceFile; w != nil {
+			w.Write(l.render(rec))
+		} else if l.o.file != nil {
+			l.o.file.Write(l.render(rec))
+		}
+	}
+
+	if l.enabled(rec.Level) {
+		if l.o.tap != nil {
+			l.o.tap.Log(rec)
+		}
+		if l.o.sink != nil {
+			l.o.sink.Log(rec)
+		} else {
+			out := l.o.out
+			if rec.Level >= LevelWarn {
+				out = l.o.errOut
+			}
+			out.Write(l.render(rec))
+		}
+	}
+	if l.o.file != nil && rec.Level >= l.o.fileThreshold && rec.Level != LevelTrace {
+		l.o.file.Write(l.render(rec))
+	}
+}
+
+// render formats a record as a line of text or, in JSON mode, of JSON.
+func (l *Logger) render(rec Record) []byte {
+	if l.o.json {
+		if data, err := json.Marshal(rec); err == nil {
+			return append(data, '\n')
+		}
+		// unencodable fields: fall back to text
+	}
+
+	msg := rec.Message
+	keys := make([]string, 0, len(rec.Fields))
+	for k := range rec.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		msg += fmt.Sprintf(" %s=%v", k, rec.Fields[k])
+	}
+
+	var prefix string
+	if l.o.timestamps {
+		prefix += rec.Time.Local().Format(time.RFC3339) + " "
+	}
+	if l.o.elapsed && rec.Elapsed > 0 {
+		prefix += fmt.Sprintf("[+%s] ", rec.Elapsed.Round(time.Second))
+	}
+	if rec.Repo != "" {
+		prefix += "[" + rec.Repo + "] "
+	}
+	switch rec.Level {
+	case LevelWarn:
+		prefix += l.o.warnPrefix
+	case LevelError:
+		prefix += l.o.errorPrefix
+	}
+	return []byte(prefix + msg + "\n")
+}
+
+// format renders args the way the log functions always have: a leading
+// format string (or Stringer) consumes the arguments after it, and
+// arguments without a verb are appended.
+func format(args []interface{}) string {
+	sprintf := func(format string, args ...interface{}) string {
+		n := strings.Count(format, "%")
+		if n < len(args) {
+			format += strings.Repeat(" %s", len(args)-n)
+		}
+		return strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	switch s := args[0].(type) {
+	case string:
+		return sprintf(s, args[1:]...)
+	case fmt.Stringer:
+		return sprintf(s.String(), args[1:]...)
+	default:
+		return sprintf(strings.TrimSuffix(strings.Repeat("%s ", len(args)), " "), args...)
+	}
+}
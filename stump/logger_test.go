@@ -0,0 +1,133 @@
+package stump
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLoggerConcurrent(t *testing.T) {
+	var buf bytes.Buffer
+	l := New()
+	l.SetOutput(&buf, &buf)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ml := l.ForMigration("test", false)
+			for j := 0; j < 100; j++ {
+				ml.Info("worker %d line %d", i, j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 800 {
+		t.Fatalf("got %d lines, want 800", len(lines))
+	}
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "worker ") {
+			t.Fatalf("interleaved line %q", line)
+		}
+	}
+}
+
+func TestForMigrationVerbose(t *testing.T) {
+	var buf bytes.Buffer
+	l := New()
+	l.SetOutput(&buf, &buf)
+
+	l.ForMigration("a", true).Debug("shown")
+	l.ForMigration("b", false).Debug("hidden")
+	l.Debug("hidden")
+
+	if got := buf.String(); got != "shown\n" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	var out, file, trace bytes.Buffer
+	l := New()
+	l.SetOutput(&out, &out)
+	l.SetFile(&file, LevelDebug)
+
+	l.Trace("dropped")
+	l.SetTrace(true, nil)
+	l.Trace("to file")
+	l.SetTrace(true, &trace)
+	l.Trace("to trace file")
+
+	if out.Len() != 0 {
+		t.Fatalf("trace reached the console: %q", out.String())
+	}
+	if got := file.String(); got != "to file\n" {
+		t.Fatalf("file got %q", got)
+	}
+	if got := trace.String(); got != "to trace file\n" {
+		t.Fatalf("trace file got %q", got)
+	}
+}
+
+func TestTap(t *testing.T) {
+	var out bytes.Buffer
+	var tapped []string
+	l := New()
+	l.SetOutput(&out, &out)
+	l.SetTap(SinkFunc(func(rec Record) { tapped = append(tapped, rec.Message) }))
+
+	l.Info("both")
+	l.Debug("neither")
+	if got := out.String(); got != "both\n" {
+		t.Fatalf("console got %q", got)
+	}
+	if len(tapped) != 1 || tapped[0] != "both" {
+		t.Fatalf("tap got %q", tapped)
+	}
+}
+
+func TestForRepo(t *testing.T) {
+	var buf bytes.Buffer
+	l := New()
+	l.SetOutput(&buf, &buf)
+
+	l.ForRepo("/a").ForMigration("8-to-9", false).Info("moved")
+	l.ForRepo("/b").Warn("slow")
+	if got := buf.String(); got != "[/a] moved\n[/b] WARNING: slow\n" {
+		t.Fatalf("got %q", got)
+	}
+
+	buf.Reset()
+	l.SetJSON(true)
+	l.ForRepo("/a").ForMigration("8-to-9", false).Info("moved")
+	if got := buf.String(); !strings.Contains(got, `"repo":"/a","migration":"8-to-9"`) {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestSetVerboseWhileDeriving(t *testing.T) {
+	var buf bytes.Buffer
+	l := New()
+	l.SetOutput(&buf, &buf)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			l.SetVerbose(i%2 == 0)
+		}
+	}()
+	var derived []*Logger
+	for i := 0; i < 100; i++ {
+		derived = append(derived, l.ForRepo("/repo"))
+	}
+	wg.Wait()
+	for _, d := range derived {
+		d.Debug("line")
+	}
+}
@@ -0,0 +1,121 @@
+package stump
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultProgressInterval is how often a Progress logs at most.
+const DefaultProgressInterval = time.Second
+
+// Progress logs repeated progress messages, such as a running key count, at
+// most once per interval. On a terminal the message is rewritten in place
+// instead of adding a line each time. It is safe for concurrent use.
+type Progress struct {
+	l        *Logger
+	interval time.Duration
+	start    time.Time
+
+	mu      sync.Mutex
+	last    time.Time
+	pending string
+	inPlace bool // a line was rewritten in place and not yet ended
+}
+
+// NewProgress returns a Progress logging to l at most once per interval.
+func (l *Logger) NewProgress(interval time.Duration) *Progress {
+	return &Progress{l: l, interval: interval, start: time.Now()}
+}
+
+// NewProgress returns a Progress logging to Default.
+func NewProgress(interval time.Duration) *Progress {
+	return Default.NewProgress(interval)
+}
+
+// Update records the current progress and logs it at LevelInfo if interval
+// has passed since the last time.
+func (p *Progress) Update(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending = msg
+	if time.Since(p.last) < p.interval {
+		return
+	}
+	p.flush()
+}
+
+// Remaining describes how far done is through total, with an estimate of
+// the time left at the rate since the Progress was created, as in
+// " of 1000 (42%, ETA 3m0s)". It returns "" if total is unknown.
+func (p *Progress) Remaining(done, total int64) string {
+	if total <= 0 {
+		return ""
+	}
+	if done > total {
+		// keys added since counting
+		total = done
+	}
+	s := fmt.Sprintf(" of %d (%d%%", total, done*100/total)
+	if done > 0 {
+		left := time.Duration(float64(time.Since(p.start)) / float64(done) * float64(total-done))
+		s += fmt.Sprintf(", ETA %s", left.Round(time.Second))
+	}
+	return s + ")"
+}
+
+// Done logs the latest progress, if it was held back, and ends a line
+// being rewritten in place.
+func (p *Progress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending != "" {
+		p.flush()
+	}
+	if p.inPlace {
+		o := p.l.o
+		o.mu.Lock()
+		fmt.Fprintln(o.out)
+		o.mu.Unlock()
+		p.inPlace = false
+	}
+}
+
+func (p *Progress) flush() {
+	p.last = time.Now()
+	rec := p.l.record(LevelInfo, p.pending)
+	p.pending = ""
+
+	o := p.l.o
+	o.mu.Lock()
+	// lines of several repos would overwrite each other
+	inPlace := o.sink == nil && !o.json && p.l.repo == "" && isTerminal(o.out) && p.l.enabled(LevelInfo)
+	if inPlace {
+		// rewrite the console line; the file still gets a line each time
+		fmt.Fprintf(o.out, "\r\x1b[K%s", rec.Message)
+		p.inPlace = true
+		if o.tap != nil {
+			o.tap.Log(rec)
+		}
+		if o.file != nil && LevelInfo >= o.fileThreshold {
+			o.file.Write(p.l.render(rec))
+		}
+	}
+	o.mu.Unlock()
+
+	if !inPlace {
+		p.l.write(rec)
+	}
+}
+
+func isTerminal(w interface{}) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
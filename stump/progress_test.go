@@ -0,0 +1,23 @@
+package stump
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressRemaining(t *testing.T) {
+	p := New().NewProgress(time.Second)
+	if s := p.Remaining(10, 0); s != "" {
+		t.Fatalf("unknown total: got %q", s)
+	}
+	if s := p.Remaining(0, 200); s != " of 200 (0%)" {
+		t.Fatalf("got %q", s)
+	}
+	if s := p.Remaining(50, 200); !strings.HasPrefix(s, " of 200 (25%, ETA ") {
+		t.Fatalf("got %q", s)
+	}
+	if s := p.Remaining(300, 200); !strings.HasPrefix(s, " of 300 (100%") {
+		t.Fatalf("got %q", s)
+	}
+}
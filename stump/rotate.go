@@ -0,0 +1,99 @@
+package stump
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Defaults for the log file opened by LogToFile.
+const (
+	DefaultMaxFileSize = 10 << 20
+	DefaultKeepFiles   = 3
+)
+
+// RotatingFile is a log file that is rotated when it grows past a size
+// limit: path is renamed to path.1, path.1 to path.2 and so on, keeping a
+// fixed number of old files.
+type RotatingFile struct {
+	path    string
+	maxSize int64
+	keep    int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// OpenRotatingFile opens path for appending, rotating it once it exceeds
+// maxSize bytes and keeping keep old files.
+func OpenRotatingFile(path string, maxSize int64, keep int) (*RotatingFile, error) {
+	r := &RotatingFile{path: path, maxSize: maxSize, keep: keep}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f, r.size = f, fi.Size()
+	return nil
+}
+
+func (r *RotatingFile) rotate() error {
+	err := r.f.Close()
+	for i := r.keep - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if r.keep > 0 {
+		if rerr := os.Rename(r.path, r.path+".1"); err == nil {
+			err = rerr
+		}
+	} else if rerr := os.Remove(r.path); err == nil {
+		err = rerr
+	}
+	// reopen even if rotating failed, so logging carries on
+	if oerr := r.open(); err == nil {
+		err = oerr
+	}
+	return err
+}
+
+// Write writes p, rotating first if p would take the file past its limit.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the file.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
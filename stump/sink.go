@@ -0,0 +1,16 @@
+package stump
+
+// Sink receives log records in place of the console, so that programs
+// embedding the migrations can route their logs into their own logging
+// framework. Records below the console threshold are not passed on; the
+// log file, if any, is still written. See Logger.SetSink.
+type Sink interface {
+	Log(rec Record)
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(rec Record)
+
+func (f SinkFunc) Log(rec Record) {
+	f(rec)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/fs-repo-migrations/blockcheck"
+	gomigrate "github.com/ipfs/fs-repo-migrations/go-migrate"
+	log "github.com/ipfs/fs-repo-migrations/stump"
+)
+
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	quarantine := fs.Bool("quarantine", false, "move blocks that do not match their key to the repo's "+blockcheck.QuarantineDir+" directory")
+	yes := fs.Bool("y", false, "quarantine without asking")
+	waitDaemon := fs.Bool("wait-for-daemon-stop", false, "wait for a running ipfs daemon to exit instead of refusing to read the repo")
+	fs.Parse(args)
+
+	ipfsdir, err := GetIpfsDir()
+	if err != nil {
+		return err
+	}
+	if err := gomigrate.CheckDaemon(ipfsdir, *waitDaemon); err != nil {
+		return err
+	}
+	if *quarantine && !(*yes || YesNoPrompt("Bad blocks will be moved out of the datastore. Continue? [y/n]")) {
+		os.Exit(1)
+	}
+
+	rep, err := verifyBlocks(ipfsdir, *quarantine)
+	if err != nil {
+		return err
+	}
+	for _, b := range rep.Bad {
+		fmt.Println(b)
+		if b.Quarantined != "" {
+			fmt.Printf("  moved to %s\n", b.Quarantined)
+		}
+	}
+	fmt.Printf("checked %d blocks, %d bytes: %d bad\n", rep.Blocks-rep.Unchecked, rep.Bytes, len(rep.Bad))
+	if rep.Unchecked > 0 {
+		fmt.Printf("%d blocks not checked: unknown key format or hash function\n", rep.Unchecked)
+	}
+	if len(rep.Bad) > 0 && !*quarantine {
+		return rep.Err()
+	}
+	if len(rep.Bad) == 0 {
+		return markBackupsVerified(ipfsdir)
+	}
+	return nil
+}
+
+// markBackupsVerified marks the backups of the migrations run on the repo
+// at ipfsdir as no longer needed, once its blocks have checked out.
+func markBackupsVerified(ipfsdir string) error {
+	dir := filepath.Join(ipfsdir, gomigrate.BackupsDir)
+	sets, err := gomigrate.ReadBackups(dir)
+	if err != nil {
+		return err
+	}
+	for _, s := range sets {
+		if s.Verified {
+			continue
+		}
+		if err := gomigrate.MarkVerified(dir, s.Migration); err != nil {
+			return err
+		}
+		fmt.Printf("marked backups of %s as verified\n", s.Migration)
+	}
+	return nil
+}
+
+// verifyBlocks scans the blocks of the repo at ipfsdir, showing progress.
+func verifyBlocks(ipfsdir string, quarantine bool) (blockcheck.Report, error) {
+	progress := log.NewProgress(log.DefaultProgressInterval)
+	rep, err := blockcheck.Scan(ipfsdir, blockcheck.Options{
+		Quarantine: quarantine,
+		Progress: func(blocks, bytes int64) {
+			progress.Update("checked %d blocks, %d bytes", blocks, bytes)
+		},
+	})
+	progress.Done()
+	return rep, err
+}